@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+var (
+	usage = `
+vstreamclient streams the row changes of a keyspace from a vtgate server,
+and writes them as json change events, one per line, with the op, before,
+after, source and ts_ms of the change.
+
+The position of the stream is saved in the vgtid file after the events
+that precede it have been written. If the file exists, the stream resumes
+from the saved position.
+
+Examples:
+
+  $ vstreamclient -server vtgate:15991 -keyspace commerce -vgtid_file commerce.vgtid
+
+  $ vstreamclient -server vtgate:15991 -keyspace commerce -tables customer,corder -output changes.json -vgtid_file commerce.vgtid
+
+  $ vstreamclient -server vtgate:15991 -keyspace commerce -exclude_columns photo -vgtid_file commerce.vgtid
+
+`
+	server      = flag.String("server", "", "vtgate server to connect to")
+	keyspace    = flag.String("keyspace", "", "keyspace to stream from")
+	shards      = flag.String("shards", "", "comma separated list of shards to stream from, all the shards of the keyspace if empty")
+	tables      = flag.String("tables", "", "comma separated list of tables to stream, all the tables of the keyspace if empty")
+	excludeCols = flag.String("exclude_columns", "", "comma separated list of columns that are not streamed, like large BLOB columns")
+	tabletType  = flag.String("tablet_type", "master", "tablet type to stream from")
+	output      = flag.String("output", "", "file the change events are appended to, stdout if empty")
+	vgtidFile   = flag.String("vgtid_file", "", "file the position of the stream is saved to and resumed from")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, usage)
+	}
+}
+
+func main() {
+	defer exit.Recover()
+	defer logutil.Flush()
+
+	flag.Parse()
+	if err := run(); err != nil {
+		log.Errorf("vstreamclient: %v", err)
+		exit.Return(1)
+	}
+}
+
+func run() error {
+	if *server == "" || *keyspace == "" {
+		flag.Usage()
+		return fmt.Errorf("-server and -keyspace are required")
+	}
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		return err
+	}
+	vgtid, err := loadVGtid(*vgtidFile)
+	if err != nil {
+		return err
+	}
+	if vgtid == nil {
+		vgtid = startVGtid(*keyspace, splitList(*shards))
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+		<-sigChan
+		cancel()
+	}()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	flags := &vtgatepb.VStreamFlags{
+		ChangeEvents: true,
+		Tables:       streamTables(splitList(*tables), splitList(*excludeCols)),
+	}
+	reader, err := conn.VStream(ctx, tt, vgtid, nil, flags)
+	if err != nil {
+		return err
+	}
+	err = consume(reader, newChangeWriter(w), *vgtidFile)
+	if ctx.Err() != nil {
+		// Interrupted: the position of the last written events is saved.
+		return nil
+	}
+	return err
+}
+
+// startVGtid returns the position to start a new stream from: the current
+// position of the shards.
+func startVGtid(keyspace string, shards []string) *binlogdatapb.VGtid {
+	vgtid := &binlogdatapb.VGtid{}
+	if len(shards) == 0 {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
+			Keyspace: keyspace,
+			Gtid:     "current",
+		})
+		return vgtid
+	}
+	for _, shard := range shards {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
+			Keyspace: keyspace,
+			Shard:    shard,
+			Gtid:     "current",
+		})
+	}
+	return vgtid
+}
+
+// streamTables returns the table subscriptions of the stream. If no
+// table is specified, all the tables are streamed.
+func streamTables(tables, excludeColumns []string) []*vtgatepb.VStreamTable {
+	if len(tables) == 0 {
+		if len(excludeColumns) == 0 {
+			return nil
+		}
+		tables = []string{"/.*"}
+	}
+	var streamTables []*vtgatepb.VStreamTable
+	for _, table := range tables {
+		streamTables = append(streamTables, &vtgatepb.VStreamTable{
+			Name:           table,
+			ExcludeColumns: excludeColumns,
+		})
+	}
+	return streamTables
+}
+
+// changeWriter writes the change events as json lines.
+type changeWriter struct {
+	w   io.Writer
+	buf *bufio.Writer
+}
+
+func newChangeWriter(w io.Writer) *changeWriter {
+	return &changeWriter{
+		w:   w,
+		buf: bufio.NewWriter(w),
+	}
+}
+
+func (cw *changeWriter) write(changeEvent string) error {
+	if _, err := cw.buf.WriteString(changeEvent); err != nil {
+		return err
+	}
+	return cw.buf.WriteByte('\n')
+}
+
+// flush makes the written events durable.
+func (cw *changeWriter) flush() error {
+	if err := cw.buf.Flush(); err != nil {
+		return err
+	}
+	if f, ok := cw.w.(*os.File); ok && f != os.Stdout {
+		return f.Sync()
+	}
+	return nil
+}
+
+// consume writes the change events of the stream until it ends. The last
+// VGTID of each response is saved once the events are flushed.
+func consume(reader vtgateconn.VStreamReader, cw *changeWriter, vgtidFile string) error {
+	for {
+		events, err := reader.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		var vgtid *binlogdatapb.VGtid
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_CHANGE:
+				if err := cw.write(event.ChangeEvent); err != nil {
+					return err
+				}
+			case binlogdatapb.VEventType_VGTID:
+				vgtid = event.Vgtid
+			}
+		}
+		if err := cw.flush(); err != nil {
+			return err
+		}
+		if vgtid != nil {
+			if err := saveVGtid(vgtidFile, vgtid); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// loadVGtid returns the saved position, or nil if there's none.
+func loadVGtid(vgtidFile string) (*binlogdatapb.VGtid, error) {
+	if vgtidFile == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(vgtidFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := json.Unmarshal(data, vgtid); err != nil {
+		return nil, fmt.Errorf("invalid vgtid file %s: %v", vgtidFile, err)
+	}
+	return vgtid, nil
+}
+
+// saveVGtid atomically replaces the saved position.
+func saveVGtid(vgtidFile string, vgtid *binlogdatapb.VGtid) error {
+	if vgtidFile == "" {
+		return nil
+	}
+	data, err := json.Marshal(vgtid)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(vgtidFile), filepath.Base(vgtidFile)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), vgtidFile)
+}
+
+// splitList splits a comma separated list.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
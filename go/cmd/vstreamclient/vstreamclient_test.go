@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+type fakeReader struct {
+	responses [][]*binlogdatapb.VEvent
+}
+
+func (fr *fakeReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(fr.responses) == 0 {
+		return nil, io.EOF
+	}
+	events := fr.responses[0]
+	fr.responses = fr.responses[1:]
+	return events, nil
+}
+
+func TestConsume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vstreamclient")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	vgtidFile := path.Join(dir, "vgtid")
+
+	vgtid, err := loadVGtid(vgtidFile)
+	require.NoError(t, err)
+	assert.Nil(t, vgtid)
+
+	vgtid1 := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos1"}}}
+	vgtid2 := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos2"}}}
+	reader := &fakeReader{responses: [][]*binlogdatapb.VEvent{{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"c"}`},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid1},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, {
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"u"}`},
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"d"}`},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid2},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}}
+	buf := &bytes.Buffer{}
+	err = consume(reader, newChangeWriter(buf), vgtidFile)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"op\":\"c\"}\n{\"op\":\"u\"}\n{\"op\":\"d\"}\n", buf.String())
+
+	vgtid, err = loadVGtid(vgtidFile)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(vgtid2, vgtid), "got %v, want %v", vgtid, vgtid2)
+}
+
+func TestStartVGtid(t *testing.T) {
+	vgtid := startVGtid("ks", nil)
+	want := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Gtid: "current"}}}
+	assert.True(t, proto.Equal(want, vgtid), "got %v, want %v", vgtid, want)
+
+	vgtid = startVGtid("ks", splitList("-80, 80-"))
+	want = &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{
+		{Keyspace: "ks", Shard: "-80", Gtid: "current"},
+		{Keyspace: "ks", Shard: "80-", Gtid: "current"},
+	}}
+	assert.True(t, proto.Equal(want, vgtid), "got %v, want %v", vgtid, want)
+}
+
+func TestStreamTables(t *testing.T) {
+	assert.Nil(t, streamTables(nil, nil))
+
+	tables := streamTables(nil, []string{"photo"})
+	want := []*vtgatepb.VStreamTable{{Name: "/.*", ExcludeColumns: []string{"photo"}}}
+	assert.Equal(t, want, tables)
+
+	tables = streamTables([]string{"customer", "corder"}, nil)
+	want = []*vtgatepb.VStreamTable{{Name: "customer"}, {Name: "corder"}}
+	assert.Equal(t, want, tables)
+}
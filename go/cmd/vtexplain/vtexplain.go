@@ -33,6 +33,8 @@ var (
 	sqlFileFlag        = flag.String("sql-file", "", "Identifies the file that contains the SQL commands to analyze")
 	schemaFlag         = flag.String("schema", "", "The SQL table schema")
 	schemaFileFlag     = flag.String("schema-file", "", "Identifies the file that contains the SQL table schema")
+	declSchemaFlag     = flag.String("declarative-schema", "", "The desired SQL table schema. When given, print the statements that migrate -schema to it instead of analyzing -sql")
+	declSchemaFileFlag = flag.String("declarative-schema-file", "", "Identifies the file that contains the desired SQL table schema")
 	vschemaFlag        = flag.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFileFlag    = flag.String("vschema-file", "", "Identifies the VTGate routing schema file")
 	ksShardMapFlag     = flag.String("ks-shard-map", "", "JSON map of keyspace name -> shard name -> ShardReference object. The inner map is the same as the output of FindAllShardsInKeyspace")
@@ -52,6 +54,8 @@ var (
 		"replication-mode",
 		"schema",
 		"schema-file",
+		"declarative-schema",
+		"declarative-schema-file",
 		"sql",
 		"sql-file",
 		"vschema",
@@ -145,12 +149,27 @@ func main() {
 }
 
 func parseAndRun() error {
-	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql", true)
+	schema, err := getFileParam(*schemaFlag, *schemaFileFlag, "schema", true)
 	if err != nil {
 		return err
 	}
 
-	schema, err := getFileParam(*schemaFlag, *schemaFileFlag, "schema", true)
+	declarativeSchema, err := getFileParam(*declSchemaFlag, *declSchemaFileFlag, "declarative-schema", false)
+	if err != nil {
+		return err
+	}
+	if declarativeSchema != "" {
+		queries, err := vtexplain.DiffSchema(schema, declarativeSchema)
+		if err != nil {
+			return err
+		}
+		for _, query := range queries {
+			fmt.Printf("%s;\n", query)
+		}
+		return nil
+	}
+
+	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql", true)
 	if err != nil {
 		return err
 	}
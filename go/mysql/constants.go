@@ -326,8 +326,10 @@ const (
 	ERInternalError = 1815
 
 	// unimplemented
-	ERNotSupportedYet = 1235
-	ERUnsupportedPS   = 1295
+	ERNotSupportedYet                  = 1235
+	ERUnsupportedPS                    = 1295
+	ERAlterOperationNotSupported       = 1845
+	ERAlterOperationNotSupportedReason = 1846
 
 	// resource exhausted
 	ERDiskFull               = 1021
@@ -34,6 +34,8 @@ const (
 	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
 	// ShowRowsRead is the query used to find the number of rows read.
 	ShowRowsRead = "show status like 'Innodb_rows_read'"
+	// ViewDefinitions is the query used to find the definitions of views.
+	ViewDefinitions = "SELECT table_name, view_definition FROM information_schema.views WHERE table_schema=database()"
 )
 
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
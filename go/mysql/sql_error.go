@@ -191,6 +191,8 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.ServerNotAvailable:           {num: ERServerIsntAvailable, state: SSNetError},
 	vterrors.CantDoThisInTransaction:      {num: ERCantDoThisDuringAnTransaction, state: SSCantDoThisDuringAnTransaction},
 	vterrors.NoSuchSession:                {num: ERUnknownComError, state: SSNetError},
+	vterrors.RowIsReferenced:              {num: ERRowIsReferenced2, state: SSConstraintViolation},
+	vterrors.NoReferencedRow:              {num: ErNoReferencedRow2, state: SSConstraintViolation},
 }
 
 func init() {
@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/syslog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Sink receives structured log records, each a JSON document.
+type Sink interface {
+	// Write writes a single record. It must not retain b.
+	Write(b []byte) error
+	// Close flushes any buffered record and releases the sink.
+	Close() error
+}
+
+// RotatingFileSink writes records to a file, one per line, and rotates
+// the file when it grows past a maximum size.
+type RotatingFileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// NewRotatingFileSink opens the file at path for appending. When the file
+// would grow past maxSize bytes, it is renamed to path.1, previous backups
+// are shifted, and only maxBackups of them are kept. A maxSize of 0
+// disables rotation.
+func NewRotatingFileSink(path string, maxSize int64, maxBackups int) (*RotatingFileSink, error) {
+	s := &RotatingFileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *RotatingFileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f = f
+	s.size = fi.Size()
+	return nil
+}
+
+func (s *RotatingFileSink) backupPath(n int) string {
+	return s.path + "." + strconv.Itoa(n)
+}
+
+func (s *RotatingFileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f = nil
+	if s.maxBackups <= 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return s.open()
+	}
+	os.Remove(s.backupPath(s.maxBackups))
+	for n := s.maxBackups - 1; n >= 1; n-- {
+		if err := os.Rename(s.backupPath(n), s.backupPath(n+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return s.open()
+}
+
+// Write is part of the Sink interface.
+func (s *RotatingFileSink) Write(b []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		// A previous rotation failed, try again.
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	n := int64(len(b)) + 1
+	if s.maxSize > 0 && s.size > 0 && s.size+n > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	line := make([]byte, 0, n)
+	line = append(append(line, b...), '\n')
+	written, err := s.f.Write(line)
+	s.size += int64(written)
+	return err
+}
+
+// Close is part of the Sink interface.
+func (s *RotatingFileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+// syslogWriter wraps syslog.Writer, so it can be mocked in unit tests.
+type syslogWriter interface {
+	Info(string) error
+	Close() error
+}
+
+// SyslogSink writes records to the local syslog daemon.
+type SyslogSink struct {
+	writer syslogWriter
+}
+
+// NewSyslogSink connects to the local syslog daemon with the given tag.
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	writer, err := syslog.New(syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{writer: writer}, nil
+}
+
+// Write is part of the Sink interface.
+func (s *SyslogSink) Write(b []byte) error {
+	return s.writer.Info(string(b))
+}
+
+// Close is part of the Sink interface.
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
+
+// httpSinkQueueSize is the number of records an HTTPSink buffers before
+// dropping new ones.
+const httpSinkQueueSize = 10000
+
+// HTTPSink sends records in batches to a collector, using the OTLP/HTTP
+// JSON encoding of log records: each record is the string body of an
+// OTLP log record, and the component is the service.name resource
+// attribute.
+type HTTPSink struct {
+	url           string
+	component     string
+	batchSize     int
+	flushInterval time.Duration
+	client        *http.Client
+
+	queue chan httpSinkRecord
+	done  chan struct{}
+}
+
+type httpSinkRecord struct {
+	time time.Time
+	body string
+}
+
+// NewHTTPSink returns a sink which posts records to url, at most
+// batchSize records at a time, and at least every flushInterval.
+func NewHTTPSink(url, component string, batchSize int, flushInterval time.Duration) *HTTPSink {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	if flushInterval <= 0 {
+		flushInterval = time.Second
+	}
+	s := &HTTPSink{
+		url:           url,
+		component:     component,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		client:        &http.Client{Timeout: 10 * time.Second},
+		queue:         make(chan httpSinkRecord, httpSinkQueueSize),
+		done:          make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write is part of the Sink interface. It does not block: if the
+// collector can't keep up, the record is dropped and an error returned.
+func (s *HTTPSink) Write(b []byte) error {
+	select {
+	case s.queue <- httpSinkRecord{time: time.Now(), body: string(b)}:
+		return nil
+	default:
+		return fmt.Errorf("http sink queue is full, dropping record")
+	}
+}
+
+// Close is part of the Sink interface.
+func (s *HTTPSink) Close() error {
+	close(s.queue)
+	<-s.done
+	return nil
+}
+
+func (s *HTTPSink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]httpSinkRecord, 0, s.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.post(batch); err != nil {
+			log.Errorf("Error sending %d structured log records to %s: %v", len(batch), s.url, err)
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case record, ok := <-s.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, record)
+			if len(batch) >= s.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano string    `json:"timeUnixNano"`
+	SeverityText string    `json:"severityText"`
+	Body         otlpValue `json:"body"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func (s *HTTPSink) post(batch []httpSinkRecord) error {
+	scope := otlpScopeLogs{LogRecords: make([]otlpLogRecord, len(batch))}
+	scope.Scope.Name = "vitess.io/vitess/go/streamlog"
+	for i, record := range batch {
+		scope.LogRecords[i] = otlpLogRecord{
+			TimeUnixNano: strconv.FormatInt(record.time.UnixNano(), 10),
+			SeverityText: "INFO",
+			Body:         otlpValue{StringValue: record.body},
+		}
+	}
+	resource := otlpResourceLogs{ScopeLogs: []otlpScopeLogs{scope}}
+	resource.Resource.Attributes = []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: s.component}}}
+
+	body, err := json.Marshal(otlpLogsRequest{ResourceLogs: []otlpResourceLogs{resource}})
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	}
+	return nil
+}
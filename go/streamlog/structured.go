@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// RecordSchemaVersion is the version of the structured query log record
+// schema. It must be incremented whenever fields are renamed or removed,
+// or their meaning changes. Adding fields does not require a new version.
+const RecordSchemaVersion = 1
+
+var (
+	structuredRecordCount = stats.NewCountersWithMultiLabels(
+		"StreamlogStructuredRecords",
+		"Structured log records written, per sink",
+		[]string{"Log", "Sink"})
+	structuredSampledOutCount = stats.NewCountersWithSingleLabel(
+		"StreamlogStructuredSampledOut",
+		"Structured log records dropped by sampling",
+		"Log")
+	structuredSinkErrorCount = stats.NewCountersWithMultiLabels(
+		"StreamlogStructuredSinkErrors",
+		"Errors writing structured log records, per sink",
+		[]string{"Log", "Sink"})
+)
+
+// RecordHeader is embedded at the start of every structured record.
+type RecordHeader struct {
+	SchemaVersion int    `json:"schema_version"`
+	Component     string `json:"component"`
+}
+
+// NewRecordHeader returns the header for a record of the given component.
+func NewRecordHeader(component string) RecordHeader {
+	return RecordHeader{
+		SchemaVersion: RecordSchemaVersion,
+		Component:     component,
+	}
+}
+
+// Recorder is implemented by messages that can be logged as structured
+// records.
+type Recorder interface {
+	// Record returns the structured record for the message, to be
+	// marshaled as JSON, or nil if the message is filtered out.
+	Record() interface{}
+	// TotalTime returns the duration of the logged request. It is used
+	// for latency based sampling.
+	TotalTime() time.Duration
+}
+
+// Sampler decides which records are logged.
+type Sampler struct {
+	// Rate is the fraction of records which are logged, between 0 and 1.
+	Rate float64
+	// LatencyThreshold, if set, makes records whose latency is at least
+	// the threshold always logged, regardless of Rate.
+	LatencyThreshold time.Duration
+
+	random func() float64
+}
+
+// Sample returns true if a record with the given latency must be logged.
+func (s *Sampler) Sample(latency time.Duration) bool {
+	if s.LatencyThreshold > 0 && latency >= s.LatencyThreshold {
+		return true
+	}
+	if s.Rate >= 1 {
+		return true
+	}
+	if s.Rate <= 0 {
+		return false
+	}
+	random := s.random
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < s.Rate
+}
+
+// StructuredLogConfig configures structured logging of a StreamLogger.
+type StructuredLogConfig struct {
+	// Sinks is a comma separated list of sinks: file, syslog, http.
+	Sinks string
+	// SampleRate and LatencyThreshold configure the Sampler.
+	SampleRate       float64
+	LatencyThreshold time.Duration
+
+	FilePath       string
+	FileMaxSize    int64
+	FileMaxBackups int
+
+	SyslogTag string
+
+	HTTPURL           string
+	HTTPBatchSize     int
+	HTTPFlushInterval time.Duration
+}
+
+// RegisterStructuredLogFlags registers the structured logging flags of a
+// binary, prefixed with prefix so that several binaries linked together
+// can be configured independently.
+func RegisterStructuredLogFlags(prefix string) *StructuredLogConfig {
+	config := &StructuredLogConfig{}
+	flag.StringVar(&config.Sinks, prefix+"-structured-querylog-sinks", "", "comma separated list of sinks for structured JSON query logs: file, syslog, http. Empty disables structured query logging.")
+	flag.Float64Var(&config.SampleRate, prefix+"-structured-querylog-sample-rate", 1, "fraction of queries, between 0 and 1, written to the structured query log")
+	flag.DurationVar(&config.LatencyThreshold, prefix+"-structured-querylog-latency-threshold", 0, "queries taking at least this long are always written to the structured query log, regardless of the sample rate. 0 disables latency based sampling.")
+	flag.StringVar(&config.FilePath, prefix+"-structured-querylog-file", "", "path of the file sink of the structured query log")
+	flag.Int64Var(&config.FileMaxSize, prefix+"-structured-querylog-file-max-size", 100*1024*1024, "size in bytes after which the structured query log file is rotated. 0 disables rotation.")
+	flag.IntVar(&config.FileMaxBackups, prefix+"-structured-querylog-file-max-backups", 5, "number of rotated structured query log files to keep")
+	flag.StringVar(&config.SyslogTag, prefix+"-structured-querylog-syslog-tag", "vtquerylogger", "syslog tag of the syslog sink of the structured query log")
+	flag.StringVar(&config.HTTPURL, prefix+"-structured-querylog-http-url", "", "URL of the collector receiving the structured query log, in OTLP/HTTP JSON format (e.g. http://localhost:4318/v1/logs)")
+	flag.IntVar(&config.HTTPBatchSize, prefix+"-structured-querylog-http-batch-size", 100, "maximum number of records sent to the collector in one request")
+	flag.DurationVar(&config.HTTPFlushInterval, prefix+"-structured-querylog-http-flush-interval", time.Second, "maximum time records are buffered before being sent to the collector")
+	return config
+}
+
+// Enabled returns true if at least one sink is configured.
+func (config *StructuredLogConfig) Enabled() bool {
+	return strings.TrimSpace(config.Sinks) != ""
+}
+
+// newSinks creates the configured sinks, keyed by name.
+func (config *StructuredLogConfig) newSinks(component string) (map[string]Sink, error) {
+	sinks := make(map[string]Sink)
+	for _, name := range strings.Split(config.Sinks, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := sinks[name]; ok {
+			continue
+		}
+		var sink Sink
+		var err error
+		switch name {
+		case "file":
+			if config.FilePath == "" {
+				err = fmt.Errorf("file sink requires a file path")
+				break
+			}
+			sink, err = NewRotatingFileSink(config.FilePath, config.FileMaxSize, config.FileMaxBackups)
+		case "syslog":
+			sink, err = NewSyslogSink(config.SyslogTag)
+		case "http":
+			if config.HTTPURL == "" {
+				err = fmt.Errorf("http sink requires a URL")
+				break
+			}
+			sink = NewHTTPSink(config.HTTPURL, component, config.HTTPBatchSize, config.HTTPFlushInterval)
+		default:
+			err = fmt.Errorf("unknown structured query log sink %q", name)
+		}
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, err
+		}
+		sinks[name] = sink
+	}
+	return sinks, nil
+}
+
+// StructuredLog writes the structured records of the messages of a
+// StreamLogger to sinks. Messages that don't implement Recorder are
+// ignored.
+type StructuredLog struct {
+	logger  *StreamLogger
+	ch      chan interface{}
+	sampler Sampler
+	sinks   map[string]Sink
+	done    chan struct{}
+}
+
+// LogStructured starts writing the structured records of the logger
+// messages to the sinks of config. component identifies the binary in
+// the records.
+func (logger *StreamLogger) LogStructured(component string, config *StructuredLogConfig) (*StructuredLog, error) {
+	sinks, err := config.newSinks(component)
+	if err != nil {
+		return nil, err
+	}
+	return logger.logStructured(Sampler{Rate: config.SampleRate, LatencyThreshold: config.LatencyThreshold}, sinks), nil
+}
+
+func (logger *StreamLogger) logStructured(sampler Sampler, sinks map[string]Sink) *StructuredLog {
+	sl := &StructuredLog{
+		logger:  logger,
+		ch:      logger.Subscribe("StructuredLog"),
+		sampler: sampler,
+		sinks:   sinks,
+		done:    make(chan struct{}),
+	}
+	go sl.run()
+	return sl
+}
+
+func (sl *StructuredLog) run() {
+	defer close(sl.done)
+	for message := range sl.ch {
+		recorder, ok := message.(Recorder)
+		if !ok {
+			continue
+		}
+		if !sl.sampler.Sample(recorder.TotalTime()) {
+			structuredSampledOutCount.Add(sl.logger.name, 1)
+			continue
+		}
+		record := recorder.Record()
+		if record == nil {
+			continue
+		}
+		b, err := json.Marshal(record)
+		if err != nil {
+			log.Errorf("Error marshaling structured log record of %s: %v", sl.logger.name, err)
+			continue
+		}
+		for name, sink := range sl.sinks {
+			if err := sink.Write(b); err != nil {
+				structuredSinkErrorCount.Add([]string{sl.logger.name, name}, 1)
+				continue
+			}
+			structuredRecordCount.Add([]string{sl.logger.name, name}, 1)
+		}
+	}
+}
+
+// Stop stops logging, flushes and closes the sinks.
+func (sl *StructuredLog) Stop() {
+	sl.logger.Unsubscribe(sl.ch)
+	close(sl.ch)
+	<-sl.done
+	for name, sink := range sl.sinks {
+		if err := sink.Close(); err != nil {
+			log.Errorf("Error closing structured log sink %s of %s: %v", name, sl.logger.name, err)
+		}
+	}
+}
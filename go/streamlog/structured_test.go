@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	RecordHeader
+	SQL string `json:"sql"`
+}
+
+type testRecorder struct {
+	sql     string
+	latency time.Duration
+}
+
+func (r *testRecorder) Record() interface{} {
+	if r.sql == "" {
+		return nil
+	}
+	return &testRecord{RecordHeader: NewRecordHeader("test"), SQL: r.sql}
+}
+
+func (r *testRecorder) TotalTime() time.Duration {
+	return r.latency
+}
+
+type memorySink struct {
+	mu      sync.Mutex
+	records []string
+	closed  bool
+}
+
+func (s *memorySink) Write(b []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, string(b))
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func TestSampler(t *testing.T) {
+	next := 0.0
+	s := &Sampler{Rate: 0.5, random: func() float64 { return next }}
+
+	next = 0.4
+	assert.True(t, s.Sample(time.Millisecond))
+	next = 0.6
+	assert.False(t, s.Sample(time.Millisecond))
+
+	s.LatencyThreshold = time.Second
+	assert.False(t, s.Sample(time.Millisecond))
+	assert.True(t, s.Sample(time.Second))
+
+	assert.True(t, (&Sampler{Rate: 1}).Sample(0))
+	assert.False(t, (&Sampler{Rate: 0}).Sample(0))
+}
+
+func TestLogStructured(t *testing.T) {
+	logger := New("logger", 10)
+	sink := &memorySink{}
+	sl := logger.logStructured(Sampler{Rate: 0, LatencyThreshold: time.Second}, map[string]Sink{"memory": sink})
+
+	logger.Send(&testRecorder{sql: "fast", latency: time.Millisecond})
+	logger.Send(&testRecorder{sql: "slow", latency: 2 * time.Second})
+	logger.Send(&testRecorder{sql: "", latency: 2 * time.Second})
+	logger.Send("not a recorder")
+	sl.Stop()
+
+	assert.True(t, sink.closed)
+	assert.Equal(t, []string{`{"schema_version":1,"component":"test","sql":"slow"}`}, sink.records)
+}
+
+func TestStructuredLogConfigSinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "structured")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := &StructuredLogConfig{Sinks: "file, http"}
+	_, err = config.newSinks("test")
+	assert.EqualError(t, err, "file sink requires a file path")
+
+	config.FilePath = path.Join(dir, "querylog")
+	_, err = config.newSinks("test")
+	assert.EqualError(t, err, "http sink requires a URL")
+
+	config.Sinks = "file,unknown"
+	_, err = config.newSinks("test")
+	assert.EqualError(t, err, `unknown structured query log sink "unknown"`)
+
+	config.Sinks = "file"
+	sinks, err := config.newSinks("test")
+	require.NoError(t, err)
+	assert.Len(t, sinks, 1)
+	for _, sink := range sinks {
+		sink.Close()
+	}
+}
+
+func TestRotatingFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "structured")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	logPath := path.Join(dir, "querylog")
+	// Each record is 10 bytes with its newline, so two fit in a file.
+	sink, err := NewRotatingFileSink(logPath, 20, 2)
+	require.NoError(t, err)
+	for _, record := range []string{"record001", "record002", "record003", "record004", "record005", "record006", "record007"} {
+		require.NoError(t, sink.Write([]byte(record)))
+	}
+	require.NoError(t, sink.Close())
+
+	read := func(p string) string {
+		b, err := ioutil.ReadFile(p)
+		require.NoError(t, err)
+		return string(b)
+	}
+	assert.Equal(t, "record007\n", read(logPath))
+	assert.Equal(t, "record005\nrecord006\n", read(logPath+".1"))
+	assert.Equal(t, "record003\nrecord004\n", read(logPath+".2"))
+	_, err = os.Stat(logPath + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestHTTPSink(t *testing.T) {
+	var mu sync.Mutex
+	var requests []otlpLogsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req otlpLogsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, "vttablet", 2, time.Hour)
+	for _, record := range []string{`{"a":1}`, `{"a":2}`, `{"a":3}`} {
+		require.NoError(t, sink.Write([]byte(record)))
+	}
+	// Close flushes the last, partial batch.
+	require.NoError(t, sink.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, requests, 2)
+	var bodies []string
+	for _, req := range requests {
+		require.Len(t, req.ResourceLogs, 1)
+		assert.Equal(t, []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: "vttablet"}}}, req.ResourceLogs[0].Resource.Attributes)
+		for _, record := range req.ResourceLogs[0].ScopeLogs[0].LogRecords {
+			assert.NotEmpty(t, record.TimeUnixNano)
+			bodies = append(bodies, record.Body.StringValue)
+		}
+	}
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`, `{"a":3}`}, bodies)
+}
@@ -210,6 +210,18 @@ func (vtgate *VtgateProcess) TearDown() error {
 	}
 }
 
+// Kill kills the running vtgate service without letting it shut down,
+// as if it crashed.
+func (vtgate *VtgateProcess) Kill() error {
+	if vtgate.proc == nil || vtgate.exit == nil {
+		return nil
+	}
+	err := vtgate.proc.Process.Kill()
+	<-vtgate.exit
+	vtgate.proc = nil
+	return err
+}
+
 // VtgateProcessInstance returns a Vtgate handle for vtgate process
 // configured with the given Config.
 // The process must be manually started by calling setup()
@@ -76,6 +76,10 @@ var (
 		ALTER TABLE %s
 			DROP PRIMARY KEY,
 			DROP COLUMN vrepl_col`
+	// MySQL applies this statement without copying the table
+	alterTableInPlaceStatement = `
+		ALTER TABLE %s
+			ALTER COLUMN test_val SET DEFAULT 7`
 	// We will run this query while throttling vreplication
 	alterTableThrottlingStatement = `
 		ALTER TABLE %s
@@ -261,6 +265,62 @@ func TestSchemaChange(t *testing.T) {
 		onlineddl.CheckCancelMigration(t, &vtParams, shards, uuid, false)
 		onlineddl.CheckRetryMigration(t, &vtParams, shards, uuid, false)
 	})
+	t.Run("postponed completion", func(t *testing.T) {
+		insertRows(t, 2)
+		uuid := testOnlineDDLStatement(t, alterTableTrivialStatement, "online -postpone-completion", "vtgate", "")
+		// the migration is ready to cut over, but stays running until completed
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, uuid, schema.OnlineDDLStatusRunning)
+		testRows(t)
+		onlineddl.CheckCompleteMigration(t, &vtParams, shards, uuid, true)
+		time.Sleep(5 * time.Second)
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, uuid, schema.OnlineDDLStatusComplete)
+		checkMigratedTable(t, fmt.Sprintf("vt_onlineddl_test_%02d", 3), "vrepl_col")
+		onlineddl.CheckCompleteMigration(t, &vtParams, shards, uuid, false)
+	})
+	t.Run("run after migration", func(t *testing.T) {
+		insertRows(t, 2)
+		uuid := testOnlineDDLStatement(t, alterTableTrivialStatement, "online -postpone-completion", "vtgate", "")
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, uuid, schema.OnlineDDLStatusRunning)
+		// the dependent migration stays queued while the first migration is incomplete
+		dependentUUID := testOnlineDDLStatement(t, alterTableTrivialStatement, "online -run-after="+uuid, "vtgate", "")
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, dependentUUID, schema.OnlineDDLStatusQueued)
+		onlineddl.CheckPriorityMigration(t, &vtParams, shards, dependentUUID, 10, true)
+
+		onlineddl.CheckCompleteMigration(t, &vtParams, shards, uuid, true)
+		time.Sleep(20 * time.Second)
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, uuid, schema.OnlineDDLStatusComplete)
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, dependentUUID, schema.OnlineDDLStatusComplete)
+		testRows(t)
+		// priority only applies to queued migrations
+		onlineddl.CheckPriorityMigration(t, &vtParams, shards, dependentUUID, 5, false)
+	})
+	t.Run("prefer instant ddl", func(t *testing.T) {
+		insertRows(t, 2)
+		uuid := testOnlineDDLStatement(t, alterTableInPlaceStatement, "online -prefer-instant-ddl", "vtgate", "DEFAULT '7'")
+		onlineddl.CheckMigrationStatus(t, &vtParams, shards, uuid, schema.OnlineDDLStatusComplete)
+		testRows(t)
+		rs := onlineddl.VtgateExecQuery(t, &vtParams, fmt.Sprintf("show vitess_migrations like '%s'", uuid), "")
+		for _, row := range rs.Named().Rows {
+			assert.NotEmpty(t, row.AsString("ddl_algorithm", ""))
+		}
+		// no shadow table was created
+		onlineddl.CheckMigrationArtifacts(t, &vtParams, shards, uuid, false)
+	})
+	t.Run("dry run", func(t *testing.T) {
+		tableName := fmt.Sprintf("vt_onlineddl_test_%02d", 3)
+		rs := onlineddl.VtgateExecDDL(t, &vtParams, "online -dry-run", fmt.Sprintf(alterTableTrivialStatement, tableName), "")
+		require.Equal(t, len(shards), len(rs.Rows))
+		for _, row := range rs.Named().Rows {
+			assert.Equal(t, "ok", row.AsString("status", ""), row.AsString("message", ""))
+			assert.Equal(t, tableName, row.AsString("mysql_table", ""))
+			assert.Contains(t, row.AsString("shared_unique_keys", ""), "PRIMARY")
+		}
+		rs = onlineddl.VtgateExecDDL(t, &vtParams, "online -dry-run", fmt.Sprintf(alterTableFailedStatement, tableName), "")
+		require.Equal(t, len(shards), len(rs.Rows))
+		for _, row := range rs.Named().Rows {
+			assert.Equal(t, "error", row.AsString("status", ""))
+		}
+	})
 	t.Run("throttled migration", func(t *testing.T) {
 		insertRows(t, 2)
 		for i := range clusterInstance.Keyspaces[0].Shards {
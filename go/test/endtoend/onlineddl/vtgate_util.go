@@ -98,6 +98,30 @@ func CheckCancelMigration(t *testing.T, vtParams *mysql.ConnParams, shards []clu
 	}
 }
 
+// CheckCompleteMigration attempts to complete a migration, and expects success/failure by counting affected rows
+func CheckCompleteMigration(t *testing.T, vtParams *mysql.ConnParams, shards []cluster.Shard, uuid string, expectCompletePossible bool) {
+	completeQuery := fmt.Sprintf("alter vitess_migration '%s' complete", uuid)
+	r := VtgateExecQuery(t, vtParams, completeQuery, "")
+
+	if expectCompletePossible {
+		assert.Equal(t, len(shards), int(r.RowsAffected))
+	} else {
+		assert.Equal(t, int(0), int(r.RowsAffected))
+	}
+}
+
+// CheckPriorityMigration attempts to set the priority of a migration, and expects success/failure by counting affected rows
+func CheckPriorityMigration(t *testing.T, vtParams *mysql.ConnParams, shards []cluster.Shard, uuid string, priority int64, expectPriorityPossible bool) {
+	priorityQuery := fmt.Sprintf("alter vitess_migration '%s' priority %d", uuid, priority)
+	r := VtgateExecQuery(t, vtParams, priorityQuery, "")
+
+	if expectPriorityPossible {
+		assert.Equal(t, len(shards), int(r.RowsAffected))
+	} else {
+		assert.Equal(t, int(0), int(r.RowsAffected))
+	}
+}
+
 // CheckCancelAllMigrations cancels all pending migrations and expect number of affected rows
 func CheckCancelAllMigrations(t *testing.T, vtParams *mysql.ConnParams, expectCount int) {
 	cancelQuery := "alter vitess_migration cancel all"
@@ -300,5 +300,29 @@ create table rating(gid int, pid int, rating int, primary key(gid));
 	"rating": {}
   }
 }
+`
+	vstreamEventSchema = `
+create table event(id int, description varbinary(128), primary key(id));
+`
+
+	vstreamEventVSchema = `
+{
+  "sharded": true,
+  "vindexes": {
+	"reverse_bits": {
+	  "type": "reverse_bits"
+	}
+  },
+  "tables": {
+	"event": {
+	  "column_vindexes": [
+		{
+		  "column": "id",
+		  "name": "reverse_bits"
+		}
+	  ]
+	}
+  }
+}
 `
 )
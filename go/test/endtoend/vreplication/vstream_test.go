@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+// TestVStreamResharding verifies that a VStream follows the reshards of a
+// keyspace without the client being involved: a running stream moves to
+// the new shards, and a stream resumed from a VGTID of retired shards reads
+// them up to their resharding journals, and then moves to the new shards.
+func TestVStreamResharding(t *testing.T) {
+	defaultCellName := "zone1"
+	allCells := []string{"zone1"}
+	allCellNames = "zone1"
+	vc = NewVitessCluster(t, "TestVStreamResharding", allCells, mainClusterConfig)
+
+	require.NotNil(t, vc)
+	defaultReplicas = 0 // because of CI resource constraints we can only run this test with master tablets
+	defer func() { defaultReplicas = 1 }()
+
+	defer vc.TearDown(t)
+
+	defaultCell = vc.Cells[defaultCellName]
+	vc.AddKeyspace(t, []*Cell{defaultCell}, "event", "-80,80-", vstreamEventVSchema, vstreamEventSchema, defaultReplicas, defaultRdonly, 100)
+	vtgate = defaultCell.Vtgates[0]
+	require.NotNil(t, vtgate)
+	for _, shard := range []string{"-80", "80-"} {
+		require.NoError(t, vtgate.WaitForStatusOfTabletInShard(fmt.Sprintf("%s.%s.master", "event", shard), 1))
+	}
+
+	vtgateConn = getConnection(t, vc.ClusterConfig.hostname, vc.ClusterConfig.vtgateMySQLPort)
+	defer vtgateConn.Close()
+	verifyClusterHealth(t, vc)
+
+	ctx := context.Background()
+	conn, err := vtgateconn.Dial(ctx, fmt.Sprintf("%s:%d", vc.ClusterConfig.hostname, vc.ClusterConfig.vtgateGrpcPort))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Get a position of the stream before the reshards. The rows are in
+	// both shards, so that the position is known for each of them.
+	insertEvents(t, 1, 2)
+	vgtid, err := streamEvents(ctx, conn, &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "event",
+			Gtid:     "current",
+		}},
+	}, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-80", "80-"}, vgtidShards(vgtid))
+
+	// This stream keeps running across the reshards.
+	type streamResult struct {
+		vgtid *binlogdatapb.VGtid
+		err   error
+	}
+	running := make(chan streamResult, 1)
+	go func() {
+		got, err := streamEvents(ctx, conn, vgtid, 3, 30)
+		running <- streamResult{vgtid: got, err: err}
+	}()
+
+	insertEvents(t, 3, 10)
+	switchReshard(t, "event", "e2e4", "-80,80-", "-40,40-80,80-c0,c0-", 300)
+	insertEvents(t, 11, 20)
+
+	// The first position refers to shards that have been retired, but not
+	// deleted yet. The stream resumed from it gets their events up to the
+	// resharding journals, and then those of the new shards.
+	resumed, err := streamEvents(ctx, conn, vgtid, 3, 20)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-40", "40-80", "80-c0", "c0-"}, vgtidShards(resumed))
+
+	// Once the retired shards are deleted, their events up to the journals
+	// are gone, and the stream can't be resumed from the first position.
+	dropSources(t, "event.e2e4")
+	_, err = streamEvents(ctx, conn, vgtid, 3, 20)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "up to its resharding journal")
+
+	reshard(t, "event", "event", "e1e2", "-40", "-20,20-40", 700, nil, nil, nil, "")
+	insertEvents(t, 21, 30)
+
+	result := <-running
+	require.NoError(t, result.err)
+	require.Equal(t, []string{"-20", "20-40", "40-80", "80-c0", "c0-"}, vgtidShards(result.vgtid))
+}
+
+// switchReshard reshards a keyspace and switches its traffic to the target
+// shards, but keeps the source shards.
+func switchReshard(t *testing.T, ksName, workflow, sourceShards, targetShards string, tabletIDBase int) {
+	ksWorkflow := ksName + "." + workflow
+	keyspace := vc.Cells[defaultCell.Name].Keyspaces[ksName]
+	require.NoError(t, vc.AddShards(t, []*Cell{defaultCell}, keyspace, targetShards, defaultReplicas, defaultRdonly, tabletIDBase))
+	for _, shard := range strings.Split(targetShards, ",") {
+		require.NoError(t, vtgate.WaitForStatusOfTabletInShard(fmt.Sprintf("%s.%s.master", ksName, shard), 1))
+	}
+	require.NoError(t, vc.VtctlClient.ExecuteCommand("Reshard", "-cells="+defaultCell.Name, "-tablet_types=replica,master", ksWorkflow, sourceShards, targetShards))
+	targets := "," + targetShards + ","
+	for _, tab := range vc.getVttabletsInKeyspace(t, defaultCell, ksName, "master") {
+		if strings.Contains(targets, ","+tab.Shard+",") {
+			catchup(t, tab, workflow, "Reshard")
+		}
+	}
+	switchReads(t, allCellNames, ksWorkflow)
+	switchWrites(t, ksWorkflow, false)
+}
+
+func insertEvents(t *testing.T, from, to int) {
+	sql := "insert into event(id, description) values "
+	for id := from; id <= to; id++ {
+		sql += fmt.Sprintf("(%d, 'event%d')", id, id)
+		if id != to {
+			sql += ","
+		}
+	}
+	execVtgateQuery(t, vtgateConn, "event", sql)
+}
+
+// streamEvents streams the event table from vgtid until the rows with
+// the ids from..to are received, and returns the position of the stream.
+func streamEvents(ctx context.Context, conn *vtgateconn.VTGateConn, vgtid *binlogdatapb.VGtid, from, to int64) (*binlogdatapb.VGtid, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "event",
+		}},
+	}
+	reader, err := conn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*querypb.Field
+	missing := make(map[int64]bool)
+	for id := from; id <= to; id++ {
+		missing[id] = true
+	}
+	for {
+		events, err := reader.Recv()
+		if err != nil {
+			return nil, fmt.Errorf("stream ended with %d missing events: %v", len(missing), err)
+		}
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_VGTID:
+				vgtid = event.Vgtid
+			case binlogdatapb.VEventType_FIELD:
+				fields = event.FieldEvent.Fields
+			case binlogdatapb.VEventType_ROW:
+				for _, rowChange := range event.RowEvent.RowChanges {
+					if rowChange.After == nil {
+						continue
+					}
+					id, err := sqltypes.MakeRowTrusted(fields, rowChange.After)[0].ToInt64()
+					if err != nil {
+						return nil, err
+					}
+					delete(missing, id)
+				}
+			}
+		}
+		if len(missing) == 0 {
+			return vgtid, nil
+		}
+	}
+}
+
+func vgtidShards(vgtid *binlogdatapb.VGtid) []string {
+	var shards []string
+	for _, sgtid := range vgtid.ShardGtids {
+		shards = append(shards, sgtid.Shard)
+	}
+	sort.Strings(shards)
+	return shards
+}
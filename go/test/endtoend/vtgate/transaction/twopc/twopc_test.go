@@ -0,0 +1,355 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twopc
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/test/endtoend/cluster"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vttablet/grpctabletconn"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// Most tests in this package play the role of the vtgate coordinating a 2PC
+// commit, and die at various points of the protocol. The last one kills a
+// real vtgate in the middle of a commit. The distributed transactions left
+// behind are then found and resolved with the vtctl DistributedTransactions
+// commands. The tablet watchdog is configured with a long abandon age so
+// that it does not interfere.
+
+var (
+	clusterInstance *cluster.LocalProcessCluster
+	keyspaceName    = "ks"
+	cell            = "zone1"
+	hostname        = "localhost"
+	sqlSchema       = `
+	create table twopc_t1 (
+		id bigint,
+		msg varchar(64),
+		primary key (id)
+	) Engine=InnoDB;`
+
+	vSchema = `
+	{
+		"sharded":true,
+		"vindexes": {
+			"hash_index": {
+				"type": "hash"
+			}
+		},
+		"tables": {
+			"twopc_t1":{
+				"column_vindexes": [
+					{
+						"column": "id",
+						"name": "hash_index"
+					}
+				]
+			}
+		}
+	}
+	`
+)
+
+func TestMain(m *testing.M) {
+	defer cluster.PanicHandler(nil)
+	flag.Parse()
+
+	exitcode, err := func() (int, error) {
+		clusterInstance = cluster.NewCluster(cell, hostname)
+		defer clusterInstance.Teardown()
+
+		clusterInstance.VtgateGrpcPort = clusterInstance.GetAndReservePort()
+		clusterInstance.VtTabletExtraArgs = []string{
+			"-twopc_enable",
+			"-twopc_coordinator_address", fmt.Sprintf("localhost:%d", clusterInstance.VtgateGrpcPort),
+			"-twopc_abandon_age", "3600",
+		}
+
+		if err := clusterInstance.StartTopo(); err != nil {
+			return 1, err
+		}
+		keyspace := &cluster.Keyspace{
+			Name:      keyspaceName,
+			SchemaSQL: sqlSchema,
+			VSchema:   vSchema,
+		}
+		if err := clusterInstance.StartKeyspace(*keyspace, []string{"-40", "40-80", "80-"}, 0, false); err != nil {
+			return 1, err
+		}
+		clusterInstance.VtGateExtraArgs = []string{"-transaction_mode", "TWOPC"}
+		if err := clusterInstance.StartVtgate(); err != nil {
+			return 1, err
+		}
+		return m.Run(), nil
+	}()
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		os.Exit(1)
+	} else {
+		os.Exit(exitcode)
+	}
+}
+
+// shardConn is a direct query service connection to the master of a shard.
+type shardConn struct {
+	conn   queryservice.QueryService
+	target *querypb.Target
+}
+
+func dialShard(t *testing.T, shardIdx int) *shardConn {
+	t.Helper()
+	shard := clusterInstance.Keyspaces[0].Shards[shardIdx]
+	vttablet := shard.MasterTablet()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: vttablet.Cell, Uid: uint32(vttablet.TabletUID)},
+		Hostname: hostname,
+		PortMap:  map[string]int32{"grpc": int32(vttablet.GrpcPort)},
+		Keyspace: keyspaceName,
+		Shard:    shard.Name,
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	conn, err := grpctabletconn.DialTablet(tablet, grpcclient.FailFast(false))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close(context.Background()) })
+	return &shardConn{
+		conn: conn,
+		target: &querypb.Target{
+			Keyspace:   keyspaceName,
+			Shard:      shard.Name,
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+	}
+}
+
+func (sc *shardConn) beginInsert(t *testing.T, id int) int64 {
+	t.Helper()
+	query := fmt.Sprintf("insert into twopc_t1(id, msg) values(%d, 'twopc')", id)
+	_, txid, _, err := sc.conn.BeginExecute(context.Background(), sc.target, nil, query, nil, 0, nil)
+	require.NoError(t, err)
+	return txid
+}
+
+func (sc *shardConn) rowExists(t *testing.T, id int) bool {
+	t.Helper()
+	qr, err := sc.conn.Execute(context.Background(), sc.target, fmt.Sprintf("select id from twopc_t1 where id = %d", id), nil, 0, 0, nil)
+	require.NoError(t, err)
+	return len(qr.Rows) == 1
+}
+
+// startDistributedTransaction runs the 2PC protocol the way vtgate does, up
+// to and including the prepare of the participant, and returns the dtid.
+// If startCommit is set, the commit decision is also recorded. The caller
+// then abandons the transaction, as a coordinator that died would.
+func startDistributedTransaction(t *testing.T, mm, participant *shardConn, id int, startCommit bool) string {
+	t.Helper()
+	ctx := context.Background()
+	mmTxID := mm.beginInsert(t, id)
+	participantTxID := participant.beginInsert(t, id+1)
+
+	dtid := dtids.New(&vtgatepb.Session_ShardSession{Target: mm.target, TransactionId: mmTxID})
+	require.NoError(t, mm.conn.CreateTransaction(ctx, mm.target, dtid, []*querypb.Target{participant.target}))
+	require.NoError(t, participant.conn.Prepare(ctx, participant.target, participantTxID, dtid))
+	if startCommit {
+		require.NoError(t, mm.conn.StartCommit(ctx, mm.target, mmTxID, dtid))
+	}
+	return dtid
+}
+
+func listDistributedTransactions(t *testing.T) []*wrangler.ShardTransactions {
+	t.Helper()
+	out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput("ListDistributedTransactions", keyspaceName)
+	require.NoError(t, err, out)
+	var shards []*wrangler.ShardTransactions
+	require.NoError(t, json.Unmarshal([]byte(out), &shards), out)
+	return shards
+}
+
+func readDistributedTransaction(t *testing.T, dtid string) *wrangler.DistributedTransaction {
+	t.Helper()
+	out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput("ReadDistributedTransaction", dtid)
+	require.NoError(t, err, out)
+	dt := &wrangler.DistributedTransaction{}
+	if err := json.Unmarshal([]byte(out), dt); err != nil {
+		// Resolved transactions are reported as text.
+		return nil
+	}
+	return dt
+}
+
+func TestConcludeAfterCoordinatorFailure(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	mm, participant := dialShard(t, 0), dialShard(t, 1)
+
+	dtid := startDistributedTransaction(t, mm, participant, 100, true)
+
+	// The transaction shows up as unresolved on both sides.
+	shards := listDistributedTransactions(t)
+	require.Len(t, shards, 3)
+	require.Len(t, shards[0].Transactions, 1)
+	assert.Equal(t, dtid, shards[0].Transactions[0].Dtid)
+	assert.Equal(t, querypb.TransactionState_COMMIT, shards[0].Transactions[0].State)
+	require.Len(t, shards[1].Prepared, 1)
+	assert.Equal(t, dtid, shards[1].Prepared[0].Dtid)
+
+	dt := readDistributedTransaction(t, dtid)
+	require.NotNil(t, dt)
+	assert.Equal(t, "COMMIT", dt.State)
+	require.Len(t, dt.Participants, 1)
+	assert.Equal(t, "Prepared", dt.Participants[0].State)
+
+	// The commit decision was made: it cannot be rolled back anymore.
+	out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput("RollbackDistributedTransaction", dtid)
+	require.Error(t, err, out)
+
+	require.NoError(t, clusterInstance.VtctlclientProcess.ExecuteCommand("ConcludeDistributedTransaction", dtid))
+	assert.True(t, mm.rowExists(t, 100))
+	assert.True(t, participant.rowExists(t, 101))
+	assert.Nil(t, readDistributedTransaction(t, dtid))
+}
+
+func TestRollbackAfterCoordinatorFailure(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	mm, participant := dialShard(t, 0), dialShard(t, 1)
+
+	dtid := startDistributedTransaction(t, mm, participant, 200, false)
+
+	dt := readDistributedTransaction(t, dtid)
+	require.NotNil(t, dt)
+	assert.Equal(t, "PREPARE", dt.State)
+	require.Len(t, dt.Participants, 1)
+	assert.Equal(t, "Prepared", dt.Participants[0].State)
+
+	require.NoError(t, clusterInstance.VtctlclientProcess.ExecuteCommand("RollbackDistributedTransaction", dtid))
+	assert.False(t, mm.rowExists(t, 200))
+	assert.False(t, participant.rowExists(t, 201))
+	assert.Nil(t, readDistributedTransaction(t, dtid))
+
+	// Nothing is left behind.
+	for _, shard := range listDistributedTransactions(t) {
+		assert.Empty(t, shard.Transactions, shard.Shard)
+		assert.Empty(t, shard.Prepared, shard.Shard)
+	}
+}
+
+func TestConcludeUndecidedRollsBack(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	mm, participant := dialShard(t, 0), dialShard(t, 1)
+
+	dtid := startDistributedTransaction(t, mm, participant, 300, false)
+
+	// Like the vtgate resolution, concluding an undecided transaction rolls it back.
+	require.NoError(t, clusterInstance.VtctlclientProcess.ExecuteCommand("ConcludeDistributedTransaction", dtid))
+	assert.False(t, mm.rowExists(t, 300))
+	assert.False(t, participant.rowExists(t, 301))
+	assert.Nil(t, readDistributedTransaction(t, dtid))
+}
+
+func TestResolveAfterVtgateCrash(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	ctx := context.Background()
+	mm, prepared, blocked := dialShard(t, 0), dialShard(t, 1), dialShard(t, 2)
+
+	// Lock all of the redo log of the last shard, so that its prepare
+	// blocks while the other participant prepares.
+	blockedTablet := clusterInstance.Keyspaces[0].Shards[2].MasterTablet()
+	lockConn, err := mysql.Connect(ctx, &mysql.ConnParams{
+		Uname:      "vt_dba",
+		UnixSocket: path.Join(blockedTablet.VttabletProcess.Directory, "mysql.sock"),
+	})
+	require.NoError(t, err)
+	defer lockConn.Close()
+	_, err = lockConn.ExecuteFetch("begin", 0, false)
+	require.NoError(t, err)
+	_, err = lockConn.ExecuteFetch("select dtid from _vt.redo_state for update", 1000, false)
+	require.NoError(t, err)
+
+	vtConn, err := mysql.Connect(ctx, &mysql.ConnParams{Host: clusterInstance.Hostname, Port: clusterInstance.VtgateMySQLPort})
+	require.NoError(t, err)
+	defer vtConn.Close()
+	// The ids 1, 3 and 4 map to the shards -40, 40-80 and 80- respectively.
+	// The first shard of the transaction is the metadata manager.
+	for _, query := range []string{
+		"begin",
+		"insert into twopc_t1(id, msg) values(1, 'crash')",
+		"insert into twopc_t1(id, msg) values(3, 'crash')",
+		"insert into twopc_t1(id, msg) values(4, 'crash')",
+	} {
+		_, err := vtConn.ExecuteFetch(query, 0, false)
+		require.NoError(t, err, query)
+	}
+	commitErr := make(chan error, 1)
+	go func() {
+		_, err := vtConn.ExecuteFetch("commit", 0, false)
+		commitErr <- err
+	}()
+
+	// Wait for the commit to be stuck after the prepare of the other
+	// participant, and before the commit decision.
+	require.Eventually(t, func() bool {
+		shards := listDistributedTransactions(t)
+		return len(shards[0].Transactions) == 1 && len(shards[1].Prepared) == 1
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Eventually(t, func() bool {
+		qr, err := lockConn.ExecuteFetch("select count(*) from information_schema.innodb_trx where trx_state = 'LOCK WAIT'", 1, false)
+		require.NoError(t, err)
+		return qr.Rows[0][0].ToString() == "1"
+	}, 30*time.Second, 100*time.Millisecond)
+
+	require.NoError(t, clusterInstance.VtgateProcess.Kill())
+	defer func() {
+		require.NoError(t, clusterInstance.StartVtgate())
+	}()
+	assert.Error(t, <-commitErr)
+	_, err = lockConn.ExecuteFetch("rollback", 0, false)
+	require.NoError(t, err)
+
+	shards := listDistributedTransactions(t)
+	require.Len(t, shards[0].Transactions, 1)
+	dtid := shards[0].Transactions[0].Dtid
+	assert.Equal(t, querypb.TransactionState_PREPARE, shards[0].Transactions[0].State)
+
+	// The commit decision was not made, so the transaction is rolled back
+	// on all the shards, including the one which prepared.
+	require.NoError(t, clusterInstance.VtctlclientProcess.ExecuteCommand("ConcludeDistributedTransaction", dtid))
+	assert.False(t, mm.rowExists(t, 1))
+	assert.False(t, prepared.rowExists(t, 3))
+	assert.False(t, blocked.rowExists(t, 4))
+	assert.Nil(t, readDistributedTransaction(t, dtid))
+	for _, shard := range listDistributedTransactions(t) {
+		assert.Empty(t, shard.Transactions, shard.Shard)
+		assert.Empty(t, shard.Prepared, shard.Shard)
+	}
+}
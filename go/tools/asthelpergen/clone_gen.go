@@ -86,11 +86,19 @@ func (c *cloneGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSP
 	name := printableTypeName(t)
 	funcName := cloneName + name
 
+	// basic elements are copied into a slice of the same length, others are appended
+	makeArgs := []jen.Code{jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))}
+	if isBasic(slice.Elem()) {
+		makeArgs = []jen.Code{jen.Id(typeString), jen.Id("len").Call(jen.Id("n"))}
+	}
+
 	c.addFunc(funcName,
 		//func (n Bytes) Clone() Bytes {
 		jen.Func().Id(funcName).Call(jen.Id("n").Id(typeString)).Id(typeString).Block(
+			//	if n == nil { return nil }
+			ifNilReturnNil("n"),
 			//	res := make(Bytes, len(n))
-			jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))),
+			jen.Id("res").Op(":=").Id("make").Call(makeArgs...),
 			c.copySliceElement(slice.Elem(), spi),
 			//	return res
 			jen.Return(jen.Id("res")),
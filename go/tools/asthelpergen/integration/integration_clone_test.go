@@ -64,3 +64,15 @@ func TestTypeException(t *testing.T) {
 
 	assert.Equal(t, expected, clone)
 }
+
+func TestCloneSlices(t *testing.T) {
+	bytes := Bytes("abc")
+	clone := CloneBytes(bytes)
+	assert.Equal(t, bytes, clone)
+	bytes[0] = 'x'
+	assert.Equal(t, Bytes("abc"), clone)
+
+	assert.Nil(t, CloneBytes(nil))
+	assert.Nil(t, CloneLeafSlice(nil))
+	assert.Equal(t, LeafSlice{}, CloneLeafSlice(LeafSlice{}))
+}
@@ -34,6 +34,9 @@ const (
 	OnDDLAction_STOP        OnDDLAction = 1
 	OnDDLAction_EXEC        OnDDLAction = 2
 	OnDDLAction_EXEC_IGNORE OnDDLAction = 3
+	// EXEC_COORDINATED waits for all the streams of the workflow to reach
+	// the DDL, applies it once, and resumes them together.
+	OnDDLAction_EXEC_COORDINATED OnDDLAction = 4
 )
 
 var OnDDLAction_name = map[int32]string{
@@ -41,13 +44,15 @@ var OnDDLAction_name = map[int32]string{
 	1: "STOP",
 	2: "EXEC",
 	3: "EXEC_IGNORE",
+	4: "EXEC_COORDINATED",
 }
 
 var OnDDLAction_value = map[string]int32{
-	"IGNORE":      0,
-	"STOP":        1,
-	"EXEC":        2,
-	"EXEC_IGNORE": 3,
+	"IGNORE":           0,
+	"STOP":             1,
+	"EXEC":             2,
+	"EXEC_IGNORE":      3,
+	"EXEC_COORDINATED": 4,
 }
 
 func (x OnDDLAction) String() string {
@@ -91,6 +96,10 @@ const (
 	VEventType_VERSION   VEventType = 17
 	VEventType_LASTPK    VEventType = 18
 	VEventType_SAVEPOINT VEventType = 19
+	// CHANGE is a self-describing row change, generated by VTGate's
+	// VStream instead of the FIELD and ROW events if the change_events
+	// flag is set.
+	VEventType_CHANGE VEventType = 20
 )
 
 var VEventType_name = map[int32]string{
@@ -114,6 +123,7 @@ var VEventType_name = map[int32]string{
 	17: "VERSION",
 	18: "LASTPK",
 	19: "SAVEPOINT",
+	20: "CHANGE",
 }
 
 var VEventType_value = map[string]int32{
@@ -137,6 +147,7 @@ var VEventType_value = map[string]int32{
 	"VERSION":   17,
 	"LASTPK":    18,
 	"SAVEPOINT": 19,
+	"CHANGE":    20,
 }
 
 func (x VEventType) String() string {
@@ -692,7 +703,11 @@ type Rule struct {
 	// "exclude" value, which will cause the matched tables
 	// to be excluded.
 	// TODO(sougou): support this on vstreamer side also.
-	Filter               string   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// ExcludeColumns is a list of columns that are pruned from the
+	// matching tables by vstreamer before the events are sent.
+	// This can be used to skip large BLOB or TEXT columns.
+	ExcludeColumns       []string `protobuf:"bytes,3,rep,name=exclude_columns,json=excludeColumns,proto3" json:"exclude_columns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -745,6 +760,13 @@ func (m *Rule) GetFilter() string {
 	return ""
 }
 
+func (m *Rule) GetExcludeColumns() []string {
+	if m != nil {
+		return m.ExcludeColumns
+	}
+	return nil
+}
+
 // Filter represents a list of ordered rules. The first
 // match wins.
 type Filter struct {
@@ -837,10 +859,17 @@ type BinlogSource struct {
 	StopAfterCopy bool `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
 	// ExternalCluster is the name of the mounted cluster which has the source keyspace/db for this workflow
 	// it is of the type <cluster_type.cluster_name>
-	ExternalCluster      string   `protobuf:"bytes,10,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ExternalCluster string `protobuf:"bytes,10,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
+	// OnDdlStrategy is the ddl strategy used to apply the DDLs if on_ddl
+	// is EXEC_COORDINATED. It has the format of @@ddl_strategy. If empty
+	// or direct, the DDLs are applied directly.
+	OnDdlStrategy string `protobuf:"bytes,11,opt,name=on_ddl_strategy,json=onDdlStrategy,proto3" json:"on_ddl_strategy,omitempty"`
+	// ExternalSink is set if the row changes must be written to an
+	// external system instead of the local MySQL.
+	ExternalSink         *ExternalSink `protobuf:"bytes,12,opt,name=external_sink,json=externalSink,proto3" json:"external_sink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *BinlogSource) Reset()         { *m = BinlogSource{} }
@@ -946,6 +975,124 @@ func (m *BinlogSource) GetExternalCluster() string {
 	return ""
 }
 
+func (m *BinlogSource) GetOnDdlStrategy() string {
+	if m != nil {
+		return m.OnDdlStrategy
+	}
+	return ""
+}
+
+func (m *BinlogSource) GetExternalSink() *ExternalSink {
+	if m != nil {
+		return m.ExternalSink
+	}
+	return nil
+}
+
+// ExternalSink specifies an external system that a stream writes its
+// row changes to. The position of the last change that was durably
+// written is saved in _vt.vreplication. The changes are delivered at
+// least once: the changes written after the last saved position are
+// sent again if the stream restarts.
+type ExternalSink struct {
+	// type is the type of the sink: "file" or "kafka".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// address is the directory of the files for a file sink, or a comma
+	// separated list of bootstrap brokers for a kafka sink.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// topic is the topic of a kafka sink. For a file sink, it's the prefix
+	// of the file names.
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	// format is the format of the records of a file sink: "json" (the
+	// default) for newline delimited json, or "avro" for avro object
+	// container files. The records of a kafka sink are json.
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// max_file_size is the size in bytes after which a file sink starts
+	// a new file. If zero, files are not rotated by size.
+	MaxFileSize int64 `protobuf:"varint,5,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// max_file_age_seconds is the age after which a file sink starts a
+	// new file. If zero, files are not rotated by age.
+	MaxFileAgeSeconds    int64    `protobuf:"varint,6,opt,name=max_file_age_seconds,json=maxFileAgeSeconds,proto3" json:"max_file_age_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalSink) Reset()         { *m = ExternalSink{} }
+func (m *ExternalSink) String() string { return proto.CompactTextString(m) }
+func (*ExternalSink) ProtoMessage()    {}
+func (*ExternalSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{9}
+}
+func (m *ExternalSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalSink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalSink.Merge(m, src)
+}
+func (m *ExternalSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalSink proto.InternalMessageInfo
+
+func (m *ExternalSink) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ExternalSink) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExternalSink) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ExternalSink) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExternalSink) GetMaxFileSize() int64 {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return 0
+}
+
+func (m *ExternalSink) GetMaxFileAgeSeconds() int64 {
+	if m != nil {
+		return m.MaxFileAgeSeconds
+	}
+	return 0
+}
+
 // RowChange represents one row change.
 // If Before is set and not After, it's a delete.
 // If After is set and not Before, it's an insert.
@@ -962,7 +1109,7 @@ func (m *RowChange) Reset()         { *m = RowChange{} }
 func (m *RowChange) String() string { return proto.CompactTextString(m) }
 func (*RowChange) ProtoMessage()    {}
 func (*RowChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{9}
+	return fileDescriptor_5fd02bcb2e350dad, []int{10}
 }
 func (m *RowChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1165,7 @@ func (m *RowEvent) Reset()         { *m = RowEvent{} }
 func (m *RowEvent) String() string { return proto.CompactTextString(m) }
 func (*RowEvent) ProtoMessage()    {}
 func (*RowEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{10}
+	return fileDescriptor_5fd02bcb2e350dad, []int{11}
 }
 func (m *RowEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1074,7 +1221,7 @@ func (m *FieldEvent) Reset()         { *m = FieldEvent{} }
 func (m *FieldEvent) String() string { return proto.CompactTextString(m) }
 func (*FieldEvent) ProtoMessage()    {}
 func (*FieldEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{11}
+	return fileDescriptor_5fd02bcb2e350dad, []int{12}
 }
 func (m *FieldEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1283,7 @@ func (m *ShardGtid) Reset()         { *m = ShardGtid{} }
 func (m *ShardGtid) String() string { return proto.CompactTextString(m) }
 func (*ShardGtid) ProtoMessage()    {}
 func (*ShardGtid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{12}
+	return fileDescriptor_5fd02bcb2e350dad, []int{13}
 }
 func (m *ShardGtid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,7 +1352,7 @@ func (m *VGtid) Reset()         { *m = VGtid{} }
 func (m *VGtid) String() string { return proto.CompactTextString(m) }
 func (*VGtid) ProtoMessage()    {}
 func (*VGtid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{13}
+	return fileDescriptor_5fd02bcb2e350dad, []int{14}
 }
 func (m *VGtid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1401,7 @@ func (m *KeyspaceShard) Reset()         { *m = KeyspaceShard{} }
 func (m *KeyspaceShard) String() string { return proto.CompactTextString(m) }
 func (*KeyspaceShard) ProtoMessage()    {}
 func (*KeyspaceShard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{14}
+	return fileDescriptor_5fd02bcb2e350dad, []int{15}
 }
 func (m *KeyspaceShard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1479,7 @@ func (m *Journal) Reset()         { *m = Journal{} }
 func (m *Journal) String() string { return proto.CompactTextString(m) }
 func (*Journal) ProtoMessage()    {}
 func (*Journal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{15}
+	return fileDescriptor_5fd02bcb2e350dad, []int{16}
 }
 func (m *Journal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1442,17 +1589,27 @@ type VEvent struct {
 	// This can be used to compenssate for clock skew.
 	CurrentTime int64 `protobuf:"varint,20,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
 	// LastPK is the last PK for a table
-	LastPKEvent          *LastPKEvent `protobuf:"bytes,21,opt,name=last_p_k_event,json=lastPKEvent,proto3" json:"last_p_k_event,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	LastPKEvent *LastPKEvent `protobuf:"bytes,21,opt,name=last_p_k_event,json=lastPKEvent,proto3" json:"last_p_k_event,omitempty"`
+	// ChangeEvent is set if the event type is CHANGE. It's a json object
+	// with the op, before, after, source, ts_ms and fields of the change.
+	ChangeEvent string `protobuf:"bytes,22,opt,name=change_event,json=changeEvent,proto3" json:"change_event,omitempty"`
+	// Keyspace and Shard are set on the HEARTBEAT events that VTGate's
+	// VStream sends to its clients.
+	Keyspace string `protobuf:"bytes,23,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,24,opt,name=shard,proto3" json:"shard,omitempty"`
+	// ReplicationLagSeconds is set on HEARTBEAT events. It's the
+	// replication lag of the source tablet.
+	ReplicationLagSeconds int64    `protobuf:"varint,25,opt,name=replication_lag_seconds,json=replicationLagSeconds,proto3" json:"replication_lag_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *VEvent) Reset()         { *m = VEvent{} }
 func (m *VEvent) String() string { return proto.CompactTextString(m) }
 func (*VEvent) ProtoMessage()    {}
 func (*VEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{16}
+	return fileDescriptor_5fd02bcb2e350dad, []int{17}
 }
 func (m *VEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,6 +1715,34 @@ func (m *VEvent) GetLastPKEvent() *LastPKEvent {
 	return nil
 }
 
+func (m *VEvent) GetChangeEvent() string {
+	if m != nil {
+		return m.ChangeEvent
+	}
+	return ""
+}
+
+func (m *VEvent) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VEvent) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *VEvent) GetReplicationLagSeconds() int64 {
+	if m != nil {
+		return m.ReplicationLagSeconds
+	}
+	return 0
+}
+
 type MinimalTable struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Fields               []*query.Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
@@ -1571,7 +1756,7 @@ func (m *MinimalTable) Reset()         { *m = MinimalTable{} }
 func (m *MinimalTable) String() string { return proto.CompactTextString(m) }
 func (*MinimalTable) ProtoMessage()    {}
 func (*MinimalTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{17}
+	return fileDescriptor_5fd02bcb2e350dad, []int{18}
 }
 func (m *MinimalTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,7 +1817,7 @@ func (m *MinimalSchema) Reset()         { *m = MinimalSchema{} }
 func (m *MinimalSchema) String() string { return proto.CompactTextString(m) }
 func (*MinimalSchema) ProtoMessage()    {}
 func (*MinimalSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{18}
+	return fileDescriptor_5fd02bcb2e350dad, []int{19}
 }
 func (m *MinimalSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1870,7 @@ func (m *VStreamRequest) Reset()         { *m = VStreamRequest{} }
 func (m *VStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamRequest) ProtoMessage()    {}
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{19}
+	return fileDescriptor_5fd02bcb2e350dad, []int{20}
 }
 func (m *VStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +1953,7 @@ func (m *VStreamResponse) Reset()         { *m = VStreamResponse{} }
 func (m *VStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResponse) ProtoMessage()    {}
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{20}
+	return fileDescriptor_5fd02bcb2e350dad, []int{21}
 }
 func (m *VStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1820,7 +2005,7 @@ func (m *VStreamRowsRequest) Reset()         { *m = VStreamRowsRequest{} }
 func (m *VStreamRowsRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamRowsRequest) ProtoMessage()    {}
 func (*VStreamRowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{21}
+	return fileDescriptor_5fd02bcb2e350dad, []int{22}
 }
 func (m *VStreamRowsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1900,7 +2085,7 @@ func (m *VStreamRowsResponse) Reset()         { *m = VStreamRowsResponse{} }
 func (m *VStreamRowsResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamRowsResponse) ProtoMessage()    {}
 func (*VStreamRowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{22}
+	return fileDescriptor_5fd02bcb2e350dad, []int{23}
 }
 func (m *VStreamRowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2161,7 @@ func (m *LastPKEvent) Reset()         { *m = LastPKEvent{} }
 func (m *LastPKEvent) String() string { return proto.CompactTextString(m) }
 func (*LastPKEvent) ProtoMessage()    {}
 func (*LastPKEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{23}
+	return fileDescriptor_5fd02bcb2e350dad, []int{24}
 }
 func (m *LastPKEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2031,7 +2216,7 @@ func (m *TableLastPK) Reset()         { *m = TableLastPK{} }
 func (m *TableLastPK) String() string { return proto.CompactTextString(m) }
 func (*TableLastPK) ProtoMessage()    {}
 func (*TableLastPK) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{24}
+	return fileDescriptor_5fd02bcb2e350dad, []int{25}
 }
 func (m *TableLastPK) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2091,7 +2276,7 @@ func (m *VStreamResultsRequest) Reset()         { *m = VStreamResultsRequest{} }
 func (m *VStreamResultsRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamResultsRequest) ProtoMessage()    {}
 func (*VStreamResultsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{25}
+	return fileDescriptor_5fd02bcb2e350dad, []int{26}
 }
 func (m *VStreamResultsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2163,7 +2348,7 @@ func (m *VStreamResultsResponse) Reset()         { *m = VStreamResultsResponse{}
 func (m *VStreamResultsResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResultsResponse) ProtoMessage()    {}
 func (*VStreamResultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{26}
+	return fileDescriptor_5fd02bcb2e350dad, []int{27}
 }
 func (m *VStreamResultsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2229,6 +2414,7 @@ func init() {
 	proto.RegisterType((*Rule)(nil), "binlogdata.Rule")
 	proto.RegisterType((*Filter)(nil), "binlogdata.Filter")
 	proto.RegisterType((*BinlogSource)(nil), "binlogdata.BinlogSource")
+	proto.RegisterType((*ExternalSink)(nil), "binlogdata.ExternalSink")
 	proto.RegisterType((*RowChange)(nil), "binlogdata.RowChange")
 	proto.RegisterType((*RowEvent)(nil), "binlogdata.RowEvent")
 	proto.RegisterType((*FieldEvent)(nil), "binlogdata.FieldEvent")
@@ -2252,130 +2438,143 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x23, 0x59,
-	0x15, 0xee, 0xf2, 0xdb, 0xa7, 0x1c, 0xa7, 0x72, 0xf3, 0xc0, 0xb4, 0x66, 0xa2, 0x4c, 0x89, 0x99,
-	0x0e, 0x91, 0x70, 0x06, 0xc3, 0x34, 0x42, 0x62, 0x66, 0xf0, 0xa3, 0x3a, 0xed, 0x8e, 0x1f, 0xe9,
-	0xeb, 0xea, 0xf4, 0x68, 0x36, 0xa5, 0x4a, 0xf9, 0x26, 0x29, 0x52, 0x76, 0xb9, 0xab, 0xae, 0x93,
-	0xf1, 0x0f, 0x40, 0x62, 0x8f, 0x84, 0xf8, 0x0b, 0xac, 0x59, 0x02, 0x5b, 0x60, 0xc9, 0x0f, 0x60,
-	0x81, 0x1a, 0xf1, 0x23, 0xd8, 0xa1, 0xfb, 0xa8, 0x57, 0x7a, 0xa6, 0x93, 0x1e, 0x89, 0x05, 0x6c,
-	0xac, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0xf3, 0xfa, 0x4e, 0x1d, 0x5f, 0xd0, 0xce, 0xdc, 0xb9, 0xe7,
-	0x5f, 0x4c, 0x6d, 0x6a, 0x37, 0x17, 0x81, 0x4f, 0x7d, 0x04, 0x09, 0xe5, 0xa1, 0x7a, 0x4d, 0x83,
-	0x85, 0x23, 0x0e, 0x1e, 0xaa, 0xaf, 0x96, 0x24, 0x58, 0xc9, 0x4d, 0x9d, 0xfa, 0x0b, 0x3f, 0x91,
-	0xd2, 0x87, 0x50, 0xee, 0x5e, 0xda, 0x41, 0x48, 0x28, 0xda, 0x81, 0x92, 0xe3, 0xb9, 0x64, 0x4e,
-	0x1b, 0xca, 0x9e, 0xb2, 0x5f, 0xc4, 0x72, 0x87, 0x10, 0x14, 0x1c, 0x7f, 0x3e, 0x6f, 0xe4, 0x38,
-	0x95, 0xaf, 0x19, 0x6f, 0x48, 0x82, 0x6b, 0x12, 0x34, 0xf2, 0x82, 0x57, 0xec, 0xf4, 0x7f, 0xe5,
-	0x61, 0xa3, 0xc3, 0xed, 0x30, 0x03, 0x7b, 0x1e, 0xda, 0x0e, 0x75, 0xfd, 0x39, 0x3a, 0x02, 0x08,
-	0xa9, 0x4d, 0xc9, 0x8c, 0xcc, 0x69, 0xd8, 0x50, 0xf6, 0xf2, 0xfb, 0x6a, 0xeb, 0x51, 0x33, 0xe5,
-	0xc1, 0x1b, 0x22, 0xcd, 0x49, 0xc4, 0x8f, 0x53, 0xa2, 0xa8, 0x05, 0x2a, 0xb9, 0x26, 0x73, 0x6a,
-	0x51, 0xff, 0x8a, 0xcc, 0x1b, 0x85, 0x3d, 0x65, 0x5f, 0x6d, 0x6d, 0x34, 0x85, 0x83, 0x06, 0x3b,
-	0x31, 0xd9, 0x01, 0x06, 0x12, 0xaf, 0x1f, 0xfe, 0x39, 0x07, 0xd5, 0x58, 0x1b, 0x1a, 0x40, 0xc5,
-	0xb1, 0x29, 0xb9, 0xf0, 0x83, 0x15, 0x77, 0xb3, 0xde, 0xfa, 0xf8, 0x9e, 0x86, 0x34, 0xbb, 0x52,
-	0x0e, 0xc7, 0x1a, 0xd0, 0x0f, 0xa0, 0xec, 0x88, 0xe8, 0xf1, 0xe8, 0xa8, 0xad, 0xcd, 0xb4, 0x32,
-	0x19, 0x58, 0x1c, 0xf1, 0x20, 0x0d, 0xf2, 0xe1, 0x2b, 0x8f, 0x87, 0xac, 0x86, 0xd9, 0x52, 0xff,
-	0x9d, 0x02, 0x95, 0x48, 0x2f, 0xda, 0x84, 0xf5, 0xce, 0xc0, 0x7a, 0x31, 0xc2, 0x46, 0x77, 0x7c,
-	0x34, 0xea, 0x7f, 0x69, 0xf4, 0xb4, 0x07, 0xa8, 0x06, 0x95, 0xce, 0xc0, 0xea, 0x18, 0x47, 0xfd,
-	0x91, 0xa6, 0xa0, 0x35, 0xa8, 0x76, 0x06, 0x56, 0x77, 0x3c, 0x1c, 0xf6, 0x4d, 0x2d, 0x87, 0xd6,
-	0x41, 0xed, 0x0c, 0x2c, 0x3c, 0x1e, 0x0c, 0x3a, 0xed, 0xee, 0xb1, 0x96, 0x47, 0xdb, 0xb0, 0xd1,
-	0x19, 0x58, 0xbd, 0xe1, 0xc0, 0xea, 0x19, 0x27, 0xd8, 0xe8, 0xb6, 0x4d, 0xa3, 0xa7, 0x15, 0x10,
-	0x40, 0x89, 0x91, 0x7b, 0x03, 0xad, 0x28, 0xd7, 0x13, 0xc3, 0xd4, 0x4a, 0x52, 0x5d, 0x7f, 0x34,
-	0x31, 0xb0, 0xa9, 0x95, 0xe5, 0xf6, 0xc5, 0x49, 0xaf, 0x6d, 0x1a, 0x5a, 0x45, 0x6e, 0x7b, 0xc6,
-	0xc0, 0x30, 0x0d, 0xad, 0xfa, 0xac, 0x50, 0xc9, 0x69, 0xf9, 0x67, 0x85, 0x4a, 0x5e, 0x2b, 0xe8,
-	0xbf, 0x56, 0x60, 0x7b, 0x42, 0x03, 0x62, 0xcf, 0x8e, 0xc9, 0x0a, 0xdb, 0xf3, 0x0b, 0x82, 0xc9,
-	0xab, 0x25, 0x09, 0x29, 0x7a, 0x08, 0x95, 0x85, 0x1f, 0xba, 0x2c, 0x76, 0x3c, 0xc0, 0x55, 0x1c,
-	0xef, 0xd1, 0x21, 0x54, 0xaf, 0xc8, 0xca, 0x0a, 0x18, 0xbf, 0x0c, 0x18, 0x6a, 0xc6, 0x05, 0x19,
-	0x6b, 0xaa, 0x5c, 0xc9, 0x55, 0x3a, 0xbe, 0xf9, 0xbb, 0xe3, 0xab, 0x9f, 0xc3, 0xce, 0x6d, 0xa3,
-	0xc2, 0x85, 0x3f, 0x0f, 0x09, 0x1a, 0x00, 0x12, 0x82, 0x16, 0x4d, 0x72, 0xcb, 0xed, 0x53, 0x5b,
-	0xef, 0xbf, 0xb5, 0x00, 0xf0, 0xc6, 0xd9, 0x6d, 0x92, 0xfe, 0x15, 0x6c, 0x8a, 0x7b, 0x4c, 0xfb,
-	0xcc, 0x23, 0xe1, 0x7d, 0x5c, 0xdf, 0x81, 0x12, 0xe5, 0xcc, 0x8d, 0xdc, 0x5e, 0x7e, 0xbf, 0x8a,
-	0xe5, 0xee, 0x5d, 0x3d, 0x9c, 0xc2, 0x56, 0xf6, 0xe6, 0xff, 0x8a, 0x7f, 0x3f, 0x86, 0x02, 0x5e,
-	0x7a, 0x04, 0x6d, 0x41, 0x71, 0x66, 0x53, 0xe7, 0x52, 0x7a, 0x23, 0x36, 0xcc, 0x95, 0x73, 0xd7,
-	0xa3, 0x24, 0xe0, 0x29, 0xac, 0x62, 0xb9, 0xd3, 0x7f, 0xaf, 0x40, 0xe9, 0x09, 0x5f, 0xa2, 0x8f,
-	0xa0, 0x18, 0x2c, 0x99, 0xb3, 0x02, 0xeb, 0x5a, 0xda, 0x02, 0xa6, 0x19, 0x8b, 0x63, 0xd4, 0x87,
-	0xfa, 0xb9, 0x4b, 0xbc, 0x29, 0x87, 0xee, 0xd0, 0x9f, 0x8a, 0xaa, 0xa8, 0xb7, 0x3e, 0x48, 0x0b,
-	0x08, 0x9d, 0xcd, 0x27, 0x19, 0x46, 0x7c, 0x4b, 0x50, 0x7f, 0x0c, 0xf5, 0x2c, 0x07, 0x83, 0x93,
-	0x81, 0xb1, 0x35, 0x1e, 0x59, 0xc3, 0xfe, 0x64, 0xd8, 0x36, 0xbb, 0x4f, 0xb5, 0x07, 0x1c, 0x31,
-	0xc6, 0xc4, 0xb4, 0x8c, 0x27, 0x4f, 0xc6, 0xd8, 0xd4, 0x14, 0xfd, 0x37, 0x79, 0xa8, 0x89, 0xa0,
-	0x4c, 0xfc, 0x65, 0xe0, 0x10, 0x96, 0xc5, 0x2b, 0xb2, 0x0a, 0x17, 0xb6, 0x43, 0xa2, 0x2c, 0x46,
-	0x7b, 0x16, 0x90, 0xf0, 0xd2, 0x0e, 0xa6, 0xd2, 0x73, 0xb1, 0x41, 0x9f, 0x80, 0xca, 0xb3, 0x49,
-	0x2d, 0xba, 0x5a, 0x10, 0x9e, 0xc7, 0x7a, 0x6b, 0x2b, 0x29, 0x6c, 0x9e, 0x2b, 0x6a, 0xae, 0x16,
-	0x04, 0x03, 0x8d, 0xd7, 0x59, 0x34, 0x14, 0xee, 0x81, 0x86, 0xa4, 0x86, 0x8a, 0x99, 0x1a, 0x3a,
-	0x88, 0x13, 0x52, 0x92, 0x5a, 0xde, 0x88, 0x5e, 0x94, 0x24, 0xd4, 0x84, 0x92, 0x3f, 0xb7, 0xa6,
-	0x53, 0xaf, 0x51, 0xe6, 0x66, 0x7e, 0x27, 0xcd, 0x3b, 0x9e, 0xf7, 0x7a, 0x83, 0xb6, 0x28, 0x8b,
-	0xa2, 0x3f, 0xef, 0x4d, 0x3d, 0xf4, 0x21, 0xd4, 0xc9, 0x57, 0x94, 0x04, 0x73, 0xdb, 0xb3, 0x66,
-	0x2b, 0xd6, 0xbd, 0x2a, 0xdc, 0xf5, 0xb5, 0x88, 0x3a, 0x64, 0x44, 0xf4, 0x11, 0xac, 0x87, 0xd4,
-	0x5f, 0x58, 0xf6, 0x39, 0x25, 0x81, 0xe5, 0xf8, 0x8b, 0x55, 0xa3, 0xba, 0xa7, 0xec, 0x57, 0xf0,
-	0x1a, 0x23, 0xb7, 0x19, 0xb5, 0xeb, 0x2f, 0x56, 0xe8, 0xfb, 0xa0, 0xc5, 0xea, 0x1c, 0x6f, 0x19,
-	0x32, 0xa3, 0x81, 0x2b, 0x5c, 0x8f, 0xe8, 0x5d, 0x41, 0xd6, 0x9f, 0x43, 0x15, 0xfb, 0x37, 0xdd,
-	0x4b, 0xee, 0xba, 0x0e, 0xa5, 0x33, 0x72, 0xee, 0x07, 0x44, 0xd6, 0x34, 0xc8, 0x9e, 0x8f, 0xfd,
-	0x1b, 0x2c, 0x4f, 0xd0, 0x1e, 0x14, 0xf9, 0xf5, 0xb2, 0xb3, 0xa4, 0x59, 0xc4, 0x81, 0x6e, 0x43,
-	0x05, 0xfb, 0x37, 0xbc, 0x42, 0xd0, 0xfb, 0x20, 0x72, 0x61, 0xcd, 0xed, 0x59, 0x94, 0xe8, 0x2a,
-	0xa7, 0x8c, 0xec, 0x19, 0x41, 0x8f, 0x41, 0x0d, 0xfc, 0x1b, 0xcb, 0xe1, 0xd7, 0x0b, 0xd0, 0xaa,
-	0xad, 0xed, 0x4c, 0x1d, 0x47, 0xc6, 0x61, 0x08, 0xa2, 0x65, 0xa8, 0x3f, 0x07, 0x48, 0xca, 0xf0,
-	0xae, 0x4b, 0xbe, 0xc7, 0x12, 0x47, 0xbc, 0x69, 0xa4, 0xbf, 0x26, 0x4d, 0xe6, 0x1a, 0xb0, 0x3c,
-	0xd3, 0x7f, 0xa5, 0x40, 0x75, 0xc2, 0x0a, 0xed, 0x88, 0xba, 0xd3, 0x6f, 0x51, 0x9e, 0x08, 0x0a,
-	0x17, 0xd4, 0x9d, 0xf2, 0xba, 0xac, 0x62, 0xbe, 0x46, 0x9f, 0x44, 0x86, 0x2d, 0xac, 0xab, 0xb0,
-	0x51, 0xe0, 0xb7, 0x67, 0x4a, 0x81, 0xd7, 0xec, 0xc0, 0x0e, 0xe9, 0xc9, 0x31, 0xae, 0x70, 0xd6,
-	0x93, 0xe3, 0x50, 0xff, 0x1c, 0x8a, 0xa7, 0xdc, 0x8a, 0xc7, 0xa0, 0x72, 0xe5, 0x16, 0xd3, 0x16,
-	0xc1, 0x3c, 0x13, 0x9e, 0xd8, 0x62, 0x0c, 0x61, 0xb4, 0x0c, 0xf5, 0x36, 0xac, 0x1d, 0x4b, 0x6b,
-	0x39, 0xc3, 0xbb, 0xbb, 0xa3, 0xff, 0x31, 0x07, 0xe5, 0x67, 0xfe, 0x92, 0x95, 0x0a, 0xaa, 0x43,
-	0xce, 0x9d, 0x72, 0xb9, 0x3c, 0xce, 0xb9, 0x53, 0xf4, 0x73, 0xa8, 0xcf, 0xdc, 0x8b, 0xc0, 0x66,
-	0x15, 0x2c, 0xc0, 0x28, 0xfa, 0xc9, 0x77, 0xd3, 0x96, 0x0d, 0x23, 0x0e, 0x8e, 0xc8, 0xb5, 0x59,
-	0x7a, 0x9b, 0xc2, 0x58, 0x3e, 0x83, 0xb1, 0x0f, 0xa1, 0xee, 0xf9, 0x8e, 0xed, 0x59, 0x71, 0x87,
-	0x2f, 0x08, 0x1c, 0x70, 0xea, 0x49, 0xd4, 0xe6, 0x6f, 0xc5, 0xa5, 0x78, 0xcf, 0xb8, 0xa0, 0x4f,
-	0xa1, 0xb6, 0xb0, 0x03, 0xea, 0x3a, 0xee, 0xc2, 0x66, 0x33, 0x52, 0x89, 0x0b, 0x66, 0xcc, 0xce,
-	0xc4, 0x0d, 0x67, 0xd8, 0x19, 0xac, 0x42, 0xde, 0xbd, 0xac, 0x1b, 0x3f, 0xb8, 0x3a, 0xf7, 0xfc,
-	0x9b, 0xb0, 0x51, 0xe6, 0xf6, 0xaf, 0x0b, 0xfa, 0xcb, 0x88, 0xac, 0xff, 0x21, 0x0f, 0xa5, 0x53,
-	0x51, 0x9d, 0x07, 0x50, 0xe0, 0x31, 0x12, 0x73, 0xd0, 0x4e, 0xfa, 0x32, 0xc1, 0xc1, 0x03, 0xc4,
-	0x79, 0xd0, 0x7b, 0x50, 0xa5, 0xee, 0x8c, 0x84, 0xd4, 0x9e, 0x2d, 0x78, 0x50, 0xf3, 0x38, 0x21,
-	0x7c, 0x6d, 0x89, 0xbd, 0x07, 0xd5, 0x78, 0x72, 0x93, 0xc1, 0x4a, 0x08, 0xe8, 0x87, 0x50, 0x65,
-	0xf8, 0xe2, 0x73, 0x5a, 0xa3, 0xc8, 0x01, 0xbb, 0x75, 0x0b, 0x5d, 0xdc, 0x04, 0x5c, 0x09, 0x22,
-	0xc4, 0xfe, 0x04, 0x54, 0x8e, 0x08, 0x29, 0x24, 0x7a, 0xdd, 0x4e, 0xb6, 0xd7, 0x45, 0xc8, 0xc3,
-	0x90, 0x7c, 0x1e, 0xd0, 0x23, 0x28, 0x5e, 0x73, 0xf3, 0xca, 0x72, 0x5e, 0x4c, 0x3b, 0xca, 0x53,
-	0x21, 0xce, 0xd9, 0xc7, 0xf8, 0x17, 0xa2, 0xb2, 0x78, 0x97, 0xbb, 0xf5, 0x31, 0x96, 0x45, 0x87,
-	0x23, 0x1e, 0x36, 0xce, 0x4d, 0x67, 0x1e, 0x6f, 0x74, 0x55, 0xcc, 0x96, 0xe8, 0x03, 0xa8, 0x39,
-	0xcb, 0x20, 0xe0, 0x13, 0xaa, 0x3b, 0x23, 0x8d, 0x2d, 0x1e, 0x28, 0x55, 0xd2, 0x4c, 0x77, 0x46,
-	0xd0, 0xcf, 0xa0, 0xee, 0xd9, 0x21, 0x65, 0xc0, 0x93, 0x8e, 0x6c, 0xf3, 0xab, 0x32, 0xe8, 0x13,
-	0xc0, 0x13, 0x9e, 0xa8, 0x5e, 0xb2, 0xd1, 0x2f, 0xa1, 0x36, 0x74, 0xe7, 0xee, 0xcc, 0xf6, 0x38,
-	0x40, 0x59, 0xe0, 0x53, 0xad, 0x85, 0xaf, 0xef, 0xd7, 0x55, 0xd0, 0x2e, 0xa8, 0xcc, 0x04, 0xc7,
-	0xf7, 0x96, 0xb3, 0xb9, 0xa8, 0xf6, 0x3c, 0xae, 0x2e, 0x8e, 0xbb, 0x82, 0xc0, 0x90, 0x2a, 0x6f,
-	0x9a, 0x38, 0x97, 0x64, 0x66, 0xa3, 0x8f, 0x63, 0x64, 0x08, 0xb4, 0x37, 0xb2, 0x98, 0x4a, 0x8c,
-	0x8a, 0x30, 0xa3, 0xff, 0x25, 0x07, 0xf5, 0x53, 0x31, 0xae, 0x44, 0x23, 0xd2, 0xe7, 0xb0, 0x49,
-	0xce, 0xcf, 0x89, 0x43, 0xdd, 0x6b, 0x62, 0x39, 0xb6, 0xe7, 0x91, 0xc0, 0x92, 0x08, 0x56, 0x5b,
-	0xeb, 0x4d, 0xf1, 0xb7, 0xa5, 0xcb, 0xe9, 0xfd, 0x1e, 0xde, 0x88, 0x79, 0x25, 0x69, 0x8a, 0x0c,
-	0xd8, 0x74, 0x67, 0x33, 0x32, 0x75, 0x6d, 0x9a, 0x56, 0x20, 0x5a, 0xfe, 0xb6, 0xf4, 0xf4, 0xd4,
-	0x3c, 0xb2, 0x29, 0x49, 0xd4, 0xc4, 0x12, 0xb1, 0x9a, 0x0f, 0x99, 0x33, 0xc1, 0x45, 0x3c, 0x75,
-	0xad, 0x49, 0x49, 0x93, 0x13, 0xb1, 0x3c, 0xcc, 0x4c, 0x74, 0x85, 0x5b, 0x13, 0x5d, 0xf2, 0xd5,
-	0x2d, 0xde, 0xf9, 0xd5, 0xfd, 0x0c, 0xd6, 0x45, 0xbb, 0x8d, 0x52, 0x1f, 0x21, 0xfc, 0x1b, 0x7b,
-	0x6e, 0x8d, 0x26, 0x9b, 0x50, 0xff, 0x14, 0xd6, 0xe3, 0x40, 0xca, 0x89, 0xef, 0x00, 0x4a, 0xbc,
-	0x7c, 0xa2, 0x74, 0xa0, 0x37, 0xe1, 0x8b, 0x25, 0x87, 0xfe, 0xcb, 0x1c, 0xa0, 0x48, 0xde, 0xbf,
-	0x09, 0xff, 0x47, 0x93, 0xb1, 0x05, 0x45, 0x4e, 0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4,
-	0xc5, 0x55, 0x9c, 0x06, 0x21, 0xfc, 0x9c, 0xfd, 0x62, 0x12, 0x2e, 0x3d, 0x8a, 0x25, 0x87, 0xfe,
-	0x27, 0x05, 0x36, 0x33, 0x71, 0x90, 0xb1, 0x4c, 0x10, 0xa3, 0xbc, 0x05, 0x31, 0xfb, 0x50, 0x59,
-	0x5c, 0xbd, 0x05, 0x59, 0xf1, 0xe9, 0xd7, 0xb6, 0xc3, 0x5d, 0x28, 0x04, 0xac, 0x2d, 0x8b, 0x6f,
-	0x6d, 0x7a, 0x38, 0xe1, 0x74, 0x36, 0xe1, 0x64, 0xfc, 0xc8, 0x4c, 0x38, 0xd2, 0x7e, 0x17, 0xd4,
-	0x54, 0x67, 0x60, 0xad, 0x24, 0x5b, 0x55, 0x32, 0x75, 0xdf, 0x58, 0x54, 0x6a, 0xaa, 0xa8, 0x58,
-	0x7f, 0x76, 0xfc, 0xd9, 0xc2, 0x23, 0x94, 0x88, 0x94, 0x55, 0x70, 0x42, 0xd0, 0xbf, 0x00, 0x35,
-	0x25, 0x79, 0xd7, 0x20, 0x93, 0x24, 0x21, 0x7f, 0x67, 0x12, 0xfe, 0xae, 0xc0, 0x76, 0x52, 0xcc,
-	0x4b, 0x8f, 0xfe, 0x5f, 0xd5, 0xa3, 0x1e, 0xc0, 0xce, 0x6d, 0xef, 0xde, 0xa9, 0xca, 0xbe, 0x45,
-	0xed, 0x1c, 0x7c, 0x06, 0x6a, 0x6a, 0x74, 0x67, 0xff, 0xf0, 0xfb, 0x47, 0xa3, 0x31, 0x36, 0xb4,
-	0x07, 0xa8, 0x02, 0x85, 0x89, 0x39, 0x3e, 0xd1, 0x14, 0xb6, 0x32, 0xbe, 0x30, 0xba, 0xe2, 0xd5,
-	0x80, 0xad, 0x2c, 0xc9, 0x94, 0x3f, 0xf8, 0xb7, 0x02, 0x90, 0x7c, 0xf1, 0x91, 0x0a, 0xe5, 0x17,
-	0xa3, 0xe3, 0xd1, 0xf8, 0xe5, 0x48, 0x28, 0x38, 0x32, 0xfb, 0x3d, 0x4d, 0x41, 0x55, 0x28, 0x8a,
-	0x67, 0x88, 0x1c, 0xbb, 0x41, 0xbe, 0x41, 0xe4, 0x51, 0x0d, 0x2a, 0xf1, 0x03, 0x44, 0x01, 0x95,
-	0x21, 0x1f, 0x3f, 0x33, 0xc8, 0x77, 0x85, 0x12, 0x53, 0x88, 0x8d, 0x93, 0x41, 0xbb, 0x6b, 0x68,
-	0x65, 0x76, 0x10, 0xbf, 0x30, 0x00, 0x94, 0xa2, 0xe7, 0x05, 0x26, 0x39, 0x31, 0x4c, 0x0d, 0xd8,
-	0x3d, 0x63, 0xf3, 0xa9, 0x81, 0x35, 0x95, 0xd1, 0xf0, 0xf8, 0xa5, 0x56, 0x63, 0xb4, 0x27, 0x7d,
-	0x63, 0xd0, 0xd3, 0xd6, 0xd0, 0x1a, 0x54, 0x9f, 0x1a, 0x6d, 0x6c, 0x76, 0x8c, 0xb6, 0xa9, 0xd5,
-	0xd9, 0xc9, 0x29, 0x37, 0x70, 0x9d, 0x5d, 0xf3, 0x6c, 0xfc, 0x02, 0x8f, 0xda, 0x03, 0x4d, 0x63,
-	0x9b, 0x53, 0x03, 0x4f, 0xfa, 0xe3, 0x91, 0xb6, 0xc1, 0xee, 0x19, 0xb4, 0x27, 0xe6, 0xc9, 0xb1,
-	0x86, 0x98, 0xfc, 0xa4, 0x7d, 0x6a, 0x9c, 0x8c, 0xfb, 0x23, 0x53, 0xdb, 0x3c, 0x78, 0xc4, 0xbe,
-	0x73, 0xe9, 0x09, 0x10, 0xa0, 0x64, 0xb6, 0x3b, 0x03, 0x63, 0xa2, 0x3d, 0x60, 0xeb, 0xc9, 0xd3,
-	0x36, 0xee, 0x4d, 0x34, 0xa5, 0xf3, 0xd3, 0xbf, 0xbe, 0xde, 0x55, 0xfe, 0xf6, 0x7a, 0x57, 0xf9,
-	0xc7, 0xeb, 0x5d, 0xe5, 0xb7, 0xff, 0xdc, 0x7d, 0xf0, 0xe5, 0xa3, 0x6b, 0x97, 0x92, 0x30, 0x6c,
-	0xba, 0xfe, 0xa1, 0x58, 0x1d, 0x5e, 0xf8, 0x87, 0xd7, 0xf4, 0x90, 0xbf, 0xac, 0x1d, 0x26, 0x18,
-	0x3c, 0x2b, 0x71, 0xca, 0x8f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x22, 0xbd, 0x3f, 0x86, 0xb5,
-	0x13, 0x00, 0x00,
+	// 2176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0xcb, 0x6e, 0xe3, 0xd6,
+	0x75, 0xa8, 0x97, 0xa5, 0x43, 0x59, 0xa6, 0xaf, 0x1f, 0x61, 0x06, 0x89, 0xe1, 0x10, 0x4d, 0xc6,
+	0x35, 0x50, 0x3b, 0x55, 0x91, 0x29, 0x0a, 0x74, 0x9a, 0xea, 0x41, 0x7b, 0x34, 0x96, 0x25, 0xe7,
+	0x8a, 0xe3, 0x09, 0x02, 0x14, 0x04, 0x87, 0xba, 0x96, 0x59, 0xf3, 0xa1, 0x21, 0xaf, 0xec, 0x51,
+	0xf6, 0x01, 0xba, 0xef, 0xa6, 0xbf, 0xd0, 0x75, 0xb7, 0x2d, 0xba, 0x6b, 0xb3, 0xec, 0x07, 0x74,
+	0x51, 0x4c, 0xd1, 0xcf, 0x28, 0x50, 0xdc, 0x07, 0x29, 0xca, 0x93, 0x79, 0x05, 0xe8, 0xa2, 0xdd,
+	0x08, 0xe7, 0x9c, 0x7b, 0xce, 0xb9, 0xe7, 0xcd, 0xa3, 0x0b, 0xda, 0x53, 0x2f, 0xf4, 0xa3, 0xc9,
+	0xd8, 0xa1, 0xce, 0xc1, 0x34, 0x8e, 0x68, 0x84, 0x60, 0x41, 0xb9, 0xab, 0x5e, 0xd3, 0x78, 0xea,
+	0x8a, 0x83, 0xbb, 0xea, 0xb3, 0x19, 0x89, 0xe7, 0x12, 0x69, 0xd0, 0x68, 0x1a, 0x2d, 0xa4, 0x8c,
+	0x53, 0x58, 0xe9, 0x5c, 0x3a, 0x71, 0x42, 0x28, 0xda, 0x86, 0x8a, 0xeb, 0x7b, 0x24, 0xa4, 0xba,
+	0xb2, 0xab, 0xec, 0x95, 0xb1, 0xc4, 0x10, 0x82, 0x92, 0x1b, 0x85, 0xa1, 0x5e, 0xe0, 0x54, 0x0e,
+	0x33, 0xde, 0x84, 0xc4, 0xd7, 0x24, 0xd6, 0x8b, 0x82, 0x57, 0x60, 0xc6, 0xbf, 0x8a, 0xb0, 0xde,
+	0xe6, 0x76, 0x58, 0xb1, 0x13, 0x26, 0x8e, 0x4b, 0xbd, 0x28, 0x44, 0xc7, 0x00, 0x09, 0x75, 0x28,
+	0x09, 0x48, 0x48, 0x13, 0x5d, 0xd9, 0x2d, 0xee, 0xa9, 0xcd, 0x7b, 0x07, 0x39, 0x0f, 0x5e, 0x12,
+	0x39, 0x18, 0xa5, 0xfc, 0x38, 0x27, 0x8a, 0x9a, 0xa0, 0x92, 0x6b, 0x12, 0x52, 0x9b, 0x46, 0x57,
+	0x24, 0xd4, 0x4b, 0xbb, 0xca, 0x9e, 0xda, 0x5c, 0x3f, 0x10, 0x0e, 0x9a, 0xec, 0xc4, 0x62, 0x07,
+	0x18, 0x48, 0x06, 0xdf, 0xfd, 0x4b, 0x01, 0x6a, 0x99, 0x36, 0xd4, 0x87, 0xaa, 0xeb, 0x50, 0x32,
+	0x89, 0xe2, 0x39, 0x77, 0xb3, 0xd1, 0xfc, 0xf4, 0x2d, 0x0d, 0x39, 0xe8, 0x48, 0x39, 0x9c, 0x69,
+	0x40, 0x3f, 0x82, 0x15, 0x57, 0x44, 0x8f, 0x47, 0x47, 0x6d, 0x6e, 0xe4, 0x95, 0xc9, 0xc0, 0xe2,
+	0x94, 0x07, 0x69, 0x50, 0x4c, 0x9e, 0xf9, 0x3c, 0x64, 0x75, 0xcc, 0x40, 0xe3, 0xf7, 0x0a, 0x54,
+	0x53, 0xbd, 0x68, 0x03, 0xd6, 0xda, 0x7d, 0xfb, 0xf1, 0x00, 0x9b, 0x9d, 0xe1, 0xf1, 0xa0, 0xf7,
+	0x95, 0xd9, 0xd5, 0xee, 0xa0, 0x3a, 0x54, 0xdb, 0x7d, 0xbb, 0x6d, 0x1e, 0xf7, 0x06, 0x9a, 0x82,
+	0x56, 0xa1, 0xd6, 0xee, 0xdb, 0x9d, 0xe1, 0xe9, 0x69, 0xcf, 0xd2, 0x0a, 0x68, 0x0d, 0xd4, 0x76,
+	0xdf, 0xc6, 0xc3, 0x7e, 0xbf, 0xdd, 0xea, 0x9c, 0x68, 0x45, 0xb4, 0x05, 0xeb, 0xed, 0xbe, 0xdd,
+	0x3d, 0xed, 0xdb, 0x5d, 0xf3, 0x0c, 0x9b, 0x9d, 0x96, 0x65, 0x76, 0xb5, 0x12, 0x02, 0xa8, 0x30,
+	0x72, 0xb7, 0xaf, 0x95, 0x25, 0x3c, 0x32, 0x2d, 0xad, 0x22, 0xd5, 0xf5, 0x06, 0x23, 0x13, 0x5b,
+	0xda, 0x8a, 0x44, 0x1f, 0x9f, 0x75, 0x5b, 0x96, 0xa9, 0x55, 0x25, 0xda, 0x35, 0xfb, 0xa6, 0x65,
+	0x6a, 0xb5, 0x47, 0xa5, 0x6a, 0x41, 0x2b, 0x3e, 0x2a, 0x55, 0x8b, 0x5a, 0xc9, 0xf8, 0xad, 0x02,
+	0x5b, 0x23, 0x1a, 0x13, 0x27, 0x38, 0x21, 0x73, 0xec, 0x84, 0x13, 0x82, 0xc9, 0xb3, 0x19, 0x49,
+	0x28, 0xba, 0x0b, 0xd5, 0x69, 0x94, 0x78, 0x2c, 0x76, 0x3c, 0xc0, 0x35, 0x9c, 0xe1, 0xe8, 0x10,
+	0x6a, 0x57, 0x64, 0x6e, 0xc7, 0x8c, 0x5f, 0x06, 0x0c, 0x1d, 0x64, 0x05, 0x99, 0x69, 0xaa, 0x5e,
+	0x49, 0x28, 0x1f, 0xdf, 0xe2, 0x9b, 0xe3, 0x6b, 0x5c, 0xc0, 0xf6, 0x6d, 0xa3, 0x92, 0x69, 0x14,
+	0x26, 0x04, 0xf5, 0x01, 0x09, 0x41, 0x9b, 0x2e, 0x72, 0xcb, 0xed, 0x53, 0x9b, 0x1f, 0xbe, 0xb6,
+	0x00, 0xf0, 0xfa, 0xd3, 0xdb, 0x24, 0xe3, 0x39, 0x6c, 0x88, 0x7b, 0x2c, 0xe7, 0xa9, 0x4f, 0x92,
+	0xb7, 0x71, 0x7d, 0x1b, 0x2a, 0x94, 0x33, 0xeb, 0x85, 0xdd, 0xe2, 0x5e, 0x0d, 0x4b, 0xec, 0x5d,
+	0x3d, 0x1c, 0xc3, 0xe6, 0xf2, 0xcd, 0xff, 0x15, 0xff, 0x7e, 0x05, 0x25, 0x3c, 0xf3, 0x09, 0xda,
+	0x84, 0x72, 0xe0, 0x50, 0xf7, 0x52, 0x7a, 0x23, 0x10, 0xe6, 0xca, 0x85, 0xe7, 0x53, 0x12, 0xf3,
+	0x14, 0xd6, 0xb0, 0xc4, 0xd0, 0x3d, 0x58, 0x23, 0xcf, 0x5d, 0x7f, 0x36, 0x26, 0xb6, 0x1b, 0xf9,
+	0xb3, 0x20, 0x4c, 0xf4, 0x22, 0xf7, 0xb5, 0x21, 0xc9, 0x1d, 0x41, 0x35, 0xfe, 0xa0, 0x40, 0xe5,
+	0x48, 0xc8, 0x7c, 0x02, 0xe5, 0x78, 0xe6, 0x93, 0x74, 0x28, 0x68, 0x79, 0x53, 0x99, 0x09, 0x58,
+	0x1c, 0xa3, 0x1e, 0x34, 0x2e, 0x3c, 0xe2, 0x8f, 0x79, 0x8f, 0x9f, 0x46, 0x63, 0x51, 0x3e, 0x8d,
+	0xe6, 0x47, 0x79, 0x01, 0xa1, 0xf3, 0xe0, 0x68, 0x89, 0x11, 0xdf, 0x12, 0x34, 0xee, 0x43, 0x63,
+	0x99, 0x83, 0xf5, 0x9d, 0x89, 0xb1, 0x3d, 0x1c, 0xd8, 0xa7, 0xbd, 0xd1, 0x69, 0xcb, 0xea, 0x3c,
+	0xd4, 0xee, 0xf0, 0xd6, 0x32, 0x47, 0x96, 0x6d, 0x1e, 0x1d, 0x0d, 0xb1, 0xa5, 0x29, 0xc6, 0xbf,
+	0x8b, 0x50, 0x17, 0xd1, 0x1b, 0x45, 0xb3, 0xd8, 0x25, 0x2c, 0xdd, 0x57, 0x64, 0x9e, 0x4c, 0x1d,
+	0x97, 0xa4, 0xe9, 0x4e, 0x71, 0x16, 0xb9, 0xe4, 0xd2, 0x89, 0xc7, 0x32, 0x44, 0x02, 0x41, 0x9f,
+	0x81, 0xca, 0xd3, 0x4e, 0x6d, 0x3a, 0x9f, 0x12, 0x9e, 0xf0, 0x46, 0x73, 0x73, 0xd1, 0x01, 0x3c,
+	0xa9, 0xd4, 0x9a, 0x4f, 0x09, 0x06, 0x9a, 0xc1, 0xcb, 0x6d, 0x53, 0x7a, 0x8b, 0xb6, 0x59, 0x14,
+	0x5b, 0x79, 0xa9, 0xd8, 0xf6, 0xb3, 0xcc, 0x55, 0xa4, 0x96, 0x97, 0xa2, 0x97, 0x65, 0xf3, 0x00,
+	0x2a, 0x51, 0x68, 0x8f, 0xc7, 0xbe, 0xbe, 0xc2, 0xcd, 0x7c, 0x2f, 0xcf, 0x3b, 0x0c, 0xbb, 0xdd,
+	0x7e, 0x4b, 0xd4, 0x4f, 0x39, 0x0a, 0xbb, 0x63, 0x1f, 0x7d, 0x0c, 0x0d, 0xf2, 0x9c, 0x92, 0x38,
+	0x74, 0x7c, 0x3b, 0x98, 0xb3, 0x31, 0x57, 0xe5, 0xae, 0xaf, 0xa6, 0xd4, 0x53, 0x46, 0x44, 0x9f,
+	0xc0, 0x5a, 0x42, 0xa3, 0xa9, 0xed, 0x5c, 0x50, 0x12, 0xdb, 0x6e, 0x34, 0x9d, 0xeb, 0xb5, 0x5d,
+	0x65, 0xaf, 0x8a, 0x57, 0x19, 0xb9, 0xc5, 0xa8, 0x9d, 0x68, 0x3a, 0x47, 0x3f, 0x04, 0x2d, 0x53,
+	0xe7, 0xfa, 0xb3, 0x84, 0x19, 0x0d, 0x5c, 0xe1, 0x5a, 0x4a, 0xef, 0x08, 0x32, 0x53, 0x29, 0x2c,
+	0xb5, 0x13, 0x1a, 0xb3, 0x59, 0x3a, 0xd7, 0x55, 0x71, 0x35, 0xb7, 0x6c, 0x24, 0x89, 0xe8, 0x01,
+	0x64, 0xb6, 0xd8, 0x89, 0x17, 0x5e, 0xe9, 0x75, 0x1e, 0x04, 0x3d, 0xef, 0x98, 0x29, 0x19, 0x46,
+	0x5e, 0x78, 0x85, 0xeb, 0x24, 0x87, 0x19, 0x7f, 0x56, 0xa0, 0x9e, 0x3f, 0x66, 0xdf, 0x45, 0x9e,
+	0x46, 0x91, 0x7b, 0x0e, 0x23, 0x1d, 0x56, 0x9c, 0xf1, 0x38, 0x26, 0x49, 0x22, 0x33, 0x9f, 0xa2,
+	0xac, 0x22, 0x68, 0x34, 0xf5, 0x5c, 0x9e, 0xf5, 0x1a, 0x16, 0x08, 0xef, 0xa5, 0x28, 0x0e, 0x1c,
+	0xaa, 0x97, 0x64, 0x2f, 0x71, 0x0c, 0x19, 0xb0, 0x1a, 0x38, 0xcf, 0xed, 0x0b, 0xcf, 0x27, 0x76,
+	0xe2, 0x7d, 0x4d, 0xf4, 0xf2, 0xae, 0xb2, 0x57, 0xc4, 0x6a, 0xe0, 0x3c, 0x3f, 0xf2, 0x7c, 0x32,
+	0xf2, 0xbe, 0x66, 0x65, 0xb1, 0x99, 0xf1, 0x38, 0x13, 0x62, 0x27, 0xc4, 0x8d, 0xc2, 0x71, 0xc2,
+	0x73, 0x5b, 0xc4, 0xeb, 0x92, 0xb5, 0x35, 0x21, 0x23, 0x71, 0x60, 0x7c, 0x01, 0x35, 0x1c, 0xdd,
+	0x74, 0x2e, 0x79, 0x8d, 0x18, 0x50, 0x79, 0x4a, 0x2e, 0xa2, 0x98, 0xc8, 0x29, 0x01, 0xf2, 0x2b,
+	0x8a, 0xa3, 0x1b, 0x2c, 0x4f, 0xd0, 0x2e, 0x94, 0x79, 0x9e, 0xf4, 0xc2, 0x4b, 0x2c, 0xe2, 0xc0,
+	0x70, 0xa0, 0x8a, 0xa3, 0x1b, 0xde, 0x4a, 0xe8, 0x43, 0x10, 0x45, 0x6b, 0x87, 0x4e, 0x90, 0x46,
+	0xa5, 0xc6, 0x29, 0x03, 0x27, 0x20, 0xe8, 0x3e, 0xa8, 0x71, 0x74, 0x63, 0xbb, 0xfc, 0x7a, 0x31,
+	0x06, 0xd5, 0xe6, 0xd6, 0x52, 0xc3, 0xa7, 0xc6, 0x61, 0x88, 0x53, 0x90, 0x59, 0x0d, 0x8b, 0x7e,
+	0x7d, 0xd3, 0x25, 0x3f, 0x60, 0x15, 0x4e, 0xfc, 0x71, 0xaa, 0xbf, 0x2e, 0x4d, 0xe6, 0x1a, 0xb0,
+	0x3c, 0x33, 0x7e, 0xa3, 0x40, 0x6d, 0xc4, 0x3a, 0xf2, 0x98, 0x7a, 0xe3, 0xef, 0xd1, 0xc7, 0x08,
+	0x4a, 0x13, 0xea, 0x8d, 0x65, 0x2a, 0x39, 0x8c, 0x3e, 0x4b, 0x0d, 0x9b, 0xda, 0x57, 0x89, 0x5e,
+	0xe2, 0xb7, 0x2f, 0xf5, 0x0c, 0x6f, 0xee, 0xbe, 0x93, 0xd0, 0xb3, 0x13, 0x5c, 0xe5, 0xac, 0x67,
+	0x27, 0x89, 0xf1, 0x39, 0x94, 0xcf, 0xb9, 0x15, 0xf7, 0x41, 0xe5, 0xca, 0x6d, 0xa6, 0x2d, 0x9d,
+	0x87, 0x4b, 0xe1, 0xc9, 0x2c, 0xc6, 0x90, 0xa4, 0x60, 0x62, 0xb4, 0x60, 0xf5, 0x44, 0x5a, 0xcb,
+	0x19, 0xde, 0xdd, 0x1d, 0xe3, 0x8f, 0x05, 0x58, 0x79, 0x14, 0xcd, 0x58, 0x61, 0xa3, 0x06, 0x14,
+	0xbc, 0x31, 0x97, 0x2b, 0xe2, 0x82, 0x37, 0x46, 0xbf, 0x84, 0x46, 0xe0, 0x4d, 0x62, 0x87, 0xb5,
+	0xba, 0x98, 0x5a, 0x62, 0xf0, 0xbe, 0x9f, 0xb7, 0xec, 0x34, 0xe5, 0xe0, 0xa3, 0x6b, 0x35, 0xc8,
+	0xa3, 0xb9, 0x61, 0x54, 0x5c, 0x1a, 0x46, 0x1f, 0x43, 0xc3, 0x8f, 0x5c, 0xc7, 0xb7, 0xb3, 0x6f,
+	0xa6, 0x68, 0x81, 0x55, 0x4e, 0x3d, 0x93, 0xc4, 0xdb, 0x71, 0x29, 0xbf, 0x65, 0x5c, 0xd0, 0x03,
+	0xa8, 0x4f, 0x9d, 0x98, 0x7a, 0xae, 0x37, 0x75, 0xd8, 0xd6, 0x59, 0xe1, 0x82, 0x4b, 0x66, 0x2f,
+	0xc5, 0x0d, 0x2f, 0xb1, 0xb3, 0xf9, 0x93, 0xf0, 0x31, 0x6f, 0xdf, 0x44, 0xf1, 0xd5, 0x85, 0x1f,
+	0xdd, 0x24, 0xfa, 0x0a, 0xb7, 0x7f, 0x4d, 0xd0, 0x9f, 0xa4, 0x64, 0xe3, 0xdb, 0x12, 0x54, 0xce,
+	0x45, 0x75, 0xee, 0xe7, 0x46, 0x42, 0xa3, 0xb9, 0x9d, 0xbf, 0x4c, 0x70, 0xf0, 0x00, 0x71, 0x1e,
+	0xf4, 0x01, 0xd4, 0xa8, 0x17, 0x90, 0x84, 0x3a, 0xc1, 0x94, 0x07, 0xb5, 0x88, 0x17, 0x84, 0xef,
+	0x2c, 0xb1, 0x0f, 0xa0, 0x96, 0xed, 0xc2, 0x32, 0x58, 0x0b, 0x02, 0xfa, 0x31, 0xd4, 0x58, 0x7f,
+	0xf1, 0xcd, 0x97, 0x8f, 0x0b, 0xb5, 0xb9, 0x79, 0xab, 0xbb, 0xb8, 0x09, 0xb8, 0x1a, 0x4b, 0x08,
+	0xfd, 0x14, 0x54, 0xde, 0x11, 0x52, 0x48, 0x7c, 0x14, 0xb6, 0x97, 0x3f, 0x0a, 0x69, 0xe7, 0x61,
+	0x58, 0x7c, 0x47, 0xd1, 0x3d, 0x28, 0x5f, 0x73, 0xf3, 0x56, 0xe4, 0x06, 0x9e, 0x77, 0x94, 0xa7,
+	0x42, 0x9c, 0xb3, 0xf5, 0xe6, 0xd7, 0xa2, 0xb2, 0xf4, 0xea, 0xcb, 0xeb, 0x8d, 0x2c, 0x3a, 0x9c,
+	0xf2, 0xb0, 0x05, 0x79, 0x1c, 0xf8, 0xfc, 0x8b, 0x50, 0xc3, 0x0c, 0x44, 0x1f, 0x41, 0xdd, 0x9d,
+	0xc5, 0x31, 0xdf, 0xf9, 0xbd, 0x80, 0xe8, 0x9b, 0x62, 0x0e, 0x4a, 0x9a, 0xe5, 0x05, 0x04, 0xfd,
+	0x1c, 0x1a, 0xbe, 0x93, 0x50, 0xd6, 0x78, 0xd2, 0x91, 0xad, 0x5d, 0xe5, 0x76, 0xf7, 0x89, 0xc6,
+	0x13, 0x9e, 0xa8, 0xfe, 0x02, 0xe1, 0x17, 0xf0, 0x49, 0x23, 0x65, 0xb7, 0xf9, 0xdd, 0xaa, 0xa0,
+	0x09, 0x96, 0x7c, 0x47, 0xbd, 0xf7, 0xaa, 0x8e, 0xd2, 0xf3, 0x03, 0xe2, 0x3e, 0xbc, 0x17, 0x93,
+	0xa9, 0xef, 0xb9, 0xa2, 0x6f, 0x7c, 0x67, 0x92, 0x4d, 0xe7, 0xf7, 0xb9, 0x03, 0x5b, 0xb9, 0xe3,
+	0xbe, 0x33, 0x49, 0x27, 0xf4, 0x25, 0xd4, 0x4f, 0xbd, 0xd0, 0x0b, 0x1c, 0x9f, 0x4f, 0x0b, 0x56,
+	0x05, 0xb9, 0x39, 0x57, 0x0a, 0xdf, 0x7a, 0xc4, 0xa1, 0x1d, 0x50, 0x59, 0x3c, 0xf2, 0x8b, 0x58,
+	0x11, 0xd7, 0xa6, 0x27, 0xe9, 0x0e, 0xd6, 0x82, 0x55, 0x79, 0xd3, 0xc8, 0xbd, 0x24, 0x81, 0x83,
+	0x3e, 0xcd, 0xda, 0x54, 0x8c, 0x1e, 0x7d, 0xb9, 0xc1, 0x17, 0x46, 0xa5, 0x0d, 0x6c, 0xfc, 0xb5,
+	0x00, 0x8d, 0x73, 0xb1, 0x8d, 0xa6, 0x1b, 0xf0, 0xe7, 0xb0, 0x41, 0x2e, 0x2e, 0x88, 0x4b, 0xbd,
+	0x6b, 0x62, 0xbb, 0x8e, 0xef, 0x93, 0xd8, 0x96, 0xe3, 0x44, 0x6d, 0xae, 0x1d, 0x88, 0x7f, 0xa5,
+	0x1d, 0x4e, 0xef, 0x75, 0xf1, 0x7a, 0xc6, 0x2b, 0x49, 0x63, 0x64, 0xc2, 0x86, 0x17, 0x04, 0x64,
+	0xec, 0x39, 0x34, 0xaf, 0x40, 0x7c, 0x7f, 0xb6, 0xa4, 0xa7, 0xe7, 0xd6, 0xb1, 0x43, 0xc9, 0x42,
+	0x4d, 0x26, 0x91, 0xa9, 0xf9, 0x98, 0x39, 0x13, 0x4f, 0xb2, 0xa5, 0x7a, 0x55, 0x4a, 0x5a, 0x9c,
+	0x88, 0xe5, 0xe1, 0xd2, 0xc2, 0x5e, 0xba, 0xb5, 0xb0, 0x2f, 0x76, 0xa5, 0xf2, 0x1b, 0x77, 0xa5,
+	0x5f, 0xc0, 0x9a, 0x98, 0xfd, 0x69, 0x1d, 0xa6, 0xe3, 0xe6, 0x95, 0x1f, 0x80, 0x3a, 0x5d, 0x20,
+	0x89, 0xf1, 0x00, 0xd6, 0xb2, 0x40, 0xca, 0x85, 0x7e, 0x1f, 0x2a, 0xbc, 0x1e, 0xd3, 0x74, 0xa0,
+	0x97, 0x67, 0x09, 0x96, 0x1c, 0xc6, 0x37, 0x05, 0x40, 0xa9, 0x7c, 0x74, 0x93, 0xfc, 0x8f, 0x26,
+	0x63, 0x13, 0xca, 0x9c, 0x2e, 0x33, 0x21, 0x10, 0x16, 0x07, 0x16, 0xd4, 0xe9, 0x55, 0x96, 0x06,
+	0x21, 0xfc, 0x05, 0xfb, 0xc5, 0x24, 0x99, 0xf9, 0x14, 0x4b, 0x0e, 0xe3, 0x4f, 0x0a, 0x6c, 0x2c,
+	0xc5, 0x41, 0xc6, 0x72, 0xd1, 0x31, 0xca, 0x6b, 0x3a, 0x66, 0x0f, 0xaa, 0xd3, 0xab, 0xd7, 0x74,
+	0x56, 0x76, 0xfa, 0x9d, 0xb3, 0x79, 0x07, 0x4a, 0x71, 0x74, 0x93, 0x7e, 0xf8, 0xf3, 0x9b, 0x12,
+	0xa7, 0xb3, 0x75, 0x6b, 0xc9, 0x8f, 0x3c, 0x47, 0x6a, 0xbf, 0x07, 0x6a, 0x6e, 0x4c, 0xb1, 0xb9,
+	0xb6, 0x5c, 0x55, 0x32, 0x75, 0xaf, 0x2c, 0x2a, 0x35, 0x57, 0x54, 0xec, 0x63, 0xe1, 0x46, 0xc1,
+	0xd4, 0x27, 0x94, 0x88, 0x94, 0x55, 0xf1, 0x82, 0x60, 0x7c, 0x09, 0x6a, 0x4e, 0xf2, 0x4d, 0x5b,
+	0xd5, 0x22, 0x09, 0xc5, 0x37, 0x26, 0xe1, 0xef, 0x0a, 0x6c, 0x2d, 0x8a, 0x79, 0xe6, 0xd3, 0xff,
+	0xab, 0x7a, 0x34, 0x62, 0xd8, 0xbe, 0xed, 0xdd, 0x3b, 0x55, 0xd9, 0xf7, 0xa8, 0x9d, 0x7d, 0x0b,
+	0xd4, 0xdc, 0x1f, 0x2e, 0xf6, 0x80, 0xd3, 0x3b, 0x1e, 0x0c, 0xb1, 0xa9, 0xdd, 0x41, 0x55, 0x28,
+	0x8d, 0xac, 0xe1, 0x99, 0xa6, 0x30, 0xc8, 0xfc, 0xd2, 0xec, 0x88, 0x47, 0x21, 0x06, 0xd9, 0x92,
+	0xa9, 0x88, 0x36, 0x41, 0xe3, 0x84, 0xce, 0x70, 0x88, 0xbb, 0xbd, 0x81, 0x78, 0x13, 0xda, 0xff,
+	0xa6, 0x00, 0xb0, 0x58, 0x4a, 0x90, 0x0a, 0x2b, 0x8f, 0x07, 0x27, 0x83, 0xe1, 0x93, 0x81, 0x50,
+	0x7b, 0x6c, 0xf5, 0xba, 0x9a, 0x82, 0x6a, 0x50, 0x16, 0x6f, 0x4f, 0x05, 0x76, 0xaf, 0x7c, 0x78,
+	0x2a, 0xb2, 0x57, 0xa9, 0xec, 0xd5, 0xa9, 0x84, 0x56, 0xa0, 0x98, 0xbd, 0x2d, 0xc9, 0xc7, 0xa4,
+	0x0a, 0x53, 0x88, 0xcd, 0xb3, 0x7e, 0xab, 0x63, 0x6a, 0x2b, 0xec, 0x20, 0x7b, 0x56, 0x02, 0xa8,
+	0xa4, 0x6f, 0x4a, 0x4c, 0x92, 0xbd, 0x44, 0x01, 0xbb, 0x67, 0x68, 0x3d, 0x34, 0xb1, 0xa6, 0x32,
+	0x1a, 0x1e, 0x3e, 0xd1, 0xea, 0x8c, 0x76, 0xd4, 0x33, 0xfb, 0x5d, 0x6d, 0x95, 0x3d, 0x45, 0x3d,
+	0x34, 0x5b, 0xd8, 0x6a, 0x9b, 0x2d, 0x4b, 0x6b, 0xb0, 0x93, 0x73, 0x6e, 0xe0, 0x1a, 0xbb, 0xe6,
+	0xd1, 0xf0, 0x31, 0x1e, 0xb4, 0xfa, 0x9a, 0xc6, 0x90, 0x73, 0x13, 0x8f, 0x7a, 0xc3, 0x81, 0xb6,
+	0xce, 0xee, 0xe9, 0xb7, 0x46, 0xd6, 0xd9, 0x89, 0x86, 0x98, 0xfc, 0xa8, 0x75, 0x6e, 0x9e, 0x0d,
+	0x7b, 0x03, 0x4b, 0xdb, 0xe0, 0xae, 0x3c, 0x6c, 0x0d, 0x8e, 0x4d, 0x6d, 0x73, 0xff, 0x1e, 0xfb,
+	0x12, 0xe6, 0x17, 0x56, 0x80, 0x8a, 0xd5, 0x6a, 0xf7, 0xcd, 0x91, 0x76, 0x87, 0xc1, 0xa3, 0x87,
+	0x2d, 0xdc, 0x1d, 0x69, 0x4a, 0xfb, 0x67, 0xdf, 0xbe, 0xd8, 0x51, 0xfe, 0xf6, 0x62, 0x47, 0xf9,
+	0xc7, 0x8b, 0x1d, 0xe5, 0x77, 0xff, 0xdc, 0xb9, 0xf3, 0xd5, 0xbd, 0x6b, 0x8f, 0x92, 0x24, 0x39,
+	0xf0, 0xa2, 0x43, 0x01, 0x1d, 0x4e, 0xa2, 0xc3, 0x6b, 0x7a, 0xc8, 0x9f, 0x56, 0x0f, 0x17, 0x5d,
+	0xfa, 0xb4, 0xc2, 0x29, 0x3f, 0xf9, 0xcf, 0x00, 0x24, 0x8f, 0xbe, 0x42, 0xb6, 0x15, 0x00, 0x00,
 }
 
 func (m *Charset) Marshal() (dAtA []byte, err error) {
@@ -2739,6 +2938,15 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExcludeColumns) > 0 {
+		for iNdEx := len(m.ExcludeColumns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeColumns[iNdEx])
+			copy(dAtA[i:], m.ExcludeColumns[iNdEx])
+			i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.ExcludeColumns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Filter) > 0 {
 		i -= len(m.Filter)
 		copy(dAtA[i:], m.Filter)
@@ -2826,6 +3034,25 @@ func (m *BinlogSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExternalSink != nil {
+		{
+			size, err := m.ExternalSink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBinlogdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OnDdlStrategy) > 0 {
+		i -= len(m.OnDdlStrategy)
+		copy(dAtA[i:], m.OnDdlStrategy)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.OnDdlStrategy)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.ExternalCluster) > 0 {
 		i -= len(m.ExternalCluster)
 		copy(dAtA[i:], m.ExternalCluster)
@@ -2910,6 +3137,71 @@ func (m *BinlogSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExternalSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxFileAgeSeconds != 0 {
+		i = encodeVarintBinlogdata(dAtA, i, uint64(m.MaxFileAgeSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxFileSize != 0 {
+		i = encodeVarintBinlogdata(dAtA, i, uint64(m.MaxFileSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RowChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3315,6 +3607,40 @@ func (m *VEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReplicationLagSeconds != 0 {
+		i = encodeVarintBinlogdata(dAtA, i, uint64(m.ReplicationLagSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.Shard) > 0 {
+		i -= len(m.Shard)
+		copy(dAtA[i:], m.Shard)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Shard)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.ChangeEvent) > 0 {
+		i -= len(m.ChangeEvent)
+		copy(dAtA[i:], m.ChangeEvent)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.ChangeEvent)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.LastPKEvent != nil {
 		{
 			size, err := m.LastPKEvent.MarshalToSizedBuffer(dAtA[:i])
@@ -3443,21 +3769,21 @@ func (m *MinimalTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PKColumns) > 0 {
-		dAtA18 := make([]byte, len(m.PKColumns)*10)
-		var j17 int
+		dAtA19 := make([]byte, len(m.PKColumns)*10)
+		var j18 int
 		for _, num1 := range m.PKColumns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j17++
+				j18++
 			}
-			dAtA18[j17] = uint8(num)
-			j17++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintBinlogdata(dAtA, i, uint64(j17))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintBinlogdata(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4233,6 +4559,12 @@ func (m *Rule) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBinlogdata(uint64(l))
 	}
+	if len(m.ExcludeColumns) > 0 {
+		for _, s := range m.ExcludeColumns {
+			l = len(s)
+			n += 1 + l + sovBinlogdata(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4305,6 +4637,48 @@ func (m *BinlogSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBinlogdata(uint64(l))
 	}
+	l = len(m.OnDdlStrategy)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	if m.ExternalSink != nil {
+		l = m.ExternalSink.Size()
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
+	if m.MaxFileSize != 0 {
+		n += 1 + sovBinlogdata(uint64(m.MaxFileSize))
+	}
+	if m.MaxFileAgeSeconds != 0 {
+		n += 1 + sovBinlogdata(uint64(m.MaxFileAgeSeconds))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4536,14 +4910,29 @@ func (m *VEvent) Size() (n int) {
 		l = m.LastPKEvent.Size()
 		n += 2 + l + sovBinlogdata(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.ChangeEvent)
+	if l > 0 {
+		n += 2 + l + sovBinlogdata(uint64(l))
 	}
-	return n
-}
-
-func (m *MinimalTable) Size() (n int) {
-	if m == nil {
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 2 + l + sovBinlogdata(uint64(l))
+	}
+	l = len(m.Shard)
+	if l > 0 {
+		n += 2 + l + sovBinlogdata(uint64(l))
+	}
+	if m.ReplicationLagSeconds != 0 {
+		n += 2 + sovBinlogdata(uint64(m.ReplicationLagSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MinimalTable) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -5779,6 +6168,38 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			}
 			m.Filter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeColumns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeColumns = append(m.ExcludeColumns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
@@ -6230,6 +6651,294 @@ func (m *BinlogSource) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDdlStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnDdlStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalSink == nil {
+				m.ExternalSink = &ExternalSink{}
+			}
+			if err := m.ExternalSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBinlogdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBinlogdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileSize", wireType)
+			}
+			m.MaxFileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileAgeSeconds", wireType)
+			}
+			m.MaxFileAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFileAgeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
@@ -7629,6 +8338,121 @@ func (m *VEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeEvent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeEvent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shard = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationLagSeconds", wireType)
+			}
+			m.ReplicationLagSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationLagSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
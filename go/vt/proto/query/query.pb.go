@@ -952,10 +952,14 @@ type ExecuteOptions struct {
 	// has_created_temp_tables signals whether plans created in this session should be cached or not
 	// if the user has created temp tables, Vitess will not reuse plans created for this session in other sessions.
 	// The current session can still use other sessions cached plans.
-	HasCreatedTempTables bool     `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HasCreatedTempTables bool `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
+	// query_tags are key=value tags parsed from the comments of the query
+	// (e.g. in sqlcommenter format). vttablet adds them to its query log and
+	// passes them through to MySQL as a comment.
+	QueryTags            map[string]string `protobuf:"bytes,13,rep,name=query_tags,json=queryTags,proto3" json:"query_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ExecuteOptions) Reset()         { *m = ExecuteOptions{} }
@@ -1047,6 +1051,13 @@ func (m *ExecuteOptions) GetHasCreatedTempTables() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetQueryTags() map[string]string {
+	if m != nil {
+		return m.QueryTags
+	}
+	return nil
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -3322,6 +3333,213 @@ func (m *ReadTransactionResponse) GetMetadata() *TransactionMetadata {
 	return nil
 }
 
+// PreparedTransaction is a transaction which was prepared on a
+// participant of a 2pc transaction. Its redo log is kept until the
+// transaction is committed or rolled back.
+type PreparedTransaction struct {
+	Dtid string `protobuf:"bytes,1,opt,name=dtid,proto3" json:"dtid,omitempty"`
+	// failed is set if the commit of the transaction failed, and can't
+	// be retried.
+	Failed               bool     `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	TimeCreated          int64    `protobuf:"varint,3,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	Queries              []string `protobuf:"bytes,4,rep,name=queries,proto3" json:"queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreparedTransaction) Reset()         { *m = PreparedTransaction{} }
+func (m *PreparedTransaction) String() string { return proto.CompactTextString(m) }
+func (*PreparedTransaction) ProtoMessage()    {}
+func (*PreparedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{41}
+}
+func (m *PreparedTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreparedTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreparedTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreparedTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreparedTransaction.Merge(m, src)
+}
+func (m *PreparedTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreparedTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreparedTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreparedTransaction proto.InternalMessageInfo
+
+func (m *PreparedTransaction) GetDtid() string {
+	if m != nil {
+		return m.Dtid
+	}
+	return ""
+}
+
+func (m *PreparedTransaction) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *PreparedTransaction) GetTimeCreated() int64 {
+	if m != nil {
+		return m.TimeCreated
+	}
+	return 0
+}
+
+func (m *PreparedTransaction) GetQueries() []string {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+// UnresolvedTransactionsRequest is the payload to UnresolvedTransactions
+type UnresolvedTransactionsRequest struct {
+	EffectiveCallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// abandon_age is the minimum age in seconds of the returned transactions.
+	AbandonAge           int64    `protobuf:"varint,4,opt,name=abandon_age,json=abandonAge,proto3" json:"abandon_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnresolvedTransactionsRequest) Reset()         { *m = UnresolvedTransactionsRequest{} }
+func (m *UnresolvedTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnresolvedTransactionsRequest) ProtoMessage()    {}
+func (*UnresolvedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{42}
+}
+func (m *UnresolvedTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnresolvedTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnresolvedTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnresolvedTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnresolvedTransactionsRequest.Merge(m, src)
+}
+func (m *UnresolvedTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnresolvedTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnresolvedTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnresolvedTransactionsRequest proto.InternalMessageInfo
+
+func (m *UnresolvedTransactionsRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *UnresolvedTransactionsRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *UnresolvedTransactionsRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *UnresolvedTransactionsRequest) GetAbandonAge() int64 {
+	if m != nil {
+		return m.AbandonAge
+	}
+	return 0
+}
+
+// UnresolvedTransactionsResponse is the returned value from UnresolvedTransactions
+type UnresolvedTransactionsResponse struct {
+	// transactions are the 2pc transactions coordinated by the tablet.
+	Transactions []*TransactionMetadata `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// prepared are the transactions prepared by the tablet as a participant.
+	Prepared             []*PreparedTransaction `protobuf:"bytes,2,rep,name=prepared,proto3" json:"prepared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UnresolvedTransactionsResponse) Reset()         { *m = UnresolvedTransactionsResponse{} }
+func (m *UnresolvedTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*UnresolvedTransactionsResponse) ProtoMessage()    {}
+func (*UnresolvedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{43}
+}
+func (m *UnresolvedTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnresolvedTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnresolvedTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnresolvedTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnresolvedTransactionsResponse.Merge(m, src)
+}
+func (m *UnresolvedTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnresolvedTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnresolvedTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnresolvedTransactionsResponse proto.InternalMessageInfo
+
+func (m *UnresolvedTransactionsResponse) GetTransactions() []*TransactionMetadata {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *UnresolvedTransactionsResponse) GetPrepared() []*PreparedTransaction {
+	if m != nil {
+		return m.Prepared
+	}
+	return nil
+}
+
 // BeginExecuteRequest is the payload to BeginExecute
 type BeginExecuteRequest struct {
 	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
@@ -3340,7 +3558,7 @@ func (m *BeginExecuteRequest) Reset()         { *m = BeginExecuteRequest{} }
 func (m *BeginExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*BeginExecuteRequest) ProtoMessage()    {}
 func (*BeginExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{41}
+	return fileDescriptor_5c6ac9b241082464, []int{44}
 }
 func (m *BeginExecuteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3437,7 +3655,7 @@ func (m *BeginExecuteResponse) Reset()         { *m = BeginExecuteResponse{} }
 func (m *BeginExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*BeginExecuteResponse) ProtoMessage()    {}
 func (*BeginExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{42}
+	return fileDescriptor_5c6ac9b241082464, []int{45}
 }
 func (m *BeginExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +3729,7 @@ func (m *BeginExecuteBatchRequest) Reset()         { *m = BeginExecuteBatchReque
 func (m *BeginExecuteBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*BeginExecuteBatchRequest) ProtoMessage()    {}
 func (*BeginExecuteBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{43}
+	return fileDescriptor_5c6ac9b241082464, []int{46}
 }
 func (m *BeginExecuteBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3601,7 +3819,7 @@ func (m *BeginExecuteBatchResponse) Reset()         { *m = BeginExecuteBatchResp
 func (m *BeginExecuteBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*BeginExecuteBatchResponse) ProtoMessage()    {}
 func (*BeginExecuteBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{44}
+	return fileDescriptor_5c6ac9b241082464, []int{47}
 }
 func (m *BeginExecuteBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3674,7 +3892,7 @@ func (m *MessageStreamRequest) Reset()         { *m = MessageStreamRequest{} }
 func (m *MessageStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*MessageStreamRequest) ProtoMessage()    {}
 func (*MessageStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{45}
+	return fileDescriptor_5c6ac9b241082464, []int{48}
 }
 func (m *MessageStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3743,7 +3961,7 @@ func (m *MessageStreamResponse) Reset()         { *m = MessageStreamResponse{} }
 func (m *MessageStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*MessageStreamResponse) ProtoMessage()    {}
 func (*MessageStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{46}
+	return fileDescriptor_5c6ac9b241082464, []int{49}
 }
 func (m *MessageStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4014,7 @@ func (m *MessageAckRequest) Reset()         { *m = MessageAckRequest{} }
 func (m *MessageAckRequest) String() string { return proto.CompactTextString(m) }
 func (*MessageAckRequest) ProtoMessage()    {}
 func (*MessageAckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{47}
+	return fileDescriptor_5c6ac9b241082464, []int{50}
 }
 func (m *MessageAckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4093,7 @@ func (m *MessageAckResponse) Reset()         { *m = MessageAckResponse{} }
 func (m *MessageAckResponse) String() string { return proto.CompactTextString(m) }
 func (*MessageAckResponse) ProtoMessage()    {}
 func (*MessageAckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{48}
+	return fileDescriptor_5c6ac9b241082464, []int{51}
 }
 func (m *MessageAckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3929,7 +4147,7 @@ func (m *ReserveExecuteRequest) Reset()         { *m = ReserveExecuteRequest{} }
 func (m *ReserveExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*ReserveExecuteRequest) ProtoMessage()    {}
 func (*ReserveExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{49}
+	return fileDescriptor_5c6ac9b241082464, []int{52}
 }
 func (m *ReserveExecuteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4023,7 +4241,7 @@ func (m *ReserveExecuteResponse) Reset()         { *m = ReserveExecuteResponse{}
 func (m *ReserveExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*ReserveExecuteResponse) ProtoMessage()    {}
 func (*ReserveExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{50}
+	return fileDescriptor_5c6ac9b241082464, []int{53}
 }
 func (m *ReserveExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4097,7 +4315,7 @@ func (m *ReserveBeginExecuteRequest) Reset()         { *m = ReserveBeginExecuteR
 func (m *ReserveBeginExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*ReserveBeginExecuteRequest) ProtoMessage()    {}
 func (*ReserveBeginExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{51}
+	return fileDescriptor_5c6ac9b241082464, []int{54}
 }
 func (m *ReserveBeginExecuteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4406,7 @@ func (m *ReserveBeginExecuteResponse) Reset()         { *m = ReserveBeginExecute
 func (m *ReserveBeginExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*ReserveBeginExecuteResponse) ProtoMessage()    {}
 func (*ReserveBeginExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{52}
+	return fileDescriptor_5c6ac9b241082464, []int{55}
 }
 func (m *ReserveBeginExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4268,7 +4486,7 @@ func (m *ReleaseRequest) Reset()         { *m = ReleaseRequest{} }
 func (m *ReleaseRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseRequest) ProtoMessage()    {}
 func (*ReleaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{53}
+	return fileDescriptor_5c6ac9b241082464, []int{56}
 }
 func (m *ReleaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4343,7 +4561,7 @@ func (m *ReleaseResponse) Reset()         { *m = ReleaseResponse{} }
 func (m *ReleaseResponse) String() string { return proto.CompactTextString(m) }
 func (*ReleaseResponse) ProtoMessage()    {}
 func (*ReleaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{54}
+	return fileDescriptor_5c6ac9b241082464, []int{57}
 }
 func (m *ReleaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4383,7 +4601,7 @@ func (m *StreamHealthRequest) Reset()         { *m = StreamHealthRequest{} }
 func (m *StreamHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamHealthRequest) ProtoMessage()    {}
 func (*StreamHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{55}
+	return fileDescriptor_5c6ac9b241082464, []int{58}
 }
 func (m *StreamHealthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4441,7 +4659,11 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_schema_changed is set by a master tablet when the schema of some of
+	// its tables changed. It lists the names of those tables, and is only sent
+	// along with the health update that notifies of the change.
+	TableSchemaChanged   []string `protobuf:"bytes,7,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4451,7 +4673,7 @@ func (m *RealtimeStats) Reset()         { *m = RealtimeStats{} }
 func (m *RealtimeStats) String() string { return proto.CompactTextString(m) }
 func (*RealtimeStats) ProtoMessage()    {}
 func (*RealtimeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{56}
+	return fileDescriptor_5c6ac9b241082464, []int{59}
 }
 func (m *RealtimeStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4522,6 +4744,13 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4548,7 +4777,7 @@ func (m *AggregateStats) Reset()         { *m = AggregateStats{} }
 func (m *AggregateStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateStats) ProtoMessage()    {}
 func (*AggregateStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{57}
+	return fileDescriptor_5c6ac9b241082464, []int{60}
 }
 func (m *AggregateStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4662,7 +4891,7 @@ func (m *StreamHealthResponse) Reset()         { *m = StreamHealthResponse{} }
 func (m *StreamHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamHealthResponse) ProtoMessage()    {}
 func (*StreamHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{58}
+	return fileDescriptor_5c6ac9b241082464, []int{61}
 }
 func (m *StreamHealthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4741,7 +4970,7 @@ func (m *TransactionMetadata) Reset()         { *m = TransactionMetadata{} }
 func (m *TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*TransactionMetadata) ProtoMessage()    {}
 func (*TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{59}
+	return fileDescriptor_5c6ac9b241082464, []int{62}
 }
 func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4816,6 +5045,7 @@ func init() {
 	proto.RegisterType((*BoundQuery)(nil), "query.BoundQuery")
 	proto.RegisterMapType((map[string]*BindVariable)(nil), "query.BoundQuery.BindVariablesEntry")
 	proto.RegisterType((*ExecuteOptions)(nil), "query.ExecuteOptions")
+	proto.RegisterMapType((map[string]string)(nil), "query.ExecuteOptions.QueryTagsEntry")
 	proto.RegisterType((*Field)(nil), "query.Field")
 	proto.RegisterType((*Row)(nil), "query.Row")
 	proto.RegisterType((*QueryResult)(nil), "query.QueryResult")
@@ -4851,6 +5081,9 @@ func init() {
 	proto.RegisterType((*ConcludeTransactionResponse)(nil), "query.ConcludeTransactionResponse")
 	proto.RegisterType((*ReadTransactionRequest)(nil), "query.ReadTransactionRequest")
 	proto.RegisterType((*ReadTransactionResponse)(nil), "query.ReadTransactionResponse")
+	proto.RegisterType((*PreparedTransaction)(nil), "query.PreparedTransaction")
+	proto.RegisterType((*UnresolvedTransactionsRequest)(nil), "query.UnresolvedTransactionsRequest")
+	proto.RegisterType((*UnresolvedTransactionsResponse)(nil), "query.UnresolvedTransactionsResponse")
 	proto.RegisterType((*BeginExecuteRequest)(nil), "query.BeginExecuteRequest")
 	proto.RegisterType((*BeginExecuteResponse)(nil), "query.BeginExecuteResponse")
 	proto.RegisterType((*BeginExecuteBatchRequest)(nil), "query.BeginExecuteBatchRequest")
@@ -4875,214 +5108,224 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x90, 0x1b, 0x49,
-	0x56, 0xee, 0x2a, 0xfd, 0xb4, 0xf4, 0xd4, 0x52, 0x67, 0x67, 0x77, 0xdb, 0x9a, 0xf6, 0x8c, 0xa7,
-	0xb7, 0x76, 0x67, 0xd7, 0x18, 0x68, 0x7b, 0xda, 0x5e, 0x63, 0x66, 0x17, 0x98, 0x6a, 0x75, 0xb5,
-	0x47, 0xb6, 0x54, 0x92, 0x53, 0x25, 0x7b, 0x3d, 0x41, 0x44, 0x45, 0x59, 0x4a, 0xab, 0x2b, 0xba,
-	0x54, 0xa5, 0xae, 0xaa, 0x6e, 0x8f, 0x6e, 0x86, 0x65, 0x59, 0xfe, 0x59, 0xfe, 0x77, 0xd9, 0x60,
-	0x83, 0x08, 0x0e, 0x04, 0x17, 0x22, 0xb8, 0x71, 0xe6, 0x30, 0x41, 0x70, 0x20, 0xe0, 0x08, 0x1c,
-	0x58, 0x86, 0x20, 0xe0, 0xb4, 0x41, 0x70, 0xe0, 0xc0, 0x81, 0x20, 0xf2, 0xa7, 0x4a, 0x52, 0xb7,
-	0xc6, 0xee, 0xf5, 0x32, 0xb1, 0x61, 0x8f, 0x6f, 0xf9, 0x7e, 0xf2, 0xe7, 0x7d, 0xf9, 0xf2, 0xbd,
-	0x57, 0xa9, 0x14, 0x94, 0x0e, 0x8f, 0x68, 0x38, 0xde, 0x1a, 0x85, 0x41, 0x1c, 0xe0, 0x1c, 0x27,
-	0x36, 0x2a, 0x71, 0x30, 0x0a, 0xfa, 0x4e, 0xec, 0x08, 0xf6, 0x46, 0xe9, 0x38, 0x0e, 0x47, 0x3d,
-	0x41, 0x68, 0x5f, 0x53, 0x20, 0x6f, 0x39, 0xe1, 0x80, 0xc6, 0x78, 0x03, 0x0a, 0x07, 0x74, 0x1c,
-	0x8d, 0x9c, 0x1e, 0xad, 0x2a, 0x9b, 0xca, 0xa5, 0x22, 0x49, 0x69, 0xbc, 0x06, 0xb9, 0x68, 0xdf,
-	0x09, 0xfb, 0x55, 0x95, 0x0b, 0x04, 0x81, 0xbf, 0x08, 0xa5, 0xd8, 0x79, 0xe8, 0xd1, 0xd8, 0x8e,
-	0xc7, 0x23, 0x5a, 0xcd, 0x6c, 0x2a, 0x97, 0x2a, 0xdb, 0x6b, 0x5b, 0xe9, 0x7c, 0x16, 0x17, 0x5a,
-	0xe3, 0x11, 0x25, 0x10, 0xa7, 0x6d, 0x8c, 0x21, 0xdb, 0xa3, 0x9e, 0x57, 0xcd, 0xf2, 0xb1, 0x78,
-	0x5b, 0xdb, 0x85, 0xca, 0x3d, 0xeb, 0x96, 0x13, 0xd3, 0x9a, 0xe3, 0x79, 0x34, 0xac, 0xef, 0xb2,
-	0xe5, 0x1c, 0x45, 0x34, 0xf4, 0x9d, 0x61, 0xba, 0x9c, 0x84, 0xc6, 0xe7, 0x20, 0x3f, 0x08, 0x83,
-	0xa3, 0x51, 0x54, 0x55, 0x37, 0x33, 0x97, 0x8a, 0x44, 0x52, 0xda, 0xcf, 0x02, 0x18, 0xc7, 0xd4,
-	0x8f, 0xad, 0xe0, 0x80, 0xfa, 0xf8, 0x75, 0x28, 0xc6, 0xee, 0x90, 0x46, 0xb1, 0x33, 0x1c, 0xf1,
-	0x21, 0x32, 0x64, 0xc2, 0xf8, 0x18, 0x93, 0x36, 0xa0, 0x30, 0x0a, 0x22, 0x37, 0x76, 0x03, 0x9f,
-	0xdb, 0x53, 0x24, 0x29, 0xad, 0xfd, 0x34, 0xe4, 0xee, 0x39, 0xde, 0x11, 0xc5, 0x6f, 0x42, 0x96,
-	0x1b, 0xac, 0x70, 0x83, 0x4b, 0x5b, 0x02, 0x74, 0x6e, 0x27, 0x17, 0xb0, 0xb1, 0x8f, 0x99, 0x26,
-	0x1f, 0x7b, 0x89, 0x08, 0x42, 0x3b, 0x80, 0xa5, 0x1d, 0xd7, 0xef, 0xdf, 0x73, 0x42, 0x97, 0x81,
-	0xf1, 0x9c, 0xc3, 0xe0, 0xcf, 0x41, 0x9e, 0x37, 0xa2, 0x6a, 0x66, 0x33, 0x73, 0xa9, 0xb4, 0xbd,
-	0x24, 0x3b, 0xf2, 0xb5, 0x11, 0x29, 0xd3, 0xfe, 0x4a, 0x01, 0xd8, 0x09, 0x8e, 0xfc, 0xfe, 0x5d,
-	0x26, 0xc4, 0x08, 0x32, 0xd1, 0xa1, 0x27, 0x81, 0x64, 0x4d, 0x7c, 0x07, 0x2a, 0x0f, 0x5d, 0xbf,
-	0x6f, 0x1f, 0xcb, 0xe5, 0x08, 0x2c, 0x4b, 0xdb, 0x9f, 0x93, 0xc3, 0x4d, 0x3a, 0x6f, 0x4d, 0xaf,
-	0x3a, 0x32, 0xfc, 0x38, 0x1c, 0x93, 0xf2, 0xc3, 0x69, 0xde, 0x46, 0x17, 0xf0, 0x69, 0x25, 0x36,
-	0xe9, 0x01, 0x1d, 0x27, 0x93, 0x1e, 0xd0, 0x31, 0xfe, 0x91, 0x69, 0x8b, 0x4a, 0xdb, 0xab, 0xc9,
-	0x5c, 0x53, 0x7d, 0xa5, 0x99, 0xef, 0xa8, 0x37, 0x15, 0xed, 0x2f, 0x16, 0xa1, 0x62, 0x7c, 0x40,
-	0x7b, 0x47, 0x31, 0x6d, 0x8d, 0xd8, 0x1e, 0x44, 0xb8, 0x09, 0xcb, 0xae, 0xdf, 0xf3, 0x8e, 0xfa,
-	0xb4, 0x6f, 0x3f, 0x72, 0xa9, 0xd7, 0x8f, 0xb8, 0x1f, 0x55, 0xd2, 0x75, 0xcf, 0xea, 0x6f, 0xd5,
-	0xa5, 0xf2, 0x1e, 0xd7, 0x25, 0x15, 0x77, 0x86, 0xc6, 0x97, 0x61, 0xa5, 0xe7, 0xb9, 0xd4, 0x8f,
-	0xed, 0x47, 0xcc, 0x5e, 0x3b, 0x0c, 0x1e, 0x47, 0xd5, 0xdc, 0xa6, 0x72, 0xa9, 0x40, 0x96, 0x85,
-	0x60, 0x8f, 0xf1, 0x49, 0xf0, 0x38, 0xc2, 0xef, 0x40, 0xe1, 0x71, 0x10, 0x1e, 0x78, 0x81, 0xd3,
-	0xaf, 0xe6, 0xf9, 0x9c, 0x17, 0xe7, 0xcf, 0x79, 0x5f, 0x6a, 0x91, 0x54, 0x1f, 0x5f, 0x02, 0x14,
-	0x1d, 0x7a, 0x76, 0x44, 0x3d, 0xda, 0x8b, 0x6d, 0xcf, 0x1d, 0xba, 0x71, 0xb5, 0xc0, 0x5d, 0xb2,
-	0x12, 0x1d, 0x7a, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x3d, 0x0e, 0x1d, 0x3f, 0x72, 0x7a,
-	0x6c, 0x30, 0xdb, 0x8d, 0x02, 0xcf, 0xe1, 0xee, 0x58, 0xe4, 0x53, 0x5e, 0x9e, 0x3f, 0xa5, 0x35,
-	0xe9, 0x52, 0x4f, 0x7a, 0x90, 0xb5, 0x78, 0x0e, 0x17, 0xbf, 0x0d, 0xeb, 0xd1, 0x81, 0x3b, 0xb2,
-	0xf9, 0x38, 0xf6, 0xc8, 0x73, 0x7c, 0xbb, 0xe7, 0xf4, 0xf6, 0x69, 0x15, 0xb8, 0xd9, 0x98, 0x09,
-	0xf9, 0xbe, 0xb7, 0x3d, 0xc7, 0xaf, 0x31, 0x09, 0x03, 0x9d, 0xe9, 0xf9, 0x34, 0xb4, 0x8f, 0x69,
-	0x18, 0xb1, 0xd5, 0x94, 0x9e, 0x06, 0x7a, 0x5b, 0x28, 0xdf, 0x13, 0xba, 0xa4, 0x32, 0x9a, 0xa1,
-	0xf1, 0x17, 0xe1, 0xfc, 0xbe, 0x13, 0xd9, 0xbd, 0x90, 0x3a, 0x31, 0xed, 0xdb, 0x31, 0x1d, 0x8e,
-	0xec, 0x58, 0xf8, 0xe0, 0x12, 0x5f, 0xc3, 0xda, 0xbe, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11,
-	0x8f, 0x23, 0x91, 0xf6, 0x25, 0xa8, 0xcc, 0xee, 0x26, 0x5e, 0x81, 0xb2, 0xf5, 0xa0, 0x6d, 0xd8,
-	0xba, 0xb9, 0x6b, 0x9b, 0x7a, 0xd3, 0x40, 0x0b, 0xb8, 0x0c, 0x45, 0xce, 0x6a, 0x99, 0x8d, 0x07,
-	0x48, 0xc1, 0x8b, 0x90, 0xd1, 0x1b, 0x0d, 0xa4, 0x6a, 0x37, 0xa1, 0x90, 0x6c, 0x0b, 0x5e, 0x86,
-	0x52, 0xd7, 0xec, 0xb4, 0x8d, 0x5a, 0x7d, 0xaf, 0x6e, 0xec, 0xa2, 0x05, 0x5c, 0x80, 0x6c, 0xab,
-	0x61, 0xb5, 0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9, 0xac, 0xe7, 0xee, 0x8e, 0x8e, 0x32, 0xda, 0x9f,
-	0x2a, 0xb0, 0x36, 0x0f, 0x5e, 0x5c, 0x82, 0xc5, 0x5d, 0x63, 0x4f, 0xef, 0x36, 0x2c, 0xb4, 0x80,
-	0x57, 0x61, 0x99, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0x9d, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x45, 0x0a,
-	0xc6, 0x50, 0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x22, 0x15, 0xaf, 0x01,
-	0xe2, 0xbc, 0xae, 0x39, 0xe1, 0x66, 0x30, 0x82, 0xa5, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x3e,
-	0x1b, 0x00, 0x65, 0xf1, 0x67, 0xe0, 0x8d, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76,
-	0xc7, 0xd4, 0xdb, 0x9d, 0xf7, 0x5a, 0x16, 0x1f, 0x59, 0x18, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b,
-	0xb5, 0xc4, 0x38, 0x28, 0xaf, 0x1d, 0x42, 0x65, 0x16, 0x79, 0xb6, 0x2a, 0xb9, 0x44, 0xbb, 0xdd,
-	0xd0, 0x4d, 0xd3, 0x20, 0x68, 0x01, 0xe7, 0x41, 0xbd, 0x77, 0x4d, 0xd8, 0x7a, 0x8b, 0xfa, 0xd7,
-	0x91, 0xca, 0x06, 0x62, 0xad, 0x5b, 0x21, 0xa5, 0xfd, 0x31, 0xca, 0xb0, 0x75, 0x33, 0xba, 0x41,
-	0x1f, 0xc5, 0xdb, 0xc4, 0x1d, 0xec, 0xc7, 0x28, 0xcb, 0xd6, 0xcd, 0x78, 0xf7, 0xdd, 0x78, 0x7f,
-	0xcf, 0xf1, 0xbc, 0x87, 0x4e, 0xef, 0x00, 0xe5, 0x6e, 0x67, 0x0b, 0x0a, 0x52, 0x6f, 0x67, 0x0b,
-	0x2a, 0xca, 0xdc, 0xce, 0x16, 0x32, 0x28, 0xab, 0xfd, 0xa5, 0x0a, 0x39, 0xbe, 0x3d, 0x2c, 0xce,
-	0x4f, 0x45, 0x6f, 0xde, 0x4e, 0x63, 0x9e, 0xfa, 0x94, 0x98, 0xc7, 0x5d, 0x41, 0x46, 0x5f, 0x41,
-	0xe0, 0x0b, 0x50, 0x0c, 0xc2, 0x81, 0x70, 0x12, 0x99, 0x37, 0x0a, 0x41, 0x38, 0xe0, 0x8e, 0xc1,
-	0x62, 0x36, 0x4b, 0x37, 0x0f, 0x9d, 0x88, 0xf2, 0xa3, 0x5b, 0x24, 0x29, 0x8d, 0x5f, 0x03, 0xa6,
-	0x67, 0xf3, 0x75, 0xe4, 0xb9, 0x6c, 0x31, 0x08, 0x07, 0x26, 0x5b, 0xca, 0x67, 0xa1, 0xdc, 0x0b,
-	0xbc, 0xa3, 0xa1, 0x6f, 0x7b, 0xd4, 0x1f, 0xc4, 0xfb, 0xd5, 0xc5, 0x4d, 0xe5, 0x52, 0x99, 0x2c,
-	0x09, 0x66, 0x83, 0xf3, 0x70, 0x15, 0x16, 0x7b, 0xfb, 0x4e, 0x18, 0x51, 0x71, 0x5c, 0xcb, 0x24,
-	0x21, 0xf9, 0xac, 0xb4, 0xe7, 0x0e, 0x1d, 0x2f, 0xe2, 0x47, 0xb3, 0x4c, 0x52, 0x9a, 0x19, 0xf1,
-	0xc8, 0x73, 0x06, 0x11, 0x3f, 0x52, 0x65, 0x22, 0x08, 0xfc, 0x26, 0x94, 0xe4, 0x84, 0x1c, 0x82,
-	0x12, 0x5f, 0x0e, 0x08, 0x16, 0x43, 0x40, 0xfb, 0x09, 0xc8, 0x90, 0xe0, 0x31, 0x9b, 0x53, 0xac,
-	0x28, 0xaa, 0x2a, 0x9b, 0x99, 0x4b, 0x98, 0x24, 0x24, 0xcb, 0x7b, 0x32, 0xf4, 0x8b, 0x8c, 0x90,
-	0x04, 0xfb, 0x6f, 0x2b, 0x50, 0xe2, 0x47, 0x96, 0xd0, 0xe8, 0xc8, 0x8b, 0x59, 0x8a, 0x90, 0xb1,
-	0x51, 0x99, 0x49, 0x11, 0x7c, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0xb8, 0xb3, 0x9d, 0x47, 0x8f,
-	0x68, 0x2f, 0xa6, 0x22, 0x13, 0x66, 0xc9, 0x12, 0x63, 0xea, 0x92, 0xc7, 0x90, 0x77, 0xfd, 0x88,
-	0x86, 0xb1, 0xed, 0xf6, 0xf9, 0x9e, 0x64, 0x49, 0x41, 0x30, 0xea, 0x7d, 0x7c, 0x11, 0xb2, 0x3c,
-	0x60, 0x66, 0xf9, 0x2c, 0x20, 0x67, 0x21, 0xc1, 0x63, 0xc2, 0xf9, 0xb7, 0xb3, 0x85, 0x1c, 0xca,
-	0x6b, 0x5f, 0x86, 0x25, 0xbe, 0xb8, 0xfb, 0x4e, 0xe8, 0xbb, 0xfe, 0x80, 0xe7, 0xff, 0xa0, 0x2f,
-	0xfc, 0xa2, 0x4c, 0x78, 0x9b, 0xd9, 0x3c, 0xa4, 0x51, 0xe4, 0x0c, 0xa8, 0xcc, 0xc7, 0x09, 0xa9,
-	0xfd, 0x71, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce, 0x90, 0xa7, 0x76, 0xfc, 0x65, 0x80, 0x28, 0x76,
-	0x62, 0x3a, 0xa4, 0x7e, 0x9c, 0xd8, 0xf7, 0xba, 0x9c, 0x79, 0x4a, 0x6f, 0xab, 0x93, 0x28, 0x91,
-	0x29, 0x7d, 0xbc, 0x0d, 0x25, 0xca, 0xc4, 0x76, 0xcc, 0x4a, 0x04, 0x99, 0x86, 0x56, 0x92, 0x28,
-	0x96, 0xd6, 0x0e, 0x04, 0x68, 0xda, 0xde, 0xf8, 0x8e, 0x0a, 0xc5, 0x74, 0x34, 0xac, 0x43, 0xa1,
-	0xe7, 0xc4, 0x74, 0x10, 0x84, 0x63, 0x99, 0xb9, 0xdf, 0x7a, 0xda, 0xec, 0x5b, 0x35, 0xa9, 0x4c,
-	0xd2, 0x6e, 0xf8, 0x0d, 0x10, 0xe5, 0x90, 0x70, 0x4b, 0x61, 0x6f, 0x91, 0x73, 0xb8, 0x63, 0xbe,
-	0x03, 0x78, 0x14, 0xba, 0x43, 0x27, 0x1c, 0xdb, 0x07, 0x74, 0x9c, 0x64, 0xb9, 0xcc, 0x9c, 0x9d,
-	0x44, 0x52, 0xef, 0x0e, 0x1d, 0xcb, 0x88, 0x78, 0x73, 0xb6, 0xaf, 0xf4, 0x96, 0xd3, 0xfb, 0x33,
-	0xd5, 0x93, 0xd7, 0x0d, 0x51, 0x52, 0x21, 0xe4, 0xb8, 0x63, 0xb1, 0xa6, 0xf6, 0x05, 0x28, 0x24,
-	0x8b, 0xc7, 0x45, 0xc8, 0x19, 0x61, 0x18, 0x84, 0x68, 0x81, 0x07, 0xc6, 0x66, 0x43, 0xc4, 0xd6,
-	0xdd, 0x5d, 0x16, 0x5b, 0xff, 0x45, 0x4d, 0xd3, 0x34, 0xa1, 0x87, 0x47, 0x34, 0x8a, 0xf1, 0xcf,
-	0xc0, 0x2a, 0xe5, 0x2e, 0xe4, 0x1e, 0x53, 0xbb, 0xc7, 0x6b, 0x3a, 0xe6, 0x40, 0x0a, 0xc7, 0x7b,
-	0x79, 0x4b, 0x94, 0xa0, 0x49, 0xad, 0x47, 0x56, 0x52, 0x5d, 0xc9, 0xea, 0x63, 0x03, 0x56, 0xdd,
-	0xe1, 0x90, 0xf6, 0x5d, 0x27, 0x9e, 0x1e, 0x40, 0x6c, 0xd8, 0x7a, 0x52, 0xf2, 0xcc, 0x94, 0x8c,
-	0x64, 0x25, 0xed, 0x91, 0x0e, 0xf3, 0x16, 0xe4, 0x63, 0x5e, 0xde, 0x72, 0xdf, 0x2d, 0x6d, 0x97,
-	0x93, 0x88, 0xc3, 0x99, 0x44, 0x0a, 0xf1, 0x17, 0x40, 0x14, 0xcb, 0x3c, 0xb6, 0x4c, 0x1c, 0x62,
-	0x52, 0x03, 0x11, 0x21, 0xc7, 0x6f, 0x41, 0x65, 0x26, 0x3b, 0xf7, 0x39, 0x60, 0x19, 0x52, 0x9e,
-	0x4e, 0xb5, 0x7d, 0x7c, 0x05, 0x16, 0x03, 0x91, 0x0b, 0x79, 0xd4, 0x99, 0xac, 0x78, 0x36, 0x51,
-	0x92, 0x44, 0x8b, 0xc5, 0x86, 0x90, 0x46, 0x34, 0x3c, 0xa6, 0x7d, 0x36, 0xe8, 0x22, 0x1f, 0x14,
-	0x12, 0x56, 0xbd, 0xaf, 0xfd, 0x14, 0x2c, 0xa7, 0x10, 0x47, 0xa3, 0xc0, 0x8f, 0x28, 0xbe, 0x0c,
-	0xf9, 0x90, 0x9f, 0x77, 0x09, 0x2b, 0x96, 0x73, 0x4c, 0x45, 0x02, 0x22, 0x35, 0xb4, 0x3e, 0x2c,
-	0x0b, 0x0e, 0x8b, 0xdf, 0x7c, 0x27, 0xf1, 0x5b, 0x90, 0xa3, 0xac, 0x71, 0x62, 0x53, 0x48, 0xbb,
-	0xc6, 0xe5, 0x44, 0x48, 0xa7, 0x66, 0x51, 0x9f, 0x39, 0xcb, 0x7f, 0xaa, 0xb0, 0x2a, 0x57, 0xb9,
-	0xe3, 0xc4, 0xbd, 0xfd, 0x17, 0xd4, 0x1b, 0x7e, 0x14, 0x16, 0x19, 0xdf, 0x4d, 0x4f, 0xce, 0x1c,
-	0x7f, 0x48, 0x34, 0x98, 0x47, 0x38, 0x91, 0x3d, 0xb5, 0xfd, 0xb2, 0x7c, 0x2c, 0x3b, 0xd1, 0x54,
-	0xd5, 0x30, 0xc7, 0x71, 0xf2, 0xcf, 0x70, 0x9c, 0xc5, 0xb3, 0x38, 0x8e, 0xb6, 0x0b, 0x6b, 0xb3,
-	0x88, 0x4b, 0xe7, 0xf8, 0x31, 0x58, 0x14, 0x9b, 0x92, 0xc4, 0xc8, 0x79, 0xfb, 0x96, 0xa8, 0x68,
-	0x1f, 0xaa, 0xb0, 0x26, 0xc3, 0xd7, 0xa7, 0xe3, 0x1c, 0x4f, 0xe1, 0x9c, 0x3b, 0xd3, 0x01, 0x3d,
-	0xdb, 0xfe, 0x69, 0x35, 0x58, 0x3f, 0x81, 0xe3, 0x73, 0x1c, 0xd6, 0xef, 0x29, 0xb0, 0xb4, 0x43,
-	0x07, 0xae, 0xff, 0x82, 0xee, 0xc2, 0x14, 0xb8, 0xd9, 0x33, 0x39, 0xf1, 0x08, 0xca, 0xd2, 0x5e,
-	0x89, 0xd6, 0x69, 0xb4, 0x95, 0x79, 0xa7, 0xe5, 0x26, 0x2c, 0xc9, 0x0b, 0x08, 0xc7, 0x73, 0x9d,
-	0x28, 0xb5, 0xe7, 0xc4, 0x0d, 0x84, 0xce, 0x84, 0x44, 0xde, 0x55, 0x70, 0x42, 0xfb, 0x37, 0x05,
-	0xca, 0xb5, 0x60, 0x38, 0x74, 0xe3, 0x17, 0x14, 0xe3, 0xd3, 0x08, 0x65, 0xe7, 0xf9, 0xe3, 0xdb,
-	0x50, 0x49, 0xcc, 0x94, 0xd0, 0x9e, 0xc8, 0x34, 0xca, 0xa9, 0x4c, 0xf3, 0xef, 0x0a, 0x2c, 0x93,
-	0x40, 0x54, 0xf8, 0x2f, 0x37, 0x38, 0xd7, 0x00, 0x4d, 0x0c, 0x3d, 0x2b, 0x3c, 0xff, 0xa3, 0x40,
-	0xa5, 0x1d, 0xd2, 0x91, 0x13, 0xd2, 0x97, 0x1a, 0x1d, 0x56, 0xa6, 0xf7, 0x63, 0x59, 0xe0, 0x14,
-	0x09, 0x6f, 0x6b, 0x2b, 0xb0, 0x9c, 0xda, 0x2e, 0x00, 0xd3, 0xfe, 0x51, 0x81, 0x75, 0xe1, 0x62,
-	0x52, 0xd2, 0x7f, 0x41, 0x61, 0x49, 0xec, 0xcd, 0x4e, 0xd9, 0x5b, 0x85, 0x73, 0x27, 0x6d, 0x93,
-	0x66, 0x7f, 0x55, 0x85, 0xf3, 0x89, 0xf3, 0xbc, 0xe0, 0x86, 0xff, 0x00, 0xfe, 0xb0, 0x01, 0xd5,
-	0xd3, 0x20, 0x48, 0x84, 0xbe, 0xa1, 0x42, 0x55, 0x5c, 0xe2, 0x4c, 0xd5, 0x41, 0x2f, 0x8f, 0x6f,
-	0xe0, 0xb7, 0x61, 0x69, 0xe4, 0x84, 0xb1, 0xdb, 0x73, 0x47, 0x0e, 0xfb, 0x14, 0xcd, 0xf1, 0x32,
-	0xeb, 0xc4, 0x00, 0x33, 0x2a, 0xda, 0x05, 0x78, 0x6d, 0x0e, 0x22, 0x12, 0xaf, 0xff, 0x55, 0x00,
-	0x77, 0x62, 0x27, 0x8c, 0x3f, 0x05, 0x79, 0x69, 0xae, 0x33, 0xad, 0xc3, 0xea, 0x8c, 0xfd, 0xd3,
-	0xb8, 0xd0, 0xf8, 0x53, 0x91, 0x92, 0x3e, 0x16, 0x97, 0x69, 0xfb, 0x25, 0x2e, 0xff, 0xac, 0xc0,
-	0x46, 0x2d, 0x10, 0x17, 0xa2, 0x2f, 0xe5, 0x09, 0xd3, 0xde, 0x80, 0x0b, 0x73, 0x0d, 0x94, 0x00,
-	0xfc, 0x93, 0x02, 0xe7, 0x08, 0x75, 0xfa, 0x2f, 0xa7, 0xf1, 0x77, 0xe1, 0xfc, 0x29, 0xe3, 0x64,
-	0x8d, 0x72, 0x03, 0x0a, 0x43, 0x1a, 0x3b, 0xac, 0xc2, 0x95, 0x26, 0x6d, 0x24, 0xe3, 0x4e, 0xb4,
-	0x9b, 0x52, 0x83, 0xa4, 0xba, 0xda, 0x77, 0x55, 0x58, 0xe5, 0x75, 0xf6, 0xab, 0x8f, 0xbc, 0x33,
-	0xdd, 0xc2, 0xe4, 0x4f, 0x16, 0x7f, 0x4c, 0x61, 0x14, 0x52, 0x3b, 0xb9, 0x1d, 0x58, 0xe4, 0xbf,
-	0x3e, 0xc2, 0x28, 0xa4, 0x77, 0x05, 0x47, 0xfb, 0x1b, 0x05, 0xd6, 0x66, 0x21, 0x4e, 0xbf, 0x68,
-	0xfe, 0xbf, 0x6f, 0x5b, 0xe6, 0x84, 0x94, 0xcc, 0x59, 0x3e, 0x92, 0xb2, 0x67, 0xfe, 0x48, 0xfa,
-	0x5b, 0x15, 0xaa, 0xd3, 0xc6, 0xbc, 0xba, 0xd3, 0x99, 0xbd, 0xd3, 0xf9, 0x7e, 0x6f, 0xf9, 0xb4,
-	0xbf, 0x57, 0xe0, 0xb5, 0x39, 0x80, 0x7e, 0x7f, 0x2e, 0x32, 0x75, 0xb3, 0xa3, 0x3e, 0xf3, 0x66,
-	0xe7, 0x93, 0x77, 0x92, 0x7f, 0x50, 0x60, 0xad, 0x29, 0xee, 0xea, 0xc5, 0xcd, 0xc7, 0x8b, 0x1b,
-	0x83, 0xf9, 0x75, 0x7c, 0x76, 0xf2, 0x6b, 0x95, 0x56, 0x83, 0xf5, 0x13, 0xa6, 0x3d, 0xc7, 0x6d,
-	0xce, 0x7f, 0x2b, 0xb0, 0x22, 0x47, 0xd1, 0x5f, 0xd8, 0xf2, 0x65, 0x0e, 0x3a, 0xf8, 0x22, 0x64,
-	0xdc, 0x7e, 0x52, 0xf7, 0xce, 0xbe, 0x42, 0x60, 0x02, 0xed, 0x5d, 0xc0, 0xd3, 0x76, 0x3f, 0x07,
-	0x74, 0xff, 0xa1, 0xc2, 0x3a, 0x11, 0xd1, 0xf7, 0xd5, 0xef, 0x0b, 0x3f, 0xe8, 0xef, 0x0b, 0x4f,
-	0x4f, 0x5c, 0x1f, 0xf2, 0x62, 0x6a, 0x16, 0xea, 0x4f, 0x2e, 0x75, 0x9d, 0x48, 0xb4, 0x99, 0x53,
-	0x89, 0xf6, 0xf9, 0xe3, 0xd1, 0x87, 0x2a, 0x6c, 0x48, 0x43, 0x5e, 0xd5, 0x3a, 0x67, 0xf7, 0x88,
-	0xfc, 0x29, 0x8f, 0xf8, 0x2f, 0x05, 0x2e, 0xcc, 0x05, 0xf2, 0x87, 0x5e, 0xd1, 0x9c, 0xf0, 0x9e,
-	0xec, 0x33, 0xbd, 0x27, 0x77, 0x66, 0xef, 0xf9, 0xba, 0x0a, 0x15, 0x42, 0x3d, 0xea, 0x44, 0x2f,
-	0xf9, 0xed, 0xde, 0x09, 0x0c, 0x73, 0xa7, 0xee, 0x39, 0x57, 0x60, 0x39, 0x05, 0x42, 0x7e, 0x70,
-	0xf1, 0x0f, 0x74, 0x96, 0x07, 0xdf, 0xa3, 0x8e, 0x17, 0x27, 0x95, 0xa0, 0xf6, 0x27, 0x2a, 0x94,
-	0x09, 0xe3, 0xb8, 0x43, 0xda, 0x89, 0x9d, 0x38, 0xc2, 0x9f, 0x81, 0xa5, 0x7d, 0xae, 0x62, 0x4f,
-	0x3c, 0xa4, 0x48, 0x4a, 0x82, 0x27, 0x7e, 0x7d, 0xdc, 0x86, 0xf5, 0x88, 0xf6, 0x02, 0xbf, 0x1f,
-	0xd9, 0x0f, 0xe9, 0xbe, 0xeb, 0xf7, 0xed, 0xa1, 0x13, 0xc5, 0x34, 0xe4, 0xb0, 0x94, 0xc9, 0xaa,
-	0x14, 0xee, 0x70, 0x59, 0x93, 0x8b, 0xf0, 0x55, 0x58, 0x7b, 0xe8, 0xfa, 0x5e, 0x30, 0xb0, 0x47,
-	0x9e, 0x33, 0xa6, 0x61, 0x64, 0xf7, 0x82, 0x23, 0x5f, 0xe0, 0x91, 0x23, 0x58, 0xc8, 0xda, 0x42,
-	0x54, 0x63, 0x12, 0xfc, 0x3e, 0x5c, 0x9e, 0x3b, 0x8b, 0xfd, 0xc8, 0xf5, 0x62, 0x1a, 0xd2, 0xbe,
-	0x1d, 0xd2, 0x91, 0xe7, 0xf6, 0xc4, 0x0b, 0x2b, 0x01, 0xd4, 0xe7, 0xe7, 0x4c, 0xbd, 0x27, 0xd5,
-	0xc9, 0x44, 0x1b, 0x5f, 0x80, 0x62, 0x6f, 0x74, 0x64, 0x1f, 0xf1, 0x47, 0x0b, 0x0c, 0x3f, 0x85,
-	0x14, 0x7a, 0xa3, 0xa3, 0x2e, 0xa3, 0x31, 0x82, 0xcc, 0xe1, 0x48, 0x04, 0x67, 0x85, 0xb0, 0xa6,
-	0xf6, 0x3d, 0x05, 0x2a, 0xfa, 0x60, 0x10, 0xd2, 0x81, 0x13, 0x4b, 0x98, 0xae, 0xc2, 0x9a, 0x80,
-	0x64, 0x6c, 0x4b, 0x77, 0x15, 0xf6, 0x28, 0xc2, 0x1e, 0x29, 0x13, 0xbe, 0x2a, 0xec, 0xb9, 0x0e,
-	0xe7, 0x8e, 0xfc, 0xb9, 0x7d, 0x54, 0xde, 0x67, 0x2d, 0x95, 0x4e, 0xf7, 0xfa, 0x49, 0x78, 0x6d,
-	0x3e, 0x0a, 0x43, 0x57, 0xbc, 0x72, 0x2c, 0x93, 0x73, 0x73, 0x8c, 0x6e, 0xba, 0xfe, 0x53, 0xba,
-	0x3a, 0x1f, 0x70, 0xbc, 0x3e, 0xa6, 0xab, 0xf3, 0x81, 0xf6, 0x67, 0xe9, 0x6f, 0x8a, 0x89, 0xbb,
-	0xa4, 0x81, 0x23, 0x71, 0x64, 0xe5, 0x69, 0x8e, 0x5c, 0x85, 0x45, 0xe6, 0x8c, 0xae, 0x3f, 0xe0,
-	0xc6, 0x15, 0x48, 0x42, 0xe2, 0x0e, 0x7c, 0x5e, 0xda, 0x4e, 0x3f, 0x88, 0x69, 0xe8, 0x3b, 0x9e,
-	0x37, 0xb6, 0xc5, 0xf5, 0xa3, 0xcf, 0x1f, 0x94, 0xa5, 0xaf, 0x3e, 0x45, 0xf8, 0xf8, 0xac, 0xd0,
-	0x36, 0x52, 0x65, 0x92, 0xea, 0x5a, 0xe9, 0x7b, 0xd0, 0x2f, 0x41, 0x25, 0x94, 0x4e, 0x6c, 0x47,
-	0x6c, 0x7b, 0x64, 0xc8, 0x5d, 0x4b, 0x5e, 0x4d, 0x4c, 0x7b, 0x38, 0x29, 0x87, 0x33, 0x0e, 0xff,
-	0xdc, 0x01, 0xe7, 0x76, 0xb6, 0x90, 0x47, 0x8b, 0xda, 0x9f, 0x2b, 0xb0, 0x3a, 0xe7, 0xdb, 0x3d,
-	0xbd, 0x18, 0x50, 0xa6, 0xee, 0x1d, 0x7f, 0x1c, 0x72, 0xfc, 0x41, 0x8b, 0x7c, 0x43, 0x75, 0xfe,
-	0xf4, 0xa7, 0x3f, 0x7f, 0x7c, 0x42, 0x84, 0x16, 0x3b, 0x8b, 0xdc, 0x26, 0xf9, 0xda, 0x4e, 0x42,
-	0x52, 0x62, 0x3c, 0xf9, 0xc4, 0xee, 0xd4, 0x4d, 0x66, 0xf6, 0x99, 0x37, 0x99, 0x97, 0x7f, 0x3b,
-	0x03, 0xc5, 0xe6, 0xb8, 0x73, 0xe8, 0xed, 0x79, 0xce, 0x80, 0xbf, 0x0e, 0x69, 0xb6, 0xad, 0x07,
-	0x68, 0x01, 0xaf, 0x40, 0xd9, 0x6c, 0x59, 0xb6, 0xd9, 0x6d, 0x34, 0xec, 0xbd, 0x86, 0x7e, 0x0b,
-	0x29, 0x18, 0xc1, 0x52, 0x9b, 0xd4, 0xed, 0x3b, 0xc6, 0x03, 0xc1, 0x51, 0xf1, 0x2a, 0x2c, 0x77,
-	0xcd, 0xfa, 0xdd, 0xae, 0x31, 0x61, 0x66, 0xf1, 0x3a, 0xac, 0x34, 0xbb, 0x0d, 0xab, 0xde, 0x6e,
-	0x4c, 0xb1, 0x0b, 0xb8, 0x0c, 0xc5, 0x9d, 0x46, 0x6b, 0x47, 0x90, 0x88, 0x8d, 0xdf, 0x35, 0x3b,
-	0xf5, 0x5b, 0xa6, 0xb1, 0x2b, 0x58, 0x9b, 0x8c, 0xf5, 0xbe, 0x41, 0x5a, 0x7b, 0xf5, 0x64, 0xca,
-	0x77, 0x31, 0x82, 0xd2, 0x4e, 0xdd, 0xd4, 0x89, 0x1c, 0xe5, 0x89, 0x82, 0x2b, 0x50, 0x34, 0xcc,
-	0x6e, 0x53, 0xd2, 0x2a, 0xae, 0xc2, 0xaa, 0xde, 0xb5, 0x5a, 0x76, 0xdd, 0xac, 0x11, 0xa3, 0x69,
-	0x98, 0x96, 0x94, 0x64, 0xf1, 0x2a, 0x54, 0xac, 0x7a, 0xd3, 0xe8, 0x58, 0x7a, 0xb3, 0x2d, 0x99,
-	0x6c, 0x15, 0x85, 0x8e, 0x91, 0xe8, 0x20, 0xbc, 0x01, 0xeb, 0x66, 0xcb, 0x4e, 0x9e, 0xd6, 0xdd,
-	0xd3, 0x1b, 0x5d, 0x43, 0xca, 0x36, 0xf1, 0x79, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0x77, 0x75, 0xcb,
-	0xb0, 0xcd, 0xd6, 0x7d, 0x29, 0x78, 0x17, 0x57, 0xa0, 0x30, 0x59, 0xc1, 0x13, 0x86, 0x42, 0xb9,
-	0xad, 0x13, 0x6b, 0x62, 0xec, 0x93, 0x27, 0x0c, 0x2c, 0xb8, 0x45, 0x5a, 0xdd, 0xf6, 0x44, 0x6d,
-	0x05, 0x4a, 0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x4e, 0xdd, 0xac, 0xa5, 0xeb, 0x7b, 0x52, 0xd8,
-	0x50, 0x91, 0x72, 0xf9, 0x00, 0xb2, 0x7c, 0x3b, 0x0a, 0x90, 0x35, 0x5b, 0xa6, 0x81, 0x16, 0xf0,
-	0x32, 0x40, 0xbd, 0x53, 0x37, 0x2d, 0xe3, 0x16, 0xd1, 0x1b, 0xcc, 0x6c, 0xce, 0x48, 0x00, 0x64,
-	0xd6, 0x2e, 0xc1, 0x62, 0xbd, 0xb3, 0xd7, 0x68, 0xe9, 0x96, 0x34, 0xb3, 0xde, 0xb9, 0xdb, 0x6d,
-	0x59, 0x4c, 0x88, 0x70, 0x09, 0xf2, 0xf5, 0x8e, 0x65, 0x7c, 0xc5, 0x62, 0x76, 0x71, 0x99, 0x40,
-	0x15, 0x3d, 0x79, 0xf7, 0xf2, 0xb7, 0x32, 0x90, 0xe5, 0xcf, 0xb9, 0xcb, 0x50, 0xe4, 0xbb, 0x6d,
-	0x3d, 0x68, 0xb3, 0x29, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x4d, 0xf4, 0x73, 0x2a, 0x06, 0xc8, 0x75,
-	0x79, 0xfb, 0xe7, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0xb7, 0x6f, 0xa0, 0xaf, 0xaa, 0x6c, 0xd8, 0xae,
-	0x20, 0x7e, 0x21, 0x11, 0x6c, 0x5f, 0x47, 0x5f, 0x4b, 0x05, 0xdb, 0xd7, 0xd1, 0x2f, 0x26, 0x82,
-	0x6b, 0xdb, 0xe8, 0xeb, 0xa9, 0xe0, 0xda, 0x36, 0xfa, 0xa5, 0x44, 0x70, 0xe3, 0x3a, 0xfa, 0xe5,
-	0x54, 0x70, 0xe3, 0x3a, 0xfa, 0x95, 0x3c, 0xb3, 0x85, 0x5b, 0x72, 0x6d, 0x1b, 0xfd, 0x6a, 0x21,
-	0xa5, 0x6e, 0x5c, 0x47, 0xbf, 0x56, 0x60, 0xfb, 0x9f, 0xee, 0x2a, 0xfa, 0x75, 0xc4, 0x96, 0xc9,
-	0x36, 0x08, 0xfd, 0x06, 0x6f, 0x32, 0x11, 0xfa, 0x4d, 0xc4, 0x6c, 0x64, 0x5c, 0x4e, 0x7e, 0x83,
-	0x4b, 0x1e, 0x18, 0x3a, 0x41, 0xbf, 0x95, 0x17, 0x8f, 0x3d, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98,
-	0xf7, 0x60, 0xa8, 0xfc, 0xce, 0x55, 0xd6, 0x64, 0xee, 0x89, 0x7e, 0xb7, 0xcd, 0x26, 0xbc, 0xa7,
-	0x93, 0xda, 0x7b, 0x3a, 0x41, 0xbf, 0x77, 0x95, 0x4d, 0x78, 0x4f, 0x27, 0x12, 0xaf, 0xdf, 0x6f,
-	0x33, 0x45, 0x2e, 0xfa, 0x83, 0xab, 0x6c, 0xd1, 0x92, 0xff, 0xcd, 0x36, 0x2e, 0x40, 0x66, 0xa7,
-	0x6e, 0xa1, 0x6f, 0xf1, 0xd9, 0x98, 0x8b, 0xa2, 0x3f, 0x44, 0x8c, 0xd9, 0x31, 0x2c, 0xf4, 0x6d,
-	0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0xb3, 0xc5, 0xdd, 0x32, 0x5a, 0x4d, 0xc3, 0x22,
-	0x0f, 0xd0, 0x1f, 0x71, 0xf5, 0xdb, 0x9d, 0x96, 0x89, 0xbe, 0x83, 0x70, 0x05, 0xc0, 0xf8, 0x4a,
-	0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0x37, 0x2f, 0xef, 0x01, 0x3a, 0x19, 0x0e, 0x98, 0x01,
-	0x5d, 0xf3, 0x8e, 0xd9, 0xba, 0x6f, 0xa2, 0x05, 0x46, 0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14,
-	0x0c, 0x90, 0x97, 0x4f, 0x48, 0x55, 0xbc, 0x04, 0x05, 0xd2, 0x6a, 0x34, 0x76, 0xf4, 0xda, 0x1d,
-	0x94, 0xd9, 0x31, 0xfe, 0xfa, 0xa3, 0x8b, 0xca, 0xdf, 0x7d, 0x74, 0x51, 0xf9, 0xee, 0x47, 0x17,
-	0x95, 0x6f, 0xfe, 0xeb, 0xc5, 0x05, 0x58, 0x76, 0x83, 0xad, 0x63, 0x37, 0xa6, 0x51, 0x24, 0xfe,
-	0x40, 0xf0, 0xbe, 0x26, 0x29, 0x37, 0xb8, 0x22, 0x5a, 0x57, 0x06, 0xc1, 0x95, 0xe3, 0xf8, 0x0a,
-	0x97, 0x5e, 0xe1, 0x11, 0xe4, 0x61, 0x9e, 0x13, 0xd7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0x64, 0x72, 0x89, 0x9e, 0x30, 0x00, 0x00,
+	// 3465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x70, 0x1c, 0x49,
+	0x56, 0x56, 0x55, 0xff, 0xa8, 0xfb, 0xb5, 0xba, 0x95, 0x4a, 0x49, 0x76, 0x8f, 0x66, 0xc6, 0xa3,
+	0xad, 0xdd, 0xd9, 0x35, 0x06, 0x64, 0x8f, 0xec, 0x35, 0x66, 0x76, 0x58, 0xa6, 0xd4, 0x2a, 0x79,
+	0xda, 0xee, 0xae, 0x6e, 0x67, 0x57, 0xdb, 0xeb, 0x09, 0x22, 0x2a, 0x4a, 0xdd, 0xa9, 0x56, 0x85,
+	0xaa, 0xab, 0x5a, 0x55, 0x25, 0x79, 0x74, 0x21, 0x0c, 0xcb, 0xb2, 0xfc, 0xb3, 0xfc, 0xcf, 0xb2,
+	0xc1, 0x06, 0x37, 0x82, 0x0b, 0x27, 0x0e, 0x9c, 0x39, 0x4c, 0x10, 0x1c, 0x08, 0x38, 0x70, 0x00,
+	0x0e, 0x2c, 0x43, 0x10, 0x70, 0x02, 0x82, 0x03, 0x07, 0x0e, 0x04, 0x91, 0x3f, 0x55, 0xdd, 0x2d,
+	0xf5, 0xd8, 0x5a, 0x2f, 0x1b, 0x1b, 0xf6, 0xf8, 0x96, 0xef, 0x27, 0x33, 0xdf, 0xfb, 0xf2, 0xd5,
+	0xcb, 0x97, 0xd9, 0xd9, 0x50, 0x3a, 0x3c, 0xa2, 0xe1, 0xc9, 0xc6, 0x28, 0x0c, 0xe2, 0x00, 0xe7,
+	0x38, 0xb1, 0x56, 0x89, 0x83, 0x51, 0xd0, 0x77, 0x62, 0x47, 0xb0, 0xd7, 0x4a, 0xc7, 0x71, 0x38,
+	0xea, 0x09, 0x42, 0xfb, 0x9a, 0x02, 0x79, 0xcb, 0x09, 0x07, 0x34, 0xc6, 0x6b, 0x50, 0x38, 0xa0,
+	0x27, 0xd1, 0xc8, 0xe9, 0xd1, 0xaa, 0xb2, 0xae, 0x5c, 0x2e, 0x92, 0x94, 0xc6, 0x2b, 0x90, 0x8b,
+	0xf6, 0x9d, 0xb0, 0x5f, 0x55, 0xb9, 0x40, 0x10, 0xf8, 0x8b, 0x50, 0x8a, 0x9d, 0x5d, 0x8f, 0xc6,
+	0x76, 0x7c, 0x32, 0xa2, 0xd5, 0xcc, 0xba, 0x72, 0xb9, 0xb2, 0xb9, 0xb2, 0x91, 0xce, 0x67, 0x71,
+	0xa1, 0x75, 0x32, 0xa2, 0x04, 0xe2, 0xb4, 0x8d, 0x31, 0x64, 0x7b, 0xd4, 0xf3, 0xaa, 0x59, 0x3e,
+	0x16, 0x6f, 0x6b, 0xdb, 0x50, 0xb9, 0x6f, 0xdd, 0x76, 0x62, 0x5a, 0x73, 0x3c, 0x8f, 0x86, 0xf5,
+	0x6d, 0x66, 0xce, 0x51, 0x44, 0x43, 0xdf, 0x19, 0xa6, 0xe6, 0x24, 0x34, 0xbe, 0x00, 0xf9, 0x41,
+	0x18, 0x1c, 0x8d, 0xa2, 0xaa, 0xba, 0x9e, 0xb9, 0x5c, 0x24, 0x92, 0xd2, 0x7e, 0x0a, 0xc0, 0x38,
+	0xa6, 0x7e, 0x6c, 0x05, 0x07, 0xd4, 0xc7, 0xaf, 0x41, 0x31, 0x76, 0x87, 0x34, 0x8a, 0x9d, 0xe1,
+	0x88, 0x0f, 0x91, 0x21, 0x63, 0xc6, 0x27, 0xb8, 0xb4, 0x06, 0x85, 0x51, 0x10, 0xb9, 0xb1, 0x1b,
+	0xf8, 0xdc, 0x9f, 0x22, 0x49, 0x69, 0xed, 0xcb, 0x90, 0xbb, 0xef, 0x78, 0x47, 0x14, 0xbf, 0x01,
+	0x59, 0xee, 0xb0, 0xc2, 0x1d, 0x2e, 0x6d, 0x08, 0xd0, 0xb9, 0x9f, 0x5c, 0xc0, 0xc6, 0x3e, 0x66,
+	0x9a, 0x7c, 0xec, 0x05, 0x22, 0x08, 0xed, 0x00, 0x16, 0xb6, 0x5c, 0xbf, 0x7f, 0xdf, 0x09, 0x5d,
+	0x06, 0xc6, 0x33, 0x0e, 0x83, 0x3f, 0x07, 0x79, 0xde, 0x88, 0xaa, 0x99, 0xf5, 0xcc, 0xe5, 0xd2,
+	0xe6, 0x82, 0xec, 0xc8, 0x6d, 0x23, 0x52, 0xa6, 0xfd, 0xb9, 0x02, 0xb0, 0x15, 0x1c, 0xf9, 0xfd,
+	0x7b, 0x4c, 0x88, 0x11, 0x64, 0xa2, 0x43, 0x4f, 0x02, 0xc9, 0x9a, 0xf8, 0x2e, 0x54, 0x76, 0x5d,
+	0xbf, 0x6f, 0x1f, 0x4b, 0x73, 0x04, 0x96, 0xa5, 0xcd, 0xcf, 0xc9, 0xe1, 0xc6, 0x9d, 0x37, 0x26,
+	0xad, 0x8e, 0x0c, 0x3f, 0x0e, 0x4f, 0x48, 0x79, 0x77, 0x92, 0xb7, 0xd6, 0x05, 0x7c, 0x56, 0x89,
+	0x4d, 0x7a, 0x40, 0x4f, 0x92, 0x49, 0x0f, 0xe8, 0x09, 0xfe, 0xa1, 0x49, 0x8f, 0x4a, 0x9b, 0xcb,
+	0xc9, 0x5c, 0x13, 0x7d, 0xa5, 0x9b, 0x6f, 0xab, 0xb7, 0x14, 0xed, 0x4f, 0x0b, 0x50, 0x31, 0x3e,
+	0xa0, 0xbd, 0xa3, 0x98, 0xb6, 0x46, 0x6c, 0x0d, 0x22, 0xdc, 0x84, 0x45, 0xd7, 0xef, 0x79, 0x47,
+	0x7d, 0xda, 0xb7, 0xf7, 0x5c, 0xea, 0xf5, 0x23, 0x1e, 0x47, 0x95, 0xd4, 0xee, 0x69, 0xfd, 0x8d,
+	0xba, 0x54, 0xde, 0xe1, 0xba, 0xa4, 0xe2, 0x4e, 0xd1, 0xf8, 0x0a, 0x2c, 0xf5, 0x3c, 0x97, 0xfa,
+	0xb1, 0xbd, 0xc7, 0xfc, 0xb5, 0xc3, 0xe0, 0x51, 0x54, 0xcd, 0xad, 0x2b, 0x97, 0x0b, 0x64, 0x51,
+	0x08, 0x76, 0x18, 0x9f, 0x04, 0x8f, 0x22, 0xfc, 0x36, 0x14, 0x1e, 0x05, 0xe1, 0x81, 0x17, 0x38,
+	0xfd, 0x6a, 0x9e, 0xcf, 0x79, 0x69, 0xf6, 0x9c, 0x0f, 0xa4, 0x16, 0x49, 0xf5, 0xf1, 0x65, 0x40,
+	0xd1, 0xa1, 0x67, 0x47, 0xd4, 0xa3, 0xbd, 0xd8, 0xf6, 0xdc, 0xa1, 0x1b, 0x57, 0x0b, 0x3c, 0x24,
+	0x2b, 0xd1, 0xa1, 0xd7, 0xe1, 0xec, 0x06, 0xe3, 0x62, 0x1b, 0x56, 0xe3, 0xd0, 0xf1, 0x23, 0xa7,
+	0xc7, 0x06, 0xb3, 0xdd, 0x28, 0xf0, 0x1c, 0xd6, 0xaa, 0x16, 0xf9, 0x94, 0x57, 0x66, 0x4f, 0x69,
+	0x8d, 0xbb, 0xd4, 0x93, 0x1e, 0x64, 0x25, 0x9e, 0xc1, 0xc5, 0x6f, 0xc1, 0x6a, 0x74, 0xe0, 0x8e,
+	0x6c, 0x3e, 0x8e, 0x3d, 0xf2, 0x1c, 0xdf, 0xee, 0x39, 0xbd, 0x7d, 0x5a, 0x05, 0xee, 0x36, 0x66,
+	0x42, 0xbe, 0xee, 0x6d, 0xcf, 0xf1, 0x6b, 0x4c, 0xc2, 0x40, 0x67, 0x7a, 0x3e, 0x0d, 0xed, 0x63,
+	0x1a, 0x46, 0xcc, 0x9a, 0xd2, 0x93, 0x40, 0x6f, 0x0b, 0xe5, 0xfb, 0x42, 0x97, 0x54, 0x46, 0x53,
+	0x34, 0xfe, 0x22, 0x5c, 0xdc, 0x77, 0x22, 0xbb, 0x17, 0x52, 0x27, 0xa6, 0x7d, 0x3b, 0xa6, 0xc3,
+	0x91, 0x1d, 0x8b, 0x18, 0x5c, 0xe0, 0x36, 0xac, 0xec, 0x3b, 0x51, 0x4d, 0x48, 0x2d, 0x3a, 0x1c,
+	0xf1, 0x3c, 0x12, 0xe1, 0x1a, 0x80, 0xb0, 0x39, 0x76, 0x06, 0x51, 0xb5, 0x3c, 0x15, 0xad, 0xa7,
+	0x0c, 0xe0, 0xf6, 0x5b, 0xce, 0x40, 0x46, 0x6b, 0xf1, 0x30, 0xa1, 0xd7, 0xde, 0x81, 0xca, 0xb4,
+	0x70, 0x46, 0x94, 0x4e, 0x7d, 0x77, 0xc5, 0xc9, 0x80, 0xfc, 0x12, 0x54, 0xa6, 0x03, 0x0a, 0x2f,
+	0x41, 0xd9, 0x7a, 0xd8, 0x36, 0x6c, 0xdd, 0xdc, 0xb6, 0x4d, 0xbd, 0x69, 0xa0, 0x39, 0x5c, 0x86,
+	0x22, 0x67, 0xb5, 0xcc, 0xc6, 0x43, 0xa4, 0xe0, 0x79, 0xc8, 0xe8, 0x8d, 0x06, 0x52, 0xb5, 0x5b,
+	0x50, 0x48, 0x22, 0x03, 0x2f, 0x42, 0xa9, 0x6b, 0x76, 0xda, 0x46, 0xad, 0xbe, 0x53, 0x37, 0xb6,
+	0xd1, 0x1c, 0x2e, 0x40, 0xb6, 0xd5, 0xb0, 0xda, 0x48, 0x11, 0x2d, 0xbd, 0x8d, 0x54, 0xd6, 0x73,
+	0x7b, 0x4b, 0x47, 0x19, 0xed, 0x8f, 0x14, 0x58, 0x99, 0xb5, 0xc2, 0xb8, 0x04, 0xf3, 0xdb, 0xc6,
+	0x8e, 0xde, 0x6d, 0x58, 0x68, 0x0e, 0x2f, 0xc3, 0x22, 0x31, 0xda, 0x86, 0x6e, 0xe9, 0x5b, 0x0d,
+	0xc3, 0x26, 0x86, 0xbe, 0x8d, 0x14, 0x8c, 0xa1, 0xc2, 0x5a, 0x76, 0xad, 0xd5, 0x6c, 0xd6, 0x2d,
+	0xcb, 0xd8, 0x46, 0x2a, 0x5e, 0x01, 0xc4, 0x79, 0x5d, 0x73, 0xcc, 0xcd, 0x60, 0x04, 0x0b, 0x1d,
+	0x83, 0xd4, 0xf5, 0x46, 0xfd, 0x7d, 0x36, 0x00, 0xca, 0xe2, 0xcf, 0xc0, 0xeb, 0xb5, 0x96, 0xd9,
+	0xa9, 0x77, 0x2c, 0xc3, 0xb4, 0xec, 0x8e, 0xa9, 0xb7, 0x3b, 0xef, 0xb5, 0x2c, 0x3e, 0xb2, 0x70,
+	0x2e, 0x87, 0x2b, 0x00, 0x7a, 0xd7, 0x6a, 0x89, 0x71, 0x50, 0x5e, 0x3b, 0x84, 0xca, 0xf4, 0xe2,
+	0x33, 0xab, 0xa4, 0x89, 0x76, 0xbb, 0xa1, 0x9b, 0xa6, 0x41, 0xd0, 0x1c, 0xce, 0x83, 0x7a, 0xff,
+	0xba, 0xf0, 0xf5, 0x36, 0xf5, 0x6f, 0x20, 0x95, 0x0d, 0xc4, 0x5a, 0xb7, 0x43, 0x4a, 0xfb, 0x27,
+	0x28, 0xc3, 0xec, 0x66, 0x74, 0x83, 0xee, 0xc5, 0x9b, 0xc4, 0x1d, 0xec, 0xc7, 0x28, 0xcb, 0xec,
+	0x66, 0xbc, 0x07, 0x6e, 0xbc, 0xbf, 0xe3, 0x78, 0xde, 0xae, 0xd3, 0x3b, 0x40, 0xb9, 0x3b, 0xd9,
+	0x82, 0x82, 0xd4, 0x3b, 0xd9, 0x82, 0x8a, 0x32, 0x77, 0xb2, 0x85, 0x0c, 0xca, 0x6a, 0x7f, 0xa6,
+	0x42, 0x8e, 0x2f, 0x0f, 0xdb, 0x6a, 0x26, 0x36, 0x10, 0xde, 0x4e, 0xd3, 0xae, 0xfa, 0x84, 0xb4,
+	0xcb, 0xa3, 0x51, 0x6e, 0x00, 0x82, 0xc0, 0xaf, 0x42, 0x31, 0x08, 0x07, 0x22, 0x4e, 0xe5, 0xd6,
+	0x55, 0x08, 0xc2, 0x01, 0x8f, 0x4d, 0xb6, 0x6d, 0xb0, 0x1d, 0x6f, 0xd7, 0x89, 0x28, 0xcf, 0x1e,
+	0x45, 0x92, 0xd2, 0xf8, 0x15, 0x60, 0x7a, 0x36, 0xb7, 0x23, 0xcf, 0x65, 0xf3, 0x41, 0x38, 0x30,
+	0x99, 0x29, 0x9f, 0x85, 0x72, 0x2f, 0xf0, 0x8e, 0x86, 0xbe, 0xed, 0x51, 0x7f, 0x10, 0xef, 0x57,
+	0xe7, 0xd7, 0x95, 0xcb, 0x65, 0xb2, 0x20, 0x98, 0x0d, 0xce, 0xc3, 0x55, 0x98, 0xef, 0xed, 0x3b,
+	0x61, 0x44, 0x45, 0xc6, 0x28, 0x93, 0x84, 0xe4, 0xb3, 0xd2, 0x9e, 0x3b, 0x74, 0xbc, 0x88, 0x67,
+	0x87, 0x32, 0x49, 0x69, 0xe6, 0xc4, 0x9e, 0xc7, 0xbe, 0x13, 0xe0, 0x02, 0x41, 0xe0, 0x37, 0xa0,
+	0x24, 0x27, 0xe4, 0x10, 0x94, 0xb8, 0x39, 0x20, 0x58, 0x0c, 0x01, 0xed, 0xc7, 0x20, 0x43, 0x82,
+	0x47, 0x6c, 0x4e, 0x61, 0x51, 0x54, 0x55, 0xd6, 0x33, 0x97, 0x31, 0x49, 0x48, 0xb6, 0xf5, 0xca,
+	0xdd, 0x47, 0x6c, 0x4a, 0xc9, 0x7e, 0xf3, 0x2d, 0x05, 0x4a, 0xfc, 0xc3, 0x22, 0x34, 0x3a, 0xf2,
+	0x62, 0xb6, 0x4b, 0xc9, 0xf4, 0xac, 0x4c, 0xed, 0x52, 0x7c, 0x5d, 0x88, 0x94, 0x31, 0x00, 0x58,
+	0xc6, 0xb5, 0x9d, 0xbd, 0x3d, 0xda, 0x8b, 0xa9, 0xd8, 0x8c, 0xb3, 0x64, 0x81, 0x31, 0x75, 0xc9,
+	0x63, 0xc8, 0xbb, 0x7e, 0x44, 0xc3, 0xd8, 0x76, 0xfb, 0x7c, 0x4d, 0xb2, 0xa4, 0x20, 0x18, 0xf5,
+	0x3e, 0xbe, 0x04, 0x59, 0x9e, 0xb3, 0xb3, 0x7c, 0x16, 0x90, 0xb3, 0x90, 0xe0, 0x11, 0xe1, 0xfc,
+	0x3b, 0xd9, 0x42, 0x0e, 0xe5, 0xb5, 0x77, 0x60, 0x81, 0x1b, 0xf7, 0xc0, 0x09, 0x7d, 0xd7, 0x1f,
+	0xf0, 0x12, 0x24, 0xe8, 0x8b, 0xb8, 0x28, 0x13, 0xde, 0x66, 0x3e, 0x0f, 0x69, 0x14, 0x39, 0x83,
+	0xe4, 0xbb, 0x4f, 0x48, 0xed, 0x0f, 0x33, 0x50, 0xea, 0xc4, 0x21, 0x75, 0x86, 0xbc, 0xba, 0xc0,
+	0xef, 0x00, 0x44, 0xb1, 0x13, 0xd3, 0x21, 0xf5, 0xe3, 0xc4, 0xbf, 0xd7, 0xe4, 0xcc, 0x13, 0x7a,
+	0x1b, 0x9d, 0x44, 0x89, 0x4c, 0xe8, 0xe3, 0x4d, 0x28, 0x51, 0x26, 0xb6, 0x63, 0x56, 0xa5, 0xc8,
+	0x9d, 0x70, 0x29, 0xc9, 0x63, 0x69, 0xf9, 0x42, 0x80, 0xa6, 0xed, 0xb5, 0x6f, 0xab, 0x50, 0x4c,
+	0x47, 0xc3, 0x3a, 0x14, 0x7a, 0x4e, 0x4c, 0x07, 0x41, 0x78, 0x22, 0x8b, 0x87, 0x37, 0x9f, 0x34,
+	0xfb, 0x46, 0x4d, 0x2a, 0x93, 0xb4, 0x1b, 0x7e, 0x1d, 0x44, 0x45, 0x26, 0xc2, 0x52, 0xf8, 0x5b,
+	0xe4, 0x1c, 0x1e, 0x98, 0x6f, 0x03, 0x1e, 0x85, 0xee, 0xd0, 0x09, 0x4f, 0xec, 0x03, 0x7a, 0x92,
+	0x6c, 0xb4, 0x99, 0x19, 0x2b, 0x89, 0xa4, 0xde, 0x5d, 0x7a, 0x22, 0x33, 0xe2, 0xad, 0xe9, 0xbe,
+	0x32, 0x5a, 0xce, 0xae, 0xcf, 0x44, 0x4f, 0x5e, 0xba, 0x44, 0x49, 0x91, 0x92, 0xe3, 0x81, 0xc5,
+	0x9a, 0xda, 0x17, 0xa0, 0x90, 0x18, 0x8f, 0x8b, 0x90, 0x33, 0xc2, 0x30, 0x08, 0xd1, 0x1c, 0x4f,
+	0x8c, 0xcd, 0x86, 0xc8, 0xad, 0xdb, 0xdb, 0x2c, 0xb7, 0xfe, 0x93, 0x9a, 0x56, 0x0a, 0x84, 0x1e,
+	0x1e, 0xd1, 0x28, 0xc6, 0x3f, 0x09, 0xcb, 0x94, 0x87, 0x90, 0x7b, 0x4c, 0xed, 0x1e, 0x2f, 0x2b,
+	0x59, 0x00, 0x29, 0x1c, 0xef, 0xc5, 0x0d, 0x51, 0x05, 0x27, 0xe5, 0x26, 0x59, 0x4a, 0x75, 0x25,
+	0xab, 0x8f, 0x0d, 0x58, 0x76, 0x87, 0x43, 0xda, 0x77, 0x9d, 0x78, 0x72, 0x00, 0xb1, 0x60, 0xab,
+	0x49, 0xd5, 0x35, 0x55, 0xb5, 0x92, 0xa5, 0xb4, 0x47, 0x3a, 0xcc, 0x9b, 0x90, 0x8f, 0x79, 0x85,
+	0xcd, 0x63, 0xb7, 0xb4, 0x59, 0x4e, 0x32, 0x0e, 0x67, 0x12, 0x29, 0xc4, 0x5f, 0x00, 0x51, 0xaf,
+	0xf3, 0xdc, 0x32, 0x0e, 0x88, 0x71, 0x19, 0x46, 0x84, 0x1c, 0xbf, 0x09, 0x95, 0xa9, 0x02, 0xa1,
+	0xcf, 0x01, 0xcb, 0x90, 0xf2, 0x04, 0xb7, 0xde, 0xc7, 0x57, 0x61, 0x3e, 0x10, 0xbb, 0x61, 0x35,
+	0x3f, 0x65, 0xf1, 0xf4, 0x56, 0x49, 0x12, 0x2d, 0x96, 0x1b, 0x42, 0x1a, 0xd1, 0xf0, 0x98, 0xf6,
+	0xd9, 0xa0, 0xf3, 0x7c, 0x50, 0x48, 0x58, 0xf5, 0xbe, 0xf6, 0x13, 0xb0, 0x98, 0x42, 0x1c, 0x8d,
+	0x02, 0x3f, 0xa2, 0xf8, 0x0a, 0xe4, 0x43, 0xfe, 0xbd, 0x4b, 0x58, 0xb1, 0x9c, 0x63, 0x22, 0x13,
+	0x10, 0xa9, 0xa1, 0xf5, 0x61, 0x51, 0x70, 0x58, 0xfe, 0xe6, 0x2b, 0x89, 0xdf, 0x84, 0x1c, 0x65,
+	0x8d, 0x53, 0x8b, 0x42, 0xda, 0x35, 0x2e, 0x27, 0x42, 0x3a, 0x31, 0x8b, 0xfa, 0xd4, 0x59, 0xfe,
+	0x53, 0x85, 0x65, 0x69, 0xe5, 0x96, 0x13, 0xf7, 0xf6, 0x9f, 0xd3, 0x68, 0xf8, 0x61, 0x98, 0x67,
+	0x7c, 0x37, 0xfd, 0x72, 0x66, 0xc4, 0x43, 0xa2, 0xc1, 0x22, 0xc2, 0x89, 0xec, 0x89, 0xe5, 0x97,
+	0x15, 0x6c, 0xd9, 0x89, 0x26, 0xaa, 0x86, 0x19, 0x81, 0x93, 0x7f, 0x4a, 0xe0, 0xcc, 0x9f, 0x27,
+	0x70, 0xb4, 0x6d, 0x58, 0x99, 0x46, 0x5c, 0x06, 0xc7, 0x8f, 0xc0, 0xbc, 0x58, 0x94, 0x24, 0x47,
+	0xce, 0x5a, 0xb7, 0x44, 0x45, 0xfb, 0x48, 0x85, 0x15, 0x99, 0xbe, 0x3e, 0x1d, 0xdf, 0xf1, 0x04,
+	0xce, 0xb9, 0x73, 0x7d, 0xa0, 0xe7, 0x5b, 0x3f, 0xad, 0x06, 0xab, 0xa7, 0x70, 0x7c, 0x86, 0x8f,
+	0xf5, 0xdf, 0x15, 0x58, 0xd8, 0xa2, 0x03, 0xd7, 0x7f, 0x4e, 0x57, 0x61, 0x02, 0xdc, 0xec, 0xb9,
+	0x82, 0x78, 0x04, 0x65, 0xe9, 0xaf, 0x44, 0xeb, 0x2c, 0xda, 0xca, 0xac, 0xaf, 0xe5, 0x16, 0x2c,
+	0xc8, 0x3b, 0x10, 0xc7, 0x73, 0x9d, 0x28, 0xf5, 0xe7, 0xd4, 0x25, 0x88, 0xce, 0x84, 0xa4, 0x14,
+	0x8f, 0x09, 0xed, 0x5f, 0x14, 0x28, 0xd7, 0x82, 0xe1, 0xd0, 0x8d, 0x9f, 0x53, 0x8c, 0xcf, 0x22,
+	0x94, 0x9d, 0x15, 0x8f, 0x6f, 0x41, 0x25, 0x71, 0x53, 0x42, 0x7b, 0x6a, 0xa7, 0x51, 0xce, 0xec,
+	0x34, 0xff, 0xaa, 0xc0, 0x22, 0x09, 0x44, 0x85, 0xff, 0x62, 0x83, 0x73, 0x1d, 0xd0, 0xd8, 0xd1,
+	0xf3, 0xc2, 0xf3, 0x3f, 0x0a, 0x54, 0xda, 0x21, 0x1d, 0x39, 0x21, 0x7d, 0xa1, 0xd1, 0x61, 0x65,
+	0x7a, 0x3f, 0x96, 0x05, 0x4e, 0x91, 0xf0, 0xb6, 0xb6, 0x04, 0x8b, 0xa9, 0xef, 0x02, 0x30, 0xed,
+	0xef, 0x15, 0x58, 0x15, 0x21, 0x26, 0x25, 0xfd, 0xe7, 0x14, 0x96, 0xc4, 0xdf, 0xec, 0x84, 0xbf,
+	0x55, 0xb8, 0x70, 0xda, 0x37, 0xe9, 0xf6, 0x57, 0x55, 0xb8, 0x98, 0x04, 0xcf, 0x73, 0xee, 0xf8,
+	0xf7, 0x10, 0x0f, 0x6b, 0x50, 0x3d, 0x0b, 0x82, 0x44, 0xe8, 0x1b, 0x2a, 0x54, 0xc5, 0x3d, 0xd2,
+	0x44, 0x1d, 0xf4, 0xe2, 0xc4, 0x06, 0x7e, 0x0b, 0x16, 0x46, 0x4e, 0x18, 0xbb, 0x3d, 0x77, 0xe4,
+	0xb0, 0xa3, 0x68, 0x6e, 0x3d, 0x73, 0x76, 0x80, 0x29, 0x15, 0xed, 0x55, 0x78, 0x65, 0x06, 0x22,
+	0x12, 0xaf, 0xff, 0x55, 0x00, 0x77, 0x62, 0x27, 0x8c, 0x3f, 0x05, 0xfb, 0xd2, 0xcc, 0x60, 0x5a,
+	0x85, 0xe5, 0x29, 0xff, 0x27, 0x71, 0xa1, 0xf1, 0xa7, 0x62, 0x4b, 0xfa, 0x44, 0x5c, 0x26, 0xfd,
+	0x97, 0xb8, 0xfc, 0xa3, 0x02, 0x6b, 0xb5, 0x40, 0x5c, 0x88, 0xbe, 0x90, 0x5f, 0x98, 0xf6, 0x3a,
+	0xbc, 0x3a, 0xd3, 0x41, 0x09, 0xc0, 0x3f, 0x28, 0x70, 0x81, 0x50, 0xa7, 0xff, 0x62, 0x3a, 0x7f,
+	0x0f, 0x2e, 0x9e, 0x71, 0x4e, 0xd6, 0x28, 0x37, 0xa1, 0x30, 0xa4, 0xb1, 0xd3, 0x77, 0x62, 0x47,
+	0xba, 0xb4, 0x96, 0x8c, 0x3b, 0xd6, 0x6e, 0x4a, 0x0d, 0x92, 0xea, 0x6a, 0x3f, 0x0d, 0xcb, 0x49,
+	0x96, 0x9e, 0x50, 0x4c, 0x67, 0x57, 0xc6, 0xb3, 0xb3, 0x9b, 0xc6, 0x3d, 0xc7, 0xf5, 0xe4, 0xa5,
+	0x60, 0x81, 0x48, 0x0a, 0x7f, 0x06, 0x16, 0xd8, 0xaf, 0x78, 0xc9, 0xcf, 0x07, 0xdc, 0xad, 0x0c,
+	0x29, 0x31, 0x9e, 0xfc, 0xcd, 0x80, 0x5d, 0xe5, 0x4d, 0x9e, 0x9e, 0x8b, 0xe9, 0x51, 0x59, 0xfb,
+	0x0f, 0x05, 0x5e, 0xef, 0xfa, 0x21, 0x8d, 0x02, 0xef, 0x78, 0xca, 0x84, 0xe8, 0x39, 0x5d, 0xb7,
+	0x37, 0xa0, 0xe4, 0xec, 0x3a, 0x7e, 0x3f, 0xf0, 0x6d, 0x76, 0x73, 0x29, 0xbe, 0x68, 0x90, 0x2c,
+	0x7d, 0x40, 0xb5, 0x0f, 0x15, 0xb8, 0xf4, 0x49, 0x1e, 0xcb, 0xc5, 0xfc, 0x32, 0x2c, 0x4c, 0xa4,
+	0x80, 0xe4, 0xb4, 0xfe, 0xa4, 0x05, 0x9d, 0xd2, 0x67, 0xc1, 0x30, 0x92, 0x8b, 0x5a, 0x55, 0xa7,
+	0xfa, 0xce, 0x58, 0x6b, 0x92, 0xea, 0x6a, 0xdf, 0x51, 0x61, 0x99, 0x1f, 0xba, 0x5e, 0x9e, 0xf8,
+	0xcf, 0x75, 0x25, 0x97, 0x3f, 0x7d, 0x12, 0x60, 0x0a, 0xa3, 0x90, 0xda, 0x49, 0xb0, 0xcf, 0xf3,
+	0x60, 0x87, 0x51, 0x48, 0xef, 0xc9, 0x78, 0xff, 0x4b, 0x05, 0x56, 0xa6, 0x21, 0x4e, 0x8f, 0xb7,
+	0xff, 0xdf, 0x57, 0x6f, 0x33, 0xf6, 0x97, 0xcc, 0x79, 0x4e, 0xcc, 0xd9, 0x73, 0x9f, 0x98, 0xff,
+	0x4a, 0x85, 0xea, 0xa4, 0x33, 0x2f, 0x2f, 0xf8, 0xa6, 0x2f, 0xf8, 0xbe, 0xdb, 0x2b, 0x5f, 0xed,
+	0x6f, 0x14, 0x78, 0x65, 0x06, 0xa0, 0xdf, 0x5d, 0x88, 0x4c, 0x5c, 0xf3, 0xa9, 0x4f, 0xbd, 0xe6,
+	0xfb, 0xfe, 0x07, 0xc9, 0xdf, 0x29, 0xb0, 0xd2, 0x14, 0x3f, 0xdc, 0x88, 0x6b, 0xb0, 0xe7, 0x77,
+	0x43, 0xe6, 0xbf, 0xcd, 0x64, 0xc7, 0x3f, 0x5d, 0xb2, 0xab, 0xbd, 0x53, 0xae, 0x3d, 0xc3, 0xd5,
+	0xde, 0x7f, 0x2b, 0xb0, 0x24, 0x47, 0xd1, 0x7b, 0x07, 0x2f, 0x0e, 0x3a, 0xf8, 0x12, 0x64, 0xdc,
+	0x7e, 0x72, 0x08, 0x9a, 0x7e, 0x15, 0xc3, 0x04, 0xda, 0xbb, 0x80, 0x27, 0xfd, 0x7e, 0x06, 0xe8,
+	0xfe, 0x4d, 0x85, 0x55, 0x22, 0xb2, 0xef, 0xcb, 0x1f, 0x9b, 0xbe, 0xd7, 0x1f, 0x9b, 0x9e, 0xbc,
+	0x71, 0x7d, 0xc4, 0x2b, 0xeb, 0x69, 0xa8, 0xbf, 0x7f, 0x5b, 0xd7, 0xa9, 0x8d, 0x36, 0x73, 0x66,
+	0xa3, 0x7d, 0xf6, 0x7c, 0xf4, 0x91, 0x0a, 0x6b, 0xd2, 0x91, 0x97, 0xb5, 0xce, 0xf9, 0x23, 0x22,
+	0x7f, 0x26, 0x22, 0xfe, 0x4b, 0x81, 0x57, 0x67, 0x02, 0xf9, 0x03, 0xaf, 0x68, 0x4e, 0x45, 0x4f,
+	0xf6, 0xa9, 0xd1, 0x93, 0x3b, 0x77, 0xf4, 0x7c, 0x5d, 0x85, 0x0a, 0xa1, 0x1e, 0x75, 0xa2, 0x17,
+	0xfc, 0xaa, 0xf7, 0x14, 0x86, 0xb9, 0x33, 0x97, 0xde, 0x4b, 0xb0, 0x98, 0x02, 0x21, 0x4f, 0xdf,
+	0xfc, 0xb6, 0x86, 0xed, 0x83, 0xef, 0x51, 0xc7, 0x8b, 0x93, 0x4a, 0x50, 0xfb, 0x5b, 0x15, 0xca,
+	0x84, 0x71, 0xdc, 0x21, 0x65, 0x8f, 0x20, 0x22, 0x76, 0x64, 0xdc, 0xe7, 0x2a, 0xf6, 0x38, 0x42,
+	0x8a, 0xa4, 0x24, 0x78, 0xe2, 0xa7, 0xe8, 0x4d, 0x58, 0x8d, 0x68, 0x2f, 0xf0, 0xfb, 0x91, 0xbd,
+	0x4b, 0xf7, 0xd9, 0xc3, 0xc8, 0xa1, 0x13, 0xc5, 0x34, 0xe4, 0xb0, 0x94, 0xc9, 0xb2, 0x14, 0x6e,
+	0x71, 0x59, 0x93, 0x8b, 0xf0, 0x35, 0x58, 0xd9, 0x75, 0x7d, 0x2f, 0x18, 0xb0, 0x57, 0x74, 0x27,
+	0x34, 0x8c, 0xec, 0x5e, 0x70, 0xe4, 0x0b, 0x3c, 0x72, 0x04, 0x0b, 0x59, 0x5b, 0x88, 0x6a, 0x4c,
+	0x82, 0xdf, 0x87, 0x2b, 0x33, 0x67, 0xb1, 0xf7, 0x5c, 0x2f, 0xa6, 0x21, 0xed, 0xdb, 0x21, 0x1d,
+	0x79, 0x6e, 0x4f, 0xbc, 0xf8, 0x13, 0x40, 0x7d, 0x7e, 0xc6, 0xd4, 0x3b, 0x52, 0x9d, 0x8c, 0xb5,
+	0xd9, 0x33, 0x99, 0xde, 0xe8, 0xc8, 0x3e, 0xe2, 0x2f, 0x58, 0x18, 0x7e, 0x0a, 0x29, 0xf4, 0x46,
+	0x47, 0x5d, 0x46, 0xb3, 0xa7, 0x15, 0x87, 0x23, 0x91, 0x9c, 0x15, 0xc2, 0x9a, 0xcc, 0x78, 0xf1,
+	0x02, 0x24, 0xea, 0xed, 0xd3, 0xa1, 0x63, 0xf7, 0xf6, 0x1d, 0x7f, 0x40, 0xfb, 0x32, 0x15, 0x63,
+	0x2e, 0xeb, 0x70, 0x51, 0x4d, 0x48, 0xd8, 0x6f, 0x82, 0x15, 0x7d, 0x30, 0x08, 0xe9, 0xc0, 0x89,
+	0x25, 0xb0, 0xd7, 0x60, 0x45, 0x80, 0x78, 0x62, 0xcb, 0x00, 0x17, 0x08, 0x28, 0x02, 0x01, 0x29,
+	0x13, 0xd1, 0x2d, 0x10, 0xb8, 0x01, 0x17, 0x8e, 0xfc, 0x99, 0x7d, 0x54, 0xde, 0x67, 0xe5, 0xc8,
+	0x9f, 0xd1, 0xeb, 0xc7, 0xe1, 0x95, 0xd9, 0xb8, 0x0d, 0x5d, 0xf1, 0x4e, 0xb7, 0x4c, 0x2e, 0xcc,
+	0x80, 0xa9, 0xe9, 0xfa, 0x4f, 0xe8, 0xea, 0x7c, 0x50, 0xcd, 0x7e, 0x72, 0x57, 0xe7, 0x03, 0xed,
+	0x8f, 0xd3, 0x9f, 0xa4, 0x93, 0x00, 0x4b, 0x53, 0x4d, 0x12, 0xfa, 0xca, 0x93, 0x42, 0xbf, 0x0a,
+	0xf3, 0x2c, 0x7c, 0x5d, 0x7f, 0x20, 0xaf, 0x30, 0x12, 0x12, 0x77, 0xe0, 0xf3, 0xd2, 0x77, 0xfa,
+	0x41, 0x4c, 0x43, 0xdf, 0xf1, 0xbc, 0x13, 0x5b, 0x1c, 0x8b, 0x7d, 0xfe, 0x24, 0x32, 0x7d, 0xb7,
+	0x2c, 0x12, 0xce, 0x67, 0x85, 0xb6, 0x91, 0x2a, 0x93, 0x54, 0xd7, 0x4a, 0x54, 0xf1, 0x97, 0xa0,
+	0x12, 0xca, 0xb0, 0xb7, 0x23, 0xb6, 0x3c, 0x32, 0x49, 0xaf, 0x48, 0xeb, 0xa6, 0xbe, 0x09, 0x52,
+	0x0e, 0x27, 0xc9, 0x67, 0x4f, 0x51, 0x77, 0xb2, 0x85, 0x3c, 0x9a, 0xd7, 0xfe, 0x44, 0x81, 0xe5,
+	0x19, 0x37, 0x05, 0x33, 0x6f, 0x76, 0x7e, 0x14, 0x72, 0xcc, 0xbe, 0xe4, 0x09, 0xde, 0xc5, 0xb3,
+	0x17, 0x0d, 0xcc, 0x26, 0x4a, 0x84, 0xd6, 0x79, 0x2e, 0x7c, 0x4e, 0x5f, 0x84, 0x67, 0x9f, 0x7a,
+	0x11, 0x7e, 0xe5, 0x37, 0x33, 0x50, 0x6c, 0x9e, 0x74, 0x0e, 0xbd, 0x1d, 0xcf, 0x19, 0xf0, 0xc7,
+	0x45, 0xcd, 0xb6, 0xf5, 0x10, 0xcd, 0xb1, 0x17, 0x9d, 0x66, 0xcb, 0xb2, 0xcd, 0x6e, 0xa3, 0x61,
+	0xef, 0x34, 0xf4, 0xdb, 0x48, 0x61, 0x4f, 0x23, 0xdb, 0xa4, 0x6e, 0xdf, 0x35, 0x1e, 0x0a, 0x8e,
+	0xca, 0x5e, 0x35, 0x76, 0xcd, 0xfa, 0xbd, 0xae, 0x31, 0x66, 0x66, 0xf1, 0x2a, 0x2c, 0x35, 0xbb,
+	0x0d, 0xab, 0xde, 0x6e, 0x4c, 0xb0, 0x0b, 0xec, 0x3d, 0xe8, 0x56, 0xa3, 0xb5, 0x25, 0x48, 0xc4,
+	0xc6, 0xef, 0x9a, 0x9d, 0xfa, 0x6d, 0xd3, 0xd8, 0x16, 0xac, 0x75, 0xc6, 0x7a, 0xdf, 0x20, 0xad,
+	0x9d, 0x7a, 0x32, 0xe5, 0xbb, 0x18, 0x41, 0x69, 0xab, 0x6e, 0xea, 0x44, 0x8e, 0xf2, 0x58, 0xc1,
+	0x15, 0x28, 0x1a, 0x66, 0xb7, 0x29, 0x69, 0x15, 0x57, 0x61, 0x99, 0x3d, 0xbd, 0xb4, 0xeb, 0x66,
+	0x8d, 0x18, 0x4d, 0xf6, 0x42, 0x53, 0x48, 0xb2, 0x78, 0x19, 0x2a, 0x56, 0xbd, 0x69, 0x74, 0x2c,
+	0xbd, 0xd9, 0x96, 0x4c, 0x66, 0x45, 0xa1, 0x63, 0x24, 0x3a, 0x08, 0xaf, 0xc1, 0xaa, 0xd9, 0xb2,
+	0x93, 0x97, 0x99, 0xf7, 0xf5, 0x46, 0xd7, 0x90, 0xb2, 0x75, 0x7c, 0x11, 0x70, 0xcb, 0xb4, 0xbb,
+	0xed, 0x6d, 0xdd, 0x32, 0x6c, 0xb3, 0xf5, 0x40, 0x0a, 0xde, 0xc5, 0x15, 0x28, 0x8c, 0x2d, 0x78,
+	0xcc, 0x50, 0x28, 0xb7, 0x75, 0x62, 0x8d, 0x9d, 0x7d, 0xfc, 0x98, 0x81, 0x05, 0xb7, 0x49, 0xab,
+	0xdb, 0x1e, 0xab, 0x2d, 0x41, 0x49, 0x82, 0x25, 0x59, 0x59, 0xc6, 0xda, 0xaa, 0x9b, 0xb5, 0xd4,
+	0xbe, 0xc7, 0x85, 0x35, 0x15, 0x29, 0x57, 0x0e, 0x20, 0xcb, 0x97, 0xa3, 0x00, 0x59, 0xb3, 0x65,
+	0xb2, 0xc7, 0xb4, 0x8b, 0x00, 0xf5, 0x4e, 0xdd, 0xb4, 0x8c, 0xdb, 0x44, 0x6f, 0x30, 0xb7, 0x39,
+	0x23, 0x01, 0x90, 0x79, 0xbb, 0x00, 0xf3, 0xf5, 0xce, 0x4e, 0xa3, 0xa5, 0x5b, 0xd2, 0xcd, 0x7a,
+	0xe7, 0x5e, 0xb7, 0xc5, 0xde, 0xb4, 0x3e, 0x46, 0xb8, 0x04, 0x79, 0xf6, 0x7c, 0xf5, 0x2b, 0x16,
+	0xf3, 0x8b, 0xcb, 0x04, 0xaa, 0xe8, 0xf1, 0xbb, 0x57, 0xbe, 0x99, 0x81, 0x2c, 0xff, 0x43, 0x42,
+	0x19, 0x8a, 0x7c, 0xb5, 0xd9, 0xab, 0x5d, 0x34, 0x87, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x2d, 0xf4,
+	0x33, 0x2a, 0x06, 0xc8, 0x75, 0x79, 0xfb, 0x67, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0xb7, 0x6e, 0xa2,
+	0xaf, 0xaa, 0x6c, 0xd8, 0xae, 0x20, 0x7e, 0x2e, 0x11, 0x6c, 0xde, 0x40, 0x5f, 0x4b, 0x05, 0x9b,
+	0x37, 0xd0, 0xcf, 0x27, 0x82, 0xeb, 0x9b, 0xe8, 0xeb, 0xa9, 0xe0, 0xfa, 0x26, 0xfa, 0x85, 0x44,
+	0x70, 0xf3, 0x06, 0xfa, 0xc5, 0x54, 0x70, 0xf3, 0x06, 0xfa, 0xa5, 0x3c, 0xf3, 0x85, 0x7b, 0x72,
+	0x7d, 0x13, 0xfd, 0x72, 0x21, 0xa5, 0x6e, 0xde, 0x40, 0xbf, 0x52, 0x60, 0xeb, 0x9f, 0xae, 0x2a,
+	0xfa, 0x55, 0xc4, 0xcc, 0x64, 0x0b, 0x84, 0x7e, 0x8d, 0x37, 0x99, 0x08, 0xfd, 0x3a, 0x62, 0x3e,
+	0x32, 0x2e, 0x27, 0xbf, 0xc1, 0x25, 0x0f, 0x0d, 0x9d, 0xa0, 0xdf, 0xc8, 0x8b, 0xb7, 0xc2, 0xb5,
+	0x7a, 0x53, 0x6f, 0x20, 0xcc, 0x7b, 0x30, 0x54, 0x7e, 0xeb, 0x1a, 0x6b, 0xb2, 0xf0, 0x44, 0xbf,
+	0xdd, 0x66, 0x13, 0xde, 0xd7, 0x49, 0xed, 0x3d, 0x9d, 0xa0, 0xdf, 0xb9, 0xc6, 0x26, 0xbc, 0xaf,
+	0x13, 0x89, 0xd7, 0xef, 0xb6, 0x99, 0x22, 0x17, 0xfd, 0xde, 0x35, 0x66, 0xb4, 0xe4, 0x7f, 0xd8,
+	0xc6, 0x05, 0xc8, 0x6c, 0xd5, 0x2d, 0xf4, 0x4d, 0x3e, 0x1b, 0x0b, 0x51, 0xf4, 0xfb, 0x88, 0x31,
+	0x3b, 0x86, 0x85, 0xbe, 0xc5, 0x98, 0x39, 0xab, 0xdb, 0x6e, 0x18, 0xe8, 0x35, 0x66, 0xdc, 0x6d,
+	0xa3, 0xd5, 0x34, 0x2c, 0xf2, 0x10, 0xfd, 0x01, 0x57, 0xbf, 0xd3, 0x69, 0x99, 0xe8, 0xdb, 0x88,
+	0x3d, 0xff, 0x35, 0xbe, 0xd2, 0x26, 0x46, 0xa7, 0x53, 0x6f, 0x99, 0xe8, 0x8d, 0x2b, 0x3b, 0x80,
+	0x4e, 0xa7, 0x03, 0xe6, 0x40, 0xd7, 0xbc, 0x6b, 0xb6, 0x1e, 0x98, 0x68, 0x8e, 0x11, 0x6d, 0x62,
+	0xb4, 0x75, 0x62, 0x20, 0x05, 0x03, 0xe4, 0xe5, 0x0b, 0x64, 0x15, 0x2f, 0x40, 0x81, 0xb4, 0x1a,
+	0x8d, 0x2d, 0xbd, 0x76, 0x17, 0x65, 0xb6, 0x8c, 0xbf, 0xf8, 0xf8, 0x92, 0xf2, 0xd7, 0x1f, 0x5f,
+	0x52, 0xbe, 0xf3, 0xf1, 0x25, 0xe5, 0xc3, 0x7f, 0xbe, 0x34, 0x07, 0x8b, 0x6e, 0xb0, 0x71, 0xec,
+	0xc6, 0x34, 0x8a, 0xc4, 0x5f, 0x60, 0xde, 0xd7, 0x24, 0xe5, 0x06, 0x57, 0x45, 0xeb, 0xea, 0x20,
+	0xb8, 0x7a, 0x1c, 0x5f, 0xe5, 0xd2, 0xab, 0x3c, 0x83, 0xec, 0xe6, 0x39, 0x71, 0xfd, 0xff, 0x06,
+	0x00, 0x04, 0x7d, 0x69, 0xb6, 0x60, 0x33, 0x00, 0x00,
 }
 
 func (m *Target) Marshal() (dAtA []byte, err error) {
@@ -5403,6 +5646,25 @@ func (m *ExecuteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.QueryTags) > 0 {
+		for k := range m.QueryTags {
+			v := m.QueryTags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.HasCreatedTempTables {
 		i--
 		if m.HasCreatedTempTables {
@@ -7443,7 +7705,7 @@ func (m *ReadTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *BeginExecuteRequest) Marshal() (dAtA []byte, err error) {
+func (m *PreparedTransaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7453,12 +7715,12 @@ func (m *BeginExecuteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BeginExecuteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PreparedTransaction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BeginExecuteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PreparedTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7467,23 +7729,72 @@ func (m *BeginExecuteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PreQueries) > 0 {
-		for iNdEx := len(m.PreQueries) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PreQueries[iNdEx])
-			copy(dAtA[i:], m.PreQueries[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PreQueries[iNdEx])))
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Queries[iNdEx])
+			copy(dAtA[i:], m.Queries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Queries[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x22
 		}
 	}
-	if m.ReservedId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedId))
+	if m.TimeCreated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeCreated))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x18
 	}
-	if m.Options != nil {
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Dtid) > 0 {
+		i -= len(m.Dtid)
+		copy(dAtA[i:], m.Dtid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Dtid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnresolvedTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnresolvedTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnresolvedTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AbandonAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AbandonAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Target != nil {
 		{
-			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7491,7 +7802,139 @@ func (m *BeginExecuteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
+	}
+	if m.ImmediateCallerId != nil {
+		{
+			size, err := m.ImmediateCallerId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EffectiveCallerId != nil {
+		{
+			size, err := m.EffectiveCallerId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnresolvedTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnresolvedTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnresolvedTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Prepared) > 0 {
+		for iNdEx := len(m.Prepared) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prepared[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeginExecuteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginExecuteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginExecuteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PreQueries) > 0 {
+		for iNdEx := len(m.PreQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreQueries[iNdEx])
+			copy(dAtA[i:], m.PreQueries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PreQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ReservedId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.Query != nil {
 		{
@@ -8502,6 +8945,15 @@ func (m *RealtimeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TableSchemaChanged) > 0 {
+		for iNdEx := len(m.TableSchemaChanged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TableSchemaChanged[iNdEx])
+			copy(dAtA[i:], m.TableSchemaChanged[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TableSchemaChanged[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Qps != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Qps))))
@@ -8908,6 +9360,14 @@ func (m *ExecuteOptions) Size() (n int) {
 	if m.HasCreatedTempTables {
 		n += 2
 	}
+	if len(m.QueryTags) > 0 {
+		for k, v := range m.QueryTags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9750,6 +10210,85 @@ func (m *ReadTransactionResponse) Size() (n int) {
 	return n
 }
 
+func (m *PreparedTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dtid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Failed {
+		n += 2
+	}
+	if m.TimeCreated != 0 {
+		n += 1 + sovQuery(uint64(m.TimeCreated))
+	}
+	if len(m.Queries) > 0 {
+		for _, s := range m.Queries {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnresolvedTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveCallerId != nil {
+		l = m.EffectiveCallerId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ImmediateCallerId != nil {
+		l = m.ImmediateCallerId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AbandonAge != 0 {
+		n += 1 + sovQuery(uint64(m.AbandonAge))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnresolvedTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Prepared) > 0 {
+		for _, e := range m.Prepared {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *BeginExecuteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10193,6 +10732,12 @@ func (m *RealtimeStats) Size() (n int) {
 	if m.Qps != 0 {
 		n += 9
 	}
+	if len(m.TableSchemaChanged) > 0 {
+		for _, s := range m.TableSchemaChanged {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11358,45 +11903,172 @@ func (m *ExecuteOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasCreatedTempTables = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryTags", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Field) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.QueryTags == nil {
+				m.QueryTags = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.QueryTags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Field) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -16621,7 +17293,7 @@ func (m *ReadTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
+func (m *PreparedTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16644,17 +17316,17 @@ func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeginExecuteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreparedTransaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeginExecuteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreparedTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCallerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dtid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16664,33 +17336,29 @@ func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveCallerId == nil {
-				m.EffectiveCallerId = &vtrpc.CallerID{}
-			}
-			if err := m.EffectiveCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dtid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCallerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16700,33 +17368,36 @@ func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ImmediateCallerId == nil {
-				m.ImmediateCallerId = &VTGateCallerID{}
+			m.Failed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreated", wireType)
 			}
-			if err := m.ImmediateCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TimeCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeCreated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16736,31 +17407,81 @@ func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &Target{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Queries = append(m.Queries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnresolvedTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnresolvedTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnresolvedTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCallerId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16787,7 +17508,418 @@ func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
+			if m.EffectiveCallerId == nil {
+				m.EffectiveCallerId = &vtrpc.CallerID{}
+			}
+			if err := m.EffectiveCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCallerId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImmediateCallerId == nil {
+				m.ImmediateCallerId = &VTGateCallerID{}
+			}
+			if err := m.ImmediateCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbandonAge", wireType)
+			}
+			m.AbandonAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbandonAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnresolvedTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnresolvedTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnresolvedTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionMetadata{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prepared = append(m.Prepared, &PreparedTransaction{})
+			if err := m.Prepared[len(m.Prepared)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginExecuteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginExecuteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginExecuteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCallerId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveCallerId == nil {
+				m.EffectiveCallerId = &vtrpc.CallerID{}
+			}
+			if err := m.EffectiveCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCallerId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ImmediateCallerId == nil {
+				m.ImmediateCallerId = &VTGateCallerID{}
+			}
+			if err := m.ImmediateCallerId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
 				m.Query = &BoundQuery{}
 			}
 			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -19500,6 +20632,38 @@ func (m *RealtimeStats) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Qps = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableSchemaChanged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableSchemaChanged = append(m.TableSchemaChanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -30,46 +30,48 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x6e, 0x0e, 0x6d, 0xd0, 0x26, 0x84, 0xb2, 0xa5, 0x40, 0x9d, 0xe0, 0x36, 0xb9, 0x71, 0x49,
-	0x10, 0x20, 0x21, 0x15, 0x71, 0x68, 0x22, 0x2a, 0x10, 0xe2, 0xcf, 0x85, 0x0a, 0x81, 0x84, 0xb4,
-	0x71, 0x46, 0xc1, 0xaa, 0xe3, 0x4d, 0xbd, 0xeb, 0x14, 0xde, 0x84, 0x47, 0xe2, 0xc8, 0x23, 0xa0,
-	0xf0, 0x18, 0x5c, 0x50, 0x6c, 0xcf, 0x7a, 0x77, 0x63, 0xe7, 0xd6, 0xf9, 0xbe, 0x99, 0xaf, 0x93,
-	0x19, 0xcf, 0xb7, 0x84, 0x5e, 0x26, 0x10, 0xff, 0x10, 0x10, 0x2f, 0x02, 0x1f, 0xfa, 0xf3, 0x98,
-	0x4b, 0x4e, 0x9b, 0x3a, 0xe6, 0x34, 0xd2, 0x28, 0xa3, 0x9c, 0xdd, 0x71, 0x10, 0x85, 0x7c, 0x3a,
-	0x61, 0x92, 0x65, 0xc8, 0xc3, 0x7f, 0x2d, 0xb2, 0xfd, 0x7e, 0x95, 0x41, 0x8f, 0x49, 0xfd, 0xf9,
-	0x77, 0xf0, 0x13, 0x09, 0x74, 0xbf, 0x9f, 0x15, 0xe5, 0xb1, 0x07, 0x97, 0x09, 0x08, 0xe9, 0xdc,
-	0xb6, 0x61, 0x31, 0xe7, 0x91, 0x80, 0xde, 0x16, 0x7d, 0x49, 0x9a, 0x39, 0x38, 0x64, 0xd2, 0xff,
-	0x46, 0x1d, 0x33, 0x33, 0x05, 0x51, 0xa5, 0x5d, 0xca, 0x29, 0xa9, 0x37, 0xe4, 0xfa, 0x99, 0x8c,
-	0x81, 0xcd, 0xb0, 0x19, 0xcc, 0x37, 0x50, 0x14, 0xeb, 0x94, 0x93, 0xa8, 0xf6, 0xa0, 0x46, 0x1f,
-	0x93, 0xed, 0x21, 0x4c, 0x83, 0x88, 0xee, 0xe5, 0xa9, 0x69, 0x84, 0xf5, 0xb7, 0x4c, 0x50, 0x75,
-	0xf1, 0x84, 0xec, 0x8c, 0xf8, 0x6c, 0x16, 0x48, 0x8a, 0x19, 0x59, 0x88, 0x75, 0xfb, 0x16, 0xaa,
-	0x0a, 0x9f, 0x91, 0x6b, 0x1e, 0x0f, 0xc3, 0x31, 0xf3, 0x2f, 0x28, 0xce, 0x0b, 0x01, 0x2c, 0xbe,
-	0xb3, 0x86, 0xab, 0xf2, 0x63, 0x52, 0x7f, 0x17, 0xc3, 0x9c, 0xc5, 0xc5, 0x12, 0xf2, 0xd8, 0x5e,
-	0x82, 0x82, 0x55, 0xed, 0x5b, 0xd2, 0xca, 0xda, 0xc9, 0xa9, 0x09, 0xed, 0x18, 0x5d, 0x22, 0x8c,
-	0x4a, 0xf7, 0x2a, 0x58, 0x25, 0xf8, 0x91, 0xec, 0x62, 0x8b, 0x4a, 0xd2, 0xb5, 0x7a, 0xb7, 0x45,
-	0x0f, 0x2b, 0x79, 0x25, 0xfb, 0x89, 0xdc, 0x1c, 0xc5, 0xc0, 0x24, 0x7c, 0x88, 0x59, 0x24, 0x98,
-	0x2f, 0x03, 0x1e, 0x51, 0xac, 0x5b, 0x63, 0x50, 0xf8, 0xa8, 0x3a, 0x41, 0x29, 0x9f, 0x92, 0xc6,
-	0x99, 0x64, 0xb1, 0xcc, 0x57, 0x77, 0xa0, 0x3e, 0x0e, 0x85, 0xa1, 0x9a, 0x53, 0x46, 0x19, 0x3a,
-	0x20, 0xd5, 0x1e, 0x95, 0x4e, 0x81, 0xad, 0xe9, 0xe8, 0x94, 0xd2, 0xf9, 0x4a, 0xf6, 0x46, 0x3c,
-	0xf2, 0xc3, 0x64, 0x62, 0xfc, 0xd6, 0xae, 0x1a, 0xfc, 0x1a, 0x87, 0xba, 0xbd, 0x4d, 0x29, 0x4a,
-	0xdf, 0x23, 0x37, 0x3c, 0x60, 0x13, 0x5d, 0x1b, 0x97, 0x6a, 0xe1, 0xa8, 0xeb, 0x56, 0xd1, 0xfa,
-	0x29, 0xa7, 0xc7, 0x80, 0xe7, 0xe7, 0xe8, 0x17, 0x62, 0x5d, 0x5f, 0xbb, 0x94, 0xd3, 0x17, 0xad,
-	0x33, 0x99, 0x35, 0x1c, 0x96, 0xd4, 0x18, 0xfe, 0x70, 0x54, 0x9d, 0xa0, 0x9b, 0xc4, 0x6b, 0x10,
-	0x82, 0x4d, 0x21, 0x3b, 0x7c, 0x65, 0x12, 0x06, 0x6a, 0x9b, 0x84, 0x45, 0x6a, 0x26, 0x31, 0x22,
-	0x24, 0x27, 0x4f, 0xfc, 0x0b, 0x7a, 0xd7, 0xcc, 0x3f, 0x29, 0xd6, 0x7d, 0x50, 0xc2, 0xe8, 0xf7,
-	0xe7, 0xc1, 0xca, 0x76, 0x01, 0x67, 0xd7, 0x51, 0xd3, 0xd6, 0x61, 0xfb, 0xfe, 0x6c, 0x56, 0xff,
-	0x7c, 0x72, 0xce, 0xd8, 0x48, 0xd7, 0xac, 0x2b, 0x5b, 0x4c, 0x6f, 0x53, 0x8a, 0x6e, 0x36, 0x1e,
-	0x84, 0xc0, 0x44, 0x61, 0x36, 0x79, 0x6c, 0x9b, 0x8d, 0x82, 0x55, 0xed, 0x2b, 0xd2, 0xcc, 0xe6,
-	0xf8, 0x02, 0x58, 0x28, 0x0b, 0xc7, 0xd7, 0x41, 0xfb, 0x33, 0x31, 0x39, 0x6d, 0xfc, 0xa7, 0xa4,
-	0x7e, 0x9e, 0x2f, 0xd2, 0xe9, 0x6b, 0x4f, 0xd4, 0xb9, 0xb9, 0xc7, 0x76, 0x29, 0xa7, 0xe9, 0x78,
-	0xa4, 0x81, 0x30, 0xbf, 0x12, 0xd4, 0x2d, 0xcb, 0xe7, 0x57, 0xa2, 0xf0, 0xaa, 0x2a, 0x5e, 0xd3,
-	0xfc, 0x42, 0x5a, 0xc5, 0xbf, 0x4a, 0x42, 0x29, 0x68, 0xb7, 0xbc, 0x8d, 0x15, 0x57, 0xcc, 0x7f,
-	0x43, 0x4a, 0x21, 0x3e, 0x7c, 0xfa, 0x6b, 0xe9, 0xd6, 0x7e, 0x2f, 0xdd, 0xda, 0x9f, 0xa5, 0x5b,
-	0xfb, 0xf9, 0xd7, 0xdd, 0xfa, 0x7c, 0x7f, 0x11, 0x48, 0x10, 0xa2, 0x1f, 0xf0, 0x41, 0xf6, 0xd7,
-	0x60, 0xca, 0x07, 0x0b, 0x39, 0x48, 0x5f, 0xeb, 0x81, 0xfe, 0xb2, 0x8f, 0x77, 0x52, 0xec, 0xd1,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x33, 0x99, 0xf7, 0xaa, 0x04, 0x08, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x5d, 0x1f, 0xb6, 0x22, 0xb7, 0x6c, 0xc3, 0x63, 0x83, 0xa5, 0x25, 0x5b, 0x2b, 0x78, 0xe0,
+	0xa5, 0x45, 0x80, 0x84, 0x34, 0xc4, 0xc3, 0x5a, 0x31, 0x81, 0x10, 0x5f, 0x19, 0x9b, 0x10, 0x48,
+	0x48, 0x6e, 0x7a, 0x55, 0xa2, 0xa5, 0x71, 0x17, 0xbb, 0x1d, 0xfc, 0x13, 0x7e, 0x12, 0x8f, 0xfc,
+	0x04, 0x54, 0xf8, 0x21, 0xa8, 0x49, 0xae, 0x63, 0xbb, 0x49, 0x79, 0xab, 0xcf, 0x39, 0xf7, 0xe4,
+	0xd6, 0xd7, 0x3e, 0x09, 0xa1, 0x97, 0x53, 0x88, 0xbf, 0x0b, 0x88, 0x67, 0x81, 0x0f, 0x9d, 0x49,
+	0xcc, 0x25, 0xa7, 0x75, 0x1d, 0x73, 0x6a, 0xc9, 0x2a, 0xa5, 0x9c, 0xed, 0x41, 0x10, 0x85, 0x7c,
+	0x34, 0x64, 0x92, 0xa5, 0xc8, 0xc3, 0xbf, 0x5b, 0x64, 0xfd, 0xfd, 0x42, 0x41, 0x8f, 0x48, 0xf5,
+	0xf9, 0x37, 0xf0, 0xa7, 0x12, 0xe8, 0x6e, 0x27, 0x2d, 0xca, 0xd6, 0x1e, 0x5c, 0x4e, 0x41, 0x48,
+	0x67, 0xcf, 0x86, 0xc5, 0x84, 0x47, 0x02, 0xda, 0x6b, 0xf4, 0x25, 0xa9, 0x67, 0x60, 0x8f, 0x49,
+	0xff, 0x2b, 0x75, 0x4c, 0x65, 0x02, 0xa2, 0x4b, 0xa3, 0x90, 0x53, 0x56, 0x6f, 0xc8, 0xf5, 0x53,
+	0x19, 0x03, 0x1b, 0x63, 0x33, 0xa8, 0x37, 0x50, 0x34, 0x6b, 0x16, 0x93, 0xe8, 0xf6, 0xa0, 0x42,
+	0x1f, 0x93, 0xf5, 0x1e, 0x8c, 0x82, 0x88, 0xee, 0x64, 0xd2, 0x64, 0x85, 0xf5, 0x37, 0x4d, 0x50,
+	0x75, 0xf1, 0x84, 0x6c, 0xf4, 0xf9, 0x78, 0x1c, 0x48, 0x8a, 0x8a, 0x74, 0x89, 0x75, 0xbb, 0x16,
+	0xaa, 0x0a, 0x9f, 0x91, 0x6b, 0x1e, 0x0f, 0xc3, 0x01, 0xf3, 0x2f, 0x28, 0xee, 0x17, 0x02, 0x58,
+	0x7c, 0x6b, 0x09, 0x57, 0xe5, 0x47, 0xa4, 0xfa, 0x2e, 0x86, 0x09, 0x8b, 0xf3, 0x21, 0x64, 0x6b,
+	0x7b, 0x08, 0x0a, 0x56, 0xb5, 0x6f, 0xc9, 0x66, 0xda, 0x4e, 0x46, 0x0d, 0x69, 0xd3, 0xe8, 0x12,
+	0x61, 0x74, 0xba, 0x53, 0xc2, 0x2a, 0xc3, 0x33, 0xb2, 0x8d, 0x2d, 0x2a, 0x4b, 0xd7, 0xea, 0xdd,
+	0x36, 0x3d, 0x28, 0xe5, 0x95, 0xed, 0x47, 0x72, 0xa3, 0x1f, 0x03, 0x93, 0xf0, 0x21, 0x66, 0x91,
+	0x60, 0xbe, 0x0c, 0x78, 0x44, 0xb1, 0x6e, 0x89, 0x41, 0xe3, 0xc3, 0x72, 0x81, 0x72, 0x3e, 0x21,
+	0xb5, 0x53, 0xc9, 0x62, 0x99, 0x8d, 0x6e, 0x5f, 0x1d, 0x0e, 0x85, 0xa1, 0x9b, 0x53, 0x44, 0x19,
+	0x3e, 0x20, 0xd5, 0x1c, 0x95, 0x4f, 0x8e, 0x2d, 0xf9, 0xe8, 0x94, 0xf2, 0xf9, 0x42, 0x76, 0xfa,
+	0x3c, 0xf2, 0xc3, 0xe9, 0xd0, 0xf8, 0xaf, 0x2d, 0xb5, 0xf1, 0x4b, 0x1c, 0xfa, 0xb6, 0x57, 0x49,
+	0x94, 0xbf, 0x47, 0xb6, 0x3c, 0x60, 0x43, 0xdd, 0x1b, 0x87, 0x6a, 0xe1, 0xe8, 0xeb, 0x96, 0xd1,
+	0xca, 0x73, 0x44, 0xf6, 0xce, 0xa2, 0x18, 0x04, 0x0f, 0x67, 0xa0, 0x4b, 0x04, 0xbd, 0x9b, 0xd5,
+	0x16, 0xd3, 0xf8, 0x84, 0x7b, 0xff, 0x51, 0xe9, 0x99, 0x91, 0xdc, 0x3a, 0xbc, 0xe7, 0x8e, 0x7e,
+	0x15, 0xad, 0x6b, 0xde, 0x28, 0xe4, 0xf4, 0x13, 0xa5, 0x33, 0x69, 0x06, 0x1d, 0x14, 0xd4, 0x18,
+	0x41, 0x74, 0x58, 0x2e, 0xd0, 0xd3, 0xe8, 0x35, 0x08, 0xc1, 0x46, 0x90, 0x26, 0x8c, 0x4a, 0x23,
+	0x03, 0xb5, 0xd3, 0xc8, 0x22, 0xb5, 0x34, 0xea, 0x13, 0x92, 0x91, 0xc7, 0xfe, 0x05, 0xbd, 0x6d,
+	0xea, 0x8f, 0xf3, 0x73, 0xb5, 0x5f, 0xc0, 0xe8, 0x17, 0xdd, 0x83, 0x45, 0xbe, 0x03, 0xee, 0x5d,
+	0x53, 0x8d, 0x55, 0x87, 0xed, 0x8b, 0x6e, 0xb3, 0xfa, 0x39, 0xcd, 0x38, 0x63, 0x22, 0x2d, 0xb3,
+	0xae, 0x68, 0x30, 0xed, 0x55, 0x12, 0x3d, 0xd5, 0x3c, 0x08, 0x81, 0x89, 0x3c, 0xd5, 0xb2, 0xb5,
+	0x9d, 0x6a, 0x0a, 0x56, 0xb5, 0xaf, 0x48, 0x3d, 0xdd, 0xc7, 0x17, 0xc0, 0x42, 0x99, 0xbf, 0x5a,
+	0x74, 0xd0, 0x3e, 0x26, 0x26, 0xa7, 0x6d, 0xff, 0x09, 0xa9, 0x9e, 0x67, 0x83, 0x74, 0x3a, 0xda,
+	0xbb, 0xf0, 0xdc, 0x9c, 0x63, 0xa3, 0x90, 0xd3, 0x7c, 0x3c, 0x52, 0x43, 0x98, 0x5f, 0x09, 0xea,
+	0x16, 0xe9, 0xf9, 0x95, 0xc8, 0x43, 0xb1, 0x8c, 0xd7, 0x3c, 0x3f, 0x93, 0xcd, 0xfc, 0x51, 0xd3,
+	0x50, 0x0a, 0xda, 0x2a, 0x6e, 0x63, 0xc1, 0xe5, 0xfb, 0xbf, 0x42, 0x92, 0x9b, 0xf7, 0x9e, 0xfe,
+	0x9c, 0xbb, 0x95, 0x5f, 0x73, 0xb7, 0xf2, 0x7b, 0xee, 0x56, 0x7e, 0xfc, 0x71, 0xd7, 0x3e, 0xdd,
+	0x9f, 0x05, 0x12, 0x84, 0xe8, 0x04, 0xbc, 0x9b, 0xfe, 0xea, 0x8e, 0x78, 0x77, 0x26, 0xbb, 0xc9,
+	0x67, 0x41, 0x57, 0xff, 0x84, 0x18, 0x6c, 0x24, 0xd8, 0xa3, 0x7f, 0x03, 0x00, 0x35, 0x39, 0x02,
+	0x12, 0x6d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -117,6 +119,9 @@ type QueryClient interface {
 	ConcludeTransaction(ctx context.Context, in *query.ConcludeTransactionRequest, opts ...grpc.CallOption) (*query.ConcludeTransactionResponse, error)
 	// ReadTransaction returns the 2pc transaction info.
 	ReadTransaction(ctx context.Context, in *query.ReadTransactionRequest, opts ...grpc.CallOption) (*query.ReadTransactionResponse, error)
+	// UnresolvedTransactions returns the 2pc transactions which are not
+	// resolved yet, as coordinator or participant.
+	UnresolvedTransactions(ctx context.Context, in *query.UnresolvedTransactionsRequest, opts ...grpc.CallOption) (*query.UnresolvedTransactionsResponse, error)
 	// BeginExecute executes a begin and the specified SQL query.
 	BeginExecute(ctx context.Context, in *query.BeginExecuteRequest, opts ...grpc.CallOption) (*query.BeginExecuteResponse, error)
 	// BeginExecuteBatch executes a begin and a list of queries.
@@ -296,6 +301,15 @@ func (c *queryClient) ReadTransaction(ctx context.Context, in *query.ReadTransac
 	return out, nil
 }
 
+func (c *queryClient) UnresolvedTransactions(ctx context.Context, in *query.UnresolvedTransactionsRequest, opts ...grpc.CallOption) (*query.UnresolvedTransactionsResponse, error) {
+	out := new(query.UnresolvedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/UnresolvedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BeginExecute(ctx context.Context, in *query.BeginExecuteRequest, opts ...grpc.CallOption) (*query.BeginExecuteResponse, error) {
 	out := new(query.BeginExecuteResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/BeginExecute", in, out, opts...)
@@ -545,6 +559,9 @@ type QueryServer interface {
 	ConcludeTransaction(context.Context, *query.ConcludeTransactionRequest) (*query.ConcludeTransactionResponse, error)
 	// ReadTransaction returns the 2pc transaction info.
 	ReadTransaction(context.Context, *query.ReadTransactionRequest) (*query.ReadTransactionResponse, error)
+	// UnresolvedTransactions returns the 2pc transactions which are not
+	// resolved yet, as coordinator or participant.
+	UnresolvedTransactions(context.Context, *query.UnresolvedTransactionsRequest) (*query.UnresolvedTransactionsResponse, error)
 	// BeginExecute executes a begin and the specified SQL query.
 	BeginExecute(context.Context, *query.BeginExecuteRequest) (*query.BeginExecuteResponse, error)
 	// BeginExecuteBatch executes a begin and a list of queries.
@@ -613,6 +630,9 @@ func (*UnimplementedQueryServer) ConcludeTransaction(ctx context.Context, req *q
 func (*UnimplementedQueryServer) ReadTransaction(ctx context.Context, req *query.ReadTransactionRequest) (*query.ReadTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadTransaction not implemented")
 }
+func (*UnimplementedQueryServer) UnresolvedTransactions(ctx context.Context, req *query.UnresolvedTransactionsRequest) (*query.UnresolvedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnresolvedTransactions not implemented")
+}
 func (*UnimplementedQueryServer) BeginExecute(ctx context.Context, req *query.BeginExecuteRequest) (*query.BeginExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginExecute not implemented")
 }
@@ -906,6 +926,24 @@ func _Query_ReadTransaction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnresolvedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.UnresolvedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnresolvedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/UnresolvedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnresolvedTransactions(ctx, req.(*query.UnresolvedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BeginExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.BeginExecuteRequest)
 	if err := dec(in); err != nil {
@@ -1175,6 +1213,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadTransaction",
 			Handler:    _Query_ReadTransaction_Handler,
 		},
+		{
+			MethodName: "UnresolvedTransactions",
+			Handler:    _Query_UnresolvedTransactions_Handler,
+		},
 		{
 			MethodName: "BeginExecute",
 			Handler:    _Query_BeginExecute_Handler,
@@ -966,6 +966,171 @@ func (m *GetPermissionsResponse) GetPermissions() *Permissions {
 	return nil
 }
 
+// HotRow describes a row (range) for which the hot row protection
+// automatically detected lock contention.
+type HotRow struct {
+	// key is the table name followed by the WHERE clause of the query.
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// contentions is the number of lock wait timeouts and deadlocks
+	// observed for the row (range) within the current decay period.
+	Contentions int64 `protobuf:"varint,3,opt,name=contentions,proto3" json:"contentions,omitempty"`
+	// protected_until is the unix timestamp (in seconds) until which
+	// transactions for this row (range) are serialized. It is 0 if the
+	// row (range) has not reached the detection threshold yet.
+	ProtectedUntil       int64    `protobuf:"varint,4,opt,name=protected_until,json=protectedUntil,proto3" json:"protected_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HotRow) Reset()         { *m = HotRow{} }
+func (m *HotRow) String() string { return proto.CompactTextString(m) }
+func (*HotRow) ProtoMessage()    {}
+func (*HotRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
+}
+func (m *HotRow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotRow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotRow.Merge(m, src)
+}
+func (m *HotRow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotRow proto.InternalMessageInfo
+
+func (m *HotRow) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HotRow) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *HotRow) GetContentions() int64 {
+	if m != nil {
+		return m.Contentions
+	}
+	return 0
+}
+
+func (m *HotRow) GetProtectedUntil() int64 {
+	if m != nil {
+		return m.ProtectedUntil
+	}
+	return 0
+}
+
+type GetHotRowsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHotRowsRequest) Reset()         { *m = GetHotRowsRequest{} }
+func (m *GetHotRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHotRowsRequest) ProtoMessage()    {}
+func (*GetHotRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
+}
+func (m *GetHotRowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHotRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHotRowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHotRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotRowsRequest.Merge(m, src)
+}
+func (m *GetHotRowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHotRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHotRowsRequest proto.InternalMessageInfo
+
+type GetHotRowsResponse struct {
+	HotRows              []*HotRow `protobuf:"bytes,1,rep,name=hot_rows,json=hotRows,proto3" json:"hot_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetHotRowsResponse) Reset()         { *m = GetHotRowsResponse{} }
+func (m *GetHotRowsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHotRowsResponse) ProtoMessage()    {}
+func (*GetHotRowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
+}
+func (m *GetHotRowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHotRowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHotRowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHotRowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotRowsResponse.Merge(m, src)
+}
+func (m *GetHotRowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHotRowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotRowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHotRowsResponse proto.InternalMessageInfo
+
+func (m *GetHotRowsResponse) GetHotRows() []*HotRow {
+	if m != nil {
+		return m.HotRows
+	}
+	return nil
+}
+
 type SetReadOnlyRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -976,7 +1141,7 @@ func (m *SetReadOnlyRequest) Reset()         { *m = SetReadOnlyRequest{} }
 func (m *SetReadOnlyRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyRequest) ProtoMessage()    {}
 func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
 }
 func (m *SetReadOnlyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1180,7 @@ func (m *SetReadOnlyResponse) Reset()         { *m = SetReadOnlyResponse{} }
 func (m *SetReadOnlyResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyResponse) ProtoMessage()    {}
 func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
 }
 func (m *SetReadOnlyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1219,7 @@ func (m *SetReadWriteRequest) Reset()         { *m = SetReadWriteRequest{} }
 func (m *SetReadWriteRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteRequest) ProtoMessage()    {}
 func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
 }
 func (m *SetReadWriteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,7 +1258,7 @@ func (m *SetReadWriteResponse) Reset()         { *m = SetReadWriteResponse{} }
 func (m *SetReadWriteResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteResponse) ProtoMessage()    {}
 func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
 }
 func (m *SetReadWriteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1298,7 @@ func (m *ChangeTypeRequest) Reset()         { *m = ChangeTypeRequest{} }
 func (m *ChangeTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeRequest) ProtoMessage()    {}
 func (*ChangeTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
 }
 func (m *ChangeTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1179,7 +1344,7 @@ func (m *ChangeTypeResponse) Reset()         { *m = ChangeTypeResponse{} }
 func (m *ChangeTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeResponse) ProtoMessage()    {}
 func (*ChangeTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
 }
 func (m *ChangeTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1218,7 +1383,7 @@ func (m *RefreshStateRequest) Reset()         { *m = RefreshStateRequest{} }
 func (m *RefreshStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateRequest) ProtoMessage()    {}
 func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
 }
 func (m *RefreshStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,7 +1422,7 @@ func (m *RefreshStateResponse) Reset()         { *m = RefreshStateResponse{} }
 func (m *RefreshStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateResponse) ProtoMessage()    {}
 func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
 }
 func (m *RefreshStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1461,7 @@ func (m *RunHealthCheckRequest) Reset()         { *m = RunHealthCheckRequest{} }
 func (m *RunHealthCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckRequest) ProtoMessage()    {}
 func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
 }
 func (m *RunHealthCheckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1335,7 +1500,7 @@ func (m *RunHealthCheckResponse) Reset()         { *m = RunHealthCheckResponse{}
 func (m *RunHealthCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckResponse) ProtoMessage()    {}
 func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
 }
 func (m *RunHealthCheckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1375,7 +1540,7 @@ func (m *IgnoreHealthErrorRequest) Reset()         { *m = IgnoreHealthErrorReque
 func (m *IgnoreHealthErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorRequest) ProtoMessage()    {}
 func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
 }
 func (m *IgnoreHealthErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1421,7 +1586,7 @@ func (m *IgnoreHealthErrorResponse) Reset()         { *m = IgnoreHealthErrorResp
 func (m *IgnoreHealthErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorResponse) ProtoMessage()    {}
 func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
 }
 func (m *IgnoreHealthErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1464,7 +1629,7 @@ func (m *ReloadSchemaRequest) Reset()         { *m = ReloadSchemaRequest{} }
 func (m *ReloadSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaRequest) ProtoMessage()    {}
 func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
 }
 func (m *ReloadSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1675,7 @@ func (m *ReloadSchemaResponse) Reset()         { *m = ReloadSchemaResponse{} }
 func (m *ReloadSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaResponse) ProtoMessage()    {}
 func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
 }
 func (m *ReloadSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1550,7 +1715,7 @@ func (m *PreflightSchemaRequest) Reset()         { *m = PreflightSchemaRequest{}
 func (m *PreflightSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaRequest) ProtoMessage()    {}
 func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
 }
 func (m *PreflightSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,7 +1764,7 @@ func (m *PreflightSchemaResponse) Reset()         { *m = PreflightSchemaResponse
 func (m *PreflightSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaResponse) ProtoMessage()    {}
 func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
 }
 func (m *PreflightSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,7 +1815,7 @@ func (m *ApplySchemaRequest) Reset()         { *m = ApplySchemaRequest{} }
 func (m *ApplySchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaRequest) ProtoMessage()    {}
 func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
 }
 func (m *ApplySchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1891,7 @@ func (m *ApplySchemaResponse) Reset()         { *m = ApplySchemaResponse{} }
 func (m *ApplySchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaResponse) ProtoMessage()    {}
 func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
 }
 func (m *ApplySchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1779,7 +1944,7 @@ func (m *LockTablesRequest) Reset()         { *m = LockTablesRequest{} }
 func (m *LockTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*LockTablesRequest) ProtoMessage()    {}
 func (*LockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
 }
 func (m *LockTablesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1818,7 +1983,7 @@ func (m *LockTablesResponse) Reset()         { *m = LockTablesResponse{} }
 func (m *LockTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*LockTablesResponse) ProtoMessage()    {}
 func (*LockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
 }
 func (m *LockTablesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1857,7 +2022,7 @@ func (m *UnlockTablesRequest) Reset()         { *m = UnlockTablesRequest{} }
 func (m *UnlockTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesRequest) ProtoMessage()    {}
 func (*UnlockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
 }
 func (m *UnlockTablesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1896,7 +2061,7 @@ func (m *UnlockTablesResponse) Reset()         { *m = UnlockTablesResponse{} }
 func (m *UnlockTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesResponse) ProtoMessage()    {}
 func (*UnlockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
 }
 func (m *UnlockTablesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1938,7 +2103,7 @@ func (m *ExecuteQueryRequest) Reset()         { *m = ExecuteQueryRequest{} }
 func (m *ExecuteQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteQueryRequest) ProtoMessage()    {}
 func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
 }
 func (m *ExecuteQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2164,7 @@ func (m *ExecuteQueryResponse) Reset()         { *m = ExecuteQueryResponse{} }
 func (m *ExecuteQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteQueryResponse) ProtoMessage()    {}
 func (*ExecuteQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
 }
 func (m *ExecuteQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2050,7 +2215,7 @@ func (m *ExecuteFetchAsDbaRequest) Reset()         { *m = ExecuteFetchAsDbaReque
 func (m *ExecuteFetchAsDbaRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
 }
 func (m *ExecuteFetchAsDbaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2125,7 +2290,7 @@ func (m *ExecuteFetchAsDbaResponse) Reset()         { *m = ExecuteFetchAsDbaResp
 func (m *ExecuteFetchAsDbaResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
 }
 func (m *ExecuteFetchAsDbaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2340,7 @@ func (m *ExecuteFetchAsAllPrivsRequest) Reset()         { *m = ExecuteFetchAsAll
 func (m *ExecuteFetchAsAllPrivsRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
 }
 func (m *ExecuteFetchAsAllPrivsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2243,7 +2408,7 @@ func (m *ExecuteFetchAsAllPrivsResponse) Reset()         { *m = ExecuteFetchAsAl
 func (m *ExecuteFetchAsAllPrivsResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
 }
 func (m *ExecuteFetchAsAllPrivsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2291,7 +2456,7 @@ func (m *ExecuteFetchAsAppRequest) Reset()         { *m = ExecuteFetchAsAppReque
 func (m *ExecuteFetchAsAppRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
 }
 func (m *ExecuteFetchAsAppRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2345,7 +2510,7 @@ func (m *ExecuteFetchAsAppResponse) Reset()         { *m = ExecuteFetchAsAppResp
 func (m *ExecuteFetchAsAppResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
 }
 func (m *ExecuteFetchAsAppResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2556,7 @@ func (m *ReplicationStatusRequest) Reset()         { *m = ReplicationStatusReque
 func (m *ReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicationStatusRequest) ProtoMessage()    {}
 func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
 }
 func (m *ReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2431,7 +2596,7 @@ func (m *ReplicationStatusResponse) Reset()         { *m = ReplicationStatusResp
 func (m *ReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicationStatusResponse) ProtoMessage()    {}
 func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
 }
 func (m *ReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2477,7 +2642,7 @@ func (m *MasterStatusRequest) Reset()         { *m = MasterStatusRequest{} }
 func (m *MasterStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*MasterStatusRequest) ProtoMessage()    {}
 func (*MasterStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
 }
 func (m *MasterStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,7 +2682,7 @@ func (m *MasterStatusResponse) Reset()         { *m = MasterStatusResponse{} }
 func (m *MasterStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MasterStatusResponse) ProtoMessage()    {}
 func (*MasterStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
 }
 func (m *MasterStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2563,7 +2728,7 @@ func (m *MasterPositionRequest) Reset()         { *m = MasterPositionRequest{} }
 func (m *MasterPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionRequest) ProtoMessage()    {}
 func (*MasterPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
 }
 func (m *MasterPositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2603,7 +2768,7 @@ func (m *MasterPositionResponse) Reset()         { *m = MasterPositionResponse{}
 func (m *MasterPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionResponse) ProtoMessage()    {}
 func (*MasterPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
 }
 func (m *MasterPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +2815,7 @@ func (m *WaitForPositionRequest) Reset()         { *m = WaitForPositionRequest{}
 func (m *WaitForPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionRequest) ProtoMessage()    {}
 func (*WaitForPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
 }
 func (m *WaitForPositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2696,7 +2861,7 @@ func (m *WaitForPositionResponse) Reset()         { *m = WaitForPositionResponse
 func (m *WaitForPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionResponse) ProtoMessage()    {}
 func (*WaitForPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
 }
 func (m *WaitForPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2735,7 +2900,7 @@ func (m *StopReplicationRequest) Reset()         { *m = StopReplicationRequest{}
 func (m *StopReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationRequest) ProtoMessage()    {}
 func (*StopReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
 }
 func (m *StopReplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2774,7 +2939,7 @@ func (m *StopReplicationResponse) Reset()         { *m = StopReplicationResponse
 func (m *StopReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationResponse) ProtoMessage()    {}
 func (*StopReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
 }
 func (m *StopReplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2815,7 +2980,7 @@ func (m *StopReplicationMinimumRequest) Reset()         { *m = StopReplicationMi
 func (m *StopReplicationMinimumRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationMinimumRequest) ProtoMessage()    {}
 func (*StopReplicationMinimumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
 }
 func (m *StopReplicationMinimumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2869,7 +3034,7 @@ func (m *StopReplicationMinimumResponse) Reset()         { *m = StopReplicationM
 func (m *StopReplicationMinimumResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationMinimumResponse) ProtoMessage()    {}
 func (*StopReplicationMinimumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
 }
 func (m *StopReplicationMinimumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2915,7 +3080,7 @@ func (m *StartReplicationRequest) Reset()         { *m = StartReplicationRequest
 func (m *StartReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationRequest) ProtoMessage()    {}
 func (*StartReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
 }
 func (m *StartReplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2954,7 +3119,7 @@ func (m *StartReplicationResponse) Reset()         { *m = StartReplicationRespon
 func (m *StartReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationResponse) ProtoMessage()    {}
 func (*StartReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
 }
 func (m *StartReplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2995,7 +3160,7 @@ func (m *StartReplicationUntilAfterRequest) Reset()         { *m = StartReplicat
 func (m *StartReplicationUntilAfterRequest) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationUntilAfterRequest) ProtoMessage()    {}
 func (*StartReplicationUntilAfterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
 }
 func (m *StartReplicationUntilAfterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3048,7 +3213,7 @@ func (m *StartReplicationUntilAfterResponse) Reset()         { *m = StartReplica
 func (m *StartReplicationUntilAfterResponse) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationUntilAfterResponse) ProtoMessage()    {}
 func (*StartReplicationUntilAfterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
 }
 func (m *StartReplicationUntilAfterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3087,7 +3252,7 @@ func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
 func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasRequest) ProtoMessage()    {}
 func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
 }
 func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3127,7 +3292,7 @@ func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
 func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasResponse) ProtoMessage()    {}
 func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
 }
 func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3173,7 +3338,7 @@ func (m *ResetReplicationRequest) Reset()         { *m = ResetReplicationRequest
 func (m *ResetReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationRequest) ProtoMessage()    {}
 func (*ResetReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
 }
 func (m *ResetReplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3212,7 +3377,7 @@ func (m *ResetReplicationResponse) Reset()         { *m = ResetReplicationRespon
 func (m *ResetReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationResponse) ProtoMessage()    {}
 func (*ResetReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
 }
 func (m *ResetReplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3252,7 +3417,7 @@ func (m *VReplicationExecRequest) Reset()         { *m = VReplicationExecRequest
 func (m *VReplicationExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecRequest) ProtoMessage()    {}
 func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
 }
 func (m *VReplicationExecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3299,7 +3464,7 @@ func (m *VReplicationExecResponse) Reset()         { *m = VReplicationExecRespon
 func (m *VReplicationExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecResponse) ProtoMessage()    {}
 func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
 }
 func (m *VReplicationExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3347,7 +3512,7 @@ func (m *VReplicationWaitForPosRequest) Reset()         { *m = VReplicationWaitF
 func (m *VReplicationWaitForPosRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosRequest) ProtoMessage()    {}
 func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
 }
 func (m *VReplicationWaitForPosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3400,7 +3565,7 @@ func (m *VReplicationWaitForPosResponse) Reset()         { *m = VReplicationWait
 func (m *VReplicationWaitForPosResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosResponse) ProtoMessage()    {}
 func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
 }
 func (m *VReplicationWaitForPosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3429,24 +3594,32 @@ func (m *VReplicationWaitForPosResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VReplicationWaitForPosResponse proto.InternalMessageInfo
 
-type InitMasterRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *InitMasterRequest) Reset()         { *m = InitMasterRequest{} }
-func (m *InitMasterRequest) String() string { return proto.CompactTextString(m) }
-func (*InitMasterRequest) ProtoMessage()    {}
-func (*InitMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
+type VDiffRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// action is one of create, resume, stop or show.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// vdiff_uuid identifies the vdiff. For show, "last" selects the
+	// most recent vdiff of the workflow.
+	VdiffUuid            string        `protobuf:"bytes,4,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	Options              *VDiffOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VDiffRequest) Reset()         { *m = VDiffRequest{} }
+func (m *VDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*VDiffRequest) ProtoMessage()    {}
+func (*VDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
 }
-func (m *InitMasterRequest) XXX_Unmarshal(b []byte) error {
+func (m *VDiffRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitMasterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitMasterRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VDiffRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3456,30 +3629,389 @@ func (m *InitMasterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *InitMasterRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitMasterRequest.Merge(m, src)
+func (m *VDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffRequest.Merge(m, src)
 }
-func (m *InitMasterRequest) XXX_Size() int {
+func (m *VDiffRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitMasterRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitMasterRequest.DiscardUnknown(m)
+func (m *VDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitMasterRequest proto.InternalMessageInfo
+var xxx_messageInfo_VDiffRequest proto.InternalMessageInfo
 
-type InitMasterResponse struct {
-	Position             string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+func (m *VDiffRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetOptions() *VDiffOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type VDiffOptions struct {
+	SourceCell  string `protobuf:"bytes,1,opt,name=source_cell,json=sourceCell,proto3" json:"source_cell,omitempty"`
+	TabletTypes string `protobuf:"bytes,2,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// filtered_replication_wait_time is in seconds.
+	FilteredReplicationWaitTime int64    `protobuf:"varint,3,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	Tables                      []string `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	// incremental only re-verifies the rows that changed since the
+	// tables were verified by the previous completed vdiff.
+	Incremental          bool     `protobuf:"varint,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InitMasterResponse) Reset()         { *m = InitMasterResponse{} }
+func (m *VDiffOptions) Reset()         { *m = VDiffOptions{} }
+func (m *VDiffOptions) String() string { return proto.CompactTextString(m) }
+func (*VDiffOptions) ProtoMessage()    {}
+func (*VDiffOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
+}
+func (m *VDiffOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffOptions.Merge(m, src)
+}
+func (m *VDiffOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffOptions proto.InternalMessageInfo
+
+func (m *VDiffOptions) GetSourceCell() string {
+	if m != nil {
+		return m.SourceCell
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetFilteredReplicationWaitTime() int64 {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return 0
+}
+
+func (m *VDiffOptions) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *VDiffOptions) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+type VDiffTableReport struct {
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// table_rows is the row estimate of the target table.
+	TableRows       int64 `protobuf:"varint,3,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	ProcessedRows   int64 `protobuf:"varint,4,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	MatchingRows    int64 `protobuf:"varint,5,opt,name=matching_rows,json=matchingRows,proto3" json:"matching_rows,omitempty"`
+	MismatchedRows  int64 `protobuf:"varint,6,opt,name=mismatched_rows,json=mismatchedRows,proto3" json:"mismatched_rows,omitempty"`
+	ExtraRowsSource int64 `protobuf:"varint,7,opt,name=extra_rows_source,json=extraRowsSource,proto3" json:"extra_rows_source,omitempty"`
+	ExtraRowsTarget int64 `protobuf:"varint,8,opt,name=extra_rows_target,json=extraRowsTarget,proto3" json:"extra_rows_target,omitempty"`
+	// incremental is true if only the rows that changed since
+	// the previous vdiff were compared.
+	Incremental          bool     `protobuf:"varint,9,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VDiffTableReport) Reset()         { *m = VDiffTableReport{} }
+func (m *VDiffTableReport) String() string { return proto.CompactTextString(m) }
+func (*VDiffTableReport) ProtoMessage()    {}
+func (*VDiffTableReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
+}
+func (m *VDiffTableReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffTableReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffTableReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffTableReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffTableReport.Merge(m, src)
+}
+func (m *VDiffTableReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffTableReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffTableReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffTableReport proto.InternalMessageInfo
+
+func (m *VDiffTableReport) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *VDiffTableReport) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *VDiffTableReport) GetTableRows() int64 {
+	if m != nil {
+		return m.TableRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetProcessedRows() int64 {
+	if m != nil {
+		return m.ProcessedRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetMatchingRows() int64 {
+	if m != nil {
+		return m.MatchingRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetMismatchedRows() int64 {
+	if m != nil {
+		return m.MismatchedRows
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetExtraRowsSource() int64 {
+	if m != nil {
+		return m.ExtraRowsSource
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetExtraRowsTarget() int64 {
+	if m != nil {
+		return m.ExtraRowsTarget
+	}
+	return 0
+}
+
+func (m *VDiffTableReport) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+type VDiffResponse struct {
+	VdiffUuid string `protobuf:"bytes,1,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// started_at and completed_at are unix timestamps in seconds,
+	// or 0 if not reached yet.
+	StartedAt            int64               `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt          int64               `protobuf:"varint,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Tables               []*VDiffTableReport `protobuf:"bytes,6,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *VDiffResponse) Reset()         { *m = VDiffResponse{} }
+func (m *VDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*VDiffResponse) ProtoMessage()    {}
+func (*VDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
+}
+func (m *VDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffResponse.Merge(m, src)
+}
+func (m *VDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffResponse proto.InternalMessageInfo
+
+func (m *VDiffResponse) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
+func (m *VDiffResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *VDiffResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *VDiffResponse) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *VDiffResponse) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *VDiffResponse) GetTables() []*VDiffTableReport {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type InitMasterRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitMasterRequest) Reset()         { *m = InitMasterRequest{} }
+func (m *InitMasterRequest) String() string { return proto.CompactTextString(m) }
+func (*InitMasterRequest) ProtoMessage()    {}
+func (*InitMasterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
+}
+func (m *InitMasterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InitMasterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InitMasterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InitMasterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitMasterRequest.Merge(m, src)
+}
+func (m *InitMasterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InitMasterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitMasterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitMasterRequest proto.InternalMessageInfo
+
+type InitMasterResponse struct {
+	Position             string   `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitMasterResponse) Reset()         { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()    {}
 func (*InitMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
 }
 func (m *InitMasterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3529,7 +4061,7 @@ func (m *PopulateReparentJournalRequest) Reset()         { *m = PopulateReparent
 func (m *PopulateReparentJournalRequest) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalRequest) ProtoMessage()    {}
 func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
 }
 func (m *PopulateReparentJournalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3596,7 +4128,7 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
 }
 func (m *PopulateReparentJournalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3638,7 +4170,7 @@ func (m *InitReplicaRequest) Reset()         { *m = InitReplicaRequest{} }
 func (m *InitReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*InitReplicaRequest) ProtoMessage()    {}
 func (*InitReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
 }
 func (m *InitReplicaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3698,7 +4230,7 @@ func (m *InitReplicaResponse) Reset()         { *m = InitReplicaResponse{} }
 func (m *InitReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*InitReplicaResponse) ProtoMessage()    {}
 func (*InitReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
 }
 func (m *InitReplicaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3737,7 +4269,7 @@ func (m *DemoteMasterRequest) Reset()         { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()    {}
 func (*DemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
 }
 func (m *DemoteMasterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3780,7 +4312,7 @@ func (m *DemoteMasterResponse) Reset()         { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()    {}
 func (*DemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
 }
 func (m *DemoteMasterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3834,7 +4366,7 @@ func (m *UndoDemoteMasterRequest) Reset()         { *m = UndoDemoteMasterRequest
 func (m *UndoDemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterRequest) ProtoMessage()    {}
 func (*UndoDemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
 }
 func (m *UndoDemoteMasterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3873,7 +4405,7 @@ func (m *UndoDemoteMasterResponse) Reset()         { *m = UndoDemoteMasterRespon
 func (m *UndoDemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterResponse) ProtoMessage()    {}
 func (*UndoDemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
 }
 func (m *UndoDemoteMasterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3912,7 +4444,7 @@ func (m *ReplicaWasPromotedRequest) Reset()         { *m = ReplicaWasPromotedReq
 func (m *ReplicaWasPromotedRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasPromotedRequest) ProtoMessage()    {}
 func (*ReplicaWasPromotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
 }
 func (m *ReplicaWasPromotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3951,7 +4483,7 @@ func (m *ReplicaWasPromotedResponse) Reset()         { *m = ReplicaWasPromotedRe
 func (m *ReplicaWasPromotedResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasPromotedResponse) ProtoMessage()    {}
 func (*ReplicaWasPromotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
 }
 func (m *ReplicaWasPromotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4526,7 @@ func (m *SetMasterRequest) Reset()         { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()    {}
 func (*SetMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
 }
 func (m *SetMasterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4061,7 +4593,7 @@ func (m *SetMasterResponse) Reset()         { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()    {}
 func (*SetMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
 }
 func (m *SetMasterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4102,7 +4634,7 @@ func (m *ReplicaWasRestartedRequest) Reset()         { *m = ReplicaWasRestartedR
 func (m *ReplicaWasRestartedRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasRestartedRequest) ProtoMessage()    {}
 func (*ReplicaWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
 }
 func (m *ReplicaWasRestartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4148,7 +4680,7 @@ func (m *ReplicaWasRestartedResponse) Reset()         { *m = ReplicaWasRestarted
 func (m *ReplicaWasRestartedResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasRestartedResponse) ProtoMessage()    {}
 func (*ReplicaWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
 }
 func (m *ReplicaWasRestartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4720,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
 }
 func (m *StopReplicationAndGetStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4240,7 +4772,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
 }
 func (m *StopReplicationAndGetStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4294,7 +4826,7 @@ func (m *PromoteReplicaRequest) Reset()         { *m = PromoteReplicaRequest{} }
 func (m *PromoteReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteReplicaRequest) ProtoMessage()    {}
 func (*PromoteReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
 }
 func (m *PromoteReplicaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4334,7 +4866,7 @@ func (m *PromoteReplicaResponse) Reset()         { *m = PromoteReplicaResponse{}
 func (m *PromoteReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteReplicaResponse) ProtoMessage()    {}
 func (*PromoteReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
 }
 func (m *PromoteReplicaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4382,7 +4914,7 @@ func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
 func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()    {}
 func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
 }
 func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4436,7 +4968,7 @@ func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
 func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()    {}
 func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
 }
 func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4482,7 +5014,7 @@ func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupReque
 func (m *RestoreFromBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()    {}
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
 }
 func (m *RestoreFromBackupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4522,7 +5054,7 @@ func (m *RestoreFromBackupResponse) Reset()         { *m = RestoreFromBackupResp
 func (m *RestoreFromBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()    {}
 func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
 }
 func (m *RestoreFromBackupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4571,7 +5103,7 @@ func (m *VExecRequest) Reset()         { *m = VExecRequest{} }
 func (m *VExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VExecRequest) ProtoMessage()    {}
 func (*VExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
 }
 func (m *VExecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4632,7 +5164,7 @@ func (m *VExecResponse) Reset()         { *m = VExecResponse{} }
 func (m *VExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VExecResponse) ProtoMessage()    {}
 func (*VExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
 }
 func (m *VExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4668,6 +5200,306 @@ func (m *VExecResponse) GetResult() *query.QueryResult {
 	return nil
 }
 
+// GCTable describes a table going through the table garbage collection
+// lifecycle (HOLD, PURGE, EVAC, DROP).
+type GCTable struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// state is the state of the table in the lifecycle, as encoded in its name.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Uuid  string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// time is the unix timestamp (in seconds) encoded in the table name, after
+	// which the table moves to its next state.
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	IsView               bool     `protobuf:"varint,5,opt,name=is_view,json=isView,proto3" json:"is_view,omitempty"`
+	TableRows            uint64   `protobuf:"varint,6,opt,name=table_rows,json=tableRows,proto3" json:"table_rows,omitempty"`
+	DataLength           uint64   `protobuf:"varint,7,opt,name=data_length,json=dataLength,proto3" json:"data_length,omitempty"`
+	IndexLength          uint64   `protobuf:"varint,8,opt,name=index_length,json=indexLength,proto3" json:"index_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTable) Reset()         { *m = GCTable{} }
+func (m *GCTable) String() string { return proto.CompactTextString(m) }
+func (*GCTable) ProtoMessage()    {}
+func (*GCTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
+}
+func (m *GCTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GCTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GCTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTable.Merge(m, src)
+}
+func (m *GCTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTable proto.InternalMessageInfo
+
+func (m *GCTable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GCTable) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GCTable) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *GCTable) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *GCTable) GetIsView() bool {
+	if m != nil {
+		return m.IsView
+	}
+	return false
+}
+
+func (m *GCTable) GetTableRows() uint64 {
+	if m != nil {
+		return m.TableRows
+	}
+	return 0
+}
+
+func (m *GCTable) GetDataLength() uint64 {
+	if m != nil {
+		return m.DataLength
+	}
+	return 0
+}
+
+func (m *GCTable) GetIndexLength() uint64 {
+	if m != nil {
+		return m.IndexLength
+	}
+	return 0
+}
+
+type GetGCTablesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGCTablesRequest) Reset()         { *m = GetGCTablesRequest{} }
+func (m *GetGCTablesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGCTablesRequest) ProtoMessage()    {}
+func (*GetGCTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
+}
+func (m *GetGCTablesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGCTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGCTablesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGCTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCTablesRequest.Merge(m, src)
+}
+func (m *GetGCTablesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGCTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCTablesRequest proto.InternalMessageInfo
+
+type GetGCTablesResponse struct {
+	Tables               []*GCTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetGCTablesResponse) Reset()         { *m = GetGCTablesResponse{} }
+func (m *GetGCTablesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGCTablesResponse) ProtoMessage()    {}
+func (*GetGCTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
+}
+func (m *GetGCTablesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGCTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGCTablesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGCTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCTablesResponse.Merge(m, src)
+}
+func (m *GetGCTablesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGCTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGCTablesResponse proto.InternalMessageInfo
+
+func (m *GetGCTablesResponse) GetTables() []*GCTable {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type CancelGCTableDropRequest struct {
+	// table_name is the name of the HOLD table to restore.
+	TableName string `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// original_name is the name the table is restored to. When empty, it is
+	// read from the online DDL migration which dropped the table.
+	OriginalName         string   `protobuf:"bytes,2,opt,name=original_name,json=originalName,proto3" json:"original_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelGCTableDropRequest) Reset()         { *m = CancelGCTableDropRequest{} }
+func (m *CancelGCTableDropRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelGCTableDropRequest) ProtoMessage()    {}
+func (*CancelGCTableDropRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{106}
+}
+func (m *CancelGCTableDropRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGCTableDropRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGCTableDropRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelGCTableDropRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGCTableDropRequest.Merge(m, src)
+}
+func (m *CancelGCTableDropRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGCTableDropRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGCTableDropRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGCTableDropRequest proto.InternalMessageInfo
+
+func (m *CancelGCTableDropRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *CancelGCTableDropRequest) GetOriginalName() string {
+	if m != nil {
+		return m.OriginalName
+	}
+	return ""
+}
+
+type CancelGCTableDropResponse struct {
+	// restored_name is the name the table was restored to.
+	RestoredName         string   `protobuf:"bytes,1,opt,name=restored_name,json=restoredName,proto3" json:"restored_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelGCTableDropResponse) Reset()         { *m = CancelGCTableDropResponse{} }
+func (m *CancelGCTableDropResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelGCTableDropResponse) ProtoMessage()    {}
+func (*CancelGCTableDropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{107}
+}
+func (m *CancelGCTableDropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelGCTableDropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelGCTableDropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelGCTableDropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGCTableDropResponse.Merge(m, src)
+}
+func (m *CancelGCTableDropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelGCTableDropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGCTableDropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGCTableDropResponse proto.InternalMessageInfo
+
+func (m *CancelGCTableDropResponse) GetRestoredName() string {
+	if m != nil {
+		return m.RestoredName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -4688,6 +5520,9 @@ func init() {
 	proto.RegisterType((*GetSchemaResponse)(nil), "tabletmanagerdata.GetSchemaResponse")
 	proto.RegisterType((*GetPermissionsRequest)(nil), "tabletmanagerdata.GetPermissionsRequest")
 	proto.RegisterType((*GetPermissionsResponse)(nil), "tabletmanagerdata.GetPermissionsResponse")
+	proto.RegisterType((*HotRow)(nil), "tabletmanagerdata.HotRow")
+	proto.RegisterType((*GetHotRowsRequest)(nil), "tabletmanagerdata.GetHotRowsRequest")
+	proto.RegisterType((*GetHotRowsResponse)(nil), "tabletmanagerdata.GetHotRowsResponse")
 	proto.RegisterType((*SetReadOnlyRequest)(nil), "tabletmanagerdata.SetReadOnlyRequest")
 	proto.RegisterType((*SetReadOnlyResponse)(nil), "tabletmanagerdata.SetReadOnlyResponse")
 	proto.RegisterType((*SetReadWriteRequest)(nil), "tabletmanagerdata.SetReadWriteRequest")
@@ -4742,6 +5577,10 @@ func init() {
 	proto.RegisterType((*VReplicationExecResponse)(nil), "tabletmanagerdata.VReplicationExecResponse")
 	proto.RegisterType((*VReplicationWaitForPosRequest)(nil), "tabletmanagerdata.VReplicationWaitForPosRequest")
 	proto.RegisterType((*VReplicationWaitForPosResponse)(nil), "tabletmanagerdata.VReplicationWaitForPosResponse")
+	proto.RegisterType((*VDiffRequest)(nil), "tabletmanagerdata.VDiffRequest")
+	proto.RegisterType((*VDiffOptions)(nil), "tabletmanagerdata.VDiffOptions")
+	proto.RegisterType((*VDiffTableReport)(nil), "tabletmanagerdata.VDiffTableReport")
+	proto.RegisterType((*VDiffResponse)(nil), "tabletmanagerdata.VDiffResponse")
 	proto.RegisterType((*InitMasterRequest)(nil), "tabletmanagerdata.InitMasterRequest")
 	proto.RegisterType((*InitMasterResponse)(nil), "tabletmanagerdata.InitMasterResponse")
 	proto.RegisterType((*PopulateReparentJournalRequest)(nil), "tabletmanagerdata.PopulateReparentJournalRequest")
@@ -4768,151 +5607,193 @@ func init() {
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
 	proto.RegisterType((*VExecRequest)(nil), "tabletmanagerdata.VExecRequest")
 	proto.RegisterType((*VExecResponse)(nil), "tabletmanagerdata.VExecResponse")
+	proto.RegisterType((*GCTable)(nil), "tabletmanagerdata.GCTable")
+	proto.RegisterType((*GetGCTablesRequest)(nil), "tabletmanagerdata.GetGCTablesRequest")
+	proto.RegisterType((*GetGCTablesResponse)(nil), "tabletmanagerdata.GetGCTablesResponse")
+	proto.RegisterType((*CancelGCTableDropRequest)(nil), "tabletmanagerdata.CancelGCTableDropRequest")
+	proto.RegisterType((*CancelGCTableDropResponse)(nil), "tabletmanagerdata.CancelGCTableDropResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4b, 0x6f, 0x1b, 0xd7,
-	0xd5, 0xdf, 0x50, 0x0f, 0x4b, 0x87, 0x0f, 0x49, 0x43, 0x4a, 0xa4, 0xe8, 0xcf, 0xb2, 0x3c, 0x76,
-	0x12, 0x23, 0x41, 0xa9, 0x46, 0x4e, 0x82, 0x20, 0x69, 0x8b, 0x48, 0xb6, 0x64, 0x27, 0x96, 0x63,
-	0x65, 0xe4, 0x47, 0x11, 0x14, 0x1d, 0x5c, 0x72, 0xae, 0xa8, 0x81, 0x86, 0x73, 0xc7, 0xf7, 0xde,
-	0x91, 0xc4, 0x4d, 0x7f, 0x42, 0xbb, 0xed, 0xaa, 0x9b, 0x02, 0xed, 0xbe, 0x3f, 0xa2, 0xe8, 0xb2,
-	0xab, 0x74, 0x5b, 0xb8, 0x3f, 0xa2, 0x8b, 0x2e, 0x5a, 0xdc, 0x17, 0x39, 0xc3, 0x19, 0xd9, 0x92,
-	0xe0, 0x02, 0xdd, 0xcd, 0x79, 0x3f, 0xee, 0xb9, 0xe7, 0x9c, 0x4b, 0x42, 0x93, 0xa3, 0x6e, 0x88,
-	0xf9, 0x00, 0x45, 0xa8, 0x8f, 0xa9, 0x8f, 0x38, 0xea, 0xc4, 0x94, 0x70, 0x62, 0x2f, 0xe5, 0x08,
-	0xed, 0xf2, 0xab, 0x04, 0xd3, 0xa1, 0xa2, 0xb7, 0x6b, 0x9c, 0xc4, 0x64, 0xcc, 0xdf, 0x5e, 0xa6,
-	0x38, 0x0e, 0x83, 0x1e, 0xe2, 0x01, 0x89, 0x52, 0xe8, 0x6a, 0x48, 0xfa, 0x09, 0x0f, 0x42, 0x05,
-	0x3a, 0xff, 0xb6, 0x60, 0xe1, 0x99, 0x50, 0xfc, 0x00, 0x1f, 0x06, 0x51, 0x20, 0x98, 0x6d, 0x1b,
-	0xa6, 0x23, 0x34, 0xc0, 0x2d, 0x6b, 0xdd, 0xba, 0x3b, 0xef, 0xca, 0x6f, 0x7b, 0x05, 0x66, 0x59,
-	0xef, 0x08, 0x0f, 0x50, 0xab, 0x24, 0xb1, 0x1a, 0xb2, 0x5b, 0x70, 0xad, 0x47, 0xc2, 0x64, 0x10,
-	0xb1, 0xd6, 0xd4, 0xfa, 0xd4, 0xdd, 0x79, 0xd7, 0x80, 0x76, 0x07, 0xea, 0x31, 0x0d, 0x06, 0x88,
-	0x0e, 0xbd, 0x63, 0x3c, 0xf4, 0x0c, 0xd7, 0xb4, 0xe4, 0x5a, 0xd2, 0xa4, 0xc7, 0x78, 0x78, 0x5f,
-	0xf3, 0xdb, 0x30, 0xcd, 0x87, 0x31, 0x6e, 0xcd, 0x28, 0xab, 0xe2, 0xdb, 0xbe, 0x09, 0x65, 0xe1,
-	0xba, 0x17, 0xe2, 0xa8, 0xcf, 0x8f, 0x5a, 0xb3, 0xeb, 0xd6, 0xdd, 0x69, 0x17, 0x04, 0x6a, 0x4f,
-	0x62, 0xec, 0xeb, 0x30, 0x4f, 0xc9, 0xa9, 0xd7, 0x23, 0x49, 0xc4, 0x5b, 0xd7, 0x24, 0x79, 0x8e,
-	0x92, 0xd3, 0xfb, 0x02, 0xb6, 0xef, 0xc0, 0xec, 0x61, 0x80, 0x43, 0x9f, 0xb5, 0xe6, 0xd6, 0xa7,
-	0xee, 0x96, 0x37, 0x2b, 0x1d, 0x95, 0xaf, 0x5d, 0x81, 0x74, 0x35, 0xcd, 0xf9, 0x83, 0x05, 0x8b,
-	0x07, 0x32, 0x98, 0x54, 0x0a, 0x3e, 0x80, 0x05, 0x61, 0xa5, 0x8b, 0x18, 0xf6, 0x74, 0xdc, 0x2a,
-	0x1b, 0x35, 0x83, 0x56, 0x22, 0xf6, 0x53, 0x50, 0xe7, 0xe2, 0xf9, 0x23, 0x61, 0xd6, 0x2a, 0x49,
-	0x73, 0x4e, 0x27, 0x7f, 0x94, 0x13, 0xa9, 0x76, 0x17, 0x79, 0x16, 0xc1, 0x44, 0x42, 0x4f, 0x30,
-	0x65, 0x01, 0x89, 0x5a, 0x53, 0xd2, 0xa2, 0x01, 0x85, 0xa3, 0xb6, 0xb2, 0x7a, 0xff, 0x08, 0x45,
-	0x7d, 0xec, 0x62, 0x96, 0x84, 0xdc, 0x7e, 0x04, 0xd5, 0x2e, 0x3e, 0x24, 0x34, 0xe3, 0x68, 0x79,
-	0xf3, 0x76, 0x81, 0xf5, 0xc9, 0x30, 0xdd, 0x8a, 0x92, 0xd4, 0xb1, 0xec, 0x42, 0x05, 0x1d, 0x72,
-	0x4c, 0xbd, 0xd4, 0x49, 0x5f, 0x50, 0x51, 0x59, 0x0a, 0x2a, 0xb4, 0xf3, 0x4f, 0x0b, 0x6a, 0xcf,
-	0x19, 0xa6, 0xfb, 0x98, 0x0e, 0x02, 0xc6, 0x74, 0x49, 0x1d, 0x11, 0xc6, 0x4d, 0x49, 0x89, 0x6f,
-	0x81, 0x4b, 0x18, 0xa6, 0xba, 0xa0, 0xe4, 0xb7, 0xfd, 0x11, 0x2c, 0xc5, 0x88, 0xb1, 0x53, 0x42,
-	0x7d, 0xaf, 0x77, 0x84, 0x7b, 0xc7, 0x2c, 0x19, 0xc8, 0x3c, 0x4c, 0xbb, 0x8b, 0x86, 0x70, 0x5f,
-	0xe3, 0xed, 0xef, 0x00, 0x62, 0x1a, 0x9c, 0x04, 0x21, 0xee, 0x63, 0x55, 0x58, 0xe5, 0xcd, 0x8f,
-	0x0b, 0xbc, 0xcd, 0xfa, 0xd2, 0xd9, 0x1f, 0xc9, 0xec, 0x44, 0x9c, 0x0e, 0xdd, 0x94, 0x92, 0xf6,
-	0x4f, 0x61, 0x61, 0x82, 0x6c, 0x2f, 0xc2, 0xd4, 0x31, 0x1e, 0x6a, 0xcf, 0xc5, 0xa7, 0xdd, 0x80,
-	0x99, 0x13, 0x14, 0x26, 0x58, 0x7b, 0xae, 0x80, 0x2f, 0x4a, 0x9f, 0x5b, 0xce, 0x0f, 0x16, 0x54,
-	0x1e, 0x74, 0xdf, 0x12, 0x77, 0x0d, 0x4a, 0x7e, 0x57, 0xcb, 0x96, 0xfc, 0xee, 0x28, 0x0f, 0x53,
-	0xa9, 0x3c, 0x3c, 0x2d, 0x08, 0x6d, 0xa3, 0x20, 0xb4, 0xb4, 0xb1, 0xff, 0x66, 0x60, 0xbf, 0xb7,
-	0xa0, 0x3c, 0xb6, 0xc4, 0xec, 0x3d, 0x58, 0x14, 0x7e, 0x7a, 0xf1, 0x18, 0xd7, 0xb2, 0xa4, 0x97,
-	0xb7, 0xde, 0x7a, 0x00, 0xee, 0x42, 0x92, 0x81, 0x99, 0xbd, 0x0b, 0x35, 0xbf, 0x9b, 0xd1, 0xa5,
-	0x6e, 0xd0, 0xcd, 0xb7, 0x44, 0xec, 0x56, 0xfd, 0x14, 0xc4, 0x9c, 0x0f, 0xa0, 0xbc, 0x1f, 0x44,
-	0x7d, 0x17, 0xbf, 0x4a, 0x30, 0xe3, 0xe2, 0x2a, 0xc5, 0x68, 0x18, 0x12, 0xe4, 0xeb, 0x20, 0x0d,
-	0xe8, 0xdc, 0x85, 0x8a, 0x62, 0x64, 0x31, 0x89, 0x18, 0x7e, 0x03, 0xe7, 0x87, 0x50, 0x39, 0x08,
-	0x31, 0x8e, 0x8d, 0xce, 0x36, 0xcc, 0xf9, 0x09, 0x95, 0x4d, 0x55, 0xb2, 0x4e, 0xb9, 0x23, 0xd8,
-	0x59, 0x80, 0xaa, 0xe6, 0x55, 0x6a, 0x9d, 0xbf, 0x59, 0x60, 0xef, 0x9c, 0xe1, 0x5e, 0xc2, 0xf1,
-	0x23, 0x42, 0x8e, 0x8d, 0x8e, 0xa2, 0xfe, 0xba, 0x06, 0x10, 0x23, 0x8a, 0x06, 0x98, 0x63, 0xaa,
-	0xc2, 0x9f, 0x77, 0x53, 0x18, 0x7b, 0x1f, 0xe6, 0xf1, 0x19, 0xa7, 0xc8, 0xc3, 0xd1, 0x89, 0xec,
-	0xb4, 0xe5, 0xcd, 0x7b, 0x05, 0xd9, 0xc9, 0x5b, 0xeb, 0xec, 0x08, 0xb1, 0x9d, 0xe8, 0x44, 0xd5,
-	0xc4, 0x1c, 0xd6, 0x60, 0xfb, 0x4b, 0xa8, 0x66, 0x48, 0x97, 0xaa, 0x87, 0x43, 0xa8, 0x67, 0x4c,
-	0xe9, 0x3c, 0xde, 0x84, 0x32, 0x3e, 0x0b, 0xb8, 0xc7, 0x38, 0xe2, 0x09, 0xd3, 0x09, 0x02, 0x81,
-	0x3a, 0x90, 0x18, 0x39, 0x46, 0xb8, 0x4f, 0x12, 0x3e, 0x1a, 0x23, 0x12, 0xd2, 0x78, 0x4c, 0xcd,
-	0x2d, 0xd0, 0x90, 0x73, 0x02, 0x8b, 0x0f, 0x31, 0x57, 0x7d, 0xc5, 0xa4, 0x6f, 0x05, 0x66, 0x65,
-	0xe0, 0xaa, 0xe2, 0xe6, 0x5d, 0x0d, 0xd9, 0xb7, 0xa1, 0x1a, 0x44, 0xbd, 0x30, 0xf1, 0xb1, 0x77,
-	0x12, 0xe0, 0x53, 0x26, 0x4d, 0xcc, 0xb9, 0x15, 0x8d, 0x7c, 0x21, 0x70, 0xf6, 0x7b, 0x50, 0xc3,
-	0x67, 0x8a, 0x49, 0x2b, 0x51, 0x63, 0xab, 0xaa, 0xb1, 0xb2, 0x41, 0x33, 0x07, 0xc3, 0x52, 0xca,
-	0xae, 0x8e, 0x6e, 0x1f, 0x96, 0x54, 0x67, 0x4c, 0x35, 0xfb, 0xcb, 0x74, 0xdb, 0x45, 0x36, 0x81,
-	0x71, 0x9a, 0xb0, 0xfc, 0x10, 0xf3, 0x54, 0x09, 0xeb, 0x18, 0x9d, 0xef, 0x61, 0x65, 0x92, 0xa0,
-	0x9d, 0xf8, 0x0a, 0xca, 0xd9, 0x4b, 0x27, 0xcc, 0xaf, 0x15, 0x98, 0x4f, 0x0b, 0xa7, 0x45, 0x9c,
-	0x06, 0xd8, 0x07, 0x98, 0xbb, 0x18, 0xf9, 0x4f, 0xa3, 0x70, 0x68, 0x2c, 0x2e, 0x43, 0x3d, 0x83,
-	0xd5, 0x25, 0x3c, 0x46, 0xbf, 0xa4, 0x01, 0xc7, 0x86, 0x7b, 0x05, 0x1a, 0x59, 0xb4, 0x66, 0xff,
-	0x06, 0x96, 0xd4, 0x70, 0x7a, 0x36, 0x8c, 0x0d, 0xb3, 0xfd, 0x29, 0x94, 0x95, 0x7b, 0x9e, 0x1c,
-	0xf0, 0xc2, 0xe5, 0xda, 0x66, 0xa3, 0x33, 0xda, 0x57, 0x64, 0xce, 0xb9, 0x94, 0x00, 0x3e, 0xfa,
-	0x16, 0x7e, 0xa6, 0x75, 0x8d, 0x1d, 0x72, 0xf1, 0x21, 0xc5, 0xec, 0x48, 0x94, 0x54, 0xda, 0xa1,
-	0x2c, 0x5a, 0xb3, 0x37, 0x61, 0xd9, 0x4d, 0xa2, 0x47, 0x18, 0x85, 0xfc, 0x48, 0x0e, 0x0e, 0x23,
-	0xd0, 0x82, 0x95, 0x49, 0x82, 0x16, 0xf9, 0x04, 0x5a, 0x5f, 0xf7, 0x23, 0x42, 0xb1, 0x22, 0xee,
-	0x50, 0x4a, 0x68, 0xa6, 0xa5, 0x70, 0x8e, 0x69, 0x34, 0x6e, 0x14, 0x12, 0x74, 0xae, 0xc3, 0x6a,
-	0x81, 0x94, 0x56, 0xf9, 0x85, 0x70, 0x5a, 0xf4, 0x93, 0x6c, 0x25, 0xdf, 0x86, 0xea, 0x29, 0x0a,
-	0xb8, 0x17, 0x13, 0x36, 0x2e, 0xa6, 0x79, 0xb7, 0x22, 0x90, 0xfb, 0x1a, 0xa7, 0x22, 0x4b, 0xcb,
-	0x6a, 0x9d, 0x9b, 0xb0, 0xb2, 0x4f, 0xf1, 0x61, 0x18, 0xf4, 0x8f, 0x26, 0x2e, 0x88, 0xd8, 0xc9,
-	0x64, 0xe2, 0xcc, 0x0d, 0x31, 0xa0, 0xd3, 0x87, 0x66, 0x4e, 0x46, 0xd7, 0xd5, 0x1e, 0xd4, 0x14,
-	0x97, 0x47, 0xe5, 0x5e, 0x61, 0xfa, 0xf9, 0x7b, 0xe7, 0x56, 0x76, 0x7a, 0x0b, 0x71, 0xab, 0xbd,
-	0x14, 0xc4, 0x9c, 0x7f, 0x59, 0x60, 0x6f, 0xc5, 0x71, 0x38, 0xcc, 0x7a, 0xb6, 0x08, 0x53, 0xec,
-	0x55, 0x68, 0x5a, 0x0c, 0x7b, 0x15, 0x8a, 0x16, 0x73, 0x48, 0x68, 0x0f, 0xeb, 0xcb, 0xaa, 0x00,
-	0xb1, 0x06, 0xa0, 0x30, 0x24, 0xa7, 0x5e, 0x6a, 0x87, 0x95, 0x9d, 0x61, 0xce, 0x5d, 0x94, 0x04,
-	0x77, 0x8c, 0xcf, 0x2f, 0x40, 0xd3, 0xef, 0x6a, 0x01, 0x9a, 0xb9, 0xe2, 0x02, 0xf4, 0x47, 0x0b,
-	0xea, 0x99, 0xe8, 0x75, 0x8e, 0xff, 0xf7, 0x56, 0xb5, 0x3a, 0x2c, 0xed, 0x91, 0xde, 0xb1, 0xea,
-	0x7a, 0xe6, 0x6a, 0x34, 0xc0, 0x4e, 0x23, 0xc7, 0x17, 0xef, 0x79, 0x14, 0xe6, 0x98, 0x57, 0xa0,
-	0x91, 0x45, 0x6b, 0x76, 0x6f, 0x34, 0x21, 0xbe, 0x13, 0x4b, 0xb7, 0xa9, 0x80, 0x06, 0xcc, 0xc8,
-	0x25, 0x5c, 0x86, 0x5e, 0x71, 0x15, 0x60, 0x37, 0xe1, 0x9a, 0xdf, 0xf5, 0xe4, 0x50, 0xd4, 0x73,
-	0xc1, 0xef, 0x7e, 0x2b, 0xc6, 0xe2, 0x2a, 0xcc, 0x0d, 0xd0, 0x99, 0x47, 0xc9, 0x29, 0xd3, 0x6b,
-	0xe0, 0xb5, 0x01, 0x3a, 0x73, 0xc9, 0x29, 0x73, 0xb6, 0xa1, 0x91, 0x35, 0xa0, 0x93, 0xfc, 0x21,
-	0xcc, 0xaa, 0x0a, 0xd6, 0xd9, 0xb5, 0xf5, 0xd6, 0x6f, 0xb8, 0x44, 0xb5, 0x6a, 0x0e, 0xe7, 0x4f,
-	0x16, 0xb4, 0xb4, 0x92, 0x5d, 0xcc, 0x7b, 0x47, 0x5b, 0xec, 0x41, 0x17, 0xbd, 0x73, 0x57, 0xe5,
-	0x6b, 0x22, 0x60, 0xf2, 0x99, 0xd0, 0x0d, 0xa2, 0x90, 0xf4, 0x99, 0xac, 0xd1, 0x39, 0xb7, 0xa6,
-	0xd1, 0xdb, 0x0a, 0x2b, 0x1a, 0x02, 0x95, 0x77, 0x3d, 0x5d, 0x81, 0x73, 0x6e, 0x85, 0xa6, 0x1a,
-	0x80, 0xf3, 0x10, 0x56, 0x0b, 0x7c, 0xbe, 0x42, 0xf4, 0xbf, 0xb6, 0xe0, 0x46, 0x56, 0xd3, 0x56,
-	0x18, 0x8a, 0x2d, 0x91, 0xbd, 0xfb, 0x14, 0xe4, 0x22, 0x9b, 0x2e, 0x88, 0x6c, 0x0f, 0xd6, 0xce,
-	0xf3, 0xe7, 0x0a, 0xe1, 0x3d, 0x9e, 0x3c, 0xdb, 0xad, 0x38, 0x7e, 0x73, 0x60, 0x69, 0xff, 0x4b,
-	0xd9, 0x6a, 0xcb, 0x25, 0x5d, 0x2a, 0xbb, 0x82, 0x57, 0x6d, 0x68, 0xa5, 0x9a, 0x97, 0x5a, 0x8b,
-	0xcc, 0x5d, 0xda, 0x83, 0xd5, 0x02, 0x9a, 0x36, 0xb2, 0x21, 0x56, 0xa4, 0xd1, 0x5a, 0x55, 0xde,
-	0x6c, 0x76, 0x26, 0x1f, 0xf8, 0x5a, 0x40, 0xb3, 0x89, 0x0b, 0xfb, 0x04, 0x31, 0x71, 0xd7, 0x33,
-	0x46, 0x9e, 0x40, 0x23, 0x8b, 0xd6, 0xfa, 0x3f, 0x9d, 0xd0, 0x7f, 0x23, 0xa7, 0x3f, 0x23, 0x66,
-	0xac, 0x34, 0x61, 0x59, 0xe1, 0xcd, 0xc0, 0x32, 0x76, 0x3e, 0x81, 0x95, 0x49, 0x82, 0xb6, 0xd4,
-	0x86, 0xb9, 0x89, 0x89, 0x37, 0x82, 0x85, 0xd4, 0x4b, 0x14, 0xf0, 0x5d, 0x32, 0xa9, 0xef, 0x8d,
-	0x52, 0xab, 0xd0, 0xcc, 0x49, 0xe9, 0x3e, 0xd4, 0x82, 0x95, 0x03, 0x4e, 0xe2, 0x54, 0x5e, 0x8d,
-	0x83, 0xab, 0xd0, 0xcc, 0x51, 0xb4, 0xd0, 0x2f, 0xe1, 0xc6, 0x04, 0xe9, 0x49, 0x10, 0x05, 0x83,
-	0x64, 0x70, 0x01, 0x67, 0xec, 0x5b, 0x20, 0x07, 0xb8, 0xc7, 0x83, 0x01, 0x36, 0x9b, 0xee, 0x94,
-	0x5b, 0x16, 0xb8, 0x67, 0x0a, 0xe5, 0xfc, 0x04, 0xd6, 0xce, 0xd3, 0x7f, 0x81, 0x1c, 0x49, 0xc7,
-	0x11, 0xe5, 0x05, 0x31, 0xb5, 0xa1, 0x95, 0x27, 0xe9, 0xa0, 0xba, 0x70, 0x6b, 0x92, 0xf6, 0x3c,
-	0xe2, 0x41, 0xb8, 0x25, 0xe6, 0xc1, 0x3b, 0x0a, 0xec, 0x0e, 0x38, 0x6f, 0xb2, 0xa1, 0x3d, 0x69,
-	0x80, 0xfd, 0x10, 0x1b, 0x9e, 0x51, 0x61, 0x7e, 0x04, 0xf5, 0x0c, 0x56, 0x67, 0xa2, 0x01, 0x33,
-	0xc8, 0xf7, 0xa9, 0xd9, 0x65, 0x14, 0x20, 0x72, 0xe0, 0x62, 0x86, 0xcf, 0xc9, 0x41, 0x9e, 0xa4,
-	0x2d, 0x6f, 0x40, 0xf3, 0x45, 0x0a, 0x2f, 0xae, 0x74, 0x61, 0x4b, 0x98, 0xd7, 0x2d, 0xc1, 0xd9,
-	0x85, 0x56, 0x5e, 0xe0, 0x4a, 0xcd, 0xe8, 0x46, 0x5a, 0xcf, 0xb8, 0x5a, 0x8d, 0xf9, 0x1a, 0x94,
-	0x02, 0x5f, 0xbf, 0x98, 0x4a, 0x81, 0x9f, 0x39, 0x88, 0xd2, 0x44, 0x01, 0xac, 0xc3, 0xda, 0x79,
-	0xca, 0x74, 0x9c, 0x75, 0x58, 0xfa, 0x3a, 0x0a, 0xb8, 0xba, 0x80, 0x26, 0x31, 0x3f, 0x06, 0x3b,
-	0x8d, 0xbc, 0x40, 0xa5, 0xfd, 0x60, 0xc1, 0xda, 0x3e, 0x89, 0x93, 0x50, 0xae, 0xd4, 0x31, 0xa2,
-	0x38, 0xe2, 0xdf, 0x90, 0x84, 0x46, 0x28, 0x34, 0x7e, 0xbf, 0x0f, 0x0b, 0xa2, 0x1e, 0xbc, 0x1e,
-	0xc5, 0x88, 0x63, 0xdf, 0x8b, 0xcc, 0xb3, 0xaf, 0x2a, 0xd0, 0xf7, 0x15, 0xf6, 0x5b, 0x26, 0x9e,
-	0x86, 0xa8, 0x27, 0x94, 0xa6, 0x07, 0x07, 0x28, 0x94, 0x1c, 0x1e, 0x9f, 0x43, 0x65, 0x20, 0x3d,
-	0xf3, 0x50, 0x18, 0x20, 0x35, 0x40, 0xca, 0x9b, 0xcb, 0x93, 0xcf, 0x84, 0x2d, 0x41, 0x74, 0xcb,
-	0x8a, 0x55, 0x02, 0xf6, 0xc7, 0xd0, 0x48, 0xb5, 0xaa, 0xf1, 0x36, 0x3d, 0x2d, 0x6d, 0xd4, 0x53,
-	0xb4, 0xd1, 0x52, 0x7d, 0x0b, 0x6e, 0x9e, 0x1b, 0x97, 0x4e, 0xe1, 0xef, 0x2c, 0x95, 0x2e, 0x9d,
-	0x68, 0x13, 0xef, 0x8f, 0x60, 0x56, 0xf1, 0xeb, 0x43, 0x3f, 0xc7, 0x41, 0xcd, 0x74, 0xae, 0x6f,
-	0xa5, 0x73, 0x7d, 0x2b, 0xca, 0xe8, 0x54, 0x41, 0x46, 0x45, 0x7f, 0xcf, 0xf8, 0x37, 0xde, 0xd3,
-	0x1e, 0xe0, 0x01, 0xe1, 0x38, 0x7b, 0xf8, 0xbf, 0xb1, 0xa0, 0x91, 0xc5, 0xeb, 0xf3, 0xbf, 0x07,
-	0x75, 0x1f, 0xc7, 0x14, 0xf7, 0xa4, 0xb1, 0x6c, 0x29, 0x6c, 0x97, 0x5a, 0x96, 0x6b, 0x8f, 0xc9,
-	0x23, 0x1f, 0xb7, 0xa1, 0xaa, 0x0f, 0x4b, 0xcf, 0x8c, 0xd2, 0x45, 0x66, 0x86, 0x3e, 0x60, 0x05,
-	0x89, 0x2b, 0xfc, 0x3c, 0xf2, 0x49, 0x91, 0xb3, 0x6d, 0x68, 0xe5, 0x49, 0x3a, 0xbe, 0xeb, 0xa3,
-	0x21, 0xf9, 0x12, 0xb1, 0x7d, 0x4a, 0x04, 0x8b, 0x6f, 0x04, 0xff, 0x1f, 0xda, 0x45, 0x44, 0x2d,
-	0xfa, 0x67, 0x0b, 0x16, 0x0f, 0x70, 0xf6, 0x56, 0x5c, 0xf6, 0x40, 0x0b, 0x4e, 0xa7, 0x54, 0x54,
-	0xef, 0x9f, 0x41, 0x53, 0xbe, 0x65, 0x44, 0x82, 0x28, 0x2f, 0x78, 0xc8, 0x2c, 0x4b, 0xf2, 0x64,
-	0xb7, 0xcc, 0xbf, 0x09, 0xa7, 0x0b, 0xde, 0x84, 0x75, 0x58, 0x4a, 0xc5, 0xa1, 0xa3, 0x7b, 0x9c,
-	0x8e, 0xdd, 0xc5, 0xd2, 0xee, 0x28, 0x33, 0x97, 0x0c, 0xd3, 0xb9, 0x01, 0xd7, 0x0b, 0x95, 0x69,
-	0x5b, 0xbf, 0x12, 0x7d, 0x3e, 0x33, 0xc0, 0xb6, 0x22, 0xff, 0x21, 0xe6, 0x99, 0x55, 0xc3, 0xfe,
-	0x39, 0x2c, 0x33, 0x4e, 0xe2, 0x74, 0xf0, 0xde, 0x80, 0xf8, 0xe6, 0x27, 0x80, 0x3b, 0x05, 0x1b,
-	0x4c, 0x76, 0x28, 0x12, 0x1f, 0xbb, 0x75, 0x96, 0x47, 0x8a, 0x17, 0xd6, 0xed, 0x37, 0x3a, 0x30,
-	0xfa, 0xb5, 0xa4, 0x7a, 0x34, 0xec, 0xd2, 0xc0, 0xf7, 0x2e, 0xb4, 0x3b, 0xc9, 0x7a, 0xaf, 0x28,
-	0x09, 0xfd, 0x8b, 0xd5, 0xcf, 0x46, 0x6b, 0x91, 0x2a, 0xf1, 0xf7, 0xdf, 0xe6, 0x74, 0x7e, 0x3f,
-	0xd2, 0x75, 0x98, 0x6d, 0x24, 0x62, 0xd3, 0x99, 0x24, 0x5c, 0xa0, 0x23, 0x1f, 0x40, 0x75, 0x1b,
-	0xf5, 0x8e, 0x93, 0xd1, 0x26, 0xbb, 0x0e, 0xe5, 0x1e, 0x89, 0x7a, 0x09, 0xa5, 0x38, 0xea, 0x0d,
-	0x75, 0xef, 0x4d, 0xa3, 0x04, 0x87, 0x7c, 0x33, 0xab, 0x72, 0xd1, 0x0f, 0xed, 0x34, 0xca, 0xf9,
-	0x0c, 0x6a, 0x46, 0xa9, 0x76, 0xe1, 0x0e, 0xcc, 0xe0, 0x93, 0x71, 0xb1, 0xd4, 0x3a, 0xe6, 0x5f,
-	0xa3, 0x1d, 0x81, 0x75, 0x15, 0x51, 0x4f, 0x5a, 0x4e, 0x28, 0xde, 0xa5, 0x64, 0x90, 0xf1, 0xcb,
-	0xd9, 0x12, 0xd7, 0x34, 0x47, 0xbb, 0x94, 0xfa, 0x5f, 0x40, 0xe5, 0xc5, 0x5b, 0x27, 0xb4, 0xc8,
-	0xd6, 0x29, 0xa1, 0xc7, 0x87, 0x21, 0x39, 0x35, 0x83, 0xd2, 0xc0, 0x82, 0x76, 0x8c, 0x87, 0x2c,
-	0x46, 0x3d, 0xac, 0x7f, 0x58, 0x1c, 0xc1, 0xce, 0x97, 0x50, 0x7d, 0x71, 0xd5, 0x71, 0xbe, 0xfd,
-	0xd5, 0x5f, 0x5e, 0xaf, 0x59, 0x7f, 0x7d, 0xbd, 0x66, 0xfd, 0xfd, 0xf5, 0x9a, 0xf5, 0xdb, 0x7f,
-	0xac, 0xfd, 0xdf, 0xf7, 0x9d, 0x93, 0x80, 0x63, 0xc6, 0x3a, 0x01, 0xd9, 0x50, 0x5f, 0x1b, 0x7d,
-	0xb2, 0x71, 0xc2, 0x37, 0xe4, 0xdf, 0x6c, 0x1b, 0xb9, 0x77, 0x79, 0x77, 0x56, 0x12, 0xee, 0xfd,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x82, 0x56, 0x16, 0xa4, 0xf0, 0x1b, 0x00, 0x00,
+	// 2801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x2f, 0x77, 0xf5, 0xb5, 0x6f, 0x3f, 0x24, 0x51, 0x2b, 0x69, 0x25, 0xd7, 0xb2, 0x4c, 0x3b,
+	0x89, 0x91, 0xa0, 0x52, 0xa3, 0x7c, 0x20, 0x4d, 0xda, 0x22, 0xb2, 0x64, 0xd9, 0x49, 0x9c, 0x58,
+	0xa1, 0x6c, 0xa7, 0x08, 0x8a, 0x10, 0x5c, 0x72, 0x76, 0x97, 0x10, 0x97, 0x43, 0xcf, 0x0c, 0x25,
+	0x2d, 0x0a, 0xf4, 0x4f, 0x68, 0xaf, 0x3d, 0xf5, 0x52, 0xa0, 0xbd, 0xf7, 0xd4, 0xbf, 0xa0, 0xe8,
+	0xb1, 0x3d, 0x34, 0xb9, 0x16, 0xee, 0x1f, 0xd1, 0x43, 0x0f, 0x2d, 0xe6, 0x8b, 0x4b, 0x72, 0x29,
+	0x59, 0x16, 0x8c, 0xa2, 0xb7, 0x9d, 0xdf, 0x7b, 0x6f, 0xe6, 0xbd, 0x37, 0x6f, 0xde, 0xbc, 0x37,
+	0x5c, 0x58, 0x65, 0x6e, 0x37, 0x44, 0x6c, 0xe8, 0x46, 0x6e, 0x1f, 0x11, 0xdf, 0x65, 0xee, 0x56,
+	0x4c, 0x30, 0xc3, 0xe6, 0xe2, 0x04, 0x61, 0xbd, 0xfe, 0x2c, 0x41, 0x64, 0x24, 0xe9, 0xeb, 0x2d,
+	0x86, 0x63, 0x3c, 0xe6, 0x5f, 0x5f, 0x26, 0x28, 0x0e, 0x03, 0xcf, 0x65, 0x01, 0x8e, 0x32, 0x70,
+	0x33, 0xc4, 0xfd, 0x84, 0x05, 0xa1, 0x1c, 0x5a, 0xff, 0x31, 0x60, 0xfe, 0x31, 0x9f, 0x78, 0x1f,
+	0xf5, 0x82, 0x28, 0xe0, 0xcc, 0xa6, 0x09, 0x53, 0x91, 0x3b, 0x44, 0x1d, 0x63, 0xd3, 0xb8, 0x53,
+	0xb3, 0xc5, 0x6f, 0x73, 0x05, 0x66, 0xa8, 0x37, 0x40, 0x43, 0xb7, 0x53, 0x11, 0xa8, 0x1a, 0x99,
+	0x1d, 0x98, 0xf5, 0x70, 0x98, 0x0c, 0x23, 0xda, 0xa9, 0x6e, 0x56, 0xef, 0xd4, 0x6c, 0x3d, 0x34,
+	0xb7, 0x60, 0x29, 0x26, 0xc1, 0xd0, 0x25, 0x23, 0xe7, 0x18, 0x8d, 0x1c, 0xcd, 0x35, 0x25, 0xb8,
+	0x16, 0x15, 0xe9, 0x33, 0x34, 0xda, 0x53, 0xfc, 0x26, 0x4c, 0xb1, 0x51, 0x8c, 0x3a, 0xd3, 0x72,
+	0x55, 0xfe, 0xdb, 0xbc, 0x01, 0x75, 0xae, 0xba, 0x13, 0xa2, 0xa8, 0xcf, 0x06, 0x9d, 0x99, 0x4d,
+	0xe3, 0xce, 0x94, 0x0d, 0x1c, 0x7a, 0x28, 0x10, 0xf3, 0x1a, 0xd4, 0x08, 0x3e, 0x75, 0x3c, 0x9c,
+	0x44, 0xac, 0x33, 0x2b, 0xc8, 0x73, 0x04, 0x9f, 0xee, 0xf1, 0xb1, 0x79, 0x1b, 0x66, 0x7a, 0x01,
+	0x0a, 0x7d, 0xda, 0x99, 0xdb, 0xac, 0xde, 0xa9, 0xef, 0x34, 0xb6, 0xa4, 0xbf, 0x0e, 0x38, 0x68,
+	0x2b, 0x9a, 0xf5, 0x7b, 0x03, 0x16, 0x8e, 0x84, 0x31, 0x19, 0x17, 0xbc, 0x01, 0xf3, 0x7c, 0x95,
+	0xae, 0x4b, 0x91, 0xa3, 0xec, 0x96, 0xde, 0x68, 0x69, 0x58, 0x8a, 0x98, 0x8f, 0x40, 0xee, 0x8b,
+	0xe3, 0xa7, 0xc2, 0xb4, 0x53, 0x11, 0xcb, 0x59, 0x5b, 0x93, 0x5b, 0x59, 0x70, 0xb5, 0xbd, 0xc0,
+	0xf2, 0x00, 0xe5, 0x0e, 0x3d, 0x41, 0x84, 0x06, 0x38, 0xea, 0x54, 0xc5, 0x8a, 0x7a, 0xc8, 0x15,
+	0x35, 0xe5, 0xaa, 0x7b, 0x03, 0x37, 0xea, 0x23, 0x1b, 0xd1, 0x24, 0x64, 0xe6, 0x03, 0x68, 0x76,
+	0x51, 0x0f, 0x93, 0x9c, 0xa2, 0xf5, 0x9d, 0x5b, 0x25, 0xab, 0x17, 0xcd, 0xb4, 0x1b, 0x52, 0x52,
+	0xd9, 0x72, 0x00, 0x0d, 0xb7, 0xc7, 0x10, 0x71, 0x32, 0x3b, 0x7d, 0xc9, 0x89, 0xea, 0x42, 0x50,
+	0xc2, 0xd6, 0xbf, 0x0c, 0x68, 0x3d, 0xa1, 0x88, 0x1c, 0x22, 0x32, 0x0c, 0x28, 0x55, 0x21, 0x35,
+	0xc0, 0x94, 0xe9, 0x90, 0xe2, 0xbf, 0x39, 0x96, 0x50, 0x44, 0x54, 0x40, 0x89, 0xdf, 0xe6, 0x5b,
+	0xb0, 0x18, 0xbb, 0x94, 0x9e, 0x62, 0xe2, 0x3b, 0xde, 0x00, 0x79, 0xc7, 0x34, 0x19, 0x0a, 0x3f,
+	0x4c, 0xd9, 0x0b, 0x9a, 0xb0, 0xa7, 0x70, 0xf3, 0x4b, 0x80, 0x98, 0x04, 0x27, 0x41, 0x88, 0xfa,
+	0x48, 0x06, 0x56, 0x7d, 0xe7, 0xed, 0x12, 0x6d, 0xf3, 0xba, 0x6c, 0x1d, 0xa6, 0x32, 0xf7, 0x22,
+	0x46, 0x46, 0x76, 0x66, 0x92, 0xf5, 0x9f, 0xc0, 0x7c, 0x81, 0x6c, 0x2e, 0x40, 0xf5, 0x18, 0x8d,
+	0x94, 0xe6, 0xfc, 0xa7, 0xd9, 0x86, 0xe9, 0x13, 0x37, 0x4c, 0x90, 0xd2, 0x5c, 0x0e, 0x3e, 0xac,
+	0x7c, 0x60, 0x58, 0xdf, 0x1a, 0xd0, 0xd8, 0xef, 0xbe, 0xc0, 0xee, 0x16, 0x54, 0xfc, 0xae, 0x92,
+	0xad, 0xf8, 0xdd, 0xd4, 0x0f, 0xd5, 0x8c, 0x1f, 0x1e, 0x95, 0x98, 0xb6, 0x5d, 0x62, 0xda, 0x7e,
+	0xf7, 0x7f, 0x63, 0xd8, 0xef, 0x0c, 0xa8, 0x8f, 0x57, 0xa2, 0xe6, 0x43, 0x58, 0xe0, 0x7a, 0x3a,
+	0xf1, 0x18, 0xeb, 0x18, 0x42, 0xcb, 0x9b, 0x2f, 0xdc, 0x00, 0x7b, 0x3e, 0xc9, 0x8d, 0xa9, 0x79,
+	0x00, 0x2d, 0xbf, 0x9b, 0x9b, 0x4b, 0x9e, 0xa0, 0x1b, 0x2f, 0xb0, 0xd8, 0x6e, 0xfa, 0x99, 0x11,
+	0xb5, 0xde, 0x80, 0xfa, 0x61, 0x10, 0xf5, 0x6d, 0xf4, 0x2c, 0x41, 0x94, 0xf1, 0xa3, 0x14, 0xbb,
+	0xa3, 0x10, 0xbb, 0xbe, 0x32, 0x52, 0x0f, 0xad, 0x3b, 0xd0, 0x90, 0x8c, 0x34, 0xc6, 0x11, 0x45,
+	0x17, 0x70, 0xbe, 0x09, 0x8d, 0xa3, 0x10, 0xa1, 0x58, 0xcf, 0xb9, 0x0e, 0x73, 0x7e, 0x42, 0x44,
+	0x52, 0x15, 0xac, 0x55, 0x3b, 0x1d, 0x5b, 0xf3, 0xd0, 0x54, 0xbc, 0x72, 0x5a, 0xeb, 0x3b, 0x03,
+	0xcc, 0x7b, 0x67, 0xc8, 0x4b, 0x18, 0x7a, 0x80, 0xf1, 0xb1, 0x9e, 0xa3, 0x2c, 0xbf, 0x6e, 0x00,
+	0xc4, 0x2e, 0x71, 0x87, 0x88, 0x21, 0x22, 0xcd, 0xaf, 0xd9, 0x19, 0xc4, 0x3c, 0x84, 0x1a, 0x3a,
+	0x63, 0xc4, 0x75, 0x50, 0x74, 0x22, 0x32, 0x6d, 0x7d, 0xe7, 0x9d, 0x12, 0xef, 0x4c, 0xae, 0xb6,
+	0x75, 0x8f, 0x8b, 0xdd, 0x8b, 0x4e, 0x64, 0x4c, 0xcc, 0x21, 0x35, 0x5c, 0xff, 0x08, 0x9a, 0x39,
+	0xd2, 0x4b, 0xc5, 0x43, 0x0f, 0x96, 0x72, 0x4b, 0x29, 0x3f, 0xde, 0x80, 0x3a, 0x3a, 0x0b, 0x98,
+	0x43, 0x99, 0xcb, 0x12, 0xaa, 0x1c, 0x04, 0x1c, 0x3a, 0x12, 0x88, 0xb8, 0x46, 0x98, 0x8f, 0x13,
+	0x96, 0x5e, 0x23, 0x62, 0xa4, 0x70, 0x44, 0xf4, 0x29, 0x50, 0x23, 0xeb, 0x04, 0x16, 0xee, 0x23,
+	0x26, 0xf3, 0x8a, 0x76, 0xdf, 0x0a, 0xcc, 0x08, 0xc3, 0x65, 0xc4, 0xd5, 0x6c, 0x35, 0x32, 0x6f,
+	0x41, 0x33, 0x88, 0xbc, 0x30, 0xf1, 0x91, 0x73, 0x12, 0xa0, 0x53, 0x2a, 0x96, 0x98, 0xb3, 0x1b,
+	0x0a, 0x7c, 0xca, 0x31, 0xf3, 0x35, 0x68, 0xa1, 0x33, 0xc9, 0xa4, 0x26, 0x91, 0xd7, 0x56, 0x53,
+	0xa1, 0x22, 0x41, 0x53, 0x0b, 0xc1, 0x62, 0x66, 0x5d, 0x65, 0xdd, 0x21, 0x2c, 0xca, 0xcc, 0x98,
+	0x49, 0xf6, 0x2f, 0x93, 0x6d, 0x17, 0x68, 0x01, 0xb1, 0x56, 0x61, 0xf9, 0x3e, 0x62, 0x99, 0x10,
+	0x56, 0x36, 0x5a, 0x5f, 0xc3, 0x4a, 0x91, 0xa0, 0x94, 0xf8, 0x18, 0xea, 0xf9, 0x43, 0xc7, 0x97,
+	0xdf, 0x28, 0x59, 0x3e, 0x2b, 0x9c, 0x15, 0xb1, 0x7e, 0x01, 0x33, 0x0f, 0x30, 0xb3, 0xf1, 0x69,
+	0xf9, 0x8e, 0x8b, 0x99, 0xf4, 0x8e, 0x8b, 0x81, 0xb9, 0x09, 0x75, 0x0f, 0x47, 0x0c, 0x45, 0xf2,
+	0x7a, 0xab, 0x8a, 0x6d, 0xcd, 0x42, 0xfc, 0xbe, 0xe4, 0xf5, 0x04, 0xf2, 0x18, 0xf2, 0x9d, 0x24,
+	0x62, 0x41, 0xd8, 0x99, 0x12, 0x5c, 0xad, 0x14, 0x7e, 0xc2, 0x51, 0x6b, 0x49, 0x38, 0x56, 0xae,
+	0x9f, 0x5a, 0xfb, 0x29, 0x98, 0x59, 0x50, 0x59, 0xfa, 0x2e, 0xcc, 0x0d, 0x30, 0x73, 0x08, 0x3e,
+	0xd5, 0xb9, 0x65, 0xad, 0xc4, 0x4c, 0x29, 0x65, 0xcf, 0x0e, 0xa4, 0xb4, 0xd5, 0x06, 0xf3, 0x08,
+	0x31, 0x1b, 0xb9, 0xfe, 0xa3, 0x28, 0x1c, 0xe9, 0x15, 0x96, 0x61, 0x29, 0x87, 0xaa, 0x03, 0x3a,
+	0x86, 0xbf, 0x22, 0x01, 0x43, 0x9a, 0x7b, 0x05, 0xda, 0x79, 0x58, 0xb1, 0x7f, 0x0a, 0x8b, 0xf2,
+	0xea, 0x7d, 0x3c, 0x8a, 0x35, 0xb3, 0xf9, 0x1e, 0xd4, 0xa5, 0x56, 0x8e, 0x28, 0x5f, 0xb8, 0x33,
+	0x5b, 0x3b, 0xed, 0xad, 0xb4, 0x1a, 0x13, 0x11, 0xc5, 0x84, 0x04, 0xb0, 0xf4, 0x37, 0xd7, 0x33,
+	0x3b, 0xd7, 0x58, 0x21, 0x1b, 0xf5, 0x08, 0xa2, 0x03, 0x7e, 0x60, 0xb2, 0x0a, 0xe5, 0x61, 0xc5,
+	0xbe, 0x0a, 0xcb, 0x76, 0x12, 0x3d, 0x40, 0x6e, 0xc8, 0x06, 0xe2, 0x5a, 0xd4, 0x02, 0x1d, 0x58,
+	0x29, 0x12, 0x94, 0xc8, 0xbb, 0xd0, 0xf9, 0xa4, 0x1f, 0x61, 0x82, 0x24, 0xf1, 0x1e, 0x21, 0x98,
+	0xe4, 0x12, 0x26, 0x63, 0x88, 0x44, 0xe3, 0x34, 0x28, 0x86, 0xd6, 0x35, 0x58, 0x2b, 0x91, 0x52,
+	0x53, 0x7e, 0xc8, 0x95, 0xe6, 0xd9, 0x32, 0x7f, 0x4e, 0x6f, 0x41, 0xf3, 0xd4, 0x0d, 0x98, 0x13,
+	0x63, 0x3a, 0x3e, 0x2a, 0x35, 0xbb, 0xc1, 0xc1, 0x43, 0x85, 0x49, 0xcb, 0xb2, 0xb2, 0x6a, 0xce,
+	0x1d, 0x58, 0x39, 0x24, 0xa8, 0x17, 0x06, 0xfd, 0x41, 0xe1, 0xf8, 0xf3, 0x8a, 0x53, 0x38, 0x4e,
+	0x9f, 0x7f, 0x3d, 0xb4, 0xfa, 0xb0, 0x3a, 0x21, 0xa3, 0x62, 0xe9, 0x21, 0xb4, 0x24, 0x97, 0x43,
+	0x44, 0xd5, 0xa4, 0x23, 0xea, 0xb5, 0x73, 0xcf, 0x6d, 0xb6, 0xc6, 0xb2, 0x9b, 0x5e, 0x66, 0x44,
+	0xad, 0x7f, 0x1b, 0x60, 0xee, 0xc6, 0x71, 0x38, 0xca, 0x6b, 0xb6, 0x00, 0x55, 0xfa, 0x2c, 0xd4,
+	0xc7, 0x89, 0x3e, 0x0b, 0xf9, 0x71, 0xea, 0x61, 0xe2, 0x21, 0x95, 0x8a, 0xe4, 0x80, 0x17, 0x39,
+	0x6e, 0x18, 0xe2, 0x53, 0x27, 0x53, 0xa1, 0x8b, 0x43, 0x35, 0x67, 0x2f, 0x08, 0x82, 0x3d, 0xc6,
+	0x27, 0xcb, 0xbb, 0xa9, 0x57, 0x55, 0xde, 0x4d, 0x5f, 0xb1, 0xbc, 0xfb, 0x83, 0x01, 0x4b, 0x39,
+	0xeb, 0x95, 0x8f, 0xff, 0xff, 0x0a, 0xd1, 0x25, 0x58, 0x7c, 0x88, 0xbd, 0x63, 0x99, 0xd3, 0xf5,
+	0xd1, 0x68, 0x83, 0x99, 0x05, 0xc7, 0x07, 0xef, 0x49, 0x14, 0x4e, 0x30, 0xaf, 0x40, 0x3b, 0x0f,
+	0x2b, 0x76, 0x27, 0xbd, 0xff, 0xbe, 0xe4, 0x2d, 0x85, 0x8e, 0x80, 0x36, 0x4c, 0x8b, 0x16, 0x43,
+	0x98, 0xde, 0xb0, 0xe5, 0xc0, 0x5c, 0x85, 0x59, 0xbf, 0xeb, 0x88, 0x2b, 0x5f, 0xdd, 0x7a, 0x7e,
+	0xf7, 0x0b, 0x7e, 0xe9, 0xaf, 0xc1, 0xdc, 0xd0, 0x3d, 0x93, 0x19, 0x4e, 0x16, 0xb9, 0xb3, 0x43,
+	0xf7, 0x4c, 0xa4, 0xb1, 0xbb, 0xd0, 0xce, 0x2f, 0xa0, 0x9c, 0xfc, 0x26, 0xcc, 0xc8, 0x08, 0x56,
+	0xde, 0x35, 0x55, 0x4f, 0xa3, 0xb9, 0x78, 0xb4, 0x2a, 0x0e, 0xeb, 0x8f, 0x06, 0x74, 0xd4, 0x24,
+	0x07, 0x88, 0x79, 0x83, 0x5d, 0xba, 0xdf, 0x75, 0x5f, 0xb9, 0xaa, 0xa2, 0x57, 0x0a, 0xa8, 0x68,
+	0x82, 0xba, 0x41, 0x14, 0xe2, 0x3e, 0x15, 0x31, 0x3a, 0x67, 0xb7, 0x14, 0x7c, 0x57, 0xa2, 0x3c,
+	0x21, 0x10, 0x71, 0xd6, 0xb3, 0x11, 0x38, 0x67, 0x37, 0x48, 0x26, 0x01, 0x58, 0xf7, 0x61, 0xad,
+	0x44, 0xe7, 0x2b, 0x58, 0xff, 0x2b, 0x03, 0xae, 0xe7, 0x67, 0xda, 0x0d, 0x43, 0x5e, 0x03, 0xd3,
+	0x57, 0xef, 0x82, 0x09, 0xcb, 0xa6, 0x4a, 0x2c, 0x7b, 0x08, 0x1b, 0xe7, 0xe9, 0x73, 0x05, 0xf3,
+	0x3e, 0x2b, 0xee, 0xed, 0x6e, 0x1c, 0x5f, 0x6c, 0x58, 0x56, 0xff, 0x4a, 0x3e, 0xda, 0x26, 0x9c,
+	0x2e, 0x26, 0xbb, 0x82, 0x56, 0xeb, 0xd0, 0xc9, 0x24, 0x2f, 0x59, 0xf4, 0xe9, 0xb3, 0xf4, 0x10,
+	0xd6, 0x4a, 0x68, 0x6a, 0x91, 0x6d, 0x5e, 0x00, 0xa6, 0x45, 0x63, 0x7d, 0x67, 0x75, 0xab, 0xf8,
+	0x7c, 0xa1, 0x04, 0x14, 0x1b, 0x3f, 0xb0, 0x9f, 0xbb, 0x94, 0x9f, 0xf5, 0xdc, 0x22, 0x9f, 0x43,
+	0x3b, 0x0f, 0xab, 0xf9, 0xdf, 0x2b, 0xcc, 0x7f, 0x7d, 0x62, 0xfe, 0x9c, 0x98, 0x5e, 0x65, 0x15,
+	0x96, 0x25, 0xae, 0x2f, 0x2c, 0xbd, 0xce, 0xbb, 0xb0, 0x52, 0x24, 0xa8, 0x95, 0xd6, 0x61, 0xae,
+	0x70, 0xe3, 0xa5, 0x63, 0x2e, 0xf5, 0x95, 0x1b, 0xb0, 0x03, 0x5c, 0x9c, 0xef, 0x42, 0xa9, 0x35,
+	0x58, 0x9d, 0x90, 0x52, 0x79, 0xa8, 0x03, 0x2b, 0x47, 0x0c, 0xc7, 0x19, 0xbf, 0x6a, 0x05, 0xd7,
+	0x60, 0x75, 0x82, 0xa2, 0x84, 0xbe, 0x81, 0xeb, 0x05, 0xd2, 0xe7, 0x41, 0x14, 0x0c, 0x93, 0xe1,
+	0x25, 0x94, 0x31, 0x6f, 0x82, 0xb8, 0xc0, 0x1d, 0x16, 0x0c, 0x91, 0xae, 0xe3, 0xab, 0x76, 0x9d,
+	0x63, 0x8f, 0x25, 0x64, 0xfd, 0x18, 0x36, 0xce, 0x9b, 0xff, 0x12, 0x3e, 0x12, 0x8a, 0xbb, 0x84,
+	0x95, 0xd8, 0xb4, 0x0e, 0x9d, 0x49, 0x92, 0x32, 0xaa, 0x0b, 0x37, 0x8b, 0x34, 0x51, 0x71, 0xee,
+	0xf2, 0xfb, 0xe0, 0x15, 0x19, 0x76, 0x1b, 0xac, 0x8b, 0xd6, 0x50, 0x9a, 0xb4, 0x45, 0x35, 0xab,
+	0x78, 0xd2, 0xc0, 0x7c, 0x0b, 0x96, 0x72, 0xa8, 0xf2, 0x44, 0x1b, 0xa6, 0x5d, 0xdf, 0x27, 0xba,
+	0x96, 0x91, 0x03, 0xee, 0x03, 0x1b, 0x51, 0x74, 0x8e, 0x0f, 0x26, 0x49, 0x6a, 0xe5, 0x6d, 0x58,
+	0x7d, 0x9a, 0xc1, 0xf9, 0x91, 0x2e, 0x4d, 0x09, 0x35, 0x95, 0x12, 0xac, 0x03, 0xe8, 0x4c, 0x0a,
+	0x5c, 0x29, 0x19, 0x5d, 0xcf, 0xce, 0x33, 0x8e, 0x56, 0xbd, 0x7c, 0x0b, 0x2a, 0x81, 0xaf, 0xfa,
+	0xc1, 0x4a, 0xe0, 0xe7, 0x36, 0xa2, 0x52, 0x08, 0x80, 0x4d, 0xd8, 0x38, 0x6f, 0x32, 0x65, 0xe7,
+	0x9f, 0x0c, 0x68, 0x3c, 0xdd, 0x0f, 0x7a, 0xbd, 0xcc, 0xbe, 0x1e, 0xa3, 0x11, 0x8d, 0x5d, 0x4f,
+	0x77, 0xd5, 0xe9, 0x98, 0xd3, 0x4e, 0x31, 0x39, 0xee, 0x85, 0xf8, 0x54, 0x2f, 0xa5, 0xc7, 0xbc,
+	0x95, 0x74, 0x3d, 0x36, 0x7e, 0x6b, 0x53, 0x23, 0xf3, 0x3a, 0xc0, 0x89, 0x1f, 0xf4, 0x7a, 0x4e,
+	0x92, 0x04, 0xbe, 0x48, 0xe6, 0x35, 0xbb, 0x26, 0x90, 0x27, 0x49, 0xe0, 0x9b, 0x3f, 0x82, 0x59,
+	0x1c, 0xcb, 0x5e, 0x48, 0x16, 0x51, 0x65, 0x0f, 0x15, 0x42, 0xc1, 0x47, 0x92, 0xcd, 0xd6, 0xfc,
+	0xd6, 0xdf, 0xb4, 0xea, 0x8a, 0xc2, 0x5b, 0x66, 0x8a, 0x13, 0xe2, 0x21, 0xc7, 0x43, 0xa1, 0x2e,
+	0x1e, 0x41, 0x42, 0x7b, 0x28, 0x0c, 0x79, 0x5c, 0x66, 0xfa, 0x0b, 0xaa, 0x6c, 0xa8, 0x8f, 0x5b,
+	0x09, 0x6a, 0xee, 0xc1, 0x46, 0x2f, 0x08, 0x19, 0x22, 0xc8, 0xcf, 0xd6, 0x94, 0x4e, 0x1a, 0xcf,
+	0xaa, 0x65, 0xbb, 0xa6, 0xb9, 0x0a, 0xee, 0xe5, 0xf1, 0x9d, 0x69, 0xab, 0xa7, 0x72, 0x6d, 0xf5,
+	0x26, 0xd4, 0x83, 0xc8, 0x23, 0x68, 0x88, 0x22, 0xe6, 0x86, 0xea, 0xce, 0xce, 0x42, 0xd6, 0xdf,
+	0x2b, 0xb0, 0x20, 0x6c, 0x12, 0x45, 0x92, 0x8d, 0x62, 0x4c, 0x18, 0x77, 0xa1, 0x7c, 0x18, 0xcd,
+	0x3c, 0x75, 0xd4, 0x04, 0x22, 0x2e, 0xd3, 0x36, 0x4c, 0x53, 0xe6, 0xb2, 0xb4, 0xd1, 0x14, 0x83,
+	0xb1, 0x50, 0x7a, 0xc9, 0x56, 0x95, 0x90, 0xb8, 0x66, 0x5f, 0x03, 0xde, 0x4e, 0x7a, 0x88, 0x52,
+	0xe4, 0x4b, 0x16, 0xd9, 0x64, 0x36, 0x53, 0x54, 0xdf, 0xc6, 0x43, 0x97, 0x79, 0x83, 0x20, 0xea,
+	0x4b, 0xae, 0x69, 0xc1, 0xd5, 0xd0, 0xa0, 0xae, 0x5a, 0x86, 0x01, 0x15, 0x90, 0x9e, 0x6c, 0x46,
+	0x76, 0xac, 0x63, 0x58, 0x30, 0xbe, 0x09, 0x8b, 0xf2, 0xe5, 0x85, 0xf3, 0x38, 0x72, 0x63, 0xc4,
+	0x53, 0x73, 0xd5, 0x9e, 0x17, 0x04, 0xce, 0x75, 0x24, 0xe0, 0x02, 0x2f, 0x73, 0x49, 0x1f, 0xb1,
+	0xce, 0x5c, 0x81, 0xf7, 0xb1, 0x80, 0x8b, 0x7e, 0xad, 0x4d, 0xfa, 0xf5, 0xb9, 0x01, 0x4d, 0x15,
+	0xe6, 0xea, 0x4c, 0xe6, 0xe3, 0xd2, 0x28, 0xc6, 0xe5, 0xb9, 0x4e, 0x0d, 0x5d, 0xca, 0x1c, 0xc4,
+	0x9b, 0x36, 0x15, 0xe8, 0x35, 0x8e, 0x88, 0x2e, 0x8e, 0x93, 0x29, 0x4f, 0x6a, 0xc8, 0x77, 0x5c,
+	0xa6, 0x1c, 0x5a, 0x53, 0xc8, 0x2e, 0xe3, 0xe1, 0xe7, 0xe1, 0x61, 0x1c, 0x22, 0xc5, 0x30, 0xad,
+	0x9b, 0x7f, 0x85, 0xed, 0x32, 0xf3, 0xa3, 0x34, 0x72, 0x66, 0x36, 0xab, 0xe7, 0x14, 0xea, 0xc5,
+	0xf8, 0xd0, 0xe1, 0xc5, 0x6b, 0xf4, 0x4f, 0xa2, 0x80, 0xc9, 0xcb, 0x54, 0x27, 0xb9, 0x1f, 0x82,
+	0x99, 0x05, 0x2f, 0x71, 0x6b, 0x7c, 0x6b, 0xc0, 0xc6, 0x21, 0x8e, 0x93, 0x50, 0xb4, 0xc7, 0xb1,
+	0x4b, 0x50, 0xc4, 0x3e, 0xc5, 0x09, 0x89, 0xdc, 0x50, 0x27, 0x89, 0xd7, 0x61, 0x9e, 0x9f, 0x05,
+	0xc7, 0x23, 0xc8, 0xe5, 0xc6, 0x44, 0xfa, 0x81, 0xaa, 0xc9, 0xe1, 0x3d, 0x89, 0x7e, 0x21, 0x4e,
+	0xa4, 0x4c, 0x03, 0xd9, 0x22, 0x10, 0x24, 0x24, 0x62, 0xf7, 0x03, 0x68, 0x0c, 0x85, 0x66, 0x8e,
+	0x1b, 0x06, 0xae, 0x8c, 0xd3, 0xfa, 0xce, 0x72, 0xb1, 0xe5, 0xdf, 0xe5, 0x44, 0xbb, 0x2e, 0x59,
+	0xc5, 0xc0, 0x7c, 0x1b, 0xda, 0xd9, 0xf3, 0x99, 0x5a, 0x23, 0x33, 0xcc, 0x52, 0x86, 0x96, 0x36,
+	0xc8, 0x37, 0xe1, 0xc6, 0xb9, 0x76, 0xa9, 0x74, 0xf8, 0x5b, 0x43, 0xba, 0x4b, 0x9d, 0x6a, 0x6d,
+	0xef, 0x0f, 0x60, 0x46, 0xf2, 0x77, 0x8c, 0x8b, 0x14, 0x54, 0x4c, 0xe7, 0xea, 0x56, 0x39, 0x57,
+	0xb7, 0x32, 0x8f, 0x56, 0x4b, 0x3c, 0xca, 0x6b, 0xb5, 0x9c, 0x7e, 0xe3, 0x9e, 0x6b, 0x1f, 0x0d,
+	0x31, 0x43, 0xf9, 0xcd, 0xff, 0xb5, 0x01, 0xed, 0x3c, 0xae, 0xf6, 0xff, 0x1d, 0x58, 0xf2, 0x51,
+	0x4c, 0x90, 0x27, 0x16, 0xcb, 0x87, 0xc2, 0xdd, 0x4a, 0xc7, 0xb0, 0xcd, 0x31, 0x39, 0xd5, 0xf1,
+	0x2e, 0x4f, 0x06, 0x62, 0xb3, 0x54, 0xfd, 0x57, 0xb9, 0x4c, 0xfd, 0xd7, 0x18, 0x66, 0x46, 0xfc,
+	0x3a, 0x7e, 0x12, 0xf9, 0xb8, 0x4c, 0xd9, 0x75, 0xe8, 0x4c, 0x92, 0x94, 0x7d, 0xd7, 0xd2, 0x82,
+	0xf7, 0x2b, 0x97, 0x1e, 0x12, 0xcc, 0x59, 0x7c, 0x2d, 0xf8, 0x7d, 0x58, 0x2f, 0x23, 0x2a, 0xd1,
+	0x3f, 0xf3, 0x8f, 0x52, 0x28, 0x7f, 0x2a, 0x5e, 0x76, 0x43, 0x4b, 0x76, 0xa7, 0x52, 0x16, 0xef,
+	0xef, 0xc3, 0xaa, 0x78, 0x97, 0x70, 0xc4, 0xa1, 0x2f, 0x79, 0x94, 0x58, 0x16, 0xe4, 0x62, 0xe5,
+	0x33, 0xf9, 0xbe, 0x33, 0x55, 0xf2, 0xbe, 0xb3, 0x04, 0x8b, 0x19, 0x3b, 0x94, 0x75, 0x9f, 0x65,
+	0x6d, 0xb7, 0x91, 0x4a, 0x36, 0x57, 0x33, 0xd3, 0xba, 0x0e, 0xd7, 0x4a, 0x27, 0x53, 0x6b, 0xfd,
+	0x92, 0xd7, 0x6c, 0xb9, 0x62, 0x74, 0x37, 0xf2, 0xf9, 0xdb, 0x6e, 0xb6, 0x6d, 0x30, 0x7f, 0x06,
+	0xcb, 0x94, 0xe1, 0x38, 0x77, 0x7b, 0x0e, 0xb1, 0xaf, 0x9f, 0xf3, 0x6e, 0x97, 0x74, 0x23, 0xf9,
+	0x02, 0x17, 0xfb, 0xc8, 0x5e, 0xa2, 0x93, 0x20, 0x7f, 0x2d, 0xb9, 0x75, 0xa1, 0x02, 0xe9, 0xbb,
+	0x6e, 0x73, 0x30, 0xea, 0x92, 0xc0, 0x77, 0x2e, 0xd5, 0x07, 0x89, 0x78, 0x6f, 0x48, 0x09, 0x89,
+	0x98, 0x3f, 0x4d, 0x5b, 0x1c, 0x19, 0xe2, 0xaf, 0xbf, 0x48, 0xe9, 0xc9, 0x5e, 0x47, 0xc5, 0x61,
+	0x3e, 0x91, 0xf0, 0xae, 0xa5, 0x48, 0xb8, 0x44, 0x46, 0x3e, 0x82, 0xe6, 0x5d, 0xd7, 0x3b, 0x4e,
+	0xd2, 0xae, 0x54, 0xbe, 0x22, 0x7b, 0x09, 0x21, 0x28, 0xf2, 0x46, 0x2a, 0xf7, 0x66, 0x21, 0xce,
+	0x21, 0xde, 0xbf, 0x64, 0xb8, 0xa8, 0x47, 0xb3, 0x2c, 0x64, 0xbd, 0x0f, 0x2d, 0x3d, 0xa9, 0x52,
+	0xe1, 0x36, 0x4c, 0xa3, 0x93, 0x71, 0xb0, 0xb4, 0xb6, 0xf4, 0xf7, 0xed, 0x7b, 0x1c, 0xb5, 0x25,
+	0x51, 0x55, 0xcd, 0x0c, 0x13, 0x74, 0x40, 0xf0, 0x30, 0xa7, 0x97, 0xb5, 0x0b, 0x6b, 0x25, 0xb4,
+	0x97, 0x9a, 0xfe, 0xe7, 0xd0, 0x78, 0xfa, 0xc2, 0x6a, 0xfb, 0xc2, 0x4a, 0x34, 0x5b, 0xc1, 0x56,
+	0xf3, 0x15, 0xac, 0xf5, 0x11, 0x34, 0x9f, 0x5e, 0xb9, 0x34, 0xff, 0xce, 0x80, 0xd9, 0xfb, 0x7b,
+	0xe2, 0xe0, 0x94, 0x7e, 0x78, 0x2a, 0xaf, 0x19, 0xf8, 0x37, 0x49, 0x5e, 0x62, 0xe8, 0x6f, 0x92,
+	0xbc, 0xba, 0xe0, 0x1f, 0xe8, 0x79, 0x2d, 0x29, 0x4b, 0x04, 0xf1, 0x9b, 0x3f, 0x96, 0x04, 0x54,
+	0x7c, 0x6e, 0x51, 0x85, 0xe1, 0x4c, 0x40, 0xf9, 0x87, 0x96, 0x42, 0x25, 0x27, 0x3f, 0xdc, 0x67,
+	0x2a, 0xb9, 0xc2, 0x87, 0xfd, 0xd9, 0x89, 0x0f, 0xfb, 0x37, 0xa1, 0x11, 0x44, 0x3e, 0x3a, 0xd3,
+	0x1c, 0x73, 0x82, 0xa3, 0x2e, 0x30, 0xc9, 0xa2, 0xfa, 0x2c, 0x65, 0x5b, 0xda, 0x67, 0x7d, 0x02,
+	0x4b, 0x39, 0x54, 0xb9, 0x6c, 0x27, 0xf7, 0xd1, 0xa8, 0xbe, 0xb3, 0x5e, 0x52, 0xa3, 0x28, 0xa1,
+	0xb4, 0x34, 0xf9, 0x06, 0x3a, 0x7b, 0x6e, 0xe4, 0xa1, 0x50, 0x11, 0xf6, 0x09, 0x4e, 0x83, 0xf9,
+	0x05, 0xe5, 0xed, 0x2d, 0x68, 0x62, 0x12, 0xf4, 0x83, 0xc8, 0x0d, 0xb3, 0x55, 0x44, 0x43, 0x83,
+	0x9c, 0xc9, 0xfa, 0x18, 0xd6, 0x4a, 0xe6, 0x57, 0x0a, 0x8b, 0x27, 0x25, 0x11, 0x95, 0x7e, 0x76,
+	0x8d, 0x86, 0x06, 0xf9, 0x0c, 0x77, 0x3f, 0xfe, 0xcb, 0xf3, 0x0d, 0xe3, 0xaf, 0xcf, 0x37, 0x8c,
+	0x7f, 0x3c, 0xdf, 0x30, 0x7e, 0xf3, 0xcf, 0x8d, 0xef, 0x7d, 0xbd, 0x75, 0x12, 0x30, 0x44, 0xe9,
+	0x56, 0x80, 0xb7, 0xe5, 0xaf, 0xed, 0x3e, 0xde, 0x3e, 0x61, 0xdb, 0xe2, 0xdf, 0x1e, 0xdb, 0x13,
+	0x36, 0x77, 0x67, 0x04, 0xe1, 0x9d, 0xff, 0x0e, 0x00, 0xdc, 0x86, 0xcd, 0x10, 0x77, 0x22, 0x00,
+	0x00,
 }
 
 func (m *TableDefinition) Marshal() (dAtA []byte, err error) {
@@ -5693,7 +6574,7 @@ func (m *GetPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SetReadOnlyRequest) Marshal() (dAtA []byte, err error) {
+func (m *HotRow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5703,12 +6584,12 @@ func (m *SetReadOnlyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetReadOnlyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HotRow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetReadOnlyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HotRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5717,10 +6598,34 @@ func (m *SetReadOnlyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ProtectedUntil != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.ProtectedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Contentions != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Contentions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetReadOnlyResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetHotRowsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5730,12 +6635,12 @@ func (m *SetReadOnlyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetReadOnlyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetHotRowsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetReadOnlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetHotRowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5747,7 +6652,7 @@ func (m *SetReadOnlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SetReadWriteRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetHotRowsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5757,12 +6662,12 @@ func (m *SetReadWriteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetHotRowsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetHotRowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5771,10 +6676,24 @@ func (m *SetReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HotRows) > 0 {
+		for iNdEx := len(m.HotRows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetReadWriteResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetReadOnlyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5784,12 +6703,12 @@ func (m *SetReadWriteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetReadOnlyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetReadOnlyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5801,7 +6720,7 @@ func (m *SetReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChangeTypeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetReadOnlyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5811,12 +6730,12 @@ func (m *ChangeTypeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetReadOnlyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetReadOnlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5825,15 +6744,10 @@ func (m *ChangeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TabletType != 0 {
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TabletType))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ChangeTypeResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetReadWriteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5843,12 +6757,12 @@ func (m *ChangeTypeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetReadWriteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetReadWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5860,7 +6774,7 @@ func (m *ChangeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetReadWriteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5870,12 +6784,12 @@ func (m *RefreshStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetReadWriteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetReadWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5887,7 +6801,7 @@ func (m *RefreshStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ChangeTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5897,12 +6811,12 @@ func (m *RefreshStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeTypeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5911,8 +6825,94 @@ func (m *RefreshStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
+	if m.TabletType != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TabletType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *RunHealthCheckRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -7524,7 +8524,7 @@ func (m *VReplicationWaitForPosResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *InitMasterRequest) Marshal() (dAtA []byte, err error) {
+func (m *VDiffRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7534,12 +8534,12 @@ func (m *InitMasterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InitMasterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VDiffRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InitMasterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7548,10 +8548,50 @@ func (m *InitMasterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VdiffUuid) > 0 {
+		i -= len(m.VdiffUuid)
+		copy(dAtA[i:], m.VdiffUuid)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.VdiffUuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InitMasterResponse) Marshal() (dAtA []byte, err error) {
+func (m *VDiffOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7561,12 +8601,12 @@ func (m *InitMasterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InitMasterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VDiffOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InitMasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VDiffOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7575,17 +8615,48 @@ func (m *InitMasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Position) > 0 {
-		i -= len(m.Position)
-		copy(dAtA[i:], m.Position)
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Position)))
+	if m.Incremental {
+		i--
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tables[iNdEx])
+			copy(dAtA[i:], m.Tables[iNdEx])
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Tables[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FilteredReplicationWaitTime != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.FilteredReplicationWaitTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TabletTypes) > 0 {
+		i -= len(m.TabletTypes)
+		copy(dAtA[i:], m.TabletTypes)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.TabletTypes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceCell) > 0 {
+		i -= len(m.SourceCell)
+		copy(dAtA[i:], m.SourceCell)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.SourceCell)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PopulateReparentJournalRequest) Marshal() (dAtA []byte, err error) {
+func (m *VDiffTableReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7595,12 +8666,12 @@ func (m *PopulateReparentJournalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PopulateReparentJournalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VDiffTableReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PopulateReparentJournalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VDiffTableReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7609,41 +8680,136 @@ func (m *PopulateReparentJournalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReplicationPosition) > 0 {
-		i -= len(m.ReplicationPosition)
-		copy(dAtA[i:], m.ReplicationPosition)
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.ReplicationPosition)))
+	if m.Incremental {
 		i--
-		dAtA[i] = 0x22
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.MasterAlias != nil {
-		{
-			size, err := m.MasterAlias.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.ExtraRowsTarget != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.ExtraRowsTarget))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExtraRowsSource != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.ExtraRowsSource))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MismatchedRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.MismatchedRows))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MatchingRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.MatchingRows))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProcessedRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.ProcessedRows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TableRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TableRows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
 		}
+	}
+	if m.CompletedAt != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.LastError)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ActionName) > 0 {
-		i -= len(m.ActionName)
-		copy(dAtA[i:], m.ActionName)
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.ActionName)))
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.State)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.TimeCreatedNs != 0 {
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TimeCreatedNs))
+	if len(m.VdiffUuid) > 0 {
+		i -= len(m.VdiffUuid)
+		copy(dAtA[i:], m.VdiffUuid)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.VdiffUuid)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PopulateReparentJournalResponse) Marshal() (dAtA []byte, err error) {
+func (m *InitMasterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7653,12 +8819,12 @@ func (m *PopulateReparentJournalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PopulateReparentJournalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InitMasterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PopulateReparentJournalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InitMasterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7670,7 +8836,7 @@ func (m *PopulateReparentJournalResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *InitReplicaRequest) Marshal() (dAtA []byte, err error) {
+func (m *InitMasterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7680,12 +8846,12 @@ func (m *InitReplicaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InitReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InitMasterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InitReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InitMasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7694,8 +8860,127 @@ func (m *InitReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TimeCreatedNs != 0 {
-		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TimeCreatedNs))
+	if len(m.Position) > 0 {
+		i -= len(m.Position)
+		copy(dAtA[i:], m.Position)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Position)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PopulateReparentJournalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PopulateReparentJournalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PopulateReparentJournalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReplicationPosition) > 0 {
+		i -= len(m.ReplicationPosition)
+		copy(dAtA[i:], m.ReplicationPosition)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.ReplicationPosition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MasterAlias != nil {
+		{
+			size, err := m.MasterAlias.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActionName) > 0 {
+		i -= len(m.ActionName)
+		copy(dAtA[i:], m.ActionName)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.ActionName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TimeCreatedNs != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TimeCreatedNs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PopulateReparentJournalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PopulateReparentJournalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PopulateReparentJournalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeCreatedNs != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TimeCreatedNs))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -8461,99 +9746,320 @@ func (m *VExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTabletmanagerdata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTabletmanagerdata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GCTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TableDefinition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GCTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	if m.IndexLength != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.IndexLength))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Columns) > 0 {
-		for _, s := range m.Columns {
-			l = len(s)
-			n += 1 + l + sovTabletmanagerdata(uint64(l))
-		}
+	if m.DataLength != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.DataLength))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.PrimaryKeyColumns) > 0 {
-		for _, s := range m.PrimaryKeyColumns {
-			l = len(s)
-			n += 1 + l + sovTabletmanagerdata(uint64(l))
-		}
+	if m.TableRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.TableRows))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	if m.IsView {
+		i--
+		if m.IsView {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.DataLength != 0 {
-		n += 1 + sovTabletmanagerdata(uint64(m.DataLength))
+	if m.Time != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.RowCount != 0 {
-		n += 1 + sovTabletmanagerdata(uint64(m.RowCount))
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovTabletmanagerdata(uint64(l))
-		}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SchemaDefinition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetGCTablesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGCTablesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGCTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DatabaseSchema)
-	if l > 0 {
-		n += 1 + l + sovTabletmanagerdata(uint64(l))
-	}
-	if len(m.TableDefinitions) > 0 {
-		for _, e := range m.TableDefinitions {
-			l = e.Size()
-			n += 1 + l + sovTabletmanagerdata(uint64(l))
-		}
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTabletmanagerdata(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SchemaChangeResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetGCTablesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BeforeSchema != nil {
-		l = m.BeforeSchema.Size()
+	return dAtA[:n], nil
+}
+
+func (m *GetGCTablesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGCTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGCTableDropRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGCTableDropRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGCTableDropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OriginalName) > 0 {
+		i -= len(m.OriginalName)
+		copy(dAtA[i:], m.OriginalName)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.OriginalName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelGCTableDropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelGCTableDropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelGCTableDropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestoredName) > 0 {
+		i -= len(m.RestoredName)
+		copy(dAtA[i:], m.RestoredName)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.RestoredName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTabletmanagerdata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTabletmanagerdata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TableDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if len(m.PrimaryKeyColumns) > 0 {
+		for _, s := range m.PrimaryKeyColumns {
+			l = len(s)
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.DataLength != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.DataLength))
+	}
+	if m.RowCount != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.RowCount))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DatabaseSchema)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if len(m.TableDefinitions) > 0 {
+		for _, e := range m.TableDefinitions {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaChangeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeforeSchema != nil {
+		l = m.BeforeSchema.Size()
 		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
 	if m.AfterSchema != nil {
@@ -8836,6 +10342,62 @@ func (m *GetPermissionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *HotRow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.Contentions != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Contentions))
+	}
+	if m.ProtectedUntil != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.ProtectedUntil))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotRowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotRowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HotRows) > 0 {
+		for _, e := range m.HotRows {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SetReadOnlyRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9653,48 +11215,189 @@ func (m *VReplicationWaitForPosResponse) Size() (n int) {
 	return n
 }
 
-func (m *InitMasterRequest) Size() (n int) {
+func (m *VDiffRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
-	return n
-}
-
-func (m *InitMasterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.Position)
+	l = len(m.Action)
 	if l > 0 {
 		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
+	l = len(m.VdiffUuid)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *PopulateReparentJournalRequest) Size() (n int) {
+func (m *VDiffOptions) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TimeCreatedNs != 0 {
-		n += 1 + sovTabletmanagerdata(uint64(m.TimeCreatedNs))
+	l = len(m.SourceCell)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
-	l = len(m.ActionName)
+	l = len(m.TabletTypes)
 	if l > 0 {
 		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
-	if m.MasterAlias != nil {
+	if m.FilteredReplicationWaitTime != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.FilteredReplicationWaitTime))
+	}
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			l = len(s)
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.Incremental {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VDiffTableReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.TableRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.TableRows))
+	}
+	if m.ProcessedRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.ProcessedRows))
+	}
+	if m.MatchingRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.MatchingRows))
+	}
+	if m.MismatchedRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.MismatchedRows))
+	}
+	if m.ExtraRowsSource != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.ExtraRowsSource))
+	}
+	if m.ExtraRowsTarget != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.ExtraRowsTarget))
+	}
+	if m.Incremental {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VdiffUuid)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.StartedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.CompletedAt))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InitMasterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InitMasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Position)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PopulateReparentJournalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeCreatedNs != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.TimeCreatedNs))
+	}
+	l = len(m.ActionName)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.MasterAlias != nil {
 		l = m.MasterAlias.Size()
 		n += 1 + l + sovTabletmanagerdata(uint64(l))
 	}
@@ -10066,6 +11769,111 @@ func (m *VExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *GCTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Time))
+	}
+	if m.IsView {
+		n += 2
+	}
+	if m.TableRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.TableRows))
+	}
+	if m.DataLength != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.DataLength))
+	}
+	if m.IndexLength != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.IndexLength))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelGCTableDropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.OriginalName)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelGCTableDropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RestoredName)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTabletmanagerdata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12352,7 +14160,7 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetReadOnlyRequest) Unmarshal(dAtA []byte) error {
+func (m *HotRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12375,66 +14183,114 @@ func (m *SetReadOnlyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetReadOnlyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HotRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetReadOnlyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HotRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetReadOnlyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contentions", wireType)
+			}
+			m.Contentions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Contentions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectedUntil", wireType)
+			}
+			m.ProtectedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtectedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetReadOnlyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetReadOnlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -12460,7 +14316,7 @@ func (m *SetReadOnlyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetReadWriteRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHotRowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12483,10 +14339,10 @@ func (m *SetReadWriteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetReadWriteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHotRowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHotRowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12514,7 +14370,7 @@ func (m *SetReadWriteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetReadWriteResponse) Unmarshal(dAtA []byte) error {
+func (m *GetHotRowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12537,12 +14393,46 @@ func (m *SetReadWriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetReadWriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHotRowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHotRowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRows = append(m.HotRows, &HotRow{})
+			if err := m.HotRows[len(m.HotRows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -12568,7 +14458,7 @@ func (m *SetReadWriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *SetReadOnlyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12591,31 +14481,12 @@ func (m *ChangeTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadOnlyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadOnlyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TabletType", wireType)
-			}
-			m.TabletType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TabletType |= topodata.TabletType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -12641,7 +14512,7 @@ func (m *ChangeTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *SetReadOnlyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12664,10 +14535,10 @@ func (m *ChangeTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadOnlyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadOnlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12695,7 +14566,7 @@ func (m *ChangeTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshStateRequest) Unmarshal(dAtA []byte) error {
+func (m *SetReadWriteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12718,10 +14589,10 @@ func (m *RefreshStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadWriteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12749,7 +14620,7 @@ func (m *RefreshStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshStateResponse) Unmarshal(dAtA []byte) error {
+func (m *SetReadWriteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12772,10 +14643,10 @@ func (m *RefreshStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadWriteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12803,7 +14674,7 @@ func (m *RefreshStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunHealthCheckRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangeTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12826,12 +14697,31 @@ func (m *RunHealthCheckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunHealthCheckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunHealthCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletType", wireType)
+			}
+			m.TabletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TabletType |= topodata.TabletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -12857,7 +14747,7 @@ func (m *RunHealthCheckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunHealthCheckResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangeTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12880,10 +14770,10 @@ func (m *RunHealthCheckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunHealthCheckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunHealthCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12911,7 +14801,7 @@ func (m *RunHealthCheckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IgnoreHealthErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12934,44 +14824,12 @@ func (m *IgnoreHealthErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IgnoreHealthErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IgnoreHealthErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -12997,7 +14855,7 @@ func (m *IgnoreHealthErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IgnoreHealthErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13020,10 +14878,10 @@ func (m *IgnoreHealthErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IgnoreHealthErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IgnoreHealthErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13051,7 +14909,7 @@ func (m *IgnoreHealthErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReloadSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *RunHealthCheckRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13074,52 +14932,20 @@ func (m *ReloadSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReloadSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunHealthCheckRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReloadSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunHealthCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitPosition", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WaitPosition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTabletmanagerdata
@@ -13137,7 +14963,7 @@ func (m *ReloadSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReloadSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *RunHealthCheckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13160,10 +14986,10 @@ func (m *ReloadSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReloadSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RunHealthCheckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReloadSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RunHealthCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13191,7 +15017,7 @@ func (m *ReloadSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreflightSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *IgnoreHealthErrorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13214,15 +15040,15 @@ func (m *PreflightSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreflightSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IgnoreHealthErrorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreflightSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IgnoreHealthErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13250,7 +15076,7 @@ func (m *PreflightSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, string(dAtA[iNdEx:postIndex]))
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13277,7 +15103,7 @@ func (m *PreflightSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreflightSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *IgnoreHealthErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13300,46 +15126,12 @@ func (m *PreflightSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreflightSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IgnoreHealthErrorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreflightSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IgnoreHealthErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeResults", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChangeResults = append(m.ChangeResults, &SchemaChangeResult{})
-			if err := m.ChangeResults[len(m.ChangeResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -13365,7 +15157,7 @@ func (m *PreflightSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplySchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *ReloadSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13388,15 +15180,15 @@ func (m *ApplySchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplySchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReloadSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplySchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReloadSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13424,120 +15216,62 @@ func (m *ApplySchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sql = string(dAtA[iNdEx:postIndex])
+			m.WaitPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowReplication", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowReplication = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSchema", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeforeSchema == nil {
-				m.BeforeSchema = &SchemaDefinition{}
-			}
-			if err := m.BeforeSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterSchema", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AfterSchema == nil {
-				m.AfterSchema = &SchemaDefinition{}
-			}
-			if err := m.AfterSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -13563,7 +15297,7 @@ func (m *ApplySchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplySchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *PreflightSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13586,17 +15320,17 @@ func (m *ApplySchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplySchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreflightSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplySchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreflightSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSchema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -13606,63 +15340,23 @@ func (m *ApplySchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeforeSchema == nil {
-				m.BeforeSchema = &SchemaDefinition{}
-			}
-			if err := m.BeforeSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterSchema", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AfterSchema == nil {
-				m.AfterSchema = &SchemaDefinition{}
-			}
-			if err := m.AfterSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Changes = append(m.Changes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13689,7 +15383,7 @@ func (m *ApplySchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockTablesRequest) Unmarshal(dAtA []byte) error {
+func (m *PreflightSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13712,12 +15406,46 @@ func (m *LockTablesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockTablesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreflightSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreflightSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeResults = append(m.ChangeResults, &SchemaChangeResult{})
+			if err := m.ChangeResults[len(m.ChangeResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -13743,7 +15471,7 @@ func (m *LockTablesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockTablesResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplySchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13766,12 +15494,156 @@ func (m *LockTablesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockTablesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplySchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplySchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowReplication", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowReplication = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeforeSchema == nil {
+				m.BeforeSchema = &SchemaDefinition{}
+			}
+			if err := m.BeforeSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AfterSchema == nil {
+				m.AfterSchema = &SchemaDefinition{}
+			}
+			if err := m.AfterSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -13797,7 +15669,7 @@ func (m *LockTablesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockTablesRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplySchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13820,20 +15692,254 @@ func (m *UnlockTablesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockTablesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplySchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplySchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSchema", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BeforeSchema == nil {
+				m.BeforeSchema = &SchemaDefinition{}
+			}
+			if err := m.BeforeSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AfterSchema == nil {
+				m.AfterSchema = &SchemaDefinition{}
+			}
+			if err := m.AfterSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTablesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTablesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTabletmanagerdata
@@ -15883,13 +17989,1516 @@ func (m *StartReplicationUntilAfterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Position = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeout", wireType)
+			m.Position = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeout", wireType)
+			}
+			m.WaitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartReplicationUntilAfterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartReplicationUntilAfterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartReplicationUntilAfterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetReplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetReplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetReplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetReplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetReplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VReplicationExecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VReplicationExecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VReplicationExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VReplicationExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VReplicationExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VReplicationExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &query.QueryResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VReplicationWaitForPosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VReplicationWaitForPosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Position = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VReplicationWaitForPosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VReplicationWaitForPosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VReplicationWaitForPosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VdiffUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VdiffUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &VDiffOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletTypes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredReplicationWaitTime", wireType)
+			}
+			m.FilteredReplicationWaitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilteredReplicationWaitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffTableReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffTableReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffTableReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableRows", wireType)
+			}
+			m.TableRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedRows", wireType)
+			}
+			m.ProcessedRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingRows", wireType)
+			}
+			m.MatchingRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MismatchedRows", wireType)
+			}
+			m.MismatchedRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MismatchedRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraRowsSource", wireType)
+			}
+			m.ExtraRowsSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraRowsSource |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraRowsTarget", wireType)
+			}
+			m.ExtraRowsTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtraRowsTarget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VdiffUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VdiffUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			m.WaitTimeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -15899,65 +19508,26 @@ func (m *StartReplicationUntilAfterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WaitTimeout |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartReplicationUntilAfterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tables = append(m.Tables, &VDiffTableReport{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartReplicationUntilAfterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartReplicationUntilAfterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -15983,7 +19553,7 @@ func (m *StartReplicationUntilAfterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicasRequest) Unmarshal(dAtA []byte) error {
+func (m *InitMasterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16006,10 +19576,10 @@ func (m *GetReplicasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitMasterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16037,7 +19607,7 @@ func (m *GetReplicasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicasResponse) Unmarshal(dAtA []byte) error {
+func (m *InitMasterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16060,15 +19630,15 @@ func (m *GetReplicasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitMasterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16096,7 +19666,7 @@ func (m *GetReplicasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.Position = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16123,7 +19693,7 @@ func (m *GetReplicasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetReplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *PopulateReparentJournalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16146,123 +19716,102 @@ func (m *ResetReplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetReplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PopulateReparentJournalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PopulateReparentJournalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			m.TimeCreatedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeCreatedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetReplicationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ActionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterAlias", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetReplicationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetReplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VReplicationExecRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MasterAlias == nil {
+				m.MasterAlias = &topodata.TabletAlias{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MasterAlias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VReplicationExecRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VReplicationExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16290,7 +19839,7 @@ func (m *VReplicationExecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.ReplicationPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16317,7 +19866,7 @@ func (m *VReplicationExecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VReplicationExecResponse) Unmarshal(dAtA []byte) error {
+func (m *PopulateReparentJournalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16340,48 +19889,12 @@ func (m *VReplicationExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VReplicationExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PopulateReparentJournalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VReplicationExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PopulateReparentJournalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &query.QueryResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -16407,7 +19920,7 @@ func (m *VReplicationExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
+func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16430,17 +19943,17 @@ func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VReplicationWaitForPosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VReplicationWaitForPosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -16450,14 +19963,31 @@ func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parent == nil {
+				m.Parent = &topodata.TabletAlias{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16485,8 +20015,27 @@ func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Position = string(dAtA[iNdEx:postIndex])
+			m.ReplicationPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
+			}
+			m.TimeCreatedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeCreatedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -16512,7 +20061,7 @@ func (m *VReplicationWaitForPosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VReplicationWaitForPosResponse) Unmarshal(dAtA []byte) error {
+func (m *InitReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16535,10 +20084,10 @@ func (m *VReplicationWaitForPosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VReplicationWaitForPosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InitReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VReplicationWaitForPosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InitReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16566,7 +20115,7 @@ func (m *VReplicationWaitForPosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitMasterRequest) Unmarshal(dAtA []byte) error {
+func (m *DemoteMasterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16589,10 +20138,10 @@ func (m *InitMasterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitMasterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DemoteMasterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DemoteMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16620,7 +20169,7 @@ func (m *InitMasterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitMasterResponse) Unmarshal(dAtA []byte) error {
+func (m *DemoteMasterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16643,15 +20192,15 @@ func (m *InitMasterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitMasterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DemoteMasterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DemoteMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16679,7 +20228,43 @@ func (m *InitMasterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Position = string(dAtA[iNdEx:postIndex])
+			m.DeprecatedPosition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MasterStatus == nil {
+				m.MasterStatus = &replicationdata.MasterStatus{}
+			}
+			if err := m.MasterStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16706,7 +20291,7 @@ func (m *InitMasterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PopulateReparentJournalRequest) Unmarshal(dAtA []byte) error {
+func (m *UndoDemoteMasterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16729,131 +20314,120 @@ func (m *PopulateReparentJournalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PopulateReparentJournalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndoDemoteMasterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PopulateReparentJournalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndoDemoteMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
-			}
-			m.TimeCreatedNs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeCreatedNs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterAlias", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndoDemoteMasterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterAlias == nil {
-				m.MasterAlias = &topodata.TabletAlias{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.MasterAlias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndoDemoteMasterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndoDemoteMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationPosition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationPosition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicaWasPromotedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaWasPromotedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaWasPromotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -16879,7 +20453,7 @@ func (m *PopulateReparentJournalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PopulateReparentJournalResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicaWasPromotedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16902,10 +20476,10 @@ func (m *PopulateReparentJournalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PopulateReparentJournalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaWasPromotedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PopulateReparentJournalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaWasPromotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16933,7 +20507,7 @@ func (m *PopulateReparentJournalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *SetMasterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16956,10 +20530,10 @@ func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMasterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16999,10 +20573,10 @@ func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationPosition", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
 			}
-			var stringLen uint64
+			m.TimeCreatedNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -17012,29 +20586,16 @@ func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeCreatedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationPosition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceStartReplication", wireType)
 			}
-			m.TimeCreatedNs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -17044,65 +20605,44 @@ func (m *InitReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeCreatedNs |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.ForceStartReplication = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPosition", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InitReplicaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InitReplicaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.WaitPosition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17128,7 +20668,7 @@ func (m *InitReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DemoteMasterRequest) Unmarshal(dAtA []byte) error {
+func (m *SetMasterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17151,10 +20691,10 @@ func (m *DemoteMasterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DemoteMasterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMasterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DemoteMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17182,7 +20722,7 @@ func (m *DemoteMasterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DemoteMasterResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicaWasRestartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17203,49 +20743,17 @@ func (m *DemoteMasterResponse) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DemoteMasterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DemoteMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPosition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeprecatedPosition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaWasRestartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaWasRestartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17272,10 +20780,10 @@ func (m *DemoteMasterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MasterStatus == nil {
-				m.MasterStatus = &replicationdata.MasterStatus{}
+			if m.Parent == nil {
+				m.Parent = &topodata.TabletAlias{}
 			}
-			if err := m.MasterStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17304,7 +20812,7 @@ func (m *DemoteMasterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndoDemoteMasterRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicaWasRestartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17327,10 +20835,10 @@ func (m *UndoDemoteMasterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndoDemoteMasterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaWasRestartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndoDemoteMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaWasRestartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17358,7 +20866,7 @@ func (m *UndoDemoteMasterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndoDemoteMasterResponse) Unmarshal(dAtA []byte) error {
+func (m *StopReplicationAndGetStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17381,12 +20889,31 @@ func (m *UndoDemoteMasterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndoDemoteMasterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopReplicationAndGetStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndoDemoteMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopReplicationAndGetStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopReplicationMode", wireType)
+			}
+			m.StopReplicationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopReplicationMode |= replicationdata.StopReplicationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17412,7 +20939,7 @@ func (m *UndoDemoteMasterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaWasPromotedRequest) Unmarshal(dAtA []byte) error {
+func (m *StopReplicationAndGetStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17435,12 +20962,84 @@ func (m *ReplicaWasPromotedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaWasPromotedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopReplicationAndGetStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaWasPromotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopReplicationAndGetStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HybridStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HybridStatus == nil {
+				m.HybridStatus = &replicationdata.Status{}
+			}
+			if err := m.HybridStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &replicationdata.StopReplicationStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17466,7 +21065,7 @@ func (m *ReplicaWasPromotedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaWasPromotedResponse) Unmarshal(dAtA []byte) error {
+func (m *PromoteReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17489,10 +21088,10 @@ func (m *ReplicaWasPromotedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaWasPromotedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromoteReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaWasPromotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromoteReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17520,7 +21119,7 @@ func (m *ReplicaWasPromotedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetMasterRequest) Unmarshal(dAtA []byte) error {
+func (m *PromoteReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17543,90 +21142,15 @@ func (m *SetMasterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetMasterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromoteReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromoteReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Parent == nil {
-				m.Parent = &topodata.TabletAlias{}
-			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeCreatedNs", wireType)
-			}
-			m.TimeCreatedNs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeCreatedNs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceStartReplication", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceStartReplication = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17654,7 +21178,7 @@ func (m *SetMasterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WaitPosition = string(dAtA[iNdEx:postIndex])
+			m.Position = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17681,7 +21205,7 @@ func (m *SetMasterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetMasterResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17700,16 +21224,55 @@ func (m *SetMasterResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetMasterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowMaster = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17735,7 +21298,7 @@ func (m *SetMasterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaWasRestartedRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17758,15 +21321,15 @@ func (m *ReplicaWasRestartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaWasRestartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaWasRestartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17793,10 +21356,10 @@ func (m *ReplicaWasRestartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &topodata.TabletAlias{}
+			if m.Event == nil {
+				m.Event = &logutil.Event{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17825,7 +21388,7 @@ func (m *ReplicaWasRestartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaWasRestartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreFromBackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17848,10 +21411,10 @@ func (m *ReplicaWasRestartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaWasRestartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreFromBackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaWasRestartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreFromBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17879,7 +21442,7 @@ func (m *ReplicaWasRestartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopReplicationAndGetStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreFromBackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17902,17 +21465,17 @@ func (m *StopReplicationAndGetStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopReplicationAndGetStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreFromBackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopReplicationAndGetStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreFromBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopReplicationMode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			m.StopReplicationMode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -17922,11 +21485,28 @@ func (m *StopReplicationAndGetStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StopReplicationMode |= replicationdata.StopReplicationMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &logutil.Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17952,7 +21532,7 @@ func (m *StopReplicationAndGetStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopReplicationAndGetStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *VExecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17975,17 +21555,17 @@ func (m *StopReplicationAndGetStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopReplicationAndGetStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VExecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopReplicationAndGetStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HybridStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -17995,33 +21575,29 @@ func (m *StopReplicationAndGetStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HybridStatus == nil {
-				m.HybridStatus = &replicationdata.Status{}
-			}
-			if err := m.HybridStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -18031,139 +21607,27 @@ func (m *StopReplicationAndGetStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &replicationdata.StopReplicationStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromoteReplicaRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromoteReplicaRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromoteReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromoteReplicaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTabletmanagerdata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromoteReplicaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromoteReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18191,7 +21655,7 @@ func (m *PromoteReplicaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Position = string(dAtA[iNdEx:postIndex])
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18218,7 +21682,7 @@ func (m *PromoteReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupRequest) Unmarshal(dAtA []byte) error {
+func (m *VExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18241,36 +21705,17 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
-			}
-			m.Concurrency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Concurrency |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowMaster", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -18280,12 +21725,28 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowMaster = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &query.QueryResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -18311,7 +21772,7 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupResponse) Unmarshal(dAtA []byte) error {
+func (m *GCTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18334,17 +21795,17 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -18354,28 +21815,184 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &logutil.Event{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsView", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsView = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableRows", wireType)
+			}
+			m.TableRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableRows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLength", wireType)
+			}
+			m.DataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexLength", wireType)
+			}
+			m.IndexLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -18401,7 +22018,7 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreFromBackupRequest) Unmarshal(dAtA []byte) error {
+func (m *GetGCTablesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18424,10 +22041,10 @@ func (m *RestoreFromBackupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreFromBackupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGCTablesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreFromBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGCTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18455,7 +22072,7 @@ func (m *RestoreFromBackupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreFromBackupResponse) Unmarshal(dAtA []byte) error {
+func (m *GetGCTablesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18478,15 +22095,15 @@ func (m *RestoreFromBackupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreFromBackupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGCTablesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreFromBackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGCTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18513,10 +22130,8 @@ func (m *RestoreFromBackupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &logutil.Event{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tables = append(m.Tables, &GCTable{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18545,7 +22160,7 @@ func (m *RestoreFromBackupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VExecRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelGCTableDropRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18568,15 +22183,15 @@ func (m *VExecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VExecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelGCTableDropRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelGCTableDropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18604,43 +22219,11 @@ func (m *VExecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTabletmanagerdata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTabletmanagerdata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Workflow = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18668,7 +22251,7 @@ func (m *VExecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			m.OriginalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18695,7 +22278,7 @@ func (m *VExecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VExecResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelGCTableDropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18718,17 +22301,17 @@ func (m *VExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelGCTableDropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelGCTableDropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTabletmanagerdata
@@ -18738,27 +22321,23 @@ func (m *VExecResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTabletmanagerdata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &query.QueryResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RestoredName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
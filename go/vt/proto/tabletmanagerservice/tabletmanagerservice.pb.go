@@ -29,72 +29,76 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1039 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdf, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0x6b, 0x89, 0x56, 0x62, 0xf9, 0xbd, 0x20, 0x2a, 0x05, 0x29, 0x14, 0xda, 0x40, 0x69,
-	0x21, 0x6e, 0x0b, 0xe5, 0xdd, 0x4d, 0x9b, 0x34, 0x28, 0x11, 0xae, 0xdd, 0x24, 0x08, 0x24, 0xa4,
-	0x8d, 0x3d, 0xb1, 0x8f, 0x9c, 0x6f, 0x8f, 0xdd, 0x3d, 0x8b, 0x3c, 0x21, 0xf1, 0x8a, 0xc4, 0x33,
-	0x2f, 0xfc, 0x3f, 0x3c, 0xf2, 0x27, 0xa0, 0xf0, 0x8f, 0xa0, 0x3b, 0xdf, 0xee, 0xcd, 0xde, 0xcd,
-	0xad, 0x2f, 0x6f, 0x96, 0xe7, 0x33, 0xf3, 0xdd, 0x9d, 0x9b, 0x9d, 0xd9, 0x3b, 0xb6, 0x61, 0xc4,
-	0x69, 0x0c, 0x66, 0x21, 0x12, 0x31, 0x03, 0xa5, 0x41, 0x2d, 0xa3, 0x09, 0x6c, 0xa7, 0x4a, 0x1a,
-	0xc9, 0xdf, 0xa3, 0x6c, 0x1b, 0x37, 0xbd, 0x7f, 0xa7, 0xc2, 0x88, 0x15, 0xfe, 0xe8, 0xaf, 0x2d,
-	0xf6, 0xc6, 0xcb, 0xc2, 0x76, 0xb8, 0xb2, 0xf1, 0x7d, 0xf6, 0xca, 0x30, 0x4a, 0x66, 0x7c, 0x73,
-	0xbb, 0xe9, 0x93, 0x1b, 0x46, 0xf0, 0x73, 0x06, 0xda, 0x6c, 0x7c, 0xd8, 0x6a, 0xd7, 0xa9, 0x4c,
-	0x34, 0x7c, 0x7c, 0x8d, 0x1f, 0xb0, 0xeb, 0xe3, 0x18, 0x20, 0xe5, 0x14, 0x5b, 0x58, 0x6c, 0xb0,
-	0x5b, 0xed, 0x80, 0x8b, 0xf6, 0x23, 0x7b, 0xed, 0xd9, 0x2f, 0x30, 0xc9, 0x0c, 0x3c, 0x97, 0xf2,
-	0x9c, 0x6f, 0x11, 0x2e, 0xc8, 0x6e, 0x23, 0x7f, 0xb2, 0x0e, 0x73, 0xf1, 0xbf, 0x63, 0xaf, 0xee,
-	0x81, 0x19, 0x4f, 0xe6, 0xb0, 0x10, 0xfc, 0x36, 0xe1, 0xe6, 0xac, 0x36, 0xf6, 0x9d, 0x30, 0xe4,
-	0x22, 0xcf, 0xd8, 0x9b, 0x7b, 0x60, 0x86, 0xa0, 0x16, 0x91, 0xd6, 0x91, 0x4c, 0x34, 0xbf, 0x4b,
-	0x7b, 0x22, 0xc4, 0x6a, 0x7c, 0xd6, 0x81, 0xc4, 0x29, 0x1a, 0x83, 0x19, 0x81, 0x98, 0x7e, 0x9b,
-	0xc4, 0x17, 0x64, 0x8a, 0x90, 0x3d, 0x94, 0x22, 0x0f, 0x73, 0xf1, 0x05, 0x7b, 0xbd, 0x34, 0x9c,
-	0xa8, 0xc8, 0x00, 0x0f, 0x78, 0x16, 0x80, 0x55, 0xf8, 0x74, 0x2d, 0xe7, 0x24, 0x7e, 0x60, 0x6c,
-	0x67, 0x2e, 0x92, 0x19, 0xbc, 0xbc, 0x48, 0x81, 0x53, 0x19, 0xae, 0xcc, 0x36, 0xfc, 0xd6, 0x1a,
-	0x0a, 0xaf, 0x7f, 0x04, 0x67, 0x0a, 0xf4, 0x7c, 0x6c, 0x44, 0xcb, 0xfa, 0x31, 0x10, 0x5a, 0xbf,
-	0xcf, 0xe1, 0x67, 0x3d, 0xca, 0x92, 0xe7, 0x20, 0x62, 0x33, 0xdf, 0x99, 0xc3, 0xe4, 0x9c, 0x7c,
-	0xd6, 0x3e, 0x12, 0x7a, 0xd6, 0x75, 0xd2, 0x09, 0xa5, 0xec, 0x9d, 0xfd, 0x59, 0x22, 0x15, 0xac,
-	0xcc, 0xcf, 0x94, 0x92, 0x8a, 0xdf, 0x27, 0x22, 0x34, 0x28, 0x2b, 0xf7, 0x79, 0x37, 0xd8, 0xcf,
-	0x5e, 0x2c, 0xc5, 0xb4, 0x3c, 0x23, 0x74, 0xf6, 0x2a, 0x20, 0x9c, 0x3d, 0xcc, 0x39, 0x89, 0x9f,
-	0xd8, 0x5b, 0x43, 0x05, 0x67, 0x71, 0x34, 0x9b, 0xdb, 0x93, 0x48, 0x25, 0xa5, 0xc6, 0x58, 0xa1,
-	0x7b, 0x5d, 0x50, 0x7c, 0x58, 0x06, 0x69, 0x1a, 0x5f, 0x94, 0x3a, 0x54, 0x11, 0x21, 0x7b, 0xe8,
-	0xb0, 0x78, 0x18, 0xae, 0xe4, 0x03, 0x39, 0x39, 0x2f, 0xba, 0xab, 0x26, 0x2b, 0xb9, 0x32, 0x87,
-	0x2a, 0x19, 0x53, 0xf8, 0x59, 0x1c, 0x25, 0x71, 0x15, 0x9e, 0x5a, 0x16, 0x06, 0x42, 0xcf, 0xc2,
-	0xe7, 0xb0, 0x44, 0xd9, 0x28, 0x5f, 0x64, 0xa0, 0x2e, 0x78, 0xa0, 0x93, 0x16, 0x40, 0x48, 0xc2,
-	0xe7, 0x70, 0x0d, 0x97, 0x96, 0x5d, 0x30, 0x93, 0xf9, 0x40, 0x3f, 0x3d, 0x15, 0x64, 0x0d, 0x37,
-	0xa8, 0x50, 0x0d, 0x13, 0xb0, 0x53, 0xfc, 0x95, 0xbd, 0xef, 0x9b, 0x07, 0x71, 0x3c, 0x54, 0xd1,
-	0x52, 0xf3, 0x07, 0x6b, 0x23, 0x59, 0xd4, 0x6a, 0x3f, 0xbc, 0x82, 0x47, 0xfb, 0x96, 0x07, 0x69,
-	0xda, 0x61, 0xcb, 0x83, 0x34, 0xed, 0xbe, 0xe5, 0x02, 0xc6, 0x8a, 0x23, 0x48, 0xe3, 0x68, 0x22,
-	0x4c, 0x24, 0x93, 0xbc, 0x5f, 0x65, 0x9a, 0x54, 0x6c, 0x50, 0x21, 0x45, 0x02, 0xc6, 0x95, 0x73,
-	0x28, 0xb4, 0x01, 0x55, 0x8a, 0x51, 0x95, 0x83, 0x81, 0x50, 0xe5, 0xf8, 0x1c, 0x6e, 0xb3, 0x2b,
-	0xcb, 0x50, 0xea, 0x28, 0x5f, 0x04, 0xd9, 0x66, 0x7d, 0x24, 0xd4, 0x66, 0xeb, 0x24, 0xee, 0x48,
-	0x27, 0x22, 0x32, 0xbb, 0xb2, 0x52, 0xa2, 0xfc, 0x6b, 0x4c, 0xa8, 0x23, 0x35, 0x50, 0xac, 0x35,
-	0x36, 0x32, 0x45, 0xa9, 0x25, 0xb5, 0x6a, 0x4c, 0x48, 0xab, 0x81, 0xe2, 0x83, 0x50, 0x33, 0x1e,
-	0x46, 0x49, 0xb4, 0xc8, 0x16, 0xe4, 0x41, 0xa0, 0xd1, 0xd0, 0x41, 0x68, 0xf3, 0x70, 0x0b, 0x58,
-	0xb0, 0xb7, 0xc7, 0x46, 0x28, 0x83, 0x77, 0x4b, 0x6f, 0xc1, 0x87, 0xac, 0xe8, 0xfd, 0x4e, 0xac,
-	0x93, 0xfb, 0xbd, 0xc7, 0x36, 0xea, 0xe6, 0xa3, 0xc4, 0x44, 0xf1, 0xe0, 0xcc, 0x80, 0xe2, 0x5f,
-	0x75, 0x88, 0x56, 0xe1, 0x76, 0x0d, 0x8f, 0xaf, 0xe8, 0x85, 0x67, 0xcf, 0x1e, 0x58, 0x4a, 0x93,
-	0xb3, 0x07, 0xd9, 0x43, 0xb3, 0xc7, 0xc3, 0x70, 0x72, 0x8f, 0xd1, 0x1a, 0xf2, 0xf6, 0x40, 0x26,
-	0xb7, 0x0e, 0x85, 0x92, 0xdb, 0x64, 0x71, 0x31, 0x61, 0x6b, 0x55, 0xe1, 0x64, 0x31, 0xd1, 0x68,
-	0xa8, 0x98, 0xda, 0x3c, 0xf0, 0x7e, 0x47, 0xa0, 0x61, 0x6d, 0x31, 0xd5, 0xa1, 0xd0, 0x7e, 0x9b,
-	0x2c, 0x1e, 0xed, 0xfb, 0x49, 0x64, 0x56, 0x4d, 0x83, 0x1c, 0xed, 0x95, 0x39, 0x34, 0xda, 0x31,
-	0xe5, 0x82, 0xff, 0xd6, 0x63, 0x37, 0x87, 0x32, 0xcd, 0xe2, 0xe2, 0x62, 0x99, 0x0a, 0x05, 0x89,
-	0xf9, 0x46, 0x66, 0x2a, 0x11, 0x31, 0xa7, 0x92, 0xd3, 0xc2, 0x5a, 0xdd, 0x47, 0x57, 0x71, 0xc1,
-	0x05, 0x9a, 0x2f, 0xae, 0xdc, 0x3e, 0x6f, 0x5b, 0x7c, 0x69, 0x0f, 0x15, 0xa8, 0x87, 0xe1, 0x11,
-	0xf1, 0x14, 0x16, 0xd2, 0x40, 0x99, 0x43, 0xca, 0x13, 0x03, 0xa1, 0x11, 0xe1, 0x73, 0xb8, 0x26,
-	0x8e, 0x92, 0xa9, 0xf4, 0x64, 0xee, 0x91, 0xd7, 0x1f, 0x1f, 0x0a, 0xd5, 0x44, 0x93, 0x75, 0x72,
-	0x9a, 0xf1, 0x72, 0x9b, 0x27, 0x42, 0x0f, 0x95, 0xcc, 0xa1, 0x29, 0x0f, 0x8c, 0x4e, 0x84, 0x59,
-	0xc9, 0x2f, 0x3a, 0xd2, 0xf8, 0x9d, 0x75, 0x0c, 0xb6, 0x0e, 0x6f, 0xd3, 0x6f, 0x59, 0xfe, 0xae,
-	0xee, 0x84, 0x21, 0x17, 0x79, 0xc9, 0xde, 0xad, 0x94, 0x47, 0xa0, 0xf3, 0xae, 0x06, 0x53, 0x1e,
-	0x5e, 0xa1, 0xe3, 0xac, 0xda, 0x76, 0x57, 0xdc, 0xe9, 0xfe, 0xd1, 0x63, 0x1f, 0xd4, 0x66, 0xc7,
-	0x20, 0x99, 0xe6, 0x6f, 0xd5, 0xab, 0xbb, 0xc4, 0xe3, 0xf5, 0xb3, 0x06, 0xf3, 0x76, 0x21, 0x5f,
-	0x5f, 0xd5, 0x0d, 0xdf, 0x34, 0xca, 0xc4, 0xdb, 0xc3, 0x70, 0x97, 0x7c, 0xcd, 0xc0, 0x48, 0xe8,
-	0xa6, 0x51, 0x27, 0x9d, 0xd0, 0x0b, 0x76, 0xe3, 0x89, 0x98, 0x9c, 0x67, 0x29, 0xa7, 0xbe, 0x86,
-	0xac, 0x4c, 0x36, 0xf0, 0x47, 0x01, 0xc2, 0x06, 0x7c, 0xd0, 0xe3, 0x2a, 0xbf, 0xfa, 0x69, 0x23,
-	0x15, 0xec, 0x2a, 0xb9, 0x28, 0xa3, 0xb7, 0xf4, 0x3a, 0x9f, 0x0a, 0x5f, 0xfd, 0x1a, 0x30, 0xd2,
-	0x3c, 0x60, 0xd7, 0x8f, 0x8b, 0x79, 0x43, 0x7d, 0xf4, 0x39, 0xc6, 0x43, 0xe6, 0x56, 0x3b, 0x60,
-	0xe3, 0x3d, 0xd9, 0xf9, 0xfb, 0x72, 0xb3, 0xf7, 0xcf, 0xe5, 0x66, 0xef, 0xdf, 0xcb, 0xcd, 0xde,
-	0x9f, 0xff, 0x6d, 0x5e, 0xfb, 0xfe, 0xe1, 0x32, 0x32, 0xa0, 0xf5, 0x76, 0x24, 0xfb, 0xab, 0x5f,
-	0xfd, 0x99, 0xec, 0x2f, 0x4d, 0xbf, 0xf8, 0x9e, 0xd5, 0xa7, 0xbe, 0x7e, 0x9d, 0xde, 0x28, 0x6c,
-	0x5f, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x44, 0xd6, 0xa8, 0x1f, 0x38, 0x13, 0x00, 0x00,
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdf, 0x8e, 0x1b, 0x35,
+	0x14, 0xc6, 0x1b, 0x09, 0x2a, 0x61, 0xfe, 0x1b, 0x44, 0xa5, 0x45, 0x0a, 0x85, 0xb6, 0x50, 0xba,
+	0xb0, 0x69, 0x0b, 0xe5, 0x3e, 0xcd, 0x76, 0xb3, 0x8b, 0xba, 0x22, 0x4d, 0xba, 0x5d, 0x04, 0x12,
+	0x92, 0x37, 0x39, 0x49, 0x86, 0x9d, 0xd8, 0x83, 0xed, 0x04, 0xf6, 0x0a, 0x89, 0x5b, 0x24, 0xae,
+	0x11, 0x4f, 0xc4, 0x25, 0x8f, 0x80, 0x96, 0x17, 0x41, 0x99, 0x8c, 0x67, 0x8e, 0x67, 0xce, 0x38,
+	0xb3, 0x77, 0xab, 0xfd, 0x7e, 0x3e, 0x9f, 0x7d, 0x72, 0x7c, 0x6c, 0x0f, 0xdb, 0xb1, 0xe2, 0x2c,
+	0x06, 0xbb, 0x10, 0x52, 0xcc, 0x40, 0x1b, 0xd0, 0xab, 0x68, 0x0c, 0x7b, 0x89, 0x56, 0x56, 0xf1,
+	0x77, 0x29, 0x6d, 0xe7, 0x86, 0xf7, 0xdf, 0x89, 0xb0, 0x62, 0x83, 0x3f, 0xfc, 0xeb, 0x2e, 0x7b,
+	0xfd, 0x79, 0xaa, 0x1d, 0x6f, 0x34, 0x7e, 0xc4, 0x5e, 0x1a, 0x44, 0x72, 0xc6, 0xdb, 0x7b, 0xd5,
+	0x31, 0x6b, 0x61, 0x08, 0x3f, 0x2d, 0xc1, 0xd8, 0x9d, 0x0f, 0x6a, 0x75, 0x93, 0x28, 0x69, 0xe0,
+	0xa3, 0x6b, 0xfc, 0x29, 0x7b, 0x79, 0x14, 0x03, 0x24, 0x9c, 0x62, 0x53, 0xc5, 0x05, 0xbb, 0x59,
+	0x0f, 0xe4, 0xd1, 0x7e, 0x60, 0xaf, 0x3e, 0xf9, 0x05, 0xc6, 0x4b, 0x0b, 0x87, 0x4a, 0x9d, 0xf3,
+	0x3b, 0xc4, 0x10, 0xa4, 0xbb, 0xc8, 0x1f, 0x6f, 0xc3, 0xf2, 0xf8, 0xdf, 0xb2, 0x57, 0xfa, 0x60,
+	0x47, 0xe3, 0x39, 0x2c, 0x04, 0xbf, 0x45, 0x0c, 0xcb, 0x55, 0x17, 0xfb, 0x76, 0x18, 0xca, 0x23,
+	0xcf, 0xd8, 0x1b, 0x7d, 0xb0, 0x03, 0xd0, 0x8b, 0xc8, 0x98, 0x48, 0x49, 0xc3, 0xef, 0xd2, 0x23,
+	0x11, 0xe2, 0x3c, 0x3e, 0x6d, 0x40, 0xe6, 0x46, 0xdf, 0x33, 0xd6, 0x07, 0x7b, 0xa8, 0xec, 0x50,
+	0xfd, 0x6c, 0x78, 0xcd, 0xf4, 0x32, 0xd9, 0x19, 0xdc, 0xd9, 0x42, 0xe1, 0xfc, 0x8f, 0xc0, 0x0e,
+	0x41, 0x4c, 0xbe, 0x91, 0xf1, 0x05, 0x99, 0x7f, 0xa4, 0x87, 0xf2, 0xef, 0x61, 0x79, 0x7c, 0xc1,
+	0x5e, 0xcb, 0x84, 0x53, 0x1d, 0x59, 0xe0, 0x81, 0x91, 0x29, 0xe0, 0x1c, 0x3e, 0xd9, 0xca, 0xe1,
+	0xfc, 0xf4, 0xe6, 0x42, 0xce, 0xe0, 0xf9, 0x45, 0x02, 0x64, 0x7e, 0x0a, 0x39, 0x94, 0x1f, 0x4c,
+	0xe1, 0xf9, 0x0f, 0x61, 0xaa, 0xc1, 0xcc, 0x47, 0x56, 0xd4, 0xcc, 0x1f, 0x03, 0xa1, 0xf9, 0xfb,
+	0x1c, 0x2e, 0xa4, 0xe1, 0x52, 0x1e, 0x82, 0x88, 0xed, 0xbc, 0x37, 0x87, 0xf1, 0x39, 0x59, 0x48,
+	0x3e, 0x12, 0x2a, 0xa4, 0x32, 0x99, 0x1b, 0x25, 0xec, 0xed, 0xa3, 0x99, 0x54, 0x1a, 0x36, 0xf2,
+	0x13, 0xad, 0x95, 0xe6, 0xbb, 0x44, 0x84, 0x0a, 0xe5, 0xec, 0x3e, 0x6b, 0x06, 0xfb, 0xd9, 0x8b,
+	0x95, 0x98, 0x64, 0x1b, 0x90, 0xce, 0x5e, 0x01, 0x84, 0xb3, 0x87, 0xb9, 0xdc, 0xe2, 0x47, 0xf6,
+	0xe6, 0x40, 0xc3, 0x34, 0x8e, 0x66, 0x73, 0xb7, 0xcd, 0xa9, 0xa4, 0x94, 0x18, 0x67, 0x74, 0xaf,
+	0x09, 0x8a, 0x37, 0x4b, 0x37, 0x49, 0xe2, 0x8b, 0xcc, 0x87, 0x2a, 0x22, 0xa4, 0x87, 0x36, 0x8b,
+	0x87, 0xe1, 0x4a, 0x7e, 0xaa, 0xc6, 0xe7, 0x69, 0xeb, 0xa6, 0x77, 0x7a, 0x21, 0x87, 0x2a, 0x19,
+	0x53, 0xf8, 0xb7, 0x38, 0x91, 0x71, 0x11, 0x9e, 0x9a, 0x16, 0x06, 0x42, 0xbf, 0x85, 0xcf, 0x61,
+	0x8b, 0xac, 0x0b, 0x3f, 0x5b, 0x82, 0xbe, 0xe0, 0x81, 0x36, 0x9d, 0x02, 0x21, 0x0b, 0x9f, 0xc3,
+	0x35, 0x9c, 0x29, 0x07, 0x60, 0xc7, 0xf3, 0xae, 0xd9, 0x3f, 0x13, 0x64, 0x0d, 0x57, 0xa8, 0x50,
+	0x0d, 0x13, 0x70, 0xee, 0xf8, 0x2b, 0x7b, 0xcf, 0x97, 0xbb, 0x71, 0x3c, 0xd0, 0xd1, 0xca, 0xf0,
+	0xfb, 0x5b, 0x23, 0x39, 0xd4, 0x79, 0x3f, 0xb8, 0xc2, 0x88, 0xfa, 0x25, 0x77, 0x93, 0xa4, 0xc1,
+	0x92, 0xbb, 0x49, 0xd2, 0x7c, 0xc9, 0x29, 0x8c, 0x1d, 0x87, 0x90, 0xc4, 0xd1, 0x58, 0xd8, 0x48,
+	0xc9, 0x91, 0x15, 0x76, 0x69, 0x48, 0xc7, 0x0a, 0x15, 0x72, 0x24, 0x60, 0x5c, 0x39, 0xc7, 0xc2,
+	0x58, 0xd0, 0x99, 0x19, 0x55, 0x39, 0x18, 0x08, 0x55, 0x8e, 0xcf, 0xe1, 0x36, 0xbb, 0x51, 0x06,
+	0xca, 0x44, 0xeb, 0x49, 0x90, 0x6d, 0xd6, 0x47, 0x42, 0x6d, 0xb6, 0x4c, 0xe2, 0x8e, 0x74, 0x2a,
+	0x22, 0x7b, 0xa0, 0x0a, 0x27, 0x6a, 0x7c, 0x89, 0x09, 0x75, 0xa4, 0x0a, 0x8a, 0xbd, 0x46, 0x56,
+	0x25, 0x28, 0xb5, 0xa4, 0x57, 0x89, 0x09, 0x79, 0x55, 0x50, 0xbc, 0x11, 0x4a, 0xe2, 0x71, 0x24,
+	0xa3, 0xc5, 0x72, 0x41, 0x6e, 0x04, 0x1a, 0x0d, 0x6d, 0x84, 0xba, 0x11, 0xf9, 0x04, 0x16, 0xec,
+	0xad, 0x91, 0x15, 0xda, 0xe2, 0xd5, 0xd2, 0x4b, 0xf0, 0x21, 0x67, 0xba, 0xdb, 0x88, 0xcd, 0xed,
+	0x7e, 0x6f, 0xb1, 0x9d, 0xb2, 0x7c, 0x22, 0x6d, 0x14, 0x77, 0xa7, 0x16, 0x34, 0xff, 0xb2, 0x41,
+	0xb4, 0x02, 0x77, 0x73, 0x78, 0x74, 0xc5, 0x51, 0xf8, 0xec, 0xe9, 0x83, 0xa3, 0x0c, 0xaf, 0xb9,
+	0xe0, 0x39, 0x3d, 0x74, 0xf6, 0x78, 0x18, 0x4e, 0xee, 0x0b, 0x34, 0x87, 0x75, 0x7b, 0x20, 0x93,
+	0x5b, 0x86, 0x42, 0xc9, 0xad, 0xb2, 0xb8, 0x98, 0xb0, 0x5a, 0x54, 0x38, 0x59, 0x4c, 0x34, 0x1a,
+	0x2a, 0xa6, 0xba, 0x11, 0xf8, 0x19, 0xf3, 0x62, 0x3f, 0x9a, 0x4e, 0xc9, 0x67, 0x4c, 0xaa, 0x84,
+	0x9e, 0x31, 0x19, 0x80, 0xb3, 0x37, 0x04, 0x03, 0x5b, 0x4b, 0xb3, 0x0c, 0x85, 0xb2, 0x57, 0x65,
+	0xf1, 0x45, 0xe1, 0x48, 0x46, 0x76, 0xd3, 0x82, 0xc8, 0x8b, 0x42, 0x21, 0x87, 0x2e, 0x0a, 0x98,
+	0xca, 0x83, 0xff, 0xd6, 0x62, 0x37, 0x06, 0x2a, 0x59, 0xc6, 0xc2, 0xc2, 0x10, 0x12, 0xa1, 0x41,
+	0xda, 0xaf, 0xd5, 0x52, 0x4b, 0x11, 0x73, 0x2a, 0xd5, 0x35, 0xac, 0xf3, 0x7d, 0x78, 0x95, 0x21,
+	0xb8, 0xdc, 0xd7, 0x93, 0xcb, 0x96, 0xcf, 0xeb, 0x26, 0x9f, 0xe9, 0xa1, 0x72, 0xf7, 0x30, 0x7c,
+	0xe0, 0xec, 0xc3, 0x42, 0x59, 0xc8, 0x72, 0x48, 0x8d, 0xc4, 0x40, 0xe8, 0xc0, 0xf1, 0x39, 0x5c,
+	0x13, 0x27, 0x72, 0xa2, 0x3c, 0x9b, 0x7b, 0xe4, 0x65, 0x6a, 0xa2, 0x28, 0xab, 0xdd, 0x46, 0x6c,
+	0x6e, 0x67, 0x18, 0xcf, 0x96, 0x79, 0x2a, 0xcc, 0x40, 0xab, 0x35, 0x34, 0xe1, 0x81, 0x83, 0x18,
+	0x61, 0xce, 0xf2, 0xf3, 0x86, 0x34, 0x7e, 0x5e, 0x8f, 0xc0, 0xd5, 0xe1, 0x2d, 0xfa, 0xcd, 0xe6,
+	0xaf, 0xea, 0x76, 0x18, 0xca, 0x23, 0xaf, 0xd8, 0x3b, 0x85, 0xf3, 0x10, 0x8c, 0x15, 0x7a, 0xbd,
+	0x9e, 0xf0, 0x0c, 0x73, 0xce, 0xb9, 0xed, 0x35, 0xc5, 0x73, 0xdf, 0x3f, 0x5a, 0xec, 0xfd, 0xd2,
+	0x49, 0xd4, 0x95, 0x93, 0xf5, 0x07, 0x80, 0xcd, 0xcd, 0xe4, 0xd1, 0xf6, 0x93, 0x0b, 0xf3, 0x6e,
+	0x22, 0x5f, 0x5d, 0x75, 0x18, 0xbe, 0xb7, 0x64, 0x89, 0x77, 0x9b, 0xe1, 0x2e, 0xf9, 0x68, 0xc1,
+	0x48, 0xe8, 0xde, 0x52, 0x26, 0x73, 0xa3, 0x67, 0xec, 0xfa, 0x63, 0x31, 0x3e, 0x5f, 0x26, 0x9c,
+	0xea, 0x78, 0x1b, 0xc9, 0x05, 0xfe, 0x30, 0x40, 0xb8, 0x80, 0xf7, 0x5b, 0x5c, 0xaf, 0x2f, 0x92,
+	0xc6, 0x2a, 0x0d, 0x07, 0x5a, 0x2d, 0xb2, 0xe8, 0x35, 0xbd, 0xce, 0xa7, 0xc2, 0x17, 0xc9, 0x0a,
+	0x8c, 0x3c, 0xd7, 0x8d, 0x3d, 0x3d, 0xbd, 0xc8, 0xc6, 0x8e, 0x8f, 0xac, 0x9b, 0xf5, 0x40, 0xe9,
+	0xd8, 0xed, 0xf7, 0xb2, 0x47, 0x53, 0xcd, 0xb1, 0xeb, 0xf4, 0x2d, 0xc7, 0x6e, 0x81, 0xe1, 0xab,
+	0x76, 0x4f, 0xc8, 0x31, 0xc4, 0x99, 0xb6, 0xaf, 0x15, 0x9d, 0xa1, 0x0a, 0x15, 0xca, 0x10, 0x01,
+	0x3b, 0xc7, 0xc7, 0xbd, 0xbf, 0x2f, 0xdb, 0xad, 0x7f, 0x2e, 0xdb, 0xad, 0x7f, 0x2f, 0xdb, 0xad,
+	0x3f, 0xff, 0x6b, 0x5f, 0xfb, 0xee, 0xc1, 0x2a, 0xb2, 0x60, 0xcc, 0x5e, 0xa4, 0x3a, 0x9b, 0xbf,
+	0x3a, 0x33, 0xd5, 0x59, 0xd9, 0x4e, 0xfa, 0x31, 0xb1, 0x43, 0x7d, 0x7a, 0x3c, 0xbb, 0x9e, 0x6a,
+	0x5f, 0xfc, 0x3f, 0x00, 0x0e, 0x3d, 0x3d, 0x96, 0xb5, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -119,6 +123,9 @@ type TabletManagerClient interface {
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetHotRows returns the row (ranges) currently tracked by the
+	// automatic hot row detection
+	GetHotRows(ctx context.Context, in *tabletmanagerdata.GetHotRowsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHotRowsResponse, error)
 	SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -158,6 +165,8 @@ type TabletManagerClient interface {
 	// VReplication API
 	VReplicationExec(ctx context.Context, in *tabletmanagerdata.VReplicationExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(ctx context.Context, in *tabletmanagerdata.VReplicationWaitForPosRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VDiff runs or reports on a tablet-side diff of a workflow
+	VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -187,6 +196,10 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// GetGCTables lists the tables going through the table garbage collection lifecycle
+	GetGCTables(ctx context.Context, in *tabletmanagerdata.GetGCTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetGCTablesResponse, error)
+	// CancelGCTableDrop cancels the drop of a table, by restoring a table in HOLD state to its original name
+	CancelGCTableDrop(ctx context.Context, in *tabletmanagerdata.CancelGCTableDropRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CancelGCTableDropResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -242,6 +255,15 @@ func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmana
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetHotRows(ctx context.Context, in *tabletmanagerdata.GetHotRowsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHotRowsResponse, error) {
+	out := new(tabletmanagerdata.GetHotRowsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetHotRows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	out := new(tabletmanagerdata.SetReadOnlyResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnly", in, out, opts...)
@@ -476,6 +498,15 @@ func (c *tabletManagerClient) VReplicationWaitForPos(ctx context.Context, in *ta
 	return out, nil
 }
 
+func (c *tabletManagerClient) VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error) {
+	out := new(tabletmanagerdata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ResetReplication(ctx context.Context, in *tabletmanagerdata.ResetReplicationRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	out := new(tabletmanagerdata.ResetReplicationResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResetReplication", in, out, opts...)
@@ -648,6 +679,24 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetGCTables(ctx context.Context, in *tabletmanagerdata.GetGCTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetGCTablesResponse, error) {
+	out := new(tabletmanagerdata.GetGCTablesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetGCTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) CancelGCTableDrop(ctx context.Context, in *tabletmanagerdata.CancelGCTableDropRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CancelGCTableDropResponse, error) {
+	out := new(tabletmanagerdata.CancelGCTableDropResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CancelGCTableDrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -660,6 +709,9 @@ type TabletManagerServer interface {
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetHotRows returns the row (ranges) currently tracked by the
+	// automatic hot row detection
+	GetHotRows(context.Context, *tabletmanagerdata.GetHotRowsRequest) (*tabletmanagerdata.GetHotRowsResponse, error)
 	SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(context.Context, *tabletmanagerdata.SetReadWriteRequest) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -699,6 +751,8 @@ type TabletManagerServer interface {
 	// VReplication API
 	VReplicationExec(context.Context, *tabletmanagerdata.VReplicationExecRequest) (*tabletmanagerdata.VReplicationExecResponse, error)
 	VReplicationWaitForPos(context.Context, *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error)
+	// VDiff runs or reports on a tablet-side diff of a workflow
+	VDiff(context.Context, *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error)
 	// ResetReplication makes the target not replicating
 	ResetReplication(context.Context, *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error)
 	// InitMaster initializes the tablet as a master
@@ -728,6 +782,10 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// GetGCTables lists the tables going through the table garbage collection lifecycle
+	GetGCTables(context.Context, *tabletmanagerdata.GetGCTablesRequest) (*tabletmanagerdata.GetGCTablesResponse, error)
+	// CancelGCTableDrop cancels the drop of a table, by restoring a table in HOLD state to its original name
+	CancelGCTableDrop(context.Context, *tabletmanagerdata.CancelGCTableDropRequest) (*tabletmanagerdata.CancelGCTableDropResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -749,6 +807,9 @@ func (*UnimplementedTabletManagerServer) GetSchema(ctx context.Context, req *tab
 func (*UnimplementedTabletManagerServer) GetPermissions(ctx context.Context, req *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
 }
+func (*UnimplementedTabletManagerServer) GetHotRows(ctx context.Context, req *tabletmanagerdata.GetHotRowsRequest) (*tabletmanagerdata.GetHotRowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotRows not implemented")
+}
 func (*UnimplementedTabletManagerServer) SetReadOnly(ctx context.Context, req *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
 }
@@ -827,6 +888,9 @@ func (*UnimplementedTabletManagerServer) VReplicationExec(ctx context.Context, r
 func (*UnimplementedTabletManagerServer) VReplicationWaitForPos(ctx context.Context, req *tabletmanagerdata.VReplicationWaitForPosRequest) (*tabletmanagerdata.VReplicationWaitForPosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VReplicationWaitForPos not implemented")
 }
+func (*UnimplementedTabletManagerServer) VDiff(ctx context.Context, req *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
 func (*UnimplementedTabletManagerServer) ResetReplication(ctx context.Context, req *tabletmanagerdata.ResetReplicationRequest) (*tabletmanagerdata.ResetReplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetReplication not implemented")
 }
@@ -869,6 +933,12 @@ func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdat
 func (*UnimplementedTabletManagerServer) VExec(ctx context.Context, req *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (*UnimplementedTabletManagerServer) GetGCTables(ctx context.Context, req *tabletmanagerdata.GetGCTablesRequest) (*tabletmanagerdata.GetGCTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGCTables not implemented")
+}
+func (*UnimplementedTabletManagerServer) CancelGCTableDrop(ctx context.Context, req *tabletmanagerdata.CancelGCTableDropRequest) (*tabletmanagerdata.CancelGCTableDropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGCTableDrop not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -964,6 +1034,24 @@ func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetHotRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetHotRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetHotRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetHotRows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetHotRows(ctx, req.(*tabletmanagerdata.GetHotRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.SetReadOnlyRequest)
 	if err := dec(in); err != nil {
@@ -1432,6 +1520,24 @@ func _TabletManager_VReplicationWaitForPos_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VDiff(ctx, req.(*tabletmanagerdata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ResetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ResetReplicationRequest)
 	if err := dec(in); err != nil {
@@ -1690,6 +1796,42 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetGCTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetGCTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetGCTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetGCTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetGCTables(ctx, req.(*tabletmanagerdata.GetGCTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_CancelGCTableDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CancelGCTableDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CancelGCTableDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CancelGCTableDrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CancelGCTableDrop(ctx, req.(*tabletmanagerdata.CancelGCTableDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1714,6 +1856,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissions",
 			Handler:    _TabletManager_GetPermissions_Handler,
 		},
+		{
+			MethodName: "GetHotRows",
+			Handler:    _TabletManager_GetHotRows_Handler,
+		},
 		{
 			MethodName: "SetReadOnly",
 			Handler:    _TabletManager_SetReadOnly_Handler,
@@ -1818,6 +1964,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VReplicationWaitForPos",
 			Handler:    _TabletManager_VReplicationWaitForPos_Handler,
 		},
+		{
+			MethodName: "VDiff",
+			Handler:    _TabletManager_VDiff_Handler,
+		},
 		{
 			MethodName: "ResetReplication",
 			Handler:    _TabletManager_ResetReplication_Handler,
@@ -1866,6 +2016,14 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "GetGCTables",
+			Handler:    _TabletManager_GetGCTables_Handler,
+		},
+		{
+			MethodName: "CancelGCTableDrop",
+			Handler:    _TabletManager_CancelGCTableDrop_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -288,6 +288,8 @@ type Table struct {
 	// "reference".
 	// See https://vitess.io/docs/reference/features/vschema/#reference-tables.
 	//
+	// If the table is a view, type must be "view".
+	//
 	// Otherwise, it should be empty.
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	// column_vindexes associates columns to vindexes.
@@ -305,10 +307,16 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// view_definition is the SELECT statement that defines
+	// a view. It is only set when type is "view".
+	ViewDefinition string `protobuf:"bytes,7,opt,name=view_definition,json=viewDefinition,proto3" json:"view_definition,omitempty"`
+	// foreign_keys lists the foreign keys of the table. Each one
+	// references a parent table of the same keyspace.
+	ForeignKeys          []*ForeignKey `protobuf:"bytes,8,rep,name=foreign_keys,json=foreignKeys,proto3" json:"foreign_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -386,6 +394,20 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetViewDefinition() string {
+	if m != nil {
+		return m.ViewDefinition
+	}
+	return ""
+}
+
+func (m *Table) GetForeignKeys() []*ForeignKey {
+	if m != nil {
+		return m.ForeignKeys
+	}
+	return nil
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -566,6 +588,103 @@ func (m *Column) GetType() query.Type {
 	return query.Type_NULL_TYPE
 }
 
+// ForeignKey describes a foreign key of a child table.
+type ForeignKey struct {
+	// name is the optional name of the constraint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// columns lists the referencing columns of the child table.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// parent_table is the referenced table. It must belong to
+	// the keyspace of the child table.
+	ParentTable string `protobuf:"bytes,3,opt,name=parent_table,json=parentTable,proto3" json:"parent_table,omitempty"`
+	// parent_columns lists the referenced columns of the parent
+	// table, in the order of columns.
+	ParentColumns []string `protobuf:"bytes,4,rep,name=parent_columns,json=parentColumns,proto3" json:"parent_columns,omitempty"`
+	// on_delete and on_update are the referential actions of the
+	// foreign key: "restrict", "no action" or "cascade".
+	// They default to "restrict".
+	OnDelete             string   `protobuf:"bytes,5,opt,name=on_delete,json=onDelete,proto3" json:"on_delete,omitempty"`
+	OnUpdate             string   `protobuf:"bytes,6,opt,name=on_update,json=onUpdate,proto3" json:"on_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForeignKey) Reset()         { *m = ForeignKey{} }
+func (m *ForeignKey) String() string { return proto.CompactTextString(m) }
+func (*ForeignKey) ProtoMessage()    {}
+func (*ForeignKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{8}
+}
+func (m *ForeignKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForeignKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForeignKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForeignKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeignKey.Merge(m, src)
+}
+func (m *ForeignKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForeignKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeignKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForeignKey proto.InternalMessageInfo
+
+func (m *ForeignKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForeignKey) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *ForeignKey) GetParentTable() string {
+	if m != nil {
+		return m.ParentTable
+	}
+	return ""
+}
+
+func (m *ForeignKey) GetParentColumns() []string {
+	if m != nil {
+		return m.ParentColumns
+	}
+	return nil
+}
+
+func (m *ForeignKey) GetOnDelete() string {
+	if m != nil {
+		return m.OnDelete
+	}
+	return ""
+}
+
+func (m *ForeignKey) GetOnUpdate() string {
+	if m != nil {
+		return m.OnUpdate
+	}
+	return ""
+}
+
 // SrvVSchema is the roll-up of all the Keyspace schema for a cell.
 type SrvVSchema struct {
 	// keyspaces is a map of keyspace name -> Keyspace object.
@@ -580,7 +699,7 @@ func (m *SrvVSchema) Reset()         { *m = SrvVSchema{} }
 func (m *SrvVSchema) String() string { return proto.CompactTextString(m) }
 func (*SrvVSchema) ProtoMessage()    {}
 func (*SrvVSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{8}
+	return fileDescriptor_3f6849254fea3e77, []int{9}
 }
 func (m *SrvVSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,6 +754,7 @@ func init() {
 	proto.RegisterType((*ColumnVindex)(nil), "vschema.ColumnVindex")
 	proto.RegisterType((*AutoIncrement)(nil), "vschema.AutoIncrement")
 	proto.RegisterType((*Column)(nil), "vschema.Column")
+	proto.RegisterType((*ForeignKey)(nil), "vschema.ForeignKey")
 	proto.RegisterType((*SrvVSchema)(nil), "vschema.SrvVSchema")
 	proto.RegisterMapType((map[string]*Keyspace)(nil), "vschema.SrvVSchema.KeyspacesEntry")
 }
@@ -642,51 +762,59 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0xfe, 0x9d, 0x90, 0x90, 0x8c, 0x49, 0xf8, 0xbb, 0x02, 0xea, 0x06, 0x11, 0x22, 0x8b, 0xaa,
-	0x69, 0x0f, 0x89, 0x14, 0xd4, 0x8a, 0xa6, 0xa2, 0x2a, 0x45, 0x1c, 0x50, 0x91, 0x5a, 0x19, 0xc4,
-	0xa1, 0x17, 0xcb, 0x38, 0x5b, 0x58, 0x91, 0x78, 0xcd, 0xee, 0xda, 0x25, 0x6f, 0xd2, 0x5e, 0xfb,
-	0x34, 0x3d, 0xf6, 0xde, 0x4b, 0x45, 0x8f, 0x7d, 0x89, 0xca, 0xbb, 0x6b, 0xb3, 0x81, 0xf4, 0xb6,
-	0xdf, 0xce, 0xcc, 0x37, 0xdf, 0xce, 0xce, 0x0c, 0x34, 0x52, 0x1e, 0x5e, 0xe0, 0x49, 0xd0, 0x8b,
-	0x19, 0x15, 0x14, 0x2d, 0x6a, 0xd8, 0xb2, 0xaf, 0x12, 0xcc, 0xa6, 0xea, 0xd6, 0x1d, 0xc2, 0x92,
-	0x47, 0x13, 0x41, 0xa2, 0x73, 0x2f, 0x19, 0x63, 0x8e, 0x9e, 0x41, 0x85, 0x65, 0x07, 0xc7, 0xea,
-	0x94, 0xbb, 0xf6, 0x60, 0xa5, 0x97, 0x93, 0x18, 0x5e, 0x9e, 0x72, 0x71, 0x0f, 0xc1, 0x36, 0x6e,
-	0xd1, 0x06, 0xc0, 0x27, 0x46, 0x27, 0xbe, 0x08, 0xce, 0xc6, 0xd8, 0xb1, 0x3a, 0x56, 0xb7, 0xee,
-	0xd5, 0xb3, 0x9b, 0x93, 0xec, 0x02, 0xad, 0x43, 0x5d, 0x50, 0x65, 0xe4, 0x4e, 0xa9, 0x53, 0xee,
-	0xd6, 0xbd, 0x9a, 0xa0, 0xd2, 0xc6, 0xdd, 0x3f, 0x25, 0xa8, 0xbd, 0xc3, 0x53, 0x1e, 0x07, 0x21,
-	0x46, 0x0e, 0x2c, 0xf2, 0x8b, 0x80, 0x8d, 0xf0, 0x48, 0xb2, 0xd4, 0xbc, 0x1c, 0xa2, 0x57, 0x50,
-	0x4b, 0x49, 0x34, 0xc2, 0xd7, 0x9a, 0xc2, 0x1e, 0x6c, 0x16, 0x02, 0xf3, 0xf0, 0xde, 0xa9, 0xf6,
-	0x38, 0x88, 0x04, 0x9b, 0x7a, 0x45, 0x00, 0x7a, 0x0e, 0x55, 0x9d, 0xbd, 0x2c, 0x43, 0x37, 0xee,
-	0x87, 0x2a, 0x35, 0x2a, 0x50, 0x3b, 0xa3, 0x1d, 0x70, 0x18, 0xbe, 0x4a, 0x08, 0xc3, 0x3e, 0xbe,
-	0x8e, 0xc7, 0x24, 0x24, 0xc2, 0x67, 0xea, 0xd9, 0xce, 0x82, 0x94, 0xb7, 0xa6, 0xed, 0x07, 0xda,
-	0xac, 0x8b, 0xd2, 0x3a, 0x82, 0xc6, 0x8c, 0x16, 0xf4, 0x3f, 0x94, 0x2f, 0xf1, 0x54, 0x97, 0x26,
-	0x3b, 0xa2, 0xc7, 0x50, 0x49, 0x83, 0x71, 0x82, 0x9d, 0x52, 0xc7, 0xea, 0xda, 0x83, 0xe5, 0x42,
-	0x92, 0x0a, 0xf4, 0x94, 0x75, 0x58, 0xda, 0xb1, 0x5a, 0x87, 0x60, 0x1b, 0xf2, 0xe6, 0x70, 0x6d,
-	0xcd, 0x72, 0x35, 0x0b, 0x2e, 0x19, 0x66, 0x50, 0xb9, 0xdf, 0x2c, 0xa8, 0xaa, 0x04, 0x08, 0xc1,
-	0x82, 0x98, 0xc6, 0xf9, 0x77, 0xc9, 0x33, 0xda, 0x86, 0x6a, 0x1c, 0xb0, 0x60, 0x92, 0xd7, 0x78,
-	0xfd, 0x8e, 0xaa, 0xde, 0x07, 0x69, 0xd5, 0x65, 0x52, 0xae, 0x68, 0x05, 0x2a, 0xf4, 0x73, 0x84,
-	0x99, 0x53, 0x96, 0x4c, 0x0a, 0xb4, 0x5e, 0x82, 0x6d, 0x38, 0xcf, 0x11, 0xbd, 0x62, 0x8a, 0xae,
-	0x9b, 0x22, 0xbf, 0x96, 0xa0, 0xa2, 0x3a, 0x67, 0x9e, 0xc6, 0xd7, 0xb0, 0x1c, 0xd2, 0x71, 0x32,
-	0x89, 0xfc, 0x3b, 0x0d, 0xb1, 0x5a, 0x88, 0xdd, 0x97, 0x76, 0x5d, 0xc8, 0x66, 0x68, 0x20, 0xcc,
-	0xd1, 0x2e, 0x34, 0x83, 0x44, 0x50, 0x9f, 0x44, 0x21, 0xc3, 0x13, 0x1c, 0x09, 0xa9, 0xdb, 0x1e,
-	0xac, 0x15, 0xe1, 0x7b, 0x89, 0xa0, 0x87, 0xb9, 0xd5, 0x6b, 0x04, 0x26, 0x44, 0x4f, 0x61, 0x51,
-	0x11, 0x72, 0x67, 0x41, 0xa6, 0x5d, 0xbe, 0x93, 0xd6, 0xcb, 0xed, 0x68, 0x0d, 0xaa, 0x31, 0x89,
-	0x22, 0x3c, 0x72, 0x2a, 0x52, 0xbf, 0x46, 0x68, 0x08, 0x8f, 0xf4, 0x0b, 0xc6, 0x84, 0x0b, 0x3f,
-	0x48, 0xc4, 0x05, 0x65, 0x44, 0x04, 0x82, 0xa4, 0xd8, 0xa9, 0xca, 0xc6, 0x7a, 0xa8, 0x1c, 0x8e,
-	0x08, 0x17, 0x7b, 0xa6, 0xd9, 0x3d, 0x81, 0x25, 0xf3, 0x75, 0x59, 0x0e, 0xe5, 0xaa, 0x6b, 0xa4,
-	0x51, 0x56, 0xb9, 0x28, 0x98, 0xe4, 0xc5, 0x95, 0xe7, 0x6c, 0xba, 0x72, 0xe9, 0x65, 0x39, 0x85,
-	0x39, 0x74, 0xf7, 0xa1, 0x31, 0xf3, 0xe8, 0x7f, 0xd2, 0xb6, 0xa0, 0xc6, 0xf1, 0x55, 0x82, 0xa3,
-	0x30, 0xa7, 0x2e, 0xb0, 0xbb, 0x0b, 0xd5, 0xfd, 0xd9, 0xe4, 0x96, 0x91, 0x7c, 0x53, 0x7f, 0x65,
-	0x16, 0xd5, 0x1c, 0xd8, 0x3d, 0xb5, 0x8a, 0x4e, 0xa6, 0x31, 0x56, 0xff, 0xea, 0xfe, 0xb4, 0x00,
-	0x8e, 0x59, 0x7a, 0x7a, 0x2c, 0x8b, 0x89, 0xde, 0x40, 0xfd, 0x52, 0x0f, 0x67, 0xbe, 0x92, 0xdc,
-	0xa2, 0xd2, 0xb7, 0x7e, 0xc5, 0x04, 0xeb, 0xa6, 0xbc, 0x0d, 0x42, 0x43, 0x68, 0xe8, 0x69, 0xf5,
-	0xd5, 0x62, 0x53, 0xd3, 0xb1, 0x3a, 0x6f, 0xb1, 0x71, 0x6f, 0x89, 0x19, 0xa8, 0xf5, 0x1e, 0x9a,
-	0xb3, 0xc4, 0x73, 0x1a, 0xf8, 0xc9, 0xec, 0xd4, 0x3d, 0xb8, 0xb7, 0x54, 0x8c, 0x9e, 0x7e, 0xfb,
-	0xe2, 0xfb, 0x4d, 0xdb, 0xfa, 0x71, 0xd3, 0xb6, 0x7e, 0xdd, 0xb4, 0xad, 0x2f, 0xbf, 0xdb, 0xff,
-	0x7d, 0xdc, 0x4a, 0x89, 0xc0, 0x9c, 0xf7, 0x08, 0xed, 0xab, 0x53, 0xff, 0x9c, 0xf6, 0x53, 0xd1,
-	0x97, 0xdb, 0xb9, 0xaf, 0xb9, 0xce, 0xaa, 0x12, 0x6e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x39, 0x77, 0x25, 0xd3, 0x05, 0x00, 0x00,
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x2d, 0x25, 0x4b, 0x96, 0x86, 0x92, 0xdc, 0x6e, 0x1d, 0x97, 0x95, 0x11, 0x47, 0x25, 0x12,
+	0xc4, 0xed, 0x41, 0x02, 0x14, 0x34, 0x48, 0x5d, 0xa4, 0x68, 0xea, 0xa4, 0x80, 0x91, 0x00, 0x2d,
+	0x18, 0x37, 0x87, 0x5e, 0x08, 0x46, 0x1a, 0xdb, 0x0b, 0x4b, 0xbb, 0xf4, 0xee, 0x92, 0x09, 0xff,
+	0xa4, 0xe7, 0x7e, 0x48, 0xcf, 0x3d, 0xf6, 0xde, 0x4b, 0xe1, 0x1e, 0xfb, 0x07, 0x3d, 0x15, 0xdc,
+	0x5d, 0xd2, 0xcb, 0x44, 0xbd, 0xed, 0xcc, 0x9b, 0x79, 0x7c, 0x3b, 0x33, 0x3b, 0x84, 0x61, 0x2e,
+	0x17, 0x17, 0xb8, 0x4e, 0xa6, 0xa9, 0xe0, 0x8a, 0x93, 0x6d, 0x6b, 0x8e, 0xfd, 0xab, 0x0c, 0x45,
+	0x61, 0xbc, 0xe1, 0x11, 0x0c, 0x22, 0x9e, 0x29, 0xca, 0xce, 0xa3, 0x6c, 0x85, 0x92, 0x7c, 0x01,
+	0x1d, 0x51, 0x1e, 0x02, 0x6f, 0xd2, 0x3e, 0xf4, 0xe7, 0xbb, 0xd3, 0x8a, 0xc4, 0x89, 0x8a, 0x4c,
+	0x48, 0x78, 0x02, 0xbe, 0xe3, 0x25, 0xb7, 0x01, 0xce, 0x04, 0x5f, 0xc7, 0x2a, 0x79, 0xbd, 0xc2,
+	0xc0, 0x9b, 0x78, 0x87, 0xfd, 0xa8, 0x5f, 0x7a, 0x4e, 0x4b, 0x07, 0xd9, 0x87, 0xbe, 0xe2, 0x06,
+	0x94, 0x41, 0x6b, 0xd2, 0x3e, 0xec, 0x47, 0x3d, 0xc5, 0x35, 0x26, 0xc3, 0x7f, 0x5a, 0xd0, 0x7b,
+	0x8e, 0x85, 0x4c, 0x93, 0x05, 0x92, 0x00, 0xb6, 0xe5, 0x45, 0x22, 0x96, 0xb8, 0xd4, 0x2c, 0xbd,
+	0xa8, 0x32, 0xc9, 0xd7, 0xd0, 0xcb, 0x29, 0x5b, 0xe2, 0x5b, 0x4b, 0xe1, 0xcf, 0xef, 0xd4, 0x02,
+	0xab, 0xf4, 0xe9, 0x2b, 0x1b, 0xf1, 0x8c, 0x29, 0x51, 0x44, 0x75, 0x02, 0xf9, 0x12, 0xba, 0xf6,
+	0xeb, 0x6d, 0x9d, 0x7a, 0xfb, 0xfd, 0x54, 0xa3, 0xc6, 0x24, 0xda, 0x60, 0xf2, 0x08, 0x02, 0x81,
+	0x57, 0x19, 0x15, 0x18, 0xe3, 0xdb, 0x74, 0x45, 0x17, 0x54, 0xc5, 0xc2, 0x5c, 0x3b, 0xd8, 0xd2,
+	0xf2, 0xf6, 0x2c, 0xfe, 0xcc, 0xc2, 0xb6, 0x28, 0xe3, 0x17, 0x30, 0x6c, 0x68, 0x21, 0x1f, 0x42,
+	0xfb, 0x12, 0x0b, 0x5b, 0x9a, 0xf2, 0x48, 0xee, 0x41, 0x27, 0x4f, 0x56, 0x19, 0x06, 0xad, 0x89,
+	0x77, 0xe8, 0xcf, 0x77, 0x6a, 0x49, 0x26, 0x31, 0x32, 0xe8, 0x51, 0xeb, 0x91, 0x37, 0x3e, 0x01,
+	0xdf, 0x91, 0xb7, 0x81, 0xeb, 0x6e, 0x93, 0x6b, 0x54, 0x73, 0xe9, 0x34, 0x87, 0x2a, 0xfc, 0xd5,
+	0x83, 0xae, 0xf9, 0x00, 0x21, 0xb0, 0xa5, 0x8a, 0xb4, 0x6a, 0x97, 0x3e, 0x93, 0x07, 0xd0, 0x4d,
+	0x13, 0x91, 0xac, 0xab, 0x1a, 0xef, 0xbf, 0xa3, 0x6a, 0xfa, 0xa3, 0x46, 0x6d, 0x99, 0x4c, 0x28,
+	0xd9, 0x85, 0x0e, 0x7f, 0xc3, 0x50, 0x04, 0x6d, 0xcd, 0x64, 0x8c, 0xf1, 0x57, 0xe0, 0x3b, 0xc1,
+	0x1b, 0x44, 0xef, 0xba, 0xa2, 0xfb, 0xae, 0xc8, 0x7f, 0x5b, 0xd0, 0x31, 0x93, 0xb3, 0x49, 0xe3,
+	0x37, 0xb0, 0xb3, 0xe0, 0xab, 0x6c, 0xcd, 0xe2, 0x77, 0x06, 0xe2, 0x56, 0x2d, 0xf6, 0x58, 0xe3,
+	0xb6, 0x90, 0xa3, 0x85, 0x63, 0xa1, 0x24, 0x8f, 0x61, 0x94, 0x64, 0x8a, 0xc7, 0x94, 0x2d, 0x04,
+	0xae, 0x91, 0x29, 0xad, 0xdb, 0x9f, 0xef, 0xd5, 0xe9, 0x4f, 0x32, 0xc5, 0x4f, 0x2a, 0x34, 0x1a,
+	0x26, 0xae, 0x49, 0x3e, 0x87, 0x6d, 0x43, 0x28, 0x83, 0xad, 0x49, 0xbb, 0xd1, 0x39, 0xf3, 0xd9,
+	0xa8, 0xc2, 0xc9, 0x1e, 0x74, 0x53, 0xca, 0x18, 0x2e, 0x83, 0x8e, 0xd6, 0x6f, 0x2d, 0x72, 0x04,
+	0x9f, 0xda, 0x1b, 0xac, 0xa8, 0x54, 0x71, 0x92, 0xa9, 0x0b, 0x2e, 0xa8, 0x4a, 0x14, 0xcd, 0x31,
+	0xe8, 0xea, 0xc1, 0xfa, 0xc4, 0x04, 0xbc, 0xa0, 0x52, 0x3d, 0x71, 0x61, 0x72, 0x1f, 0x76, 0x72,
+	0x8a, 0x6f, 0xe2, 0x25, 0x9e, 0x51, 0x46, 0x15, 0xe5, 0x2c, 0xd8, 0xd6, 0xe4, 0xa3, 0xd2, 0xfd,
+	0xb4, 0xf6, 0x92, 0x87, 0x30, 0x38, 0xe3, 0x02, 0xe9, 0x39, 0x8b, 0x2f, 0xb1, 0x90, 0x41, 0x4f,
+	0x8b, 0xfd, 0xb8, 0x16, 0xfb, 0xbd, 0x01, 0x9f, 0x63, 0x11, 0xf9, 0x67, 0xf5, 0x59, 0x86, 0xa7,
+	0x30, 0x70, 0xcb, 0x57, 0x5e, 0xc2, 0x68, 0xb1, 0x4d, 0xb0, 0x56, 0xd9, 0x1a, 0x96, 0xac, 0xab,
+	0xee, 0xe9, 0x73, 0xf9, 0x7c, 0xab, 0xda, 0xb4, 0xf5, 0x33, 0xaf, 0xcc, 0xf0, 0x18, 0x86, 0x8d,
+	0xaa, 0xfe, 0x2f, 0xed, 0x18, 0x7a, 0x12, 0xaf, 0x32, 0x64, 0x8b, 0x8a, 0xba, 0xb6, 0xc3, 0xc7,
+	0xd0, 0x3d, 0x6e, 0x7e, 0xdc, 0x73, 0x3e, 0x7e, 0xc7, 0xce, 0x4a, 0x99, 0x35, 0x9a, 0xfb, 0x53,
+	0xb3, 0xeb, 0x4e, 0x8b, 0x14, 0xcd, 0xe0, 0x84, 0xbf, 0x79, 0x00, 0x37, 0xb7, 0xde, 0xc8, 0xe1,
+	0x5c, 0xa0, 0xd5, 0xb8, 0x00, 0xf9, 0x0c, 0x06, 0x69, 0x22, 0x90, 0x29, 0xbb, 0xe4, 0xcc, 0xac,
+	0xfb, 0xc6, 0x67, 0x86, 0xf5, 0x1e, 0x8c, 0x6c, 0x88, 0x3b, 0x20, 0xfd, 0x68, 0x68, 0xbc, 0xc7,
+	0x96, 0x69, 0x1f, 0xfa, 0x9c, 0xc5, 0x4b, 0x5c, 0xa1, 0x42, 0x3b, 0x18, 0x3d, 0xce, 0x9e, 0x6a,
+	0xdb, 0x82, 0x59, 0xba, 0x4c, 0x94, 0x19, 0x05, 0x0d, 0xfe, 0xa4, 0xed, 0xf0, 0x4f, 0x0f, 0xe0,
+	0xa5, 0xc8, 0x5f, 0xbd, 0xd4, 0x1d, 0x24, 0xdf, 0x42, 0xff, 0xd2, 0xae, 0xaf, 0x6a, 0x69, 0x87,
+	0x75, 0x7b, 0x6f, 0xe2, 0xea, 0x1d, 0x67, 0x9f, 0xed, 0x4d, 0x12, 0x39, 0x82, 0xa1, 0xdd, 0x67,
+	0xb1, 0x59, 0xfd, 0x66, 0x7f, 0xdc, 0xda, 0xb4, 0xfa, 0x65, 0x34, 0x10, 0x8e, 0x35, 0xfe, 0x01,
+	0x46, 0x4d, 0xe2, 0x0d, 0x4f, 0xfc, 0x7e, 0x73, 0x2f, 0x7d, 0xf4, 0xde, 0xda, 0x75, 0x5e, 0xfd,
+	0x77, 0x0f, 0x7f, 0xbf, 0x3e, 0xf0, 0xfe, 0xb8, 0x3e, 0xf0, 0xfe, 0xba, 0x3e, 0xf0, 0x7e, 0xf9,
+	0xfb, 0xe0, 0x83, 0x9f, 0xef, 0xe6, 0x54, 0xa1, 0x94, 0x53, 0xca, 0x67, 0xe6, 0x34, 0x3b, 0xe7,
+	0xb3, 0x5c, 0xcd, 0xf4, 0xff, 0x6b, 0x66, 0xb9, 0x5e, 0x77, 0xb5, 0xf9, 0xe0, 0xbf, 0x01, 0x00,
+	0xc5, 0x5f, 0x01, 0x18, 0xf5, 0x06, 0x00, 0x00,
 }
 
 func (m *RoutingRules) Marshal() (dAtA []byte, err error) {
@@ -956,6 +1084,27 @@ func (m *Table) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ForeignKeys) > 0 {
+		for iNdEx := len(m.ForeignKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForeignKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVschema(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ViewDefinition) > 0 {
+		i -= len(m.ViewDefinition)
+		copy(dAtA[i:], m.ViewDefinition)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.ViewDefinition)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ColumnListAuthoritative {
 		i--
 		if m.ColumnListAuthoritative {
@@ -1153,6 +1302,79 @@ func (m *Column) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForeignKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeignKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForeignKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OnUpdate) > 0 {
+		i -= len(m.OnUpdate)
+		copy(dAtA[i:], m.OnUpdate)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.OnUpdate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OnDelete) > 0 {
+		i -= len(m.OnDelete)
+		copy(dAtA[i:], m.OnDelete)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.OnDelete)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ParentColumns) > 0 {
+		for iNdEx := len(m.ParentColumns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParentColumns[iNdEx])
+			copy(dAtA[i:], m.ParentColumns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.ParentColumns[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ParentTable) > 0 {
+		i -= len(m.ParentTable)
+		copy(dAtA[i:], m.ParentTable)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.ParentTable)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SrvVSchema) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1374,6 +1596,16 @@ func (m *Table) Size() (n int) {
 	if m.ColumnListAuthoritative {
 		n += 2
 	}
+	l = len(m.ViewDefinition)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if len(m.ForeignKeys) > 0 {
+		for _, e := range m.ForeignKeys {
+			l = e.Size()
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1445,6 +1677,46 @@ func (m *Column) Size() (n int) {
 	return n
 }
 
+func (m *ForeignKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
+	l = len(m.ParentTable)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if len(m.ParentColumns) > 0 {
+		for _, s := range m.ParentColumns {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
+	l = len(m.OnDelete)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	l = len(m.OnUpdate)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SrvVSchema) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2500,6 +2772,72 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColumnListAuthoritative = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewDefinition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewDefinition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignKeys = append(m.ForeignKeys, &ForeignKey{})
+			if err := m.ForeignKeys[len(m.ForeignKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -2898,6 +3236,252 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForeignKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVschema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentColumns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentColumns = append(m.ParentColumns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnDelete = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnUpdate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVschema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SrvVSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -218,10 +218,18 @@ type MaterializeSettings struct {
 	TabletTypes string `protobuf:"bytes,7,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
 	// ExternalCluster is the name of the mounted cluster which has the source keyspace/db for this workflow
 	// it is of the type <cluster_type.cluster_name>
-	ExternalCluster      string   `protobuf:"bytes,8,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ExternalCluster string `protobuf:"bytes,8,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
+	// on_ddl specifies the action to be taken when a DDL is encountered.
+	OnDdl binlogdata.OnDDLAction `protobuf:"varint,9,opt,name=on_ddl,json=onDdl,proto3,enum=binlogdata.OnDDLAction" json:"on_ddl,omitempty"`
+	// on_ddl_strategy is the ddl strategy used to apply the DDLs if on_ddl
+	// is EXEC_COORDINATED.
+	OnDdlStrategy string `protobuf:"bytes,10,opt,name=on_ddl_strategy,json=onDdlStrategy,proto3" json:"on_ddl_strategy,omitempty"`
+	// external_sink, if set, makes the workflow write the row changes to an
+	// external sink instead of the tables of the target keyspace.
+	ExternalSink         *binlogdata.ExternalSink `protobuf:"bytes,11,opt,name=external_sink,json=externalSink,proto3" json:"external_sink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *MaterializeSettings) Reset()         { *m = MaterializeSettings{} }
@@ -313,6 +321,27 @@ func (m *MaterializeSettings) GetExternalCluster() string {
 	return ""
 }
 
+func (m *MaterializeSettings) GetOnDdl() binlogdata.OnDDLAction {
+	if m != nil {
+		return m.OnDdl
+	}
+	return binlogdata.OnDDLAction_IGNORE
+}
+
+func (m *MaterializeSettings) GetOnDdlStrategy() string {
+	if m != nil {
+		return m.OnDdlStrategy
+	}
+	return ""
+}
+
+func (m *MaterializeSettings) GetExternalSink() *binlogdata.ExternalSink {
+	if m != nil {
+		return m.ExternalSink
+	}
+	return nil
+}
+
 type Keyspace struct {
 	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Keyspace             *topodata.Keyspace `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
@@ -4216,178 +4245,182 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 2731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0xb5, 0xcb, 0x2f, 0x89, 0x8f, 0x1f, 0x92, 0x56, 0x94, 0xb4, 0x61, 0x6c, 0x59, 0x5e, 0xc7, 0x8e,
-	0xea, 0xc4, 0x94, 0xad, 0x24, 0x86, 0xe1, 0x24, 0xad, 0x6d, 0x89, 0x32, 0xe4, 0x38, 0xaa, 0xba,
-	0x54, 0x15, 0x34, 0x87, 0x6e, 0x47, 0xe4, 0x88, 0x5e, 0x68, 0xb9, 0xcb, 0xec, 0x0c, 0x29, 0x31,
-	0x3d, 0xf4, 0xd2, 0x1e, 0x02, 0x14, 0xe8, 0xb5, 0x40, 0x50, 0xa0, 0xa7, 0xa2, 0xe8, 0xad, 0x97,
-	0x00, 0x2d, 0x8a, 0x1e, 0x8b, 0x1e, 0x7a, 0xe8, 0xb5, 0xb7, 0xc2, 0xfd, 0x19, 0xbd, 0x14, 0xf3,
-	0xb5, 0x5c, 0x2e, 0x97, 0xb4, 0x2c, 0x1b, 0x28, 0x7a, 0x12, 0xe7, 0xcd, 0x7b, 0xf3, 0xde, 0xbc,
-	0xef, 0x37, 0x2b, 0x98, 0xeb, 0xd3, 0x26, 0x75, 0x5b, 0x88, 0xa2, 0x5a, 0x37, 0xf0, 0xa9, 0xaf,
-	0xe7, 0x43, 0x40, 0x75, 0xfe, 0xc8, 0xf1, 0x5c, 0xbf, 0x3d, 0xdc, 0xac, 0x96, 0x5c, 0xbf, 0xdd,
-	0xa3, 0x8e, 0x2b, 0x97, 0xe5, 0xce, 0x80, 0x7c, 0xe1, 0x36, 0xa9, 0x5a, 0x2f, 0x05, 0xb8, 0xeb,
-	0x3a, 0x4d, 0x44, 0x1d, 0xdf, 0x8b, 0x50, 0xad, 0x50, 0x74, 0xe4, 0x62, 0xda, 0x41, 0x1e, 0x6a,
-	0xe3, 0x20, 0xb2, 0x51, 0xa6, 0x7e, 0xd7, 0x8f, 0x1e, 0xdf, 0x27, 0xcd, 0x67, 0xb8, 0xa3, 0x96,
-	0xc5, 0x3e, 0xa5, 0x4e, 0x07, 0x8b, 0x95, 0xf9, 0x19, 0x54, 0xeb, 0x67, 0xb8, 0xd9, 0xa3, 0xf8,
-	0x90, 0x49, 0xb8, 0xe5, 0x77, 0x3a, 0xc8, 0x6b, 0x59, 0xf8, 0x8b, 0x1e, 0x26, 0x54, 0xd7, 0x21,
-	0x83, 0x82, 0x36, 0x31, 0xb4, 0xb5, 0xf4, 0x7a, 0xde, 0xe2, 0xbf, 0xf5, 0xeb, 0x50, 0x46, 0x4d,
-	0x26, 0x8b, 0xcd, 0x8e, 0xf1, 0x7b, 0xd4, 0x48, 0xad, 0x69, 0xeb, 0x69, 0xab, 0x24, 0xa0, 0x07,
-	0x02, 0x68, 0x6e, 0xc1, 0x9b, 0x89, 0x07, 0x93, 0xae, 0xef, 0x11, 0xac, 0xbf, 0x05, 0x59, 0xdc,
-	0xc7, 0x1e, 0x35, 0xb4, 0x35, 0x6d, 0xbd, 0xb0, 0x59, 0xae, 0x29, 0x1d, 0xd4, 0x19, 0xd4, 0x12,
-	0x9b, 0xe6, 0x57, 0x1a, 0x18, 0x07, 0xec, 0x9a, 0x9f, 0x22, 0x8a, 0x03, 0x07, 0xb9, 0xce, 0x97,
-	0xb8, 0x81, 0x29, 0x75, 0xbc, 0x36, 0xd1, 0xaf, 0x42, 0x91, 0xa2, 0xa0, 0x8d, 0xa9, 0xcd, 0x35,
-	0xc1, 0x4f, 0xca, 0x5b, 0x05, 0x01, 0xe3, 0x54, 0xfa, 0x3b, 0xb0, 0x40, 0xfc, 0x5e, 0xd0, 0xc4,
-	0x36, 0x3e, 0xeb, 0x06, 0x98, 0x10, 0xc7, 0xf7, 0xb8, 0xb8, 0x79, 0x6b, 0x5e, 0x6c, 0xd4, 0x43,
-	0xb8, 0x7e, 0x19, 0xa0, 0x19, 0x60, 0x44, 0xb1, 0xdd, 0x6a, 0xb9, 0x46, 0x9a, 0x63, 0xe5, 0x05,
-	0x64, 0xbb, 0xe5, 0x9a, 0xff, 0x4c, 0xc1, 0x62, 0x92, 0x18, 0x55, 0x98, 0x3d, 0xf5, 0x83, 0x93,
-	0x63, 0xd7, 0x3f, 0x95, 0x22, 0x84, 0x6b, 0xfd, 0x6d, 0x98, 0x93, 0xfc, 0x4f, 0xf0, 0x80, 0x74,
-	0x51, 0x13, 0x4b, 0xee, 0x65, 0x01, 0xfe, 0x44, 0x42, 0x19, 0xa2, 0xbc, 0x4b, 0x88, 0x28, 0x04,
-	0x28, 0x0b, 0x70, 0x88, 0x78, 0x03, 0xe6, 0x08, 0xf5, 0xbb, 0x36, 0x3a, 0xa6, 0x38, 0xb0, 0x9b,
-	0x7e, 0x77, 0x60, 0x64, 0xd6, 0xb4, 0xf5, 0x59, 0xab, 0xc4, 0xc0, 0x0f, 0x19, 0x74, 0xcb, 0xef,
-	0x0e, 0xf4, 0x27, 0x50, 0xe6, 0x5a, 0xb1, 0x89, 0x94, 0xd3, 0xc8, 0xae, 0xa5, 0xd7, 0x0b, 0x9b,
-	0xd7, 0x6a, 0x43, 0xd7, 0x9c, 0xa4, 0x59, 0xab, 0xc4, 0x49, 0xc3, 0x1b, 0xea, 0x90, 0x69, 0x62,
-	0xd7, 0x35, 0x72, 0x5c, 0x22, 0xfe, 0x5b, 0x28, 0x9f, 0xf9, 0x9f, 0x4d, 0x07, 0x5d, 0x4c, 0x8c,
-	0x19, 0xa5, 0x7c, 0x06, 0x3b, 0x60, 0x20, 0xfd, 0xdb, 0x30, 0x8f, 0xcf, 0x28, 0x0e, 0x3c, 0xe4,
-	0xda, 0x4d, 0xb7, 0x47, 0x28, 0x0e, 0x8c, 0x59, 0x8e, 0x36, 0xa7, 0xe0, 0x5b, 0x02, 0x6c, 0xee,
-	0xc1, 0x6c, 0x78, 0x43, 0x1d, 0x32, 0x1e, 0xea, 0x28, 0x73, 0xf2, 0xdf, 0x7a, 0x0d, 0x66, 0x47,
-	0x14, 0x58, 0xd8, 0xd4, 0x6b, 0xa1, 0x97, 0x2b, 0x4a, 0x2b, 0xc4, 0x31, 0x7f, 0x04, 0xd9, 0xc6,
-	0x33, 0x14, 0xb4, 0x98, 0x71, 0x42, 0x42, 0x69, 0x9c, 0x93, 0x38, 0xa3, 0x54, 0x84, 0xd1, 0x75,
-	0xc8, 0x12, 0x46, 0xc8, 0xb5, 0x5f, 0xd8, 0x9c, 0x1b, 0x72, 0xe1, 0xe7, 0x59, 0x62, 0xd7, 0xfc,
-	0x5d, 0x1e, 0x66, 0x3f, 0x53, 0x46, 0x4e, 0x12, 0xf8, 0xbb, 0x90, 0x13, 0x16, 0x96, 0xe2, 0xbe,
-	0x1d, 0x51, 0xbb, 0x22, 0xac, 0x59, 0xc3, 0xb8, 0x7e, 0xea, 0x8b, 0xbf, 0x96, 0x24, 0x63, 0x07,
-	0x08, 0xcb, 0x4b, 0x49, 0xce, 0x7f, 0x80, 0x20, 0xd3, 0xef, 0xc0, 0x52, 0x07, 0x9d, 0xd9, 0x7d,
-	0x3b, 0x92, 0x3d, 0x6c, 0x17, 0xb5, 0xb9, 0xbb, 0xa4, 0x2d, 0xbd, 0x83, 0xce, 0x0e, 0xa3, 0xf4,
-	0xa8, 0xad, 0x3f, 0x81, 0x12, 0xbf, 0x9e, 0x4d, 0x68, 0x80, 0x51, 0x47, 0xb9, 0xcc, 0xf5, 0x24,
-	0xd6, 0x5c, 0x1d, 0x0d, 0x81, 0x57, 0xf7, 0x68, 0x30, 0xb0, 0x8a, 0x24, 0x02, 0xaa, 0xfe, 0x18,
-	0x16, 0xc6, 0x50, 0xf4, 0x79, 0x48, 0x9f, 0xe0, 0x81, 0x54, 0x14, 0xfb, 0xa9, 0x7f, 0x00, 0xd9,
-	0x3e, 0x72, 0x7b, 0x4a, 0x4d, 0x57, 0x5e, 0xc0, 0xca, 0x12, 0xd8, 0xf7, 0x53, 0xf7, 0xb4, 0xea,
-	0x2e, 0x2c, 0x26, 0xdc, 0x7f, 0xaa, 0xc5, 0x97, 0x21, 0xc7, 0x85, 0x24, 0x46, 0x8a, 0x27, 0x34,
-	0xb9, 0xaa, 0xfe, 0x51, 0x83, 0x42, 0x84, 0x8b, 0xfe, 0x3e, 0xcc, 0x28, 0x15, 0x68, 0x5c, 0x05,
-	0xd5, 0x44, 0xb9, 0x84, 0x48, 0x0a, 0x55, 0xdf, 0x61, 0x31, 0xcc, 0x43, 0xa2, 0xe9, 0x7b, 0x34,
-	0xf0, 0x5d, 0xc1, 0xa6, 0xb0, 0x79, 0x39, 0xe6, 0x45, 0x22, 0xf0, 0xe8, 0x96, 0xc0, 0xb2, 0x44,
-	0xa0, 0xaa, 0x25, 0xd1, 0xdf, 0x05, 0xdd, 0x21, 0x76, 0x37, 0x70, 0x3a, 0x28, 0x18, 0xd8, 0x04,
-	0x07, 0x7d, 0xc7, 0x6b, 0x73, 0x37, 0x98, 0xb5, 0xe6, 0x1d, 0xb2, 0x2f, 0x36, 0x1a, 0x02, 0x5e,
-	0xfd, 0x75, 0x06, 0x72, 0x52, 0xec, 0x32, 0xa4, 0x9c, 0x16, 0xbf, 0x74, 0xda, 0x4a, 0x39, 0x2d,
-	0xbd, 0xa2, 0x9c, 0x59, 0x78, 0xb8, 0x58, 0xe8, 0xb7, 0x98, 0x67, 0x31, 0x86, 0xd2, 0xb3, 0x96,
-	0x86, 0xd2, 0x09, 0xb9, 0x1e, 0xba, 0x0e, 0x22, 0x96, 0x44, 0xd2, 0x3f, 0x86, 0x92, 0x28, 0x58,
-	0xb6, 0x74, 0xe8, 0x0c, 0xa7, 0x32, 0x6a, 0x91, 0x32, 0xf6, 0x88, 0xff, 0x6c, 0xf0, 0x7d, 0xab,
-	0x78, 0x14, 0x59, 0x31, 0x73, 0x74, 0x7d, 0xe2, 0x30, 0xd3, 0x18, 0x59, 0x61, 0x0e, 0xb5, 0xd6,
-	0xaf, 0x01, 0x4f, 0x5a, 0x76, 0x88, 0x20, 0x12, 0x4c, 0x91, 0x01, 0xf7, 0x15, 0x12, 0xbb, 0x04,
-	0x45, 0x14, 0xcb, 0x0c, 0x23, 0x16, 0xfa, 0x0a, 0xcc, 0xb4, 0x8e, 0x6c, 0x1e, 0x76, 0x22, 0xa5,
-	0xe4, 0x5a, 0x47, 0x7b, 0x2c, 0xf0, 0x1e, 0xc2, 0x12, 0x0d, 0x90, 0x47, 0x22, 0x25, 0x8a, 0x50,
-	0xd4, 0xe9, 0x1a, 0x79, 0x2e, 0x76, 0xb1, 0x26, 0xab, 0x1f, 0x2b, 0x53, 0x56, 0x25, 0x82, 0x7a,
-	0xa0, 0x30, 0xf5, 0x0d, 0x28, 0x32, 0x14, 0xbb, 0xd7, 0x6d, 0x21, 0x8a, 0x5b, 0x06, 0x24, 0x50,
-	0x16, 0xd8, 0xcf, 0x1f, 0x08, 0x04, 0xdd, 0x80, 0x99, 0x0e, 0x26, 0x04, 0xb5, 0xb1, 0x51, 0xe0,
-	0xc2, 0xa8, 0xa5, 0x5e, 0x87, 0x02, 0x4b, 0xd1, 0x36, 0x17, 0x9a, 0x18, 0x45, 0xee, 0x0e, 0x6f,
-	0x4d, 0x76, 0xa6, 0x1a, 0xcb, 0xdd, 0x0d, 0x86, 0x6c, 0x41, 0x53, 0xfd, 0x24, 0xd5, 0xfb, 0x90,
-	0x0f, 0x37, 0x98, 0x42, 0xa2, 0xf5, 0x4e, 0x2c, 0x98, 0x42, 0x5c, 0x44, 0xa8, 0xdd, 0x3d, 0x91,
-	0xd6, 0xce, 0xb1, 0xe5, 0xfe, 0x89, 0xf9, 0xb5, 0x06, 0x2b, 0x5b, 0xcf, 0x90, 0xd7, 0xc6, 0x07,
-	0x61, 0x6e, 0x56, 0xe5, 0xfd, 0x5e, 0x98, 0xc4, 0x11, 0xb3, 0xb9, 0xac, 0xc5, 0x13, 0x1c, 0x42,
-	0xe6, 0x76, 0xbe, 0xd0, 0x6f, 0x71, 0xfd, 0xb3, 0xd4, 0xcf, 0xd9, 0x95, 0x37, 0x2b, 0x71, 0x22,
-	0xce, 0x27, 0xd7, 0x3a, 0x62, 0x7f, 0xb9, 0xb9, 0x82, 0x81, 0x1d, 0xf4, 0x3c, 0xe9, 0xc7, 0xb9,
-	0x56, 0x30, 0xb0, 0x7a, 0x9e, 0xf9, 0x5b, 0x0d, 0x8c, 0x71, 0xe9, 0x64, 0x8f, 0xf0, 0x01, 0x94,
-	0x8e, 0xf0, 0xb1, 0x1f, 0x60, 0x5b, 0x3a, 0xac, 0x90, 0x6f, 0x3e, 0xce, 0xca, 0x2a, 0x0a, 0x34,
-	0xb1, 0xd2, 0xdf, 0x83, 0xa2, 0xa8, 0x8e, 0x92, 0x2a, 0x35, 0x81, 0xaa, 0xc0, 0xb1, 0x24, 0xd1,
-	0x2a, 0x14, 0x4e, 0x11, 0xb1, 0x47, 0xa5, 0xcc, 0x9f, 0x22, 0xb2, 0x2d, 0x04, 0xfd, 0x26, 0x0d,
-	0x4b, 0x5b, 0xbc, 0x17, 0x08, 0xcb, 0xcd, 0xb0, 0x47, 0x1a, 0x4b, 0xff, 0x15, 0xc8, 0x1e, 0xfb,
-	0x2a, 0xfb, 0xcf, 0x5a, 0x62, 0xa1, 0x6f, 0x40, 0x05, 0xb9, 0xae, 0x7f, 0x6a, 0xe3, 0x4e, 0x97,
-	0x0e, 0xec, 0xbe, 0x2d, 0xfa, 0x32, 0xc9, 0x6c, 0x81, 0xef, 0xd5, 0xd9, 0xd6, 0x61, 0x83, 0x6f,
-	0xe8, 0xb7, 0xa1, 0xc2, 0x63, 0xd6, 0xf1, 0xda, 0x76, 0xd3, 0x77, 0x7b, 0x1d, 0x4f, 0xb8, 0x7c,
-	0x86, 0xb3, 0xd2, 0xd5, 0xde, 0x16, 0xdf, 0xe2, 0xee, 0xff, 0x64, 0x9c, 0x82, 0x1b, 0x29, 0xcb,
-	0x8d, 0x64, 0x8c, 0x17, 0xcd, 0xdd, 0x16, 0x57, 0x79, 0xec, 0x2c, 0x6e, 0xb4, 0x07, 0x50, 0x64,
-	0xc9, 0x07, 0xb7, 0xec, 0xe3, 0xc0, 0xef, 0x10, 0x23, 0x17, 0x4f, 0x66, 0xea, 0x8c, 0x5a, 0x83,
-	0xa3, 0xed, 0x04, 0x7e, 0xc7, 0x2a, 0x90, 0xf0, 0x37, 0xd1, 0x6f, 0x42, 0x86, 0x73, 0x9f, 0xe1,
-	0xdc, 0x97, 0xc7, 0x29, 0x39, 0x6f, 0x8e, 0xc3, 0x92, 0xc1, 0x11, 0x22, 0x91, 0x46, 0x49, 0xc4,
-	0x75, 0x91, 0x01, 0xc3, 0xde, 0xe0, 0x0e, 0x94, 0x88, 0x87, 0xba, 0xe4, 0x99, 0x4f, 0x79, 0x68,
-	0x27, 0x46, 0x75, 0x51, 0xa1, 0xb0, 0x95, 0xb9, 0x0b, 0xcb, 0x71, 0xbb, 0x49, 0xf7, 0xda, 0x88,
-	0x55, 0x8a, 0xc2, 0xe6, 0x62, 0x24, 0x32, 0x13, 0xba, 0x8a, 0x5f, 0x68, 0xa0, 0x8b, 0xb3, 0x44,
-	0x33, 0x20, 0x1d, 0x60, 0x5a, 0xc5, 0xb9, 0x0c, 0x20, 0x4a, 0x6a, 0xa4, 0xd3, 0xc8, 0x73, 0xc8,
-	0xde, 0x88, 0x9f, 0xa4, 0xa3, 0x7e, 0x72, 0x1d, 0xca, 0x8e, 0xd7, 0x74, 0x7b, 0x2d, 0x6c, 0x77,
-	0x51, 0xc0, 0x9a, 0x64, 0xd9, 0xe2, 0x49, 0xe8, 0x3e, 0x07, 0x9a, 0xbf, 0xd1, 0x60, 0x71, 0x44,
-	0x9c, 0x0b, 0xde, 0x4b, 0xbf, 0x11, 0xad, 0x13, 0x2c, 0x52, 0x86, 0xd8, 0xd1, 0xae, 0x27, 0x74,
-	0x47, 0x1b, 0xb9, 0x01, 0x46, 0xad, 0x81, 0x8d, 0xcf, 0x1c, 0x42, 0x89, 0x14, 0x5e, 0xb8, 0xd0,
-	0x43, 0xb1, 0x55, 0xe7, 0x3b, 0xe6, 0xf7, 0x61, 0x69, 0x1b, 0xbb, 0x78, 0x3c, 0x68, 0xa6, 0xe9,
-	0xec, 0x12, 0xe4, 0x03, 0xdc, 0xec, 0x05, 0xc4, 0xe9, 0xab, 0x00, 0x1a, 0x02, 0x4c, 0x03, 0x96,
-	0xe3, 0x47, 0x8a, 0x7b, 0x9b, 0x3f, 0xd7, 0x60, 0x51, 0x6c, 0x71, 0xa9, 0x89, 0xe2, 0xb5, 0x1e,
-	0x56, 0x7d, 0x51, 0xcc, 0xc7, 0xef, 0x27, 0xf7, 0xa7, 0x73, 0x66, 0xad, 0x37, 0x9b, 0x4a, 0x6c,
-	0xe7, 0x38, 0x2c, 0xca, 0xd2, 0x2e, 0x0c, 0xbc, 0x7b, 0x2c, 0x2b, 0xb2, 0xb9, 0x0c, 0x95, 0x51,
-	0x31, 0xa4, 0x7c, 0x03, 0x05, 0x17, 0x29, 0x27, 0x94, 0xef, 0x23, 0xd9, 0xaa, 0xcb, 0x2c, 0x8c,
-	0x95, 0x9c, 0x13, 0xf2, 0x70, 0x29, 0x92, 0x87, 0x31, 0x61, 0x71, 0x23, 0x92, 0x8a, 0x6c, 0x18,
-	0xa4, 0xdc, 0x45, 0x0e, 0x94, 0xbd, 0x82, 0xb9, 0xa2, 0xec, 0x10, 0xb2, 0x96, 0x32, 0xfd, 0x32,
-	0x05, 0x97, 0xeb, 0x1d, 0x1c, 0xb4, 0xb1, 0xd7, 0x1c, 0x58, 0x58, 0xb8, 0xdb, 0xb9, 0xbd, 0x3b,
-	0xb9, 0xc1, 0xb8, 0x0b, 0x05, 0x0f, 0x0f, 0xe5, 0x99, 0xda, 0x65, 0x80, 0x87, 0x95, 0x90, 0xfa,
-	0x77, 0x60, 0xce, 0x69, 0x7b, 0x2c, 0xdd, 0xcb, 0x96, 0x95, 0x18, 0x99, 0x69, 0x8a, 0x28, 0x0b,
-	0x6c, 0xd9, 0x04, 0x12, 0x7d, 0x1b, 0x96, 0x4e, 0x91, 0x43, 0x43, 0xea, 0x70, 0x3e, 0xcd, 0x86,
-	0x6e, 0xcd, 0x93, 0xc4, 0x76, 0x2f, 0x10, 0xad, 0xf2, 0x22, 0x43, 0x57, 0xe4, 0x6a, 0x6e, 0xfd,
-	0xb3, 0x06, 0xab, 0x93, 0x34, 0x22, 0x03, 0xec, 0xe5, 0x55, 0xf2, 0x00, 0xe6, 0xbb, 0x81, 0xdf,
-	0xf1, 0x29, 0x6e, 0x9d, 0x4f, 0x2f, 0x73, 0x0a, 0x5d, 0x29, 0xe7, 0x06, 0xe4, 0xf8, 0x48, 0xac,
-	0x74, 0x12, 0x1f, 0x98, 0xe5, 0xae, 0xf9, 0x11, 0xac, 0xee, 0x38, 0x5e, 0xeb, 0xa1, 0xeb, 0x0a,
-	0xef, 0xdb, 0xf5, 0x5e, 0x22, 0xf4, 0xcc, 0xbf, 0x68, 0x70, 0x65, 0x22, 0xb9, 0xbc, 0xfd, 0x5e,
-	0x2c, 0x9c, 0xee, 0x46, 0xc2, 0xe9, 0x05, 0xb4, 0x22, 0xdc, 0xe4, 0xbc, 0xa0, 0x9a, 0xef, 0x4f,
-	0x64, 0xef, 0x3d, 0x71, 0x46, 0xb8, 0x31, 0x3a, 0x23, 0x24, 0xa4, 0xa7, 0x70, 0x28, 0x30, 0xeb,
-	0xb0, 0xf0, 0x18, 0xd3, 0x47, 0xa8, 0x79, 0xd2, 0xeb, 0x92, 0x0b, 0xbb, 0xb0, 0xb9, 0x0d, 0x7a,
-	0xf4, 0x18, 0x79, 0xf3, 0x1a, 0xcc, 0x1c, 0x09, 0x90, 0xbc, 0x7a, 0xa5, 0x16, 0x3e, 0xd5, 0x08,
-	0xdc, 0x5d, 0xef, 0xd8, 0xb7, 0x14, 0x92, 0xf9, 0x06, 0xac, 0x3c, 0xc6, 0x74, 0x0b, 0xbb, 0x2e,
-	0x83, 0xb3, 0x84, 0xaf, 0x44, 0x32, 0x6f, 0x83, 0x31, 0xbe, 0x25, 0xd9, 0x54, 0x20, 0xcb, 0xaa,
-	0x85, 0x7a, 0x75, 0x11, 0x0b, 0x73, 0x9d, 0x8b, 0xa4, 0x28, 0x22, 0xcd, 0x07, 0x1f, 0xcd, 0xb5,
-	0xe1, 0x68, 0x6e, 0xee, 0xc0, 0xe2, 0x08, 0x66, 0x58, 0x16, 0xf2, 0x6c, 0xdb, 0x76, 0xbc, 0x63,
-	0x5f, 0xd6, 0x85, 0xc8, 0x10, 0x1d, 0xa2, 0xcf, 0x36, 0xe5, 0x2f, 0x96, 0x69, 0xe5, 0x39, 0x44,
-	0x26, 0x1b, 0x25, 0xfd, 0x37, 0x5a, 0x78, 0xb3, 0xe1, 0x96, 0x64, 0xb3, 0x0b, 0x33, 0xa3, 0x69,
-	0x6c, 0x23, 0x62, 0xaf, 0x09, 0x44, 0x35, 0xb9, 0x16, 0x8e, 0xa1, 0xe8, 0xab, 0xfb, 0x50, 0x8c,
-	0x6e, 0x24, 0xb8, 0xc6, 0xcd, 0x51, 0xd7, 0xa8, 0x8c, 0xde, 0x47, 0xb0, 0x89, 0xba, 0xc7, 0x12,
-	0x57, 0x8d, 0x72, 0xcb, 0xf0, 0x3e, 0xbb, 0x50, 0x19, 0x05, 0xcb, 0xbb, 0xdc, 0x81, 0xbc, 0x72,
-	0x14, 0x75, 0x9b, 0xc4, 0x52, 0x3a, 0xc4, 0x32, 0x6f, 0x73, 0x33, 0xbd, 0x4c, 0xcc, 0xed, 0x8c,
-	0xc8, 0x74, 0xf1, 0xee, 0xe4, 0x67, 0x29, 0x98, 0x7f, 0x8c, 0xa9, 0x68, 0x1d, 0x5f, 0xbd, 0xc3,
-	0x5f, 0x96, 0x63, 0x62, 0x38, 0x2b, 0x8b, 0x15, 0x6b, 0x4e, 0xf0, 0x99, 0x68, 0x4e, 0xe4, 0x7e,
-	0x9a, 0xef, 0x97, 0x24, 0xf4, 0x40, 0xa0, 0x5d, 0x03, 0xd5, 0xad, 0xd8, 0x7d, 0x07, 0x9f, 0x12,
-	0x59, 0x2a, 0x8b, 0x12, 0x78, 0xc8, 0x60, 0xfa, 0x3a, 0xcc, 0x8b, 0x47, 0x2a, 0xee, 0xe2, 0xb6,
-	0xef, 0xb9, 0x03, 0x9e, 0xac, 0x67, 0xe5, 0x4c, 0xcc, 0xe3, 0xe2, 0x7b, 0x9e, 0x3b, 0x18, 0x62,
-	0x12, 0xe7, 0x4b, 0x85, 0x99, 0x8b, 0x60, 0x36, 0x18, 0x98, 0x61, 0x9a, 0xfb, 0x3c, 0x03, 0x28,
-	0x2d, 0x48, 0x65, 0x7e, 0x08, 0x39, 0xd9, 0x6b, 0x0b, 0x05, 0x5c, 0xab, 0x8d, 0x3f, 0x9e, 0x0a,
-	0x92, 0x6d, 0x7c, 0xec, 0x78, 0x8e, 0x7c, 0x8a, 0xe1, 0x10, 0xf3, 0x29, 0xcc, 0xb1, 0x13, 0x5f,
-	0x4f, 0xcb, 0x67, 0xde, 0x17, 0x56, 0x1a, 0x29, 0x28, 0x61, 0x03, 0xa6, 0x4d, 0x6d, 0xc0, 0xcc,
-	0x27, 0x3c, 0x22, 0x1b, 0x41, 0x3f, 0xee, 0xc1, 0x2f, 0x4a, 0x71, 0x2c, 0xa6, 0x95, 0x21, 0xc5,
-	0xc2, 0xfc, 0xbb, 0x88, 0xe1, 0xd1, 0xc3, 0xa4, 0x3c, 0x3f, 0x84, 0x12, 0x09, 0xfa, 0x76, 0xdc,
-	0xf7, 0xdf, 0x1f, 0x8d, 0xe4, 0x24, 0xd2, 0x5a, 0x14, 0xa8, 0xde, 0x85, 0x22, 0xa0, 0xea, 0x21,
-	0x2c, 0x8c, 0xa1, 0x24, 0x04, 0xf6, 0x3b, 0xa3, 0x81, 0x1d, 0x71, 0xd8, 0x08, 0x75, 0x34, 0xb2,
-	0x6f, 0xf2, 0x10, 0x6e, 0x04, 0xfd, 0xc3, 0xd1, 0x00, 0x48, 0x4a, 0x90, 0x7b, 0xb0, 0x14, 0xc3,
-	0x0d, 0x07, 0x4e, 0x26, 0xec, 0x70, 0x30, 0x0b, 0xe3, 0x4e, 0x3e, 0xa0, 0x47, 0x48, 0x80, 0x84,
-	0xbf, 0xcd, 0xa7, 0xdc, 0xa4, 0x72, 0xaa, 0x7c, 0xd5, 0xc0, 0x33, 0x3f, 0xe6, 0x0e, 0xac, 0x4e,
-	0x93, 0x92, 0xad, 0x87, 0x8f, 0x36, 0x93, 0x66, 0x60, 0xb9, 0x6f, 0xfe, 0x41, 0x8b, 0xd0, 0x5f,
-	0xbc, 0x04, 0x0e, 0xbd, 0x26, 0x1d, 0xf1, 0x1a, 0xfe, 0x82, 0x46, 0x03, 0xa7, 0xa9, 0x46, 0x12,
-	0xb9, 0x4a, 0xe8, 0x61, 0xb3, 0xe7, 0xef, 0x61, 0xcd, 0x07, 0x3c, 0x69, 0xc6, 0x7a, 0x53, 0xfd,
-	0x26, 0xcc, 0x08, 0xb4, 0x61, 0xe3, 0x1e, 0xbf, 0xb4, 0x42, 0x30, 0x37, 0xf8, 0xa5, 0x63, 0xb6,
-	0x9f, 0x96, 0x75, 0x1f, 0x71, 0x96, 0x71, 0x07, 0x78, 0x17, 0x66, 0x63, 0xc6, 0x5f, 0x08, 0x8d,
-	0x1f, 0x7a, 0xdd, 0x4c, 0x5f, 0xda, 0xdd, 0xe2, 0x99, 0x5b, 0x3d, 0xe1, 0x9c, 0x4b, 0xd7, 0x57,
-	0xa0, 0x80, 0x9a, 0xd4, 0xe9, 0x63, 0x91, 0xc2, 0x44, 0xaf, 0x0e, 0x02, 0xc4, 0xd3, 0x97, 0x28,
-	0x45, 0x91, 0x33, 0x87, 0xa5, 0x48, 0x7d, 0x55, 0x48, 0x2a, 0x45, 0x8a, 0xc0, 0x1a, 0x62, 0x99,
-	0xff, 0xd1, 0x60, 0x65, 0xd7, 0x73, 0x44, 0xae, 0x91, 0x7d, 0xe4, 0xc5, 0xfd, 0xc1, 0x82, 0xaa,
-	0x7a, 0x92, 0xc4, 0x2e, 0x6e, 0xca, 0x8f, 0x2e, 0xca, 0xbd, 0xa7, 0x36, 0xb3, 0x2b, 0x92, 0xb0,
-	0xce, 0xe8, 0x22, 0x1b, 0xc3, 0xf1, 0x37, 0x13, 0x1d, 0x7f, 0x5f, 0x4f, 0x1f, 0xff, 0x08, 0x8c,
-	0xf1, 0xcb, 0x87, 0xf9, 0x56, 0x35, 0xd3, 0xda, 0xd4, 0x66, 0xfa, 0xab, 0x14, 0xbc, 0xb9, 0xef,
-	0x22, 0xcf, 0xc3, 0xad, 0xff, 0xf1, 0x6c, 0x74, 0x1f, 0x4a, 0xa8, 0xef, 0x3b, 0xc3, 0xe9, 0x21,
-	0x33, 0x8d, 0xb2, 0xc8, 0x71, 0x15, 0xed, 0xeb, 0xd1, 0xe7, 0x9f, 0x34, 0xb8, 0x94, 0xac, 0x8b,
-	0xff, 0x83, 0xa9, 0xe8, 0xa7, 0xf0, 0x86, 0x85, 0x3b, 0x7e, 0x3f, 0x7c, 0x34, 0x60, 0xed, 0xe1,
-	0x79, 0xac, 0xa8, 0xca, 0x47, 0x2a, 0xf2, 0xe9, 0x2b, 0xf9, 0xd1, 0x66, 0xe4, 0xed, 0x20, 0x13,
-	0x7f, 0xb5, 0xb8, 0x04, 0xd5, 0x24, 0x01, 0xe4, 0x14, 0xfe, 0xb5, 0x06, 0xcb, 0x62, 0x9b, 0xab,
-	0xf4, 0xbc, 0xc2, 0xbd, 0xe0, 0x71, 0x49, 0xc9, 0x9e, 0x4e, 0x92, 0x3d, 0x33, 0x51, 0xf6, 0x6c,
-	0x5c, 0xf6, 0x37, 0x60, 0x65, 0x4c, 0x38, 0x29, 0xf8, 0x0e, 0x2c, 0x29, 0x67, 0x18, 0x2d, 0x7f,
-	0xb7, 0x62, 0xf5, 0x6a, 0xfa, 0x47, 0x06, 0xf3, 0x27, 0xec, 0xfe, 0xa3, 0xe7, 0x5c, 0xd8, 0xab,
-	0x36, 0x60, 0xe6, 0x5c, 0xce, 0xa4, 0xb0, 0xcc, 0x03, 0x58, 0x93, 0x9e, 0x1c, 0x7e, 0x4d, 0x52,
-	0x5f, 0x1f, 0x5e, 0x61, 0x84, 0xfc, 0x7d, 0x1a, 0xae, 0x4e, 0x39, 0x56, 0x5e, 0xef, 0x0c, 0x2a,
-	0xd1, 0xef, 0x73, 0x84, 0x22, 0xda, 0x1b, 0x8e, 0x4e, 0xf5, 0xb1, 0x46, 0x70, 0xca, 0x59, 0xd1,
-	0xaf, 0x81, 0x0d, 0x79, 0x8e, 0xe8, 0xc0, 0x16, 0x83, 0xf1, 0x1d, 0xfd, 0x73, 0x00, 0x99, 0xc1,
-	0x3b, 0xa8, 0x2b, 0x3f, 0x54, 0x7d, 0xf8, 0x52, 0xfc, 0x84, 0x32, 0x3f, 0x45, 0x5d, 0xc1, 0x25,
-	0x4f, 0xd5, 0xba, 0x6a, 0x83, 0x31, 0x49, 0x98, 0x84, 0x5e, 0xef, 0xd6, 0x68, 0xaf, 0xb7, 0x52,
-	0x8b, 0xff, 0xbf, 0x83, 0x38, 0x20, 0xfa, 0xed, 0x6f, 0x0f, 0xca, 0xa3, 0xdc, 0xcf, 0xf3, 0x6c,
-	0x10, 0x6f, 0x1e, 0x22, 0xdd, 0xa3, 0x05, 0x57, 0x05, 0xb0, 0x2e, 0x3f, 0x4c, 0xbb, 0xe1, 0xd3,
-	0x0f, 0x6e, 0x5d, 0xd0, 0xa7, 0xff, 0xaa, 0x81, 0x39, 0xed, 0xd0, 0x0b, 0x3b, 0xf8, 0x45, 0x6b,
-	0xc8, 0x5d, 0x28, 0xf8, 0xee, 0x39, 0x2b, 0x08, 0xf8, 0xae, 0x4a, 0xb2, 0x8f, 0xee, 0xfd, 0xed,
-	0xf9, 0xaa, 0xf6, 0x8f, 0xe7, 0xab, 0xda, 0xbf, 0x9e, 0xaf, 0x6a, 0xbf, 0xfa, 0xf7, 0xea, 0xb7,
-	0x3e, 0xbf, 0xd1, 0x77, 0x28, 0x26, 0xa4, 0xe6, 0xf8, 0x1b, 0xe2, 0xd7, 0x46, 0xdb, 0xdf, 0xe8,
-	0xd3, 0x0d, 0xfe, 0x2f, 0x25, 0x1b, 0xa1, 0x0f, 0x1d, 0xe5, 0x38, 0xe0, 0xbd, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0xda, 0xbd, 0xf5, 0x12, 0x0f, 0x23, 0x00, 0x00,
+	// 2794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x49, 0x6f, 0x23, 0xc7,
+	0xf5, 0xff, 0xb7, 0xb8, 0x48, 0x7c, 0x5c, 0x24, 0x95, 0xb6, 0x36, 0xed, 0x91, 0xe5, 0x1e, 0xcf,
+	0x58, 0xff, 0xb1, 0x87, 0xb2, 0xe5, 0x05, 0xc6, 0xd8, 0x4e, 0x3c, 0x23, 0x69, 0x0c, 0x8d, 0xc7,
+	0x63, 0xa5, 0xa9, 0xc8, 0x88, 0x0f, 0xe9, 0x94, 0xc8, 0x12, 0xa7, 0xa1, 0x66, 0x37, 0xdd, 0x55,
+	0xa4, 0x44, 0xe7, 0x90, 0x4b, 0x72, 0x30, 0x10, 0x20, 0xd7, 0x00, 0x46, 0x80, 0x9c, 0x82, 0x20,
+	0xb7, 0x5c, 0x0c, 0x24, 0x08, 0x72, 0x0c, 0x72, 0xc8, 0x21, 0x1f, 0x21, 0x70, 0xbe, 0x40, 0xee,
+	0xb9, 0x04, 0xb5, 0x35, 0x8b, 0xcd, 0x16, 0x47, 0x23, 0x1b, 0x08, 0x72, 0x52, 0xd7, 0x5b, 0xaa,
+	0x5e, 0xbd, 0xfa, 0xbd, 0xa5, 0x8a, 0x82, 0xf9, 0x01, 0x6b, 0xb1, 0xa0, 0x8d, 0x19, 0x6e, 0xf4,
+	0xe2, 0x88, 0x45, 0xa8, 0x94, 0x10, 0xea, 0x0b, 0xc7, 0x7e, 0x18, 0x44, 0x9d, 0x11, 0xb3, 0x5e,
+	0x0d, 0xa2, 0x4e, 0x9f, 0xf9, 0x81, 0x1a, 0xd6, 0xba, 0x43, 0xfa, 0x59, 0xd0, 0x62, 0x7a, 0xbc,
+	0x12, 0x93, 0x5e, 0xe0, 0xb7, 0x30, 0xf3, 0xa3, 0xd0, 0xd0, 0x5a, 0x63, 0xf8, 0x38, 0x20, 0xac,
+	0x8b, 0x43, 0xdc, 0x21, 0xb1, 0xc1, 0xa8, 0xb1, 0xa8, 0x17, 0x99, 0xd3, 0x0f, 0x68, 0xeb, 0x31,
+	0xe9, 0xea, 0x61, 0x65, 0xc0, 0x98, 0xdf, 0x25, 0x72, 0xe4, 0x7c, 0x02, 0xf5, 0xbd, 0x73, 0xd2,
+	0xea, 0x33, 0x72, 0xc4, 0x2d, 0xdc, 0x89, 0xba, 0x5d, 0x1c, 0xb6, 0x5d, 0xf2, 0x59, 0x9f, 0x50,
+	0x86, 0x10, 0xe4, 0x71, 0xdc, 0xa1, 0xb6, 0xb5, 0x91, 0xdb, 0x2c, 0xb9, 0xe2, 0x1b, 0xdd, 0x80,
+	0x1a, 0x6e, 0x71, 0x5b, 0x3c, 0x3e, 0x4d, 0xd4, 0x67, 0xf6, 0xcc, 0x86, 0xb5, 0x99, 0x73, 0xab,
+	0x92, 0x7a, 0x28, 0x89, 0xce, 0x0e, 0x3c, 0x9b, 0x39, 0x31, 0xed, 0x45, 0x21, 0x25, 0xe8, 0x45,
+	0x28, 0x90, 0x01, 0x09, 0x99, 0x6d, 0x6d, 0x58, 0x9b, 0xe5, 0xed, 0x5a, 0x43, 0xfb, 0x60, 0x8f,
+	0x53, 0x5d, 0xc9, 0x74, 0xbe, 0xb0, 0xc0, 0x3e, 0xe4, 0xdb, 0xfc, 0x08, 0x33, 0x12, 0xfb, 0x38,
+	0xf0, 0x3f, 0x27, 0x4d, 0xc2, 0x98, 0x1f, 0x76, 0x28, 0x7a, 0x01, 0x2a, 0x0c, 0xc7, 0x1d, 0xc2,
+	0x3c, 0xe1, 0x09, 0x31, 0x53, 0xc9, 0x2d, 0x4b, 0x9a, 0xd0, 0x42, 0x2f, 0xc3, 0x22, 0x8d, 0xfa,
+	0x71, 0x8b, 0x78, 0xe4, 0xbc, 0x17, 0x13, 0x4a, 0xfd, 0x28, 0x14, 0xe6, 0x96, 0xdc, 0x05, 0xc9,
+	0xd8, 0x4b, 0xe8, 0xe8, 0x1a, 0x40, 0x2b, 0x26, 0x98, 0x11, 0xaf, 0xdd, 0x0e, 0xec, 0x9c, 0x90,
+	0x2a, 0x49, 0xca, 0x6e, 0x3b, 0x70, 0xfe, 0x95, 0x83, 0xa5, 0x2c, 0x33, 0xea, 0x30, 0x77, 0x16,
+	0xc5, 0xa7, 0x27, 0x41, 0x74, 0xa6, 0x4c, 0x48, 0xc6, 0xe8, 0x25, 0x98, 0x57, 0xeb, 0x9f, 0x92,
+	0x21, 0xed, 0xe1, 0x16, 0x51, 0xab, 0xd7, 0x24, 0xf9, 0x43, 0x45, 0xe5, 0x82, 0x6a, 0x2f, 0x89,
+	0xa0, 0x34, 0xa0, 0x26, 0xc9, 0x89, 0xe0, 0x4d, 0x98, 0xa7, 0x2c, 0xea, 0x79, 0xf8, 0x84, 0x91,
+	0xd8, 0x6b, 0x45, 0xbd, 0xa1, 0x9d, 0xdf, 0xb0, 0x36, 0xe7, 0xdc, 0x2a, 0x27, 0xdf, 0xe5, 0xd4,
+	0x9d, 0xa8, 0x37, 0x44, 0x0f, 0xa0, 0x26, 0xbc, 0xe2, 0x51, 0x65, 0xa7, 0x5d, 0xd8, 0xc8, 0x6d,
+	0x96, 0xb7, 0xaf, 0x37, 0x46, 0xd0, 0xbc, 0xc8, 0xb3, 0x6e, 0x55, 0xa8, 0x26, 0x3b, 0x44, 0x90,
+	0x6f, 0x91, 0x20, 0xb0, 0x8b, 0xc2, 0x22, 0xf1, 0x2d, 0x9d, 0xcf, 0xf1, 0xe7, 0xb1, 0x61, 0x8f,
+	0x50, 0x7b, 0x56, 0x3b, 0x9f, 0xd3, 0x0e, 0x39, 0x09, 0xfd, 0x3f, 0x2c, 0x90, 0x73, 0x46, 0xe2,
+	0x10, 0x07, 0x5e, 0x2b, 0xe8, 0x53, 0x46, 0x62, 0x7b, 0x4e, 0x88, 0xcd, 0x6b, 0xfa, 0x8e, 0x24,
+	0xa3, 0x06, 0x14, 0xa3, 0x50, 0xb8, 0xbd, 0xb4, 0x61, 0x6d, 0xd6, 0xb6, 0xd7, 0x1a, 0x46, 0x90,
+	0x7c, 0x1c, 0xee, 0xee, 0x3e, 0xbc, 0x2b, 0xc0, 0xe5, 0x16, 0xa2, 0x70, 0xb7, 0x1d, 0x70, 0x2f,
+	0x48, 0x79, 0x8f, 0xb2, 0x18, 0x33, 0xd2, 0x19, 0xda, 0x20, 0x66, 0xae, 0x0a, 0x7e, 0x53, 0x11,
+	0xd1, 0x7b, 0x50, 0x4d, 0x4c, 0xa0, 0x7e, 0x78, 0x6a, 0x97, 0x05, 0xda, 0x6c, 0x73, 0xfa, 0x3d,
+	0x25, 0xd0, 0xf4, 0xc3, 0x53, 0xb7, 0x42, 0x8c, 0x91, 0xf3, 0x08, 0xe6, 0x12, 0xc7, 0x23, 0xc8,
+	0x87, 0xb8, 0xab, 0x51, 0x26, 0xbe, 0x51, 0x03, 0xe6, 0xc6, 0xce, 0xb5, 0xbc, 0x8d, 0x1a, 0x49,
+	0xf0, 0x69, 0x4d, 0x37, 0x91, 0x71, 0x7e, 0x08, 0x85, 0xe6, 0x63, 0x1c, 0xb7, 0x39, 0x66, 0x12,
+	0x45, 0x85, 0x99, 0xd3, 0xf4, 0x42, 0x33, 0xc6, 0x42, 0x37, 0xa0, 0x40, 0xb9, 0xa2, 0x00, 0x45,
+	0x79, 0x7b, 0x7e, 0xb4, 0x8a, 0x98, 0xcf, 0x95, 0x5c, 0xe7, 0xb7, 0x25, 0x98, 0xfb, 0x44, 0x63,
+	0x2f, 0xcb, 0xe0, 0xef, 0x42, 0x51, 0x02, 0x4f, 0x99, 0xfb, 0x92, 0x81, 0x06, 0xad, 0xd8, 0x70,
+	0x47, 0xe9, 0xe6, 0x61, 0x24, 0xff, 0xba, 0x4a, 0x8d, 0x4f, 0x20, 0x01, 0x69, 0xe7, 0x9e, 0x72,
+	0x02, 0xa9, 0x86, 0x5e, 0x83, 0x95, 0x2e, 0x3e, 0xf7, 0x06, 0x9e, 0x91, 0xd4, 0xbc, 0x00, 0x77,
+	0x04, 0x8a, 0x73, 0x2e, 0xea, 0xe2, 0xf3, 0x23, 0x53, 0x1f, 0x77, 0xd0, 0x03, 0xa8, 0x8a, 0xed,
+	0xf1, 0xb3, 0x26, 0xb8, 0xab, 0x91, 0x7c, 0x23, 0x6b, 0x69, 0xe1, 0x8e, 0xa6, 0x94, 0xdb, 0x0b,
+	0x59, 0x3c, 0x74, 0x2b, 0xd4, 0x20, 0xd5, 0x7f, 0x04, 0x8b, 0x13, 0x22, 0x68, 0x01, 0x72, 0xa7,
+	0x64, 0xa8, 0x1c, 0xc5, 0x3f, 0xd1, 0x9b, 0x50, 0x18, 0xe0, 0xa0, 0xaf, 0xdd, 0xf4, 0xfc, 0x13,
+	0x96, 0x72, 0xa5, 0xf4, 0x9d, 0x99, 0xb7, 0xad, 0xfa, 0x3e, 0x2c, 0x65, 0xec, 0x7f, 0xea, 0x89,
+	0xaf, 0x42, 0x51, 0x18, 0x49, 0xed, 0x19, 0x91, 0x67, 0xd5, 0xa8, 0xfe, 0x07, 0x0b, 0xca, 0xc6,
+	0x2a, 0xe8, 0x0d, 0x98, 0xd5, 0x2e, 0xb0, 0x84, 0x0b, 0xea, 0x99, 0x76, 0x49, 0x93, 0xb4, 0x28,
+	0xba, 0x0f, 0xf3, 0x32, 0x2a, 0xbd, 0x56, 0x14, 0xb2, 0x38, 0x0a, 0xe4, 0x32, 0xe5, 0xed, 0x6b,
+	0x29, 0x14, 0xc9, 0x7c, 0xc0, 0x76, 0xa4, 0x94, 0x5b, 0x63, 0xe6, 0x90, 0xa2, 0x57, 0x00, 0xf9,
+	0xd4, 0xeb, 0xc5, 0x7e, 0x17, 0xc7, 0x43, 0x8f, 0x92, 0x78, 0xe0, 0x87, 0x1d, 0x01, 0x83, 0x39,
+	0x77, 0xc1, 0xa7, 0x07, 0x92, 0xd1, 0x94, 0xf4, 0xfa, 0xaf, 0xf2, 0x50, 0x54, 0x66, 0xd7, 0x60,
+	0xc6, 0x6f, 0x8b, 0x4d, 0xe7, 0xdc, 0x19, 0xbf, 0x8d, 0x96, 0x35, 0x98, 0x25, 0xc2, 0xe5, 0x00,
+	0xdd, 0x86, 0xa2, 0x5c, 0x50, 0x21, 0x6b, 0x65, 0x64, 0x9d, 0xb4, 0xeb, 0x6e, 0xe0, 0x63, 0xea,
+	0x2a, 0x21, 0x1e, 0xd9, 0x32, 0x86, 0x3d, 0x05, 0xe8, 0xfc, 0x64, 0x64, 0xdf, 0x13, 0x9f, 0x4d,
+	0xc1, 0x77, 0x2b, 0xc7, 0xc6, 0x88, 0x1f, 0x47, 0x2f, 0xa2, 0x3e, 0x3f, 0x1a, 0xbb, 0x20, 0x8f,
+	0x43, 0x8f, 0xd1, 0x75, 0x10, 0xb9, 0xd4, 0x4b, 0x04, 0x64, 0xde, 0xab, 0x70, 0xe2, 0x81, 0x16,
+	0xe2, 0x9b, 0x60, 0x98, 0x11, 0x95, 0xf8, 0xe4, 0x00, 0xad, 0xc1, 0x6c, 0xfb, 0xd8, 0x13, 0x61,
+	0x27, 0x33, 0x5d, 0xb1, 0x7d, 0xfc, 0x88, 0x07, 0xde, 0x5d, 0x58, 0x61, 0x31, 0x0e, 0xa9, 0x51,
+	0x39, 0x29, 0xc3, 0xdd, 0x9e, 0xc8, 0x77, 0xe5, 0xed, 0x4a, 0x43, 0x15, 0x65, 0x5e, 0x3d, 0xdd,
+	0x65, 0x43, 0xf4, 0x50, 0x4b, 0xa2, 0x2d, 0xa8, 0x70, 0x11, 0xaf, 0xdf, 0x6b, 0x63, 0x46, 0xda,
+	0x36, 0x64, 0x68, 0x96, 0xf9, 0xe7, 0xf7, 0xa5, 0x00, 0xb2, 0x61, 0xb6, 0x4b, 0x28, 0xc5, 0x1d,
+	0x22, 0xd2, 0x5e, 0xc9, 0xd5, 0x43, 0xb4, 0x07, 0x65, 0x5e, 0x39, 0x3c, 0x61, 0x34, 0xb5, 0x2b,
+	0x02, 0x0e, 0x2f, 0x5e, 0x0c, 0xa6, 0x06, 0x2f, 0x29, 0x4d, 0x2e, 0xec, 0x42, 0x4b, 0x7f, 0xd2,
+	0xfa, 0x1d, 0x28, 0x25, 0x0c, 0xee, 0x10, 0xb3, 0x0c, 0xcb, 0x01, 0x77, 0x48, 0x80, 0x29, 0xf3,
+	0x7a, 0xa7, 0xea, 0xb4, 0x8b, 0x7c, 0x78, 0x70, 0xea, 0x7c, 0x69, 0xc1, 0xda, 0xce, 0x63, 0x1c,
+	0x76, 0xc8, 0x61, 0x52, 0x32, 0x74, 0xd7, 0xf1, 0x76, 0x52, 0x5b, 0x30, 0x3f, 0x73, 0xdb, 0x9a,
+	0x06, 0x88, 0x32, 0x1b, 0x0d, 0xd0, 0x6d, 0xe1, 0x7f, 0x5e, 0x91, 0xc4, 0x72, 0xb5, 0xed, 0xe5,
+	0xb4, 0x92, 0x58, 0xa7, 0xd8, 0x3e, 0xe6, 0x7f, 0xc5, 0x71, 0xc5, 0x43, 0x2f, 0xee, 0x87, 0x0a,
+	0xc7, 0xc5, 0x76, 0x3c, 0x74, 0xfb, 0xa1, 0xf3, 0x1b, 0x0b, 0xec, 0x49, 0xeb, 0x54, 0xeb, 0xf2,
+	0x26, 0x54, 0x8f, 0xc9, 0x49, 0x14, 0x13, 0x4f, 0x01, 0x56, 0xda, 0xb7, 0x90, 0x5e, 0xca, 0xad,
+	0x48, 0x31, 0x39, 0x42, 0xaf, 0x43, 0x45, 0x16, 0x6d, 0xa5, 0x35, 0x73, 0x81, 0x56, 0x59, 0x48,
+	0x29, 0xa5, 0x75, 0x28, 0x9f, 0x61, 0xea, 0x8d, 0x5b, 0x59, 0x3a, 0xc3, 0x74, 0x57, 0x1a, 0xfa,
+	0x55, 0x0e, 0x56, 0x76, 0x44, 0x8b, 0x92, 0x94, 0x9b, 0x51, 0xeb, 0x36, 0x91, 0xfe, 0x97, 0xa1,
+	0x70, 0x12, 0xe9, 0xec, 0x3f, 0xe7, 0xca, 0x01, 0xda, 0x82, 0x65, 0x1c, 0x04, 0xd1, 0x99, 0x47,
+	0xba, 0x3d, 0x36, 0xf4, 0x06, 0x9e, 0x6c, 0x17, 0xd5, 0x62, 0x8b, 0x82, 0xb7, 0xc7, 0x59, 0x47,
+	0x4d, 0xc1, 0x40, 0xaf, 0xc2, 0xb2, 0x88, 0x59, 0x3f, 0xec, 0x78, 0xad, 0x28, 0xe8, 0x77, 0x43,
+	0x09, 0xf9, 0xbc, 0x58, 0x0a, 0x69, 0xde, 0x8e, 0x60, 0x09, 0xf8, 0x3f, 0x98, 0xd4, 0x10, 0x87,
+	0x54, 0x10, 0x87, 0x64, 0x4f, 0x16, 0xcd, 0xfd, 0xb6, 0x70, 0x79, 0x6a, 0x2e, 0x71, 0x68, 0xef,
+	0x43, 0x85, 0x27, 0x1f, 0xd2, 0xf6, 0x4e, 0xe2, 0xa8, 0x4b, 0xed, 0x62, 0x3a, 0x99, 0xe9, 0x39,
+	0x1a, 0x4d, 0x21, 0x76, 0x3f, 0x8e, 0xba, 0x6e, 0x99, 0x26, 0xdf, 0x14, 0xdd, 0x82, 0xbc, 0x58,
+	0x7d, 0x56, 0xac, 0xbe, 0x3a, 0xa9, 0x29, 0xd6, 0x16, 0x32, 0x3c, 0x19, 0x1c, 0x63, 0x6a, 0xf4,
+	0x6f, 0x32, 0xae, 0x2b, 0x9c, 0xa8, 0xc5, 0xd1, 0x6b, 0x50, 0xa5, 0x21, 0xee, 0xd1, 0xc7, 0x11,
+	0x13, 0xa1, 0x9d, 0x19, 0xd5, 0x15, 0x2d, 0xc2, 0x47, 0xce, 0x3e, 0xac, 0xa6, 0xcf, 0x4d, 0xc1,
+	0x6b, 0x2b, 0x55, 0x29, 0xca, 0xdb, 0x4b, 0x46, 0x64, 0x66, 0x74, 0x15, 0x3f, 0xb7, 0x00, 0xc9,
+	0xb9, 0x64, 0x33, 0xa0, 0x00, 0x30, 0xad, 0xe2, 0x5c, 0x03, 0x90, 0x25, 0xd5, 0xe8, 0x34, 0x4a,
+	0x82, 0xf2, 0x68, 0x0c, 0x27, 0x39, 0x13, 0x27, 0x37, 0xa0, 0xe6, 0x87, 0xad, 0xa0, 0xdf, 0x26,
+	0x5e, 0x0f, 0xc7, 0xbc, 0x77, 0x57, 0x9d, 0xa7, 0xa2, 0x1e, 0x08, 0xa2, 0xf3, 0x6b, 0x0b, 0x96,
+	0xc6, 0xcc, 0xb9, 0xe2, 0xbe, 0xd0, 0x4d, 0xb3, 0x4e, 0xf0, 0x48, 0x19, 0x49, 0x9b, 0x5d, 0x4f,
+	0x02, 0x47, 0x0f, 0x07, 0x31, 0xc1, 0xed, 0xa1, 0x47, 0xce, 0x7d, 0xca, 0xa8, 0x32, 0x5e, 0x42,
+	0xe8, 0xae, 0x64, 0xed, 0x09, 0x8e, 0xf3, 0x3d, 0x58, 0xd9, 0x25, 0x01, 0x99, 0x0c, 0x9a, 0x69,
+	0x3e, 0x7b, 0x0e, 0x4a, 0x31, 0x69, 0xf5, 0x63, 0xea, 0x0f, 0x74, 0x00, 0x8d, 0x08, 0x8e, 0x0d,
+	0xab, 0xe9, 0x29, 0xe5, 0xbe, 0x9d, 0x9f, 0x59, 0xb0, 0x24, 0x59, 0xc2, 0x6a, 0xaa, 0xd7, 0xda,
+	0x4c, 0xaa, 0xbe, 0x2c, 0xe6, 0x93, 0xfb, 0x53, 0xfc, 0xe9, 0x2b, 0xf3, 0x5e, 0x98, 0x0c, 0x48,
+	0xe8, 0xf9, 0x27, 0x49, 0x51, 0x56, 0xe7, 0xc2, 0xc9, 0xfb, 0x27, 0xaa, 0x22, 0x3b, 0xab, 0xb0,
+	0x3c, 0x6e, 0x86, 0xb2, 0x6f, 0xa8, 0xe9, 0x32, 0xe5, 0x24, 0xf6, 0xbd, 0x0b, 0x35, 0x33, 0x0b,
+	0x13, 0x6d, 0xe7, 0x05, 0x79, 0xb8, 0x6a, 0xe4, 0x61, 0x42, 0x79, 0xdc, 0xc8, 0xa4, 0xa2, 0x1a,
+	0x06, 0x65, 0x77, 0x45, 0x10, 0x55, 0xaf, 0xe0, 0xac, 0xe9, 0x73, 0x48, 0x96, 0x56, 0x36, 0xfd,
+	0x62, 0x06, 0xae, 0xed, 0x75, 0x49, 0xdc, 0x21, 0x61, 0x6b, 0xe8, 0x12, 0x09, 0xb7, 0x4b, 0xa3,
+	0x3b, 0xbb, 0xc1, 0x78, 0x0b, 0xca, 0x21, 0x19, 0xd9, 0x33, 0xb5, 0xcb, 0x80, 0x90, 0x68, 0x23,
+	0xd1, 0x77, 0x60, 0xde, 0xef, 0x84, 0x3c, 0xdd, 0xab, 0x96, 0x95, 0xda, 0xf9, 0x69, 0x8e, 0xa8,
+	0x49, 0x69, 0xd5, 0x04, 0x52, 0xb4, 0x0b, 0x2b, 0x67, 0xd8, 0x67, 0x89, 0x76, 0x72, 0x6d, 0x2e,
+	0x24, 0xb0, 0xe6, 0x94, 0xc6, 0x6e, 0x3f, 0x96, 0xad, 0xf2, 0x12, 0x17, 0xd7, 0xea, 0xfa, 0x3a,
+	0xfd, 0x27, 0x0b, 0xd6, 0x2f, 0xf2, 0x88, 0x0a, 0xb0, 0xa7, 0x77, 0xc9, 0xfb, 0xb0, 0xd0, 0x8b,
+	0xa3, 0x6e, 0xc4, 0x48, 0xfb, 0x72, 0x7e, 0x99, 0xd7, 0xe2, 0xda, 0x39, 0x37, 0xa1, 0x28, 0x6e,
+	0xea, 0xda, 0x27, 0xe9, 0x7b, 0xbc, 0xe2, 0x3a, 0xef, 0xc2, 0xfa, 0x7d, 0x3f, 0x6c, 0xdf, 0x0d,
+	0x02, 0x89, 0xbe, 0xfd, 0xf0, 0x29, 0x42, 0xcf, 0xf9, 0xb3, 0x05, 0xcf, 0x5f, 0xa8, 0xae, 0x76,
+	0xff, 0x28, 0x15, 0x4e, 0x6f, 0x19, 0xe1, 0xf4, 0x04, 0x5d, 0x19, 0x6e, 0xea, 0xbe, 0xa0, 0x9b,
+	0xef, 0x0f, 0xa1, 0x6c, 0x90, 0x33, 0xee, 0x08, 0x37, 0xc7, 0xef, 0x08, 0x19, 0xe9, 0x29, 0xb9,
+	0x14, 0x38, 0x7b, 0xb0, 0xf8, 0x01, 0x61, 0xf7, 0x70, 0xeb, 0xb4, 0xdf, 0xa3, 0x57, 0x86, 0xb0,
+	0xb3, 0x0b, 0xc8, 0x9c, 0x46, 0xed, 0xbc, 0x01, 0xb3, 0xc7, 0x92, 0xa4, 0xb6, 0xbe, 0xdc, 0x48,
+	0x5e, 0x90, 0xa4, 0xec, 0x7e, 0x78, 0x12, 0xb9, 0x5a, 0xc8, 0x79, 0x06, 0xd6, 0x3e, 0x20, 0x6c,
+	0x87, 0x04, 0x01, 0xa7, 0xf3, 0x84, 0xaf, 0x4d, 0x72, 0x5e, 0x05, 0x7b, 0x92, 0xa5, 0x96, 0x59,
+	0x86, 0x02, 0xaf, 0x16, 0xfa, 0x31, 0x48, 0x0e, 0x9c, 0x4d, 0x40, 0x86, 0x86, 0xd1, 0x7c, 0x88,
+	0x17, 0x03, 0x6b, 0xf4, 0x62, 0xe0, 0xdc, 0x87, 0xa5, 0x31, 0xc9, 0xa4, 0x2c, 0x94, 0x38, 0xdb,
+	0xf3, 0xc3, 0x93, 0xc8, 0xb6, 0xd2, 0x97, 0xe8, 0x44, 0x7c, 0xae, 0xa5, 0xbe, 0x78, 0xa6, 0x55,
+	0xf3, 0x50, 0x95, 0x6c, 0xb4, 0xf5, 0x5f, 0x59, 0xb0, 0x36, 0xc1, 0x52, 0xcb, 0xec, 0xc3, 0xec,
+	0x78, 0x1a, 0xdb, 0x32, 0xce, 0xeb, 0x02, 0xa5, 0x86, 0x1a, 0x4b, 0x60, 0x68, 0xfd, 0xfa, 0x01,
+	0x54, 0x4c, 0x46, 0x06, 0x34, 0x6e, 0x8d, 0x43, 0x63, 0x79, 0x7c, 0x3f, 0x72, 0x19, 0x13, 0x1e,
+	0x2b, 0xc2, 0x35, 0x1a, 0x96, 0xc9, 0x7e, 0xf6, 0x61, 0x79, 0x9c, 0xac, 0xf6, 0xf2, 0x1a, 0x94,
+	0x34, 0x50, 0xf4, 0x6e, 0x32, 0x4b, 0xe9, 0x48, 0xca, 0x79, 0x55, 0x1c, 0xd3, 0xd3, 0xc4, 0xdc,
+	0xfd, 0x31, 0x9b, 0xae, 0xde, 0x9d, 0xfc, 0x74, 0x06, 0x16, 0x3e, 0x20, 0x4c, 0xb6, 0x8e, 0xdf,
+	0xbc, 0xc3, 0x5f, 0x55, 0xd7, 0xc4, 0xe4, 0xae, 0x2c, 0x47, 0xbc, 0x39, 0x21, 0xe7, 0xb2, 0x39,
+	0x51, 0xfc, 0x9c, 0xe0, 0x57, 0x15, 0xf5, 0x50, 0x8a, 0x5d, 0x07, 0xdd, 0xad, 0x78, 0x03, 0x9f,
+	0x9c, 0x51, 0x55, 0x2a, 0x2b, 0x8a, 0x78, 0xc4, 0x69, 0x68, 0x13, 0x16, 0xe4, 0xdb, 0x99, 0x80,
+	0xb8, 0x17, 0x85, 0xc1, 0x50, 0x24, 0xeb, 0x39, 0x75, 0x27, 0x16, 0x71, 0xf1, 0x71, 0x18, 0x0c,
+	0x47, 0x92, 0xd4, 0xff, 0x5c, 0x4b, 0x16, 0x0d, 0xc9, 0xa6, 0xff, 0xb9, 0x94, 0x74, 0x0e, 0x60,
+	0xd1, 0xf0, 0x82, 0x72, 0xe6, 0x3b, 0x50, 0x54, 0xbd, 0xb6, 0x74, 0xc0, 0xf5, 0xc6, 0xe4, 0x9b,
+	0xae, 0x54, 0xd9, 0x25, 0x27, 0x7e, 0xe8, 0xab, 0xa7, 0x18, 0x41, 0x71, 0x1e, 0xc2, 0x3c, 0x9f,
+	0xf1, 0xdb, 0x69, 0xf9, 0x9c, 0x3b, 0xf2, 0x94, 0xc6, 0x0a, 0x4a, 0xd2, 0x80, 0x59, 0x53, 0x1b,
+	0x30, 0xe7, 0x81, 0x88, 0xc8, 0x66, 0x3c, 0x48, 0x23, 0xf8, 0x49, 0x29, 0x8e, 0xc7, 0xb4, 0x3e,
+	0x48, 0x39, 0x70, 0xfe, 0x26, 0x63, 0x78, 0x7c, 0x32, 0x65, 0xcf, 0x0f, 0xa0, 0x4a, 0xe3, 0x81,
+	0x97, 0xc6, 0xfe, 0x1b, 0xe3, 0x91, 0x9c, 0xa5, 0xda, 0x30, 0x89, 0xfa, 0x5d, 0xc8, 0x20, 0xd5,
+	0x8f, 0x60, 0x71, 0x42, 0x24, 0x23, 0xb0, 0x5f, 0x1e, 0x0f, 0x6c, 0x03, 0xb0, 0x86, 0xb6, 0x19,
+	0xd9, 0xb7, 0x44, 0x08, 0x37, 0xe3, 0xc1, 0xd1, 0x78, 0x00, 0x64, 0x25, 0xc8, 0x47, 0xb0, 0x92,
+	0x92, 0x4d, 0x2e, 0x9c, 0xdc, 0xd8, 0xd1, 0xc5, 0x2c, 0x89, 0x3b, 0x39, 0x6e, 0x18, 0x2a, 0x40,
+	0x93, 0x6f, 0xe7, 0xa1, 0x38, 0x52, 0x75, 0xab, 0xfc, 0xa6, 0x81, 0xe7, 0xbc, 0x27, 0x00, 0xac,
+	0x67, 0x53, 0x96, 0x6d, 0x26, 0x8f, 0x36, 0x17, 0xdd, 0x81, 0x15, 0xdf, 0xf9, 0xbd, 0x65, 0xe8,
+	0x5f, 0xbd, 0x04, 0x8e, 0x50, 0x93, 0x33, 0x50, 0x23, 0x5e, 0xd0, 0x58, 0xec, 0xb7, 0xf4, 0x95,
+	0x44, 0x8d, 0x32, 0x7a, 0xd8, 0xc2, 0xe5, 0x7b, 0x58, 0xe7, 0x7d, 0x91, 0x34, 0x53, 0xbd, 0x29,
+	0xba, 0x05, 0xb3, 0x52, 0x6c, 0xd4, 0xb8, 0xa7, 0x37, 0xad, 0x05, 0x9c, 0x2d, 0xb1, 0xe9, 0xd4,
+	0xd9, 0x4f, 0xcb, 0xba, 0xf7, 0x00, 0x99, 0x0a, 0x6a, 0xc9, 0x57, 0x60, 0x2e, 0x75, 0xf8, 0x8b,
+	0xc9, 0xe1, 0x27, 0xa8, 0x9b, 0x1d, 0xa8, 0x73, 0x77, 0x45, 0xe6, 0xd6, 0x4f, 0x38, 0x97, 0xf2,
+	0xf5, 0xf3, 0x50, 0xc6, 0x2d, 0xe6, 0x0f, 0x88, 0x4c, 0x61, 0xb2, 0x57, 0x07, 0x49, 0x12, 0xe9,
+	0x4b, 0x96, 0x22, 0x63, 0xce, 0x51, 0x29, 0xd2, 0x3f, 0x76, 0x64, 0x95, 0x22, 0xad, 0xe0, 0x8e,
+	0xa4, 0x9c, 0x7f, 0x5b, 0xb0, 0xb6, 0x1f, 0xfa, 0x32, 0xd7, 0xa8, 0x3e, 0xf2, 0xea, 0x78, 0x70,
+	0xa1, 0xae, 0x9f, 0x24, 0x49, 0x40, 0x5a, 0xcc, 0x33, 0xcf, 0x7b, 0x7a, 0x33, 0xbb, 0xa6, 0x14,
+	0xf7, 0xb8, 0x9e, 0xc1, 0x18, 0x5d, 0x7f, 0xf3, 0xe6, 0xf5, 0xf7, 0xdb, 0xe9, 0xe3, 0xef, 0x81,
+	0x3d, 0xb9, 0xf9, 0x24, 0xdf, 0xea, 0x66, 0xda, 0x9a, 0xda, 0x4c, 0x7f, 0x31, 0x03, 0xcf, 0x1e,
+	0x04, 0x38, 0x0c, 0x49, 0xfb, 0xbf, 0x7c, 0x37, 0xba, 0x03, 0x55, 0x3c, 0x88, 0xfc, 0xd1, 0xed,
+	0x21, 0x3f, 0x4d, 0xb3, 0x22, 0x64, 0xb5, 0xee, 0xb7, 0xe3, 0xcf, 0x3f, 0x5a, 0xf0, 0x5c, 0xb6,
+	0x2f, 0xfe, 0x07, 0x6e, 0x45, 0x3f, 0x81, 0x67, 0x5c, 0xd2, 0x8d, 0x06, 0xc9, 0xa3, 0x01, 0x6f,
+	0x0f, 0x2f, 0x73, 0x8a, 0xba, 0x7c, 0xcc, 0x18, 0xbf, 0xc8, 0x65, 0x3f, 0xda, 0x8c, 0xbd, 0x1d,
+	0xe4, 0xd3, 0xaf, 0x16, 0xcf, 0x41, 0x3d, 0xcb, 0x00, 0x75, 0x0b, 0xff, 0xd2, 0x82, 0x55, 0xc9,
+	0x16, 0x2e, 0xbd, 0xac, 0x71, 0x4f, 0x78, 0x5c, 0xd2, 0xb6, 0xe7, 0xb2, 0x6c, 0xcf, 0x5f, 0x68,
+	0x7b, 0x21, 0x6d, 0xfb, 0x33, 0xb0, 0x36, 0x61, 0x9c, 0x32, 0xfc, 0x3e, 0xac, 0x68, 0x30, 0x8c,
+	0x97, 0xbf, 0xdb, 0xa9, 0x7a, 0x35, 0xfd, 0x47, 0x06, 0xe7, 0xc7, 0xb0, 0x9a, 0x9e, 0xe7, 0xca,
+	0xa8, 0xda, 0x82, 0xd9, 0x4b, 0x81, 0x49, 0x4b, 0x39, 0x87, 0xb0, 0xa1, 0x90, 0x9c, 0xfc, 0x9a,
+	0xa4, 0x7f, 0x7d, 0xf8, 0x06, 0x57, 0xc8, 0xdf, 0xe5, 0xe0, 0x85, 0x29, 0xd3, 0xaa, 0xed, 0x9d,
+	0xc3, 0xb2, 0xf9, 0xfb, 0x1c, 0x65, 0x98, 0xf5, 0x47, 0x57, 0xa7, 0xbd, 0x89, 0x46, 0x70, 0xca,
+	0x5c, 0xe6, 0xaf, 0x81, 0x4d, 0x35, 0x8f, 0xec, 0xc0, 0x96, 0xe2, 0x49, 0x0e, 0xfa, 0x14, 0x40,
+	0x65, 0xf0, 0x2e, 0xee, 0xa9, 0x1f, 0xaa, 0xde, 0x79, 0xaa, 0xf5, 0xa4, 0x33, 0x3f, 0xc2, 0x3d,
+	0xb9, 0x4a, 0x89, 0xe9, 0x71, 0xdd, 0x03, 0xfb, 0x22, 0x63, 0x32, 0x7a, 0xbd, 0xdb, 0xe3, 0xbd,
+	0xde, 0x5a, 0x23, 0xfd, 0x6f, 0x18, 0x72, 0x02, 0xf3, 0xb7, 0xbf, 0x47, 0x50, 0x1b, 0x5f, 0xfd,
+	0x32, 0xcf, 0x06, 0xe9, 0xe6, 0xc1, 0xe8, 0x1e, 0x5d, 0x78, 0x41, 0x12, 0xf5, 0x6f, 0xd4, 0x41,
+	0xf2, 0xf4, 0x43, 0xda, 0x57, 0xc4, 0xf4, 0x5f, 0x2c, 0x70, 0xa6, 0x4d, 0x7a, 0x65, 0x80, 0x5f,
+	0xb5, 0x86, 0xbc, 0x05, 0xe5, 0x28, 0xb8, 0x64, 0x05, 0x81, 0x28, 0xd0, 0x49, 0xf6, 0xde, 0xdb,
+	0x7f, 0xfd, 0x7a, 0xdd, 0xfa, 0xfb, 0xd7, 0xeb, 0xd6, 0x3f, 0xbe, 0x5e, 0xb7, 0x7e, 0xf9, 0xcf,
+	0xf5, 0xff, 0xfb, 0xf4, 0xe6, 0xc0, 0x67, 0x84, 0xd2, 0x86, 0x1f, 0x6d, 0xc9, 0xaf, 0xad, 0x4e,
+	0xb4, 0x35, 0x60, 0x5b, 0xe2, 0x3f, 0x5d, 0xb6, 0x12, 0x0c, 0x1d, 0x17, 0x05, 0xe1, 0xf5, 0xff,
+	0x0c, 0x00, 0xd1, 0xe3, 0xf8, 0xde, 0xa6, 0x23, 0x00, 0x00,
 }
 
 func (m *ExecuteVtctlCommandRequest) Marshal() (dAtA []byte, err error) {
@@ -4542,6 +4575,30 @@ func (m *MaterializeSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExternalSink != nil {
+		{
+			size, err := m.ExternalSink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVtctldata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OnDdlStrategy) > 0 {
+		i -= len(m.OnDdlStrategy)
+		copy(dAtA[i:], m.OnDdlStrategy)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.OnDdlStrategy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OnDdl != 0 {
+		i = encodeVarintVtctldata(dAtA, i, uint64(m.OnDdl))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ExternalCluster) > 0 {
 		i -= len(m.ExternalCluster)
 		copy(dAtA[i:], m.ExternalCluster)
@@ -7969,6 +8026,17 @@ func (m *MaterializeSettings) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
+	if m.OnDdl != 0 {
+		n += 1 + sovVtctldata(uint64(m.OnDdl))
+	}
+	l = len(m.OnDdlStrategy)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.ExternalSink != nil {
+		l = m.ExternalSink.Size()
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10030,6 +10098,93 @@ func (m *MaterializeSettings) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDdl", wireType)
+			}
+			m.OnDdl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnDdl |= binlogdata.OnDDLAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDdlStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnDdlStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalSink == nil {
+				m.ExternalSink = &binlogdata.ExternalSink{}
+			}
+			if err := m.ExternalSink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtctldata(dAtA[iNdEx:])
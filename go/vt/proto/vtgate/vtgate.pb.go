@@ -161,10 +161,14 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// transaction_query_tags are the query tags of the statement which
+	// started the current transaction. They apply to all statements of
+	// the transaction.
+	TransactionQueryTags map[string]string `protobuf:"bytes,24,rep,name=transaction_query_tags,json=transactionQueryTags,proto3" json:"transaction_query_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -354,6 +358,13 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetTransactionQueryTags() map[string]string {
+	if m != nil {
+		return m.TransactionQueryTags
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -1072,7 +1083,20 @@ func (m *ResolveTransactionResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ResolveTransactionResponse proto.InternalMessageInfo
 
 type VStreamFlags struct {
-	MinimizeSkew         bool     `protobuf:"varint,1,opt,name=minimize_skew,json=minimizeSkew,proto3" json:"minimize_skew,omitempty"`
+	MinimizeSkew bool `protobuf:"varint,1,opt,name=minimize_skew,json=minimizeSkew,proto3" json:"minimize_skew,omitempty"`
+	// change_events makes VStream send each row change as a CHANGE event
+	// that contains a self-describing json envelope, instead of FIELD and
+	// ROW events.
+	ChangeEvents bool `protobuf:"varint,2,opt,name=change_events,json=changeEvents,proto3" json:"change_events,omitempty"`
+	// tables is the list of tables to subscribe to. If set, vtgate
+	// builds the filter for each shard from it, and the filter of the
+	// request must be empty.
+	Tables []*VStreamTable `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	// heartbeat_interval is the interval in seconds at which a HEARTBEAT
+	// event is sent for each shard that has no other events to send.
+	// The event contains the current time and the replication lag of the
+	// source tablet. If 0, heartbeats are not sent.
+	HeartbeatInterval    uint32   `protobuf:"varint,4,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1118,6 +1142,107 @@ func (m *VStreamFlags) GetMinimizeSkew() bool {
 	return false
 }
 
+func (m *VStreamFlags) GetChangeEvents() bool {
+	if m != nil {
+		return m.ChangeEvents
+	}
+	return false
+}
+
+func (m *VStreamFlags) GetTables() []*VStreamTable {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *VStreamFlags) GetHeartbeatInterval() uint32 {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
+// VStreamTable is a table subscription of a VStream.
+type VStreamTable struct {
+	// keyspace restricts the subscription to one keyspace. If empty,
+	// the table is streamed from all the keyspaces of the vgtid.
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// name is a table name or, if it starts with a '/', a regular
+	// expression that matches table names.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// columns is the list of columns to stream. If empty, all the
+	// columns are streamed. It can only be set for a table name.
+	Columns []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// exclude_columns is the list of columns that are pruned by
+	// vstreamer before the events are sent.
+	ExcludeColumns       []string `protobuf:"bytes,4,rep,name=exclude_columns,json=excludeColumns,proto3" json:"exclude_columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VStreamTable) Reset()         { *m = VStreamTable{} }
+func (m *VStreamTable) String() string { return proto.CompactTextString(m) }
+func (*VStreamTable) ProtoMessage()    {}
+func (*VStreamTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{11}
+}
+func (m *VStreamTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VStreamTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VStreamTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VStreamTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VStreamTable.Merge(m, src)
+}
+func (m *VStreamTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *VStreamTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_VStreamTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VStreamTable proto.InternalMessageInfo
+
+func (m *VStreamTable) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VStreamTable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VStreamTable) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *VStreamTable) GetExcludeColumns() []string {
+	if m != nil {
+		return m.ExcludeColumns
+	}
+	return nil
+}
+
 // VStreamRequest is the payload for VStream.
 type VStreamRequest struct {
 	CallerId   *vtrpc.CallerID     `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
@@ -1137,7 +1262,7 @@ func (m *VStreamRequest) Reset()         { *m = VStreamRequest{} }
 func (m *VStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamRequest) ProtoMessage()    {}
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{11}
+	return fileDescriptor_aab96496ceaf1ebb, []int{12}
 }
 func (m *VStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1213,7 +1338,7 @@ func (m *VStreamResponse) Reset()         { *m = VStreamResponse{} }
 func (m *VStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResponse) ProtoMessage()    {}
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{12}
+	return fileDescriptor_aab96496ceaf1ebb, []int{13}
 }
 func (m *VStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,6 +1379,7 @@ func init() {
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
 	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.TransactionQueryTagsEntry")
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
 	proto.RegisterType((*ReadAfterWrite)(nil), "vtgate.ReadAfterWrite")
@@ -1266,6 +1392,7 @@ func init() {
 	proto.RegisterType((*ResolveTransactionRequest)(nil), "vtgate.ResolveTransactionRequest")
 	proto.RegisterType((*ResolveTransactionResponse)(nil), "vtgate.ResolveTransactionResponse")
 	proto.RegisterType((*VStreamFlags)(nil), "vtgate.VStreamFlags")
+	proto.RegisterType((*VStreamTable)(nil), "vtgate.VStreamTable")
 	proto.RegisterType((*VStreamRequest)(nil), "vtgate.VStreamRequest")
 	proto.RegisterType((*VStreamResponse)(nil), "vtgate.VStreamResponse")
 }
@@ -1273,98 +1400,108 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
-	0x12, 0xce, 0xea, 0x5f, 0xa3, 0xbf, 0x35, 0x2d, 0x3b, 0x1b, 0x9f, 0x1c, 0x1f, 0x41, 0x49, 0x10,
-	0xc5, 0xa7, 0xb0, 0x5b, 0xa7, 0x45, 0x83, 0xa2, 0x45, 0x6b, 0xcb, 0x76, 0xaa, 0xc0, 0x8e, 0x5c,
-	0x4a, 0xb6, 0x81, 0xa2, 0xc5, 0x62, 0xad, 0xa5, 0x65, 0xc2, 0xd2, 0xae, 0x42, 0x52, 0x52, 0xd5,
-	0x97, 0xe8, 0x6d, 0xd1, 0x17, 0xe8, 0x4d, 0xef, 0xfb, 0x0a, 0xbd, 0x6c, 0xde, 0xa0, 0x48, 0xdf,
-	0xa1, 0xd7, 0x05, 0xb9, 0x5c, 0x79, 0xa5, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0x82, 0x38, 0xdf, 0x70,
-	0x38, 0xfc, 0xbe, 0x19, 0x92, 0x0b, 0xf9, 0x91, 0xe8, 0x3a, 0x82, 0xac, 0x0f, 0x98, 0x2f, 0x7c,
-	0x94, 0x0a, 0x46, 0x2b, 0xe6, 0x29, 0xf5, 0x7a, 0x7e, 0xd7, 0x75, 0x84, 0x13, 0x20, 0x2b, 0xb9,
-	0x67, 0x43, 0xc2, 0x26, 0x7a, 0x50, 0x14, 0xfe, 0xc0, 0x8f, 0x82, 0x23, 0xc1, 0x06, 0x9d, 0x60,
-	0x50, 0x7d, 0x9e, 0x83, 0x74, 0x8b, 0x70, 0x4e, 0x7d, 0x0f, 0xdd, 0x83, 0x22, 0xf5, 0x6c, 0xc1,
-	0x1c, 0x8f, 0x3b, 0x1d, 0x41, 0x7d, 0xcf, 0x32, 0x2a, 0x46, 0x2d, 0x83, 0x0b, 0xd4, 0x6b, 0x5f,
-	0x1a, 0x51, 0x1d, 0x8a, 0xfc, 0xdc, 0x61, 0xae, 0xcd, 0x83, 0x79, 0xdc, 0x8a, 0x55, 0xe2, 0xb5,
-	0xdc, 0xe6, 0xed, 0x75, 0x9d, 0x9d, 0x8e, 0xb7, 0xde, 0x92, 0x5e, 0x7a, 0x80, 0x0b, 0x3c, 0x32,
-	0xe2, 0x68, 0x15, 0xc0, 0x19, 0x0a, 0xbf, 0xe3, 0xf7, 0xfb, 0x54, 0x58, 0x09, 0xb5, 0x4e, 0xc4,
-	0x82, 0xee, 0x40, 0x41, 0x38, 0xac, 0x4b, 0x84, 0xcd, 0x05, 0xa3, 0x5e, 0xd7, 0x4a, 0x56, 0x8c,
-	0x5a, 0x16, 0xe7, 0x03, 0x63, 0x4b, 0xd9, 0xd0, 0x06, 0xa4, 0xfd, 0x81, 0x50, 0x29, 0xa4, 0x2a,
-	0x46, 0x2d, 0xb7, 0xb9, 0xb4, 0x1e, 0x6c, 0x7c, 0xf7, 0x3b, 0xd2, 0x19, 0x0a, 0xd2, 0x0c, 0x40,
-	0x1c, 0x7a, 0xa1, 0x6d, 0x30, 0x23, 0xdb, 0xb3, 0xfb, 0xbe, 0x4b, 0xac, 0x74, 0xc5, 0xa8, 0x15,
-	0x37, 0x6f, 0x86, 0xc9, 0x47, 0x76, 0x7a, 0xe0, 0xbb, 0x04, 0x97, 0xc4, 0xac, 0x01, 0x6d, 0x40,
-	0x66, 0xec, 0x30, 0x8f, 0x7a, 0x5d, 0x6e, 0x65, 0xd4, 0xc6, 0x17, 0xf5, 0xaa, 0x5f, 0xc9, 0xdf,
-	0x93, 0x00, 0xc3, 0x53, 0x27, 0xf4, 0x39, 0xe4, 0x07, 0x8c, 0x5c, 0xb2, 0x95, 0xbd, 0x06, 0x5b,
-	0xb9, 0x01, 0x23, 0x53, 0xae, 0xb6, 0xa0, 0x30, 0xf0, 0xb9, 0xb8, 0x8c, 0x00, 0xd7, 0x88, 0x90,
-	0x97, 0x53, 0xa6, 0x21, 0xee, 0x42, 0xb1, 0xe7, 0x70, 0x61, 0x53, 0x8f, 0x13, 0x26, 0x6c, 0xea,
-	0x5a, 0xb9, 0x8a, 0x51, 0x4b, 0xe0, 0xbc, 0xb4, 0x36, 0x94, 0xb1, 0xe1, 0xa2, 0xff, 0x02, 0x9c,
-	0xf9, 0x43, 0xcf, 0xb5, 0x99, 0x3f, 0xe6, 0x56, 0x5e, 0x79, 0x64, 0x95, 0x05, 0xfb, 0x63, 0x8e,
-	0x6c, 0x58, 0x1e, 0x72, 0xc2, 0x6c, 0x97, 0x9c, 0x51, 0x8f, 0xb8, 0xf6, 0xc8, 0x61, 0xd4, 0x39,
-	0xed, 0x11, 0x6e, 0x15, 0x54, 0x42, 0x0f, 0xe6, 0x13, 0x3a, 0xe2, 0x84, 0xed, 0x04, 0xce, 0xc7,
-	0xa1, 0xef, 0xae, 0x27, 0xd8, 0x04, 0x97, 0x87, 0x57, 0x40, 0xa8, 0x09, 0x26, 0x9f, 0x70, 0x41,
-	0xfa, 0x91, 0xd0, 0x45, 0x15, 0xfa, 0xee, 0x4b, 0x7b, 0x55, 0x7e, 0x73, 0x51, 0x4b, 0x7c, 0xd6,
-	0x8a, 0xfe, 0x03, 0x59, 0xe6, 0x8f, 0xed, 0x8e, 0x3f, 0xf4, 0x84, 0x55, 0xaa, 0x18, 0xb5, 0x38,
-	0xce, 0x30, 0x7f, 0x5c, 0x97, 0x63, 0x59, 0x82, 0xdc, 0x19, 0x91, 0x81, 0x4f, 0x3d, 0xc1, 0x2d,
-	0xb3, 0x12, 0xaf, 0x65, 0x71, 0xc4, 0x82, 0x6a, 0x60, 0x52, 0xcf, 0x66, 0x84, 0x13, 0x36, 0x22,
-	0xae, 0xdd, 0xf1, 0x3d, 0xcf, 0x5a, 0x50, 0x85, 0x5a, 0xa4, 0x1e, 0xd6, 0xe6, 0xba, 0xef, 0x79,
-	0x52, 0xe1, 0x9e, 0xdf, 0xb9, 0x08, 0x05, 0xb2, 0x90, 0x2a, 0xc6, 0x57, 0x28, 0x2c, 0x67, 0x84,
-	0x9d, 0xb7, 0x0e, 0x8b, 0x4a, 0x1e, 0x15, 0xe5, 0x9c, 0x38, 0x4c, 0x9c, 0x12, 0x47, 0x58, 0x8b,
-	0x2a, 0xe3, 0x05, 0x09, 0xed, 0xfb, 0x9d, 0x8b, 0x2f, 0x43, 0x00, 0x7d, 0x01, 0x26, 0x23, 0x8e,
-	0x6b, 0x3b, 0x67, 0x82, 0x30, 0x7b, 0xcc, 0xa8, 0x20, 0x56, 0x59, 0x2d, 0xba, 0x1c, 0x2e, 0x8a,
-	0x89, 0xe3, 0x6e, 0x49, 0xf8, 0x44, 0xa2, 0xb8, 0xc8, 0x66, 0xc6, 0xa8, 0x02, 0xb9, 0x9d, 0x9d,
-	0xfd, 0x96, 0x60, 0x8e, 0x20, 0xdd, 0x89, 0xb5, 0xa4, 0xba, 0x2b, 0x6a, 0x92, 0x1e, 0x3a, 0xbd,
-	0xa3, 0xa3, 0xc6, 0x8e, 0xb5, 0x1c, 0x78, 0x44, 0x4c, 0xe8, 0x43, 0x58, 0x26, 0x9e, 0x24, 0xda,
-	0xd6, 0xaa, 0x71, 0x22, 0x84, 0xea, 0x8b, 0x9b, 0x8a, 0xa6, 0x72, 0x80, 0x06, 0x52, 0xb5, 0x34,
-	0xb6, 0xf2, 0xab, 0x01, 0xf9, 0x28, 0x13, 0xe8, 0x1e, 0xa4, 0x82, 0xae, 0x56, 0xc7, 0x4d, 0x6e,
-	0xb3, 0xa0, 0xdb, 0xa9, 0xad, 0x8c, 0x58, 0x83, 0xf2, 0x74, 0x8a, 0xf6, 0x2e, 0x75, 0xad, 0x98,
-	0xa2, 0xa7, 0x10, 0xb1, 0x36, 0x5c, 0xf4, 0x08, 0xf2, 0x42, 0xae, 0x2a, 0x6c, 0xa7, 0x47, 0x1d,
-	0x6e, 0xc5, 0xf5, 0xc1, 0x30, 0x3d, 0x04, 0xdb, 0x0a, 0xdd, 0x92, 0x20, 0xce, 0x89, 0xcb, 0x01,
-	0xfa, 0x1f, 0xe4, 0xa6, 0x62, 0x53, 0x57, 0x9d, 0x49, 0x71, 0x0c, 0xa1, 0xa9, 0xe1, 0xae, 0x7c,
-	0x03, 0xb7, 0xfe, 0xb1, 0xa2, 0x91, 0x09, 0xf1, 0x0b, 0x32, 0x51, 0x5b, 0xc8, 0x62, 0xf9, 0x17,
-	0x3d, 0x80, 0xe4, 0xc8, 0xe9, 0x0d, 0x89, 0xca, 0xf3, 0xf2, 0x94, 0xd8, 0xa6, 0xde, 0x74, 0x2e,
-	0x0e, 0x3c, 0x3e, 0x89, 0x3d, 0x32, 0x56, 0xb6, 0xa1, 0x7c, 0x55, 0x51, 0x5f, 0x11, 0xb8, 0x1c,
-	0x0d, 0x9c, 0x8d, 0xc4, 0x78, 0x92, 0xc8, 0xc4, 0xcd, 0x44, 0xf5, 0x17, 0x03, 0x8a, 0xb3, 0xf2,
-	0xa3, 0x0f, 0x60, 0x69, 0xbe, 0x60, 0xec, 0xae, 0xa0, 0xae, 0x0e, 0x8b, 0x66, 0xab, 0xe3, 0xb1,
-	0xa0, 0x2e, 0xfa, 0x18, 0xac, 0x97, 0xa6, 0x08, 0xda, 0x27, 0xfe, 0x50, 0xa8, 0x85, 0x0d, 0xbc,
-	0x34, 0x3b, 0xab, 0x1d, 0x80, 0xb2, 0x98, 0x75, 0x23, 0xc8, 0xbb, 0xa4, 0x73, 0xa1, 0x16, 0x0a,
-	0x84, 0xc8, 0xe0, 0x05, 0x0d, 0xb5, 0x25, 0x22, 0xd7, 0xe1, 0xd5, 0x9f, 0x63, 0x50, 0xd4, 0x07,
-	0x36, 0x26, 0xcf, 0x86, 0x84, 0x0b, 0xf4, 0x1e, 0x64, 0x3b, 0x4e, 0xaf, 0x47, 0x98, 0xad, 0x53,
-	0xcc, 0x6d, 0x96, 0xd6, 0x83, 0x6b, 0xab, 0xae, 0xec, 0x8d, 0x1d, 0x9c, 0x09, 0x3c, 0x1a, 0x2e,
-	0x7a, 0x00, 0xe9, 0xb0, 0xf3, 0x62, 0x53, 0xdf, 0x68, 0xe7, 0xe1, 0x10, 0x47, 0xf7, 0x21, 0xa9,
-	0x54, 0xd0, 0x65, 0xb1, 0x10, 0x6a, 0x22, 0xcf, 0x38, 0x75, 0x7c, 0xe3, 0x00, 0x47, 0x1f, 0x81,
-	0xae, 0x0d, 0x5b, 0x4c, 0x06, 0x44, 0x15, 0x43, 0x71, 0xb3, 0x3c, 0x5f, 0x45, 0xed, 0xc9, 0x80,
-	0x60, 0x10, 0xd3, 0xff, 0xb2, 0x48, 0x2f, 0xc8, 0x84, 0x0f, 0x9c, 0x0e, 0xb1, 0xd5, 0x85, 0xa7,
-	0x2e, 0xa6, 0x2c, 0x2e, 0x84, 0x56, 0x55, 0xf9, 0xd1, 0x8b, 0x2b, 0x7d, 0x9d, 0x8b, 0xeb, 0x49,
-	0x22, 0x93, 0x34, 0x53, 0xd5, 0x1f, 0x0c, 0x28, 0x4d, 0x99, 0xe2, 0x03, 0xdf, 0xe3, 0x72, 0xc5,
-	0x24, 0x61, 0xcc, 0x67, 0x73, 0x34, 0xe1, 0xc3, 0xfa, 0xae, 0x34, 0xe3, 0x00, 0x7d, 0x1d, 0x8e,
-	0xd6, 0x20, 0xc5, 0x08, 0x1f, 0xf6, 0x84, 0x26, 0x09, 0x45, 0xaf, 0x37, 0xac, 0x10, 0xac, 0x3d,
-	0xaa, 0xcf, 0x63, 0xb0, 0xa8, 0x33, 0xda, 0x76, 0x44, 0xe7, 0xfc, 0x9d, 0x0b, 0xf8, 0x7f, 0x48,
-	0xcb, 0x6c, 0x28, 0x91, 0x05, 0x15, 0xbf, 0x5a, 0xc2, 0xd0, 0xe3, 0x2d, 0x44, 0x74, 0xf8, 0xcc,
-	0x3b, 0x28, 0x19, 0xbc, 0x83, 0x1c, 0x1e, 0x7d, 0x07, 0xbd, 0x23, 0xad, 0xab, 0x3f, 0x19, 0x50,
-	0x9e, 0xe5, 0xf4, 0x9d, 0x49, 0xfd, 0x3e, 0xa4, 0x03, 0x21, 0x43, 0x36, 0x97, 0x75, 0x6e, 0x81,
-	0xcc, 0x27, 0x54, 0x9c, 0x07, 0xa1, 0x43, 0x37, 0xd9, 0xac, 0xe5, 0x96, 0x60, 0xc4, 0xe9, 0xbf,
-	0x55, 0xcb, 0x4e, 0xfb, 0x30, 0xf6, 0x7a, 0x7d, 0x18, 0x7f, 0xe3, 0x3e, 0x4c, 0xbc, 0x42, 0x9b,
-	0xe4, 0xb5, 0x1e, 0x90, 0x11, 0x6e, 0x53, 0xff, 0xce, 0x6d, 0xb5, 0x0e, 0x4b, 0x73, 0x44, 0x69,
-	0x19, 0x2f, 0xfb, 0xcb, 0x78, 0x65, 0x7f, 0x7d, 0x0b, 0xb7, 0x30, 0xe1, 0x7e, 0x6f, 0x44, 0x22,
-	0x95, 0xf7, 0x66, 0x94, 0x23, 0x48, 0xb8, 0x42, 0xdf, 0x9a, 0x59, 0xac, 0xfe, 0x57, 0x6f, 0xc3,
-	0xca, 0x55, 0xe1, 0x83, 0x44, 0xab, 0x0f, 0x21, 0x7f, 0x1c, 0x6c, 0x61, 0xaf, 0xe7, 0x74, 0xb9,
-	0x7c, 0x93, 0xf7, 0xa9, 0x47, 0xfb, 0xf4, 0x7b, 0x62, 0xf3, 0x0b, 0x32, 0xd6, 0x9f, 0x07, 0xf9,
-	0xd0, 0xd8, 0xba, 0x20, 0xe3, 0xea, 0x5f, 0x06, 0x14, 0xf5, 0xac, 0x37, 0xcb, 0x73, 0x4e, 0xf1,
-	0xd8, 0x35, 0x15, 0xbf, 0x0f, 0xc9, 0x91, 0xba, 0xd1, 0xc2, 0x93, 0x3d, 0xf2, 0x51, 0x74, 0x2c,
-	0x2f, 0x1a, 0x1c, 0xe0, 0x92, 0xfe, 0x33, 0xda, 0x13, 0x84, 0xa9, 0x92, 0x90, 0xf4, 0x47, 0x3c,
-	0xf7, 0x14, 0x82, 0xb5, 0x07, 0x5a, 0x83, 0xe4, 0x99, 0xdc, 0xba, 0xae, 0x8e, 0x72, 0x28, 0x76,
-	0x94, 0x16, 0x1c, 0xb8, 0x54, 0x3f, 0x83, 0xd2, 0x74, 0xdf, 0x97, 0x4a, 0x93, 0x11, 0x91, 0xaf,
-	0x4b, 0x43, 0x75, 0xd7, 0xcc, 0x52, 0xc7, 0xbb, 0x12, 0xc2, 0xda, 0x63, 0x6d, 0x07, 0x4a, 0x73,
-	0x9f, 0x1e, 0xa8, 0x04, 0xb9, 0xa3, 0xa7, 0xad, 0xc3, 0xdd, 0x7a, 0x63, 0xaf, 0xb1, 0xbb, 0x63,
-	0xde, 0x40, 0x00, 0xa9, 0x56, 0xe3, 0xe9, 0xe3, 0xfd, 0x5d, 0xd3, 0x40, 0x59, 0x48, 0x1e, 0x1c,
-	0xed, 0xb7, 0x1b, 0x66, 0x4c, 0xfe, 0x6d, 0x9f, 0x34, 0x0f, 0xeb, 0x66, 0x7c, 0xed, 0x53, 0xc8,
-	0xd5, 0xd5, 0x07, 0x54, 0x93, 0xb9, 0x84, 0xc9, 0x09, 0x4f, 0x9b, 0xf8, 0x60, 0x6b, 0xdf, 0xbc,
-	0x81, 0xd2, 0x10, 0x3f, 0xc4, 0x72, 0x66, 0x06, 0x12, 0x87, 0xcd, 0x56, 0xdb, 0x8c, 0xa1, 0x22,
-	0xc0, 0xd6, 0x51, 0xbb, 0x59, 0x6f, 0x1e, 0x1c, 0x34, 0xda, 0x66, 0x7c, 0x7b, 0xef, 0xb7, 0x17,
-	0xab, 0xc6, 0xef, 0x2f, 0x56, 0x8d, 0x3f, 0x5e, 0xac, 0x1a, 0x3f, 0xfe, 0xb9, 0x7a, 0x03, 0x4a,
-	0xd4, 0x5f, 0x1f, 0x51, 0x41, 0x38, 0x0f, 0xbe, 0x17, 0xbf, 0xbe, 0xa3, 0x47, 0xd4, 0xdf, 0x08,
-	0xfe, 0x6d, 0x74, 0xfd, 0x8d, 0x91, 0xd8, 0x50, 0xe8, 0x46, 0x40, 0xcf, 0x69, 0x4a, 0x8d, 0x1e,
-	0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xa5, 0xb0, 0xf3, 0xaf, 0x0e, 0x00, 0x00,
+	// 1601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x63, 0x49,
+	0x11, 0x9e, 0xe3, 0x9f, 0xd8, 0x2e, 0xff, 0x9d, 0xf4, 0x38, 0xd9, 0x33, 0x61, 0x09, 0x96, 0x77,
+	0x57, 0xe3, 0x19, 0x96, 0x04, 0x02, 0x88, 0x15, 0x02, 0x41, 0xe2, 0x64, 0x06, 0xaf, 0x92, 0x49,
+	0x68, 0x3b, 0x33, 0x12, 0x02, 0x1d, 0xf5, 0xf8, 0x74, 0x9c, 0x56, 0xec, 0xd3, 0xde, 0xee, 0xb6,
+	0xb3, 0xe1, 0x8e, 0x27, 0xe0, 0x16, 0xf1, 0x02, 0xdc, 0x70, 0x8b, 0x78, 0x05, 0x2e, 0xe1, 0x0d,
+	0xd0, 0xf0, 0x0e, 0xdc, 0x70, 0x83, 0xfa, 0xe7, 0xd8, 0xc7, 0xde, 0xec, 0x4e, 0x66, 0x46, 0x73,
+	0x63, 0xb9, 0xea, 0xab, 0xae, 0xae, 0xae, 0xfa, 0xaa, 0xab, 0x0f, 0x54, 0x66, 0x6a, 0x48, 0x14,
+	0xdd, 0x99, 0x08, 0xae, 0x38, 0x5a, 0xb3, 0xd2, 0x96, 0xff, 0x92, 0xc5, 0x23, 0x3e, 0x8c, 0x88,
+	0x22, 0x16, 0xd9, 0x2a, 0x7f, 0x31, 0xa5, 0xe2, 0xc6, 0x09, 0x35, 0xc5, 0x27, 0x3c, 0x0d, 0xce,
+	0x94, 0x98, 0x0c, 0xac, 0xd0, 0xfa, 0x5f, 0x05, 0x0a, 0x3d, 0x2a, 0x25, 0xe3, 0x31, 0xfa, 0x04,
+	0x6a, 0x2c, 0x0e, 0x95, 0x20, 0xb1, 0x24, 0x03, 0xc5, 0x78, 0x1c, 0x78, 0x4d, 0xaf, 0x5d, 0xc4,
+	0x55, 0x16, 0xf7, 0x17, 0x4a, 0xd4, 0x81, 0x9a, 0xbc, 0x24, 0x22, 0x0a, 0xa5, 0x5d, 0x27, 0x83,
+	0x4c, 0x33, 0xdb, 0x2e, 0xef, 0x7d, 0xb8, 0xe3, 0xa2, 0x73, 0xfe, 0x76, 0x7a, 0xda, 0xca, 0x09,
+	0xb8, 0x2a, 0x53, 0x92, 0x44, 0xdb, 0x00, 0x64, 0xaa, 0xf8, 0x80, 0x8f, 0xc7, 0x4c, 0x05, 0x39,
+	0xb3, 0x4f, 0x4a, 0x83, 0x3e, 0x82, 0xaa, 0x22, 0x62, 0x48, 0x55, 0x28, 0x95, 0x60, 0xf1, 0x30,
+	0xc8, 0x37, 0xbd, 0x76, 0x09, 0x57, 0xac, 0xb2, 0x67, 0x74, 0x68, 0x17, 0x0a, 0x7c, 0xa2, 0x4c,
+	0x08, 0x6b, 0x4d, 0xaf, 0x5d, 0xde, 0xdb, 0xd8, 0xb1, 0x07, 0x3f, 0xfa, 0x92, 0x0e, 0xa6, 0x8a,
+	0x9e, 0x5a, 0x10, 0x27, 0x56, 0xe8, 0x00, 0xfc, 0xd4, 0xf1, 0xc2, 0x31, 0x8f, 0x68, 0x50, 0x68,
+	0x7a, 0xed, 0xda, 0xde, 0x07, 0x49, 0xf0, 0xa9, 0x93, 0x9e, 0xf0, 0x88, 0xe2, 0xba, 0x5a, 0x56,
+	0xa0, 0x5d, 0x28, 0x5e, 0x13, 0x11, 0xb3, 0x78, 0x28, 0x83, 0xa2, 0x39, 0xf8, 0x7d, 0xb7, 0xeb,
+	0xaf, 0xf5, 0xef, 0x0b, 0x8b, 0xe1, 0xb9, 0x11, 0xfa, 0x05, 0x54, 0x26, 0x82, 0x2e, 0xb2, 0x55,
+	0xba, 0x43, 0xb6, 0xca, 0x13, 0x41, 0xe7, 0xb9, 0xda, 0x87, 0xea, 0x84, 0x4b, 0xb5, 0xf0, 0x00,
+	0x77, 0xf0, 0x50, 0xd1, 0x4b, 0xe6, 0x2e, 0x3e, 0x86, 0xda, 0x88, 0x48, 0x15, 0xb2, 0x58, 0x52,
+	0xa1, 0x42, 0x16, 0x05, 0xe5, 0xa6, 0xd7, 0xce, 0xe1, 0x8a, 0xd6, 0x76, 0x8d, 0xb2, 0x1b, 0xa1,
+	0x6f, 0x03, 0x5c, 0xf0, 0x69, 0x1c, 0x85, 0x82, 0x5f, 0xcb, 0xa0, 0x62, 0x2c, 0x4a, 0x46, 0x83,
+	0xf9, 0xb5, 0x44, 0x21, 0x6c, 0x4e, 0x25, 0x15, 0x61, 0x44, 0x2f, 0x58, 0x4c, 0xa3, 0x70, 0x46,
+	0x04, 0x23, 0x2f, 0x47, 0x54, 0x06, 0x55, 0x13, 0xd0, 0xa3, 0xd5, 0x80, 0xce, 0x25, 0x15, 0x87,
+	0xd6, 0xf8, 0x79, 0x62, 0x7b, 0x14, 0x2b, 0x71, 0x83, 0x1b, 0xd3, 0x5b, 0x20, 0x74, 0x0a, 0xbe,
+	0xbc, 0x91, 0x8a, 0x8e, 0x53, 0xae, 0x6b, 0xc6, 0xf5, 0xc7, 0x5f, 0x39, 0xab, 0xb1, 0x5b, 0xf1,
+	0x5a, 0x97, 0xcb, 0x5a, 0xf4, 0x2d, 0x28, 0x09, 0x7e, 0x1d, 0x0e, 0xf8, 0x34, 0x56, 0x41, 0xbd,
+	0xe9, 0xb5, 0xb3, 0xb8, 0x28, 0xf8, 0x75, 0x47, 0xcb, 0x9a, 0x82, 0x92, 0xcc, 0xe8, 0x84, 0xb3,
+	0x58, 0xc9, 0xc0, 0x6f, 0x66, 0xdb, 0x25, 0x9c, 0xd2, 0xa0, 0x36, 0xf8, 0x2c, 0x0e, 0x05, 0x95,
+	0x54, 0xcc, 0x68, 0x14, 0x0e, 0x78, 0x1c, 0x07, 0xeb, 0x86, 0xa8, 0x35, 0x16, 0x63, 0xa7, 0xee,
+	0xf0, 0x38, 0xd6, 0x15, 0x1e, 0xf1, 0xc1, 0x55, 0x52, 0xa0, 0x00, 0x35, 0xbd, 0xd7, 0xd6, 0xa7,
+	0xac, 0x57, 0x38, 0x01, 0xed, 0xc0, 0x7d, 0x53, 0x1e, 0xe3, 0xe5, 0x92, 0x12, 0xa1, 0x5e, 0x52,
+	0xa2, 0x82, 0xfb, 0x26, 0xe2, 0x75, 0x0d, 0x1d, 0xf3, 0xc1, 0xd5, 0xaf, 0x12, 0x00, 0xfd, 0x12,
+	0x7c, 0x41, 0x49, 0x14, 0x92, 0x0b, 0x45, 0x45, 0x78, 0x2d, 0x98, 0xa2, 0x41, 0xc3, 0x6c, 0xba,
+	0x99, 0x6c, 0x8a, 0x29, 0x89, 0xf6, 0x35, 0xfc, 0x42, 0xa3, 0xb8, 0x26, 0x96, 0x64, 0xd4, 0x84,
+	0xf2, 0xe1, 0xe1, 0x71, 0x4f, 0x09, 0xa2, 0xe8, 0xf0, 0x26, 0xd8, 0x30, 0xdd, 0x95, 0x56, 0x69,
+	0x0b, 0x17, 0xde, 0xf9, 0x79, 0xf7, 0x30, 0xd8, 0xb4, 0x16, 0x29, 0x15, 0xfa, 0x11, 0x6c, 0xd2,
+	0x58, 0x27, 0x3a, 0x74, 0x55, 0x93, 0x54, 0x29, 0xd3, 0x17, 0x1f, 0x98, 0x34, 0x35, 0x2c, 0x6a,
+	0x4b, 0xd5, 0x73, 0x98, 0x66, 0x51, 0xba, 0x07, 0x4d, 0xeb, 0x84, 0x8a, 0x0c, 0x65, 0x10, 0xdc,
+	0xce, 0xa2, 0x54, 0x47, 0x9a, 0x0e, 0xeb, 0x93, 0x61, 0xc2, 0x22, 0x75, 0x0b, 0xb4, 0xf5, 0x77,
+	0x0f, 0x2a, 0xe9, 0x54, 0xa3, 0x4f, 0x60, 0xcd, 0x5e, 0x1b, 0xe6, 0x3e, 0x2b, 0xef, 0x55, 0x5d,
+	0xbf, 0xf6, 0x8d, 0x12, 0x3b, 0x50, 0x5f, 0x7f, 0xe9, 0xc0, 0x58, 0x14, 0x64, 0x4c, 0xfe, 0xab,
+	0x29, 0x6d, 0x37, 0x42, 0x9f, 0x41, 0x45, 0xe9, 0x63, 0xa9, 0x90, 0x8c, 0x18, 0x91, 0x41, 0xd6,
+	0xdd, 0x3c, 0xf3, 0x5b, 0xb6, 0x6f, 0xd0, 0x7d, 0x0d, 0xe2, 0xb2, 0x5a, 0x08, 0xe8, 0x3b, 0x50,
+	0x9e, 0xb3, 0x89, 0x45, 0xe6, 0xd2, 0xcb, 0x62, 0x48, 0x54, 0xdd, 0x68, 0xeb, 0xb7, 0xf0, 0xe0,
+	0x6b, 0x5b, 0x06, 0xf9, 0x90, 0xbd, 0xa2, 0x37, 0xe6, 0x08, 0x25, 0xac, 0xff, 0xa2, 0x47, 0x90,
+	0x9f, 0x91, 0xd1, 0x94, 0x9a, 0x38, 0x17, 0xd7, 0xd0, 0x01, 0x8b, 0xe7, 0x6b, 0xb1, 0xb5, 0xf8,
+	0x69, 0xe6, 0x33, 0x6f, 0xeb, 0x00, 0x1a, 0xb7, 0x75, 0xcd, 0x2d, 0x8e, 0x1b, 0x69, 0xc7, 0xa5,
+	0xb4, 0x8f, 0xa7, 0xf0, 0xe0, 0x6b, 0xcb, 0xf1, 0x26, 0x8e, 0x3e, 0xcf, 0x15, 0xb3, 0x7e, 0xae,
+	0xf5, 0x57, 0x0f, 0x6a, 0xcb, 0x44, 0x45, 0x3f, 0x80, 0x8d, 0x55, 0x6a, 0x87, 0x43, 0xc5, 0x22,
+	0xe7, 0x16, 0x2d, 0xf3, 0xf8, 0xa9, 0x62, 0x11, 0xfa, 0x09, 0x04, 0x5f, 0x59, 0xa2, 0xd8, 0x98,
+	0xf2, 0xa9, 0x32, 0x1b, 0x7b, 0x78, 0x63, 0x79, 0x55, 0xdf, 0x82, 0xba, 0xed, 0x5c, 0xcb, 0xea,
+	0xa9, 0x37, 0xb8, 0x32, 0x1b, 0xd9, 0x8a, 0x16, 0xf1, 0xba, 0x83, 0xfa, 0x1a, 0xd1, 0xfb, 0xc8,
+	0xd6, 0x5f, 0x32, 0x50, 0x73, 0xa3, 0x05, 0xd3, 0x2f, 0xa6, 0x54, 0x2a, 0xf4, 0x29, 0x94, 0x06,
+	0x64, 0x34, 0xa2, 0x22, 0x74, 0x21, 0x96, 0xf7, 0xea, 0x3b, 0x76, 0xc0, 0x76, 0x8c, 0xbe, 0x7b,
+	0x88, 0x8b, 0xd6, 0xa2, 0x1b, 0xa1, 0x47, 0x50, 0x48, 0xee, 0x88, 0xcc, 0xdc, 0x36, 0x4d, 0x76,
+	0x9c, 0xe0, 0xe8, 0x21, 0xe4, 0x4d, 0x39, 0x1d, 0xbf, 0xd6, 0x93, 0xe2, 0xea, 0xdb, 0xd8, 0xe4,
+	0x1d, 0x5b, 0x1c, 0xfd, 0x18, 0x1c, 0xc9, 0x42, 0x75, 0x33, 0xa1, 0x86, 0x55, 0xb5, 0xbd, 0xc6,
+	0x2a, 0x1d, 0xfb, 0x37, 0x13, 0x8a, 0x41, 0xcd, 0xff, 0x6b, 0xb6, 0x5f, 0xd1, 0x1b, 0x39, 0x21,
+	0x03, 0x1a, 0x9a, 0xd1, 0x6c, 0x46, 0x68, 0x09, 0x57, 0x13, 0xad, 0x69, 0xa1, 0xf4, 0x88, 0x2d,
+	0xdc, 0x65, 0xc4, 0x7e, 0x9e, 0x2b, 0xe6, 0xfd, 0xb5, 0xd6, 0x1f, 0x3d, 0xa8, 0xcf, 0x33, 0x25,
+	0x27, 0x3c, 0x96, 0x7a, 0xc7, 0x3c, 0x15, 0x82, 0x8b, 0x95, 0x34, 0xe1, 0xb3, 0xce, 0x91, 0x56,
+	0x63, 0x8b, 0xbe, 0x49, 0x8e, 0x1e, 0xc3, 0x9a, 0xa0, 0x72, 0x3a, 0x52, 0x2e, 0x49, 0x28, 0x3d,
+	0x88, 0xb1, 0x41, 0xb0, 0xb3, 0x68, 0xfd, 0x2b, 0x03, 0xf7, 0x5d, 0x44, 0x07, 0x44, 0x0d, 0x2e,
+	0xdf, 0x7b, 0x01, 0xbf, 0x0b, 0x05, 0x1d, 0x0d, 0xa3, 0x9a, 0x50, 0xd9, 0xdb, 0x4b, 0x98, 0x58,
+	0xbc, 0x43, 0x11, 0x89, 0x5c, 0x7a, 0xb1, 0xe5, 0xed, 0x8b, 0x8d, 0xc8, 0xf4, 0x8b, 0xed, 0x3d,
+	0xd5, 0xba, 0xf5, 0x67, 0x0f, 0x1a, 0xcb, 0x39, 0x7d, 0x6f, 0xa5, 0xfe, 0x3e, 0x14, 0x6c, 0x21,
+	0x93, 0x6c, 0x6e, 0xba, 0xd8, 0x6c, 0x99, 0x5f, 0x30, 0x75, 0x69, 0x5d, 0x27, 0x66, 0xba, 0x59,
+	0x1b, 0x3d, 0x25, 0x28, 0x19, 0xbf, 0x53, 0xcb, 0xce, 0xfb, 0x30, 0xf3, 0x66, 0x7d, 0x98, 0x7d,
+	0xeb, 0x3e, 0xcc, 0xbd, 0xa6, 0x36, 0xf9, 0x3b, 0x3d, 0x75, 0x53, 0xb9, 0x5d, 0xfb, 0xe6, 0xdc,
+	0xb6, 0x3a, 0xb0, 0xb1, 0x92, 0x28, 0x57, 0xc6, 0x45, 0x7f, 0x79, 0xaf, 0xed, 0xaf, 0xdf, 0xc1,
+	0x03, 0x4c, 0x25, 0x1f, 0xcd, 0x68, 0x8a, 0x79, 0x6f, 0x97, 0x72, 0x04, 0xb9, 0x48, 0xb9, 0xf1,
+	0x5b, 0xc2, 0xe6, 0x7f, 0xeb, 0x43, 0xd8, 0xba, 0xcd, 0xbd, 0x0d, 0xb4, 0xf5, 0x37, 0x0f, 0x2a,
+	0xcf, 0xed, 0x19, 0x9e, 0x8c, 0xc8, 0x50, 0xea, 0xcf, 0x87, 0x31, 0x8b, 0xd9, 0x98, 0xfd, 0x9e,
+	0x86, 0xf2, 0x8a, 0x5e, 0xbb, 0x2f, 0x99, 0x4a, 0xa2, 0xec, 0x5d, 0xd1, 0x6b, 0x6d, 0x34, 0xb8,
+	0x24, 0xf1, 0x90, 0x86, 0x74, 0x46, 0xf5, 0x1b, 0x30, 0x63, 0x8d, 0xac, 0xf2, 0xc8, 0xe8, 0xd0,
+	0xa7, 0xfa, 0xf1, 0x60, 0x5e, 0xa2, 0x96, 0x77, 0x8d, 0x24, 0x8d, 0x6e, 0x3f, 0x53, 0x56, 0xec,
+	0x6c, 0xd0, 0xf7, 0x00, 0xcd, 0x9f, 0x6f, 0x21, 0x8b, 0x15, 0x15, 0x33, 0x32, 0x32, 0x15, 0xad,
+	0xe2, 0xf5, 0x39, 0xd2, 0x75, 0x40, 0xeb, 0x0f, 0x8b, 0xb8, 0x8d, 0x1f, 0xb4, 0x05, 0xc5, 0xa4,
+	0xee, 0x6e, 0xe0, 0xcd, 0x65, 0x9d, 0x96, 0x98, 0x8c, 0x93, 0x59, 0x6a, 0xfe, 0xa3, 0x00, 0x0a,
+	0x03, 0x3e, 0x9a, 0x8e, 0x63, 0x1b, 0x5e, 0x09, 0x27, 0x22, 0x7a, 0x08, 0x75, 0xfa, 0xe5, 0x60,
+	0x34, 0x8d, 0x68, 0x98, 0x58, 0xe4, 0x8c, 0x45, 0xcd, 0xa9, 0x3b, 0x56, 0xdb, 0xfa, 0xaf, 0x07,
+	0x35, 0x17, 0xc3, 0xdb, 0x95, 0x6b, 0x85, 0xf8, 0x99, 0x3b, 0x12, 0xff, 0x21, 0xe4, 0x67, 0x66,
+	0xb0, 0x27, 0x03, 0x2e, 0xf5, 0x15, 0xfb, 0x5c, 0xcf, 0x5b, 0x6c, 0x71, 0xcd, 0xc2, 0x0b, 0x36,
+	0x52, 0x54, 0x04, 0x39, 0xc7, 0xc2, 0x94, 0xe5, 0x13, 0x83, 0x60, 0x67, 0x81, 0x1e, 0x43, 0xfe,
+	0x42, 0x13, 0xc0, 0x35, 0xc9, 0x6a, 0xb1, 0x0c, 0x39, 0xb0, 0x35, 0x69, 0xfd, 0x1c, 0xea, 0xf3,
+	0x73, 0x2f, 0x08, 0xef, 0xa8, 0xe0, 0x35, 0xb3, 0xab, 0x5b, 0x3d, 0x37, 0x8c, 0xc0, 0xce, 0xe2,
+	0xf1, 0x21, 0xd4, 0x57, 0xbe, 0x15, 0x51, 0x1d, 0xca, 0xe7, 0xcf, 0x7a, 0x67, 0x47, 0x9d, 0xee,
+	0x93, 0xee, 0xd1, 0xa1, 0x7f, 0x0f, 0x01, 0xac, 0xf5, 0xba, 0xcf, 0x9e, 0x1e, 0x1f, 0xf9, 0x1e,
+	0x2a, 0x41, 0xfe, 0xe4, 0xfc, 0xb8, 0xdf, 0xf5, 0x33, 0xfa, 0x6f, 0xff, 0xc5, 0xe9, 0x59, 0xc7,
+	0xcf, 0x3e, 0xfe, 0x19, 0x94, 0x3b, 0xe6, 0x8b, 0xf7, 0x54, 0x44, 0x54, 0xe8, 0x05, 0xcf, 0x4e,
+	0xf1, 0xc9, 0xfe, 0xb1, 0x7f, 0x0f, 0x15, 0x20, 0x7b, 0x86, 0xf5, 0xca, 0x22, 0xe4, 0xce, 0x4e,
+	0x7b, 0x7d, 0x3f, 0x83, 0x6a, 0x00, 0xfb, 0xe7, 0xfd, 0xd3, 0xce, 0xe9, 0xc9, 0x49, 0xb7, 0xef,
+	0x67, 0x0f, 0x9e, 0xfc, 0xe3, 0xd5, 0xb6, 0xf7, 0xcf, 0x57, 0xdb, 0xde, 0xbf, 0x5f, 0x6d, 0x7b,
+	0x7f, 0xfa, 0xcf, 0xf6, 0x3d, 0xa8, 0x33, 0xbe, 0x33, 0x63, 0x8a, 0x4a, 0x69, 0x3f, 0xf0, 0x7f,
+	0xf3, 0x91, 0x93, 0x18, 0xdf, 0xb5, 0xff, 0x76, 0x87, 0x7c, 0x77, 0xa6, 0x76, 0x0d, 0xba, 0x6b,
+	0xd3, 0xf3, 0x72, 0xcd, 0x48, 0x3f, 0xfc, 0xff, 0x00, 0xfe, 0x05, 0xb3, 0x9d, 0x60, 0x10, 0x00,
+	0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -1391,6 +1528,27 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TransactionQueryTags) > 0 {
+		for k := range m.TransactionQueryTags {
+			v := m.TransactionQueryTags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintVtgate(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintVtgate(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintVtgate(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	if m.EnableSystemSettings {
 		i--
 		if m.EnableSystemSettings {
@@ -2290,6 +2448,35 @@ func (m *VStreamFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HeartbeatInterval != 0 {
+		i = encodeVarintVtgate(dAtA, i, uint64(m.HeartbeatInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVtgate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChangeEvents {
+		i--
+		if m.ChangeEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MinimizeSkew {
 		i--
 		if m.MinimizeSkew {
@@ -2303,6 +2490,65 @@ func (m *VStreamFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VStreamTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VStreamTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VStreamTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExcludeColumns) > 0 {
+		for iNdEx := len(m.ExcludeColumns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeColumns[iNdEx])
+			copy(dAtA[i:], m.ExcludeColumns[iNdEx])
+			i = encodeVarintVtgate(dAtA, i, uint64(len(m.ExcludeColumns[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintVtgate(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2543,6 +2789,14 @@ func (m *Session) Size() (n int) {
 	if m.EnableSystemSettings {
 		n += 3
 	}
+	if len(m.TransactionQueryTags) > 0 {
+		for k, v := range m.TransactionQueryTags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovVtgate(uint64(len(k))) + 1 + len(v) + sovVtgate(uint64(len(v)))
+			n += mapEntrySize + 2 + sovVtgate(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2814,6 +3068,50 @@ func (m *VStreamFlags) Size() (n int) {
 	if m.MinimizeSkew {
 		n += 2
 	}
+	if m.ChangeEvents {
+		n += 2
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovVtgate(uint64(l))
+		}
+	}
+	if m.HeartbeatInterval != 0 {
+		n += 1 + sovVtgate(uint64(m.HeartbeatInterval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VStreamTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 1 + l + sovVtgate(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVtgate(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovVtgate(uint64(l))
+		}
+	}
+	if len(m.ExcludeColumns) > 0 {
+		for _, s := range m.ExcludeColumns {
+			l = len(s)
+			n += 1 + l + sovVtgate(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3707,63 +4005,9 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableSystemSettings = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipVtgate(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthVtgate
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthVtgate
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Session_ShardSession) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowVtgate
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShardSession: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardSession: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionQueryTags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3790,23 +4034,204 @@ func (m *Session_ShardSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &query.Target{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			if m.TransactionQueryTags == nil {
+				m.TransactionQueryTags = make(map[string]string)
 			}
-			m.TransactionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVtgate
-				}
-				if iNdEx >= l {
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVtgate
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtgate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtgate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVtgate(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TransactionQueryTags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtgate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Session_ShardSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtgate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &query.Target{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -5415,6 +5840,261 @@ func (m *VStreamFlags) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MinimizeSkew = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChangeEvents = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &VStreamTable{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatInterval", wireType)
+			}
+			m.HeartbeatInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtgate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VStreamTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtgate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VStreamTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VStreamTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeColumns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeColumns = append(m.ExcludeColumns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
@@ -19,6 +19,8 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/google/shlex"
 )
@@ -28,10 +30,17 @@ var (
 )
 
 const (
-	declarativeFlag      = "declarative"
-	skipTopoFlag         = "skip-topo"
-	singletonFlag        = "singleton"
-	singletonContextFlag = "singleton-context"
+	declarativeFlag        = "declarative"
+	skipTopoFlag           = "skip-topo"
+	singletonFlag          = "singleton"
+	singletonContextFlag   = "singleton-context"
+	postponeCompletionFlag = "postpone-completion"
+	allowConcurrentFlag    = "allow-concurrent"
+	dryRunFlag             = "dry-run"
+	preferInstantDDLFlag   = "prefer-instant-ddl"
+	priorityFlag           = "priority"
+	runAfterFlag           = "run-after"
+	scheduleWindowFlag     = "schedule-window"
 )
 
 // DDLStrategy suggests how an ALTER TABLE should run (e.g. "direct", "online", "gh-ost" or "pt-osc")
@@ -89,6 +98,15 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if _, err := setting.Priority(); err != nil {
+		return nil, err
+	}
+	if runAfter := setting.RunAfter(); runAfter != "" && !IsOnlineDDLUUID(runAfter) {
+		return nil, fmt.Errorf("Invalid -%s value: '%s' is not a migration UUID", runAfterFlag, runAfter)
+	}
+	if _, err := ParseScheduleWindows(setting.ScheduleWindow()); err != nil {
+		return nil, err
+	}
 	return setting, nil
 }
 
@@ -103,6 +121,23 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// flagValue returns the value of the given string when it is a CLI flag of the given name in
+// the form -name=value, or else an empty string and false
+func flagValue(s string, name string) (string, bool) {
+	for _, prefix := range []string{fmt.Sprintf("-%s=", name), fmt.Sprintf("--%s=", name)} {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimPrefix(s, prefix), true
+		}
+	}
+	return "", false
+}
+
+// isValueFlag returns true when the given string is a CLI flag of the given name, in the form -name=value
+func isValueFlag(s string, name string) bool {
+	_, ok := flagValue(s, name)
+	return ok
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	opts, _ := shlex.Split(setting.Options)
@@ -114,6 +149,17 @@ func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	return false
 }
 
+// getFlagValue returns the value of a named flag given in Options as -name=value, or an empty string
+func (setting *DDLStrategySetting) getFlagValue(name string) string {
+	opts, _ := shlex.Split(setting.Options)
+	for _, opt := range opts {
+		if val, ok := flagValue(opt, name); ok {
+			return val
+		}
+	}
+	return ""
+}
+
 // IsDeclarative checks if strategy options include -declarative
 func (setting *DDLStrategySetting) IsDeclarative() bool {
 	return setting.hasFlag(declarativeFlag)
@@ -129,6 +175,50 @@ func (setting *DDLStrategySetting) IsSingletonContext() bool {
 	return setting.hasFlag(singletonContextFlag)
 }
 
+// IsPostponeCompletion checks if strategy options include -postpone-completion
+func (setting *DDLStrategySetting) IsPostponeCompletion() bool {
+	return setting.hasFlag(postponeCompletionFlag)
+}
+
+// IsAllowConcurrent checks if strategy options include -allow-concurrent
+func (setting *DDLStrategySetting) IsAllowConcurrent() bool {
+	return setting.hasFlag(allowConcurrentFlag)
+}
+
+// IsDryRun checks if strategy options include -dry-run
+func (setting *DDLStrategySetting) IsDryRun() bool {
+	return setting.hasFlag(dryRunFlag)
+}
+
+// IsPreferInstantDDL checks if strategy options include -prefer-instant-ddl
+func (setting *DDLStrategySetting) IsPreferInstantDDL() bool {
+	return setting.hasFlag(preferInstantDDLFlag)
+}
+
+// Priority returns the value of -priority=<n>, or 0 when not given. Higher priority migrations are
+// scheduled first.
+func (setting *DDLStrategySetting) Priority() (int64, error) {
+	val := setting.getFlagValue(priorityFlag)
+	if val == "" {
+		return 0, nil
+	}
+	priority, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid -%s value: '%s' is not an integer", priorityFlag, val)
+	}
+	return priority, nil
+}
+
+// RunAfter returns the migration UUID given by -run-after=<uuid>, or an empty string when not given
+func (setting *DDLStrategySetting) RunAfter() string {
+	return setting.getFlagValue(runAfterFlag)
+}
+
+// ScheduleWindow returns the value of -schedule-window=<HH:MM-HH:MM,...>, or an empty string when not given
+func (setting *DDLStrategySetting) ScheduleWindow() string {
+	return setting.getFlagValue(scheduleWindowFlag)
+}
+
 // RuntimeOptions returns the options used as runtime flags for given strategy, removing any internal hint options
 func (setting *DDLStrategySetting) RuntimeOptions() []string {
 	opts, _ := shlex.Split(setting.Options)
@@ -139,6 +229,13 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, skipTopoFlag):
 		case isFlag(opt, singletonFlag):
 		case isFlag(opt, singletonContextFlag):
+		case isFlag(opt, postponeCompletionFlag):
+		case isFlag(opt, allowConcurrentFlag):
+		case isFlag(opt, dryRunFlag):
+		case isFlag(opt, preferInstantDDLFlag):
+		case isValueFlag(opt, priorityFlag):
+		case isValueFlag(opt, runAfterFlag):
+		case isValueFlag(opt, scheduleWindowFlag):
 		default:
 			validOpts = append(validOpts, opt)
 		}
@@ -151,6 +248,9 @@ func (setting *DDLStrategySetting) IsSkipTopo() bool {
 	switch {
 	case setting.IsSingleton(), setting.IsSingletonContext():
 		return true
+	case setting.IsDryRun():
+		// a dry run reports back from the tablets, and is never persisted
+		return true
 	case setting.hasFlag(skipTopoFlag):
 		return true
 	}
@@ -41,6 +41,13 @@ func TestParseDDLStrategy(t *testing.T) {
 		options          string
 		isDeclarative    bool
 		isSingleton      bool
+		isPostponed      bool
+		isConcurrent     bool
+		isDryRun         bool
+		isPreferInstant  bool
+		priority         int64
+		runAfter         string
+		scheduleWindow   string
 		runtimeOptions   string
 		err              error
 	}{
@@ -90,6 +97,52 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:   "",
 			isSingleton:      true,
 		},
+		{
+			strategyVariable: "online -postpone-completion",
+			strategy:         DDLStrategyOnline,
+			options:          "-postpone-completion",
+			runtimeOptions:   "",
+			isPostponed:      true,
+		},
+		{
+			strategyVariable: "online -allow-concurrent --max-load=Threads_running=100",
+			strategy:         DDLStrategyOnline,
+			options:          "-allow-concurrent --max-load=Threads_running=100",
+			runtimeOptions:   "--max-load=Threads_running=100",
+			isConcurrent:     true,
+		},
+		{
+			strategyVariable: "online -dry-run",
+			strategy:         DDLStrategyOnline,
+			options:          "-dry-run",
+			runtimeOptions:   "",
+			isDryRun:         true,
+		},
+		{
+			strategyVariable: "online -prefer-instant-ddl -allow-concurrent",
+			strategy:         DDLStrategyOnline,
+			options:          "-prefer-instant-ddl -allow-concurrent",
+			runtimeOptions:   "",
+			isConcurrent:     true,
+			isPreferInstant:  true,
+		},
+		{
+			strategyVariable: "online -priority=3 -run-after=9748c3b7_7fdb_11eb_ac2c_f875a4d24e90 -schedule-window=01:00-05:00 -allow-concurrent",
+			strategy:         DDLStrategyOnline,
+			options:          "-priority=3 -run-after=9748c3b7_7fdb_11eb_ac2c_f875a4d24e90 -schedule-window=01:00-05:00 -allow-concurrent",
+			runtimeOptions:   "",
+			isConcurrent:     true,
+			priority:         3,
+			runAfter:         "9748c3b7_7fdb_11eb_ac2c_f875a4d24e90",
+			scheduleWindow:   "01:00-05:00",
+		},
+		{
+			strategyVariable: "gh-ost --priority=-1 --max-load=Threads_running=100",
+			strategy:         DDLStrategyGhost,
+			options:          "--priority=-1 --max-load=Threads_running=100",
+			runtimeOptions:   "--max-load=Threads_running=100",
+			priority:         -1,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -98,6 +151,16 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.options, setting.Options)
 		assert.Equal(t, ts.isDeclarative, setting.IsDeclarative())
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
+		assert.Equal(t, ts.isPostponed, setting.IsPostponeCompletion())
+		assert.Equal(t, ts.isConcurrent, setting.IsAllowConcurrent())
+		assert.Equal(t, ts.isDryRun, setting.IsDryRun())
+		assert.Equal(t, ts.isPreferInstant, setting.IsPreferInstantDDL())
+		priority, err := setting.Priority()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.priority, priority)
+		assert.Equal(t, ts.runAfter, setting.RunAfter())
+		assert.Equal(t, ts.scheduleWindow, setting.ScheduleWindow())
+		assert.Equal(t, ts.isDryRun || ts.isSingleton, setting.IsSkipTopo())
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -106,4 +169,16 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("online -priority=high")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("online -run-after=some-migration")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("online -schedule-window=01:00")
+		assert.Error(t, err)
+	}
 }
@@ -263,7 +263,11 @@ func TestNewOnlineDDLs(t *testing.T) {
 		"select * from t":                               {notDDL: true},
 		"drop database t":                               {notDDL: true},
 		"truncate table t":                              {isError: true},
-		"drop view t":                                   {isError: true},
+		"drop view t":                                   {sqls: []string{"drop view t"}},
+		"drop view if exists v1, v2":                    {sqls: []string{"drop view if exists v1", "drop view if exists v2"}},
+		"create view v as select * from t":              {sqls: []string{"create view v as select * from t"}},
+		"create or replace view v as select id from t":  {sqls: []string{"create or replace view v as select id from t"}},
+		"alter view v as select id from t":              {sqls: []string{"alter view v as select id from t"}},
 		"rename table t to t1":                          {isError: true},
 	}
 	migrationContext := "354b-11eb-82cd-f875a4d24e90"
@@ -306,6 +310,9 @@ func TestOnlineDDLFromCommentedStatement(t *testing.T) {
 		`create table t (id int primary key)`,
 		`alter table t drop primary key`,
 		`drop table if exists t`,
+		`create or replace view t as select id from t1`,
+		`alter view t as select id from t1`,
+		`drop view if exists t`,
 		`revert vitess_migration '4e5dcf80_354b_11eb_82cd_f875a4d24e90'`,
 	}
 	strategySetting := NewDDLStrategySetting(DDLStrategyGhost, `-singleton -declarative --max-load="Threads_running=5"`)
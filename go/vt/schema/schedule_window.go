@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	scheduleWindowTimeFormat = "15:04"
+)
+
+// ScheduleWindow is a daily time range, in UTC, within which a migration may start.
+// A window whose end precedes its start wraps around midnight, e.g. "22:00-02:00"
+type ScheduleWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseScheduleWindows parses a comma delimited list of time ranges, in the form "HH:MM-HH:MM", e.g.
+// "01:00-05:00,22:30-23:30". An empty string parses as no windows, which means no restriction.
+func ParseScheduleWindows(s string) (windows []*ScheduleWindow, err error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	for _, token := range strings.Split(s, ",") {
+		bounds := strings.Split(strings.TrimSpace(token), "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid schedule window: '%s', expected HH:MM-HH:MM", token)
+		}
+		window := &ScheduleWindow{}
+		if window.Start, err = parseScheduleWindowTime(bounds[0]); err != nil {
+			return nil, err
+		}
+		if window.End, err = parseScheduleWindowTime(bounds[1]); err != nil {
+			return nil, err
+		}
+		if window.Start == window.End {
+			return nil, fmt.Errorf("invalid schedule window: '%s' is empty", token)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// parseScheduleWindowTime returns the offset from midnight of a given "HH:MM" time
+func parseScheduleWindowTime(s string) (time.Duration, error) {
+	t, err := time.Parse(scheduleWindowTimeFormat, strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule window time: '%s', expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains returns true when the given time of day, in UTC, falls within this window
+func (w *ScheduleWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	// wraps around midnight
+	return offset >= w.Start || offset < w.End
+}
+
+// IsInScheduleWindows returns true when the given time falls within any of the given windows, or when
+// there are no windows at all
+func IsInScheduleWindows(windows []*ScheduleWindow, t time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+	for _, w := range windows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleWindows(t *testing.T) {
+	tt := []struct {
+		windows string
+		count   int
+		isError bool
+	}{
+		{windows: ""},
+		{windows: "01:00-05:00", count: 1},
+		{windows: "01:00-05:00, 22:30-23:30", count: 2},
+		{windows: "22:00-02:00", count: 1},
+		{windows: "01:00", isError: true},
+		{windows: "01:00-25:00", isError: true},
+		{windows: "1am-5am", isError: true},
+		{windows: "03:00-03:00", isError: true},
+	}
+	for _, ts := range tt {
+		t.Run(ts.windows, func(t *testing.T) {
+			windows, err := ParseScheduleWindows(ts.windows)
+			if ts.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ts.count, len(windows))
+		})
+	}
+}
+
+func TestIsInScheduleWindows(t *testing.T) {
+	tt := []struct {
+		windows  string
+		time     string
+		isWithin bool
+	}{
+		{windows: "", time: "12:00", isWithin: true},
+		{windows: "01:00-05:00", time: "01:00", isWithin: true},
+		{windows: "01:00-05:00", time: "04:59", isWithin: true},
+		{windows: "01:00-05:00", time: "05:00"},
+		{windows: "01:00-05:00", time: "00:59"},
+		{windows: "01:00-05:00,22:30-23:30", time: "23:00", isWithin: true},
+		{windows: "01:00-05:00,22:30-23:30", time: "12:00"},
+		{windows: "22:00-02:00", time: "23:59", isWithin: true},
+		{windows: "22:00-02:00", time: "00:30", isWithin: true},
+		{windows: "22:00-02:00", time: "02:00"},
+		{windows: "22:00-02:00", time: "21:00"},
+	}
+	for _, ts := range tt {
+		t.Run(ts.windows+" "+ts.time, func(t *testing.T) {
+			windows, err := ParseScheduleWindows(ts.windows)
+			require.NoError(t, err)
+			tm, err := time.Parse("15:04", ts.time)
+			require.NoError(t, err)
+			assert.Equal(t, ts.isWithin, IsInScheduleWindows(windows, tm))
+		})
+	}
+}
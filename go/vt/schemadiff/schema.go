@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Schema is a set of tables and views, as given by their CREATE statements
+type Schema struct {
+	tables []*sqlparser.CreateTable
+	views  []*sqlparser.CreateView
+
+	named map[string]sqlparser.Statement
+}
+
+// NewSchemaFromStatements creates a schema from CREATE TABLE and CREATE VIEW statements
+func NewSchemaFromStatements(statements []sqlparser.Statement) (*Schema, error) {
+	s := &Schema{named: map[string]sqlparser.Statement{}}
+	for _, stmt := range statements {
+		var name string
+		switch stmt := stmt.(type) {
+		case *sqlparser.CreateTable:
+			if stmt.OptLike != nil || stmt.TableSpec == nil {
+				return nil, nameError(ErrCreateTableLike, stmt.Table.Name.String())
+			}
+			name = stmt.Table.Name.String()
+			s.tables = append(s.tables, stmt)
+		case *sqlparser.CreateView:
+			name = stmt.ViewName.Name.String()
+			s.views = append(s.views, stmt)
+		default:
+			return nil, nameError(ErrUnsupportedStatement, sqlparser.String(stmt))
+		}
+		if _, ok := s.named[name]; ok {
+			return nil, nameError(ErrDuplicateName, name)
+		}
+		s.named[name] = stmt
+	}
+	return s, nil
+}
+
+// NewSchemaFromQueries creates a schema from CREATE TABLE and CREATE VIEW queries
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	var statements []sqlparser.Statement
+	for _, query := range queries {
+		stmt, err := sqlparser.ParseStrictDDL(query)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	return NewSchemaFromStatements(statements)
+}
+
+// NewSchemaFromSQL creates a schema from a sequence of semicolon separated CREATE TABLE
+// and CREATE VIEW queries
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	queries, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchemaFromQueries(queries)
+}
+
+// Tables returns the tables of the schema, ordered so that a table comes after the tables
+// its foreign keys reference
+func (s *Schema) Tables() []*sqlparser.CreateTable {
+	names := make([]string, len(s.tables))
+	for i, table := range s.tables {
+		names[i] = table.Table.Name.String()
+	}
+	ordered := s.orderByDependencies(names)
+	tables := make([]*sqlparser.CreateTable, len(ordered))
+	for i, name := range ordered {
+		tables[i] = s.named[name].(*sqlparser.CreateTable)
+	}
+	return tables
+}
+
+// Views returns the views of the schema, ordered so that a view comes after the views it reads from
+func (s *Schema) Views() []*sqlparser.CreateView {
+	names := make([]string, len(s.views))
+	for i, view := range s.views {
+		names[i] = view.ViewName.Name.String()
+	}
+	ordered := s.orderByDependencies(names)
+	views := make([]*sqlparser.CreateView, len(ordered))
+	for i, name := range ordered {
+		views[i] = s.named[name].(*sqlparser.CreateView)
+	}
+	return views
+}
+
+// dependencies returns the names of the tables and views in the schema that the named
+// table or view depends on: the tables referenced by its foreign keys, or the tables and
+// views a view reads from.
+func (s *Schema) dependencies(name string) []string {
+	var dependencies []string
+	addDependency := func(tableName sqlparser.TableName) {
+		dependency := tableName.Name.String()
+		if _, ok := s.named[dependency]; ok && dependency != name {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	switch stmt := s.named[name].(type) {
+	case *sqlparser.CreateTable:
+		for _, constraint := range stmt.TableSpec.Constraints {
+			if fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition); ok {
+				addDependency(fk.ReferencedTable)
+			}
+		}
+	case *sqlparser.CreateView:
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if aliased, ok := node.(*sqlparser.AliasedTableExpr); ok {
+				if tableName, ok := aliased.Expr.(sqlparser.TableName); ok {
+					addDependency(tableName)
+				}
+			}
+			return true, nil
+		}, stmt.Select)
+	}
+	return dependencies
+}
+
+// orderByDependencies orders the given names so that each comes after its dependencies
+// among them, otherwise keeping the given order. In a dependency loop, which foreign
+// keys allow, the first name of the loop goes first.
+func (s *Schema) orderByDependencies(names []string) []string {
+	pending := map[string]bool{}
+	for _, name := range names {
+		pending[name] = true
+	}
+	isReady := func(name string) bool {
+		for _, dependency := range s.dependencies(name) {
+			if pending[dependency] {
+				return false
+			}
+		}
+		return true
+	}
+	var ordered []string
+	for len(ordered) < len(names) {
+		next := ""
+		for _, name := range names {
+			if !pending[name] {
+				continue
+			}
+			if next == "" {
+				next = name
+			}
+			if isReady(name) {
+				next = name
+				break
+			}
+		}
+		ordered = append(ordered, next)
+		delete(pending, next)
+	}
+	return ordered
+}
+
+// Diff returns the statements that turn this schema into the given schema, in the order
+// they should be applied:
+// - views that are gone are dropped
+// - foreign keys to tables that are gone are dropped, then those tables are dropped
+// - new tables are created, with referenced tables first
+// - existing tables are altered
+// - views are created or altered, with the views they read from first
+func (s *Schema) Diff(to *Schema, hints *DiffHints) ([]sqlparser.Statement, error) {
+	var statements []sqlparser.Statement
+
+	views := s.Views()
+	for i := len(views) - 1; i >= 0; i-- {
+		view := views[i]
+		if _, ok := to.named[view.ViewName.Name.String()].(*sqlparser.CreateView); !ok {
+			statements = append(statements, &sqlparser.DropView{FromTables: sqlparser.TableNames{view.ViewName}})
+		}
+	}
+	dropped := map[string]bool{}
+	var dropTables []sqlparser.Statement
+	tables := s.Tables()
+	for i := len(tables) - 1; i >= 0; i-- {
+		table := tables[i]
+		if _, ok := to.named[table.Table.Name.String()].(*sqlparser.CreateTable); !ok {
+			dropped[table.Table.Name.String()] = true
+			dropTables = append(dropTables, &sqlparser.DropTable{FromTables: sqlparser.TableNames{table.Table}})
+		}
+	}
+
+	var createTables, alterTables []sqlparser.Statement
+	for _, table := range to.Tables() {
+		fromTable, ok := s.named[table.Table.Name.String()].(*sqlparser.CreateTable)
+		if !ok {
+			createTables = append(createTables, table)
+			continue
+		}
+		alterTable, err := DiffTables(fromTable, table, hints)
+		if err != nil {
+			return nil, err
+		}
+		if alterTable == nil {
+			continue
+		}
+		// A table can't be dropped while foreign keys reference it
+		if fkDrops := splitForeignKeyDrops(fromTable, alterTable, dropped); fkDrops != nil {
+			statements = append(statements, fkDrops)
+			if len(alterTable.AlterOptions) == 0 && alterTable.PartitionSpec == nil && alterTable.PartitionOption == nil {
+				continue
+			}
+		}
+		alterTables = append(alterTables, alterTable)
+	}
+	statements = append(statements, dropTables...)
+	statements = append(statements, createTables...)
+	statements = append(statements, alterTables...)
+
+	for _, view := range to.Views() {
+		fromView, ok := s.named[view.ViewName.Name.String()].(*sqlparser.CreateView)
+		if !ok {
+			statements = append(statements, view)
+			continue
+		}
+		alterView, err := DiffViews(fromView, view)
+		if err != nil {
+			return nil, err
+		}
+		if alterView != nil {
+			statements = append(statements, alterView)
+		}
+	}
+	return statements, nil
+}
+
+// splitForeignKeyDrops moves the drops of the foreign keys that reference the dropped
+// tables out of the ALTER TABLE, into an ALTER TABLE of their own. It returns nil if the
+// ALTER TABLE drops no such foreign key.
+func splitForeignKeyDrops(from *sqlparser.CreateTable, alterTable *sqlparser.AlterTable, dropped map[string]bool) *sqlparser.AlterTable {
+	if len(dropped) == 0 {
+		return nil
+	}
+	// DiffTables names the foreign keys as the normalized table does
+	normalized, err := NormalizeCreateTable(from)
+	if err != nil {
+		return nil
+	}
+	referencesDropped := map[string]bool{}
+	for _, constraint := range normalized.TableSpec.Constraints {
+		if fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition); ok && dropped[fk.ReferencedTable.Name.String()] {
+			referencesDropped[constraint.Name.Lowered()] = true
+		}
+	}
+	var fkDrops, others []sqlparser.AlterOption
+	for _, option := range alterTable.AlterOptions {
+		if dropKey, ok := option.(*sqlparser.DropKey); ok && dropKey.Type == sqlparser.ForeignKeyType && referencesDropped[dropKey.Name.Lowered()] {
+			fkDrops = append(fkDrops, option)
+			continue
+		}
+		others = append(others, option)
+	}
+	if len(fkDrops) == 0 {
+		return nil
+	}
+	alterTable.AlterOptions = others
+	return &sqlparser.AlterTable{
+		Table:        alterTable.Table,
+		FullyParsed:  true,
+		AlterOptions: fkDrops,
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestNewSchema(t *testing.T) {
+	schema, err := NewSchemaFromSQL(`
+		create view v2 as select * from v1;
+		create view v1 as select id from child;
+		create table child (id int primary key, p int, constraint fk foreign key (p) references parent (id));
+		create table parent (id int primary key);
+		create table other (id int primary key)`)
+	require.NoError(t, err)
+
+	var tables []string
+	for _, table := range schema.Tables() {
+		tables = append(tables, table.Table.Name.String())
+	}
+	assert.Equal(t, []string{"parent", "child", "other"}, tables)
+	var views []string
+	for _, view := range schema.Views() {
+		views = append(views, view.ViewName.Name.String())
+	}
+	assert.Equal(t, []string{"v1", "v2"}, views)
+
+	_, err = NewSchemaFromSQL("create table t (id int); create view t as select 1")
+	assert.True(t, errors.Is(err, ErrDuplicateName))
+	_, err = NewSchemaFromSQL("create table t (id int); drop table t")
+	assert.True(t, errors.Is(err, ErrUnsupportedStatement))
+}
+
+func TestDiffSchemas(t *testing.T) {
+	tcases := []struct {
+		name string
+		from string
+		to   string
+		diff []string
+	}{
+		{
+			name: "identical",
+			from: "create table t (id int primary key); create view v as select id from t",
+			to:   "create view v as select id from t; create table t (id int(11) not null, primary key (id))",
+		},
+		{
+			name: "create tables in dependency order",
+			from: "",
+			to:   "create table child (id int primary key, p int, constraint fk foreign key (p) references parent (id)); create table parent (id int primary key)",
+			diff: []string{
+				"create table parent (\n\tid int primary key\n)",
+				"create table child (\n\tid int primary key,\n\tp int,\n\tconstraint fk foreign key (p) references parent (id)\n)",
+			},
+		},
+		{
+			name: "drop tables in reverse dependency order",
+			from: "create table parent (id int primary key); create table child (id int primary key, p int, constraint fk foreign key (p) references parent (id)); create view v as select id from child",
+			to:   "",
+			diff: []string{
+				"drop view v",
+				"drop table child",
+				"drop table parent",
+			},
+		},
+		{
+			name: "drop foreign keys before the tables they reference",
+			from: "create table p (id int primary key); create table c (id int primary key, p int, constraint fk foreign key (p) references p (id))",
+			to:   "create table c (id int primary key, p int)",
+			diff: []string{
+				"alter table c drop foreign key fk",
+				"drop table p",
+				"alter table c drop key fk",
+			},
+		},
+		{
+			name: "alter, create and drop",
+			from: "create table t1 (id int primary key); create table t2 (id int primary key); create view v1 as select id from t1",
+			to:   "create table t1 (id int primary key, i int); create table t3 (id int primary key); create view v2 as select id from v1; create view v1 as select id, i from t1",
+			diff: []string{
+				"drop table t2",
+				"create table t3 (\n\tid int primary key\n)",
+				"alter table t1 add column i int after id",
+				"alter view v1 as select id, i from t1",
+				"create view v2 as select id from v1",
+			},
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			statements, err := DiffSchemasSQL(tcase.from, tcase.to, nil)
+			require.NoError(t, err)
+			var diff []string
+			for _, stmt := range statements {
+				diff = append(diff, sqlparser.String(stmt))
+			}
+			assert.Equal(t, tcase.diff, diff)
+		})
+	}
+}
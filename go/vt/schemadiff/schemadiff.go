@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemadiff computes the difference between two schemas, or between
+// two tables or views, purely from their CREATE statements as parsed by
+// sqlparser. It does not need a MySQL server.
+//
+// Before comparing, both sides are normalized the way MySQL would present
+// them in SHOW CREATE TABLE: type aliases are resolved, implicit names are
+// given to indexes and constraints, redundant defaults are removed, and so on.
+// The result of a diff is a set of statements that, applied to the "from"
+// side, produce the "to" side.
+package schemadiff
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// AutoIncrementStrategy indicates how to handle a difference in the
+// AUTO_INCREMENT table option
+type AutoIncrementStrategy int
+
+const (
+	// AutoIncrementIgnore never diffs AUTO_INCREMENT
+	AutoIncrementIgnore AutoIncrementStrategy = iota
+	// AutoIncrementApplyHigher applies AUTO_INCREMENT only when the new value is higher than the existing one
+	AutoIncrementApplyHigher
+	// AutoIncrementApplyAlways applies AUTO_INCREMENT whenever it differs
+	AutoIncrementApplyAlways
+)
+
+// DiffHints affects the way a diff is computed
+type DiffHints struct {
+	AutoIncrementStrategy AutoIncrementStrategy
+}
+
+var (
+	// ErrNotCreateTable is returned when a query expected to be a CREATE TABLE is not one
+	ErrNotCreateTable = errors.New("expected CREATE TABLE statement")
+	// ErrNotCreateView is returned when a query expected to be a CREATE VIEW is not one
+	ErrNotCreateView = errors.New("expected CREATE VIEW statement")
+	// ErrUnsupportedStatement is returned for statements that cannot be part of a schema
+	ErrUnsupportedStatement = errors.New("unsupported statement: only CREATE TABLE and CREATE VIEW are supported")
+	// ErrCreateTableLike is returned for CREATE TABLE ... LIKE statements, which do not describe the table
+	ErrCreateTableLike = errors.New("CREATE TABLE ... LIKE is not supported")
+	// ErrDuplicateName is returned when a schema has two tables or views with the same name
+	ErrDuplicateName = errors.New("duplicate table or view name")
+)
+
+// ParseCreateTable parses the given query, which must be a CREATE TABLE statement
+func ParseCreateTable(query string) (*sqlparser.CreateTable, error) {
+	stmt, err := sqlparser.ParseStrictDDL(query)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, ErrNotCreateTable
+	}
+	return createTable, nil
+}
+
+// ParseCreateView parses the given query, which must be a CREATE VIEW statement
+func ParseCreateView(query string) (*sqlparser.CreateView, error) {
+	stmt, err := sqlparser.ParseStrictDDL(query)
+	if err != nil {
+		return nil, err
+	}
+	createView, ok := stmt.(*sqlparser.CreateView)
+	if !ok {
+		return nil, ErrNotCreateView
+	}
+	return createView, nil
+}
+
+// DiffCreateTablesQueries parses two CREATE TABLE queries and returns the ALTER TABLE
+// statement that turns the first table into the second, or nil if they are equivalent.
+func DiffCreateTablesQueries(from, to string, hints *DiffHints) (*sqlparser.AlterTable, error) {
+	fromCreateTable, err := ParseCreateTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toCreateTable, err := ParseCreateTable(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffTables(fromCreateTable, toCreateTable, hints)
+}
+
+// DiffCreateViewsQueries parses two CREATE VIEW queries and returns the ALTER VIEW
+// statement that turns the first view into the second, or nil if they are equivalent.
+func DiffCreateViewsQueries(from, to string) (*sqlparser.AlterView, error) {
+	fromCreateView, err := ParseCreateView(from)
+	if err != nil {
+		return nil, err
+	}
+	toCreateView, err := ParseCreateView(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffViews(fromCreateView, toCreateView)
+}
+
+// DiffSchemasSQL parses two schemas, each given as a sequence of CREATE TABLE and CREATE VIEW
+// statements, and returns the statements that turn the first schema into the second.
+func DiffSchemasSQL(from, to string, hints *DiffHints) ([]sqlparser.Statement, error) {
+	fromSchema, err := NewSchemaFromSQL(from)
+	if err != nil {
+		return nil, err
+	}
+	toSchema, err := NewSchemaFromSQL(to)
+	if err != nil {
+		return nil, err
+	}
+	return fromSchema.Diff(toSchema, hints)
+}
+
+// nameError annotates an error with the name of the table or view it relates to
+func nameError(err error, name string) error {
+	return fmt.Errorf("%w: %s", err, name)
+}
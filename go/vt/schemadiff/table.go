@@ -0,0 +1,741 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	// typeAliases maps type synonyms to the type MySQL reports
+	typeAliases = map[string]string{
+		"integer": "int",
+		"dec":     "decimal",
+		"numeric": "decimal",
+		"fixed":   "decimal",
+	}
+	// integerTypes have a display width which MySQL 8.0 does not report
+	integerTypes = map[string]bool{
+		"tinyint":   true,
+		"smallint":  true,
+		"mediumint": true,
+		"int":       true,
+		"bigint":    true,
+	}
+	// textTypes have a charset and collation, defaulting to those of the table
+	textTypes = map[string]bool{
+		"char":       true,
+		"varchar":    true,
+		"tinytext":   true,
+		"text":       true,
+		"mediumtext": true,
+		"longtext":   true,
+		"enum":       true,
+		"set":        true,
+	}
+	// tableOptionDefaults hold the values that reset table options to their defaults.
+	// Options that are not listed, like the engine or the charset, have no such value.
+	tableOptionDefaults = map[string]*sqlparser.TableOption{
+		"avg_row_length":    {Name: "avg_row_length", Value: sqlparser.NewIntLiteral("0")},
+		"checksum":          {Name: "checksum", Value: sqlparser.NewIntLiteral("0")},
+		"comment":           {Name: "comment", Value: sqlparser.NewStrLiteral("")},
+		"compression":       {Name: "compression", Value: sqlparser.NewStrLiteral("None")},
+		"delay_key_write":   {Name: "delay_key_write", Value: sqlparser.NewIntLiteral("0")},
+		"key_block_size":    {Name: "key_block_size", Value: sqlparser.NewIntLiteral("0")},
+		"max_rows":          {Name: "max_rows", Value: sqlparser.NewIntLiteral("0")},
+		"min_rows":          {Name: "min_rows", Value: sqlparser.NewIntLiteral("0")},
+		"pack_keys":         {Name: "pack_keys", String: "default"},
+		"row_format":        {Name: "row_format", String: "default"},
+		"stats_auto_recalc": {Name: "stats_auto_recalc", String: "default"},
+		"stats_persistent":  {Name: "stats_persistent", String: "default"},
+	}
+	// engineNames maps lowercase engine names to the way MySQL reports them
+	engineNames = map[string]string{
+		"innodb":     "InnoDB",
+		"myisam":     "MyISAM",
+		"memory":     "MEMORY",
+		"csv":        "CSV",
+		"archive":    "ARCHIVE",
+		"blackhole":  "BLACKHOLE",
+		"federated":  "FEDERATED",
+		"mrg_myisam": "MRG_MYISAM",
+	}
+	// currentTimestampSynonyms all evaluate to CURRENT_TIMESTAMP in DEFAULT and ON UPDATE clauses
+	currentTimestampSynonyms = map[string]bool{
+		"current_timestamp": true,
+		"now":               true,
+		"localtime":         true,
+		"localtimestamp":    true,
+	}
+)
+
+// NormalizeCreateTable returns a copy of the given CREATE TABLE statement in
+// the canonical form schemadiff compares, which is close to the output of
+// SHOW CREATE TABLE. The given statement is not modified.
+func NormalizeCreateTable(createTable *sqlparser.CreateTable) (*sqlparser.CreateTable, error) {
+	if createTable.OptLike != nil || createTable.TableSpec == nil {
+		return nil, nameError(ErrCreateTableLike, createTable.Table.Name.String())
+	}
+	normalized := sqlparser.CloneRefOfCreateTable(createTable)
+	normalized.IfNotExists = false
+	normalized.Comments = nil
+	spec := normalized.TableSpec
+
+	normalizeTableOptions(spec)
+	charset, collate := tableCharsetCollate(spec)
+
+	// Column key options, like `id int primary key`, are turned into indexes
+	var keyOptionIndexes []*sqlparser.IndexDefinition
+	for _, col := range spec.Columns {
+		if index := col.ExtractKeyOptionIndex(); index != nil {
+			if index.Info.Primary {
+				keyOptionIndexes = append([]*sqlparser.IndexDefinition{index}, keyOptionIndexes...)
+			} else {
+				keyOptionIndexes = append(keyOptionIndexes, index)
+			}
+		}
+	}
+	spec.Indexes = append(keyOptionIndexes, spec.Indexes...)
+
+	primaryKeyColumns := map[string]bool{}
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			for _, col := range index.Columns {
+				primaryKeyColumns[col.Column.Lowered()] = true
+			}
+		}
+	}
+	for _, col := range spec.Columns {
+		normalizeColumn(col, charset, collate, primaryKeyColumns[col.Name.Lowered()])
+	}
+	normalizeIndexes(spec)
+	normalizeConstraints(normalized.Table.Name.String(), spec)
+	return normalized, nil
+}
+
+// normalizeTableOptions lowercases option names and charset values
+func normalizeTableOptions(spec *sqlparser.TableSpec) {
+	for _, option := range spec.Options {
+		option.Name = strings.ToLower(option.Name)
+		switch option.Name {
+		case "charset", "collate":
+			option.String = strings.ToLower(option.String)
+		case "engine":
+			if engine, ok := engineNames[strings.ToLower(option.String)]; ok {
+				option.String = engine
+			}
+		}
+	}
+}
+
+// tableCharsetCollate returns the default charset and collation of the table, if given
+func tableCharsetCollate(spec *sqlparser.TableSpec) (charset, collate string) {
+	for _, option := range spec.Options {
+		switch option.Name {
+		case "charset":
+			charset = option.String
+		case "collate":
+			collate = option.String
+		}
+	}
+	return charset, collate
+}
+
+func normalizeColumn(col *sqlparser.ColumnDefinition, tableCharset, tableCollate string, isPrimaryKey bool) {
+	ct := &col.Type
+	ct.Type = strings.ToLower(ct.Type)
+	if alias, ok := typeAliases[ct.Type]; ok {
+		ct.Type = alias
+	}
+	switch {
+	case ct.Type == "bool" || ct.Type == "boolean":
+		ct.Type = "tinyint"
+		ct.Length = sqlparser.NewIntLiteral("1")
+	case integerTypes[ct.Type]:
+		if ct.Zerofill {
+			ct.Unsigned = true
+		} else if !(ct.Type == "tinyint" && ct.Length != nil && ct.Length.Val == "1") {
+			ct.Length = nil
+		}
+	case ct.Type == "decimal":
+		if ct.Length == nil {
+			ct.Length = sqlparser.NewIntLiteral("10")
+		}
+		if ct.Scale == nil {
+			ct.Scale = sqlparser.NewIntLiteral("0")
+		}
+	}
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+	if ct.Charset == tableCharset {
+		ct.Charset = ""
+	}
+	if ct.Collate == tableCollate {
+		ct.Collate = ""
+	}
+
+	if ct.Options == nil {
+		ct.Options = &sqlparser.ColumnTypeOptions{}
+	}
+	options := ct.Options
+	if options.Null != nil && *options.Null && ct.Type != "timestamp" {
+		// NULL is the default for all types but TIMESTAMP
+		options.Null = nil
+	}
+	if isPrimaryKey {
+		notNull := false
+		options.Null = &notNull
+	}
+	nullable := options.Null == nil || *options.Null
+	if _, ok := options.Default.(*sqlparser.NullVal); ok && nullable {
+		options.Default = nil
+	}
+	if literal, ok := options.Default.(*sqlparser.Literal); ok {
+		switch literal.Type {
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			// MySQL reports numeric defaults as strings
+			options.Default = sqlparser.NewStrLiteral(literal.Val)
+		}
+	}
+	options.Default = normalizeCurrentTimestamp(options.Default)
+	options.OnUpdate = normalizeCurrentTimestamp(options.OnUpdate)
+	if options.Comment != nil && options.Comment.Val == "" {
+		options.Comment = nil
+	}
+}
+
+// normalizeCurrentTimestamp replaces the synonyms of CURRENT_TIMESTAMP with CURRENT_TIMESTAMP itself
+func normalizeCurrentTimestamp(expr sqlparser.Expr) sqlparser.Expr {
+	switch expr := expr.(type) {
+	case *sqlparser.FuncExpr:
+		if currentTimestampSynonyms[expr.Name.Lowered()] && len(expr.Exprs) == 0 && expr.Qualifier.IsEmpty() {
+			return &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("current_timestamp")}
+		}
+	case *sqlparser.CurTimeFuncExpr:
+		if currentTimestampSynonyms[expr.Name.Lowered()] {
+			if literal, ok := expr.Fsp.(*sqlparser.Literal); ok && literal.Val == "0" {
+				return &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("current_timestamp")}
+			}
+			return &sqlparser.CurTimeFuncExpr{Name: sqlparser.NewColIdent("current_timestamp"), Fsp: expr.Fsp}
+		}
+	}
+	return expr
+}
+
+// normalizeIndexes names unnamed indexes and derives their type from their flags
+func normalizeIndexes(spec *sqlparser.TableSpec) {
+	names := map[string]bool{}
+	for _, index := range spec.Indexes {
+		if index.Info.Name.IsEmpty() && !index.Info.Primary {
+			index.Info.Name = index.Info.ConstraintName
+		}
+		index.Info.ConstraintName = sqlparser.ColIdent{}
+		if index.Info.Primary {
+			index.Info.Name = sqlparser.NewColIdent("PRIMARY")
+		}
+		if !index.Info.Name.IsEmpty() {
+			names[index.Info.Name.Lowered()] = true
+		}
+	}
+	for _, index := range spec.Indexes {
+		info := index.Info
+		switch {
+		case info.Primary:
+			info.Type = "primary key"
+		case info.Unique:
+			info.Type = "unique key"
+		case info.Fulltext:
+			info.Type = "fulltext key"
+		case info.Spatial:
+			info.Type = "spatial key"
+		default:
+			info.Type = "key"
+		}
+		if info.Name.IsEmpty() && len(index.Columns) > 0 {
+			info.Name = uniqueName(index.Columns[0].Column.String(), names)
+		}
+
+		var options []*sqlparser.IndexOption
+		for _, option := range index.Options {
+			option.Name = strings.ToLower(option.Name)
+			switch option.Name {
+			case "using":
+				option.String = strings.ToLower(option.String)
+				if option.String == "btree" {
+					// BTREE is the default
+					continue
+				}
+			case "comment":
+				if option.Value != nil && option.Value.Val == "" {
+					continue
+				}
+			}
+			options = append(options, option)
+		}
+		index.Options = options
+	}
+}
+
+// uniqueName returns the given name, or the first of name_2, name_3, ... that is not
+// in use, the way MySQL names unnamed indexes. The returned name is then marked as used.
+func uniqueName(name string, names map[string]bool) sqlparser.ColIdent {
+	candidate := name
+	for i := 2; names[strings.ToLower(candidate)]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[strings.ToLower(candidate)] = true
+	return sqlparser.NewColIdent(candidate)
+}
+
+// normalizeConstraints names unnamed foreign keys and check constraints, adds the index MySQL
+// implicitly creates for a foreign key, and resolves reference actions to their defaults.
+func normalizeConstraints(tableName string, spec *sqlparser.TableSpec) {
+	names := map[string]bool{}
+	for _, constraint := range spec.Constraints {
+		if !constraint.Name.IsEmpty() {
+			names[constraint.Name.Lowered()] = true
+		}
+	}
+	indexNames := map[string]bool{}
+	for _, index := range spec.Indexes {
+		indexNames[index.Info.Name.Lowered()] = true
+	}
+	fkCount, checkCount := 0, 0
+	nextName := func(suffix string, count *int) sqlparser.ColIdent {
+		for {
+			*count++
+			name := fmt.Sprintf("%s_%s_%d", tableName, suffix, *count)
+			if !names[strings.ToLower(name)] {
+				names[strings.ToLower(name)] = true
+				return sqlparser.NewColIdent(name)
+			}
+		}
+	}
+	for _, constraint := range spec.Constraints {
+		switch details := constraint.Details.(type) {
+		case *sqlparser.ForeignKeyDefinition:
+			explicitName := constraint.Name
+			if constraint.Name.IsEmpty() {
+				constraint.Name = nextName("ibfk", &fkCount)
+			}
+			details.OnDelete = normalizeReferenceAction(details.OnDelete)
+			details.OnUpdate = normalizeReferenceAction(details.OnUpdate)
+			if !hasIndexPrefix(spec.Indexes, details.Source) && len(details.Source) > 0 {
+				indexName := explicitName
+				if indexName.IsEmpty() || indexNames[indexName.Lowered()] {
+					indexName = uniqueName(details.Source[0].String(), indexNames)
+				} else {
+					indexNames[indexName.Lowered()] = true
+				}
+				index := &sqlparser.IndexDefinition{
+					Info: &sqlparser.IndexInfo{Type: "key", Name: indexName},
+				}
+				for _, col := range details.Source {
+					index.Columns = append(index.Columns, &sqlparser.IndexColumn{Column: col})
+				}
+				spec.Indexes = append(spec.Indexes, index)
+			}
+		case *sqlparser.CheckConstraintDefinition:
+			if constraint.Name.IsEmpty() {
+				constraint.Name = nextName("chk", &checkCount)
+			}
+		}
+	}
+}
+
+// normalizeReferenceAction maps RESTRICT and NO ACTION, which are the InnoDB default, to the default action
+func normalizeReferenceAction(action sqlparser.ReferenceAction) sqlparser.ReferenceAction {
+	switch action {
+	case sqlparser.Restrict, sqlparser.NoAction:
+		return sqlparser.DefaultAction
+	}
+	return action
+}
+
+// hasIndexPrefix returns true when some index starts with the given columns, in order
+func hasIndexPrefix(indexes []*sqlparser.IndexDefinition, columns sqlparser.Columns) bool {
+	for _, index := range indexes {
+		if len(index.Columns) < len(columns) {
+			continue
+		}
+		match := true
+		for i, col := range columns {
+			if !index.Columns[i].Column.Equal(col) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffTables returns the ALTER TABLE statement that turns the "from" table into the "to"
+// table, or nil if the two tables are equivalent. The ALTER TABLE statement applies to the
+// "from" table.
+func DiffTables(from, to *sqlparser.CreateTable, hints *DiffHints) (*sqlparser.AlterTable, error) {
+	if hints == nil {
+		hints = &DiffHints{}
+	}
+	from, err := NormalizeCreateTable(from)
+	if err != nil {
+		return nil, err
+	}
+	to, err = NormalizeCreateTable(to)
+	if err != nil {
+		return nil, err
+	}
+	fromCharset, fromCollate := tableCharsetCollate(from.TableSpec)
+	toCharset, toCollate := tableCharsetCollate(to.TableSpec)
+	if fromCharset != toCharset || fromCollate != toCollate {
+		// The table charset is only the default of new columns: text columns that
+		// follow it are modified to keep following it
+		qualifyColumnCharsets(from.TableSpec, fromCharset, fromCollate)
+		qualifyColumnCharsets(to.TableSpec, toCharset, toCollate)
+	}
+	alterTable := &sqlparser.AlterTable{
+		Table:       from.Table,
+		FullyParsed: true,
+	}
+
+	// Drops go first, then changes and additions
+	var drops, changes, additions []sqlparser.AlterOption
+	diffColumns(from.TableSpec, to.TableSpec, &drops, &changes)
+	diffIndexes(from.TableSpec, to.TableSpec, &drops, &additions)
+	diffConstraints(from.TableSpec, to.TableSpec, &drops, &additions)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, drops...)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, changes...)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, additions...)
+	if tableOptions := diffTableOptions(from.TableSpec, to.TableSpec, hints); len(tableOptions) > 0 {
+		alterTable.AlterOptions = append(alterTable.AlterOptions, tableOptions)
+	}
+	diffPartitions(from.TableSpec, to.TableSpec, alterTable)
+
+	if len(alterTable.AlterOptions) == 0 && alterTable.PartitionSpec == nil && alterTable.PartitionOption == nil {
+		return nil, nil
+	}
+	return alterTable, nil
+}
+
+// qualifyColumnCharsets gives the text columns that follow the table charset and
+// collation an explicit charset and collation
+func qualifyColumnCharsets(spec *sqlparser.TableSpec, tableCharset, tableCollate string) {
+	for _, col := range spec.Columns {
+		ct := &col.Type
+		if !textTypes[ct.Type] || ct.Charset != "" {
+			continue
+		}
+		ct.Charset = tableCharset
+		if ct.Collate == "" {
+			ct.Collate = tableCollate
+		}
+	}
+}
+
+// diffColumns drops columns missing from "to", and adds, modifies or repositions the
+// others so that the columns end up in the order of "to".
+func diffColumns(from, to *sqlparser.TableSpec, drops, changes *[]sqlparser.AlterOption) {
+	toColumns := map[string]*sqlparser.ColumnDefinition{}
+	for _, col := range to.Columns {
+		toColumns[col.Name.Lowered()] = col
+	}
+	fromColumns := map[string]*sqlparser.ColumnDefinition{}
+	// current holds the column names in the order the table has them as the ALTER proceeds
+	var current []string
+	for _, col := range from.Columns {
+		fromColumns[col.Name.Lowered()] = col
+		if _, ok := toColumns[col.Name.Lowered()]; !ok {
+			*drops = append(*drops, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: col.Name}})
+			continue
+		}
+		current = append(current, col.Name.Lowered())
+	}
+
+	indexOf := func(name string) int {
+		for i, n := range current {
+			if n == name {
+				return i
+			}
+		}
+		return -1
+	}
+	for i, col := range to.Columns {
+		name := col.Name.Lowered()
+		var first, after *sqlparser.ColName
+		if i == 0 {
+			first = &sqlparser.ColName{}
+		} else {
+			after = &sqlparser.ColName{Name: to.Columns[i-1].Name}
+		}
+		fromCol, ok := fromColumns[name]
+		if !ok {
+			*changes = append(*changes, &sqlparser.AddColumns{
+				Columns: []*sqlparser.ColumnDefinition{col},
+				First:   first,
+				After:   after,
+			})
+			current = append(current[:i], append([]string{name}, current[i:]...)...)
+			continue
+		}
+		moved := current[i] != name
+		if !moved && sqlparser.String(&fromCol.Type) == sqlparser.String(&col.Type) {
+			continue
+		}
+		modify := &sqlparser.ModifyColumn{NewColDefinition: col}
+		if moved {
+			modify.First, modify.After = first, after
+			j := indexOf(name)
+			current = append(current[:j], current[j+1:]...)
+			current = append(current[:i], append([]string{name}, current[i:]...)...)
+		}
+		*changes = append(*changes, modify)
+	}
+}
+
+// indexSignature returns the definition of an index without its name
+func indexSignature(index *sqlparser.IndexDefinition) string {
+	clone := sqlparser.CloneRefOfIndexDefinition(index)
+	clone.Info.Name = sqlparser.ColIdent{}
+	return sqlparser.String(clone)
+}
+
+// diffIndexes drops and adds indexes by name. An index that is only renamed is renamed.
+func diffIndexes(from, to *sqlparser.TableSpec, drops, additions *[]sqlparser.AlterOption) {
+	fromIndexes := map[string]*sqlparser.IndexDefinition{}
+	for _, index := range from.Indexes {
+		fromIndexes[index.Info.Name.Lowered()] = index
+	}
+	toIndexes := map[string]*sqlparser.IndexDefinition{}
+	for _, index := range to.Indexes {
+		toIndexes[index.Info.Name.Lowered()] = index
+	}
+
+	var dropped []*sqlparser.IndexDefinition
+	for _, index := range from.Indexes {
+		toIndex, ok := toIndexes[index.Info.Name.Lowered()]
+		if ok && sqlparser.EqualsRefOfIndexDefinition(index, toIndex) {
+			continue
+		}
+		dropped = append(dropped, index)
+	}
+	var added []*sqlparser.IndexDefinition
+	for _, index := range to.Indexes {
+		fromIndex, ok := fromIndexes[index.Info.Name.Lowered()]
+		if ok && sqlparser.EqualsRefOfIndexDefinition(fromIndex, index) {
+			continue
+		}
+		added = append(added, index)
+	}
+
+	renamed := map[*sqlparser.IndexDefinition]bool{}
+	for _, fromIndex := range dropped {
+		if fromIndex.Info.Primary {
+			continue
+		}
+		if _, ok := toIndexes[fromIndex.Info.Name.Lowered()]; ok {
+			continue
+		}
+		for _, toIndex := range added {
+			if renamed[toIndex] {
+				continue
+			}
+			if _, ok := fromIndexes[toIndex.Info.Name.Lowered()]; ok {
+				continue
+			}
+			if indexSignature(fromIndex) == indexSignature(toIndex) {
+				renamed[fromIndex] = true
+				renamed[toIndex] = true
+				*additions = append(*additions, &sqlparser.RenameIndex{OldName: fromIndex.Info.Name, NewName: toIndex.Info.Name})
+				break
+			}
+		}
+	}
+	for _, index := range dropped {
+		if renamed[index] {
+			continue
+		}
+		dropKey := &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: index.Info.Name}
+		if index.Info.Primary {
+			dropKey = &sqlparser.DropKey{Type: sqlparser.PrimaryKeyType}
+		}
+		*drops = append(*drops, dropKey)
+	}
+	for _, index := range added {
+		if renamed[index] {
+			continue
+		}
+		*additions = append(*additions, &sqlparser.AddIndexDefinition{IndexDefinition: index})
+	}
+}
+
+// diffConstraints drops and adds foreign keys and check constraints. A constraint that
+// changes is dropped and added again.
+func diffConstraints(from, to *sqlparser.TableSpec, drops, additions *[]sqlparser.AlterOption) {
+	fromConstraints := map[string]*sqlparser.ConstraintDefinition{}
+	for _, constraint := range from.Constraints {
+		fromConstraints[constraint.Name.Lowered()] = constraint
+	}
+	toConstraints := map[string]*sqlparser.ConstraintDefinition{}
+	for _, constraint := range to.Constraints {
+		toConstraints[constraint.Name.Lowered()] = constraint
+	}
+	for _, constraint := range from.Constraints {
+		toConstraint, ok := toConstraints[constraint.Name.Lowered()]
+		if ok && sqlparser.EqualsRefOfConstraintDefinition(constraint, toConstraint) {
+			continue
+		}
+		dropType := sqlparser.ForeignKeyType
+		if _, ok := constraint.Details.(*sqlparser.CheckConstraintDefinition); ok {
+			dropType = sqlparser.CheckKeyType
+		}
+		*drops = append(*drops, &sqlparser.DropKey{Type: dropType, Name: constraint.Name})
+	}
+	for _, constraint := range to.Constraints {
+		fromConstraint, ok := fromConstraints[constraint.Name.Lowered()]
+		if ok && sqlparser.EqualsRefOfConstraintDefinition(fromConstraint, constraint) {
+			continue
+		}
+		*additions = append(*additions, &sqlparser.AddConstraintDefinition{ConstraintDefinition: constraint})
+	}
+}
+
+// diffTableOptions returns the table options of "to" that differ from "from". Options
+// that only "from" has are reset to their defaults where they have one, and otherwise
+// left alone.
+func diffTableOptions(from, to *sqlparser.TableSpec, hints *DiffHints) sqlparser.TableOptions {
+	fromOptions := map[string]*sqlparser.TableOption{}
+	for _, option := range from.Options {
+		fromOptions[option.Name] = option
+	}
+	toOptions := map[string]*sqlparser.TableOption{}
+	for _, option := range to.Options {
+		toOptions[option.Name] = option
+	}
+
+	var options sqlparser.TableOptions
+	for _, option := range to.Options {
+		fromOption, ok := fromOptions[option.Name]
+		if ok && sqlparser.String(sqlparser.TableOptions{fromOption}) == sqlparser.String(sqlparser.TableOptions{option}) {
+			continue
+		}
+		if option.Name == "auto_increment" {
+			switch hints.AutoIncrementStrategy {
+			case AutoIncrementIgnore:
+				continue
+			case AutoIncrementApplyHigher:
+				if ok && optionIntValue(fromOption) >= optionIntValue(option) {
+					continue
+				}
+			}
+		}
+		options = append(options, option)
+	}
+	for _, fromOption := range from.Options {
+		if _, ok := toOptions[fromOption.Name]; ok {
+			continue
+		}
+		reset, ok := tableOptionDefaults[fromOption.Name]
+		if !ok || sqlparser.String(sqlparser.TableOptions{fromOption}) == sqlparser.String(sqlparser.TableOptions{reset}) {
+			continue
+		}
+		options = append(options, sqlparser.CloneRefOfTableOption(reset))
+	}
+	return options
+}
+
+// optionIntValue returns the integer value of a table option, or 0 if it has none
+func optionIntValue(option *sqlparser.TableOption) uint64 {
+	if option.Value == nil {
+		return 0
+	}
+	value, _ := strconv.ParseUint(option.Value.Val, 10, 64)
+	return value
+}
+
+// diffPartitions sets the partitioning changes on the ALTER TABLE. With RANGE or LIST
+// partitioning, dropping partitions, or adding one partition after the last one, is done
+// with a partition action; any other change repartitions the table.
+func diffPartitions(from, to *sqlparser.TableSpec, alterTable *sqlparser.AlterTable) {
+	if sqlparser.EqualsRefOfPartitionOption(from.PartitionOption, to.PartitionOption) {
+		return
+	}
+	if to.PartitionOption == nil {
+		alterTable.PartitionSpec = &sqlparser.PartitionSpec{Action: sqlparser.RemoveAction}
+		return
+	}
+	if from.PartitionOption == nil {
+		alterTable.PartitionOption = to.PartitionOption
+		return
+	}
+
+	// Compare the partitioning schemes, without the partition definitions
+	fromScheme := sqlparser.CloneRefOfPartitionOption(from.PartitionOption)
+	toScheme := sqlparser.CloneRefOfPartitionOption(to.PartitionOption)
+	fromScheme.Definitions, toScheme.Definitions = nil, nil
+	fromDefinitions := from.PartitionOption.Definitions
+	toDefinitions := to.PartitionOption.Definitions
+	if !sqlparser.EqualsRefOfPartitionOption(fromScheme, toScheme) || len(fromDefinitions) == 0 || len(toDefinitions) == 0 ||
+		(toScheme.Type != sqlparser.RangeType && toScheme.Type != sqlparser.ListType) {
+		// HASH and KEY partitions can't be dropped
+		alterTable.PartitionOption = to.PartitionOption
+		return
+	}
+
+	// Partitions that remain must be identical and keep their order
+	toDefinitionsMap := map[string]*sqlparser.PartitionDefinition{}
+	for _, definition := range toDefinitions {
+		toDefinitionsMap[definition.Name.Lowered()] = definition
+	}
+	var kept []*sqlparser.PartitionDefinition
+	var dropped sqlparser.Partitions
+	for _, definition := range fromDefinitions {
+		toDefinition, ok := toDefinitionsMap[definition.Name.Lowered()]
+		if !ok {
+			dropped = append(dropped, definition.Name)
+			continue
+		}
+		if !sqlparser.EqualsRefOfPartitionDefinition(definition, toDefinition) {
+			alterTable.PartitionOption = to.PartitionOption
+			return
+		}
+		kept = append(kept, definition)
+	}
+	for i, definition := range kept {
+		if !toDefinitions[i].Name.Equal(definition.Name) {
+			alterTable.PartitionOption = to.PartitionOption
+			return
+		}
+	}
+	switch len(toDefinitions) - len(kept) {
+	case 0:
+		alterTable.PartitionSpec = &sqlparser.PartitionSpec{Action: sqlparser.DropAction, Names: dropped}
+	case 1:
+		if len(dropped) == 0 {
+			alterTable.PartitionSpec = &sqlparser.PartitionSpec{Action: sqlparser.AddAction, Definitions: toDefinitions[len(kept):]}
+			return
+		}
+		fallthrough
+	default:
+		alterTable.PartitionOption = to.PartitionOption
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestDiffTables(t *testing.T) {
+	tcases := []struct {
+		name    string
+		from    string
+		to      string
+		autoinc AutoIncrementStrategy
+		diff    string
+		err     error
+	}{
+		{
+			name: "identical",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i int)",
+		},
+		{
+			name: "identical after normalization",
+			from: "create table t (id int(11) not null, i integer null default null, d decimal, b bool, ts timestamp default now(), primary key (id)) engine=innodb default charset=utf8mb4",
+			to:   "CREATE TABLE `t` (`id` INT PRIMARY KEY, `i` INT, `d` DECIMAL(10,0), `b` TINYINT(1), `ts` TIMESTAMP DEFAULT CURRENT_TIMESTAMP) ENGINE InnoDB CHARSET utf8mb4",
+		},
+		{
+			name: "different table names",
+			from: "create table t1 (id int primary key)",
+			to:   "create table t2 (id int primary key)",
+		},
+		{
+			name: "numeric default",
+			from: "create table t (id int primary key, i int default 0)",
+			to:   "create table t (id int primary key, i int default '0')",
+		},
+		{
+			name: "column charset same as table",
+			from: "create table t (id int primary key, s varchar(10) charset utf8mb4) charset utf8mb4",
+			to:   "create table t (id int primary key, s varchar(10)) charset utf8mb4",
+		},
+		{
+			name: "add column",
+			from: "create table t (id int primary key)",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t add column i int after id",
+		},
+		{
+			name: "add first column",
+			from: "create table t (id int primary key)",
+			to:   "create table t (i int, id int primary key)",
+			diff: "alter table t add column i int first",
+		},
+		{
+			name: "drop column",
+			from: "create table t (id int primary key, i int, j int)",
+			to:   "create table t (id int primary key, j int)",
+			diff: "alter table t drop column i",
+		},
+		{
+			name: "modify column",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i bigint not null default 1)",
+			diff: "alter table t modify column i bigint not null default '1'",
+		},
+		{
+			name: "reorder columns",
+			from: "create table t (id int primary key, i int, j int)",
+			to:   "create table t (id int primary key, j int, i int)",
+			diff: "alter table t modify column j int after id",
+		},
+		{
+			name: "move column first",
+			from: "create table t (id int primary key, i int, j int)",
+			to:   "create table t (j int, id int primary key, i int)",
+			diff: "alter table t modify column j int first",
+		},
+		{
+			name: "add, drop and modify",
+			from: "create table t (id int primary key, i int, j int)",
+			to:   "create table t (id int primary key, k int, j varchar(10))",
+			diff: "alter table t drop column i, add column k int after id, modify column j varchar(10)",
+		},
+		{
+			name: "add index",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i int, key i_idx (i))",
+			diff: "alter table t add key i_idx (i)",
+		},
+		{
+			name: "unnamed index",
+			from: "create table t (id int primary key, i int, key i (i))",
+			to:   "create table t (id int primary key, i int, index (i) using btree)",
+		},
+		{
+			name: "unnamed index collision",
+			from: "create table t (id int primary key, i int, key i (i), key i_2 (i, id))",
+			to:   "create table t (id int primary key, i int, key (i), key (i, id))",
+		},
+		{
+			name: "unique column option",
+			from: "create table t (id int primary key, i int unique)",
+			to:   "create table t (id int, i int, primary key (id), unique key i (i))",
+		},
+		{
+			name: "drop index",
+			from: "create table t (id int primary key, i int, key i_idx (i))",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t drop key i_idx",
+		},
+		{
+			name: "modify index",
+			from: "create table t (id int primary key, i int, key i_idx (i))",
+			to:   "create table t (id int primary key, i int, unique key i_idx (i, id))",
+			diff: "alter table t drop key i_idx, add unique key i_idx (i, id)",
+		},
+		{
+			name: "rename index",
+			from: "create table t (id int primary key, i int, key i_idx (i))",
+			to:   "create table t (id int primary key, i int, key i_index (i))",
+			diff: "alter table t rename index i_idx to i_index",
+		},
+		{
+			name: "change primary key",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int, i int, primary key (id, i))",
+			diff: "alter table t drop primary key, modify column i int not null, add primary key (id, i)",
+		},
+		{
+			name: "add foreign key",
+			from: "create table t (id int primary key, p int)",
+			to:   "create table t (id int primary key, p int, constraint fk_p foreign key (p) references parent (id) on delete cascade)",
+			diff: "alter table t add key fk_p (p), add constraint fk_p foreign key (p) references parent (id) on delete cascade",
+		},
+		{
+			name: "foreign key normalization",
+			from: "create table t (id int primary key, p int, key p (p), constraint t_ibfk_1 foreign key (p) references parent (id))",
+			to:   "create table t (id int primary key, p int, foreign key (p) references parent (id) on delete restrict on update no action)",
+		},
+		{
+			name: "modify foreign key",
+			from: "create table t (id int primary key, p int, key p (p), constraint fk foreign key (p) references parent (id))",
+			to:   "create table t (id int primary key, p int, key p (p), constraint fk foreign key (p) references parent (id) on delete set null)",
+			diff: "alter table t drop foreign key fk, add constraint fk foreign key (p) references parent (id) on delete set null",
+		},
+		{
+			name: "add check constraint",
+			from: "create table t (id int primary key, i int)",
+			to:   "create table t (id int primary key, i int, check (i > 0))",
+			diff: "alter table t add constraint t_chk_1 check (i > 0)",
+		},
+		{
+			name: "drop check constraint",
+			from: "create table t (id int primary key, i int, check (i > 0))",
+			to:   "create table t (id int primary key, i int)",
+			diff: "alter table t drop check t_chk_1",
+		},
+		{
+			name: "modify check constraint",
+			from: "create table t (id int primary key, i int, constraint ck check (i > 0))",
+			to:   "create table t (id int primary key, i int, constraint ck check (i >= 0))",
+			diff: "alter table t drop check ck, add constraint ck check (i >= 0)",
+		},
+		{
+			name: "table options",
+			from: "create table t (id int primary key) engine=myisam comment='old'",
+			to:   "create table t (id int primary key) engine=InnoDB",
+			diff: "alter table t engine InnoDB comment ''",
+		},
+		{
+			name: "removed table options",
+			from: "create table t (id int primary key) engine=InnoDB row_format=compressed key_block_size=8 stats_persistent=1",
+			to:   "create table t (id int primary key) engine=InnoDB",
+			diff: "alter table t row_format default key_block_size 0 stats_persistent default",
+		},
+		{
+			name: "table charset",
+			from: "create table t (id int primary key, s varchar(10), l varchar(10) charset latin1, t text) charset utf8mb3",
+			to:   "create table t (id int primary key, s varchar(10), l varchar(10) charset latin1, t text) charset utf8mb4",
+			diff: "alter table t modify column s varchar(10) character set utf8mb4, modify column t text character set utf8mb4, charset utf8mb4",
+		},
+		{
+			name: "auto_increment ignored",
+			from: "create table t (id int primary key) auto_increment=100",
+			to:   "create table t (id int primary key) auto_increment=200",
+		},
+		{
+			name:    "auto_increment higher",
+			from:    "create table t (id int primary key) auto_increment=100",
+			to:      "create table t (id int primary key) auto_increment=200",
+			autoinc: AutoIncrementApplyHigher,
+			diff:    "alter table t auto_increment 200",
+		},
+		{
+			name:    "auto_increment lower",
+			from:    "create table t (id int primary key) auto_increment=300",
+			to:      "create table t (id int primary key) auto_increment=200",
+			autoinc: AutoIncrementApplyHigher,
+		},
+		{
+			name:    "auto_increment always",
+			from:    "create table t (id int primary key) auto_increment=300",
+			to:      "create table t (id int primary key) auto_increment=200",
+			autoinc: AutoIncrementApplyAlways,
+			diff:    "alter table t auto_increment 200",
+		},
+		{
+			name: "add partitioning",
+			from: "create table t (id int primary key)",
+			to:   "create table t (id int primary key) partition by hash (id) partitions 4",
+			diff: "alter table t partition by hash (id) partitions 4",
+		},
+		{
+			name: "remove partitioning",
+			from: "create table t (id int primary key) partition by hash (id) partitions 4",
+			to:   "create table t (id int primary key)",
+			diff: "alter table t remove partitioning",
+		},
+		{
+			name: "add partition",
+			from: "create table t (id int primary key) partition by range (id) (partition p1 values less than (10))",
+			to:   "create table t (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20))",
+			diff: "alter table t add partition (partition p2 values less than (20))",
+		},
+		{
+			name: "drop partitions",
+			from: "create table t (id int primary key) partition by range (id) (partition p1 values less than (10), partition p2 values less than (20), partition p3 values less than (30))",
+			to:   "create table t (id int primary key) partition by range (id) (partition p2 values less than (20))",
+			diff: "alter table t drop partition p1, p3",
+		},
+		{
+			name: "drop list partition",
+			from: "create table t (id int primary key) partition by list (id) (partition p1 values in (1, 2), partition p2 values in (3, 4))",
+			to:   "create table t (id int primary key) partition by list (id) (partition p2 values in (3, 4))",
+			diff: "alter table t drop partition p1",
+		},
+		{
+			name: "drop hash partition",
+			from: "create table t (id int primary key) partition by hash (id) (partition p1, partition p2)",
+			to:   "create table t (id int primary key) partition by hash (id) (partition p1)",
+			diff: "alter table t partition by hash (id) (\n\tpartition p1\n)",
+		},
+		{
+			name: "repartition",
+			from: "create table t (id int primary key) partition by hash (id) partitions 4",
+			to:   "create table t (id int primary key) partition by hash (id) partitions 8",
+			diff: "alter table t partition by hash (id) partitions 8",
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			alterTable, err := DiffCreateTablesQueries(tcase.from, tcase.to, &DiffHints{AutoIncrementStrategy: tcase.autoinc})
+			if tcase.err != nil {
+				assert.True(t, errors.Is(err, tcase.err), "expected %v, got %v", tcase.err, err)
+				return
+			}
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				if alterTable != nil {
+					t.Errorf("unexpected diff: %s", sqlparser.String(alterTable))
+				}
+				return
+			}
+			require.NotNil(t, alterTable)
+			assert.Equal(t, tcase.diff, sqlparser.String(alterTable))
+			// The diff must be parseable
+			_, err = sqlparser.ParseStrictDDL(sqlparser.String(alterTable))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNormalizeCreateTable(t *testing.T) {
+	createTable, err := ParseCreateTable("create table t (id int(11) unsigned primary key, i integer null, b boolean, d numeric(8), ts timestamp default now() on update localtimestamp, c char(2) collate utf8mb4_bin comment '', p int, foreign key (p) references parent (id)) ENGINE=innodb COLLATE utf8mb4_bin")
+	require.NoError(t, err)
+	original := sqlparser.String(createTable)
+	normalized, err := NormalizeCreateTable(createTable)
+	require.NoError(t, err)
+	assert.Equal(t, original, sqlparser.String(createTable))
+	expected := "create table t (\n" +
+		"\tid int unsigned not null,\n" +
+		"\ti int,\n" +
+		"\tb tinyint(1),\n" +
+		"\td decimal(8,0),\n" +
+		"\tts timestamp default current_timestamp() on update current_timestamp(),\n" +
+		"\tc char(2),\n" +
+		"\tp int,\n" +
+		"\tprimary key (id),\n" +
+		"\tkey p (p),\n" +
+		"\tconstraint t_ibfk_1 foreign key (p) references parent (id)\n" +
+		") engine InnoDB,\n  collate utf8mb4_bin"
+	assert.Equal(t, expected, sqlparser.String(normalized))
+
+	createTable, err = ParseCreateTable("create table t like t2")
+	require.NoError(t, err)
+	_, err = NormalizeCreateTable(createTable)
+	assert.True(t, errors.Is(err, ErrCreateTableLike))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// NormalizeCreateView returns a copy of the given CREATE VIEW statement in the
+// canonical form schemadiff compares: clauses that state the default are removed.
+// The given statement is not modified.
+func NormalizeCreateView(createView *sqlparser.CreateView) *sqlparser.CreateView {
+	normalized := sqlparser.CloneRefOfCreateView(createView)
+	normalized.IsReplace = false
+	normalized.Algorithm = strings.ToLower(normalized.Algorithm)
+	if normalized.Algorithm == "undefined" {
+		normalized.Algorithm = ""
+	}
+	normalized.Security = strings.ToLower(normalized.Security)
+	if normalized.Security == "definer" {
+		normalized.Security = ""
+	}
+	switch strings.ToLower(normalized.Definer) {
+	case "current_user", "current_user()":
+		normalized.Definer = ""
+	}
+	normalized.CheckOption = strings.ToLower(normalized.CheckOption)
+	return normalized
+}
+
+// DiffViews returns the ALTER VIEW statement that turns the "from" view into the "to"
+// view, or nil if the two views are equivalent. The ALTER VIEW statement applies to the
+// "from" view.
+func DiffViews(from, to *sqlparser.CreateView) (*sqlparser.AlterView, error) {
+	from = NormalizeCreateView(from)
+	to = NormalizeCreateView(to)
+	to.ViewName = from.ViewName
+	if sqlparser.String(from) == sqlparser.String(to) {
+		return nil, nil
+	}
+	return &sqlparser.AlterView{
+		ViewName:    from.ViewName,
+		Algorithm:   to.Algorithm,
+		Definer:     to.Definer,
+		Security:    to.Security,
+		Columns:     to.Columns,
+		Select:      to.Select,
+		CheckOption: to.CheckOption,
+	}, nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestDiffViews(t *testing.T) {
+	tcases := []struct {
+		name string
+		from string
+		to   string
+		diff string
+	}{
+		{
+			name: "identical",
+			from: "create view v as select id from t",
+			to:   "create view v as select id from t",
+		},
+		{
+			name: "identical after normalization",
+			from: "create algorithm = undefined definer = current_user sql security definer view v as select id from t",
+			to:   "create or replace view v as SELECT `id` FROM `t`",
+		},
+		{
+			name: "different select",
+			from: "create view v as select id from t",
+			to:   "create view v as select id, i from t where i > 0",
+			diff: "alter view v as select id, i from t where i > 0",
+		},
+		{
+			name: "different options",
+			from: "create view v as select id from t",
+			to:   "create algorithm = merge sql security invoker view v (a) as select id from t with check option",
+			diff: "alter algorithm = merge sql security invoker view v(a) as select id from t with cascaded check option",
+		},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			alterView, err := DiffCreateViewsQueries(tcase.from, tcase.to)
+			require.NoError(t, err)
+			if tcase.diff == "" {
+				if alterView != nil {
+					t.Errorf("unexpected diff: %s", sqlparser.String(alterView))
+				}
+				return
+			}
+			require.NotNil(t, alterView)
+			assert.Equal(t, tcase.diff, sqlparser.String(alterView))
+		})
+	}
+
+	_, err := DiffCreateViewsQueries("create table t (id int)", "create view v as select 1")
+	assert.Equal(t, ErrNotCreateView, err)
+}
@@ -18,15 +18,18 @@ package schemamanager
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -228,10 +231,67 @@ func (exec *TabletExecutor) preflightSchemaChanges(ctx context.Context, sqls []s
 	if exec.skipPreflight {
 		return nil
 	}
+	if exec.ddlStrategySetting != nil && exec.ddlStrategySetting.IsDeclarative() {
+		diffs, err := exec.declarativeDiffs(ctx, sqls)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			exec.wr.Logger().Infof("declarative: %s", diff)
+		}
+	}
 	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
 	return err
 }
 
+// declarativeDiffs describes what each declarative CREATE TABLE statement translates to,
+// given the schema of the first shard: a new table, no change, or an ALTER TABLE statement.
+// The tablets compute their own diff when they run the migration.
+func (exec *TabletExecutor) declarativeDiffs(ctx context.Context, sqls []string) ([]string, error) {
+	dbSchema, err := exec.wr.TabletManagerClient().GetSchema(
+		ctx, exec.tablets[0], []string{}, []string{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database schema, error: %v", err)
+	}
+	existingSchemas := make(map[string]string, len(dbSchema.TableDefinitions))
+	for _, tableSchema := range dbSchema.TableDefinitions {
+		existingSchemas[tableSchema.Name] = tableSchema.Schema
+	}
+	hints := &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementApplyHigher}
+
+	var diffs []string
+	for _, sql := range sqls {
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+		}
+		createTable, ok := stmt.(*sqlparser.CreateTable)
+		if !ok {
+			continue
+		}
+		tableName := createTable.Table.Name.String()
+		existingSchema, ok := existingSchemas[tableName]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("table %s will be created", tableName))
+			continue
+		}
+		existingCreateTable, err := schemadiff.ParseCreateTable(existingSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schema of table %s: %v", tableName, err)
+		}
+		alterTable, err := schemadiff.DiffTables(existingCreateTable, createTable, hints)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff table %s: %v", tableName, err)
+		}
+		if alterTable == nil {
+			diffs = append(diffs, fmt.Sprintf("table %s has no change", tableName))
+			continue
+		}
+		diffs = append(diffs, sqlparser.String(alterTable))
+	}
+	return diffs, nil
+}
+
 // executeSQL executes a single SQL statement either as online DDL or synchronously on all tablets.
 // In online DDL case, the query may be exploded into multiple queries during
 func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResult *ExecuteResult) error {
@@ -248,7 +308,10 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 				return err
 			}
 			for _, onlineDDL := range onlineDDLs {
-				if exec.ddlStrategySetting.IsSkipTopo() {
+				if exec.ddlStrategySetting.IsDryRun() {
+					exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
+					exec.printDryRunReports(execResult)
+				} else if exec.ddlStrategySetting.IsSkipTopo() {
 					exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
 					if len(execResult.SuccessShards) > 0 {
 						exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
@@ -266,7 +329,10 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 			execResult.ExecutorErr = err.Error()
 			return err
 		}
-		if exec.ddlStrategySetting.IsSkipTopo() {
+		if exec.ddlStrategySetting.IsDryRun() {
+			exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
+			exec.printDryRunReports(execResult)
+		} else if exec.ddlStrategySetting.IsSkipTopo() {
 			exec.executeOnAllTablets(ctx, execResult, onlineDDL.SQL, true)
 			exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
 		} else {
@@ -347,6 +413,21 @@ func (exec *TabletExecutor) executeOnlineDDL(
 	exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
 }
 
+// printDryRunReports prints the pre-flight reports returned by the shards on a dry run migration,
+// one line per shard
+func (exec *TabletExecutor) printDryRunReports(execResult *ExecuteResult) {
+	for _, shardResult := range execResult.SuccessShards {
+		qr := sqltypes.Proto3ToResult(shardResult.Result)
+		for _, row := range qr.Rows {
+			values := make([]string, 0, len(row))
+			for i, value := range row {
+				values = append(values, fmt.Sprintf("%s=%s", qr.Fields[i].Name, value.ToString()))
+			}
+			exec.wr.Logger().Printf("%s\n", strings.Join(values, " "))
+		}
+	}
+}
+
 // executeOnAllTablets runs a query on all tablets, synchronously. This can be a long running operation.
 func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string, viaQueryService bool) {
 	var wg sync.WaitGroup
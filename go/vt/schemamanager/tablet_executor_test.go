@@ -299,3 +299,41 @@ func TestIsOnlineSchemaDDL(t *testing.T) {
 		}
 	}
 }
+
+func TestTabletExecutorDeclarativeDiffs(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "t1",
+				Schema: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Type:   tmutils.TableBaseTable,
+			},
+			{
+				Name:   "t2",
+				Schema: "CREATE TABLE `t2` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor("TestTabletExecutorDeclarativeDiffs", wr, testWaitReplicasTimeout)
+	ctx := context.Background()
+	err := executor.SetDDLStrategy("online -declarative")
+	assert.NoError(t, err)
+	err = executor.Open(ctx, "test_keyspace")
+	assert.NoError(t, err)
+	defer executor.Close()
+
+	diffs, err := executor.declarativeDiffs(ctx, []string{
+		"create table t1 (id int primary key, i int)",
+		"create table t2 (id int(11) primary key)",
+		"create table t3 (id int primary key)",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"alter table t1 add column i int after id",
+		"table t2 has no change",
+		"table t3 will be created",
+	}, diffs)
+}
@@ -99,7 +99,8 @@ type (
 		IndexDefinition *IndexDefinition
 	}
 
-	// AddColumns represents a ADD COLUMN alter option
+	// AddColumns represents a ADD COLUMN alter option.
+	// For a FIRST clause without a column, First is a ColName with an empty name.
 	AddColumns struct {
 		Columns []*ColumnDefinition
 		First   *ColName
@@ -422,17 +423,19 @@ type (
 
 	// AlterMigration represents a ALTER VITESS_MIGRATION statement
 	AlterMigration struct {
-		Type AlterMigrationType
-		UUID string
+		Type     AlterMigrationType
+		UUID     string
+		Priority *Literal
 	}
 
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
-		Table         TableName
-		AlterOptions  []AlterOption
-		PartitionSpec *PartitionSpec
-		Comments      Comments
-		FullyParsed   bool
+		Table           TableName
+		AlterOptions    []AlterOption
+		PartitionSpec   *PartitionSpec
+		PartitionOption *PartitionOption
+		Comments        Comments
+		FullyParsed     bool
 	}
 
 	// DropTable represents a DROP TABLE statement.
@@ -448,6 +451,7 @@ type (
 	DropView struct {
 		FromTables TableNames
 		IfExists   bool
+		Comments   Comments
 	}
 
 	// CreateTable represents a CREATE TABLE statement.
@@ -470,6 +474,7 @@ type (
 		Columns     Columns
 		Select      SelectStatement
 		CheckOption string
+		Comments    Comments
 		IsReplace   bool
 	}
 
@@ -482,6 +487,7 @@ type (
 		Columns     Columns
 		Select      SelectStatement
 		CheckOption string
+		Comments    Comments
 	}
 
 	// DDLAction is an enum for DDL.Action
@@ -1090,7 +1096,7 @@ func (node *CreateTable) SetComments(comments Comments) {
 
 // SetComments implements DDLStatement.
 func (node *CreateView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments implements DDLStatement.
@@ -1100,12 +1106,12 @@ func (node *DropTable) SetComments(comments Comments) {
 
 // SetComments implements DDLStatement.
 func (node *DropView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments implements DDLStatement.
 func (node *AlterView) SetComments(comments Comments) {
-	// irrelevant
+	node.Comments = comments
 }
 
 // SetComments for RevertMigration, does not implement DDLStatement
@@ -1137,8 +1143,7 @@ func (node *CreateTable) GetComments() Comments {
 
 // GetComments implements DDLStatement.
 func (node *CreateView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetComments implements DDLStatement.
@@ -1148,14 +1153,12 @@ func (node *DropTable) GetComments() Comments {
 
 // GetComments implements DDLStatement.
 func (node *DropView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetComments implements DDLStatement.
 func (node *AlterView) GetComments() Comments {
-	// irrelevant
-	return nil
+	return node.Comments
 }
 
 // GetToTables implements the DDLStatement interface
@@ -1415,17 +1418,35 @@ type PartitionDefinition struct {
 	Name     ColIdent
 	Limit    Expr
 	Maxvalue bool
+	InValues ValTuple
+	Engine   string
 }
 
+// PartitionOption describes the partitioning of a table, as given by the
+// PARTITION BY clause of a CREATE TABLE or ALTER TABLE statement
+type PartitionOption struct {
+	Linear       bool
+	Type         PartitionByType
+	KeyAlgorithm *Literal
+	ColList      Columns
+	Expr         Expr
+	Partitions   *Literal
+	Definitions  []*PartitionDefinition
+}
+
+// PartitionByType is an enum for PartitionOption.Type
+type PartitionByType int8
+
 // TableOptions specifies a list of table options
 type TableOptions []*TableOption
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns     []*ColumnDefinition
-	Indexes     []*IndexDefinition
-	Constraints []*ConstraintDefinition
-	Options     TableOptions
+	Columns         []*ColumnDefinition
+	Indexes         []*IndexDefinition
+	Constraints     []*ConstraintDefinition
+	Options         TableOptions
+	PartitionOption *PartitionOption
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -205,6 +205,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
 		return CloneRefOfPartitionDefinition(in)
+	case *PartitionOption:
+		return CloneRefOfPartitionOption(in)
 	case *PartitionSpec:
 		return CloneRefOfPartitionSpec(in)
 	case Partitions:
@@ -414,6 +416,7 @@ func CloneRefOfAlterMigration(n *AlterMigration) *AlterMigration {
 		return nil
 	}
 	out := *n
+	out.Priority = CloneRefOfLiteral(n.Priority)
 	return &out
 }
 
@@ -426,6 +429,7 @@ func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	out.Table = CloneTableName(n.Table)
 	out.AlterOptions = CloneSliceOfAlterOption(n.AlterOptions)
 	out.PartitionSpec = CloneRefOfPartitionSpec(n.PartitionSpec)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
 	out.Comments = CloneComments(n.Comments)
 	return &out
 }
@@ -439,6 +443,7 @@ func CloneRefOfAlterView(n *AlterView) *AlterView {
 	out.ViewName = CloneTableName(n.ViewName)
 	out.Columns = CloneColumns(n.Columns)
 	out.Select = CloneSelectStatement(n.Select)
+	out.Comments = CloneComments(n.Comments)
 	return &out
 }
 
@@ -589,6 +594,9 @@ func CloneRefOfColumnType(n *ColumnType) *ColumnType {
 
 // CloneColumns creates a deep clone of the input.
 func CloneColumns(n Columns) Columns {
+	if n == nil {
+		return nil
+	}
 	res := make(Columns, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -598,7 +606,10 @@ func CloneColumns(n Columns) Columns {
 
 // CloneComments creates a deep clone of the input.
 func CloneComments(n Comments) Comments {
-	res := make(Comments, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make(Comments, len(n))
 	copy(res, n)
 	return res
 }
@@ -701,6 +712,7 @@ func CloneRefOfCreateView(n *CreateView) *CreateView {
 	out.ViewName = CloneTableName(n.ViewName)
 	out.Columns = CloneColumns(n.Columns)
 	out.Select = CloneSelectStatement(n.Select)
+	out.Comments = CloneComments(n.Comments)
 	return &out
 }
 
@@ -799,6 +811,7 @@ func CloneRefOfDropView(n *DropView) *DropView {
 	}
 	out := *n
 	out.FromTables = CloneTableNames(n.FromTables)
+	out.Comments = CloneComments(n.Comments)
 	return &out
 }
 
@@ -834,6 +847,9 @@ func CloneRefOfExplainTab(n *ExplainTab) *ExplainTab {
 
 // CloneExprs creates a deep clone of the input.
 func CloneExprs(n Exprs) Exprs {
+	if n == nil {
+		return nil
+	}
 	res := make(Exprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -887,6 +903,9 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 
 // CloneGroupBy creates a deep clone of the input.
 func CloneGroupBy(n GroupBy) GroupBy {
+	if n == nil {
+		return nil
+	}
 	res := make(GroupBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1102,6 +1121,9 @@ func CloneRefOfNullVal(n *NullVal) *NullVal {
 
 // CloneOnDup creates a deep clone of the input.
 func CloneOnDup(n OnDup) OnDup {
+	if n == nil {
+		return nil
+	}
 	res := make(OnDup, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1142,6 +1164,9 @@ func CloneRefOfOrder(n *Order) *Order {
 
 // CloneOrderBy creates a deep clone of the input.
 func CloneOrderBy(n OrderBy) OrderBy {
+	if n == nil {
+		return nil
+	}
 	res := make(OrderBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfOrder(x))
@@ -1205,6 +1230,21 @@ func CloneRefOfPartitionDefinition(n *PartitionDefinition) *PartitionDefinition
 	out := *n
 	out.Name = CloneColIdent(n.Name)
 	out.Limit = CloneExpr(n.Limit)
+	out.InValues = CloneValTuple(n.InValues)
+	return &out
+}
+
+// CloneRefOfPartitionOption creates a deep clone of the input.
+func CloneRefOfPartitionOption(n *PartitionOption) *PartitionOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.KeyAlgorithm = CloneRefOfLiteral(n.KeyAlgorithm)
+	out.ColList = CloneColumns(n.ColList)
+	out.Expr = CloneExpr(n.Expr)
+	out.Partitions = CloneRefOfLiteral(n.Partitions)
+	out.Definitions = CloneSliceOfRefOfPartitionDefinition(n.Definitions)
 	return &out
 }
 
@@ -1223,6 +1263,9 @@ func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
 
 // ClonePartitions creates a deep clone of the input.
 func ClonePartitions(n Partitions) Partitions {
+	if n == nil {
+		return nil
+	}
 	res := make(Partitions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -1343,6 +1386,9 @@ func CloneRefOfSelect(n *Select) *Select {
 
 // CloneSelectExprs creates a deep clone of the input.
 func CloneSelectExprs(n SelectExprs) SelectExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SelectExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneSelectExpr(x))
@@ -1383,6 +1429,9 @@ func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
 
 // CloneSetExprs creates a deep clone of the input.
 func CloneSetExprs(n SetExprs) SetExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SetExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfSetExpr(x))
@@ -1504,6 +1553,9 @@ func CloneRefOfSubstrExpr(n *SubstrExpr) *SubstrExpr {
 
 // CloneTableExprs creates a deep clone of the input.
 func CloneTableExprs(n TableExprs) TableExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(TableExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableExpr(x))
@@ -1523,6 +1575,9 @@ func CloneTableName(n TableName) TableName {
 
 // CloneTableNames creates a deep clone of the input.
 func CloneTableNames(n TableNames) TableNames {
+	if n == nil {
+		return nil
+	}
 	res := make(TableNames, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableName(x))
@@ -1532,6 +1587,9 @@ func CloneTableNames(n TableNames) TableNames {
 
 // CloneTableOptions creates a deep clone of the input.
 func CloneTableOptions(n TableOptions) TableOptions {
+	if n == nil {
+		return nil
+	}
 	res := make(TableOptions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableOption(x))
@@ -1549,6 +1607,7 @@ func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
 	out.Indexes = CloneSliceOfRefOfIndexDefinition(n.Indexes)
 	out.Constraints = CloneSliceOfRefOfConstraintDefinition(n.Constraints)
 	out.Options = CloneTableOptions(n.Options)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
 	return &out
 }
 
@@ -1652,6 +1711,9 @@ func CloneRefOfUpdateExpr(n *UpdateExpr) *UpdateExpr {
 
 // CloneUpdateExprs creates a deep clone of the input.
 func CloneUpdateExprs(n UpdateExprs) UpdateExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(UpdateExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1685,6 +1747,9 @@ func CloneRefOfVStream(n *VStream) *VStream {
 
 // CloneValTuple creates a deep clone of the input.
 func CloneValTuple(n ValTuple) ValTuple {
+	if n == nil {
+		return nil
+	}
 	res := make(ValTuple, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1703,6 +1768,9 @@ func CloneRefOfValidation(n *Validation) *Validation {
 
 // CloneValues creates a deep clone of the input.
 func CloneValues(n Values) Values {
+	if n == nil {
+		return nil
+	}
 	res := make(Values, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneValTuple(x))
@@ -2209,6 +2277,9 @@ func CloneTableExpr(in TableExpr) TableExpr {
 
 // CloneSliceOfRefOfColumnDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ColumnDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfColumnDefinition(x))
@@ -2218,6 +2289,9 @@ func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinitio
 
 // CloneSliceOfCollateAndCharset creates a deep clone of the input.
 func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
+	if n == nil {
+		return nil
+	}
 	res := make([]CollateAndCharset, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCollateAndCharset(x))
@@ -2227,6 +2301,9 @@ func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
 
 // CloneSliceOfAlterOption creates a deep clone of the input.
 func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]AlterOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneAlterOption(x))
@@ -2236,6 +2313,9 @@ func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 
 // CloneSliceOfColIdent creates a deep clone of the input.
 func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
+	if n == nil {
+		return nil
+	}
 	res := make([]ColIdent, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -2245,6 +2325,9 @@ func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
 
 // CloneSliceOfRefOfWhen creates a deep clone of the input.
 func CloneSliceOfRefOfWhen(n []*When) []*When {
+	if n == nil {
+		return nil
+	}
 	res := make([]*When, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfWhen(x))
@@ -2281,13 +2364,19 @@ func CloneRefOfColumnTypeOptions(n *ColumnTypeOptions) *ColumnTypeOptions {
 
 // CloneSliceOfString creates a deep clone of the input.
 func CloneSliceOfString(n []string) []string {
-	res := make([]string, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
 
 // CloneSliceOfRefOfIndexColumn creates a deep clone of the input.
 func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexColumn, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexColumn(x))
@@ -2297,6 +2386,9 @@ func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
 
 // CloneSliceOfRefOfIndexOption creates a deep clone of the input.
 func CloneSliceOfRefOfIndexOption(n []*IndexOption) []*IndexOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexOption(x))
@@ -2317,6 +2409,9 @@ func CloneRefOfJoinCondition(n *JoinCondition) *JoinCondition {
 
 // CloneTableAndLockTypes creates a deep clone of the input.
 func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
+	if n == nil {
+		return nil
+	}
 	res := make(TableAndLockTypes, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableAndLockType(x))
@@ -2326,6 +2421,9 @@ func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
 
 // CloneSliceOfRefOfPartitionDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*PartitionDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*PartitionDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfPartitionDefinition(x))
@@ -2335,6 +2433,9 @@ func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*Partition
 
 // CloneSliceOfRefOfRenameTablePair creates a deep clone of the input.
 func CloneSliceOfRefOfRenameTablePair(n []*RenameTablePair) []*RenameTablePair {
+	if n == nil {
+		return nil
+	}
 	res := make([]*RenameTablePair, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfRenameTablePair(x))
@@ -2353,6 +2454,9 @@ func CloneRefOfBool(n *bool) *bool {
 
 // CloneSliceOfCharacteristic creates a deep clone of the input.
 func CloneSliceOfCharacteristic(n []Characteristic) []Characteristic {
+	if n == nil {
+		return nil
+	}
 	res := make([]Characteristic, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCharacteristic(x))
@@ -2403,6 +2507,9 @@ func CloneRefOfTableOption(n *TableOption) *TableOption {
 
 // CloneSliceOfRefOfIndexDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexDefinition(x))
@@ -2412,6 +2519,9 @@ func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
 
 // CloneSliceOfRefOfConstraintDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*ConstraintDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ConstraintDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfConstraintDefinition(x))
@@ -2421,6 +2531,9 @@ func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*Constra
 
 // CloneSliceOfRefOfUnionSelect creates a deep clone of the input.
 func CloneSliceOfRefOfUnionSelect(n []*UnionSelect) []*UnionSelect {
+	if n == nil {
+		return nil
+	}
 	res := make([]*UnionSelect, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUnionSelect(x))
@@ -2440,6 +2553,9 @@ func CloneRefOfVindexParam(n *VindexParam) *VindexParam {
 
 // CloneSliceOfVindexParam creates a deep clone of the input.
 func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
+	if n == nil {
+		return nil
+	}
 	res := make([]VindexParam, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneVindexParam(x))
@@ -233,8 +233,13 @@ func (node *AlterMigration) Format(buf *TrackedBuffer) {
 		alterType = "cancel"
 	case CancelAllMigrationType:
 		alterType = "cancel all"
+	case PriorityMigrationType:
+		alterType = "priority"
 	}
 	buf.astPrintf(node, " %s", alterType)
+	if node.Priority != nil {
+		buf.astPrintf(node, " %v", node.Priority)
+	}
 }
 
 // Format formats the node.
@@ -384,10 +389,57 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 
 // Format formats the node
 func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
-	if !node.Maxvalue {
-		buf.astPrintf(node, "partition %v values less than (%v)", node.Name, node.Limit)
-	} else {
+	switch {
+	case node.Maxvalue:
 		buf.astPrintf(node, "partition %v values less than (maxvalue)", node.Name)
+	case node.Limit != nil:
+		if limits, ok := node.Limit.(ValTuple); ok {
+			buf.astPrintf(node, "partition %v values less than %v", node.Name, limits)
+		} else {
+			buf.astPrintf(node, "partition %v values less than (%v)", node.Name, node.Limit)
+		}
+	case node.InValues != nil:
+		buf.astPrintf(node, "partition %v values in %v", node.Name, node.InValues)
+	default:
+		buf.astPrintf(node, "partition %v", node.Name)
+	}
+	if node.Engine != "" {
+		buf.astPrintf(node, " engine %s", node.Engine)
+	}
+}
+
+// Format formats the node
+func (node *PartitionOption) Format(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	buf.WriteString(node.Type.ToString())
+	if node.KeyAlgorithm != nil {
+		buf.astPrintf(node, " algorithm = %v", node.KeyAlgorithm)
+	}
+	switch {
+	case node.Expr != nil:
+		buf.astPrintf(node, " (%v)", node.Expr)
+	case len(node.ColList) == 0:
+		buf.WriteString(" ()")
+	case node.Type == KeyType:
+		buf.astPrintf(node, " %v", node.ColList)
+	default:
+		buf.astPrintf(node, " columns %v", node.ColList)
+	}
+	if node.Partitions != nil {
+		buf.astPrintf(node, " partitions %v", node.Partitions)
+	}
+	if len(node.Definitions) > 0 {
+		buf.WriteString(" (")
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(",")
+			}
+			buf.astPrintf(node, "\n\t%v", pd)
+		}
+		buf.WriteString("\n)")
 	}
 }
 
@@ -422,6 +474,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 			buf.astPrintf(ts, " (%v)", opt.Tables)
 		}
 	}
+	if ts.PartitionOption != nil {
+		buf.astPrintf(ts, "\n%v", ts.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -1395,20 +1450,20 @@ func (node *CreateTable) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *CreateView) Format(buf *TrackedBuffer) {
-	buf.WriteString("create")
+	buf.astPrintf(node, "create %v", node.Comments)
 	if node.IsReplace {
-		buf.WriteString(" or replace")
+		buf.WriteString("or replace ")
 	}
 	if node.Algorithm != "" {
-		buf.astPrintf(node, " algorithm = %s", node.Algorithm)
+		buf.astPrintf(node, "algorithm = %s ", node.Algorithm)
 	}
 	if node.Definer != "" {
-		buf.astPrintf(node, " definer = %s", node.Definer)
+		buf.astPrintf(node, "definer = %s ", node.Definer)
 	}
 	if node.Security != "" {
-		buf.astPrintf(node, " sql security %s", node.Security)
+		buf.astPrintf(node, "sql security %s ", node.Security)
 	}
-	buf.astPrintf(node, " view %v", node.ViewName)
+	buf.astPrintf(node, "view %v", node.ViewName)
 	buf.astPrintf(node, "%v as %v", node.Columns, node.Select)
 	if node.CheckOption != "" {
 		buf.astPrintf(node, " with %s check option", node.CheckOption)
@@ -1430,17 +1485,17 @@ func (node *UnlockTables) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *AlterView) Format(buf *TrackedBuffer) {
-	buf.WriteString("alter")
+	buf.astPrintf(node, "alter %v", node.Comments)
 	if node.Algorithm != "" {
-		buf.astPrintf(node, " algorithm = %s", node.Algorithm)
+		buf.astPrintf(node, "algorithm = %s ", node.Algorithm)
 	}
 	if node.Definer != "" {
-		buf.astPrintf(node, " definer = %s", node.Definer)
+		buf.astPrintf(node, "definer = %s ", node.Definer)
 	}
 	if node.Security != "" {
-		buf.astPrintf(node, " sql security %s", node.Security)
+		buf.astPrintf(node, "sql security %s ", node.Security)
 	}
-	buf.astPrintf(node, " view %v", node.ViewName)
+	buf.astPrintf(node, "view %v", node.ViewName)
 	buf.astPrintf(node, "%v as %v", node.Columns, node.Select)
 	if node.CheckOption != "" {
 		buf.astPrintf(node, " with %s check option", node.CheckOption)
@@ -1466,7 +1521,7 @@ func (node *DropView) Format(buf *TrackedBuffer) {
 	if node.IfExists {
 		exists = " if exists"
 	}
-	buf.astPrintf(node, "drop view%s %v", exists, node.FromTables)
+	buf.astPrintf(node, "drop %vview%s %v", node.Comments, exists, node.FromTables)
 }
 
 // Format formats the AlterTable node.
@@ -1485,6 +1540,9 @@ func (node *AlterTable) Format(buf *TrackedBuffer) {
 	if node.PartitionSpec != nil {
 		buf.astPrintf(node, "%s %v", prefix, node.PartitionSpec)
 	}
+	if node.PartitionOption != nil {
+		buf.astPrintf(node, " %v", node.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -1503,7 +1561,10 @@ func (node *AddColumns) Format(buf *TrackedBuffer) {
 	if len(node.Columns) == 1 {
 		buf.astPrintf(node, "add column %v", node.Columns[0])
 		if node.First != nil {
-			buf.astPrintf(node, " first %v", node.First)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.astPrintf(node, " %v", node.First)
+			}
 		}
 		if node.After != nil {
 			buf.astPrintf(node, " after %v", node.After)
@@ -1539,7 +1600,10 @@ func (node *AlterColumn) Format(buf *TrackedBuffer) {
 func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "change column %v %v", node.OldColumn, node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
@@ -1550,7 +1614,10 @@ func (node *ChangeColumn) Format(buf *TrackedBuffer) {
 func (node *ModifyColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "modify column %v", node.NewColDefinition)
 	if node.First != nil {
-		buf.astPrintf(node, " first %v", node.First)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.astPrintf(node, " %v", node.First)
+		}
 	}
 	if node.After != nil {
 		buf.astPrintf(node, " after %v", node.After)
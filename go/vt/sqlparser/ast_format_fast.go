@@ -362,9 +362,15 @@ func (node *AlterMigration) formatFast(buf *TrackedBuffer) {
 		alterType = "cancel"
 	case CancelAllMigrationType:
 		alterType = "cancel all"
+	case PriorityMigrationType:
+		alterType = "priority"
 	}
 	buf.WriteByte(' ')
 	buf.WriteString(alterType)
+	if node.Priority != nil {
+		buf.WriteByte(' ')
+		node.Priority.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -546,16 +552,78 @@ func (node *PartitionSpec) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node
 func (node *PartitionDefinition) formatFast(buf *TrackedBuffer) {
-	if !node.Maxvalue {
+	switch {
+	case node.Maxvalue:
 		buf.WriteString("partition ")
 		node.Name.formatFast(buf)
-		buf.WriteString(" values less than (")
-		node.Limit.formatFast(buf)
-		buf.WriteByte(')')
-	} else {
+		buf.WriteString(" values less than (maxvalue)")
+	case node.Limit != nil:
+		if limits, ok := node.Limit.(ValTuple); ok {
+			buf.WriteString("partition ")
+			node.Name.formatFast(buf)
+			buf.WriteString(" values less than ")
+			limits.formatFast(buf)
+		} else {
+			buf.WriteString("partition ")
+			node.Name.formatFast(buf)
+			buf.WriteString(" values less than (")
+			node.Limit.formatFast(buf)
+			buf.WriteByte(')')
+		}
+	case node.InValues != nil:
+		buf.WriteString("partition ")
+		node.Name.formatFast(buf)
+		buf.WriteString(" values in ")
+		node.InValues.formatFast(buf)
+	default:
 		buf.WriteString("partition ")
 		node.Name.formatFast(buf)
-		buf.WriteString(" values less than (maxvalue)")
+	}
+	if node.Engine != "" {
+		buf.WriteString(" engine ")
+		buf.WriteString(node.Engine)
+	}
+}
+
+// formatFast formats the node
+func (node *PartitionOption) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	buf.WriteString(node.Type.ToString())
+	if node.KeyAlgorithm != nil {
+		buf.WriteString(" algorithm = ")
+		node.KeyAlgorithm.formatFast(buf)
+	}
+	switch {
+	case node.Expr != nil:
+		buf.WriteString(" (")
+		node.Expr.formatFast(buf)
+		buf.WriteByte(')')
+	case len(node.ColList) == 0:
+		buf.WriteString(" ()")
+	case node.Type == KeyType:
+		buf.WriteByte(' ')
+		node.ColList.formatFast(buf)
+	default:
+		buf.WriteString(" columns ")
+		node.ColList.formatFast(buf)
+	}
+	if node.Partitions != nil {
+		buf.WriteString(" partitions ")
+		node.Partitions.formatFast(buf)
+	}
+	if len(node.Definitions) > 0 {
+		buf.WriteString(" (")
+		for i, pd := range node.Definitions {
+			if i != 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n\t")
+			pd.formatFast(buf)
+		}
+		buf.WriteString("\n)")
 	}
 }
 
@@ -599,6 +667,10 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 			buf.WriteByte(')')
 		}
 	}
+	if ts.PartitionOption != nil {
+		buf.WriteByte('\n')
+		ts.PartitionOption.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -1841,23 +1913,27 @@ func (node *CreateTable) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *CreateView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("create")
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
 	if node.IsReplace {
-		buf.WriteString(" or replace")
+		buf.WriteString("or replace ")
 	}
 	if node.Algorithm != "" {
-		buf.WriteString(" algorithm = ")
+		buf.WriteString("algorithm = ")
 		buf.WriteString(node.Algorithm)
+		buf.WriteByte(' ')
 	}
 	if node.Definer != "" {
-		buf.WriteString(" definer = ")
+		buf.WriteString("definer = ")
 		buf.WriteString(node.Definer)
+		buf.WriteByte(' ')
 	}
 	if node.Security != "" {
-		buf.WriteString(" sql security ")
+		buf.WriteString("sql security ")
 		buf.WriteString(node.Security)
+		buf.WriteByte(' ')
 	}
-	buf.WriteString(" view ")
+	buf.WriteString("view ")
 	node.ViewName.formatFast(buf)
 	node.Columns.formatFast(buf)
 	buf.WriteString(" as ")
@@ -1890,20 +1966,24 @@ func (node *UnlockTables) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *AlterView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter")
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
 	if node.Algorithm != "" {
-		buf.WriteString(" algorithm = ")
+		buf.WriteString("algorithm = ")
 		buf.WriteString(node.Algorithm)
+		buf.WriteByte(' ')
 	}
 	if node.Definer != "" {
-		buf.WriteString(" definer = ")
+		buf.WriteString("definer = ")
 		buf.WriteString(node.Definer)
+		buf.WriteByte(' ')
 	}
 	if node.Security != "" {
-		buf.WriteString(" sql security ")
+		buf.WriteString("sql security ")
 		buf.WriteString(node.Security)
+		buf.WriteByte(' ')
 	}
-	buf.WriteString(" view ")
+	buf.WriteString("view ")
 	node.ViewName.formatFast(buf)
 	node.Columns.formatFast(buf)
 	buf.WriteString(" as ")
@@ -1940,7 +2020,9 @@ func (node *DropView) formatFast(buf *TrackedBuffer) {
 	if node.IfExists {
 		exists = " if exists"
 	}
-	buf.WriteString("drop view")
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("view")
 	buf.WriteString(exists)
 	buf.WriteByte(' ')
 	node.FromTables.formatFast(buf)
@@ -1968,6 +2050,10 @@ func (node *AlterTable) formatFast(buf *TrackedBuffer) {
 		buf.WriteByte(' ')
 		node.PartitionSpec.formatFast(buf)
 	}
+	if node.PartitionOption != nil {
+		buf.WriteByte(' ')
+		node.PartitionOption.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -1989,8 +2075,11 @@ func (node *AddColumns) formatFast(buf *TrackedBuffer) {
 		buf.WriteString("add column ")
 		node.Columns[0].formatFast(buf)
 		if node.First != nil {
-			buf.WriteString(" first ")
-			node.First.formatFast(buf)
+			buf.WriteString(" first")
+			if !node.First.Name.IsEmpty() {
+				buf.WriteByte(' ')
+				node.First.formatFast(buf)
+			}
 		}
 		if node.After != nil {
 			buf.WriteString(" after ")
@@ -2038,8 +2127,11 @@ func (node *ChangeColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(' ')
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
@@ -2052,8 +2144,11 @@ func (node *ModifyColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("modify column ")
 	node.NewColDefinition.formatFast(buf)
 	if node.First != nil {
-		buf.WriteString(" first ")
-		node.First.formatFast(buf)
+		buf.WriteString(" first")
+		if !node.First.Name.IsEmpty() {
+			buf.WriteByte(' ')
+			node.First.formatFast(buf)
+		}
 	}
 	if node.After != nil {
 		buf.WriteString(" after ")
@@ -101,6 +101,34 @@ const (
 	colKey
 )
 
+// ExtractKeyOptionIndex removes the key option of a column, as in
+// `id int primary key`, and returns the equivalent index, or nil if the
+// column has no key option. Like in MySQL, a KEY option defines the
+// primary key, and the other indexes are named after the column.
+func (col *ColumnDefinition) ExtractKeyOptionIndex() *IndexDefinition {
+	if col.Type.Options == nil {
+		return nil
+	}
+	var info *IndexInfo
+	switch col.Type.Options.KeyOpt {
+	case colKeyPrimary, colKey:
+		info = &IndexInfo{Type: "primary key", Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+	case colKeyUnique, colKeyUniqueKey:
+		info = &IndexInfo{Type: "unique key", Name: col.Name, Unique: true}
+	case colKeySpatialKey:
+		info = &IndexInfo{Type: "spatial key", Name: col.Name, Spatial: true}
+	case colKeyFulltextKey:
+		info = &IndexInfo{Type: "fulltext key", Name: col.Name, Fulltext: true}
+	default:
+		return nil
+	}
+	col.Type.Options.KeyOpt = colKeyNone
+	return &IndexDefinition{
+		Info:    info,
+		Columns: []*IndexColumn{{Column: col.Name}},
+	}
+}
+
 // ReferenceAction indicates the action takes by a referential constraint e.g.
 // the `CASCADE` in a `FOREIGN KEY .. ON DELETE CASCADE` table definition.
 type ReferenceAction int
@@ -1276,11 +1304,29 @@ func (key DropKeyType) ToString() string {
 		return ForeignKeyTypeStr
 	case NormalKeyType:
 		return NormalKeyTypeStr
+	case CheckKeyType:
+		return CheckKeyTypeStr
 	default:
 		return "Unknown DropKeyType"
 	}
 }
 
+// ToString returns the PartitionByType as a string
+func (partitionType PartitionByType) ToString() string {
+	switch partitionType {
+	case HashType:
+		return HashTypeStr
+	case KeyType:
+		return KeyTypeStr
+	case RangeType:
+		return RangeTypeStr
+	case ListType:
+		return ListTypeStr
+	default:
+		return "Unknown PartitionByType"
+	}
+}
+
 // ToString returns the LockOptionType as a string
 func (lock LockOptionType) ToString() string {
 	switch lock {
@@ -204,6 +204,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfParenTableExpr(in, f)
 	case *PartitionDefinition:
 		return VisitRefOfPartitionDefinition(in, f)
+	case *PartitionOption:
+		return VisitRefOfPartitionOption(in, f)
 	case *PartitionSpec:
 		return VisitRefOfPartitionSpec(in, f)
 	case Partitions:
@@ -442,6 +444,9 @@ func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitRefOfLiteral(in.Priority, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
@@ -462,6 +467,9 @@ func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	if err := VisitRefOfPartitionSpec(in.PartitionSpec, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfPartitionOption(in.PartitionOption, f); err != nil {
+		return err
+	}
 	if err := VisitComments(in.Comments, f); err != nil {
 		return err
 	}
@@ -483,6 +491,9 @@ func VisitRefOfAlterView(in *AlterView, f Visit) error {
 	if err := VisitSelectStatement(in.Select, f); err != nil {
 		return err
 	}
+	if err := VisitComments(in.Comments, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfAlterVschema(in *AlterVschema, f Visit) error {
@@ -844,6 +855,9 @@ func VisitRefOfCreateView(in *CreateView, f Visit) error {
 	if err := VisitSelectStatement(in.Select, f); err != nil {
 		return err
 	}
+	if err := VisitComments(in.Comments, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfCurTimeFuncExpr(in *CurTimeFuncExpr, f Visit) error {
@@ -976,6 +990,9 @@ func VisitRefOfDropView(in *DropView, f Visit) error {
 	if err := VisitTableNames(in.FromTables, f); err != nil {
 		return err
 	}
+	if err := VisitComments(in.Comments, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfExistsExpr(in *ExistsExpr, f Visit) error {
@@ -1505,6 +1522,35 @@ func VisitRefOfPartitionDefinition(in *PartitionDefinition, f Visit) error {
 	if err := VisitExpr(in.Limit, f); err != nil {
 		return err
 	}
+	if err := VisitValTuple(in.InValues, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfPartitionOption(in *PartitionOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.KeyAlgorithm, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.ColList, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.Partitions, f); err != nil {
+		return err
+	}
+	for _, el := range in.Definitions {
+		if err := VisitRefOfPartitionDefinition(el, f); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 func VisitRefOfPartitionSpec(in *PartitionSpec, f Visit) error {
@@ -1988,6 +2034,9 @@ func VisitRefOfTableSpec(in *TableSpec, f Visit) error {
 	if err := VisitTableOptions(in.Options, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfPartitionOption(in.PartitionOption, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfTablespaceOperation(in *TablespaceOperation, f Visit) error {
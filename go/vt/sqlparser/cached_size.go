@@ -162,10 +162,12 @@ func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(32)
 	}
 	// field UUID string
 	size += int64(len(cached.UUID))
+	// field Priority *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Priority.CachedSize(true)
 	return size
 }
 func (cached *AlterTable) CachedSize(alloc bool) int64 {
@@ -174,7 +176,7 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(89)
+		size += int64(97)
 	}
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
@@ -189,6 +191,8 @@ func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	}
 	// field PartitionSpec *vitess.io/vitess/go/vt/sqlparser.PartitionSpec
 	size += cached.PartitionSpec.CachedSize(true)
+	// field PartitionOption *vitess.io/vitess/go/vt/sqlparser.PartitionOption
+	size += cached.PartitionOption.CachedSize(true)
 	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
 	{
 		size += int64(cap(cached.Comments)) * int64(16)
@@ -204,7 +208,7 @@ func (cached *AlterView) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(160)
 	}
 	// field ViewName vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.ViewName.CachedSize(false)
@@ -227,6 +231,13 @@ func (cached *AlterView) CachedSize(alloc bool) int64 {
 	}
 	// field CheckOption string
 	size += int64(len(cached.CheckOption))
+	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
+	{
+		size += int64(cap(cached.Comments)) * int64(16)
+		for _, elem := range cached.Comments {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *AlterVschema) CachedSize(alloc bool) int64 {
@@ -675,7 +686,7 @@ func (cached *CreateView) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(137)
+		size += int64(161)
 	}
 	// field ViewName vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.ViewName.CachedSize(false)
@@ -698,6 +709,13 @@ func (cached *CreateView) CachedSize(alloc bool) int64 {
 	}
 	// field CheckOption string
 	size += int64(len(cached.CheckOption))
+	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
+	{
+		size += int64(cap(cached.Comments)) * int64(16)
+		for _, elem := range cached.Comments {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *CurTimeFuncExpr) CachedSize(alloc bool) int64 {
@@ -866,7 +884,7 @@ func (cached *DropView) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(25)
+		size += int64(49)
 	}
 	// field FromTables vitess.io/vitess/go/vt/sqlparser.TableNames
 	{
@@ -875,6 +893,13 @@ func (cached *DropView) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
+	{
+		size += int64(cap(cached.Comments)) * int64(16)
+		for _, elem := range cached.Comments {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *ExistsExpr) CachedSize(alloc bool) int64 {
@@ -1474,7 +1499,7 @@ func (cached *PartitionDefinition) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(57)
+		size += int64(104)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -1482,6 +1507,49 @@ func (cached *PartitionDefinition) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Limit.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field InValues vitess.io/vitess/go/vt/sqlparser.ValTuple
+	{
+		size += int64(cap(cached.InValues)) * int64(16)
+		for _, elem := range cached.InValues {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Engine string
+	size += int64(len(cached.Engine))
+	return size
+}
+func (cached *PartitionOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(88)
+	}
+	// field KeyAlgorithm *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.KeyAlgorithm.CachedSize(true)
+	// field ColList vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.ColList)) * int64(40)
+		for _, elem := range cached.ColList {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Partitions *vitess.io/vitess/go/vt/sqlparser.Literal
+	size += cached.Partitions.CachedSize(true)
+	// field Definitions []*vitess.io/vitess/go/vt/sqlparser.PartitionDefinition
+	{
+		size += int64(cap(cached.Definitions)) * int64(8)
+		for _, elem := range cached.Definitions {
+			size += elem.CachedSize(true)
+		}
+	}
 	return size
 }
 func (cached *PartitionSpec) CachedSize(alloc bool) int64 {
@@ -2037,7 +2105,7 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(104)
 	}
 	// field Columns []*vitess.io/vitess/go/vt/sqlparser.ColumnDefinition
 	{
@@ -2067,6 +2135,8 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field PartitionOption *vitess.io/vitess/go/vt/sqlparser.PartitionOption
+	size += cached.PartitionOption.CachedSize(true)
 	return size
 }
 func (cached *TablespaceOperation) CachedSize(alloc bool) int64 {
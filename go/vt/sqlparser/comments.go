@@ -17,6 +17,8 @@ limitations under the License.
 package sqlparser
 
 import (
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -347,3 +349,123 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// ParseQueryTags returns the tags carried by the given margin comments.
+// Tags use the sqlcommenter format: a comma separated list of key='value'
+// pairs in a single comment, with URL encoded keys and values, e.g.
+// /*application='shop',request_id='a%2Fb'*/. Comments that are not in
+// this format are ignored. If a key appears more than once, the last
+// value wins. Returns nil if no tags are found.
+func ParseQueryTags(comments MarginComments) map[string]string {
+	var tags map[string]string
+	for _, text := range []string{comments.Leading, comments.Trailing} {
+		for {
+			start := strings.Index(text, "/*")
+			if start < 0 {
+				break
+			}
+			end := strings.Index(text[start+2:], "*/")
+			if end < 0 {
+				break
+			}
+			body := text[start+2 : start+2+end]
+			text = text[start+2+end+2:]
+			parsed, ok := parseQueryTagComment(body)
+			if !ok {
+				continue
+			}
+			if tags == nil {
+				tags = make(map[string]string, len(parsed))
+			}
+			for k, v := range parsed {
+				tags[k] = v
+			}
+		}
+	}
+	return tags
+}
+
+// parseQueryTagComment parses the body of a single sqlcommenter comment.
+// It returns false if the body is not a well formed list of tags.
+func parseQueryTagComment(body string) (map[string]string, bool) {
+	body = strings.TrimSpace(body)
+	if body == "" || body[0] == '!' {
+		return nil, false
+	}
+	tags := make(map[string]string)
+	for body != "" {
+		eq := strings.IndexByte(body, '=')
+		if eq <= 0 || eq+1 >= len(body) || body[eq+1] != '\'' {
+			return nil, false
+		}
+		key, err := url.PathUnescape(strings.TrimSpace(body[:eq]))
+		if err != nil || key == "" || strings.ContainsAny(key, " \t\n'") {
+			return nil, false
+		}
+		rest := body[eq+2:]
+		var value strings.Builder
+		closed := false
+		i := 0
+		for ; i < len(rest); i++ {
+			switch {
+			case rest[i] == '\\' && i+1 < len(rest):
+				i++
+				value.WriteByte(rest[i])
+			case rest[i] == '\'':
+				closed = true
+			default:
+				value.WriteByte(rest[i])
+			}
+			if closed {
+				break
+			}
+		}
+		if !closed {
+			return nil, false
+		}
+		unescaped, err := url.PathUnescape(value.String())
+		if err != nil {
+			return nil, false
+		}
+		tags[key] = unescaped
+		body = strings.TrimSpace(rest[i+1:])
+		if body == "" {
+			break
+		}
+		if body[0] != ',' {
+			return nil, false
+		}
+		body = strings.TrimSpace(body[1:])
+		if body == "" {
+			return nil, false
+		}
+	}
+	return tags, true
+}
+
+// FormatQueryTags returns the tags as a sqlcommenter comment, with keys
+// sorted and keys and values URL encoded. It returns an empty string if
+// there are no tags.
+func FormatQueryTags(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf strings.Builder
+	buf.WriteString("/*")
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(url.PathEscape(k))
+		buf.WriteString("='")
+		buf.WriteString(url.PathEscape(tags[k]))
+		buf.WriteByte('\'')
+	}
+	buf.WriteString("*/")
+	return buf.String()
+}
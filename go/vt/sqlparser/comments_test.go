@@ -469,3 +469,52 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestParseQueryTags(t *testing.T) {
+	testCases := []struct {
+		sql  string
+		want map[string]string
+	}{{
+		sql:  "select 1",
+		want: nil,
+	}, {
+		sql:  "/* just a comment */ select 1",
+		want: nil,
+	}, {
+		sql:  "select 1 /*application='shop',request_id='a%2Fb'*/",
+		want: map[string]string{"application": "shop", "request_id": "a/b"},
+	}, {
+		sql:  "/*route='%2Fcart', action='checkout'*/ select 1",
+		want: map[string]string{"route": "/cart", "action": "checkout"},
+	}, {
+		sql:  "/*a='1'*/ /* not tags */ select 1 /*b='2',a='3'*/",
+		want: map[string]string{"a": "3", "b": "2"},
+	}, {
+		sql:  "/*a='it\\'s'*/ select 1",
+		want: map[string]string{"a": "it's"},
+	}, {
+		sql:  "/*a='1',*/ select 1",
+		want: nil,
+	}, {
+		sql:  "/*a=1*/ select 1",
+		want: nil,
+	}, {
+		sql:  "/*!40000 a='1'*/ select 1",
+		want: nil,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			_, comments := SplitMarginComments(tc.sql)
+			assert.Equal(t, tc.want, ParseQueryTags(comments))
+		})
+	}
+}
+
+func TestFormatQueryTags(t *testing.T) {
+	assert.Equal(t, "", FormatQueryTags(nil))
+
+	tags := map[string]string{"route": "/cart */ drop", "application": "shop's"}
+	formatted := FormatQueryTags(tags)
+	assert.Equal(t, "/*application='shop%27s',route='%2Fcart%20%2A%2F%20drop'*/", formatted)
+	assert.Equal(t, tags, ParseQueryTags(MarginComments{Leading: formatted}))
+}
@@ -244,6 +244,7 @@ const (
 	// DropKeyType strings
 	PrimaryKeyTypeStr = "primary key"
 	ForeignKeyTypeStr = "foreign key"
+	CheckKeyTypeStr   = "check"
 	NormalKeyTypeStr  = "key"
 
 	// LockOptionType strings
@@ -251,6 +252,12 @@ const (
 	SharedTypeStr    = "shared"
 	DefaultTypeStr   = "default"
 	ExclusiveTypeStr = "exclusive"
+
+	// PartitionByType strings
+	HashTypeStr  = "hash"
+	KeyTypeStr   = "key"
+	RangeTypeStr = "range"
+	ListTypeStr  = "list"
 )
 
 // Constants for Enum type - AccessMode
@@ -435,6 +442,14 @@ const (
 	UpgradeAction
 )
 
+// Constant for Enum Type - PartitionByType
+const (
+	HashType PartitionByType = iota
+	KeyType
+	RangeType
+	ListType
+)
+
 // Constant for Enum Type - ExplainType
 const (
 	EmptyType ExplainType = iota
@@ -506,6 +521,7 @@ const (
 	PrimaryKeyType DropKeyType = iota
 	ForeignKeyType
 	NormalKeyType
+	CheckKeyType
 )
 
 // LockOptionType constants
@@ -522,4 +538,5 @@ const (
 	CompleteMigrationType
 	CancelMigrationType
 	CancelAllMigrationType
+	PriorityMigrationType
 )
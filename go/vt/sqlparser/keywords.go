@@ -263,9 +263,10 @@ var keywords = []keyword{
 	{"level", LEVEL},
 	{"like", LIKE},
 	{"limit", LIMIT},
-	{"linear", UNUSED},
+	{"linear", LINEAR},
 	{"lines", LINES},
 	{"linestring", LINESTRING},
+	{"list", LIST},
 	{"load", LOAD},
 	{"local", LOCAL},
 	{"localtime", LOCALTIME},
@@ -328,6 +329,7 @@ var keywords = []keyword{
 	{"parser", PARSER},
 	{"partition", PARTITION},
 	{"partitioning", PARTITIONING},
+	{"partitions", PARTITIONS},
 	{"password", PASSWORD},
 	{"plugins", PLUGINS},
 	{"point", POINT},
@@ -338,7 +340,7 @@ var keywords = []keyword{
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
+	{"range", RANGE},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
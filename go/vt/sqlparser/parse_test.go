@@ -926,6 +926,11 @@ var (
 	}, {
 		input:  "alter table a add foo int first v",
 		output: "alter table a add column foo int first v",
+	}, {
+		input:  "alter table a add foo int first",
+		output: "alter table a add column foo int first",
+	}, {
+		input: "alter table a modify column foo int default 1 first, change column bar baz int first",
 	}, {
 		input:  "alter table a lock default, lock = none, lock shared, lock exclusive",
 		output: "alter table a lock default, lock none, lock shared, lock exclusive",
@@ -1019,7 +1024,15 @@ var (
 		input: "alter table a upgrade partitioning",
 	}, {
 		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output: "alter table a",
+		output: "alter table a partition by range (id) (\n\tpartition p0 values less than (10),\n\tpartition p1 values less than (maxvalue)\n)",
+	}, {
+		input: "alter table a partition by hash (id) partitions 4",
+	}, {
+		input:  "alter table a engine = innodb partition by linear key algorithm = 2 (id, name) partitions 8",
+		output: "alter table a engine innodb partition by linear key algorithm = 2 (id, `name`) partitions 8",
+	}, {
+		input:  "alter table a engine innodb partition by key () partitions 2",
+		output: "alter table a engine innodb partition by key () partitions 2",
 	}, {
 		input: "alter table `Post With Space` drop foreign key `Post With Space_ibfk_1`",
 	}, {
@@ -1065,8 +1078,7 @@ var (
 	}, {
 		input: "alter table a add check (ch_1) not enforced",
 	}, {
-		input:  "alter table a drop check ch_1",
-		output: "alter table a",
+		input: "alter table a drop check ch_1",
 	}, {
 		input: "alter table a drop foreign key kx",
 	}, {
@@ -1154,6 +1166,20 @@ var (
 		output: "create table a (\n\tb1 bool not null primary key,\n\tb2 boolean not null,\n\tKEY b2_idx (b)\n)",
 	}, {
 		input: "create temporary table a (\n\tid bigint\n)",
+	}, {
+		input: "create table a (\n\tid int,\n\tpurchased date\n) engine InnoDB\npartition by range (year(purchased)) (\n\tpartition p0 values less than (1990),\n\tpartition p1 values less than (maxvalue)\n)",
+	}, {
+		input:  "CREATE TABLE a (id int, name varchar(10)) ENGINE=InnoDB /*!50100 PARTITION BY LIST COLUMNS(name) (PARTITION p0 VALUES IN ('a','b') ENGINE = InnoDB, PARTITION p1 VALUES IN ('c') ENGINE = InnoDB) */",
+		output: "create table a (\n\tid int,\n\t`name` varchar(10)\n) ENGINE InnoDB\npartition by list columns (`name`) (\n\tpartition p0 values in ('a', 'b') engine InnoDB,\n\tpartition p1 values in ('c') engine InnoDB\n)",
+	}, {
+		input:  "create table a (id int) partition by linear hash (id % 10) partitions 10",
+		output: "create table a (\n\tid int\n)\npartition by linear hash (id % 10) partitions 10",
+	}, {
+		input:  "create table a (id int, c int) partition by range columns (id, c) (partition p0 values less than (10, 20), partition p1 values less than (maxvalue))",
+		output: "create table a (\n\tid int,\n\tc int\n)\npartition by range columns (id, c) (\n\tpartition p0 values less than (10, 20),\n\tpartition p1 values less than (maxvalue)\n)",
+	}, {
+		input:  "create table a (id int) partition by key (id) partitions 2 (partition p0, partition p1)",
+		output: "create table a (\n\tid int\n)\npartition by key (id) partitions 2 (\n\tpartition p0,\n\tpartition p1\n)",
 	}, {
 		input:  "CREATE TABLE pkai (id INT PRIMARY KEY AUTO_INCREMENT);",
 		output: "create table pkai (\n\tid INT auto_increment primary key\n)",
@@ -1281,6 +1307,10 @@ var (
 		input: "alter view a as select * from t",
 	}, {
 		input: "alter algorithm = merge definer = m@172.0.1.01 sql security definer view a as select * from t with local check option",
+	}, {
+		input: "create /*vt+ strategy=online */ or replace view a as select * from t",
+	}, {
+		input: "alter /*vt+ strategy=online */ view a as select * from t",
 	}, {
 		input:  "rename table a to b",
 		output: "rename table a to b",
@@ -1307,6 +1337,8 @@ var (
 	}, {
 		input:  "drop view if exists a cascade",
 		output: "drop view if exists a",
+	}, {
+		input: "drop /*vt+ strategy=online */ view if exists a",
 	}, {
 		input:  "drop index b on a lock = none algorithm default",
 		output: "alter table a drop key b, lock none, algorithm = default",
@@ -1575,6 +1607,15 @@ var (
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' complete",
 	}, {
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' cancel",
+	}, {
+		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' priority 5",
+	}, {
+		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' priority -5",
+	}, {
+		input:  "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' PRIORITY 5",
+		output: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' priority 5",
+	}, {
+		input: "select priority from t where priority = 1",
 	}, {
 		input: "alter vitess_migration cancel all",
 	}, {
@@ -3089,6 +3130,9 @@ var (
 	}, {
 		input:  "select next id from a",
 		output: "expecting value after next at position 15 near 'id'",
+	}, {
+		input:  "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' urgency 5",
+		output: "expecting priority at position 72 near '5'",
 	}, {
 		input:  "select next 1+1 values from a",
 		output: "syntax error at position 15",
@@ -114,392 +114,397 @@ const CROSS = 57411
 const NATURAL = 57412
 const USE = 57413
 const FORCE = 57414
-const ON = 57415
-const USING = 57416
-const INPLACE = 57417
-const COPY = 57418
-const ALGORITHM = 57419
-const NONE = 57420
-const SHARED = 57421
-const EXCLUSIVE = 57422
-const ID = 57423
-const AT_ID = 57424
-const AT_AT_ID = 57425
-const HEX = 57426
-const STRING = 57427
-const INTEGRAL = 57428
-const FLOAT = 57429
-const HEXNUM = 57430
-const VALUE_ARG = 57431
-const LIST_ARG = 57432
-const COMMENT = 57433
-const COMMENT_KEYWORD = 57434
-const BIT_LITERAL = 57435
-const COMPRESSION = 57436
-const NULL = 57437
-const TRUE = 57438
-const FALSE = 57439
-const OFF = 57440
-const DISCARD = 57441
-const IMPORT = 57442
-const ENABLE = 57443
-const DISABLE = 57444
-const TABLESPACE = 57445
-const OR = 57446
-const XOR = 57447
-const AND = 57448
-const NOT = 57449
-const BETWEEN = 57450
-const CASE = 57451
-const WHEN = 57452
-const THEN = 57453
-const ELSE = 57454
-const END = 57455
-const LE = 57456
-const GE = 57457
-const NE = 57458
-const NULL_SAFE_EQUAL = 57459
-const IS = 57460
-const LIKE = 57461
-const REGEXP = 57462
-const IN = 57463
-const SHIFT_LEFT = 57464
-const SHIFT_RIGHT = 57465
-const DIV = 57466
-const MOD = 57467
-const UNARY = 57468
-const COLLATE = 57469
-const BINARY = 57470
-const UNDERSCORE_BINARY = 57471
-const UNDERSCORE_UTF8MB4 = 57472
-const UNDERSCORE_UTF8 = 57473
-const UNDERSCORE_LATIN1 = 57474
-const INTERVAL = 57475
-const JSON_EXTRACT_OP = 57476
-const JSON_UNQUOTE_EXTRACT_OP = 57477
-const CREATE = 57478
-const ALTER = 57479
-const DROP = 57480
-const RENAME = 57481
-const ANALYZE = 57482
-const ADD = 57483
-const FLUSH = 57484
-const CHANGE = 57485
-const MODIFY = 57486
-const REVERT = 57487
-const SCHEMA = 57488
-const TABLE = 57489
-const INDEX = 57490
-const VIEW = 57491
-const TO = 57492
-const IGNORE = 57493
-const IF = 57494
-const UNIQUE = 57495
-const PRIMARY = 57496
-const COLUMN = 57497
-const SPATIAL = 57498
-const FULLTEXT = 57499
-const KEY_BLOCK_SIZE = 57500
-const CHECK = 57501
-const INDEXES = 57502
-const ACTION = 57503
-const CASCADE = 57504
-const CONSTRAINT = 57505
-const FOREIGN = 57506
-const NO = 57507
-const REFERENCES = 57508
-const RESTRICT = 57509
-const SHOW = 57510
-const DESCRIBE = 57511
-const EXPLAIN = 57512
-const DATE = 57513
-const ESCAPE = 57514
-const REPAIR = 57515
-const OPTIMIZE = 57516
-const TRUNCATE = 57517
-const COALESCE = 57518
-const EXCHANGE = 57519
-const REBUILD = 57520
-const PARTITIONING = 57521
-const REMOVE = 57522
-const MAXVALUE = 57523
-const PARTITION = 57524
-const REORGANIZE = 57525
-const LESS = 57526
-const THAN = 57527
-const PROCEDURE = 57528
-const TRIGGER = 57529
-const VINDEX = 57530
-const VINDEXES = 57531
-const DIRECTORY = 57532
-const NAME = 57533
-const UPGRADE = 57534
-const STATUS = 57535
-const VARIABLES = 57536
-const WARNINGS = 57537
-const CASCADED = 57538
-const DEFINER = 57539
-const OPTION = 57540
-const SQL = 57541
-const UNDEFINED = 57542
-const SEQUENCE = 57543
-const MERGE = 57544
-const TEMPORARY = 57545
-const TEMPTABLE = 57546
-const INVOKER = 57547
-const SECURITY = 57548
-const FIRST = 57549
-const AFTER = 57550
-const LAST = 57551
-const VITESS_MIGRATION = 57552
-const CANCEL = 57553
-const RETRY = 57554
-const COMPLETE = 57555
-const BEGIN = 57556
-const START = 57557
-const TRANSACTION = 57558
-const COMMIT = 57559
-const ROLLBACK = 57560
-const SAVEPOINT = 57561
-const RELEASE = 57562
-const WORK = 57563
-const BIT = 57564
-const TINYINT = 57565
-const SMALLINT = 57566
-const MEDIUMINT = 57567
-const INT = 57568
-const INTEGER = 57569
-const BIGINT = 57570
-const INTNUM = 57571
-const REAL = 57572
-const DOUBLE = 57573
-const FLOAT_TYPE = 57574
-const DECIMAL = 57575
-const NUMERIC = 57576
-const TIME = 57577
-const TIMESTAMP = 57578
-const DATETIME = 57579
-const YEAR = 57580
-const CHAR = 57581
-const VARCHAR = 57582
-const BOOL = 57583
-const CHARACTER = 57584
-const VARBINARY = 57585
-const NCHAR = 57586
-const TEXT = 57587
-const TINYTEXT = 57588
-const MEDIUMTEXT = 57589
-const LONGTEXT = 57590
-const BLOB = 57591
-const TINYBLOB = 57592
-const MEDIUMBLOB = 57593
-const LONGBLOB = 57594
-const JSON = 57595
-const ENUM = 57596
-const GEOMETRY = 57597
-const POINT = 57598
-const LINESTRING = 57599
-const POLYGON = 57600
-const GEOMETRYCOLLECTION = 57601
-const MULTIPOINT = 57602
-const MULTILINESTRING = 57603
-const MULTIPOLYGON = 57604
-const NULLX = 57605
-const AUTO_INCREMENT = 57606
-const APPROXNUM = 57607
-const SIGNED = 57608
-const UNSIGNED = 57609
-const ZEROFILL = 57610
-const CODE = 57611
-const COLLATION = 57612
-const COLUMNS = 57613
-const DATABASES = 57614
-const ENGINES = 57615
-const EVENT = 57616
-const EXTENDED = 57617
-const FIELDS = 57618
-const FULL = 57619
-const FUNCTION = 57620
-const GTID_EXECUTED = 57621
-const KEYSPACES = 57622
-const OPEN = 57623
-const PLUGINS = 57624
-const PRIVILEGES = 57625
-const PROCESSLIST = 57626
-const SCHEMAS = 57627
-const TABLES = 57628
-const TRIGGERS = 57629
-const USER = 57630
-const VGTID_EXECUTED = 57631
-const VITESS_KEYSPACES = 57632
-const VITESS_METADATA = 57633
-const VITESS_MIGRATIONS = 57634
-const VITESS_SHARDS = 57635
-const VITESS_TABLETS = 57636
-const VSCHEMA = 57637
-const NAMES = 57638
-const CHARSET = 57639
-const GLOBAL = 57640
-const SESSION = 57641
-const ISOLATION = 57642
-const LEVEL = 57643
-const READ = 57644
-const WRITE = 57645
-const ONLY = 57646
-const REPEATABLE = 57647
-const COMMITTED = 57648
-const UNCOMMITTED = 57649
-const SERIALIZABLE = 57650
-const CURRENT_TIMESTAMP = 57651
-const DATABASE = 57652
-const CURRENT_DATE = 57653
-const CURRENT_TIME = 57654
-const LOCALTIME = 57655
-const LOCALTIMESTAMP = 57656
-const CURRENT_USER = 57657
-const UTC_DATE = 57658
-const UTC_TIME = 57659
-const UTC_TIMESTAMP = 57660
-const REPLACE = 57661
-const CONVERT = 57662
-const CAST = 57663
-const SUBSTR = 57664
-const SUBSTRING = 57665
-const GROUP_CONCAT = 57666
-const SEPARATOR = 57667
-const TIMESTAMPADD = 57668
-const TIMESTAMPDIFF = 57669
-const MATCH = 57670
-const AGAINST = 57671
-const BOOLEAN = 57672
-const LANGUAGE = 57673
-const WITH = 57674
-const QUERY = 57675
-const EXPANSION = 57676
-const WITHOUT = 57677
-const VALIDATION = 57678
-const UNUSED = 57679
-const ARRAY = 57680
-const CUME_DIST = 57681
-const DESCRIPTION = 57682
-const DENSE_RANK = 57683
-const EMPTY = 57684
-const EXCEPT = 57685
-const FIRST_VALUE = 57686
-const GROUPING = 57687
-const GROUPS = 57688
-const JSON_TABLE = 57689
-const LAG = 57690
-const LAST_VALUE = 57691
-const LATERAL = 57692
-const LEAD = 57693
-const MEMBER = 57694
-const NTH_VALUE = 57695
-const NTILE = 57696
-const OF = 57697
-const OVER = 57698
-const PERCENT_RANK = 57699
-const RANK = 57700
-const RECURSIVE = 57701
-const ROW_NUMBER = 57702
-const SYSTEM = 57703
-const WINDOW = 57704
-const ACTIVE = 57705
-const ADMIN = 57706
-const BUCKETS = 57707
-const CLONE = 57708
-const COMPONENT = 57709
-const DEFINITION = 57710
-const ENFORCED = 57711
-const EXCLUDE = 57712
-const FOLLOWING = 57713
-const GEOMCOLLECTION = 57714
-const GET_MASTER_PUBLIC_KEY = 57715
-const HISTOGRAM = 57716
-const HISTORY = 57717
-const INACTIVE = 57718
-const INVISIBLE = 57719
-const LOCKED = 57720
-const MASTER_COMPRESSION_ALGORITHMS = 57721
-const MASTER_PUBLIC_KEY_PATH = 57722
-const MASTER_TLS_CIPHERSUITES = 57723
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57724
-const NESTED = 57725
-const NETWORK_NAMESPACE = 57726
-const NOWAIT = 57727
-const NULLS = 57728
-const OJ = 57729
-const OLD = 57730
-const OPTIONAL = 57731
-const ORDINALITY = 57732
-const ORGANIZATION = 57733
-const OTHERS = 57734
-const PATH = 57735
-const PERSIST = 57736
-const PERSIST_ONLY = 57737
-const PRECEDING = 57738
-const PRIVILEGE_CHECKS_USER = 57739
-const PROCESS = 57740
-const RANDOM = 57741
-const REFERENCE = 57742
-const REQUIRE_ROW_FORMAT = 57743
-const RESOURCE = 57744
-const RESPECT = 57745
-const RESTART = 57746
-const RETAIN = 57747
-const REUSE = 57748
-const ROLE = 57749
-const SECONDARY = 57750
-const SECONDARY_ENGINE = 57751
-const SECONDARY_LOAD = 57752
-const SECONDARY_UNLOAD = 57753
-const SKIP = 57754
-const SRID = 57755
-const THREAD_PRIORITY = 57756
-const TIES = 57757
-const UNBOUNDED = 57758
-const VCPU = 57759
-const VISIBLE = 57760
-const FORMAT = 57761
-const TREE = 57762
-const VITESS = 57763
-const TRADITIONAL = 57764
-const LOCAL = 57765
-const LOW_PRIORITY = 57766
-const NO_WRITE_TO_BINLOG = 57767
-const LOGS = 57768
-const ERROR = 57769
-const GENERAL = 57770
-const HOSTS = 57771
-const OPTIMIZER_COSTS = 57772
-const USER_RESOURCES = 57773
-const SLOW = 57774
-const CHANNEL = 57775
-const RELAY = 57776
-const EXPORT = 57777
-const AVG_ROW_LENGTH = 57778
-const CONNECTION = 57779
-const CHECKSUM = 57780
-const DELAY_KEY_WRITE = 57781
-const ENCRYPTION = 57782
-const ENGINE = 57783
-const INSERT_METHOD = 57784
-const MAX_ROWS = 57785
-const MIN_ROWS = 57786
-const PACK_KEYS = 57787
-const PASSWORD = 57788
-const FIXED = 57789
-const DYNAMIC = 57790
-const COMPRESSED = 57791
-const REDUNDANT = 57792
-const COMPACT = 57793
-const ROW_FORMAT = 57794
-const STATS_AUTO_RECALC = 57795
-const STATS_PERSISTENT = 57796
-const STATS_SAMPLE_PAGES = 57797
-const STORAGE = 57798
-const MEMORY = 57799
-const DISK = 57800
+const LOWER_THAN_AFTER = 57415
+const AFTER = 57416
+const REMOVE = 57417
+const ON = 57418
+const USING = 57419
+const INPLACE = 57420
+const COPY = 57421
+const ALGORITHM = 57422
+const NONE = 57423
+const SHARED = 57424
+const EXCLUSIVE = 57425
+const ID = 57426
+const AT_ID = 57427
+const AT_AT_ID = 57428
+const HEX = 57429
+const STRING = 57430
+const INTEGRAL = 57431
+const FLOAT = 57432
+const HEXNUM = 57433
+const VALUE_ARG = 57434
+const LIST_ARG = 57435
+const COMMENT = 57436
+const COMMENT_KEYWORD = 57437
+const BIT_LITERAL = 57438
+const COMPRESSION = 57439
+const NULL = 57440
+const TRUE = 57441
+const FALSE = 57442
+const OFF = 57443
+const DISCARD = 57444
+const IMPORT = 57445
+const ENABLE = 57446
+const DISABLE = 57447
+const TABLESPACE = 57448
+const OR = 57449
+const XOR = 57450
+const AND = 57451
+const NOT = 57452
+const BETWEEN = 57453
+const CASE = 57454
+const WHEN = 57455
+const THEN = 57456
+const ELSE = 57457
+const END = 57458
+const LE = 57459
+const GE = 57460
+const NE = 57461
+const NULL_SAFE_EQUAL = 57462
+const IS = 57463
+const LIKE = 57464
+const REGEXP = 57465
+const IN = 57466
+const SHIFT_LEFT = 57467
+const SHIFT_RIGHT = 57468
+const DIV = 57469
+const MOD = 57470
+const UNARY = 57471
+const COLLATE = 57472
+const BINARY = 57473
+const UNDERSCORE_BINARY = 57474
+const UNDERSCORE_UTF8MB4 = 57475
+const UNDERSCORE_UTF8 = 57476
+const UNDERSCORE_LATIN1 = 57477
+const INTERVAL = 57478
+const JSON_EXTRACT_OP = 57479
+const JSON_UNQUOTE_EXTRACT_OP = 57480
+const CREATE = 57481
+const ALTER = 57482
+const DROP = 57483
+const RENAME = 57484
+const ANALYZE = 57485
+const ADD = 57486
+const FLUSH = 57487
+const CHANGE = 57488
+const MODIFY = 57489
+const REVERT = 57490
+const SCHEMA = 57491
+const TABLE = 57492
+const INDEX = 57493
+const VIEW = 57494
+const TO = 57495
+const IGNORE = 57496
+const IF = 57497
+const UNIQUE = 57498
+const PRIMARY = 57499
+const COLUMN = 57500
+const SPATIAL = 57501
+const FULLTEXT = 57502
+const KEY_BLOCK_SIZE = 57503
+const CHECK = 57504
+const INDEXES = 57505
+const ACTION = 57506
+const CASCADE = 57507
+const CONSTRAINT = 57508
+const FOREIGN = 57509
+const NO = 57510
+const REFERENCES = 57511
+const RESTRICT = 57512
+const SHOW = 57513
+const DESCRIBE = 57514
+const EXPLAIN = 57515
+const DATE = 57516
+const ESCAPE = 57517
+const REPAIR = 57518
+const OPTIMIZE = 57519
+const TRUNCATE = 57520
+const COALESCE = 57521
+const EXCHANGE = 57522
+const REBUILD = 57523
+const PARTITIONING = 57524
+const MAXVALUE = 57525
+const PARTITION = 57526
+const REORGANIZE = 57527
+const LESS = 57528
+const THAN = 57529
+const PROCEDURE = 57530
+const TRIGGER = 57531
+const PARTITIONS = 57532
+const LINEAR = 57533
+const RANGE = 57534
+const LIST = 57535
+const VINDEX = 57536
+const VINDEXES = 57537
+const DIRECTORY = 57538
+const NAME = 57539
+const UPGRADE = 57540
+const STATUS = 57541
+const VARIABLES = 57542
+const WARNINGS = 57543
+const CASCADED = 57544
+const DEFINER = 57545
+const OPTION = 57546
+const SQL = 57547
+const UNDEFINED = 57548
+const SEQUENCE = 57549
+const MERGE = 57550
+const TEMPORARY = 57551
+const TEMPTABLE = 57552
+const INVOKER = 57553
+const SECURITY = 57554
+const FIRST = 57555
+const LAST = 57556
+const VITESS_MIGRATION = 57557
+const CANCEL = 57558
+const RETRY = 57559
+const COMPLETE = 57560
+const BEGIN = 57561
+const START = 57562
+const TRANSACTION = 57563
+const COMMIT = 57564
+const ROLLBACK = 57565
+const SAVEPOINT = 57566
+const RELEASE = 57567
+const WORK = 57568
+const BIT = 57569
+const TINYINT = 57570
+const SMALLINT = 57571
+const MEDIUMINT = 57572
+const INT = 57573
+const INTEGER = 57574
+const BIGINT = 57575
+const INTNUM = 57576
+const REAL = 57577
+const DOUBLE = 57578
+const FLOAT_TYPE = 57579
+const DECIMAL = 57580
+const NUMERIC = 57581
+const TIME = 57582
+const TIMESTAMP = 57583
+const DATETIME = 57584
+const YEAR = 57585
+const CHAR = 57586
+const VARCHAR = 57587
+const BOOL = 57588
+const CHARACTER = 57589
+const VARBINARY = 57590
+const NCHAR = 57591
+const TEXT = 57592
+const TINYTEXT = 57593
+const MEDIUMTEXT = 57594
+const LONGTEXT = 57595
+const BLOB = 57596
+const TINYBLOB = 57597
+const MEDIUMBLOB = 57598
+const LONGBLOB = 57599
+const JSON = 57600
+const ENUM = 57601
+const GEOMETRY = 57602
+const POINT = 57603
+const LINESTRING = 57604
+const POLYGON = 57605
+const GEOMETRYCOLLECTION = 57606
+const MULTIPOINT = 57607
+const MULTILINESTRING = 57608
+const MULTIPOLYGON = 57609
+const NULLX = 57610
+const AUTO_INCREMENT = 57611
+const APPROXNUM = 57612
+const SIGNED = 57613
+const UNSIGNED = 57614
+const ZEROFILL = 57615
+const CODE = 57616
+const COLLATION = 57617
+const COLUMNS = 57618
+const DATABASES = 57619
+const ENGINES = 57620
+const EVENT = 57621
+const EXTENDED = 57622
+const FIELDS = 57623
+const FULL = 57624
+const FUNCTION = 57625
+const GTID_EXECUTED = 57626
+const KEYSPACES = 57627
+const OPEN = 57628
+const PLUGINS = 57629
+const PRIVILEGES = 57630
+const PROCESSLIST = 57631
+const SCHEMAS = 57632
+const TABLES = 57633
+const TRIGGERS = 57634
+const USER = 57635
+const VGTID_EXECUTED = 57636
+const VITESS_KEYSPACES = 57637
+const VITESS_METADATA = 57638
+const VITESS_MIGRATIONS = 57639
+const VITESS_SHARDS = 57640
+const VITESS_TABLETS = 57641
+const VSCHEMA = 57642
+const NAMES = 57643
+const CHARSET = 57644
+const GLOBAL = 57645
+const SESSION = 57646
+const ISOLATION = 57647
+const LEVEL = 57648
+const READ = 57649
+const WRITE = 57650
+const ONLY = 57651
+const REPEATABLE = 57652
+const COMMITTED = 57653
+const UNCOMMITTED = 57654
+const SERIALIZABLE = 57655
+const CURRENT_TIMESTAMP = 57656
+const DATABASE = 57657
+const CURRENT_DATE = 57658
+const CURRENT_TIME = 57659
+const LOCALTIME = 57660
+const LOCALTIMESTAMP = 57661
+const CURRENT_USER = 57662
+const UTC_DATE = 57663
+const UTC_TIME = 57664
+const UTC_TIMESTAMP = 57665
+const REPLACE = 57666
+const CONVERT = 57667
+const CAST = 57668
+const SUBSTR = 57669
+const SUBSTRING = 57670
+const GROUP_CONCAT = 57671
+const SEPARATOR = 57672
+const TIMESTAMPADD = 57673
+const TIMESTAMPDIFF = 57674
+const MATCH = 57675
+const AGAINST = 57676
+const BOOLEAN = 57677
+const LANGUAGE = 57678
+const WITH = 57679
+const QUERY = 57680
+const EXPANSION = 57681
+const WITHOUT = 57682
+const VALIDATION = 57683
+const UNUSED = 57684
+const ARRAY = 57685
+const CUME_DIST = 57686
+const DESCRIPTION = 57687
+const DENSE_RANK = 57688
+const EMPTY = 57689
+const EXCEPT = 57690
+const FIRST_VALUE = 57691
+const GROUPING = 57692
+const GROUPS = 57693
+const JSON_TABLE = 57694
+const LAG = 57695
+const LAST_VALUE = 57696
+const LATERAL = 57697
+const LEAD = 57698
+const MEMBER = 57699
+const NTH_VALUE = 57700
+const NTILE = 57701
+const OF = 57702
+const OVER = 57703
+const PERCENT_RANK = 57704
+const RANK = 57705
+const RECURSIVE = 57706
+const ROW_NUMBER = 57707
+const SYSTEM = 57708
+const WINDOW = 57709
+const ACTIVE = 57710
+const ADMIN = 57711
+const BUCKETS = 57712
+const CLONE = 57713
+const COMPONENT = 57714
+const DEFINITION = 57715
+const ENFORCED = 57716
+const EXCLUDE = 57717
+const FOLLOWING = 57718
+const GEOMCOLLECTION = 57719
+const GET_MASTER_PUBLIC_KEY = 57720
+const HISTOGRAM = 57721
+const HISTORY = 57722
+const INACTIVE = 57723
+const INVISIBLE = 57724
+const LOCKED = 57725
+const MASTER_COMPRESSION_ALGORITHMS = 57726
+const MASTER_PUBLIC_KEY_PATH = 57727
+const MASTER_TLS_CIPHERSUITES = 57728
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57729
+const NESTED = 57730
+const NETWORK_NAMESPACE = 57731
+const NOWAIT = 57732
+const NULLS = 57733
+const OJ = 57734
+const OLD = 57735
+const OPTIONAL = 57736
+const ORDINALITY = 57737
+const ORGANIZATION = 57738
+const OTHERS = 57739
+const PATH = 57740
+const PERSIST = 57741
+const PERSIST_ONLY = 57742
+const PRECEDING = 57743
+const PRIVILEGE_CHECKS_USER = 57744
+const PROCESS = 57745
+const RANDOM = 57746
+const REFERENCE = 57747
+const REQUIRE_ROW_FORMAT = 57748
+const RESOURCE = 57749
+const RESPECT = 57750
+const RESTART = 57751
+const RETAIN = 57752
+const REUSE = 57753
+const ROLE = 57754
+const SECONDARY = 57755
+const SECONDARY_ENGINE = 57756
+const SECONDARY_LOAD = 57757
+const SECONDARY_UNLOAD = 57758
+const SKIP = 57759
+const SRID = 57760
+const THREAD_PRIORITY = 57761
+const TIES = 57762
+const UNBOUNDED = 57763
+const VCPU = 57764
+const VISIBLE = 57765
+const FORMAT = 57766
+const TREE = 57767
+const VITESS = 57768
+const TRADITIONAL = 57769
+const LOCAL = 57770
+const LOW_PRIORITY = 57771
+const NO_WRITE_TO_BINLOG = 57772
+const LOGS = 57773
+const ERROR = 57774
+const GENERAL = 57775
+const HOSTS = 57776
+const OPTIMIZER_COSTS = 57777
+const USER_RESOURCES = 57778
+const SLOW = 57779
+const CHANNEL = 57780
+const RELAY = 57781
+const EXPORT = 57782
+const AVG_ROW_LENGTH = 57783
+const CONNECTION = 57784
+const CHECKSUM = 57785
+const DELAY_KEY_WRITE = 57786
+const ENCRYPTION = 57787
+const ENGINE = 57788
+const INSERT_METHOD = 57789
+const MAX_ROWS = 57790
+const MIN_ROWS = 57791
+const PACK_KEYS = 57792
+const PASSWORD = 57793
+const FIXED = 57794
+const DYNAMIC = 57795
+const COMPRESSED = 57796
+const REDUNDANT = 57797
+const COMPACT = 57798
+const ROW_FORMAT = 57799
+const STATS_AUTO_RECALC = 57800
+const STATS_PERSISTENT = 57801
+const STATS_SAMPLE_PAGES = 57802
+const STORAGE = 57803
+const MEMORY = 57804
+const DISK = 57805
 
 var yyToknames = [...]string{
 	"$end",
@@ -574,6 +579,9 @@ var yyToknames = [...]string{
 	"NATURAL",
 	"USE",
 	"FORCE",
+	"LOWER_THAN_AFTER",
+	"AFTER",
+	"REMOVE",
 	"ON",
 	"USING",
 	"INPLACE",
@@ -698,7 +706,6 @@ var yyToknames = [...]string{
 	"EXCHANGE",
 	"REBUILD",
 	"PARTITIONING",
-	"REMOVE",
 	"MAXVALUE",
 	"PARTITION",
 	"REORGANIZE",
@@ -706,6 +713,10 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"PARTITIONS",
+	"LINEAR",
+	"RANGE",
+	"LIST",
 	"VINDEX",
 	"VINDEXES",
 	"DIRECTORY",
@@ -726,7 +737,6 @@ var yyToknames = [...]string{
 	"INVOKER",
 	"SECURITY",
 	"FIRST",
-	"AFTER",
 	"LAST",
 	"VITESS_MIGRATION",
 	"CANCEL",
@@ -993,2952 +1003,3010 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 45,
 	1, 112,
-	476, 112,
+	481, 112,
 	-2, 118,
 	-1, 46,
-	143, 118,
-	259, 118,
-	314, 118,
-	-2, 326,
+	146, 118,
+	264, 118,
+	319, 118,
+	-2, 327,
 	-1, 53,
-	34, 474,
-	165, 474,
-	177, 474,
-	210, 488,
-	211, 488,
-	-2, 476,
+	34, 501,
+	168, 501,
+	180, 501,
+	216, 515,
+	217, 515,
+	-2, 503,
 	-1, 58,
-	167, 498,
-	-2, 496,
+	170, 525,
+	-2, 523,
 	-1, 84,
-	56, 566,
-	-2, 574,
+	56, 593,
+	-2, 601,
 	-1, 97,
-	164, 936,
+	167, 963,
 	-2, 91,
 	-1, 99,
 	1, 113,
-	476, 113,
+	481, 113,
 	-2, 118,
 	-1, 109,
-	170, 231,
-	171, 231,
+	173, 231,
+	174, 231,
 	-2, 320,
 	-1, 128,
-	143, 118,
-	259, 118,
-	314, 118,
-	-2, 335,
-	-1, 563,
-	150, 957,
-	-2, 953,
-	-1, 564,
-	150, 958,
-	-2, 954,
-	-1, 583,
-	56, 567,
-	-2, 579,
-	-1, 584,
-	56, 568,
-	-2, 580,
-	-1, 605,
-	118, 1302,
+	146, 118,
+	264, 118,
+	319, 118,
+	-2, 336,
+	-1, 565,
+	153, 984,
+	-2, 980,
+	-1, 566,
+	153, 985,
+	-2, 981,
+	-1, 585,
+	56, 594,
+	-2, 606,
+	-1, 586,
+	56, 595,
+	-2, 607,
+	-1, 607,
+	121, 1333,
 	-2, 84,
-	-1, 606,
-	118, 1183,
+	-1, 608,
+	121, 1212,
 	-2, 85,
-	-1, 612,
-	118, 1234,
-	-2, 930,
-	-1, 750,
-	118, 1120,
-	-2, 927,
-	-1, 786,
-	176, 38,
-	181, 38,
+	-1, 614,
+	121, 1264,
+	-2, 957,
+	-1, 754,
+	121, 1149,
+	-2, 954,
+	-1, 792,
+	179, 38,
+	184, 38,
 	-2, 242,
-	-1, 863,
-	1, 373,
-	476, 373,
-	-2, 118,
-	-1, 1104,
-	1, 269,
-	476, 269,
+	-1, 870,
+	1, 376,
+	481, 376,
 	-2, 118,
-	-1, 1180,
-	170, 231,
-	171, 231,
+	-1, 1111,
+	146, 118,
+	264, 118,
+	319, 118,
+	-2, 269,
+	-1, 1188,
+	173, 231,
+	174, 231,
 	-2, 320,
-	-1, 1189,
-	176, 39,
-	181, 39,
+	-1, 1197,
+	179, 39,
+	184, 39,
 	-2, 243,
-	-1, 1397,
-	150, 962,
-	-2, 956,
-	-1, 1490,
-	74, 66,
-	82, 66,
+	-1, 1406,
+	153, 989,
+	-2, 983,
+	-1, 1499,
+	77, 66,
+	85, 66,
 	-2, 70,
-	-1, 1511,
-	1, 270,
-	476, 270,
-	-2, 118,
-	-1, 1934,
-	5, 823,
-	18, 823,
-	20, 823,
-	32, 823,
-	83, 823,
-	-2, 606,
-	-1, 2159,
-	46, 898,
-	-2, 892,
+	-1, 1520,
+	146, 118,
+	264, 118,
+	319, 118,
+	-2, 270,
+	-1, 1961,
+	5, 850,
+	18, 850,
+	20, 850,
+	32, 850,
+	86, 850,
+	-2, 633,
+	-1, 2219,
+	46, 925,
+	-2, 919,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 28179
+const yyLast = 28737
 
 var yyAct = [...]int{
-	563, 2251, 2238, 2084, 1991, 2186, 2109, 2212, 1811, 923,
-	2160, 83, 3, 2137, 1694, 1576, 2199, 1914, 535, 2081,
-	1915, 1727, 1434, 1526, 1161, 1059, 1508, 1052, 1005, 1728,
-	521, 1791, 1541, 1854, 1561, 1911, 1815, 1546, 504, 1792,
-	816, 1873, 1714, 753, 1487, 1793, 137, 1926, 1654, 165,
-	1391, 1574, 165, 1295, 469, 165, 874, 1560, 123, 81,
-	485, 506, 165, 1383, 1086, 576, 1187, 1089, 903, 1548,
-	165, 1785, 781, 1096, 610, 1476, 1469, 1607, 1057, 1436,
-	33, 1062, 1082, 1044, 508, 1417, 1080, 1360, 570, 941,
-	1292, 757, 485, 1278, 497, 485, 165, 485, 794, 585,
-	1079, 607, 787, 1194, 1452, 760, 1558, 761, 782, 783,
-	1095, 1537, 1093, 1492, 784, 1069, 1205, 79, 1300, 921,
-	100, 101, 106, 1179, 1527, 8, 140, 1018, 7, 859,
-	1156, 6, 1834, 1833, 1605, 78, 1021, 1861, 107, 492,
-	1862, 1349, 2111, 1348, 1264, 1431, 1432, 1347, 1346, 167,
-	168, 169, 1345, 1344, 495, 1337, 496, 769, 2226, 1692,
-	571, 2156, 1960, 2060, 764, 2133, 818, 754, 592, 596,
-	108, 2132, 102, 2079, 820, 821, 2080, 2250, 444, 832,
-	833, 161, 836, 837, 838, 839, 819, 2257, 842, 843,
-	844, 845, 846, 847, 848, 849, 850, 851, 852, 853,
-	854, 855, 856, 493, 604, 161, 103, 2209, 1553, 798,
-	942, 2181, 84, 80, 2242, 2085, 611, 145, 1644, 1593,
-	775, 774, 2208, 2180, 1890, 797, 102, 776, 942, 1551,
-	103, 2024, 125, 1170, 1841, 829, 1758, 1940, 1840, 1757,
-	1693, 145, 1759, 1502, 822, 823, 824, 1941, 1942, 86,
-	87, 88, 89, 90, 91, 1503, 1504, 97, 1762, 1860,
-	162, 1394, 1642, 439, 1097, 834, 1098, 1433, 167, 168,
-	169, 142, 135, 143, 893, 567, 952, 124, 566, 1775,
-	1493, 835, 160, 569, 35, 898, 899, 72, 39, 40,
-	102, 894, 919, 473, 952, 142, 773, 143, 868, 869,
-	1520, 887, 112, 113, 134, 133, 160, 777, 881, 1550,
-	483, 1993, 2183, 882, 2015, 2013, 770, 1048, 1336, 487,
-	858, 481, 1338, 1339, 1340, 2146, 967, 966, 976, 977,
-	969, 970, 971, 972, 973, 974, 975, 968, 1816, 1284,
-	978, 146, 1575, 1837, 1987, 167, 168, 169, 472, 902,
-	2249, 151, 1988, 771, 129, 110, 136, 117, 109, 71,
-	130, 131, 1608, 1279, 864, 146, 948, 895, 916, 940,
-	1613, 900, 773, 881, 765, 151, 118, 888, 882, 768,
-	1849, 901, 767, 766, 948, 2227, 880, 1994, 879, 918,
-	121, 119, 114, 115, 116, 120, 1623, 473, 1254, 548,
-	111, 554, 555, 552, 553, 461, 551, 550, 549, 122,
-	1618, 1616, 1617, 1612, 460, 1995, 556, 557, 1620, 841,
-	1621, 840, 1622, 473, 1614, 458, 1610, 896, 897, 771,
-	2129, 2074, 1577, 1470, 814, 813, 812, 811, 1959, 165,
-	1255, 165, 1256, 473, 165, 805, 803, 810, 2075, 809,
-	778, 808, 472, 807, 1611, 802, 1173, 138, 862, 815,
-	772, 758, 914, 758, 455, 2240, 790, 756, 2258, 758,
-	485, 485, 485, 467, 1193, 1192, 1293, 789, 472, 1285,
-	1559, 138, 1839, 1552, 598, 891, 1850, 1599, 485, 485,
-	1493, 1289, 928, 825, 1967, 1836, 1899, 1898, 472, 1897,
-	2179, 934, 877, 1168, 883, 884, 885, 886, 915, 947,
-	944, 945, 946, 951, 953, 950, 796, 949, 1420, 1167,
-	473, 1166, 1853, 1826, 943, 920, 2184, 947, 944, 945,
-	946, 951, 953, 950, 917, 949, 772, 132, 806, 804,
-	1290, 1164, 943, 443, 773, 857, 2147, 1643, 445, 126,
-	447, 462, 127, 475, 438, 474, 451, 2200, 449, 453,
-	463, 454, 99, 448, 796, 459, 2167, 165, 450, 464,
-	465, 479, 478, 466, 1595, 472, 457, 476, 1266, 1265,
-	1267, 1268, 1269, 910, 1050, 912, 2044, 1856, 988, 878,
-	925, 926, 1855, 796, 1939, 485, 1049, 870, 165, 2255,
-	165, 165, 579, 485, 867, 1695, 1697, 831, 861, 485,
-	990, 991, 607, 796, 73, 937, 890, 1006, 935, 1856,
-	1719, 936, 909, 911, 1855, 1673, 1662, 1585, 892, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	1283, 1498, 1670, 1073, 1078, 156, 157, 158, 159, 1045,
-	1874, 1003, 795, 139, 144, 141, 147, 148, 149, 150,
-	152, 153, 154, 155, 1848, 872, 1509, 1847, 978, 156,
-	157, 158, 159, 1754, 1448, 594, 1020, 1023, 1025, 1027,
-	1028, 1030, 1032, 1033, 796, 1063, 1024, 1026, 968, 1029,
-	1031, 978, 1034, 860, 1876, 956, 957, 955, 1594, 1332,
-	795, 477, 1696, 1894, 958, 1042, 799, 789, 772, 94,
-	907, 1772, 1767, 958, 908, 904, 800, 2175, 817, 470,
-	1280, 1301, 1281, 955, 913, 1282, 863, 611, 1924, 795,
-	876, 167, 168, 169, 471, 1385, 789, 792, 793, 958,
-	758, 498, 1609, 796, 786, 790, 906, 2253, 1892, 795,
-	2254, 830, 2252, 165, 95, 1768, 1878, 1157, 1882, 1286,
-	1877, 1099, 1875, 785, 990, 991, 1165, 1880, 969, 970,
-	971, 972, 973, 974, 975, 968, 1879, 1770, 978, 938,
-	1765, 990, 991, 957, 955, 485, 1051, 1189, 1418, 1881,
-	1883, 1386, 1766, 1804, 1418, 1198, 1680, 1592, 1790, 1202,
-	958, 1590, 485, 485, 805, 485, 803, 485, 485, 2259,
-	485, 485, 485, 485, 485, 485, 1944, 1171, 1172, 1367,
-	795, 1273, 956, 957, 955, 485, 799, 789, 1185, 165,
-	1238, 905, 1066, 1365, 1366, 1364, 800, 1302, 2059, 1178,
-	958, 2058, 1271, 875, 1061, 1251, 1965, 1587, 1355, 1357,
-	1358, 1094, 1773, 1771, 801, 2243, 485, 1789, 165, 1199,
-	1356, 1197, 971, 972, 973, 974, 975, 968, 1235, 1291,
-	978, 1591, 2232, 165, 1587, 1241, 1242, 2260, 1901, 795,
-	1272, 1247, 1248, 2244, 1233, 1234, 789, 792, 793, 165,
-	758, 1195, 1195, 1163, 786, 790, 165, 1196, 1589, 1788,
-	2233, 1270, 1176, 1175, 1556, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 485, 485, 485, 1188, 1174, 1207,
-	1274, 1208, 1261, 1210, 1212, 1669, 1902, 1216, 1218, 1220,
-	1222, 1224, 167, 168, 169, 1259, 1780, 1647, 1648, 1649,
-	71, 165, 1258, 602, 1305, 1257, 1249, 1297, 1303, 1304,
-	1236, 1309, 1363, 1311, 1312, 1313, 1314, 2236, 161, 1243,
-	1318, 1294, 1308, 1769, 1240, 1239, 1214, 2235, 580, 1315,
-	1316, 1317, 1453, 1454, 1333, 956, 957, 955, 2234, 1384,
-	1361, 1260, 1169, 103, 775, 774, 2220, 2218, 1387, 2100,
-	102, 2056, 1781, 958, 145, 524, 523, 526, 527, 528,
-	529, 2032, 485, 597, 525, 1343, 530, 1947, 1307, 956,
-	957, 955, 1903, 1395, 967, 966, 976, 977, 969, 970,
-	971, 972, 973, 974, 975, 968, 1798, 958, 978, 1388,
-	1389, 1668, 1401, 1328, 1329, 1330, 485, 485, 1786, 1667,
-	167, 168, 169, 1638, 1761, 1990, 1362, 165, 142, 1603,
-	143, 167, 168, 169, 956, 957, 955, 1396, 1602, 160,
-	1442, 485, 1298, 1262, 956, 957, 955, 1250, 165, 1246,
-	1006, 485, 958, 1406, 1409, 165, 1441, 165, 1397, 1419,
-	1245, 1244, 958, 1395, 80, 165, 165, 1974, 2224, 1425,
-	1426, 580, 485, 599, 600, 485, 1488, 1715, 167, 168,
-	169, 607, 1569, 2127, 607, 2126, 485, 167, 168, 169,
-	2083, 1567, 167, 168, 169, 1443, 1252, 1912, 146, 1450,
-	1974, 2206, 1398, 1974, 2173, 1455, 1923, 1467, 151, 1974,
-	2168, 1974, 580, 2077, 580, 1587, 580, 1521, 1818, 1522,
-	1523, 1524, 1525, 1528, 1529, 1530, 2042, 580, 1397, 1513,
-	1491, 1512, 1974, 1979, 1715, 1533, 1534, 1535, 1536, 1957,
-	1956, 485, 580, 1463, 1953, 1954, 1473, 1562, 1563, 1564,
-	1953, 1952, 1566, 1568, 1461, 580, 959, 1494, 1516, 1493,
-	1835, 35, 1449, 1160, 1820, 485, 1465, 1801, 1543, 1813,
-	1814, 485, 1473, 580, 1549, 1198, 35, 1198, 954, 580,
-	1588, 1496, 2061, 1500, 1499, 1586, 1722, 956, 957, 955,
-	1160, 1159, 498, 82, 1515, 1514, 611, 1105, 1104, 611,
-	1517, 1016, 1748, 1923, 1923, 958, 2039, 2174, 1573, 1723,
-	1493, 954, 35, 1974, 138, 485, 1955, 1384, 1473, 1495,
-	1501, 1685, 1384, 1384, 2116, 1684, 573, 1497, 1544, 1461,
-	2062, 2063, 2064, 1055, 1058, 1587, 71, 1557, 1587, 1570,
-	1451, 1583, 2171, 1584, 1539, 1540, 1555, 1429, 1554, 1402,
-	1403, 71, 1462, 1408, 1411, 1412, 1565, 165, 1596, 1461,
-	1544, 1341, 798, 1494, 165, 1288, 1091, 1597, 1472, 165,
-	165, 1578, 1195, 165, 564, 165, 1579, 1582, 797, 1424,
-	1598, 165, 1427, 1428, 780, 1600, 1601, 71, 165, 967,
-	966, 976, 977, 969, 970, 971, 972, 973, 974, 975,
-	968, 71, 1229, 978, 966, 976, 977, 969, 970, 971,
-	972, 973, 974, 975, 968, 165, 485, 978, 779, 1473,
-	71, 1606, 1461, 166, 2139, 1495, 166, 2082, 2050, 166,
-	1162, 1542, 1795, 1493, 486, 1989, 166, 1580, 1538, 1532,
-	1633, 1634, 1531, 1276, 166, 1636, 1190, 1186, 1158, 1655,
-	1230, 1231, 1232, 2065, 1637, 96, 1794, 862, 1927, 1928,
-	1992, 1626, 1226, 1361, 2140, 1553, 486, 2246, 2239, 486,
-	166, 486, 976, 977, 969, 970, 971, 972, 973, 974,
-	975, 968, 1972, 1971, 978, 1970, 139, 144, 141, 147,
-	148, 149, 150, 152, 153, 154, 155, 1933, 2066, 2067,
-	1930, 1795, 156, 157, 158, 159, 1912, 1227, 1228, 1805,
-	165, 1641, 1627, 1478, 1481, 1482, 1483, 1479, 165, 1480,
-	1484, 1334, 1739, 1927, 1928, 1737, 1932, 1740, 1736, 1362,
-	1738, 1735, 1650, 1478, 1481, 1482, 1483, 1479, 1704, 1480,
-	1484, 2229, 1741, 165, 1482, 1483, 2207, 1664, 1904, 1060,
-	2043, 1701, 1977, 1713, 165, 165, 165, 165, 165, 571,
-	1712, 1724, 2231, 1708, 2211, 2213, 165, 1663, 2188, 2191,
-	165, 2161, 2163, 165, 165, 1702, 2187, 165, 165, 165,
-	2164, 1746, 1679, 1703, 2158, 1287, 565, 1717, 1799, 827,
-	1760, 1720, 1045, 1691, 1414, 826, 2002, 1699, 1794, 1053,
-	1859, 927, 2114, 1399, 1400, 1828, 1827, 103, 1779, 1415,
-	1707, 1054, 1949, 1948, 1729, 1581, 1204, 1749, 1203, 1191,
-	2037, 1751, 1446, 1716, 1453, 1454, 1776, 1777, 1968, 1630,
-	2169, 2134, 1299, 1778, 485, 1782, 1783, 1784, 1763, 165,
-	1742, 1731, 1732, 1718, 1734, 1752, 165, 1747, 1486, 1444,
-	574, 575, 485, 1297, 1730, 1755, 1711, 1733, 485, 1619,
-	1646, 485, 1549, 1198, 1710, 577, 1764, 2219, 485, 1821,
-	2217, 1797, 2216, 2192, 2190, 1823, 2036, 1973, 2035, 1571,
-	1832, 578, 590, 586, 1787, 82, 1907, 1715, 2248, 2247,
-	2248, 165, 165, 165, 165, 165, 1674, 1796, 587, 1671,
-	1806, 1807, 1808, 1074, 1067, 1831, 2165, 165, 165, 1178,
-	1350, 1351, 1352, 1353, 1830, 1802, 1946, 1817, 1447, 1396,
-	573, 1064, 1065, 589, 80, 588, 85, 77, 1829, 1822,
-	1, 456, 1430, 1043, 1659, 1660, 468, 2237, 1263, 1253,
-	1397, 2086, 2136, 485, 1980, 1547, 590, 586, 788, 1384,
-	128, 1510, 1870, 1511, 2202, 1677, 93, 751, 92, 791,
-	889, 1572, 587, 2078, 1774, 1404, 1405, 1519, 1111, 1871,
-	1109, 1851, 1872, 1110, 1108, 1113, 1112, 1107, 1335, 485,
-	1863, 1857, 482, 1891, 1858, 583, 584, 589, 1485, 588,
-	165, 1885, 1869, 163, 1100, 1068, 828, 446, 1958, 1331,
-	485, 1604, 452, 498, 986, 533, 485, 485, 1709, 1870,
-	1756, 608, 1884, 166, 601, 166, 1918, 2185, 166, 2157,
-	2159, 2110, 1916, 2162, 1913, 2155, 2230, 2210, 1518, 165,
-	1445, 1056, 2034, 1906, 1678, 1015, 1416, 1083, 507, 1440,
-	1354, 522, 519, 520, 486, 486, 486, 1456, 1721, 960,
-	1922, 505, 499, 1075, 1477, 1507, 1475, 1474, 1628, 1087,
-	1929, 1931, 486, 486, 1729, 484, 1910, 1925, 1081, 1460,
-	1935, 1838, 1937, 1936, 1938, 1986, 939, 582, 494, 763,
-	1966, 1413, 2145, 1950, 1951, 1645, 165, 2023, 581, 1943,
-	61, 38, 489, 2225, 485, 1900, 930, 609, 591, 32,
-	755, 31, 762, 30, 29, 28, 23, 165, 22, 21,
-	20, 19, 25, 1962, 1545, 1963, 1964, 165, 1961, 18,
-	17, 1981, 16, 1921, 98, 48, 45, 43, 105, 104,
-	46, 165, 42, 865, 165, 1549, 27, 1975, 1978, 26,
-	15, 166, 14, 2003, 13, 12, 1984, 1983, 11, 10,
-	9, 5, 4, 933, 24, 1004, 2, 0, 1976, 0,
-	0, 0, 0, 0, 1998, 1997, 0, 0, 0, 486,
-	0, 0, 166, 0, 166, 166, 0, 486, 0, 2008,
-	2009, 0, 2010, 486, 0, 2012, 1657, 2014, 0, 0,
-	1658, 2011, 0, 0, 2000, 2001, 0, 0, 0, 0,
-	0, 1665, 1666, 0, 0, 0, 2033, 1672, 0, 0,
-	1675, 1676, 0, 0, 0, 0, 0, 2006, 1682, 0,
-	1683, 0, 0, 1686, 1687, 1688, 1689, 1690, 2046, 2038,
-	0, 0, 0, 2047, 0, 0, 0, 0, 0, 1700,
-	0, 2052, 0, 0, 2027, 0, 0, 0, 165, 0,
-	0, 165, 165, 165, 485, 485, 2055, 2054, 2057, 2053,
-	0, 0, 2072, 0, 0, 1729, 0, 0, 0, 0,
-	0, 0, 0, 2087, 485, 485, 485, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1744, 1745, 0, 0,
-	2093, 967, 966, 976, 977, 969, 970, 971, 972, 973,
-	974, 975, 968, 0, 0, 978, 0, 0, 0, 485,
-	485, 485, 165, 2092, 2091, 0, 0, 2103, 2105, 2106,
-	0, 0, 0, 485, 0, 485, 0, 166, 0, 0,
-	0, 485, 0, 2117, 2107, 0, 2108, 0, 1916, 2122,
-	2115, 2119, 1916, 0, 0, 2113, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 0, 0, 486,
-	0, 0, 2124, 485, 2125, 0, 485, 0, 2128, 2099,
-	0, 2135, 0, 2131, 1681, 2138, 486, 486, 0, 486,
-	0, 486, 486, 0, 486, 486, 486, 486, 486, 486,
-	0, 0, 2121, 0, 0, 0, 0, 0, 2123, 486,
-	0, 0, 2154, 166, 0, 1705, 1706, 1058, 0, 0,
-	0, 0, 0, 0, 2166, 1916, 0, 0, 0, 0,
-	485, 165, 0, 0, 0, 0, 2172, 0, 2176, 0,
-	486, 0, 166, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2182, 0, 0, 0, 534, 166, 485, 0,
-	2189, 0, 485, 0, 0, 485, 485, 0, 1867, 1868,
-	2198, 2195, 0, 166, 2138, 2203, 501, 2193, 2201, 0,
-	166, 2215, 2214, 0, 0, 609, 609, 609, 0, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 486, 486,
-	486, 2228, 2221, 929, 931, 164, 0, 0, 442, 0,
-	0, 480, 0, 0, 0, 0, 0, 1729, 442, 0,
-	0, 0, 2241, 0, 0, 166, 442, 0, 0, 0,
-	2245, 0, 0, 0, 1919, 0, 0, 0, 0, 0,
-	2256, 0, 0, 595, 595, 0, 0, 0, 0, 0,
-	0, 0, 442, 0, 0, 1934, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 35, 36,
-	37, 72, 39, 40, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 486, 0, 76, 0,
-	0, 0, 0, 41, 67, 68, 0, 65, 69, 0,
-	0, 0, 0, 0, 66, 0, 0, 0, 0, 0,
-	1071, 0, 0, 0, 0, 0, 0, 0, 609, 0,
-	486, 486, 0, 2021, 1101, 0, 0, 0, 0, 0,
-	0, 166, 0, 54, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 71, 0, 486, 1893, 2020, 0, 2026,
-	0, 0, 166, 0, 0, 486, 0, 0, 0, 166,
-	0, 166, 0, 0, 0, 0, 0, 2019, 0, 166,
-	166, 0, 0, 0, 0, 0, 486, 0, 2005, 486,
-	0, 1908, 2007, 0, 0, 0, 0, 0, 0, 0,
-	486, 0, 2018, 2016, 2017, 0, 967, 966, 976, 977,
-	969, 970, 971, 972, 973, 974, 975, 968, 0, 2031,
-	978, 0, 0, 0, 0, 44, 47, 50, 49, 52,
-	0, 64, 0, 0, 70, 0, 2040, 2041, 0, 0,
-	2045, 0, 967, 966, 976, 977, 969, 970, 971, 972,
-	973, 974, 975, 968, 0, 486, 978, 53, 75, 74,
-	0, 0, 62, 63, 51, 0, 967, 966, 976, 977,
-	969, 970, 971, 972, 973, 974, 975, 968, 0, 486,
-	978, 0, 0, 0, 0, 486, 967, 966, 976, 977,
-	969, 970, 971, 972, 973, 974, 975, 968, 0, 2076,
-	978, 0, 0, 55, 56, 0, 57, 58, 59, 60,
-	755, 967, 966, 976, 977, 969, 970, 971, 972, 973,
-	974, 975, 968, 1200, 0, 978, 0, 1206, 1206, 486,
-	1206, 1864, 1206, 1206, 0, 1215, 1206, 1206, 1206, 1206,
-	1206, 0, 0, 0, 0, 0, 0, 2104, 1200, 1200,
-	755, 967, 966, 976, 977, 969, 970, 971, 972, 973,
-	974, 975, 968, 0, 0, 978, 0, 0, 0, 0,
-	2025, 166, 0, 0, 0, 0, 0, 0, 166, 0,
-	0, 1275, 0, 166, 166, 0, 0, 166, 0, 166,
-	0, 0, 0, 498, 0, 166, 0, 0, 0, 0,
-	2048, 0, 166, 2049, 0, 442, 2051, 442, 73, 0,
-	442, 0, 0, 2141, 2142, 2143, 2144, 0, 2148, 0,
-	2149, 2150, 2151, 0, 2152, 2153, 0, 0, 0, 166,
-	486, 0, 0, 0, 0, 0, 0, 0, 0, 609,
-	609, 609, 0, 0, 0, 0, 0, 0, 0, 962,
-	0, 965, 0, 0, 0, 0, 0, 979, 980, 981,
-	982, 983, 984, 985, 2178, 963, 964, 961, 967, 966,
-	976, 977, 969, 970, 971, 972, 973, 974, 975, 968,
-	0, 0, 978, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 992, 993, 994, 995,
-	996, 997, 998, 999, 1000, 1001, 0, 0, 0, 0,
-	0, 2112, 498, 0, 0, 2222, 2223, 0, 0, 0,
-	0, 0, 0, 0, 166, 0, 0, 1390, 0, 609,
-	0, 0, 166, 442, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1200, 0, 0, 0, 0, 0, 595,
-	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
-	0, 1422, 1423, 0, 442, 0, 442, 1090, 166, 166,
-	166, 166, 166, 0, 0, 0, 0, 0, 0, 0,
-	166, 1656, 0, 0, 166, 0, 1457, 166, 166, 0,
-	0, 166, 166, 166, 0, 0, 1071, 0, 0, 609,
-	0, 967, 966, 976, 977, 969, 970, 971, 972, 973,
-	974, 975, 968, 0, 0, 978, 0, 609, 0, 0,
-	609, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 755, 967, 966, 976, 977, 969, 970, 971, 972,
-	973, 974, 975, 968, 0, 0, 978, 0, 486, 0,
-	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 486, 0, 0, 0,
-	0, 0, 486, 0, 0, 486, 0, 0, 0, 0,
-	0, 0, 486, 0, 0, 0, 762, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 166, 166, 166, 166, 166,
-	755, 0, 0, 0, 0, 0, 762, 0, 0, 442,
-	0, 166, 166, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 536, 34, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 486, 0, 0,
-	755, 0, 0, 0, 1201, 0, 0, 0, 0, 0,
-	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1201,
-	1201, 0, 0, 486, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 0, 166, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 486, 0, 0, 572, 0, 0,
-	486, 486, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1296,
-	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 1640, 442, 0, 0, 0, 0, 0, 0, 0,
-	0, 1319, 1320, 442, 442, 442, 442, 442, 442, 442,
+	565, 2314, 2301, 2030, 2262, 2134, 2249, 2197, 2275, 2220,
+	578, 2015, 83, 3, 1742, 930, 1941, 1443, 2159, 1709,
+	2131, 1942, 523, 1535, 2020, 1012, 2019, 1729, 1743, 1059,
+	1938, 1066, 1550, 1808, 1570, 1881, 537, 1840, 1555, 506,
+	1809, 508, 1953, 1900, 1810, 881, 137, 757, 1669, 165,
+	1093, 1588, 165, 612, 471, 165, 123, 1195, 823, 1400,
+	487, 1392, 165, 1214, 1496, 81, 1569, 1557, 1621, 1802,
+	165, 910, 1304, 787, 783, 1096, 1103, 1478, 1485, 1064,
+	1445, 1089, 1069, 1051, 587, 1369, 1426, 510, 572, 1087,
+	1301, 499, 487, 33, 1517, 487, 165, 487, 1086, 948,
+	1836, 764, 1287, 1567, 1202, 1461, 793, 609, 761, 765,
+	788, 789, 1429, 1100, 1102, 79, 790, 1309, 1076, 1187,
+	1501, 494, 100, 1546, 106, 928, 140, 866, 800, 1536,
+	101, 1163, 8, 7, 6, 1859, 1858, 1619, 78, 1025,
+	107, 1273, 2014, 2016, 1888, 1889, 1028, 167, 168, 169,
+	1440, 1441, 2161, 1358, 949, 1357, 1356, 1355, 1354, 1353,
+	1346, 497, 1403, 498, 1707, 2288, 108, 773, 758, 594,
+	598, 768, 102, 573, 2216, 828, 949, 2100, 2193, 2192,
+	167, 168, 169, 446, 825, 495, 2129, 1987, 827, 2130,
+	2320, 826, 2272, 2313, 80, 2244, 581, 839, 840, 1659,
+	843, 844, 845, 846, 2305, 606, 849, 850, 851, 852,
+	853, 854, 855, 856, 857, 858, 859, 860, 861, 862,
+	863, 613, 779, 959, 2135, 1607, 102, 803, 2271, 1917,
+	778, 2243, 780, 2064, 1562, 1177, 1708, 84, 1866, 804,
+	463, 1967, 1865, 1968, 1969, 959, 829, 830, 831, 462,
+	777, 1887, 875, 876, 1168, 1560, 1773, 1657, 1511, 1772,
+	460, 1104, 1774, 1105, 1502, 836, 869, 1512, 1513, 161,
+	841, 842, 1442, 900, 86, 87, 88, 89, 90, 91,
+	1835, 569, 97, 774, 926, 162, 568, 475, 441, 1529,
+	102, 1792, 888, 781, 103, 2246, 125, 889, 457, 35,
+	71, 71, 72, 39, 40, 145, 901, 894, 571, 2032,
+	775, 469, 955, 2055, 550, 947, 556, 557, 554, 555,
+	2053, 553, 552, 551, 485, 483, 1345, 1347, 1348, 1349,
+	865, 558, 559, 489, 955, 1559, 135, 1055, 1589, 777,
+	2026, 769, 474, 1862, 124, 1293, 772, 1627, 2027, 771,
+	770, 1622, 777, 864, 905, 906, 2312, 475, 167, 168,
+	169, 888, 142, 1635, 143, 1636, 889, 1637, 1288, 1189,
+	1190, 134, 133, 160, 887, 923, 886, 71, 903, 904,
+	925, 902, 895, 909, 2033, 447, 596, 449, 464, 475,
+	477, 2289, 476, 453, 1632, 451, 455, 465, 456, 775,
+	450, 1876, 461, 871, 1628, 452, 466, 467, 481, 480,
+	468, 475, 474, 459, 478, 1638, 776, 848, 868, 847,
+	2034, 129, 1191, 136, 1624, 1188, 1828, 130, 131, 917,
+	1263, 919, 146, 1583, 1581, 1582, 2186, 782, 1591, 907,
+	1626, 165, 151, 165, 474, 2123, 165, 784, 2321, 908,
+	1479, 821, 500, 812, 810, 954, 951, 952, 953, 958,
+	960, 957, 820, 956, 921, 1986, 474, 916, 918, 819,
+	950, 818, 487, 487, 487, 1264, 1265, 954, 951, 952,
+	953, 958, 960, 957, 1625, 956, 817, 1294, 1864, 816,
+	487, 487, 950, 884, 1180, 890, 891, 892, 893, 815,
+	1901, 1789, 1784, 867, 941, 776, 814, 809, 922, 1834,
+	1832, 1561, 822, 2242, 2247, 802, 927, 2122, 776, 2206,
+	974, 973, 983, 984, 976, 977, 978, 979, 980, 981,
+	982, 975, 2318, 1658, 985, 475, 1502, 2125, 479, 924,
+	1633, 1630, 1631, 2263, 1903, 813, 811, 1880, 1785, 762,
+	138, 2016, 2303, 762, 796, 762, 472, 760, 914, 1201,
+	1200, 802, 915, 784, 802, 795, 1302, 1710, 1712, 165,
+	1787, 473, 920, 1782, 898, 1568, 600, 1877, 1613, 1298,
+	1275, 1274, 1276, 1277, 1278, 1783, 935, 1057, 832, 1994,
+	474, 1861, 1926, 995, 913, 1925, 802, 487, 932, 933,
+	165, 885, 165, 165, 1056, 487, 132, 1924, 1905, 877,
+	1909, 487, 1904, 874, 1902, 1175, 1174, 838, 126, 1907,
+	609, 127, 1173, 802, 944, 942, 943, 1851, 1906, 1609,
+	1299, 1171, 1013, 1883, 73, 445, 440, 2227, 1882, 1518,
+	99, 1908, 1910, 2084, 1883, 1790, 1788, 1688, 802, 1882,
+	1052, 1966, 1873, 1734, 801, 1872, 1677, 1685, 997, 998,
+	1599, 795, 798, 799, 1292, 762, 1085, 1711, 1507, 792,
+	796, 1080, 1070, 983, 984, 976, 977, 978, 979, 980,
+	981, 982, 975, 2316, 1010, 985, 2317, 791, 2315, 1049,
+	1027, 1030, 1032, 1034, 1035, 1037, 1039, 1040, 1031, 1033,
+	801, 1036, 1038, 801, 1041, 879, 805, 795, 897, 805,
+	795, 807, 975, 1769, 807, 985, 806, 985, 1874, 806,
+	899, 1457, 139, 144, 141, 147, 148, 149, 150, 152,
+	153, 154, 155, 808, 613, 801, 1341, 883, 156, 157,
+	158, 159, 2207, 911, 1289, 1310, 1290, 1068, 965, 1291,
+	2238, 167, 168, 169, 824, 1394, 1608, 165, 1786, 2115,
+	1919, 1164, 801, 1875, 837, 963, 964, 962, 962, 1951,
+	1172, 974, 973, 983, 984, 976, 977, 978, 979, 980,
+	981, 982, 975, 965, 965, 985, 1623, 801, 1295, 997,
+	998, 487, 1106, 1197, 795, 798, 799, 94, 762, 997,
+	998, 1206, 792, 796, 870, 1210, 1462, 1463, 1213, 487,
+	487, 1395, 487, 1058, 487, 487, 945, 487, 487, 487,
+	487, 487, 487, 976, 977, 978, 979, 980, 981, 982,
+	975, 1670, 487, 985, 1427, 1821, 165, 1247, 1193, 1178,
+	1179, 1376, 95, 1186, 1601, 1207, 978, 979, 980, 981,
+	982, 975, 1260, 882, 985, 1374, 1375, 1373, 1205, 1427,
+	1606, 1695, 912, 487, 1311, 165, 1604, 812, 1605, 810,
+	1601, 1242, 1243, 1216, 2306, 1217, 1300, 1219, 1221, 1244,
+	165, 1225, 1227, 1229, 1231, 1233, 167, 168, 169, 966,
+	1797, 963, 964, 962, 1603, 1170, 165, 1662, 1663, 1664,
+	1250, 1251, 2307, 165, 1204, 1183, 1256, 1257, 1971, 965,
+	1184, 2294, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 487, 487, 487, 1196, 500, 1182, 1203, 1203, 2322,
+	1364, 1366, 1367, 1306, 1023, 963, 964, 962, 1073, 2295,
+	1314, 1459, 1365, 1921, 964, 962, 1798, 1318, 165, 1320,
+	1321, 1322, 1323, 965, 2183, 1683, 1327, 71, 1312, 1313,
+	2099, 965, 1245, 1682, 2098, 1101, 1062, 1065, 1303, 1372,
+	1342, 2035, 1317, 526, 525, 528, 529, 530, 531, 1324,
+	1325, 1326, 527, 1684, 532, 2002, 1393, 1282, 963, 964,
+	962, 1928, 1992, 779, 1806, 1396, 1280, 102, 1352, 1805,
+	2323, 778, 1565, 1270, 1283, 1370, 965, 1458, 1268, 487,
+	1267, 1176, 1266, 1258, 1316, 973, 983, 984, 976, 977,
+	978, 979, 980, 981, 982, 975, 1404, 1807, 985, 1252,
+	1249, 1248, 963, 964, 962, 1223, 2298, 1397, 1398, 2297,
+	2296, 2283, 1929, 487, 487, 1410, 1337, 1338, 1339, 1281,
+	965, 963, 964, 962, 165, 599, 1371, 604, 1279, 2281,
+	1415, 1418, 2150, 2096, 1406, 1269, 1428, 1452, 487, 965,
+	963, 964, 962, 2072, 1974, 165, 1930, 1464, 487, 1815,
+	1450, 1803, 165, 1405, 165, 167, 168, 169, 965, 1776,
+	1013, 1653, 165, 165, 1617, 1616, 1404, 1451, 1307, 487,
+	1271, 1259, 487, 1255, 1497, 1434, 1435, 167, 168, 169,
+	1254, 1578, 1253, 487, 609, 2029, 582, 609, 167, 168,
+	169, 80, 1576, 2177, 1408, 1409, 1830, 1407, 167, 168,
+	169, 2176, 1261, 2133, 1406, 167, 168, 169, 2011, 2286,
+	2011, 2269, 1843, 1500, 1818, 601, 602, 2011, 2236, 82,
+	1537, 1538, 1539, 1476, 1526, 1472, 2011, 582, 1521, 1950,
+	1522, 2011, 2228, 2127, 582, 1601, 582, 1730, 487, 1503,
+	1453, 2082, 582, 1730, 1571, 1572, 1573, 1411, 1412, 1575,
+	1577, 1417, 1420, 1421, 1829, 1602, 1525, 2011, 2018, 1984,
+	1983, 1980, 1981, 487, 1503, 1552, 1474, 1980, 1979, 487,
+	1481, 1558, 582, 1206, 2101, 1206, 2079, 1433, 1470, 582,
+	1436, 1437, 1508, 1600, 1509, 1505, 1502, 1860, 1470, 1590,
+	1167, 1845, 1471, 1524, 1523, 1838, 1839, 566, 613, 1482,
+	582, 613, 1939, 35, 1504, 961, 582, 1167, 1166, 1482,
+	1587, 1950, 1506, 1601, 487, 1950, 1393, 1112, 1111, 35,
+	2237, 1393, 1393, 35, 1482, 2102, 2103, 2104, 1737, 1504,
+	1597, 1763, 1598, 1238, 961, 2011, 1982, 1502, 1482, 1502,
+	1308, 1510, 1553, 1566, 1564, 1700, 166, 1563, 1699, 166,
+	1574, 1738, 166, 1548, 1549, 1470, 165, 488, 575, 166,
+	1601, 1610, 1584, 165, 1460, 1470, 1611, 166, 165, 165,
+	1593, 2166, 165, 1592, 165, 1553, 1596, 1612, 803, 1438,
+	165, 71, 1614, 1615, 1239, 1240, 1241, 165, 2234, 488,
+	804, 1350, 488, 166, 488, 1297, 1098, 71, 1960, 786,
+	1203, 71, 2005, 785, 71, 2199, 1620, 2132, 2090, 1169,
+	1551, 2028, 1594, 1547, 165, 487, 1541, 1540, 1359, 1360,
+	1361, 1362, 1285, 1198, 1194, 1165, 96, 1812, 1648, 1649,
+	869, 1954, 1955, 1651, 2031, 2105, 71, 2200, 1811, 1562,
+	1841, 2309, 1652, 2302, 1530, 1235, 1531, 1532, 1533, 1534,
+	582, 1999, 1998, 1997, 1957, 1939, 1822, 1642, 1343, 1959,
+	1641, 1754, 1542, 1543, 1544, 1545, 1755, 1752, 2291, 2270,
+	1751, 1750, 1753, 1413, 1414, 1487, 1490, 1491, 1492, 1488,
+	1370, 1489, 1493, 2106, 2107, 1931, 1812, 1719, 1954, 1955,
+	1067, 1679, 2083, 1236, 1237, 1728, 974, 973, 983, 984,
+	976, 977, 978, 979, 980, 981, 982, 975, 1727, 165,
+	985, 500, 1487, 1490, 1491, 1492, 1488, 165, 1489, 1493,
+	1656, 1756, 2251, 1491, 1492, 2221, 2223, 2293, 2276, 1665,
+	2250, 1371, 2274, 1717, 2224, 2254, 2218, 1296, 567, 1816,
+	834, 1718, 165, 1423, 833, 2042, 1811, 1060, 1886, 934,
+	1853, 2164, 1716, 165, 165, 165, 165, 165, 1424, 1061,
+	1852, 1739, 103, 1516, 1723, 165, 1678, 1976, 1975, 165,
+	1595, 573, 165, 165, 1212, 1735, 165, 165, 165, 1672,
+	1211, 1761, 1694, 1673, 1199, 2077, 1462, 1463, 2229, 1775,
+	1052, 1455, 1706, 1744, 1680, 1681, 1995, 1714, 1645, 2194,
+	1687, 1495, 1634, 1690, 1691, 1661, 1732, 1796, 576, 577,
+	1722, 1697, 579, 1698, 2282, 2280, 1701, 1702, 1703, 1704,
+	1705, 1726, 1554, 1731, 2279, 1793, 1794, 1733, 2255, 1725,
+	2253, 1306, 1715, 487, 1674, 1675, 1764, 1780, 165, 2076,
+	1766, 2010, 1757, 1746, 1747, 165, 1749, 1762, 1585, 1181,
+	580, 1745, 1767, 82, 1748, 1692, 487, 1770, 2075, 1934,
+	1730, 1558, 487, 1689, 1778, 487, 1781, 1206, 2311, 2310,
+	2311, 1686, 487, 1081, 1074, 592, 588, 2225, 1973, 1759,
+	1760, 1804, 1456, 575, 1857, 1848, 80, 85, 1814, 77,
+	1, 589, 458, 1439, 1813, 165, 165, 165, 165, 165,
+	1795, 1842, 1799, 1800, 1801, 1819, 592, 588, 1823, 1824,
+	1825, 1186, 165, 165, 1071, 1072, 591, 1050, 590, 1855,
+	470, 2300, 589, 1406, 1847, 1272, 1262, 2136, 2196, 2004,
+	1827, 1580, 1854, 1579, 1777, 2000, 1556, 794, 166, 128,
+	166, 1519, 1405, 166, 1520, 585, 586, 591, 487, 590,
+	2265, 93, 755, 92, 1393, 797, 896, 1586, 2128, 1791,
+	1528, 1118, 1878, 1897, 1116, 1846, 1117, 1115, 1120, 488,
+	488, 488, 1119, 1114, 1344, 484, 1494, 1856, 163, 1899,
+	1898, 1107, 1075, 835, 487, 1890, 448, 488, 488, 1884,
+	1985, 1340, 1885, 1618, 1918, 165, 454, 993, 1912, 1724,
+	1771, 610, 603, 1945, 2248, 487, 1896, 2217, 2219, 2160,
+	2222, 487, 487, 2215, 2292, 1911, 1937, 2273, 1527, 1454,
+	1897, 1063, 1940, 2074, 1933, 1693, 1022, 1425, 1090, 509,
+	1449, 1363, 524, 521, 165, 522, 1465, 1736, 967, 507,
+	1949, 501, 1082, 1486, 1484, 1943, 1483, 1927, 1643, 1744,
+	1094, 1956, 1952, 1088, 1469, 1863, 2025, 1894, 1895, 946,
+	1962, 1958, 1964, 584, 1965, 496, 166, 767, 1422, 2205,
+	1660, 2063, 583, 61, 38, 1948, 491, 2287, 937, 593,
+	32, 31, 30, 29, 1696, 28, 23, 1993, 22, 21,
+	20, 19, 25, 165, 488, 18, 1970, 166, 1963, 166,
+	166, 17, 488, 16, 98, 48, 45, 43, 488, 487,
+	105, 104, 2061, 46, 42, 1720, 1721, 1065, 872, 2067,
+	27, 1989, 165, 1946, 1988, 26, 15, 14, 13, 12,
+	11, 10, 165, 9, 5, 4, 940, 24, 2066, 1011,
+	1990, 1991, 2, 0, 1961, 0, 0, 0, 165, 0,
+	1558, 165, 0, 2013, 2017, 0, 2012, 0, 1977, 1978,
+	2043, 0, 2023, 2022, 0, 0, 974, 973, 983, 984,
+	976, 977, 978, 979, 980, 981, 982, 975, 0, 2046,
+	985, 0, 0, 2038, 2037, 974, 973, 983, 984, 976,
+	977, 978, 979, 980, 981, 982, 975, 0, 0, 985,
+	2040, 2041, 0, 0, 0, 0, 0, 0, 2051, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2073, 974, 973, 983, 984, 976, 977,
+	978, 979, 980, 981, 982, 975, 0, 0, 985, 0,
+	0, 2078, 0, 0, 0, 0, 0, 0, 0, 2087,
+	0, 2086, 0, 0, 166, 0, 0, 0, 0, 0,
+	0, 2093, 1744, 0, 2092, 165, 2094, 0, 165, 165,
+	165, 0, 0, 2095, 0, 2097, 0, 0, 487, 2045,
+	487, 487, 487, 2047, 0, 0, 0, 0, 488, 0,
+	0, 0, 0, 0, 2056, 2057, 0, 0, 2112, 0,
+	0, 0, 2137, 487, 487, 487, 488, 488, 0, 488,
+	2071, 488, 488, 0, 488, 488, 488, 488, 488, 488,
+	2143, 0, 0, 0, 0, 0, 0, 2080, 2081, 488,
+	0, 2085, 0, 166, 0, 0, 0, 0, 0, 487,
+	487, 487, 165, 2142, 2149, 2124, 2141, 0, 0, 0,
+	0, 0, 1920, 487, 0, 487, 0, 0, 0, 2157,
+	488, 487, 166, 0, 2167, 0, 2158, 2171, 0, 0,
+	2169, 2165, 2163, 2173, 0, 0, 536, 166, 2117, 0,
+	2119, 2120, 0, 0, 0, 487, 1943, 1935, 0, 2116,
+	1943, 2118, 0, 166, 0, 2189, 165, 0, 0, 2178,
+	166, 0, 2126, 487, 0, 0, 487, 0, 0, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 488, 488,
+	488, 2198, 2191, 0, 0, 164, 2048, 2049, 444, 2050,
+	2187, 482, 2052, 0, 2054, 0, 0, 0, 444, 2153,
+	2155, 2156, 0, 0, 0, 166, 444, 2214, 0, 0,
+	0, 2154, 0, 2226, 0, 0, 0, 0, 0, 0,
+	2174, 2172, 2175, 597, 597, 0, 0, 0, 0, 2233,
+	487, 165, 444, 1943, 0, 0, 0, 2235, 0, 0,
+	0, 0, 0, 0, 0, 2181, 0, 0, 0, 0,
+	0, 0, 0, 2231, 0, 2179, 2180, 2182, 487, 2252,
+	2184, 0, 2185, 2195, 2245, 487, 488, 0, 487, 487,
+	2256, 0, 2258, 2259, 0, 2264, 0, 0, 0, 0,
+	0, 0, 0, 2198, 2266, 2278, 2277, 2201, 2202, 2203,
+	2204, 0, 2208, 2284, 2209, 2210, 2211, 1744, 2212, 2213,
+	488, 488, 0, 2290, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 2230, 488, 2304, 2299, 0, 0,
+	2239, 0, 166, 2308, 0, 488, 0, 0, 2065, 166,
+	0, 166, 103, 2319, 125, 0, 0, 0, 2241, 166,
+	166, 0, 0, 145, 0, 0, 488, 0, 0, 488,
+	0, 500, 0, 0, 0, 2261, 0, 0, 2088, 0,
+	488, 2089, 0, 0, 2091, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 135, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2285, 0, 0, 0, 161, 0, 0,
+	142, 0, 143, 0, 0, 0, 0, 112, 113, 134,
+	133, 160, 0, 0, 0, 488, 0, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 0, 0, 0, 0, 0, 161,
+	488, 0, 0, 0, 0, 0, 488, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	110, 136, 117, 109, 103, 130, 131, 0, 0, 0,
+	146, 0, 0, 0, 0, 145, 0, 1779, 0, 535,
+	151, 118, 2162, 500, 0, 1891, 0, 0, 0, 0,
+	142, 488, 143, 0, 0, 121, 119, 114, 115, 116,
+	120, 160, 0, 0, 111, 974, 973, 983, 984, 976,
+	977, 978, 979, 980, 981, 982, 975, 122, 0, 985,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 166, 143, 0, 0, 0, 0, 486,
+	166, 0, 0, 160, 0, 166, 166, 0, 2060, 166,
+	0, 166, 0, 0, 0, 0, 0, 166, 0, 0,
+	146, 0, 0, 0, 166, 0, 0, 444, 0, 444,
+	151, 611, 444, 0, 759, 0, 766, 0, 0, 0,
+	0, 0, 0, 0, 2059, 0, 0, 0, 138, 0,
+	0, 166, 488, 0, 0, 500, 0, 969, 0, 972,
+	0, 0, 146, 0, 0, 986, 987, 988, 989, 990,
+	991, 992, 151, 970, 971, 968, 974, 973, 983, 984,
+	976, 977, 978, 979, 980, 981, 982, 975, 0, 0,
+	985, 0, 0, 0, 0, 0, 0, 0, 0, 500,
+	0, 0, 0, 0, 132, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 126, 0, 0, 127,
+	974, 973, 983, 984, 976, 977, 978, 979, 980, 981,
+	982, 975, 2058, 0, 985, 0, 0, 0, 138, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
+	0, 0, 0, 0, 166, 444, 974, 973, 983, 984,
+	976, 977, 978, 979, 980, 981, 982, 975, 0, 0,
+	985, 597, 0, 0, 0, 0, 0, 0, 0, 166,
+	138, 0, 0, 0, 0, 0, 444, 0, 444, 1097,
+	166, 166, 166, 166, 166, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 166, 0, 0, 166,
+	166, 0, 0, 166, 166, 166, 0, 0, 0, 0,
+	139, 144, 141, 147, 148, 149, 150, 152, 153, 154,
+	155, 0, 0, 0, 0, 0, 156, 157, 158, 159,
+	0, 0, 0, 0, 974, 973, 983, 984, 976, 977,
+	978, 979, 980, 981, 982, 975, 0, 0, 985, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	488, 0, 0, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 488, 0, 0, 0, 0, 0, 488,
+	0, 0, 488, 0, 0, 0, 0, 0, 0, 488,
+	139, 144, 141, 147, 148, 149, 150, 152, 153, 154,
+	155, 0, 0, 0, 0, 0, 156, 157, 158, 159,
+	0, 0, 166, 166, 166, 166, 166, 0, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 166,
+	166, 0, 139, 144, 141, 147, 148, 149, 150, 152,
+	153, 154, 155, 0, 0, 0, 0, 0, 156, 157,
+	158, 159, 1671, 974, 973, 983, 984, 976, 977, 978,
+	979, 980, 981, 982, 975, 488, 0, 985, 0, 0,
+	1209, 0, 974, 973, 983, 984, 976, 977, 978, 979,
+	980, 981, 982, 975, 0, 0, 985, 503, 0, 0,
+	0, 611, 611, 611, 0, 0, 1209, 1209, 0, 0,
+	0, 488, 444, 0, 0, 0, 0, 0, 0, 936,
+	938, 0, 166, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 488, 0, 0, 0, 0, 0, 488, 488,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1305, 0, 0, 0,
+	0, 166, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 1328, 1329,
+	444, 444, 444, 444, 444, 444, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 444, 0, 1078, 0, 0, 0,
+	0, 0, 0, 0, 611, 0, 488, 0, 0, 0,
+	1108, 0, 0, 0, 0, 0, 0, 1053, 0, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 597, 1305, 0, 0,
+	0, 597, 597, 0, 0, 597, 597, 597, 0, 443,
+	0, 1209, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 570, 0, 0,
+	0, 597, 597, 597, 597, 597, 0, 0, 0, 0,
+	1447, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 763, 0, 0, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 1305, 444, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 444, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 166, 166, 166, 0, 0,
+	0, 0, 0, 0, 0, 488, 0, 488, 488, 488,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	759, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	488, 488, 488, 1208, 0, 538, 34, 0, 1215, 1215,
+	0, 1215, 0, 1215, 1215, 0, 1224, 1215, 1215, 1215,
+	1215, 1215, 0, 0, 0, 0, 0, 0, 0, 1208,
+	1208, 759, 0, 0, 0, 0, 488, 488, 488, 166,
+	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	488, 0, 488, 0, 0, 0, 0, 0, 488, 0,
+	0, 0, 1284, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 488, 0, 0, 574, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
+	488, 0, 0, 488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	611, 611, 611, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 444, 444, 0, 0, 444, 0,
+	1646, 0, 0, 0, 0, 0, 444, 0, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 488, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 999,
+	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 0,
+	444, 0, 0, 0, 0, 488, 0, 0, 0, 0,
+	0, 0, 488, 0, 0, 488, 488, 0, 1399, 0,
+	611, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1431, 1432, 0, 0, 0, 0, 597, 597,
+	0, 0, 0, 0, 0, 0, 0, 0, 873, 0,
+	878, 0, 0, 880, 0, 0, 0, 1466, 0, 597,
+	0, 0, 0, 0, 0, 0, 0, 1078, 0, 0,
+	611, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 1447, 0, 0, 0, 0, 611, 0,
+	0, 611, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 759, 0, 0, 0, 0, 597, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1209, 444,
+	444, 444, 444, 444, 0, 0, 0, 0, 0, 0,
+	0, 1758, 0, 0, 0, 444, 0, 0, 444, 444,
+	0, 0, 444, 1768, 1305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 766, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 759, 0, 0, 0, 0, 0, 766, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 444, 0, 0, 1084, 0, 0,
+	1095, 1826, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1209, 0, 0, 0,
+	0, 0, 0, 759, 0, 0, 1305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 444, 444, 444, 444, 444, 0, 929, 929, 929,
+	0, 0, 0, 0, 0, 0, 0, 0, 444, 444,
+	0, 0, 0, 0, 0, 0, 0, 34, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 0, 442, 486, 0,
+	994, 996, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 597, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1009, 0, 0, 1655, 1014, 1015, 1016, 1017, 1018,
+	1019, 1020, 1021, 0, 1024, 1026, 1029, 1029, 1029, 1026,
+	1029, 1029, 1026, 1029, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 444, 0, 0, 1113, 0, 1054, 0, 0, 0,
+	34, 0, 0, 0, 1209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1091, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1368, 0, 0, 1377, 1378,
+	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
+	1389, 1390, 1391, 1246, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1208, 1286, 0, 0, 0, 0, 0, 1209, 0,
+	0, 0, 0, 0, 0, 0, 0, 1430, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 444, 0,
+	0, 0, 0, 1315, 0, 161, 0, 0, 0, 0,
+	1319, 0, 0, 0, 444, 0, 1185, 444, 0, 0,
+	0, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 0, 0,
+	103, 0, 125, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1817, 1135, 0, 1095, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 135, 0, 0, 1837, 0, 0, 0, 1208,
+	124, 1844, 0, 0, 1837, 0, 0, 1209, 0, 611,
+	0, 1849, 0, 0, 0, 0, 0, 0, 142, 0,
+	143, 0, 0, 0, 0, 1189, 1190, 134, 133, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 444, 0, 0, 444, 444, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 1191, 136,
+	0, 1188, 0, 130, 131, 0, 0, 611, 146, 1123,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 0,
+	0, 0, 1473, 0, 0, 0, 0, 0, 0, 1477,
+	0, 1480, 0, 0, 0, 0, 0, 0, 0, 0,
+	1499, 0, 0, 1215, 0, 0, 0, 0, 1447, 0,
+	0, 0, 0, 1136, 0, 0, 929, 929, 929, 0,
+	0, 0, 0, 0, 611, 0, 0, 1208, 0, 0,
+	1947, 1215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 1149, 1152, 1153, 1154,
+	1155, 1156, 1157, 0, 1158, 1159, 1160, 1161, 1162, 1137,
+	1138, 1139, 1140, 1121, 1122, 1150, 138, 1124, 0, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1141,
+	1142, 1143, 1144, 1145, 1146, 1147, 1148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 759, 0,
+	0, 1208, 1666, 1667, 1668, 0, 0, 444, 0, 0,
+	0, 0, 132, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 126, 0, 0, 127, 0, 0,
+	0, 0, 1209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1151, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1498, 0, 0,
+	0, 0, 0, 1095, 0, 0, 0, 0, 0, 0,
+	1629, 0, 0, 0, 0, 1639, 1640, 0, 0, 1644,
+	0, 0, 0, 0, 0, 0, 0, 1647, 0, 0,
+	0, 0, 0, 0, 1650, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1654, 0, 0, 0, 0, 0, 0, 139, 144,
+	141, 147, 148, 149, 150, 152, 153, 154, 155, 0,
+	0, 0, 0, 0, 156, 157, 158, 159, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 166, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 166, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 166, 0, 1359, 166, 0,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
-	1378, 1379, 1380, 1381, 1382, 0, 0, 0, 1046, 595,
-	1296, 0, 0, 0, 595, 595, 0, 0, 595, 595,
-	595, 0, 0, 0, 1201, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1200, 0,
-	0, 0, 0, 0, 595, 595, 595, 595, 595, 1421,
-	0, 0, 0, 1438, 0, 0, 0, 0, 0, 0,
-	441, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	488, 0, 0, 0, 442, 0, 0, 0, 568, 0,
-	1296, 442, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 442, 442, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 166, 0, 759, 166, 166, 166, 486, 486,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1800,
-	0, 0, 0, 0, 0, 0, 0, 0, 486, 486,
-	486, 0, 0, 0, 0, 0, 0, 1812, 0, 0,
-	0, 1200, 0, 1819, 0, 0, 1812, 0, 0, 0,
-	0, 609, 0, 1824, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 486, 486, 486, 166, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 486, 0, 486,
-	0, 0, 0, 0, 0, 486, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 0, 486, 0, 0,
-	486, 0, 0, 0, 0, 0, 0, 0, 609, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 922, 922, 922,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1206, 0, 0, 34, 0, 0,
-	0, 0, 0, 0, 486, 166, 0, 0, 0, 0,
-	987, 989, 0, 442, 0, 609, 0, 0, 1200, 0,
-	442, 1920, 1206, 0, 0, 442, 442, 0, 0, 442,
-	0, 1631, 486, 0, 0, 0, 486, 442, 0, 486,
-	486, 1002, 0, 0, 442, 1007, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 0, 1017, 1019, 1022, 1022, 1022, 1019,
-	1022, 1022, 1019, 1022, 1035, 1036, 1037, 1038, 1039, 1040,
-	1041, 442, 0, 0, 0, 0, 1047, 0, 0, 0,
-	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1837, 0, 1837,
+	1837, 2121, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1084, 0, 755,
-	0, 0, 1200, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1651, 1652, 1653, 0, 0, 0, 0, 595,
-	595, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	595, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 442, 0, 0, 0,
-	0, 0, 0, 0, 1438, 0, 0, 866, 0, 871,
-	0, 0, 873, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 595, 442,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1201,
-	442, 442, 442, 442, 442, 0, 0, 0, 0, 1200,
-	0, 0, 1743, 0, 0, 0, 442, 0, 0, 442,
-	442, 0, 0, 442, 1753, 1296, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1812,
-	2073, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2088,
-	2089, 2090, 0, 0, 161, 442, 0, 0, 0, 0,
-	0, 0, 1809, 0, 0, 1810, 0, 0, 0, 0,
-	0, 0, 1201, 0, 0, 0, 0, 0, 0, 103,
-	0, 125, 1296, 0, 1812, 1812, 1812, 0, 0, 0,
-	145, 0, 0, 0, 0, 0, 1077, 0, 2118, 1088,
-	2120, 0, 0, 0, 0, 0, 1812, 442, 442, 442,
-	442, 442, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 135, 0, 442, 442, 0, 124, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1812, 0,
-	0, 609, 0, 0, 142, 0, 143, 0, 0, 0,
-	0, 1181, 1182, 134, 133, 160, 0, 0, 595, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1865, 1866, 0, 0, 0, 0, 0, 0,
-	0, 922, 922, 922, 0, 0, 0, 1886, 1887, 0,
-	1888, 1889, 0, 0, 0, 1812, 0, 0, 0, 0,
-	0, 1895, 1896, 129, 1183, 136, 442, 1180, 0, 130,
-	131, 0, 0, 0, 146, 0, 0, 0, 0, 1201,
-	0, 1200, 0, 2194, 151, 0, 0, 1812, 0, 0,
-	609, 609, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 1106, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1945, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1201, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 442, 0, 0, 0, 1237, 0, 0,
-	138, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 442, 0, 0,
-	442, 0, 1489, 0, 0, 0, 1277, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2004, 0, 0, 0, 0, 132, 1306, 0, 0,
-	0, 0, 0, 0, 1310, 0, 0, 0, 126, 0,
-	0, 127, 0, 0, 0, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 0, 0, 0, 0, 0, 0, 0, 0,
-	1201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1088,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 0, 0, 442, 0, 0, 442, 442, 442,
-	0, 1177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 103, 0, 125, 0, 0,
-	0, 0, 0, 0, 0, 0, 145, 0, 0, 0,
-	0, 0, 139, 144, 141, 147, 148, 149, 150, 152,
-	153, 154, 155, 0, 0, 0, 0, 0, 156, 157,
-	158, 159, 0, 0, 0, 0, 0, 135, 1438, 0,
-	0, 0, 124, 0, 0, 2094, 2095, 2096, 2097, 2098,
-	0, 0, 0, 2101, 2102, 0, 0, 0, 0, 0,
-	142, 0, 143, 0, 0, 0, 0, 1181, 1182, 134,
-	133, 160, 0, 0, 0, 0, 1464, 0, 0, 0,
-	0, 0, 442, 1468, 0, 1471, 0, 0, 0, 0,
-	0, 0, 0, 0, 1490, 0, 0, 0, 0, 0,
+	0, 0, 2138, 2139, 2140, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1128, 0, 0, 0, 129,
-	1183, 136, 0, 1180, 0, 130, 131, 0, 0, 0,
-	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	151, 0, 0, 0, 0, 0, 0, 442, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1837, 1837,
+	1837, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2168, 0, 2170, 0, 0, 0, 0, 0,
+	1837, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1201, 1661, 0, 0, 572, 0, 0, 0,
+	0, 0, 0, 0, 1837, 0, 0, 0, 0, 0,
+	1765, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1837, 0, 0, 611, 0, 0, 1892, 1893,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2196, 0, 0,
+	0, 0, 0, 1913, 1914, 0, 1915, 1916, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1922, 1923, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1698, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1116, 0,
+	0, 0, 0, 0, 0, 1820, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1837,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1084, 0, 0, 0, 0, 0, 138, 1725, 1726, 0,
-	0, 1084, 1084, 1084, 1084, 1084, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1489, 0, 0,
-	1084, 0, 1129, 0, 1084, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1088, 0, 0, 0, 0,
-	0, 0, 1615, 0, 0, 0, 0, 1624, 1625, 0,
-	0, 1629, 132, 0, 0, 0, 0, 0, 0, 1632,
-	0, 0, 0, 0, 126, 0, 1635, 127, 0, 0,
-	0, 0, 0, 1142, 1145, 1146, 1147, 1148, 1149, 1150,
-	0, 1151, 1152, 1153, 1154, 1155, 1130, 1131, 1132, 1133,
-	1114, 1115, 1143, 1639, 1117, 0, 1118, 1119, 1120, 1121,
-	1122, 1123, 1124, 1125, 1126, 1127, 1134, 1135, 1136, 1137,
-	1138, 1139, 1140, 1141, 0, 0, 1825, 0, 0, 0,
+	1676, 0, 0, 574, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1208, 0, 2257, 0, 0,
+	0, 0, 0, 0, 1837, 0, 0, 611, 611, 0,
+	0, 0, 1867, 1868, 1869, 1870, 1871, 0, 0, 0,
+	1713, 0, 1972, 0, 0, 0, 0, 0, 0, 1095,
+	1879, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1091, 0, 0,
+	0, 0, 0, 0, 1740, 1741, 0, 0, 1091, 1091,
+	1091, 1091, 1091, 0, 0, 0, 0, 0, 0, 2006,
+	0, 2008, 0, 0, 1498, 0, 0, 1091, 0, 0,
+	0, 1091, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 35, 36, 37, 72, 39, 40,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1932, 0, 76, 0, 0, 0, 0, 41,
+	67, 68, 0, 65, 69, 0, 0, 0, 0, 2044,
+	66, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1831, 1833, 54,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 71, 0, 0, 0, 0, 0, 1850, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 139, 144,
-	141, 147, 148, 149, 150, 152, 153, 154, 155, 0,
-	0, 1144, 0, 0, 156, 157, 158, 159, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1996, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2021,
+	0, 2113, 0, 0, 44, 47, 50, 49, 52, 2024,
+	64, 0, 0, 70, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2036, 0, 0, 2039, 0,
+	0, 0, 0, 0, 0, 0, 53, 75, 74, 0,
+	0, 62, 63, 51, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2144, 2145, 2146, 2147,
+	2148, 0, 0, 0, 2151, 2152, 0, 0, 0, 0,
+	0, 0, 0, 0, 1944, 0, 34, 0, 0, 0,
+	0, 0, 0, 0, 55, 56, 0, 57, 58, 59,
+	60, 0, 0, 0, 0, 0, 0, 0, 0, 1091,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1917, 1750, 34, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1084, 0, 0, 0,
+	0, 0, 2108, 0, 0, 2109, 2110, 2111, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2001, 0, 2003, 0, 0, 2007, 0,
+	2009, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 73,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1803, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1842,
-	1843, 1844, 1845, 1846, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1088, 1852, 0, 0, 0,
+	0, 0, 0, 2062, 0, 0, 0, 0, 0, 0,
+	2068, 2069, 2070, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2190, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2022, 0,
-	0, 0, 0, 0, 0, 2028, 2029, 2030, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1905, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2114, 0, 0, 0, 0, 0, 0, 0, 2240, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1944, 0, 34, 0, 1944,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1969, 0, 0, 1917, 0, 34,
-	0, 1917, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1982, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1985, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1996,
-	0, 0, 1999, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2188,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1917, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2170, 0, 0,
-	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 34, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1944, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2068, 0, 0, 2069,
-	2070, 2071, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 734, 721, 0,
-	0, 670, 737, 641, 659, 746, 661, 664, 704, 620,
-	683, 313, 656, 0, 645, 616, 652, 617, 643, 672,
-	222, 676, 640, 723, 686, 736, 271, 0, 622, 646,
-	327, 706, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 743, 275, 693, 0,
-	374, 298, 0, 0, 0, 674, 726, 681, 717, 669,
-	705, 630, 692, 738, 657, 701, 739, 261, 206, 175,
-	310, 375, 236, 0, 0, 0, 167, 168, 169, 0,
-	2204, 2205, 0, 0, 2130, 0, 0, 197, 0, 204,
-	698, 733, 654, 700, 218, 259, 224, 217, 390, 703,
-	749, 615, 695, 0, 618, 621, 745, 729, 649, 650,
-	0, 0, 0, 0, 0, 0, 0, 673, 682, 714,
-	667, 0, 0, 0, 0, 0, 0, 0, 0, 647,
-	0, 691, 0, 0, 0, 626, 619, 0, 0, 0,
-	0, 671, 0, 0, 0, 629, 0, 648, 715, 2177,
-	613, 244, 623, 299, 0, 719, 728, 668, 422, 732,
-	666, 665, 735, 710, 627, 725, 660, 270, 625, 267,
-	171, 186, 0, 658, 309, 348, 354, 724, 644, 653,
-	209, 651, 352, 323, 407, 193, 234, 345, 328, 350,
-	690, 708, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 924, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
-	196, 639, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 720, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 712, 748, 322, 353, 199, 409, 373, 634, 638,
-	632, 633, 684, 685, 635, 740, 741, 742, 716, 628,
-	0, 636, 637, 0, 722, 730, 731, 689, 170, 183,
-	273, 744, 342, 237, 435, 416, 412, 614, 631, 215,
-	642, 0, 0, 655, 662, 663, 675, 677, 678, 679,
-	680, 688, 696, 697, 699, 707, 709, 711, 713, 718,
-	727, 747, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 687, 694, 283, 231, 248, 258, 702,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 734, 721,
-	0, 0, 670, 737, 641, 659, 746, 661, 664, 704,
-	620, 683, 313, 656, 0, 645, 616, 652, 617, 643,
-	672, 222, 676, 640, 723, 686, 736, 271, 0, 622,
-	646, 327, 706, 365, 208, 280, 278, 393, 232, 225,
-	221, 207, 255, 286, 325, 383, 319, 743, 275, 693,
-	0, 374, 298, 0, 0, 0, 674, 726, 681, 717,
-	669, 705, 630, 692, 738, 657, 701, 739, 261, 206,
-	175, 310, 375, 236, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 197, 0,
-	204, 698, 733, 654, 700, 218, 259, 224, 217, 390,
-	703, 749, 615, 695, 0, 618, 621, 745, 729, 649,
-	650, 0, 0, 0, 0, 0, 0, 0, 673, 682,
-	714, 667, 0, 0, 0, 0, 0, 0, 1909, 0,
-	647, 0, 691, 0, 0, 0, 626, 619, 0, 0,
-	0, 0, 671, 0, 0, 0, 629, 0, 648, 715,
-	0, 613, 244, 623, 299, 0, 719, 728, 668, 422,
-	732, 666, 665, 735, 710, 627, 725, 660, 270, 625,
-	267, 171, 186, 0, 658, 309, 348, 354, 724, 644,
-	653, 209, 651, 352, 323, 407, 193, 234, 345, 328,
-	350, 690, 708, 351, 276, 395, 340, 405, 423, 424,
-	216, 303, 413, 387, 419, 434, 187, 213, 317, 380,
-	410, 371, 296, 391, 392, 266, 370, 242, 174, 274,
-	431, 185, 360, 201, 178, 382, 403, 198, 363, 0,
-	0, 0, 180, 401, 379, 293, 263, 264, 179, 0,
-	344, 220, 240, 211, 312, 398, 399, 210, 436, 189,
-	418, 182, 924, 417, 305, 394, 402, 294, 285, 181,
-	400, 292, 284, 269, 230, 250, 338, 279, 339, 251,
-	301, 300, 302, 0, 176, 0, 376, 411, 437, 194,
-	195, 196, 639, 229, 233, 239, 241, 0, 247, 254,
-	272, 316, 337, 335, 341, 720, 389, 406, 414, 421,
-	427, 428, 432, 429, 430, 433, 304, 190, 253, 372,
-	268, 277, 712, 748, 322, 353, 199, 409, 373, 634,
-	638, 632, 633, 684, 685, 635, 740, 741, 742, 716,
-	628, 0, 636, 637, 0, 722, 730, 731, 689, 170,
-	183, 273, 744, 342, 237, 435, 416, 412, 614, 631,
-	215, 642, 0, 0, 655, 662, 663, 675, 677, 678,
-	679, 680, 688, 696, 697, 699, 707, 709, 711, 713,
-	718, 727, 747, 172, 173, 184, 192, 202, 214, 227,
-	235, 245, 249, 252, 256, 257, 260, 265, 282, 287,
-	288, 289, 290, 306, 307, 308, 311, 314, 315, 318,
-	320, 321, 324, 330, 331, 332, 333, 334, 336, 343,
-	347, 355, 356, 357, 358, 359, 361, 362, 366, 367,
-	368, 369, 377, 381, 396, 397, 408, 420, 425, 246,
-	404, 426, 0, 281, 687, 694, 283, 231, 248, 258,
-	702, 415, 378, 188, 349, 238, 177, 205, 191, 212,
-	226, 228, 262, 291, 297, 326, 329, 243, 223, 203,
-	346, 200, 364, 384, 385, 386, 388, 295, 219, 734,
-	721, 0, 0, 670, 737, 641, 659, 746, 661, 664,
-	704, 620, 683, 313, 656, 0, 645, 616, 652, 617,
-	643, 672, 222, 676, 640, 723, 686, 736, 271, 0,
-	622, 646, 327, 706, 365, 208, 280, 278, 393, 232,
-	225, 221, 207, 255, 286, 325, 383, 319, 743, 275,
-	693, 0, 374, 298, 0, 0, 0, 674, 726, 681,
-	717, 669, 705, 630, 692, 738, 657, 701, 739, 261,
-	206, 175, 310, 375, 236, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
-	0, 204, 698, 733, 654, 700, 218, 259, 224, 217,
-	390, 703, 749, 615, 695, 0, 618, 621, 745, 729,
-	649, 650, 0, 0, 0, 0, 0, 0, 0, 673,
-	682, 714, 667, 0, 0, 0, 0, 0, 0, 1754,
-	0, 647, 0, 691, 0, 0, 0, 626, 619, 0,
-	0, 0, 0, 671, 0, 0, 0, 629, 0, 648,
-	715, 0, 613, 244, 623, 299, 0, 719, 728, 668,
-	422, 732, 666, 665, 735, 710, 627, 725, 660, 270,
-	625, 267, 171, 186, 0, 658, 309, 348, 354, 724,
-	644, 653, 209, 651, 352, 323, 407, 193, 234, 345,
-	328, 350, 690, 708, 351, 276, 395, 340, 405, 423,
-	424, 216, 303, 413, 387, 419, 434, 187, 213, 317,
-	380, 410, 371, 296, 391, 392, 266, 370, 242, 174,
-	274, 431, 185, 360, 201, 178, 382, 403, 198, 363,
-	0, 0, 0, 180, 401, 379, 293, 263, 264, 179,
-	0, 344, 220, 240, 211, 312, 398, 399, 210, 436,
-	189, 418, 182, 924, 417, 305, 394, 402, 294, 285,
-	181, 400, 292, 284, 269, 230, 250, 338, 279, 339,
-	251, 301, 300, 302, 0, 176, 0, 376, 411, 437,
-	194, 195, 196, 639, 229, 233, 239, 241, 0, 247,
-	254, 272, 316, 337, 335, 341, 720, 389, 406, 414,
-	421, 427, 428, 432, 429, 430, 433, 304, 190, 253,
-	372, 268, 277, 712, 748, 322, 353, 199, 409, 373,
-	634, 638, 632, 633, 684, 685, 635, 740, 741, 742,
-	716, 628, 0, 636, 637, 0, 722, 730, 731, 689,
-	170, 183, 273, 744, 342, 237, 435, 416, 412, 614,
-	631, 215, 642, 0, 0, 655, 662, 663, 675, 677,
-	678, 679, 680, 688, 696, 697, 699, 707, 709, 711,
-	713, 718, 727, 747, 172, 173, 184, 192, 202, 214,
-	227, 235, 245, 249, 252, 256, 257, 260, 265, 282,
-	287, 288, 289, 290, 306, 307, 308, 311, 314, 315,
-	318, 320, 321, 324, 330, 331, 332, 333, 334, 336,
-	343, 347, 355, 356, 357, 358, 359, 361, 362, 366,
-	367, 368, 369, 377, 381, 396, 397, 408, 420, 425,
-	246, 404, 426, 0, 281, 687, 694, 283, 231, 248,
-	258, 702, 415, 378, 188, 349, 238, 177, 205, 191,
-	212, 226, 228, 262, 291, 297, 326, 329, 243, 223,
-	203, 346, 200, 364, 384, 385, 386, 388, 295, 219,
-	734, 721, 0, 0, 670, 737, 641, 659, 746, 661,
-	664, 704, 620, 683, 313, 656, 0, 645, 616, 652,
-	617, 643, 672, 222, 676, 640, 723, 686, 736, 271,
-	0, 622, 646, 327, 706, 365, 208, 280, 278, 393,
-	232, 225, 221, 207, 255, 286, 325, 383, 319, 743,
-	275, 693, 0, 374, 298, 0, 0, 0, 674, 726,
-	681, 717, 669, 705, 630, 692, 738, 657, 701, 739,
-	261, 206, 175, 310, 375, 236, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	197, 0, 204, 698, 733, 654, 700, 218, 259, 224,
-	217, 390, 703, 749, 615, 695, 0, 618, 621, 745,
-	729, 649, 650, 0, 0, 0, 0, 0, 0, 0,
-	673, 682, 714, 667, 0, 0, 0, 0, 0, 0,
-	1466, 0, 647, 0, 691, 0, 0, 0, 626, 619,
-	0, 0, 0, 0, 671, 0, 0, 0, 629, 0,
-	648, 715, 0, 613, 244, 623, 299, 0, 719, 728,
-	668, 422, 732, 666, 665, 735, 710, 627, 725, 660,
-	270, 625, 267, 171, 186, 0, 658, 309, 348, 354,
-	724, 644, 653, 209, 651, 352, 323, 407, 193, 234,
-	345, 328, 350, 690, 708, 351, 276, 395, 340, 405,
-	423, 424, 216, 303, 413, 387, 419, 434, 187, 213,
-	317, 380, 410, 371, 296, 391, 392, 266, 370, 242,
-	174, 274, 431, 185, 360, 201, 178, 382, 403, 198,
-	363, 0, 0, 0, 180, 401, 379, 293, 263, 264,
-	179, 0, 344, 220, 240, 211, 312, 398, 399, 210,
-	436, 189, 418, 182, 924, 417, 305, 394, 402, 294,
-	285, 181, 400, 292, 284, 269, 230, 250, 338, 279,
-	339, 251, 301, 300, 302, 0, 176, 0, 376, 411,
-	437, 194, 195, 196, 639, 229, 233, 239, 241, 0,
-	247, 254, 272, 316, 337, 335, 341, 720, 389, 406,
-	414, 421, 427, 428, 432, 429, 430, 433, 304, 190,
-	253, 372, 268, 277, 712, 748, 322, 353, 199, 409,
-	373, 634, 638, 632, 633, 684, 685, 635, 740, 741,
-	742, 716, 628, 0, 636, 637, 0, 722, 730, 731,
-	689, 170, 183, 273, 744, 342, 237, 435, 416, 412,
-	614, 631, 215, 642, 0, 0, 655, 662, 663, 675,
-	677, 678, 679, 680, 688, 696, 697, 699, 707, 709,
-	711, 713, 718, 727, 747, 172, 173, 184, 192, 202,
+	0, 0, 2232, 0, 0, 0, 0, 0, 0, 0,
+	34, 0, 0, 0, 0, 738, 725, 0, 0, 672,
+	741, 643, 661, 750, 663, 666, 707, 622, 685, 314,
+	658, 0, 647, 618, 654, 619, 645, 674, 222, 678,
+	642, 727, 689, 740, 271, 34, 624, 648, 328, 709,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 747, 275, 696, 0, 376, 299,
+	0, 0, 0, 676, 730, 683, 721, 671, 708, 632,
+	695, 742, 659, 0, 174, 352, 704, 743, 261, 206,
+	175, 311, 377, 236, 0, 0, 0, 167, 168, 169,
+	0, 2267, 2268, 0, 0, 0, 0, 0, 197, 0,
+	204, 701, 737, 656, 703, 218, 259, 224, 217, 392,
+	706, 753, 617, 698, 0, 620, 623, 749, 733, 651,
+	652, 0, 0, 0, 0, 0, 0, 0, 675, 684,
+	718, 669, 0, 0, 0, 0, 0, 0, 0, 0,
+	649, 0, 694, 0, 0, 0, 628, 621, 0, 0,
+	0, 0, 673, 0, 0, 0, 631, 0, 650, 719,
+	0, 615, 244, 625, 300, 0, 723, 732, 670, 424,
+	736, 668, 667, 739, 713, 629, 729, 662, 270, 627,
+	267, 171, 186, 0, 660, 310, 350, 356, 728, 646,
+	655, 209, 653, 354, 324, 409, 193, 234, 347, 329,
+	693, 711, 353, 276, 397, 342, 407, 330, 686, 714,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 931, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 641, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 724, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 716, 752, 323, 355, 199, 411,
+	375, 636, 640, 634, 635, 687, 688, 637, 744, 745,
+	746, 720, 630, 0, 638, 639, 0, 726, 734, 735,
+	692, 170, 183, 273, 748, 344, 237, 437, 418, 414,
+	616, 633, 215, 644, 0, 0, 657, 664, 665, 677,
+	679, 680, 681, 682, 691, 699, 700, 702, 710, 712,
+	715, 717, 722, 731, 751, 172, 173, 184, 192, 202,
 	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
-	282, 287, 288, 289, 290, 306, 307, 308, 311, 314,
-	315, 318, 320, 321, 324, 330, 331, 332, 333, 334,
-	336, 343, 347, 355, 356, 357, 358, 359, 361, 362,
-	366, 367, 368, 369, 377, 381, 396, 397, 408, 420,
-	425, 246, 404, 426, 0, 281, 687, 694, 283, 231,
-	248, 258, 702, 415, 378, 188, 349, 238, 177, 205,
-	191, 212, 226, 228, 262, 291, 297, 326, 329, 243,
-	223, 203, 346, 200, 364, 384, 385, 386, 388, 295,
-	219, 734, 721, 0, 0, 670, 737, 641, 659, 746,
-	661, 664, 704, 620, 683, 313, 656, 0, 645, 616,
-	652, 617, 643, 672, 222, 676, 640, 723, 686, 736,
-	271, 0, 622, 646, 327, 706, 365, 208, 280, 278,
-	393, 232, 225, 221, 207, 255, 286, 325, 383, 319,
-	743, 275, 693, 0, 374, 298, 0, 0, 0, 674,
-	726, 681, 717, 669, 705, 630, 692, 738, 657, 701,
-	739, 261, 206, 175, 310, 375, 236, 71, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 197, 0, 204, 698, 733, 654, 700, 218, 259,
-	224, 217, 390, 703, 749, 615, 695, 0, 618, 621,
-	745, 729, 649, 650, 0, 0, 0, 0, 0, 0,
-	0, 673, 682, 714, 667, 0, 0, 0, 0, 0,
-	0, 0, 0, 647, 0, 691, 0, 0, 0, 626,
-	619, 0, 0, 0, 0, 671, 0, 0, 0, 629,
-	0, 648, 715, 0, 613, 244, 623, 299, 0, 719,
-	728, 668, 422, 732, 666, 665, 735, 710, 627, 725,
-	660, 270, 625, 267, 171, 186, 0, 658, 309, 348,
-	354, 724, 644, 653, 209, 651, 352, 323, 407, 193,
-	234, 345, 328, 350, 690, 708, 351, 276, 395, 340,
-	405, 423, 424, 216, 303, 413, 387, 419, 434, 187,
-	213, 317, 380, 410, 371, 296, 391, 392, 266, 370,
-	242, 174, 274, 431, 185, 360, 201, 178, 382, 403,
-	198, 363, 0, 0, 0, 180, 401, 379, 293, 263,
-	264, 179, 0, 344, 220, 240, 211, 312, 398, 399,
-	210, 436, 189, 418, 182, 924, 417, 305, 394, 402,
-	294, 285, 181, 400, 292, 284, 269, 230, 250, 338,
-	279, 339, 251, 301, 300, 302, 0, 176, 0, 376,
-	411, 437, 194, 195, 196, 639, 229, 233, 239, 241,
-	0, 247, 254, 272, 316, 337, 335, 341, 720, 389,
-	406, 414, 421, 427, 428, 432, 429, 430, 433, 304,
-	190, 253, 372, 268, 277, 712, 748, 322, 353, 199,
-	409, 373, 634, 638, 632, 633, 684, 685, 635, 740,
-	741, 742, 716, 628, 0, 636, 637, 0, 722, 730,
-	731, 689, 170, 183, 273, 744, 342, 237, 435, 416,
-	412, 614, 631, 215, 642, 0, 0, 655, 662, 663,
-	675, 677, 678, 679, 680, 688, 696, 697, 699, 707,
-	709, 711, 713, 718, 727, 747, 172, 173, 184, 192,
-	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
-	265, 282, 287, 288, 289, 290, 306, 307, 308, 311,
-	314, 315, 318, 320, 321, 324, 330, 331, 332, 333,
-	334, 336, 343, 347, 355, 356, 357, 358, 359, 361,
-	362, 366, 367, 368, 369, 377, 381, 396, 397, 408,
-	420, 425, 246, 404, 426, 0, 281, 687, 694, 283,
-	231, 248, 258, 702, 415, 378, 188, 349, 238, 177,
-	205, 191, 212, 226, 228, 262, 291, 297, 326, 329,
-	243, 223, 203, 346, 200, 364, 384, 385, 386, 388,
-	295, 219, 734, 721, 0, 0, 670, 737, 641, 659,
-	746, 661, 664, 704, 620, 683, 313, 656, 0, 645,
-	616, 652, 617, 643, 672, 222, 676, 640, 723, 686,
-	736, 271, 0, 622, 646, 327, 706, 365, 208, 280,
-	278, 393, 232, 225, 221, 207, 255, 286, 325, 383,
-	319, 743, 275, 693, 0, 374, 298, 0, 0, 0,
-	674, 726, 681, 717, 669, 705, 630, 692, 738, 657,
-	701, 739, 261, 206, 175, 310, 375, 236, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 197, 0, 204, 698, 733, 654, 700, 218,
-	259, 224, 217, 390, 703, 749, 615, 695, 0, 618,
-	621, 745, 729, 649, 650, 0, 0, 0, 0, 0,
-	0, 0, 673, 682, 714, 667, 0, 0, 0, 0,
-	0, 0, 0, 0, 647, 0, 691, 0, 0, 0,
-	626, 619, 0, 0, 0, 0, 671, 0, 0, 0,
-	629, 0, 648, 715, 0, 613, 244, 623, 299, 0,
-	719, 728, 668, 422, 732, 666, 665, 735, 710, 627,
-	725, 660, 270, 625, 267, 171, 186, 0, 658, 309,
-	348, 354, 724, 644, 653, 209, 651, 352, 323, 407,
-	193, 234, 345, 328, 350, 690, 708, 351, 276, 395,
-	340, 405, 423, 424, 216, 303, 413, 387, 419, 434,
-	187, 213, 317, 380, 410, 371, 296, 391, 392, 266,
-	370, 242, 174, 274, 431, 185, 360, 201, 178, 382,
-	403, 198, 363, 0, 0, 0, 180, 401, 379, 293,
-	263, 264, 179, 0, 344, 220, 240, 211, 312, 398,
-	399, 210, 436, 189, 418, 182, 924, 417, 305, 394,
-	402, 294, 285, 181, 400, 292, 284, 269, 230, 250,
-	338, 279, 339, 251, 301, 300, 302, 0, 176, 0,
-	376, 411, 437, 194, 195, 196, 639, 229, 233, 239,
-	241, 0, 247, 254, 272, 316, 337, 335, 341, 720,
-	389, 406, 414, 421, 427, 428, 432, 429, 430, 433,
-	304, 190, 253, 372, 268, 277, 712, 748, 322, 353,
-	199, 409, 373, 634, 638, 632, 633, 684, 685, 635,
-	740, 741, 742, 716, 628, 0, 636, 637, 0, 722,
-	730, 731, 689, 170, 183, 273, 744, 342, 237, 435,
-	416, 412, 614, 631, 215, 642, 0, 0, 655, 662,
-	663, 675, 677, 678, 679, 680, 688, 696, 697, 699,
-	707, 709, 711, 713, 718, 727, 747, 172, 173, 184,
-	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
-	260, 265, 282, 287, 288, 289, 290, 306, 307, 308,
-	311, 314, 315, 318, 320, 321, 324, 330, 331, 332,
-	333, 334, 336, 343, 347, 355, 356, 357, 358, 359,
-	361, 362, 366, 367, 368, 369, 377, 381, 396, 397,
-	408, 420, 425, 246, 404, 426, 0, 281, 687, 694,
-	283, 231, 248, 258, 702, 415, 378, 188, 349, 238,
-	177, 205, 191, 212, 226, 228, 262, 291, 297, 326,
-	329, 243, 223, 203, 346, 200, 364, 384, 385, 386,
-	388, 295, 219, 734, 721, 0, 0, 670, 737, 641,
-	659, 746, 661, 664, 704, 620, 683, 313, 656, 0,
-	645, 616, 652, 617, 643, 672, 222, 676, 640, 723,
-	686, 736, 271, 0, 622, 646, 327, 706, 365, 208,
-	280, 278, 393, 232, 225, 221, 207, 255, 286, 325,
-	383, 319, 743, 275, 693, 0, 374, 298, 0, 0,
-	0, 674, 726, 681, 717, 669, 705, 630, 692, 738,
-	657, 701, 739, 261, 206, 175, 310, 375, 236, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 197, 0, 204, 698, 733, 654, 700,
-	218, 259, 224, 217, 390, 703, 749, 615, 695, 0,
-	618, 621, 745, 729, 649, 650, 0, 0, 0, 0,
-	0, 0, 0, 673, 682, 714, 667, 0, 0, 0,
-	0, 0, 0, 0, 0, 647, 0, 691, 0, 0,
-	0, 626, 619, 0, 0, 0, 0, 671, 0, 0,
-	0, 629, 0, 648, 715, 0, 613, 244, 623, 299,
-	0, 719, 728, 668, 422, 732, 666, 665, 735, 710,
-	627, 725, 660, 270, 625, 267, 171, 186, 0, 658,
-	309, 348, 354, 724, 644, 653, 209, 651, 352, 323,
-	407, 193, 234, 345, 328, 350, 690, 708, 351, 276,
-	395, 340, 405, 423, 424, 216, 303, 413, 387, 419,
-	434, 187, 213, 317, 380, 410, 371, 296, 391, 392,
-	266, 370, 242, 174, 274, 431, 185, 360, 201, 178,
-	382, 403, 198, 363, 0, 0, 0, 180, 401, 379,
-	293, 263, 264, 179, 0, 344, 220, 240, 211, 312,
-	398, 399, 210, 436, 189, 418, 182, 624, 417, 305,
-	394, 402, 294, 285, 181, 400, 292, 284, 269, 230,
-	250, 338, 279, 339, 251, 301, 300, 302, 0, 176,
-	0, 376, 411, 437, 194, 195, 196, 639, 229, 233,
-	239, 241, 0, 247, 254, 272, 316, 337, 335, 341,
-	720, 389, 406, 414, 421, 427, 428, 432, 429, 430,
-	433, 612, 750, 606, 605, 268, 277, 712, 748, 322,
-	353, 199, 409, 373, 634, 638, 632, 633, 684, 685,
-	635, 740, 741, 742, 716, 628, 0, 636, 637, 0,
-	722, 730, 731, 689, 170, 183, 273, 744, 342, 237,
-	435, 416, 412, 614, 631, 215, 642, 0, 0, 655,
-	662, 663, 675, 677, 678, 679, 680, 688, 696, 697,
-	699, 707, 709, 711, 713, 718, 727, 747, 172, 173,
-	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
-	257, 260, 265, 282, 287, 288, 289, 290, 306, 307,
-	308, 311, 314, 315, 318, 320, 321, 324, 330, 331,
-	332, 333, 334, 336, 343, 347, 355, 356, 357, 358,
-	359, 361, 362, 366, 367, 368, 369, 377, 381, 396,
-	397, 408, 420, 425, 246, 404, 426, 0, 281, 687,
-	694, 283, 231, 248, 258, 702, 415, 378, 188, 349,
-	238, 177, 205, 191, 212, 226, 228, 262, 291, 297,
-	326, 329, 243, 223, 203, 346, 200, 364, 384, 385,
-	386, 388, 295, 219, 734, 721, 0, 0, 670, 737,
-	641, 659, 746, 661, 664, 704, 620, 683, 313, 656,
-	0, 645, 616, 652, 617, 643, 672, 222, 676, 640,
-	723, 686, 736, 271, 0, 622, 646, 327, 706, 365,
-	208, 280, 278, 393, 232, 225, 221, 207, 255, 286,
-	325, 383, 319, 743, 275, 693, 0, 374, 298, 0,
-	0, 0, 674, 726, 681, 717, 669, 705, 630, 692,
-	738, 657, 701, 739, 261, 206, 175, 310, 375, 236,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 690, 697, 284, 231,
+	248, 258, 705, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 738, 725, 0, 0, 672, 741, 643, 661, 750,
+	663, 666, 707, 622, 685, 314, 658, 0, 647, 618,
+	654, 619, 645, 674, 222, 678, 642, 727, 689, 740,
+	271, 0, 624, 648, 328, 709, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	747, 275, 696, 0, 376, 299, 0, 0, 0, 676,
+	730, 683, 721, 671, 708, 632, 695, 742, 659, 0,
+	174, 352, 704, 743, 261, 206, 175, 311, 377, 236,
 	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 197, 0, 204, 698, 733, 654,
-	700, 218, 259, 224, 217, 390, 703, 749, 615, 695,
-	0, 618, 621, 745, 729, 649, 650, 0, 0, 0,
-	0, 0, 0, 0, 673, 682, 714, 667, 0, 0,
-	0, 0, 0, 0, 0, 0, 647, 0, 691, 0,
-	0, 0, 626, 619, 0, 0, 0, 0, 671, 0,
-	0, 0, 629, 0, 648, 715, 0, 613, 244, 623,
-	299, 0, 719, 728, 668, 422, 732, 666, 665, 735,
-	710, 627, 725, 660, 270, 625, 267, 171, 186, 0,
-	658, 309, 348, 354, 724, 644, 653, 209, 651, 352,
-	323, 407, 193, 234, 345, 328, 350, 690, 708, 351,
-	276, 395, 340, 405, 423, 424, 216, 303, 413, 387,
-	419, 434, 187, 213, 317, 380, 410, 371, 296, 391,
-	392, 266, 370, 242, 174, 274, 431, 185, 360, 201,
-	178, 382, 1092, 198, 363, 0, 0, 0, 180, 401,
-	379, 293, 263, 264, 179, 0, 344, 220, 240, 211,
-	312, 398, 399, 210, 436, 189, 418, 182, 624, 417,
-	305, 394, 402, 294, 285, 181, 400, 292, 284, 269,
-	230, 250, 338, 279, 339, 251, 301, 300, 302, 0,
-	176, 0, 376, 411, 437, 194, 195, 196, 639, 229,
-	233, 239, 241, 0, 247, 254, 272, 316, 337, 335,
-	341, 720, 389, 406, 414, 421, 427, 428, 432, 429,
-	430, 433, 612, 750, 606, 605, 268, 277, 712, 748,
-	322, 353, 199, 409, 373, 634, 638, 632, 633, 684,
-	685, 635, 740, 741, 742, 716, 628, 0, 636, 637,
-	0, 722, 730, 731, 689, 170, 183, 273, 744, 342,
-	237, 435, 416, 412, 614, 631, 215, 642, 0, 0,
-	655, 662, 663, 675, 677, 678, 679, 680, 688, 696,
-	697, 699, 707, 709, 711, 713, 718, 727, 747, 172,
+	0, 0, 0, 0, 197, 0, 204, 701, 737, 656,
+	703, 218, 259, 224, 217, 392, 706, 753, 617, 698,
+	0, 620, 623, 749, 733, 651, 652, 0, 0, 0,
+	0, 0, 0, 0, 675, 684, 718, 669, 0, 0,
+	0, 0, 0, 0, 1936, 0, 649, 0, 694, 0,
+	0, 0, 628, 621, 0, 0, 0, 0, 673, 0,
+	0, 0, 631, 0, 650, 719, 0, 615, 244, 625,
+	300, 0, 723, 732, 670, 424, 736, 668, 667, 739,
+	713, 629, 729, 662, 270, 627, 267, 171, 186, 0,
+	660, 310, 350, 356, 728, 646, 655, 209, 653, 354,
+	324, 409, 193, 234, 347, 329, 693, 711, 353, 276,
+	397, 342, 407, 330, 686, 714, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	931, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	641, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 724, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 305, 190, 253, 374, 268, 277,
+	716, 752, 323, 355, 199, 411, 375, 636, 640, 634,
+	635, 687, 688, 637, 744, 745, 746, 720, 630, 0,
+	638, 639, 0, 726, 734, 735, 692, 170, 183, 273,
+	748, 344, 237, 437, 418, 414, 616, 633, 215, 644,
+	0, 0, 657, 664, 665, 677, 679, 680, 681, 682,
+	691, 699, 700, 702, 710, 712, 715, 717, 722, 731,
+	751, 172, 173, 184, 192, 202, 214, 227, 235, 245,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 690, 697, 284, 231, 248, 258, 705, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219, 738, 725, 0,
+	0, 672, 741, 643, 661, 750, 663, 666, 707, 622,
+	685, 314, 658, 0, 647, 618, 654, 619, 645, 674,
+	222, 678, 642, 727, 689, 740, 271, 0, 624, 648,
+	328, 709, 367, 208, 281, 278, 395, 232, 225, 221,
+	207, 255, 287, 326, 385, 320, 747, 275, 696, 0,
+	376, 299, 0, 0, 0, 676, 730, 683, 721, 671,
+	708, 632, 695, 742, 659, 0, 174, 352, 704, 743,
+	261, 206, 175, 311, 377, 236, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 0, 204, 701, 737, 656, 703, 218, 259, 224,
+	217, 392, 706, 753, 617, 698, 0, 620, 623, 749,
+	733, 651, 652, 0, 0, 0, 0, 0, 0, 0,
+	675, 684, 718, 669, 0, 0, 0, 0, 0, 0,
+	1769, 0, 649, 0, 694, 0, 0, 0, 628, 621,
+	0, 0, 0, 0, 673, 0, 0, 0, 631, 0,
+	650, 719, 0, 615, 244, 625, 300, 0, 723, 732,
+	670, 424, 736, 668, 667, 739, 713, 629, 729, 662,
+	270, 627, 267, 171, 186, 0, 660, 310, 350, 356,
+	728, 646, 655, 209, 653, 354, 324, 409, 193, 234,
+	347, 329, 693, 711, 353, 276, 397, 342, 407, 330,
+	686, 714, 280, 425, 426, 216, 304, 415, 389, 421,
+	436, 187, 213, 318, 382, 412, 373, 297, 393, 394,
+	266, 372, 242, 274, 433, 185, 362, 201, 178, 384,
+	405, 198, 365, 0, 0, 0, 180, 403, 381, 294,
+	263, 264, 179, 0, 346, 220, 240, 211, 313, 400,
+	401, 210, 438, 189, 420, 182, 931, 419, 306, 396,
+	404, 295, 286, 181, 402, 293, 285, 269, 230, 250,
+	340, 279, 341, 251, 302, 301, 303, 0, 176, 0,
+	378, 413, 439, 194, 195, 196, 641, 229, 233, 239,
+	241, 0, 247, 254, 272, 317, 339, 337, 343, 724,
+	391, 408, 416, 423, 429, 430, 434, 431, 432, 435,
+	305, 190, 253, 374, 268, 277, 716, 752, 323, 355,
+	199, 411, 375, 636, 640, 634, 635, 687, 688, 637,
+	744, 745, 746, 720, 630, 0, 638, 639, 0, 726,
+	734, 735, 692, 170, 183, 273, 748, 344, 237, 437,
+	418, 414, 616, 633, 215, 644, 0, 0, 657, 664,
+	665, 677, 679, 680, 681, 682, 691, 699, 700, 702,
+	710, 712, 715, 717, 722, 731, 751, 172, 173, 184,
+	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
+	260, 265, 283, 288, 289, 290, 291, 307, 308, 309,
+	312, 315, 316, 319, 321, 322, 325, 332, 333, 334,
+	335, 336, 338, 345, 349, 357, 358, 359, 360, 361,
+	363, 364, 368, 369, 370, 371, 379, 383, 398, 399,
+	410, 422, 427, 246, 406, 428, 0, 282, 690, 697,
+	284, 231, 248, 258, 705, 417, 380, 188, 351, 238,
+	177, 205, 191, 212, 226, 228, 262, 292, 298, 327,
+	331, 243, 223, 203, 348, 200, 366, 386, 387, 388,
+	390, 296, 219, 738, 725, 0, 0, 672, 741, 643,
+	661, 750, 663, 666, 707, 622, 685, 314, 658, 0,
+	647, 618, 654, 619, 645, 674, 222, 678, 642, 727,
+	689, 740, 271, 0, 624, 648, 328, 709, 367, 208,
+	281, 278, 395, 232, 225, 221, 207, 255, 287, 326,
+	385, 320, 747, 275, 696, 0, 376, 299, 0, 0,
+	0, 676, 730, 683, 721, 671, 708, 632, 695, 742,
+	659, 0, 174, 352, 704, 743, 261, 206, 175, 311,
+	377, 236, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 0, 204, 701,
+	737, 656, 703, 218, 259, 224, 217, 392, 706, 753,
+	617, 698, 0, 620, 623, 749, 733, 651, 652, 0,
+	0, 0, 0, 0, 0, 0, 675, 684, 718, 669,
+	0, 0, 0, 0, 0, 0, 1475, 0, 649, 0,
+	694, 0, 0, 0, 628, 621, 0, 0, 0, 0,
+	673, 0, 0, 0, 631, 0, 650, 719, 0, 615,
+	244, 625, 300, 0, 723, 732, 670, 424, 736, 668,
+	667, 739, 713, 629, 729, 662, 270, 627, 267, 171,
+	186, 0, 660, 310, 350, 356, 728, 646, 655, 209,
+	653, 354, 324, 409, 193, 234, 347, 329, 693, 711,
+	353, 276, 397, 342, 407, 330, 686, 714, 280, 425,
+	426, 216, 304, 415, 389, 421, 436, 187, 213, 318,
+	382, 412, 373, 297, 393, 394, 266, 372, 242, 274,
+	433, 185, 362, 201, 178, 384, 405, 198, 365, 0,
+	0, 0, 180, 403, 381, 294, 263, 264, 179, 0,
+	346, 220, 240, 211, 313, 400, 401, 210, 438, 189,
+	420, 182, 931, 419, 306, 396, 404, 295, 286, 181,
+	402, 293, 285, 269, 230, 250, 340, 279, 341, 251,
+	302, 301, 303, 0, 176, 0, 378, 413, 439, 194,
+	195, 196, 641, 229, 233, 239, 241, 0, 247, 254,
+	272, 317, 339, 337, 343, 724, 391, 408, 416, 423,
+	429, 430, 434, 431, 432, 435, 305, 190, 253, 374,
+	268, 277, 716, 752, 323, 355, 199, 411, 375, 636,
+	640, 634, 635, 687, 688, 637, 744, 745, 746, 720,
+	630, 0, 638, 639, 0, 726, 734, 735, 692, 170,
+	183, 273, 748, 344, 237, 437, 418, 414, 616, 633,
+	215, 644, 0, 0, 657, 664, 665, 677, 679, 680,
+	681, 682, 691, 699, 700, 702, 710, 712, 715, 717,
+	722, 731, 751, 172, 173, 184, 192, 202, 214, 227,
+	235, 245, 249, 252, 256, 257, 260, 265, 283, 288,
+	289, 290, 291, 307, 308, 309, 312, 315, 316, 319,
+	321, 322, 325, 332, 333, 334, 335, 336, 338, 345,
+	349, 357, 358, 359, 360, 361, 363, 364, 368, 369,
+	370, 371, 379, 383, 398, 399, 410, 422, 427, 246,
+	406, 428, 0, 282, 690, 697, 284, 231, 248, 258,
+	705, 417, 380, 188, 351, 238, 177, 205, 191, 212,
+	226, 228, 262, 292, 298, 327, 331, 243, 223, 203,
+	348, 200, 366, 386, 387, 388, 390, 296, 219, 738,
+	725, 0, 0, 672, 741, 643, 661, 750, 663, 666,
+	707, 622, 685, 314, 658, 0, 647, 618, 654, 619,
+	645, 674, 222, 678, 642, 727, 689, 740, 271, 0,
+	624, 648, 328, 709, 367, 208, 281, 278, 395, 232,
+	225, 221, 207, 255, 287, 326, 385, 320, 747, 275,
+	696, 0, 376, 299, 0, 0, 0, 676, 730, 683,
+	721, 671, 708, 632, 695, 742, 659, 0, 174, 352,
+	704, 743, 261, 206, 175, 311, 377, 236, 71, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 0, 204, 701, 737, 656, 703, 218,
+	259, 224, 217, 392, 706, 753, 617, 698, 0, 620,
+	623, 749, 733, 651, 652, 0, 0, 0, 0, 0,
+	0, 0, 675, 684, 718, 669, 0, 0, 0, 0,
+	0, 0, 0, 0, 649, 0, 694, 0, 0, 0,
+	628, 621, 0, 0, 0, 0, 673, 0, 0, 0,
+	631, 0, 650, 719, 0, 615, 244, 625, 300, 0,
+	723, 732, 670, 424, 736, 668, 667, 739, 713, 629,
+	729, 662, 270, 627, 267, 171, 186, 0, 660, 310,
+	350, 356, 728, 646, 655, 209, 653, 354, 324, 409,
+	193, 234, 347, 329, 693, 711, 353, 276, 397, 342,
+	407, 330, 686, 714, 280, 425, 426, 216, 304, 415,
+	389, 421, 436, 187, 213, 318, 382, 412, 373, 297,
+	393, 394, 266, 372, 242, 274, 433, 185, 362, 201,
+	178, 384, 405, 198, 365, 0, 0, 0, 180, 403,
+	381, 294, 263, 264, 179, 0, 346, 220, 240, 211,
+	313, 400, 401, 210, 438, 189, 420, 182, 931, 419,
+	306, 396, 404, 295, 286, 181, 402, 293, 285, 269,
+	230, 250, 340, 279, 341, 251, 302, 301, 303, 0,
+	176, 0, 378, 413, 439, 194, 195, 196, 641, 229,
+	233, 239, 241, 0, 247, 254, 272, 317, 339, 337,
+	343, 724, 391, 408, 416, 423, 429, 430, 434, 431,
+	432, 435, 305, 190, 253, 374, 268, 277, 716, 752,
+	323, 355, 199, 411, 375, 636, 640, 634, 635, 687,
+	688, 637, 744, 745, 746, 720, 630, 0, 638, 639,
+	0, 726, 734, 735, 692, 170, 183, 273, 748, 344,
+	237, 437, 418, 414, 616, 633, 215, 644, 0, 0,
+	657, 664, 665, 677, 679, 680, 681, 682, 691, 699,
+	700, 702, 710, 712, 715, 717, 722, 731, 751, 172,
 	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
-	256, 257, 260, 265, 282, 287, 288, 289, 290, 306,
-	307, 308, 311, 314, 315, 318, 320, 321, 324, 330,
-	331, 332, 333, 334, 336, 343, 347, 355, 356, 357,
-	358, 359, 361, 362, 366, 367, 368, 369, 377, 381,
-	396, 397, 408, 420, 425, 246, 404, 426, 0, 281,
-	687, 694, 283, 231, 248, 258, 702, 415, 378, 188,
-	349, 238, 177, 205, 191, 212, 226, 228, 262, 291,
-	297, 326, 329, 243, 223, 203, 346, 200, 364, 384,
-	385, 386, 388, 295, 219, 734, 721, 0, 0, 670,
-	737, 641, 659, 746, 661, 664, 704, 620, 683, 313,
-	656, 0, 645, 616, 652, 617, 643, 672, 222, 676,
-	640, 723, 686, 736, 271, 0, 622, 646, 327, 706,
-	365, 208, 280, 278, 393, 232, 225, 221, 207, 255,
-	286, 325, 383, 319, 743, 275, 693, 0, 374, 298,
-	0, 0, 0, 674, 726, 681, 717, 669, 705, 630,
-	692, 738, 657, 701, 739, 261, 206, 175, 310, 375,
-	236, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 197, 0, 204, 698, 733,
-	654, 700, 218, 259, 224, 217, 390, 703, 749, 615,
-	695, 0, 618, 621, 745, 729, 649, 650, 0, 0,
-	0, 0, 0, 0, 0, 673, 682, 714, 667, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 0, 691,
-	0, 0, 0, 626, 619, 0, 0, 0, 0, 671,
-	0, 0, 0, 629, 0, 648, 715, 0, 613, 244,
-	623, 299, 0, 719, 728, 668, 422, 732, 666, 665,
-	735, 710, 627, 725, 660, 270, 625, 267, 171, 186,
-	0, 658, 309, 348, 354, 724, 644, 653, 209, 651,
-	352, 323, 407, 193, 234, 345, 328, 350, 690, 708,
-	351, 276, 395, 340, 405, 423, 424, 216, 303, 413,
-	387, 419, 434, 187, 213, 317, 380, 410, 371, 296,
-	391, 392, 266, 370, 242, 174, 274, 431, 185, 360,
-	201, 178, 382, 603, 198, 363, 0, 0, 0, 180,
-	401, 379, 293, 263, 264, 179, 0, 344, 220, 240,
-	211, 312, 398, 399, 210, 436, 189, 418, 182, 624,
-	417, 305, 394, 402, 294, 285, 181, 400, 292, 284,
-	269, 230, 250, 338, 279, 339, 251, 301, 300, 302,
-	0, 176, 0, 376, 411, 437, 194, 195, 196, 639,
-	229, 233, 239, 241, 0, 247, 254, 272, 316, 337,
-	335, 341, 720, 389, 406, 414, 421, 427, 428, 432,
-	429, 430, 433, 612, 750, 606, 605, 268, 277, 712,
-	748, 322, 353, 199, 409, 373, 634, 638, 632, 633,
-	684, 685, 635, 740, 741, 742, 716, 628, 0, 636,
-	637, 0, 722, 730, 731, 689, 170, 183, 273, 744,
-	342, 237, 435, 416, 412, 614, 631, 215, 642, 0,
-	0, 655, 662, 663, 675, 677, 678, 679, 680, 688,
-	696, 697, 699, 707, 709, 711, 713, 718, 727, 747,
-	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
-	252, 256, 257, 260, 265, 282, 287, 288, 289, 290,
-	306, 307, 308, 311, 314, 315, 318, 320, 321, 324,
-	330, 331, 332, 333, 334, 336, 343, 347, 355, 356,
-	357, 358, 359, 361, 362, 366, 367, 368, 369, 377,
-	381, 396, 397, 408, 420, 425, 246, 404, 426, 0,
-	281, 687, 694, 283, 231, 248, 258, 702, 415, 378,
-	188, 349, 238, 177, 205, 191, 212, 226, 228, 262,
-	291, 297, 326, 329, 243, 223, 203, 346, 200, 364,
-	384, 385, 386, 388, 295, 219, 313, 0, 0, 1392,
-	0, 503, 0, 0, 0, 222, 0, 502, 0, 0,
-	0, 271, 0, 0, 1393, 327, 0, 365, 208, 280,
-	278, 393, 232, 225, 221, 207, 255, 286, 325, 383,
-	319, 546, 275, 0, 0, 374, 298, 0, 0, 0,
-	0, 0, 537, 538, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 206, 175, 310, 375, 236, 71, 0,
-	0, 167, 168, 169, 524, 523, 526, 527, 528, 529,
-	0, 0, 197, 525, 204, 530, 531, 532, 0, 218,
-	259, 224, 217, 390, 0, 0, 0, 500, 517, 0,
-	545, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 515, 593, 0, 0, 0, 561, 0, 516, 0,
-	0, 509, 510, 512, 511, 513, 518, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 299, 0,
-	560, 0, 0, 422, 0, 0, 558, 0, 0, 0,
-	0, 0, 270, 0, 267, 171, 186, 0, 0, 309,
-	348, 354, 0, 0, 0, 209, 0, 352, 323, 407,
-	193, 234, 345, 328, 350, 0, 0, 351, 276, 395,
-	340, 405, 423, 424, 216, 303, 413, 387, 419, 434,
-	187, 213, 317, 380, 410, 371, 296, 391, 392, 266,
-	370, 242, 174, 274, 431, 185, 360, 201, 178, 382,
-	403, 198, 363, 0, 0, 0, 180, 401, 379, 293,
-	263, 264, 179, 0, 344, 220, 240, 211, 312, 398,
-	399, 210, 436, 189, 418, 182, 0, 417, 305, 394,
-	402, 294, 285, 181, 400, 292, 284, 269, 230, 250,
-	338, 279, 339, 251, 301, 300, 302, 0, 176, 0,
-	376, 411, 437, 194, 195, 196, 0, 229, 233, 239,
-	241, 0, 247, 254, 272, 316, 337, 335, 341, 0,
-	389, 406, 414, 421, 427, 428, 432, 429, 430, 433,
-	304, 190, 253, 372, 268, 277, 0, 0, 322, 353,
-	199, 409, 373, 548, 559, 554, 555, 552, 553, 547,
-	551, 550, 549, 562, 539, 540, 541, 542, 544, 0,
-	556, 557, 543, 170, 183, 273, 0, 342, 237, 435,
-	416, 412, 0, 0, 215, 0, 0, 0, 0, 0,
+	256, 257, 260, 265, 283, 288, 289, 290, 291, 307,
+	308, 309, 312, 315, 316, 319, 321, 322, 325, 332,
+	333, 334, 335, 336, 338, 345, 349, 357, 358, 359,
+	360, 361, 363, 364, 368, 369, 370, 371, 379, 383,
+	398, 399, 410, 422, 427, 246, 406, 428, 0, 282,
+	690, 697, 284, 231, 248, 258, 705, 417, 380, 188,
+	351, 238, 177, 205, 191, 212, 226, 228, 262, 292,
+	298, 327, 331, 243, 223, 203, 348, 200, 366, 386,
+	387, 388, 390, 296, 219, 738, 725, 0, 0, 672,
+	741, 643, 661, 750, 663, 666, 707, 622, 685, 314,
+	658, 0, 647, 618, 654, 619, 645, 674, 222, 678,
+	642, 727, 689, 740, 271, 0, 624, 648, 328, 709,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 747, 275, 696, 0, 376, 299,
+	0, 0, 0, 676, 730, 683, 721, 671, 708, 632,
+	695, 742, 659, 0, 174, 352, 704, 743, 261, 206,
+	175, 311, 377, 236, 0, 0, 0, 167, 168, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 197, 0,
+	204, 701, 737, 656, 703, 218, 259, 224, 217, 392,
+	706, 753, 617, 698, 0, 620, 623, 749, 733, 651,
+	652, 0, 0, 0, 0, 0, 0, 0, 675, 684,
+	718, 669, 0, 0, 0, 0, 0, 0, 0, 0,
+	649, 0, 694, 0, 0, 0, 628, 621, 0, 0,
+	0, 0, 673, 0, 0, 0, 631, 0, 650, 719,
+	0, 615, 244, 625, 300, 0, 723, 732, 670, 424,
+	736, 668, 667, 739, 713, 629, 729, 662, 270, 627,
+	267, 171, 186, 0, 660, 310, 350, 356, 728, 646,
+	655, 209, 653, 354, 324, 409, 193, 234, 347, 329,
+	693, 711, 353, 276, 397, 342, 407, 330, 686, 714,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 931, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 641, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 724, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 716, 752, 323, 355, 199, 411,
+	375, 636, 640, 634, 635, 687, 688, 637, 744, 745,
+	746, 720, 630, 0, 638, 639, 0, 726, 734, 735,
+	692, 170, 183, 273, 748, 344, 237, 437, 418, 414,
+	616, 633, 215, 644, 0, 0, 657, 664, 665, 677,
+	679, 680, 681, 682, 691, 699, 700, 702, 710, 712,
+	715, 717, 722, 731, 751, 172, 173, 184, 192, 202,
+	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 690, 697, 284, 231,
+	248, 258, 705, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 738, 725, 0, 0, 672, 741, 643, 661, 750,
+	663, 666, 707, 622, 685, 314, 658, 0, 647, 618,
+	654, 619, 645, 674, 222, 678, 642, 727, 689, 740,
+	271, 0, 624, 648, 328, 709, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	747, 275, 696, 0, 376, 299, 0, 0, 0, 676,
+	730, 683, 721, 671, 708, 632, 695, 742, 659, 0,
+	174, 352, 704, 743, 261, 206, 175, 311, 377, 236,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 0, 204, 701, 737, 656,
+	703, 218, 259, 224, 217, 392, 706, 753, 617, 698,
+	0, 620, 623, 749, 733, 651, 652, 0, 0, 0,
+	0, 0, 0, 0, 675, 684, 718, 669, 0, 0,
+	0, 0, 0, 0, 0, 0, 649, 0, 694, 0,
+	0, 0, 628, 621, 0, 0, 0, 0, 673, 0,
+	0, 0, 631, 0, 650, 719, 0, 615, 244, 625,
+	300, 0, 723, 732, 670, 424, 736, 668, 667, 739,
+	713, 629, 729, 662, 270, 627, 267, 171, 186, 0,
+	660, 310, 350, 356, 728, 646, 655, 209, 653, 354,
+	324, 409, 193, 234, 347, 329, 693, 711, 353, 276,
+	397, 342, 407, 330, 686, 714, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	626, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	641, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 724, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 614, 754, 608, 607, 268, 277,
+	716, 752, 323, 355, 199, 411, 375, 636, 640, 634,
+	635, 687, 688, 637, 744, 745, 746, 720, 630, 0,
+	638, 639, 0, 726, 734, 735, 692, 170, 183, 273,
+	748, 344, 237, 437, 418, 414, 616, 633, 215, 644,
+	0, 0, 657, 664, 665, 677, 679, 680, 681, 682,
+	691, 699, 700, 702, 710, 712, 715, 717, 722, 731,
+	751, 172, 173, 184, 192, 202, 214, 227, 235, 245,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 690, 697, 284, 231, 248, 258, 705, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219, 738, 725, 0,
+	0, 672, 741, 643, 661, 750, 663, 666, 707, 622,
+	685, 314, 658, 0, 647, 618, 654, 619, 645, 674,
+	222, 678, 642, 727, 689, 740, 271, 0, 624, 648,
+	328, 709, 367, 208, 281, 278, 395, 232, 225, 221,
+	207, 255, 287, 326, 385, 320, 747, 275, 696, 0,
+	376, 299, 0, 0, 0, 676, 730, 683, 721, 671,
+	708, 632, 695, 742, 659, 0, 174, 352, 704, 743,
+	261, 206, 175, 311, 377, 236, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 0, 204, 701, 737, 656, 703, 218, 259, 224,
+	217, 392, 706, 753, 617, 698, 0, 620, 623, 749,
+	733, 651, 652, 0, 0, 0, 0, 0, 0, 0,
+	675, 684, 718, 669, 0, 0, 0, 0, 0, 0,
+	0, 0, 649, 0, 694, 0, 0, 0, 628, 621,
+	0, 0, 0, 0, 673, 0, 0, 0, 631, 0,
+	650, 719, 0, 615, 244, 625, 300, 0, 723, 732,
+	670, 424, 736, 668, 667, 739, 713, 629, 729, 662,
+	270, 627, 267, 171, 186, 0, 660, 310, 350, 356,
+	728, 646, 655, 209, 653, 354, 324, 409, 193, 234,
+	347, 329, 693, 711, 353, 276, 397, 342, 407, 330,
+	686, 714, 280, 425, 426, 216, 304, 415, 389, 421,
+	436, 187, 213, 318, 382, 412, 373, 297, 393, 394,
+	266, 372, 242, 274, 433, 185, 362, 201, 178, 384,
+	1099, 198, 365, 0, 0, 0, 180, 403, 381, 294,
+	263, 264, 179, 0, 346, 220, 240, 211, 313, 400,
+	401, 210, 438, 189, 420, 182, 626, 419, 306, 396,
+	404, 295, 286, 181, 402, 293, 285, 269, 230, 250,
+	340, 279, 341, 251, 302, 301, 303, 0, 176, 0,
+	378, 413, 439, 194, 195, 196, 641, 229, 233, 239,
+	241, 0, 247, 254, 272, 317, 339, 337, 343, 724,
+	391, 408, 416, 423, 429, 430, 434, 431, 432, 435,
+	614, 754, 608, 607, 268, 277, 716, 752, 323, 355,
+	199, 411, 375, 636, 640, 634, 635, 687, 688, 637,
+	744, 745, 746, 720, 630, 0, 638, 639, 0, 726,
+	734, 735, 692, 170, 183, 273, 748, 344, 237, 437,
+	418, 414, 616, 633, 215, 644, 0, 0, 657, 664,
+	665, 677, 679, 680, 681, 682, 691, 699, 700, 702,
+	710, 712, 715, 717, 722, 731, 751, 172, 173, 184,
+	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
+	260, 265, 283, 288, 289, 290, 291, 307, 308, 309,
+	312, 315, 316, 319, 321, 322, 325, 332, 333, 334,
+	335, 336, 338, 345, 349, 357, 358, 359, 360, 361,
+	363, 364, 368, 369, 370, 371, 379, 383, 398, 399,
+	410, 422, 427, 246, 406, 428, 0, 282, 690, 697,
+	284, 231, 248, 258, 705, 417, 380, 188, 351, 238,
+	177, 205, 191, 212, 226, 228, 262, 292, 298, 327,
+	331, 243, 223, 203, 348, 200, 366, 386, 387, 388,
+	390, 296, 219, 738, 725, 0, 0, 672, 741, 643,
+	661, 750, 663, 666, 707, 622, 685, 314, 658, 0,
+	647, 618, 654, 619, 645, 674, 222, 678, 642, 727,
+	689, 740, 271, 0, 624, 648, 328, 709, 367, 208,
+	281, 278, 395, 232, 225, 221, 207, 255, 287, 326,
+	385, 320, 747, 275, 696, 0, 376, 299, 0, 0,
+	0, 676, 730, 683, 721, 671, 708, 632, 695, 742,
+	659, 0, 174, 352, 704, 743, 261, 206, 175, 311,
+	377, 236, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 0, 204, 701,
+	737, 656, 703, 218, 259, 224, 217, 392, 706, 753,
+	617, 698, 0, 620, 623, 749, 733, 651, 652, 0,
+	0, 0, 0, 0, 0, 0, 675, 684, 718, 669,
+	0, 0, 0, 0, 0, 0, 0, 0, 649, 0,
+	694, 0, 0, 0, 628, 621, 0, 0, 0, 0,
+	673, 0, 0, 0, 631, 0, 650, 719, 0, 615,
+	244, 625, 300, 0, 723, 732, 670, 424, 736, 668,
+	667, 739, 713, 629, 729, 662, 270, 627, 267, 171,
+	186, 0, 660, 310, 350, 356, 728, 646, 655, 209,
+	653, 354, 324, 409, 193, 234, 347, 329, 693, 711,
+	353, 276, 397, 342, 407, 330, 686, 714, 280, 425,
+	426, 216, 304, 415, 389, 421, 436, 187, 213, 318,
+	382, 412, 373, 297, 393, 394, 266, 372, 242, 274,
+	433, 185, 362, 201, 178, 384, 605, 198, 365, 0,
+	0, 0, 180, 403, 381, 294, 263, 264, 179, 0,
+	346, 220, 240, 211, 313, 400, 401, 210, 438, 189,
+	420, 182, 626, 419, 306, 396, 404, 295, 286, 181,
+	402, 293, 285, 269, 230, 250, 340, 279, 341, 251,
+	302, 301, 303, 0, 176, 0, 378, 413, 439, 194,
+	195, 196, 641, 229, 233, 239, 241, 0, 247, 254,
+	272, 317, 339, 337, 343, 724, 391, 408, 416, 423,
+	429, 430, 434, 431, 432, 435, 614, 754, 608, 607,
+	268, 277, 716, 752, 323, 355, 199, 411, 375, 636,
+	640, 634, 635, 687, 688, 637, 744, 745, 746, 720,
+	630, 0, 638, 639, 0, 726, 734, 735, 692, 170,
+	183, 273, 748, 344, 237, 437, 418, 414, 616, 633,
+	215, 644, 0, 0, 657, 664, 665, 677, 679, 680,
+	681, 682, 691, 699, 700, 702, 710, 712, 715, 717,
+	722, 731, 751, 172, 173, 184, 192, 202, 214, 227,
+	235, 245, 249, 252, 256, 257, 260, 265, 283, 288,
+	289, 290, 291, 307, 308, 309, 312, 315, 316, 319,
+	321, 322, 325, 332, 333, 334, 335, 336, 338, 345,
+	349, 357, 358, 359, 360, 361, 363, 364, 368, 369,
+	370, 371, 379, 383, 398, 399, 410, 422, 427, 246,
+	406, 428, 0, 282, 690, 697, 284, 231, 248, 258,
+	705, 417, 380, 188, 351, 238, 177, 205, 191, 212,
+	226, 228, 262, 292, 298, 327, 331, 243, 223, 203,
+	348, 200, 366, 386, 387, 388, 390, 296, 219, 314,
+	0, 0, 1401, 0, 505, 0, 0, 0, 222, 0,
+	504, 0, 0, 0, 271, 0, 0, 1402, 328, 0,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 548, 275, 0, 0, 376, 299,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 0, 0, 0, 174, 352, 0, 0, 261, 206,
+	175, 311, 377, 236, 71, 0, 0, 167, 168, 169,
+	526, 525, 528, 529, 530, 531, 0, 0, 197, 527,
+	204, 532, 533, 534, 0, 218, 259, 224, 217, 392,
+	0, 0, 0, 502, 519, 0, 547, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 517, 595, 0,
+	0, 0, 563, 0, 518, 0, 0, 511, 512, 514,
+	513, 515, 520, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 300, 0, 562, 0, 0, 424,
+	0, 0, 560, 0, 0, 0, 0, 0, 270, 0,
+	267, 171, 186, 0, 0, 310, 350, 356, 0, 0,
+	0, 209, 0, 354, 324, 409, 193, 234, 347, 329,
+	0, 0, 353, 276, 397, 342, 407, 330, 0, 0,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 0, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 0, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 0, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 0, 0, 323, 355, 199, 411,
+	375, 550, 561, 556, 557, 554, 555, 549, 553, 552,
+	551, 564, 541, 542, 543, 544, 546, 0, 558, 559,
+	545, 170, 183, 273, 0, 344, 237, 437, 418, 414,
+	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
+	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 0, 0, 284, 231,
+	248, 258, 0, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 314, 0, 0, 0, 0, 505, 0, 0, 0,
+	222, 0, 504, 0, 0, 0, 271, 0, 0, 0,
+	328, 0, 367, 208, 281, 278, 395, 232, 225, 221,
+	207, 255, 287, 326, 385, 320, 548, 275, 0, 0,
+	376, 299, 0, 0, 0, 0, 0, 539, 540, 0,
+	0, 0, 0, 0, 0, 0, 174, 352, 1514, 0,
+	261, 206, 175, 311, 377, 236, 71, 0, 0, 167,
+	168, 169, 526, 525, 528, 529, 530, 531, 0, 0,
+	197, 527, 204, 532, 533, 534, 1515, 218, 259, 224,
+	217, 392, 0, 0, 0, 502, 519, 0, 547, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 517,
+	0, 0, 0, 0, 563, 0, 518, 0, 0, 511,
+	512, 514, 513, 515, 520, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 300, 0, 562, 0,
+	0, 424, 0, 0, 560, 0, 0, 0, 0, 0,
+	270, 0, 267, 171, 186, 0, 0, 310, 350, 356,
+	0, 0, 0, 209, 0, 354, 324, 409, 193, 234,
+	347, 329, 0, 0, 353, 276, 397, 342, 407, 330,
+	0, 0, 280, 425, 426, 216, 304, 415, 389, 421,
+	436, 187, 213, 318, 382, 412, 373, 297, 393, 394,
+	266, 372, 242, 274, 433, 185, 362, 201, 178, 384,
+	405, 198, 365, 0, 0, 0, 180, 403, 381, 294,
+	263, 264, 179, 0, 346, 220, 240, 211, 313, 400,
+	401, 210, 438, 189, 420, 182, 0, 419, 306, 396,
+	404, 295, 286, 181, 402, 293, 285, 269, 230, 250,
+	340, 279, 341, 251, 302, 301, 303, 0, 176, 0,
+	378, 413, 439, 194, 195, 196, 0, 229, 233, 239,
+	241, 0, 247, 254, 272, 317, 339, 337, 343, 0,
+	391, 408, 416, 423, 429, 430, 434, 431, 432, 435,
+	305, 190, 253, 374, 268, 277, 0, 0, 323, 355,
+	199, 411, 375, 550, 561, 556, 557, 554, 555, 549,
+	553, 552, 551, 564, 541, 542, 543, 544, 546, 0,
+	558, 559, 545, 170, 183, 273, 0, 344, 237, 437,
+	418, 414, 0, 0, 215, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
 	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
-	260, 265, 282, 287, 288, 289, 290, 306, 307, 308,
-	311, 314, 315, 318, 320, 321, 324, 330, 331, 332,
-	333, 334, 336, 343, 347, 355, 356, 357, 358, 359,
-	361, 362, 366, 367, 368, 369, 377, 381, 396, 397,
-	408, 420, 425, 246, 404, 426, 0, 281, 0, 0,
-	283, 231, 248, 258, 0, 415, 378, 188, 349, 238,
-	177, 205, 191, 212, 226, 228, 262, 291, 297, 326,
-	329, 243, 223, 203, 346, 200, 364, 384, 385, 386,
-	388, 295, 219, 313, 0, 0, 0, 0, 503, 0,
-	0, 0, 222, 0, 502, 0, 0, 0, 271, 0,
-	0, 0, 327, 0, 365, 208, 280, 278, 393, 232,
-	225, 221, 207, 255, 286, 325, 383, 319, 546, 275,
-	0, 0, 374, 298, 0, 0, 0, 0, 0, 537,
-	538, 0, 0, 0, 0, 0, 0, 1505, 0, 261,
-	206, 175, 310, 375, 236, 71, 0, 0, 167, 168,
-	169, 524, 523, 526, 527, 528, 529, 0, 0, 197,
-	525, 204, 530, 531, 532, 1506, 218, 259, 224, 217,
-	390, 0, 0, 0, 500, 517, 0, 545, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 514, 515, 0,
-	0, 0, 0, 561, 0, 516, 0, 0, 509, 510,
-	512, 511, 513, 518, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 299, 0, 560, 0, 0,
-	422, 0, 0, 558, 0, 0, 0, 0, 0, 270,
-	0, 267, 171, 186, 0, 0, 309, 348, 354, 0,
-	0, 0, 209, 0, 352, 323, 407, 193, 234, 345,
-	328, 350, 0, 0, 351, 276, 395, 340, 405, 423,
-	424, 216, 303, 413, 387, 419, 434, 187, 213, 317,
-	380, 410, 371, 296, 391, 392, 266, 370, 242, 174,
-	274, 431, 185, 360, 201, 178, 382, 403, 198, 363,
-	0, 0, 0, 180, 401, 379, 293, 263, 264, 179,
-	0, 344, 220, 240, 211, 312, 398, 399, 210, 436,
-	189, 418, 182, 0, 417, 305, 394, 402, 294, 285,
-	181, 400, 292, 284, 269, 230, 250, 338, 279, 339,
-	251, 301, 300, 302, 0, 176, 0, 376, 411, 437,
-	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
-	254, 272, 316, 337, 335, 341, 0, 389, 406, 414,
-	421, 427, 428, 432, 429, 430, 433, 304, 190, 253,
-	372, 268, 277, 0, 0, 322, 353, 199, 409, 373,
-	548, 559, 554, 555, 552, 553, 547, 551, 550, 549,
-	562, 539, 540, 541, 542, 544, 0, 556, 557, 543,
-	170, 183, 273, 0, 342, 237, 435, 416, 412, 0,
-	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	260, 265, 283, 288, 289, 290, 291, 307, 308, 309,
+	312, 315, 316, 319, 321, 322, 325, 332, 333, 334,
+	335, 336, 338, 345, 349, 357, 358, 359, 360, 361,
+	363, 364, 368, 369, 370, 371, 379, 383, 398, 399,
+	410, 422, 427, 246, 406, 428, 0, 282, 0, 0,
+	284, 231, 248, 258, 0, 417, 380, 188, 351, 238,
+	177, 205, 191, 212, 226, 228, 262, 292, 298, 327,
+	331, 243, 223, 203, 348, 200, 366, 386, 387, 388,
+	390, 296, 219, 314, 0, 0, 0, 0, 505, 0,
+	0, 0, 222, 0, 504, 0, 0, 0, 271, 0,
+	0, 0, 328, 0, 367, 208, 281, 278, 395, 232,
+	225, 221, 207, 255, 287, 326, 385, 320, 548, 275,
+	0, 0, 376, 299, 0, 0, 0, 0, 0, 539,
+	540, 0, 0, 0, 0, 0, 0, 0, 174, 352,
+	0, 0, 261, 206, 175, 311, 377, 236, 71, 0,
+	0, 167, 168, 169, 526, 525, 528, 529, 530, 531,
+	0, 0, 197, 527, 204, 532, 533, 534, 0, 218,
+	259, 224, 217, 392, 0, 0, 0, 502, 519, 0,
+	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	516, 517, 0, 0, 0, 0, 563, 0, 518, 0,
+	0, 511, 512, 514, 513, 515, 520, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 300, 0,
+	562, 0, 0, 424, 0, 0, 560, 0, 0, 0,
+	0, 0, 270, 0, 267, 171, 186, 0, 0, 310,
+	350, 356, 0, 0, 0, 209, 0, 354, 324, 409,
+	193, 234, 347, 329, 2260, 0, 353, 276, 397, 342,
+	407, 330, 0, 0, 280, 425, 426, 216, 304, 415,
+	389, 421, 436, 187, 213, 318, 382, 412, 373, 297,
+	393, 394, 266, 372, 242, 274, 433, 185, 362, 201,
+	178, 384, 405, 198, 365, 0, 0, 0, 180, 403,
+	381, 294, 263, 264, 179, 0, 346, 220, 240, 211,
+	313, 400, 401, 210, 438, 189, 420, 182, 0, 419,
+	306, 396, 404, 295, 286, 181, 402, 293, 285, 269,
+	230, 250, 340, 279, 341, 251, 302, 301, 303, 0,
+	176, 0, 378, 413, 439, 194, 195, 196, 0, 229,
+	233, 239, 241, 0, 247, 254, 272, 317, 339, 337,
+	343, 0, 391, 408, 416, 423, 429, 430, 434, 431,
+	432, 435, 305, 190, 253, 374, 268, 277, 0, 0,
+	323, 355, 199, 411, 375, 550, 561, 556, 557, 554,
+	555, 549, 553, 552, 551, 564, 541, 542, 543, 544,
+	546, 0, 558, 559, 545, 170, 183, 273, 0, 344,
+	237, 437, 418, 414, 0, 0, 215, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
-	227, 235, 245, 249, 252, 256, 257, 260, 265, 282,
-	287, 288, 289, 290, 306, 307, 308, 311, 314, 315,
-	318, 320, 321, 324, 330, 331, 332, 333, 334, 336,
-	343, 347, 355, 356, 357, 358, 359, 361, 362, 366,
-	367, 368, 369, 377, 381, 396, 397, 408, 420, 425,
-	246, 404, 426, 0, 281, 0, 0, 283, 231, 248,
-	258, 0, 415, 378, 188, 349, 238, 177, 205, 191,
-	212, 226, 228, 262, 291, 297, 326, 329, 243, 223,
-	203, 346, 200, 364, 384, 385, 386, 388, 295, 219,
-	313, 0, 0, 0, 0, 503, 0, 0, 0, 222,
-	0, 502, 0, 0, 0, 271, 0, 0, 0, 327,
-	0, 365, 208, 280, 278, 393, 232, 225, 221, 207,
-	255, 286, 325, 383, 319, 546, 275, 0, 0, 374,
-	298, 0, 0, 0, 0, 0, 537, 538, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 206, 175, 310,
-	375, 236, 71, 0, 580, 167, 168, 169, 524, 523,
-	526, 527, 528, 529, 0, 0, 197, 525, 204, 530,
-	531, 532, 0, 218, 259, 224, 217, 390, 0, 0,
-	0, 500, 517, 0, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 514, 515, 0, 0, 0, 0,
-	561, 0, 516, 0, 0, 509, 510, 512, 511, 513,
-	518, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 299, 0, 560, 0, 0, 422, 0, 0,
-	558, 0, 0, 0, 0, 0, 270, 0, 267, 171,
-	186, 0, 0, 309, 348, 354, 0, 0, 0, 209,
-	0, 352, 323, 407, 193, 234, 345, 328, 350, 0,
-	0, 351, 276, 395, 340, 405, 423, 424, 216, 303,
-	413, 387, 419, 434, 187, 213, 317, 380, 410, 371,
-	296, 391, 392, 266, 370, 242, 174, 274, 431, 185,
-	360, 201, 178, 382, 403, 198, 363, 0, 0, 0,
-	180, 401, 379, 293, 263, 264, 179, 0, 344, 220,
-	240, 211, 312, 398, 399, 210, 436, 189, 418, 182,
-	0, 417, 305, 394, 402, 294, 285, 181, 400, 292,
-	284, 269, 230, 250, 338, 279, 339, 251, 301, 300,
-	302, 0, 176, 0, 376, 411, 437, 194, 195, 196,
-	0, 229, 233, 239, 241, 0, 247, 254, 272, 316,
-	337, 335, 341, 0, 389, 406, 414, 421, 427, 428,
-	432, 429, 430, 433, 304, 190, 253, 372, 268, 277,
-	0, 0, 322, 353, 199, 409, 373, 548, 559, 554,
-	555, 552, 553, 547, 551, 550, 549, 562, 539, 540,
-	541, 542, 544, 0, 556, 557, 543, 170, 183, 273,
-	0, 342, 237, 435, 416, 412, 0, 0, 215, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
+	256, 257, 260, 265, 283, 288, 289, 290, 291, 307,
+	308, 309, 312, 315, 316, 319, 321, 322, 325, 332,
+	333, 334, 335, 336, 338, 345, 349, 357, 358, 359,
+	360, 361, 363, 364, 368, 369, 370, 371, 379, 383,
+	398, 399, 410, 422, 427, 246, 406, 428, 0, 282,
+	0, 0, 284, 231, 248, 258, 0, 417, 380, 188,
+	351, 238, 177, 205, 191, 212, 226, 228, 262, 292,
+	298, 327, 331, 243, 223, 203, 348, 200, 366, 386,
+	387, 388, 390, 296, 219, 314, 0, 0, 0, 0,
+	505, 0, 0, 0, 222, 0, 504, 0, 0, 0,
+	271, 0, 0, 0, 328, 0, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	548, 275, 0, 0, 376, 299, 0, 0, 0, 0,
+	0, 539, 540, 0, 0, 0, 0, 0, 0, 0,
+	174, 352, 0, 0, 261, 206, 175, 311, 377, 236,
+	71, 0, 582, 167, 168, 169, 526, 525, 528, 529,
+	530, 531, 0, 0, 197, 527, 204, 532, 533, 534,
+	0, 218, 259, 224, 217, 392, 0, 0, 0, 502,
+	519, 0, 547, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 517, 0, 0, 0, 0, 563, 0,
+	518, 0, 0, 511, 512, 514, 513, 515, 520, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	300, 0, 562, 0, 0, 424, 0, 0, 560, 0,
+	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
+	0, 310, 350, 356, 0, 0, 0, 209, 0, 354,
+	324, 409, 193, 234, 347, 329, 0, 0, 353, 276,
+	397, 342, 407, 330, 0, 0, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	0, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	0, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 0, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 305, 190, 253, 374, 268, 277,
+	0, 0, 323, 355, 199, 411, 375, 550, 561, 556,
+	557, 554, 555, 549, 553, 552, 551, 564, 541, 542,
+	543, 544, 546, 0, 558, 559, 545, 170, 183, 273,
+	0, 344, 237, 437, 418, 414, 0, 0, 215, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
-	249, 252, 256, 257, 260, 265, 282, 287, 288, 289,
-	290, 306, 307, 308, 311, 314, 315, 318, 320, 321,
-	324, 330, 331, 332, 333, 334, 336, 343, 347, 355,
-	356, 357, 358, 359, 361, 362, 366, 367, 368, 369,
-	377, 381, 396, 397, 408, 420, 425, 246, 404, 426,
-	0, 281, 0, 0, 283, 231, 248, 258, 0, 415,
-	378, 188, 349, 238, 177, 205, 191, 212, 226, 228,
-	262, 291, 297, 326, 329, 243, 223, 203, 346, 200,
-	364, 384, 385, 386, 388, 295, 219, 313, 0, 0,
-	0, 0, 503, 0, 0, 0, 222, 0, 502, 0,
-	0, 0, 271, 0, 0, 0, 327, 0, 365, 208,
-	280, 278, 393, 232, 225, 221, 207, 255, 286, 325,
-	383, 319, 546, 275, 0, 0, 374, 298, 0, 0,
-	0, 0, 0, 537, 538, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 206, 175, 310, 375, 236, 71,
-	0, 0, 167, 168, 169, 524, 523, 526, 527, 528,
-	529, 0, 0, 197, 525, 204, 530, 531, 532, 0,
-	218, 259, 224, 217, 390, 0, 0, 0, 500, 517,
-	0, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 514, 515, 593, 0, 0, 0, 561, 0, 516,
-	0, 0, 509, 510, 512, 511, 513, 518, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 299,
-	0, 560, 0, 0, 422, 0, 0, 558, 0, 0,
-	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
-	309, 348, 354, 0, 0, 0, 209, 0, 352, 323,
-	407, 193, 234, 345, 328, 350, 0, 0, 351, 276,
-	395, 340, 405, 423, 424, 216, 303, 413, 387, 419,
-	434, 187, 213, 317, 380, 410, 371, 296, 391, 392,
-	266, 370, 242, 174, 274, 431, 185, 360, 201, 178,
-	382, 403, 198, 363, 0, 0, 0, 180, 401, 379,
-	293, 263, 264, 179, 0, 344, 220, 240, 211, 312,
-	398, 399, 210, 436, 189, 418, 182, 0, 417, 305,
-	394, 402, 294, 285, 181, 400, 292, 284, 269, 230,
-	250, 338, 279, 339, 251, 301, 300, 302, 0, 176,
-	0, 376, 411, 437, 194, 195, 196, 0, 229, 233,
-	239, 241, 0, 247, 254, 272, 316, 337, 335, 341,
-	0, 389, 406, 414, 421, 427, 428, 432, 429, 430,
-	433, 304, 190, 253, 372, 268, 277, 0, 0, 322,
-	353, 199, 409, 373, 548, 559, 554, 555, 552, 553,
-	547, 551, 550, 549, 562, 539, 540, 541, 542, 544,
-	0, 556, 557, 543, 170, 183, 273, 0, 342, 237,
-	435, 416, 412, 0, 0, 215, 0, 0, 0, 0,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 0, 0, 284, 231, 248, 258, 0, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219, 314, 0, 0,
+	0, 0, 505, 0, 0, 0, 222, 0, 504, 0,
+	0, 0, 271, 0, 0, 0, 328, 0, 367, 208,
+	281, 278, 395, 232, 225, 221, 207, 255, 287, 326,
+	385, 320, 548, 275, 0, 0, 376, 299, 0, 0,
+	0, 0, 0, 539, 540, 0, 0, 0, 0, 0,
+	0, 0, 174, 352, 0, 0, 261, 206, 175, 311,
+	377, 236, 71, 0, 0, 167, 168, 169, 526, 525,
+	528, 529, 530, 531, 0, 0, 197, 527, 204, 532,
+	533, 534, 0, 218, 259, 224, 217, 392, 0, 0,
+	0, 502, 519, 0, 547, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 516, 517, 595, 0, 0, 0,
+	563, 0, 518, 0, 0, 511, 512, 514, 513, 515,
+	520, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 300, 0, 562, 0, 0, 424, 0, 0,
+	560, 0, 0, 0, 0, 0, 270, 0, 267, 171,
+	186, 0, 0, 310, 350, 356, 0, 0, 0, 209,
+	0, 354, 324, 409, 193, 234, 347, 329, 0, 0,
+	353, 276, 397, 342, 407, 330, 0, 0, 280, 425,
+	426, 216, 304, 415, 389, 421, 436, 187, 213, 318,
+	382, 412, 373, 297, 393, 394, 266, 372, 242, 274,
+	433, 185, 362, 201, 178, 384, 405, 198, 365, 0,
+	0, 0, 180, 403, 381, 294, 263, 264, 179, 0,
+	346, 220, 240, 211, 313, 400, 401, 210, 438, 189,
+	420, 182, 0, 419, 306, 396, 404, 295, 286, 181,
+	402, 293, 285, 269, 230, 250, 340, 279, 341, 251,
+	302, 301, 303, 0, 176, 0, 378, 413, 439, 194,
+	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
+	272, 317, 339, 337, 343, 0, 391, 408, 416, 423,
+	429, 430, 434, 431, 432, 435, 305, 190, 253, 374,
+	268, 277, 0, 0, 323, 355, 199, 411, 375, 550,
+	561, 556, 557, 554, 555, 549, 553, 552, 551, 564,
+	541, 542, 543, 544, 546, 0, 558, 559, 545, 170,
+	183, 273, 0, 344, 237, 437, 418, 414, 0, 0,
+	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
-	257, 260, 265, 282, 287, 288, 289, 290, 306, 307,
-	308, 311, 314, 315, 318, 320, 321, 324, 330, 331,
-	332, 333, 334, 336, 343, 347, 355, 356, 357, 358,
-	359, 361, 362, 366, 367, 368, 369, 377, 381, 396,
-	397, 408, 420, 425, 246, 404, 426, 0, 281, 0,
-	0, 283, 231, 248, 258, 0, 415, 378, 188, 349,
-	238, 177, 205, 191, 212, 226, 228, 262, 291, 297,
-	326, 329, 243, 223, 203, 346, 200, 364, 384, 385,
-	386, 388, 295, 219, 313, 0, 0, 0, 0, 503,
-	0, 0, 0, 222, 0, 502, 0, 0, 0, 271,
-	0, 0, 0, 327, 0, 365, 208, 280, 278, 393,
-	232, 225, 221, 207, 255, 286, 325, 383, 319, 546,
-	275, 0, 0, 374, 298, 0, 0, 0, 0, 0,
-	537, 538, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 206, 175, 310, 375, 236, 71, 0, 0, 167,
-	168, 169, 524, 1410, 526, 527, 528, 529, 0, 0,
-	197, 525, 204, 530, 531, 532, 0, 218, 259, 224,
-	217, 390, 0, 0, 0, 500, 517, 0, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 515,
-	593, 0, 0, 0, 561, 0, 516, 0, 0, 509,
-	510, 512, 511, 513, 518, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 299, 0, 560, 0,
-	0, 422, 0, 0, 558, 0, 0, 0, 0, 0,
-	270, 0, 267, 171, 186, 0, 0, 309, 348, 354,
-	0, 0, 0, 209, 0, 352, 323, 407, 193, 234,
-	345, 328, 350, 0, 0, 351, 276, 395, 340, 405,
-	423, 424, 216, 303, 413, 387, 419, 434, 187, 213,
-	317, 380, 410, 371, 296, 391, 392, 266, 370, 242,
-	174, 274, 431, 185, 360, 201, 178, 382, 403, 198,
-	363, 0, 0, 0, 180, 401, 379, 293, 263, 264,
-	179, 0, 344, 220, 240, 211, 312, 398, 399, 210,
-	436, 189, 418, 182, 0, 417, 305, 394, 402, 294,
-	285, 181, 400, 292, 284, 269, 230, 250, 338, 279,
-	339, 251, 301, 300, 302, 0, 176, 0, 376, 411,
-	437, 194, 195, 196, 0, 229, 233, 239, 241, 0,
-	247, 254, 272, 316, 337, 335, 341, 0, 389, 406,
-	414, 421, 427, 428, 432, 429, 430, 433, 304, 190,
-	253, 372, 268, 277, 0, 0, 322, 353, 199, 409,
-	373, 548, 559, 554, 555, 552, 553, 547, 551, 550,
-	549, 562, 539, 540, 541, 542, 544, 0, 556, 557,
-	543, 170, 183, 273, 0, 342, 237, 435, 416, 412,
+	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
+	235, 245, 249, 252, 256, 257, 260, 265, 283, 288,
+	289, 290, 291, 307, 308, 309, 312, 315, 316, 319,
+	321, 322, 325, 332, 333, 334, 335, 336, 338, 345,
+	349, 357, 358, 359, 360, 361, 363, 364, 368, 369,
+	370, 371, 379, 383, 398, 399, 410, 422, 427, 246,
+	406, 428, 0, 282, 0, 0, 284, 231, 248, 258,
+	0, 417, 380, 188, 351, 238, 177, 205, 191, 212,
+	226, 228, 262, 292, 298, 327, 331, 243, 223, 203,
+	348, 200, 366, 386, 387, 388, 390, 296, 219, 314,
+	0, 0, 0, 0, 505, 0, 0, 0, 222, 0,
+	504, 0, 0, 0, 271, 0, 0, 0, 328, 0,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 548, 275, 0, 0, 376, 299,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 0, 0, 0, 174, 352, 0, 0, 261, 206,
+	175, 311, 377, 236, 71, 0, 0, 167, 168, 169,
+	526, 1419, 528, 529, 530, 531, 0, 0, 197, 527,
+	204, 532, 533, 534, 0, 218, 259, 224, 217, 392,
+	0, 0, 0, 502, 519, 0, 547, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 516, 517, 595, 0,
+	0, 0, 563, 0, 518, 0, 0, 511, 512, 514,
+	513, 515, 520, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 300, 0, 562, 0, 0, 424,
+	0, 0, 560, 0, 0, 0, 0, 0, 270, 0,
+	267, 171, 186, 0, 0, 310, 350, 356, 0, 0,
+	0, 209, 0, 354, 324, 409, 193, 234, 347, 329,
+	0, 0, 353, 276, 397, 342, 407, 330, 0, 0,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 0, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 0, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 0, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 0, 0, 323, 355, 199, 411,
+	375, 550, 561, 556, 557, 554, 555, 549, 553, 552,
+	551, 564, 541, 542, 543, 544, 546, 0, 558, 559,
+	545, 170, 183, 273, 0, 344, 237, 437, 418, 414,
 	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
 	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
-	282, 287, 288, 289, 290, 306, 307, 308, 311, 314,
-	315, 318, 320, 321, 324, 330, 331, 332, 333, 334,
-	336, 343, 347, 355, 356, 357, 358, 359, 361, 362,
-	366, 367, 368, 369, 377, 381, 396, 397, 408, 420,
-	425, 246, 404, 426, 0, 281, 0, 0, 283, 231,
-	248, 258, 0, 415, 378, 188, 349, 238, 177, 205,
-	191, 212, 226, 228, 262, 291, 297, 326, 329, 243,
-	223, 203, 346, 200, 364, 384, 385, 386, 388, 295,
-	219, 313, 0, 0, 0, 0, 503, 0, 0, 0,
-	222, 0, 502, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 546, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 537, 538, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 71, 0, 0, 167, 168, 169, 524,
-	1407, 526, 527, 528, 529, 0, 0, 197, 525, 204,
-	530, 531, 532, 0, 218, 259, 224, 217, 390, 0,
-	0, 0, 500, 517, 0, 545, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 515, 593, 0, 0,
-	0, 561, 0, 516, 0, 0, 509, 510, 512, 511,
-	513, 518, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 560, 0, 0, 422, 0,
-	0, 558, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
-	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 548, 559,
-	554, 555, 552, 553, 547, 551, 550, 549, 562, 539,
-	540, 541, 542, 544, 0, 556, 557, 543, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 0, 0, 284, 231,
+	248, 258, 0, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 314, 0, 0, 0, 0, 505, 0, 0, 0,
+	222, 0, 504, 0, 0, 0, 271, 0, 0, 0,
+	328, 0, 367, 208, 281, 278, 395, 232, 225, 221,
+	207, 255, 287, 326, 385, 320, 548, 275, 0, 0,
+	376, 299, 0, 0, 0, 0, 0, 539, 540, 0,
+	0, 0, 0, 0, 0, 0, 174, 352, 0, 0,
+	261, 206, 175, 311, 377, 236, 71, 0, 0, 167,
+	168, 169, 526, 1416, 528, 529, 530, 531, 0, 0,
+	197, 527, 204, 532, 533, 534, 0, 218, 259, 224,
+	217, 392, 0, 0, 0, 502, 519, 0, 547, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 517,
+	595, 0, 0, 0, 563, 0, 518, 0, 0, 511,
+	512, 514, 513, 515, 520, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 300, 0, 562, 0,
+	0, 424, 0, 0, 560, 0, 0, 0, 0, 0,
+	270, 0, 267, 171, 186, 0, 0, 310, 350, 356,
+	0, 0, 0, 209, 0, 354, 324, 409, 193, 234,
+	347, 329, 0, 0, 353, 276, 397, 342, 407, 330,
+	0, 0, 280, 425, 426, 216, 304, 415, 389, 421,
+	436, 187, 213, 318, 382, 412, 373, 297, 393, 394,
+	266, 372, 242, 274, 433, 185, 362, 201, 178, 384,
+	405, 198, 365, 0, 0, 0, 180, 403, 381, 294,
+	263, 264, 179, 0, 346, 220, 240, 211, 313, 400,
+	401, 210, 438, 189, 420, 182, 0, 419, 306, 396,
+	404, 295, 286, 181, 402, 293, 285, 269, 230, 250,
+	340, 279, 341, 251, 302, 301, 303, 0, 176, 0,
+	378, 413, 439, 194, 195, 196, 0, 229, 233, 239,
+	241, 0, 247, 254, 272, 317, 339, 337, 343, 0,
+	391, 408, 416, 423, 429, 430, 434, 431, 432, 435,
+	305, 190, 253, 374, 268, 277, 0, 0, 323, 355,
+	199, 411, 375, 550, 561, 556, 557, 554, 555, 549,
+	553, 552, 551, 564, 541, 542, 543, 544, 546, 0,
+	558, 559, 545, 170, 183, 273, 0, 344, 237, 437,
+	418, 414, 0, 0, 215, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 573, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 313, 0, 0, 0, 0, 503, 0, 0, 0,
-	222, 0, 502, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 546, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 537, 538, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 71, 0, 0, 167, 168, 169, 524,
-	523, 526, 527, 528, 529, 0, 0, 197, 525, 204,
-	530, 531, 532, 0, 218, 259, 224, 217, 390, 0,
-	0, 0, 500, 517, 0, 545, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 515, 0, 0, 0,
-	0, 561, 0, 516, 0, 0, 509, 510, 512, 511,
-	513, 518, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 560, 0, 0, 422, 0,
-	0, 558, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
+	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
+	260, 265, 283, 288, 289, 290, 291, 307, 308, 309,
+	312, 315, 316, 319, 321, 322, 325, 332, 333, 334,
+	335, 336, 338, 345, 349, 357, 358, 359, 360, 361,
+	363, 364, 368, 369, 370, 371, 379, 383, 398, 399,
+	410, 422, 427, 246, 406, 428, 0, 282, 0, 0,
+	284, 231, 248, 258, 0, 417, 380, 188, 351, 238,
+	177, 205, 191, 212, 226, 228, 262, 292, 298, 327,
+	331, 243, 223, 203, 348, 200, 366, 386, 387, 388,
+	390, 296, 219, 575, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 314, 0, 0, 0,
+	0, 505, 0, 0, 0, 222, 0, 504, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 548, 275, 0, 0, 376, 299, 0, 0, 0,
+	0, 0, 539, 540, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 71, 0, 0, 167, 168, 169, 526, 525, 528,
+	529, 530, 531, 0, 0, 197, 527, 204, 532, 533,
+	534, 0, 218, 259, 224, 217, 392, 0, 0, 0,
+	502, 519, 0, 547, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 516, 517, 0, 0, 0, 0, 563,
+	0, 518, 0, 0, 511, 512, 514, 513, 515, 520,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 562, 0, 0, 424, 0, 0, 560,
+	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
+	0, 0, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
 	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 548, 559,
-	554, 555, 552, 553, 547, 551, 550, 549, 562, 539,
-	540, 541, 542, 544, 0, 556, 557, 543, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 550, 561,
+	556, 557, 554, 555, 549, 553, 552, 551, 564, 541,
+	542, 543, 544, 546, 0, 558, 559, 545, 170, 183,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 313, 0,
-	0, 0, 0, 503, 0, 0, 0, 222, 0, 502,
-	0, 0, 0, 271, 0, 0, 0, 327, 0, 365,
-	208, 280, 278, 393, 232, 225, 221, 207, 255, 286,
-	325, 383, 319, 546, 275, 0, 0, 374, 298, 0,
-	0, 0, 0, 0, 537, 538, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 206, 175, 310, 375, 236,
-	71, 0, 0, 167, 168, 169, 524, 523, 526, 527,
-	528, 529, 0, 0, 197, 525, 204, 530, 531, 532,
-	0, 218, 259, 224, 217, 390, 0, 0, 0, 500,
-	517, 0, 545, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 515, 0, 0, 0, 0, 561, 0,
-	516, 0, 0, 509, 510, 512, 511, 513, 518, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	299, 0, 560, 0, 0, 422, 0, 0, 558, 0,
-	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
-	0, 309, 348, 354, 0, 0, 0, 209, 0, 352,
-	323, 407, 193, 234, 345, 328, 350, 0, 0, 351,
-	276, 395, 340, 405, 423, 424, 216, 303, 413, 387,
-	419, 434, 187, 213, 317, 380, 410, 371, 296, 391,
-	392, 266, 370, 242, 174, 274, 431, 185, 360, 201,
-	178, 382, 403, 198, 363, 0, 0, 0, 180, 401,
-	379, 293, 263, 264, 179, 0, 344, 220, 240, 211,
-	312, 398, 399, 210, 436, 189, 418, 182, 0, 417,
-	305, 394, 402, 294, 285, 181, 400, 292, 284, 269,
-	230, 250, 338, 279, 339, 251, 301, 300, 302, 0,
-	176, 0, 376, 411, 437, 194, 195, 196, 0, 229,
-	233, 239, 241, 0, 247, 254, 272, 316, 337, 335,
-	341, 0, 389, 406, 414, 421, 427, 428, 432, 429,
-	430, 433, 304, 190, 253, 372, 268, 277, 0, 0,
-	322, 353, 199, 409, 373, 548, 559, 554, 555, 552,
-	553, 547, 551, 550, 549, 562, 539, 540, 541, 542,
-	544, 0, 556, 557, 543, 170, 183, 273, 0, 342,
-	237, 435, 416, 412, 0, 0, 215, 0, 0, 0,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 314, 0,
+	0, 0, 0, 505, 0, 0, 0, 222, 0, 504,
+	0, 0, 0, 271, 0, 0, 0, 328, 0, 367,
+	208, 281, 278, 395, 232, 225, 221, 207, 255, 287,
+	326, 385, 320, 548, 275, 0, 0, 376, 299, 0,
+	0, 0, 0, 0, 539, 540, 0, 0, 0, 0,
+	0, 0, 0, 174, 352, 0, 0, 261, 206, 175,
+	311, 377, 236, 71, 0, 0, 167, 168, 169, 526,
+	525, 528, 529, 530, 531, 0, 0, 197, 527, 204,
+	532, 533, 534, 0, 218, 259, 224, 217, 392, 0,
+	0, 0, 502, 519, 0, 547, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 244, 0, 300, 0, 562, 0, 0, 424, 0,
+	0, 560, 0, 0, 0, 0, 0, 270, 0, 267,
+	171, 186, 0, 0, 310, 350, 356, 0, 0, 0,
+	209, 0, 354, 324, 409, 193, 234, 347, 329, 0,
+	0, 353, 276, 397, 342, 407, 330, 0, 0, 280,
+	425, 426, 216, 304, 415, 389, 421, 436, 187, 213,
+	318, 382, 412, 373, 297, 393, 394, 266, 372, 242,
+	274, 433, 185, 362, 201, 178, 384, 405, 198, 365,
+	0, 0, 0, 180, 403, 381, 294, 263, 264, 179,
+	0, 346, 220, 240, 211, 313, 400, 401, 210, 438,
+	189, 420, 182, 0, 419, 306, 396, 404, 295, 286,
+	181, 402, 293, 285, 269, 230, 250, 340, 279, 341,
+	251, 302, 301, 303, 0, 176, 0, 378, 413, 439,
+	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
+	254, 272, 317, 339, 337, 343, 0, 391, 408, 416,
+	423, 429, 430, 434, 431, 432, 435, 305, 190, 253,
+	374, 268, 277, 0, 0, 323, 355, 199, 411, 375,
+	550, 561, 556, 557, 554, 555, 549, 553, 552, 551,
+	564, 541, 542, 543, 544, 546, 0, 558, 559, 545,
+	170, 183, 273, 0, 344, 237, 437, 418, 414, 0,
+	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
-	256, 257, 260, 265, 282, 287, 288, 289, 290, 306,
-	307, 308, 311, 314, 315, 318, 320, 321, 324, 330,
-	331, 332, 333, 334, 336, 343, 347, 355, 356, 357,
-	358, 359, 361, 362, 366, 367, 368, 369, 377, 381,
-	396, 397, 408, 420, 425, 246, 404, 426, 0, 281,
-	0, 0, 283, 231, 248, 258, 0, 415, 378, 188,
-	349, 238, 177, 205, 191, 212, 226, 228, 262, 291,
-	297, 326, 329, 243, 223, 203, 346, 200, 364, 384,
-	385, 386, 388, 295, 219, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
-	271, 0, 0, 0, 327, 0, 365, 208, 280, 278,
-	393, 232, 225, 221, 207, 255, 286, 325, 383, 319,
-	546, 275, 0, 0, 374, 298, 0, 0, 0, 0,
-	0, 537, 538, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 206, 175, 310, 375, 236, 71, 0, 0,
-	167, 168, 169, 524, 523, 526, 527, 528, 529, 0,
-	0, 197, 525, 204, 530, 531, 532, 0, 218, 259,
-	224, 217, 390, 0, 0, 0, 0, 517, 0, 545,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 514,
-	515, 0, 0, 0, 0, 561, 0, 516, 0, 0,
-	509, 510, 512, 511, 513, 518, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 299, 0, 560,
-	0, 0, 422, 0, 0, 558, 0, 0, 0, 0,
-	0, 270, 0, 267, 171, 186, 0, 0, 309, 348,
-	354, 0, 0, 0, 209, 0, 352, 323, 407, 193,
-	234, 345, 328, 350, 2197, 0, 351, 276, 395, 340,
-	405, 423, 424, 216, 303, 413, 387, 419, 434, 187,
-	213, 317, 380, 410, 371, 296, 391, 392, 266, 370,
-	242, 174, 274, 431, 185, 360, 201, 178, 382, 403,
-	198, 363, 0, 0, 0, 180, 401, 379, 293, 263,
-	264, 179, 0, 344, 220, 240, 211, 312, 398, 399,
-	210, 436, 189, 418, 182, 0, 417, 305, 394, 402,
-	294, 285, 181, 400, 292, 284, 269, 230, 250, 338,
-	279, 339, 251, 301, 300, 302, 0, 176, 0, 376,
-	411, 437, 194, 195, 196, 0, 229, 233, 239, 241,
-	0, 247, 254, 272, 316, 337, 335, 341, 0, 389,
-	406, 414, 421, 427, 428, 432, 429, 430, 433, 304,
-	190, 253, 372, 268, 277, 0, 0, 322, 353, 199,
-	409, 373, 548, 559, 554, 555, 552, 553, 547, 551,
-	550, 549, 562, 539, 540, 541, 542, 544, 0, 556,
-	557, 543, 170, 183, 273, 0, 342, 237, 435, 416,
-	412, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
+	227, 235, 245, 249, 252, 256, 257, 260, 265, 283,
+	288, 289, 290, 291, 307, 308, 309, 312, 315, 316,
+	319, 321, 322, 325, 332, 333, 334, 335, 336, 338,
+	345, 349, 357, 358, 359, 360, 361, 363, 364, 368,
+	369, 370, 371, 379, 383, 398, 399, 410, 422, 427,
+	246, 406, 428, 0, 282, 0, 0, 284, 231, 248,
+	258, 0, 417, 380, 188, 351, 238, 177, 205, 191,
+	212, 226, 228, 262, 292, 298, 327, 331, 243, 223,
+	203, 348, 200, 366, 386, 387, 388, 390, 296, 219,
+	314, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 328,
+	0, 367, 208, 281, 278, 395, 232, 225, 221, 207,
+	255, 287, 326, 385, 320, 548, 275, 0, 0, 376,
+	299, 0, 0, 0, 0, 0, 539, 540, 0, 0,
+	0, 0, 0, 0, 0, 174, 352, 0, 0, 261,
+	206, 175, 311, 377, 236, 71, 0, 582, 167, 168,
+	169, 526, 525, 528, 529, 530, 531, 0, 0, 197,
+	527, 204, 532, 533, 534, 0, 218, 259, 224, 217,
+	392, 0, 0, 0, 0, 519, 0, 547, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 517, 0,
+	0, 0, 0, 563, 0, 518, 0, 0, 511, 512,
+	514, 513, 515, 520, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 300, 0, 562, 0, 0,
+	424, 0, 0, 560, 0, 0, 0, 0, 0, 270,
+	0, 267, 171, 186, 0, 0, 310, 350, 356, 0,
+	0, 0, 209, 0, 354, 324, 409, 193, 234, 347,
+	329, 0, 0, 353, 276, 397, 342, 407, 330, 0,
+	0, 280, 425, 426, 216, 304, 415, 389, 421, 436,
+	187, 213, 318, 382, 412, 373, 297, 393, 394, 266,
+	372, 242, 274, 433, 185, 362, 201, 178, 384, 405,
+	198, 365, 0, 0, 0, 180, 403, 381, 294, 263,
+	264, 179, 0, 346, 220, 240, 211, 313, 400, 401,
+	210, 438, 189, 420, 182, 0, 419, 306, 396, 404,
+	295, 286, 181, 402, 293, 285, 269, 230, 250, 340,
+	279, 341, 251, 302, 301, 303, 0, 176, 0, 378,
+	413, 439, 194, 195, 196, 0, 229, 233, 239, 241,
+	0, 247, 254, 272, 317, 339, 337, 343, 0, 391,
+	408, 416, 423, 429, 430, 434, 431, 432, 435, 305,
+	190, 253, 374, 268, 277, 0, 0, 323, 355, 199,
+	411, 375, 550, 561, 556, 557, 554, 555, 549, 553,
+	552, 551, 564, 541, 542, 543, 544, 546, 0, 558,
+	559, 545, 170, 183, 273, 0, 344, 237, 437, 418,
+	414, 0, 0, 215, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 172, 173, 184, 192,
 	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
-	265, 282, 287, 288, 289, 290, 306, 307, 308, 311,
-	314, 315, 318, 320, 321, 324, 330, 331, 332, 333,
-	334, 336, 343, 347, 355, 356, 357, 358, 359, 361,
-	362, 366, 367, 368, 369, 377, 381, 396, 397, 408,
-	420, 425, 246, 404, 426, 0, 281, 0, 0, 283,
-	231, 248, 258, 0, 415, 378, 188, 349, 238, 177,
-	205, 191, 212, 226, 228, 262, 291, 297, 326, 329,
-	243, 223, 203, 346, 200, 364, 384, 385, 386, 388,
-	295, 219, 313, 0, 0, 0, 0, 0, 0, 0,
+	265, 283, 288, 289, 290, 291, 307, 308, 309, 312,
+	315, 316, 319, 321, 322, 325, 332, 333, 334, 335,
+	336, 338, 345, 349, 357, 358, 359, 360, 361, 363,
+	364, 368, 369, 370, 371, 379, 383, 398, 399, 410,
+	422, 427, 246, 406, 428, 0, 282, 0, 0, 284,
+	231, 248, 258, 0, 417, 380, 188, 351, 238, 177,
+	205, 191, 212, 226, 228, 262, 292, 298, 327, 331,
+	243, 223, 203, 348, 200, 366, 386, 387, 388, 390,
+	296, 219, 314, 0, 0, 0, 0, 0, 0, 0,
 	0, 222, 0, 0, 0, 0, 0, 271, 0, 0,
-	0, 327, 0, 365, 208, 280, 278, 393, 232, 225,
-	221, 207, 255, 286, 325, 383, 319, 546, 275, 0,
-	0, 374, 298, 0, 0, 0, 0, 0, 537, 538,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 206,
-	175, 310, 375, 236, 71, 0, 580, 167, 168, 169,
-	524, 523, 526, 527, 528, 529, 0, 0, 197, 525,
-	204, 530, 531, 532, 0, 218, 259, 224, 217, 390,
-	0, 0, 0, 0, 517, 0, 545, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 514, 515, 0, 0,
-	0, 0, 561, 0, 516, 0, 0, 509, 510, 512,
-	511, 513, 518, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 299, 0, 560, 0, 0, 422,
-	0, 0, 558, 0, 0, 0, 0, 0, 270, 0,
-	267, 171, 186, 0, 0, 309, 348, 354, 0, 0,
-	0, 209, 0, 352, 323, 407, 193, 234, 345, 328,
-	350, 0, 0, 351, 276, 395, 340, 405, 423, 424,
-	216, 303, 413, 387, 419, 434, 187, 213, 317, 380,
-	410, 371, 296, 391, 392, 266, 370, 242, 174, 274,
-	431, 185, 360, 201, 178, 382, 403, 198, 363, 0,
-	0, 0, 180, 401, 379, 293, 263, 264, 179, 0,
-	344, 220, 240, 211, 312, 398, 399, 210, 436, 189,
-	418, 182, 0, 417, 305, 394, 402, 294, 285, 181,
-	400, 292, 284, 269, 230, 250, 338, 279, 339, 251,
-	301, 300, 302, 0, 176, 0, 376, 411, 437, 194,
-	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
-	272, 316, 337, 335, 341, 0, 389, 406, 414, 421,
-	427, 428, 432, 429, 430, 433, 304, 190, 253, 372,
-	268, 277, 0, 0, 322, 353, 199, 409, 373, 548,
-	559, 554, 555, 552, 553, 547, 551, 550, 549, 562,
-	539, 540, 541, 542, 544, 0, 556, 557, 543, 170,
-	183, 273, 0, 342, 237, 435, 416, 412, 0, 0,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 0, 367, 208, 281, 278, 395, 232, 225,
+	221, 207, 255, 287, 326, 385, 320, 548, 275, 0,
+	0, 376, 299, 0, 0, 0, 0, 0, 539, 540,
+	0, 0, 0, 0, 0, 0, 0, 174, 352, 0,
+	0, 261, 206, 175, 311, 377, 236, 71, 0, 0,
+	167, 168, 169, 526, 525, 528, 529, 530, 531, 0,
+	0, 197, 527, 204, 532, 533, 534, 0, 218, 259,
+	224, 217, 392, 0, 0, 0, 0, 519, 0, 547,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 516,
+	517, 0, 0, 0, 0, 563, 0, 518, 0, 0,
+	511, 512, 514, 513, 515, 520, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 244, 0, 300, 0, 562,
+	0, 0, 424, 0, 0, 560, 0, 0, 0, 0,
+	0, 270, 0, 267, 171, 186, 0, 0, 310, 350,
+	356, 0, 0, 0, 209, 0, 354, 324, 409, 193,
+	234, 347, 329, 0, 0, 353, 276, 397, 342, 407,
+	330, 0, 0, 280, 425, 426, 216, 304, 415, 389,
+	421, 436, 187, 213, 318, 382, 412, 373, 297, 393,
+	394, 266, 372, 242, 274, 433, 185, 362, 201, 178,
+	384, 405, 198, 365, 0, 0, 0, 180, 403, 381,
+	294, 263, 264, 179, 0, 346, 220, 240, 211, 313,
+	400, 401, 210, 438, 189, 420, 182, 0, 419, 306,
+	396, 404, 295, 286, 181, 402, 293, 285, 269, 230,
+	250, 340, 279, 341, 251, 302, 301, 303, 0, 176,
+	0, 378, 413, 439, 194, 195, 196, 0, 229, 233,
+	239, 241, 0, 247, 254, 272, 317, 339, 337, 343,
+	0, 391, 408, 416, 423, 429, 430, 434, 431, 432,
+	435, 305, 190, 253, 374, 268, 277, 0, 0, 323,
+	355, 199, 411, 375, 550, 561, 556, 557, 554, 555,
+	549, 553, 552, 551, 564, 541, 542, 543, 544, 546,
+	0, 558, 559, 545, 170, 183, 273, 0, 344, 237,
+	437, 418, 414, 0, 0, 215, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
-	235, 245, 249, 252, 256, 257, 260, 265, 282, 287,
-	288, 289, 290, 306, 307, 308, 311, 314, 315, 318,
-	320, 321, 324, 330, 331, 332, 333, 334, 336, 343,
-	347, 355, 356, 357, 358, 359, 361, 362, 366, 367,
-	368, 369, 377, 381, 396, 397, 408, 420, 425, 246,
-	404, 426, 0, 281, 0, 0, 283, 231, 248, 258,
-	0, 415, 378, 188, 349, 238, 177, 205, 191, 212,
-	226, 228, 262, 291, 297, 326, 329, 243, 223, 203,
-	346, 200, 364, 384, 385, 386, 388, 295, 219, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	0, 0, 0, 0, 271, 0, 0, 0, 327, 0,
-	365, 208, 280, 278, 393, 232, 225, 221, 207, 255,
-	286, 325, 383, 319, 546, 275, 0, 0, 374, 298,
-	0, 0, 0, 0, 0, 537, 538, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 206, 175, 310, 375,
-	236, 71, 0, 0, 167, 168, 169, 524, 523, 526,
-	527, 528, 529, 0, 0, 197, 525, 204, 530, 531,
-	532, 0, 218, 259, 224, 217, 390, 0, 0, 0,
-	0, 517, 0, 545, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 514, 515, 0, 0, 0, 0, 561,
-	0, 516, 0, 0, 509, 510, 512, 511, 513, 518,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 299, 0, 560, 0, 0, 422, 0, 0, 558,
-	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
-	0, 0, 309, 348, 354, 0, 0, 0, 209, 0,
-	352, 323, 407, 193, 234, 345, 328, 350, 0, 0,
-	351, 276, 395, 340, 405, 423, 424, 216, 303, 413,
-	387, 419, 434, 187, 213, 317, 380, 410, 371, 296,
-	391, 392, 266, 370, 242, 174, 274, 431, 185, 360,
-	201, 178, 382, 403, 198, 363, 0, 0, 0, 180,
-	401, 379, 293, 263, 264, 179, 0, 344, 220, 240,
-	211, 312, 398, 399, 210, 436, 189, 418, 182, 0,
-	417, 305, 394, 402, 294, 285, 181, 400, 292, 284,
-	269, 230, 250, 338, 279, 339, 251, 301, 300, 302,
-	0, 176, 0, 376, 411, 437, 194, 195, 196, 0,
-	229, 233, 239, 241, 0, 247, 254, 272, 316, 337,
-	335, 341, 0, 389, 406, 414, 421, 427, 428, 432,
-	429, 430, 433, 304, 190, 253, 372, 268, 277, 0,
-	0, 322, 353, 199, 409, 373, 548, 559, 554, 555,
-	552, 553, 547, 551, 550, 549, 562, 539, 540, 541,
-	542, 544, 0, 556, 557, 543, 170, 183, 273, 0,
-	342, 237, 435, 416, 412, 0, 0, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
+	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
+	257, 260, 265, 283, 288, 289, 290, 291, 307, 308,
+	309, 312, 315, 316, 319, 321, 322, 325, 332, 333,
+	334, 335, 336, 338, 345, 349, 357, 358, 359, 360,
+	361, 363, 364, 368, 369, 370, 371, 379, 383, 398,
+	399, 410, 422, 427, 246, 406, 428, 0, 282, 0,
+	0, 284, 231, 248, 258, 0, 417, 380, 188, 351,
+	238, 177, 205, 191, 212, 226, 228, 262, 292, 298,
+	327, 331, 243, 223, 203, 348, 200, 366, 386, 387,
+	388, 390, 296, 219, 314, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 328, 0, 367, 208, 281, 278, 395,
+	232, 225, 221, 207, 255, 287, 326, 385, 320, 0,
+	275, 0, 0, 376, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	352, 0, 0, 261, 206, 175, 311, 377, 236, 0,
+	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
+	218, 259, 224, 217, 392, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 974, 973, 983,
+	984, 976, 977, 978, 979, 980, 981, 982, 975, 0,
+	0, 985, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 300,
+	0, 0, 0, 0, 424, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
+	310, 350, 356, 0, 0, 0, 209, 0, 354, 324,
+	409, 193, 234, 347, 329, 0, 0, 353, 276, 397,
+	342, 407, 330, 0, 0, 280, 425, 426, 216, 304,
+	415, 389, 421, 436, 187, 213, 318, 382, 412, 373,
+	297, 393, 394, 266, 372, 242, 274, 433, 185, 362,
+	201, 178, 384, 405, 198, 365, 0, 0, 0, 180,
+	403, 381, 294, 263, 264, 179, 0, 346, 220, 240,
+	211, 313, 400, 401, 210, 438, 189, 420, 182, 0,
+	419, 306, 396, 404, 295, 286, 181, 402, 293, 285,
+	269, 230, 250, 340, 279, 341, 251, 302, 301, 303,
+	0, 176, 0, 378, 413, 439, 194, 195, 196, 0,
+	229, 233, 239, 241, 0, 247, 254, 272, 317, 339,
+	337, 343, 0, 391, 408, 416, 423, 429, 430, 434,
+	431, 432, 435, 305, 190, 253, 374, 268, 277, 0,
+	0, 323, 355, 199, 411, 375, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 183, 273, 0,
+	344, 237, 437, 418, 414, 0, 0, 215, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
-	252, 256, 257, 260, 265, 282, 287, 288, 289, 290,
-	306, 307, 308, 311, 314, 315, 318, 320, 321, 324,
-	330, 331, 332, 333, 334, 336, 343, 347, 355, 356,
-	357, 358, 359, 361, 362, 366, 367, 368, 369, 377,
-	381, 396, 397, 408, 420, 425, 246, 404, 426, 0,
-	281, 0, 0, 283, 231, 248, 258, 0, 415, 378,
-	188, 349, 238, 177, 205, 191, 212, 226, 228, 262,
-	291, 297, 326, 329, 243, 223, 203, 346, 200, 364,
-	384, 385, 386, 388, 295, 219, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
-	0, 271, 0, 0, 0, 327, 0, 365, 208, 280,
-	278, 393, 232, 225, 221, 207, 255, 286, 325, 383,
-	319, 0, 275, 0, 0, 374, 298, 0, 0, 0,
+	252, 256, 257, 260, 265, 283, 288, 289, 290, 291,
+	307, 308, 309, 312, 315, 316, 319, 321, 322, 325,
+	332, 333, 334, 335, 336, 338, 345, 349, 357, 358,
+	359, 360, 361, 363, 364, 368, 369, 370, 371, 379,
+	383, 398, 399, 410, 422, 427, 246, 406, 428, 0,
+	282, 0, 0, 284, 231, 248, 258, 0, 417, 380,
+	188, 351, 238, 177, 205, 191, 212, 226, 228, 262,
+	292, 298, 327, 331, 243, 223, 203, 348, 200, 366,
+	386, 387, 388, 390, 296, 219, 314, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 802, 0, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 0, 275, 0, 0, 376, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
+	0, 0, 218, 259, 224, 217, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 206, 175, 310, 375, 236, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 197, 0, 204, 0, 0, 0, 0, 218,
-	259, 224, 217, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 967, 966, 976, 977,
-	969, 970, 971, 972, 973, 974, 975, 968, 0, 0,
-	978, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 299, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 0, 267, 171, 186, 0, 0, 309,
-	348, 354, 0, 0, 0, 209, 0, 352, 323, 407,
-	193, 234, 345, 328, 350, 0, 0, 351, 276, 395,
-	340, 405, 423, 424, 216, 303, 413, 387, 419, 434,
-	187, 213, 317, 380, 410, 371, 296, 391, 392, 266,
-	370, 242, 174, 274, 431, 185, 360, 201, 178, 382,
-	403, 198, 363, 0, 0, 0, 180, 401, 379, 293,
-	263, 264, 179, 0, 344, 220, 240, 211, 312, 398,
-	399, 210, 436, 189, 418, 182, 0, 417, 305, 394,
-	402, 294, 285, 181, 400, 292, 284, 269, 230, 250,
-	338, 279, 339, 251, 301, 300, 302, 0, 176, 0,
-	376, 411, 437, 194, 195, 196, 0, 229, 233, 239,
-	241, 0, 247, 254, 272, 316, 337, 335, 341, 0,
-	389, 406, 414, 421, 427, 428, 432, 429, 430, 433,
-	304, 190, 253, 372, 268, 277, 0, 0, 322, 353,
-	199, 409, 373, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 183, 273, 0, 342, 237, 435,
-	416, 412, 0, 0, 215, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
-	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
-	260, 265, 282, 287, 288, 289, 290, 306, 307, 308,
-	311, 314, 315, 318, 320, 321, 324, 330, 331, 332,
-	333, 334, 336, 343, 347, 355, 356, 357, 358, 359,
-	361, 362, 366, 367, 368, 369, 377, 381, 396, 397,
-	408, 420, 425, 246, 404, 426, 0, 281, 0, 0,
-	283, 231, 248, 258, 0, 415, 378, 188, 349, 238,
-	177, 205, 191, 212, 226, 228, 262, 291, 297, 326,
-	329, 243, 223, 203, 346, 200, 364, 384, 385, 386,
-	388, 295, 219, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 796, 0, 0, 0, 0, 271, 0,
-	0, 0, 327, 0, 365, 208, 280, 278, 393, 232,
-	225, 221, 207, 255, 286, 325, 383, 319, 0, 275,
-	0, 0, 374, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	206, 175, 310, 375, 236, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
-	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 0, 0, 801, 424, 0, 0, 0,
+	0, 0, 0, 798, 799, 270, 762, 267, 171, 186,
+	792, 796, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
+	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 299, 0, 0, 0, 795,
-	422, 0, 0, 0, 0, 0, 0, 792, 793, 270,
-	758, 267, 171, 186, 786, 790, 309, 348, 354, 0,
-	0, 0, 209, 0, 352, 323, 407, 193, 234, 345,
-	328, 350, 0, 0, 351, 276, 395, 340, 405, 423,
-	424, 216, 303, 413, 387, 419, 434, 187, 213, 317,
-	380, 410, 371, 296, 391, 392, 266, 370, 242, 174,
-	274, 431, 185, 360, 201, 178, 382, 403, 198, 363,
-	0, 0, 0, 180, 401, 379, 293, 263, 264, 179,
-	0, 344, 220, 240, 211, 312, 398, 399, 210, 436,
-	189, 418, 182, 0, 417, 305, 394, 402, 294, 285,
-	181, 400, 292, 284, 269, 230, 250, 338, 279, 339,
-	251, 301, 300, 302, 0, 176, 0, 376, 411, 437,
+	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 314, 0,
+	0, 0, 1077, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 271, 0, 0, 0, 328, 0, 367,
+	208, 281, 278, 395, 232, 225, 221, 207, 255, 287,
+	326, 385, 320, 0, 275, 0, 0, 376, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 352, 0, 0, 261, 206, 175,
+	311, 377, 236, 0, 0, 0, 167, 168, 169, 0,
+	1079, 0, 0, 0, 0, 0, 0, 197, 0, 204,
+	0, 0, 0, 0, 218, 259, 224, 217, 392, 963,
+	964, 962, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 965, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 244, 0, 300, 0, 0, 0, 0, 424, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
+	171, 186, 0, 0, 310, 350, 356, 0, 0, 0,
+	209, 0, 354, 324, 409, 193, 234, 347, 329, 0,
+	0, 353, 276, 397, 342, 407, 330, 0, 0, 280,
+	425, 426, 216, 304, 415, 389, 421, 436, 187, 213,
+	318, 382, 412, 373, 297, 393, 394, 266, 372, 242,
+	274, 433, 185, 362, 201, 178, 384, 405, 198, 365,
+	0, 0, 0, 180, 403, 381, 294, 263, 264, 179,
+	0, 346, 220, 240, 211, 313, 400, 401, 210, 438,
+	189, 420, 182, 0, 419, 306, 396, 404, 295, 286,
+	181, 402, 293, 285, 269, 230, 250, 340, 279, 341,
+	251, 302, 301, 303, 0, 176, 0, 378, 413, 439,
 	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
-	254, 272, 316, 337, 335, 341, 0, 389, 406, 414,
-	421, 427, 428, 432, 429, 430, 433, 304, 190, 253,
-	372, 268, 277, 0, 0, 322, 353, 199, 409, 373,
+	254, 272, 317, 339, 337, 343, 0, 391, 408, 416,
+	423, 429, 430, 434, 431, 432, 435, 305, 190, 253,
+	374, 268, 277, 0, 0, 323, 355, 199, 411, 375,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 183, 273, 0, 342, 237, 435, 416, 412, 0,
+	170, 183, 273, 0, 344, 237, 437, 418, 414, 0,
 	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
-	227, 235, 245, 249, 252, 256, 257, 260, 265, 282,
-	287, 288, 289, 290, 306, 307, 308, 311, 314, 315,
-	318, 320, 321, 324, 330, 331, 332, 333, 334, 336,
-	343, 347, 355, 356, 357, 358, 359, 361, 362, 366,
-	367, 368, 369, 377, 381, 396, 397, 408, 420, 425,
-	246, 404, 426, 0, 281, 0, 0, 283, 231, 248,
-	258, 0, 415, 378, 188, 349, 238, 177, 205, 191,
-	212, 226, 228, 262, 291, 297, 326, 329, 243, 223,
-	203, 346, 200, 364, 384, 385, 386, 388, 295, 219,
-	313, 0, 0, 0, 1070, 0, 0, 0, 0, 222,
-	0, 0, 0, 0, 0, 271, 0, 0, 0, 327,
-	0, 365, 208, 280, 278, 393, 232, 225, 221, 207,
-	255, 286, 325, 383, 319, 0, 275, 0, 0, 374,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 206, 175, 310,
-	375, 236, 0, 0, 0, 167, 168, 169, 0, 1072,
-	0, 0, 0, 0, 0, 0, 197, 0, 204, 0,
-	0, 0, 0, 218, 259, 224, 217, 390, 956, 957,
-	955, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 958, 0, 0, 0,
+	227, 235, 245, 249, 252, 256, 257, 260, 265, 283,
+	288, 289, 290, 291, 307, 308, 309, 312, 315, 316,
+	319, 321, 322, 325, 332, 333, 334, 335, 336, 338,
+	345, 349, 357, 358, 359, 360, 361, 363, 364, 368,
+	369, 370, 371, 379, 383, 398, 399, 410, 422, 427,
+	246, 406, 428, 0, 282, 0, 0, 284, 231, 248,
+	258, 0, 417, 380, 188, 351, 238, 177, 205, 191,
+	212, 226, 228, 262, 292, 298, 327, 331, 243, 223,
+	203, 348, 200, 366, 386, 387, 388, 390, 296, 219,
+	35, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 328, 0, 367, 208, 281, 278, 395, 232,
+	225, 221, 207, 255, 287, 326, 385, 320, 0, 275,
+	0, 0, 376, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 352,
+	0, 0, 261, 206, 175, 311, 377, 236, 71, 0,
+	582, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 0, 204, 0, 0, 0, 0, 218,
+	259, 224, 217, 392, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 299, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 0, 267, 171,
-	186, 0, 0, 309, 348, 354, 0, 0, 0, 209,
-	0, 352, 323, 407, 193, 234, 345, 328, 350, 0,
-	0, 351, 276, 395, 340, 405, 423, 424, 216, 303,
-	413, 387, 419, 434, 187, 213, 317, 380, 410, 371,
-	296, 391, 392, 266, 370, 242, 174, 274, 431, 185,
-	360, 201, 178, 382, 403, 198, 363, 0, 0, 0,
-	180, 401, 379, 293, 263, 264, 179, 0, 344, 220,
-	240, 211, 312, 398, 399, 210, 436, 189, 418, 182,
-	0, 417, 305, 394, 402, 294, 285, 181, 400, 292,
-	284, 269, 230, 250, 338, 279, 339, 251, 301, 300,
-	302, 0, 176, 0, 376, 411, 437, 194, 195, 196,
-	0, 229, 233, 239, 241, 0, 247, 254, 272, 316,
-	337, 335, 341, 0, 389, 406, 414, 421, 427, 428,
-	432, 429, 430, 433, 304, 190, 253, 372, 268, 277,
-	0, 0, 322, 353, 199, 409, 373, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 183, 273,
-	0, 342, 237, 435, 416, 412, 0, 0, 215, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 300, 0,
+	0, 0, 0, 424, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 267, 171, 186, 0, 0, 310,
+	350, 356, 0, 0, 0, 209, 0, 354, 324, 409,
+	193, 234, 347, 329, 0, 0, 353, 276, 397, 342,
+	407, 330, 0, 0, 280, 425, 426, 216, 304, 415,
+	389, 421, 436, 187, 213, 318, 382, 412, 373, 297,
+	393, 394, 266, 372, 242, 274, 433, 185, 362, 201,
+	178, 384, 405, 198, 365, 0, 0, 0, 180, 403,
+	381, 294, 263, 264, 179, 0, 346, 220, 240, 211,
+	313, 400, 401, 210, 438, 189, 420, 182, 0, 419,
+	306, 396, 404, 295, 286, 181, 402, 293, 285, 269,
+	230, 250, 340, 279, 341, 251, 302, 301, 303, 0,
+	176, 0, 378, 413, 439, 194, 195, 196, 0, 229,
+	233, 239, 241, 0, 247, 254, 272, 317, 339, 337,
+	343, 0, 391, 408, 416, 423, 429, 430, 434, 431,
+	432, 435, 305, 190, 253, 374, 268, 277, 0, 0,
+	323, 355, 199, 411, 375, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 183, 273, 0, 344,
+	237, 437, 418, 414, 0, 0, 215, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
-	249, 252, 256, 257, 260, 265, 282, 287, 288, 289,
-	290, 306, 307, 308, 311, 314, 315, 318, 320, 321,
-	324, 330, 331, 332, 333, 334, 336, 343, 347, 355,
-	356, 357, 358, 359, 361, 362, 366, 367, 368, 369,
-	377, 381, 396, 397, 408, 420, 425, 246, 404, 426,
-	0, 281, 0, 0, 283, 231, 248, 258, 0, 415,
-	378, 188, 349, 238, 177, 205, 191, 212, 226, 228,
-	262, 291, 297, 326, 329, 243, 223, 203, 346, 200,
-	364, 384, 385, 386, 388, 295, 219, 35, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 222,
-	0, 0, 0, 0, 0, 271, 0, 0, 0, 327,
-	0, 365, 208, 280, 278, 393, 232, 225, 221, 207,
-	255, 286, 325, 383, 319, 0, 275, 0, 0, 374,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 206, 175, 310,
-	375, 236, 71, 0, 580, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 197, 0, 204, 0,
-	0, 0, 0, 218, 259, 224, 217, 390, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
+	256, 257, 260, 265, 283, 288, 289, 290, 291, 307,
+	308, 309, 312, 315, 316, 319, 321, 322, 325, 332,
+	333, 334, 335, 336, 338, 345, 349, 357, 358, 359,
+	360, 361, 363, 364, 368, 369, 370, 371, 379, 383,
+	398, 399, 410, 422, 427, 246, 406, 428, 0, 282,
+	0, 0, 284, 231, 248, 258, 0, 417, 380, 188,
+	351, 238, 177, 205, 191, 212, 226, 228, 262, 292,
+	298, 327, 331, 243, 223, 203, 348, 200, 366, 386,
+	387, 388, 390, 296, 219, 314, 0, 0, 0, 1446,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	271, 0, 0, 0, 328, 0, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	0, 275, 0, 0, 376, 299, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 352, 0, 0, 261, 206, 175, 311, 377, 236,
+	0, 0, 0, 167, 168, 169, 0, 1448, 0, 0,
+	0, 0, 0, 0, 197, 0, 204, 0, 0, 0,
+	0, 218, 259, 224, 217, 392, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 299, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 0, 267, 171,
-	186, 0, 0, 309, 348, 354, 0, 0, 0, 209,
-	0, 352, 323, 407, 193, 234, 345, 328, 350, 0,
-	0, 351, 276, 395, 340, 405, 423, 424, 216, 303,
-	413, 387, 419, 434, 187, 213, 317, 380, 410, 371,
-	296, 391, 392, 266, 370, 242, 174, 274, 431, 185,
-	360, 201, 178, 382, 403, 198, 363, 0, 0, 0,
-	180, 401, 379, 293, 263, 264, 179, 0, 344, 220,
-	240, 211, 312, 398, 399, 210, 436, 189, 418, 182,
-	0, 417, 305, 394, 402, 294, 285, 181, 400, 292,
-	284, 269, 230, 250, 338, 279, 339, 251, 301, 300,
-	302, 0, 176, 0, 376, 411, 437, 194, 195, 196,
-	0, 229, 233, 239, 241, 0, 247, 254, 272, 316,
-	337, 335, 341, 0, 389, 406, 414, 421, 427, 428,
-	432, 429, 430, 433, 304, 190, 253, 372, 268, 277,
-	0, 0, 322, 353, 199, 409, 373, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	300, 0, 0, 0, 0, 424, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
+	0, 310, 350, 356, 0, 0, 0, 209, 0, 354,
+	324, 409, 193, 234, 347, 329, 0, 1444, 353, 276,
+	397, 342, 407, 330, 0, 0, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	0, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	0, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 0, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 305, 190, 253, 374, 268, 277,
+	0, 0, 323, 355, 199, 411, 375, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 170, 183, 273,
-	0, 342, 237, 435, 416, 412, 0, 0, 215, 0,
+	0, 344, 237, 437, 418, 414, 0, 0, 215, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
-	249, 252, 256, 257, 260, 265, 282, 287, 288, 289,
-	290, 306, 307, 308, 311, 314, 315, 318, 320, 321,
-	324, 330, 331, 332, 333, 334, 336, 343, 347, 355,
-	356, 357, 358, 359, 361, 362, 366, 367, 368, 369,
-	377, 381, 396, 397, 408, 420, 425, 246, 404, 426,
-	0, 281, 0, 0, 283, 231, 248, 258, 0, 415,
-	378, 188, 349, 238, 177, 205, 191, 212, 226, 228,
-	262, 291, 297, 326, 329, 243, 223, 203, 346, 200,
-	364, 384, 385, 386, 388, 295, 219, 313, 0, 0,
-	0, 1437, 0, 0, 0, 0, 222, 0, 0, 0,
-	0, 0, 271, 0, 0, 0, 327, 0, 365, 208,
-	280, 278, 393, 232, 225, 221, 207, 255, 286, 325,
-	383, 319, 0, 275, 0, 0, 374, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 206, 175, 310, 375, 236, 0,
-	0, 0, 167, 168, 169, 0, 1439, 0, 0, 0,
-	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
-	218, 259, 224, 217, 390, 0, 0, 0, 0, 0,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 0, 0, 284, 231, 248, 258, 0, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
+	0, 0, 271, 0, 0, 0, 328, 0, 367, 208,
+	281, 278, 395, 232, 225, 221, 207, 255, 287, 326,
+	385, 320, 0, 275, 0, 0, 376, 299, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 352, 0, 0, 261, 206, 175, 311,
+	377, 236, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 0, 204, 0,
+	0, 0, 0, 218, 259, 224, 217, 392, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 756, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 299,
-	0, 0, 0, 0, 422, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
-	309, 348, 354, 0, 0, 0, 209, 0, 352, 323,
-	407, 193, 234, 345, 328, 350, 0, 1435, 351, 276,
-	395, 340, 405, 423, 424, 216, 303, 413, 387, 419,
-	434, 187, 213, 317, 380, 410, 371, 296, 391, 392,
-	266, 370, 242, 174, 274, 431, 185, 360, 201, 178,
-	382, 403, 198, 363, 0, 0, 0, 180, 401, 379,
-	293, 263, 264, 179, 0, 344, 220, 240, 211, 312,
-	398, 399, 210, 436, 189, 418, 182, 0, 417, 305,
-	394, 402, 294, 285, 181, 400, 292, 284, 269, 230,
-	250, 338, 279, 339, 251, 301, 300, 302, 0, 176,
-	0, 376, 411, 437, 194, 195, 196, 0, 229, 233,
-	239, 241, 0, 247, 254, 272, 316, 337, 335, 341,
-	0, 389, 406, 414, 421, 427, 428, 432, 429, 430,
-	433, 304, 190, 253, 372, 268, 277, 0, 0, 322,
-	353, 199, 409, 373, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 183, 273, 0, 342, 237,
-	435, 416, 412, 0, 0, 215, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
-	257, 260, 265, 282, 287, 288, 289, 290, 306, 307,
-	308, 311, 314, 315, 318, 320, 321, 324, 330, 331,
-	332, 333, 334, 336, 343, 347, 355, 356, 357, 358,
-	359, 361, 362, 366, 367, 368, 369, 377, 381, 396,
-	397, 408, 420, 425, 246, 404, 426, 0, 281, 0,
-	0, 283, 231, 248, 258, 0, 415, 378, 188, 349,
-	238, 177, 205, 191, 212, 226, 228, 262, 291, 297,
-	326, 329, 243, 223, 203, 346, 200, 364, 384, 385,
-	386, 388, 295, 219, 313, 0, 0, 0, 0, 0,
-	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
-	0, 0, 0, 327, 0, 365, 208, 280, 278, 393,
-	232, 225, 221, 207, 255, 286, 325, 383, 319, 0,
-	275, 0, 0, 374, 298, 0, 0, 0, 0, 0,
+	244, 0, 300, 0, 0, 0, 0, 424, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 762, 267, 171,
+	186, 760, 0, 310, 350, 356, 0, 0, 0, 209,
+	0, 354, 324, 409, 193, 234, 347, 329, 0, 0,
+	353, 276, 397, 342, 407, 330, 0, 0, 280, 425,
+	426, 216, 304, 415, 389, 421, 436, 187, 213, 318,
+	382, 412, 373, 297, 393, 394, 266, 372, 242, 274,
+	433, 185, 362, 201, 178, 384, 405, 198, 365, 0,
+	0, 0, 180, 403, 381, 294, 263, 264, 179, 0,
+	346, 220, 240, 211, 313, 400, 401, 210, 438, 189,
+	420, 182, 0, 419, 306, 396, 404, 295, 286, 181,
+	402, 293, 285, 269, 230, 250, 340, 279, 341, 251,
+	302, 301, 303, 0, 176, 0, 378, 413, 439, 194,
+	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
+	272, 317, 339, 337, 343, 0, 391, 408, 416, 423,
+	429, 430, 434, 431, 432, 435, 305, 190, 253, 374,
+	268, 277, 0, 0, 323, 355, 199, 411, 375, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 206, 175, 310, 375, 236, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	197, 0, 204, 0, 0, 0, 0, 218, 259, 224,
-	217, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 752, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 299, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 758, 267, 171, 186, 756, 0, 309, 348, 354,
-	0, 0, 0, 209, 0, 352, 323, 407, 193, 234,
-	345, 328, 350, 0, 0, 351, 276, 395, 340, 405,
-	423, 424, 216, 303, 413, 387, 419, 434, 187, 213,
-	317, 380, 410, 371, 296, 391, 392, 266, 370, 242,
-	174, 274, 431, 185, 360, 201, 178, 382, 403, 198,
-	363, 0, 0, 0, 180, 401, 379, 293, 263, 264,
-	179, 0, 344, 220, 240, 211, 312, 398, 399, 210,
-	436, 189, 418, 182, 0, 417, 305, 394, 402, 294,
-	285, 181, 400, 292, 284, 269, 230, 250, 338, 279,
-	339, 251, 301, 300, 302, 0, 176, 0, 376, 411,
-	437, 194, 195, 196, 0, 229, 233, 239, 241, 0,
-	247, 254, 272, 316, 337, 335, 341, 0, 389, 406,
-	414, 421, 427, 428, 432, 429, 430, 433, 304, 190,
-	253, 372, 268, 277, 0, 0, 322, 353, 199, 409,
-	373, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 183, 273, 0, 342, 237, 435, 416, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	183, 273, 0, 344, 237, 437, 418, 414, 0, 0,
+	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
+	235, 245, 249, 252, 256, 257, 260, 265, 283, 288,
+	289, 290, 291, 307, 308, 309, 312, 315, 316, 319,
+	321, 322, 325, 332, 333, 334, 335, 336, 338, 345,
+	349, 357, 358, 359, 360, 361, 363, 364, 368, 369,
+	370, 371, 379, 383, 398, 399, 410, 422, 427, 246,
+	406, 428, 0, 282, 0, 0, 284, 231, 248, 258,
+	0, 417, 380, 188, 351, 238, 177, 205, 191, 212,
+	226, 228, 262, 292, 298, 327, 331, 243, 223, 203,
+	348, 200, 366, 386, 387, 388, 390, 296, 219, 314,
+	0, 0, 0, 1446, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 271, 0, 0, 0, 328, 0,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 0, 275, 0, 0, 376, 299,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 352, 0, 0, 261, 206,
+	175, 311, 377, 236, 0, 0, 0, 167, 168, 169,
+	0, 1448, 0, 0, 0, 0, 0, 0, 197, 0,
+	204, 0, 0, 0, 0, 218, 259, 224, 217, 392,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 300, 0, 0, 0, 0, 424,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	267, 171, 186, 0, 0, 310, 350, 356, 0, 0,
+	0, 209, 0, 354, 324, 409, 193, 234, 347, 329,
+	0, 0, 353, 276, 397, 342, 407, 330, 0, 0,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 0, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 0, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 0, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 0, 0, 323, 355, 199, 411,
+	375, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 183, 273, 0, 344, 237, 437, 418, 414,
 	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
 	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
-	282, 287, 288, 289, 290, 306, 307, 308, 311, 314,
-	315, 318, 320, 321, 324, 330, 331, 332, 333, 334,
-	336, 343, 347, 355, 356, 357, 358, 359, 361, 362,
-	366, 367, 368, 369, 377, 381, 396, 397, 408, 420,
-	425, 246, 404, 426, 0, 281, 0, 0, 283, 231,
-	248, 258, 0, 415, 378, 188, 349, 238, 177, 205,
-	191, 212, 226, 228, 262, 291, 297, 326, 329, 243,
-	223, 203, 346, 200, 364, 384, 385, 386, 388, 295,
-	219, 313, 0, 0, 0, 1437, 0, 0, 0, 0,
-	222, 0, 0, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 0, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 0, 0, 0, 167, 168, 169, 0,
-	1439, 0, 0, 0, 0, 0, 0, 197, 0, 204,
-	0, 0, 0, 0, 218, 259, 224, 217, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 0, 0, 284, 231,
+	248, 258, 0, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 35, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 314, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 328, 0, 367, 208, 281, 278, 395,
+	232, 225, 221, 207, 255, 287, 326, 385, 320, 0,
+	275, 0, 0, 376, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	352, 0, 0, 261, 206, 175, 311, 377, 236, 71,
+	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
+	218, 259, 224, 217, 392, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 0, 0, 0, 422, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
-	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 300,
+	0, 0, 0, 0, 424, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
+	310, 350, 356, 0, 0, 0, 209, 0, 354, 324,
+	409, 193, 234, 347, 329, 0, 0, 353, 276, 397,
+	342, 407, 330, 0, 0, 280, 425, 426, 216, 304,
+	415, 389, 421, 436, 187, 213, 318, 382, 412, 373,
+	297, 393, 394, 266, 372, 242, 274, 433, 185, 362,
+	201, 178, 384, 405, 198, 365, 0, 0, 0, 180,
+	403, 381, 294, 263, 264, 179, 0, 346, 220, 240,
+	211, 313, 400, 401, 210, 438, 189, 420, 182, 0,
+	419, 306, 396, 404, 295, 286, 181, 402, 293, 285,
+	269, 230, 250, 340, 279, 341, 251, 302, 301, 303,
+	0, 176, 0, 378, 413, 439, 194, 195, 196, 0,
+	229, 233, 239, 241, 0, 247, 254, 272, 317, 339,
+	337, 343, 0, 391, 408, 416, 423, 429, 430, 434,
+	431, 432, 435, 305, 190, 253, 374, 268, 277, 0,
+	0, 323, 355, 199, 411, 375, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
+	0, 0, 0, 0, 0, 0, 170, 183, 273, 0,
+	344, 237, 437, 418, 414, 0, 0, 215, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 35, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 313, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 0, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 0, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 71, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 197, 0, 204,
-	0, 0, 0, 0, 218, 259, 224, 217, 390, 0,
+	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
+	252, 256, 257, 260, 265, 283, 288, 289, 290, 291,
+	307, 308, 309, 312, 315, 316, 319, 321, 322, 325,
+	332, 333, 334, 335, 336, 338, 345, 349, 357, 358,
+	359, 360, 361, 363, 364, 368, 369, 370, 371, 379,
+	383, 398, 399, 410, 422, 427, 246, 406, 428, 0,
+	282, 0, 0, 284, 231, 248, 258, 0, 417, 380,
+	188, 351, 238, 177, 205, 191, 212, 226, 228, 262,
+	292, 298, 327, 331, 243, 223, 203, 348, 200, 366,
+	386, 387, 388, 390, 296, 219, 314, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 0, 275, 0, 0, 376, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 0, 0, 0, 167, 168, 169, 0, 0, 1467,
+	0, 0, 1468, 0, 0, 197, 0, 204, 0, 0,
+	0, 0, 218, 259, 224, 217, 392, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 0, 0, 0, 422, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 0, 0, 0, 424, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
+	0, 0, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
 	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 0, 0,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
-	0, 0, 0, 271, 0, 0, 0, 327, 0, 365,
-	208, 280, 278, 393, 232, 225, 221, 207, 255, 286,
-	325, 383, 319, 0, 275, 0, 0, 374, 298, 0,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 314, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 1110,
+	0, 0, 0, 271, 0, 0, 0, 328, 0, 367,
+	208, 281, 278, 395, 232, 225, 221, 207, 255, 287,
+	326, 385, 320, 0, 275, 0, 0, 376, 299, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 206, 175, 310, 375, 236,
-	0, 0, 0, 167, 168, 169, 0, 0, 1458, 0,
-	0, 1459, 0, 0, 197, 0, 204, 0, 0, 0,
-	0, 218, 259, 224, 217, 390, 0, 0, 0, 0,
+	0, 0, 0, 174, 352, 0, 0, 261, 206, 175,
+	311, 377, 236, 0, 0, 0, 167, 168, 169, 0,
+	1109, 0, 0, 0, 0, 0, 0, 197, 0, 204,
+	0, 0, 0, 0, 218, 259, 224, 217, 392, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	299, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
-	0, 309, 348, 354, 0, 0, 0, 209, 0, 352,
-	323, 407, 193, 234, 345, 328, 350, 0, 0, 351,
-	276, 395, 340, 405, 423, 424, 216, 303, 413, 387,
-	419, 434, 187, 213, 317, 380, 410, 371, 296, 391,
-	392, 266, 370, 242, 174, 274, 431, 185, 360, 201,
-	178, 382, 403, 198, 363, 0, 0, 0, 180, 401,
-	379, 293, 263, 264, 179, 0, 344, 220, 240, 211,
-	312, 398, 399, 210, 436, 189, 418, 182, 0, 417,
-	305, 394, 402, 294, 285, 181, 400, 292, 284, 269,
-	230, 250, 338, 279, 339, 251, 301, 300, 302, 0,
-	176, 0, 376, 411, 437, 194, 195, 196, 0, 229,
-	233, 239, 241, 0, 247, 254, 272, 316, 337, 335,
-	341, 0, 389, 406, 414, 421, 427, 428, 432, 429,
-	430, 433, 304, 190, 253, 372, 268, 277, 0, 0,
-	322, 353, 199, 409, 373, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 183, 273, 0, 342,
-	237, 435, 416, 412, 0, 0, 215, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
-	256, 257, 260, 265, 282, 287, 288, 289, 290, 306,
-	307, 308, 311, 314, 315, 318, 320, 321, 324, 330,
-	331, 332, 333, 334, 336, 343, 347, 355, 356, 357,
-	358, 359, 361, 362, 366, 367, 368, 369, 377, 381,
-	396, 397, 408, 420, 425, 246, 404, 426, 0, 281,
-	0, 0, 283, 231, 248, 258, 0, 415, 378, 188,
-	349, 238, 177, 205, 191, 212, 226, 228, 262, 291,
-	297, 326, 329, 243, 223, 203, 346, 200, 364, 384,
-	385, 386, 388, 295, 219, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 1103, 0, 0, 0,
-	271, 0, 0, 0, 327, 0, 365, 208, 280, 278,
-	393, 232, 225, 221, 207, 255, 286, 325, 383, 319,
-	0, 275, 0, 0, 374, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 206, 175, 310, 375, 236, 0, 0, 0,
-	167, 168, 169, 0, 1102, 0, 0, 0, 0, 0,
-	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
-	224, 217, 390, 0, 0, 0, 0, 0, 0, 0,
+	0, 244, 0, 300, 0, 0, 0, 0, 424, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
+	171, 186, 0, 0, 310, 350, 356, 0, 0, 0,
+	209, 0, 354, 324, 409, 193, 234, 347, 329, 0,
+	0, 353, 276, 397, 342, 407, 330, 0, 0, 280,
+	425, 426, 216, 304, 415, 389, 421, 436, 187, 213,
+	318, 382, 412, 373, 297, 393, 394, 266, 372, 242,
+	274, 433, 185, 362, 201, 178, 384, 405, 198, 365,
+	0, 0, 0, 180, 403, 381, 294, 263, 264, 179,
+	0, 346, 220, 240, 211, 313, 400, 401, 210, 438,
+	189, 420, 182, 0, 419, 306, 396, 404, 295, 286,
+	181, 402, 293, 285, 269, 230, 250, 340, 279, 341,
+	251, 302, 301, 303, 0, 176, 0, 378, 413, 439,
+	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
+	254, 272, 317, 339, 337, 343, 0, 391, 408, 416,
+	423, 429, 430, 434, 431, 432, 435, 305, 190, 253,
+	374, 268, 277, 0, 0, 323, 355, 199, 411, 375,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 183, 273, 0, 344, 237, 437, 418, 414, 0,
+	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
+	227, 235, 245, 249, 252, 256, 257, 260, 265, 283,
+	288, 289, 290, 291, 307, 308, 309, 312, 315, 316,
+	319, 321, 322, 325, 332, 333, 334, 335, 336, 338,
+	345, 349, 357, 358, 359, 360, 361, 363, 364, 368,
+	369, 370, 371, 379, 383, 398, 399, 410, 422, 427,
+	246, 406, 428, 0, 282, 0, 0, 284, 231, 248,
+	258, 0, 417, 380, 188, 351, 238, 177, 205, 191,
+	212, 226, 228, 262, 292, 298, 327, 331, 243, 223,
+	203, 348, 200, 366, 386, 387, 388, 390, 296, 219,
+	314, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 328,
+	0, 367, 208, 281, 278, 395, 232, 225, 221, 207,
+	255, 287, 326, 385, 320, 0, 275, 0, 0, 376,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 352, 0, 0, 261,
+	206, 175, 311, 377, 236, 0, 0, 582, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 299, 0, 0,
-	0, 0, 422, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 0, 267, 171, 186, 0, 0, 309, 348,
-	354, 0, 0, 0, 209, 0, 352, 323, 407, 193,
-	234, 345, 328, 350, 0, 0, 351, 276, 395, 340,
-	405, 423, 424, 216, 303, 413, 387, 419, 434, 187,
-	213, 317, 380, 410, 371, 296, 391, 392, 266, 370,
-	242, 174, 274, 431, 185, 360, 201, 178, 382, 403,
-	198, 363, 0, 0, 0, 180, 401, 379, 293, 263,
-	264, 179, 0, 344, 220, 240, 211, 312, 398, 399,
-	210, 436, 189, 418, 182, 0, 417, 305, 394, 402,
-	294, 285, 181, 400, 292, 284, 269, 230, 250, 338,
-	279, 339, 251, 301, 300, 302, 0, 176, 0, 376,
-	411, 437, 194, 195, 196, 0, 229, 233, 239, 241,
-	0, 247, 254, 272, 316, 337, 335, 341, 0, 389,
-	406, 414, 421, 427, 428, 432, 429, 430, 433, 304,
-	190, 253, 372, 268, 277, 0, 0, 322, 353, 199,
-	409, 373, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 300, 0, 0, 0, 0,
+	424, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 267, 171, 186, 0, 0, 310, 350, 356, 0,
+	0, 0, 209, 0, 354, 324, 409, 193, 234, 347,
+	329, 0, 0, 353, 276, 397, 342, 407, 330, 0,
+	0, 280, 425, 426, 216, 304, 415, 389, 421, 436,
+	187, 213, 318, 382, 412, 373, 297, 393, 394, 266,
+	372, 242, 274, 433, 185, 362, 201, 178, 384, 405,
+	198, 365, 0, 0, 0, 180, 403, 381, 294, 263,
+	264, 179, 0, 346, 220, 240, 211, 313, 400, 401,
+	210, 438, 189, 420, 182, 0, 419, 306, 396, 404,
+	295, 286, 181, 402, 293, 285, 269, 230, 250, 340,
+	279, 341, 251, 302, 301, 303, 0, 176, 0, 378,
+	413, 439, 194, 195, 196, 0, 229, 233, 239, 241,
+	0, 247, 254, 272, 317, 339, 337, 343, 0, 391,
+	408, 416, 423, 429, 430, 434, 431, 432, 435, 305,
+	190, 253, 374, 268, 277, 0, 0, 323, 355, 199,
+	411, 375, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 183, 273, 0, 342, 237, 435, 416,
-	412, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 183, 273, 0, 344, 237, 437, 418,
+	414, 0, 0, 215, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 172, 173, 184, 192,
 	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
-	265, 282, 287, 288, 289, 290, 306, 307, 308, 311,
-	314, 315, 318, 320, 321, 324, 330, 331, 332, 333,
-	334, 336, 343, 347, 355, 356, 357, 358, 359, 361,
-	362, 366, 367, 368, 369, 377, 381, 396, 397, 408,
-	420, 425, 246, 404, 426, 0, 281, 0, 0, 283,
-	231, 248, 258, 0, 415, 378, 188, 349, 238, 177,
-	205, 191, 212, 226, 228, 262, 291, 297, 326, 329,
-	243, 223, 203, 346, 200, 364, 384, 385, 386, 388,
-	295, 219, 313, 0, 0, 0, 0, 0, 0, 0,
+	265, 283, 288, 289, 290, 291, 307, 308, 309, 312,
+	315, 316, 319, 321, 322, 325, 332, 333, 334, 335,
+	336, 338, 345, 349, 357, 358, 359, 360, 361, 363,
+	364, 368, 369, 370, 371, 379, 383, 398, 399, 410,
+	422, 427, 246, 406, 428, 0, 282, 0, 0, 284,
+	231, 248, 258, 0, 417, 380, 188, 351, 238, 177,
+	205, 191, 212, 226, 228, 262, 292, 298, 327, 331,
+	243, 223, 203, 348, 200, 366, 386, 387, 388, 390,
+	296, 219, 314, 0, 0, 0, 0, 0, 0, 0,
 	0, 222, 0, 0, 0, 0, 0, 271, 0, 0,
-	0, 327, 0, 365, 208, 280, 278, 393, 232, 225,
-	221, 207, 255, 286, 325, 383, 319, 0, 275, 0,
-	0, 374, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 206,
-	175, 310, 375, 236, 0, 0, 580, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 197, 0,
-	204, 0, 0, 0, 0, 218, 259, 224, 217, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 0, 367, 208, 281, 278, 395, 232, 225,
+	221, 207, 255, 287, 326, 385, 320, 0, 275, 0,
+	0, 376, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 352, 0,
+	0, 261, 206, 175, 311, 377, 236, 71, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
+	224, 217, 392, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 299, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
-	267, 171, 186, 0, 0, 309, 348, 354, 0, 0,
-	0, 209, 0, 352, 323, 407, 193, 234, 345, 328,
-	350, 0, 0, 351, 276, 395, 340, 405, 423, 424,
-	216, 303, 413, 387, 419, 434, 187, 213, 317, 380,
-	410, 371, 296, 391, 392, 266, 370, 242, 174, 274,
-	431, 185, 360, 201, 178, 382, 403, 198, 363, 0,
-	0, 0, 180, 401, 379, 293, 263, 264, 179, 0,
-	344, 220, 240, 211, 312, 398, 399, 210, 436, 189,
-	418, 182, 0, 417, 305, 394, 402, 294, 285, 181,
-	400, 292, 284, 269, 230, 250, 338, 279, 339, 251,
-	301, 300, 302, 0, 176, 0, 376, 411, 437, 194,
-	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
-	272, 316, 337, 335, 341, 0, 389, 406, 414, 421,
-	427, 428, 432, 429, 430, 433, 304, 190, 253, 372,
-	268, 277, 0, 0, 322, 353, 199, 409, 373, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	183, 273, 0, 342, 237, 435, 416, 412, 0, 0,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 244, 0, 300, 0, 0,
+	0, 0, 424, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 267, 171, 186, 0, 0, 310, 350,
+	356, 0, 0, 0, 209, 0, 354, 324, 409, 193,
+	234, 347, 329, 0, 0, 353, 276, 397, 342, 407,
+	330, 0, 0, 280, 425, 426, 216, 304, 415, 389,
+	421, 436, 187, 213, 318, 382, 412, 373, 297, 393,
+	394, 266, 372, 242, 274, 433, 185, 362, 201, 178,
+	384, 405, 198, 365, 0, 0, 0, 180, 403, 381,
+	294, 263, 264, 179, 0, 346, 220, 240, 211, 313,
+	400, 401, 210, 438, 189, 420, 182, 0, 419, 306,
+	396, 404, 295, 286, 181, 402, 293, 285, 269, 230,
+	250, 340, 279, 341, 251, 302, 301, 303, 0, 176,
+	0, 378, 413, 439, 194, 195, 196, 0, 229, 233,
+	239, 241, 0, 247, 254, 272, 317, 339, 337, 343,
+	0, 391, 408, 416, 423, 429, 430, 434, 431, 432,
+	435, 305, 190, 253, 374, 268, 277, 0, 0, 323,
+	355, 199, 411, 375, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
-	235, 245, 249, 252, 256, 257, 260, 265, 282, 287,
-	288, 289, 290, 306, 307, 308, 311, 314, 315, 318,
-	320, 321, 324, 330, 331, 332, 333, 334, 336, 343,
-	347, 355, 356, 357, 358, 359, 361, 362, 366, 367,
-	368, 369, 377, 381, 396, 397, 408, 420, 425, 246,
-	404, 426, 0, 281, 0, 0, 283, 231, 248, 258,
-	0, 415, 378, 188, 349, 238, 177, 205, 191, 212,
-	226, 228, 262, 291, 297, 326, 329, 243, 223, 203,
-	346, 200, 364, 384, 385, 386, 388, 295, 219, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	0, 0, 0, 0, 271, 0, 0, 0, 327, 0,
-	365, 208, 280, 278, 393, 232, 225, 221, 207, 255,
-	286, 325, 383, 319, 0, 275, 0, 0, 374, 298,
+	0, 0, 0, 0, 170, 183, 273, 0, 344, 237,
+	437, 418, 414, 0, 0, 215, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 206, 175, 310, 375,
-	236, 71, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
-	0, 0, 218, 259, 224, 217, 390, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
+	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
+	257, 260, 265, 283, 288, 289, 290, 291, 307, 308,
+	309, 312, 315, 316, 319, 321, 322, 325, 332, 333,
+	334, 335, 336, 338, 345, 349, 357, 358, 359, 360,
+	361, 363, 364, 368, 369, 370, 371, 379, 383, 398,
+	399, 410, 422, 427, 246, 406, 428, 0, 282, 0,
+	0, 284, 231, 248, 258, 0, 417, 380, 188, 351,
+	238, 177, 205, 191, 212, 226, 228, 262, 292, 298,
+	327, 331, 243, 223, 203, 348, 200, 366, 386, 387,
+	388, 390, 296, 219, 314, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 328, 0, 367, 208, 281, 278, 395,
+	232, 225, 221, 207, 255, 287, 326, 385, 320, 0,
+	275, 0, 0, 376, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	352, 0, 0, 261, 206, 175, 311, 377, 236, 0,
+	0, 0, 167, 168, 169, 0, 1448, 0, 0, 0,
+	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
+	218, 259, 224, 217, 392, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 299, 0, 0, 0, 0, 422, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
-	0, 0, 309, 348, 354, 0, 0, 0, 209, 0,
-	352, 323, 407, 193, 234, 345, 328, 350, 0, 0,
-	351, 276, 395, 340, 405, 423, 424, 216, 303, 413,
-	387, 419, 434, 187, 213, 317, 380, 410, 371, 296,
-	391, 392, 266, 370, 242, 174, 274, 431, 185, 360,
-	201, 178, 382, 403, 198, 363, 0, 0, 0, 180,
-	401, 379, 293, 263, 264, 179, 0, 344, 220, 240,
-	211, 312, 398, 399, 210, 436, 189, 418, 182, 0,
-	417, 305, 394, 402, 294, 285, 181, 400, 292, 284,
-	269, 230, 250, 338, 279, 339, 251, 301, 300, 302,
-	0, 176, 0, 376, 411, 437, 194, 195, 196, 0,
-	229, 233, 239, 241, 0, 247, 254, 272, 316, 337,
-	335, 341, 0, 389, 406, 414, 421, 427, 428, 432,
-	429, 430, 433, 304, 190, 253, 372, 268, 277, 0,
-	0, 322, 353, 199, 409, 373, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 300,
+	0, 0, 0, 0, 424, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
+	310, 350, 356, 0, 0, 0, 209, 0, 354, 324,
+	409, 193, 234, 347, 329, 0, 0, 353, 276, 397,
+	342, 407, 330, 0, 0, 280, 425, 426, 216, 304,
+	415, 389, 421, 436, 187, 213, 318, 382, 412, 373,
+	297, 393, 394, 266, 372, 242, 274, 433, 185, 362,
+	201, 178, 384, 405, 198, 365, 0, 0, 0, 180,
+	403, 381, 294, 263, 264, 179, 0, 346, 220, 240,
+	211, 313, 400, 401, 210, 438, 189, 420, 182, 0,
+	419, 306, 396, 404, 295, 286, 181, 402, 293, 285,
+	269, 230, 250, 340, 279, 341, 251, 302, 301, 303,
+	0, 176, 0, 378, 413, 439, 194, 195, 196, 0,
+	229, 233, 239, 241, 0, 247, 254, 272, 317, 339,
+	337, 343, 0, 391, 408, 416, 423, 429, 430, 434,
+	431, 432, 435, 305, 190, 253, 374, 268, 277, 0,
+	0, 323, 355, 199, 411, 375, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 170, 183, 273, 0,
-	342, 237, 435, 416, 412, 0, 0, 215, 0, 0,
+	344, 237, 437, 418, 414, 0, 0, 215, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
-	252, 256, 257, 260, 265, 282, 287, 288, 289, 290,
-	306, 307, 308, 311, 314, 315, 318, 320, 321, 324,
-	330, 331, 332, 333, 334, 336, 343, 347, 355, 356,
-	357, 358, 359, 361, 362, 366, 367, 368, 369, 377,
-	381, 396, 397, 408, 420, 425, 246, 404, 426, 0,
-	281, 0, 0, 283, 231, 248, 258, 0, 415, 378,
-	188, 349, 238, 177, 205, 191, 212, 226, 228, 262,
-	291, 297, 326, 329, 243, 223, 203, 346, 200, 364,
-	384, 385, 386, 388, 295, 219, 313, 0, 0, 0,
+	252, 256, 257, 260, 265, 283, 288, 289, 290, 291,
+	307, 308, 309, 312, 315, 316, 319, 321, 322, 325,
+	332, 333, 334, 335, 336, 338, 345, 349, 357, 358,
+	359, 360, 361, 363, 364, 368, 369, 370, 371, 379,
+	383, 398, 399, 410, 422, 427, 246, 406, 428, 0,
+	282, 0, 0, 284, 231, 248, 258, 0, 417, 380,
+	188, 351, 238, 177, 205, 191, 212, 226, 228, 262,
+	292, 298, 327, 331, 243, 223, 203, 348, 200, 366,
+	386, 387, 388, 390, 296, 219, 314, 0, 0, 0,
 	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
-	0, 271, 0, 0, 0, 327, 0, 365, 208, 280,
-	278, 393, 232, 225, 221, 207, 255, 286, 325, 383,
-	319, 0, 275, 0, 0, 374, 298, 0, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 0, 275, 0, 0, 376, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 206, 175, 310, 375, 236, 0, 0,
-	0, 167, 168, 169, 0, 1439, 0, 0, 0, 0,
-	0, 0, 197, 0, 204, 0, 0, 0, 0, 218,
-	259, 224, 217, 390, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 0, 0, 0, 167, 168, 169, 0, 1079, 0,
+	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
+	0, 0, 218, 259, 224, 217, 392, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 299, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 0, 267, 171, 186, 0, 0, 309,
-	348, 354, 0, 0, 0, 209, 0, 352, 323, 407,
-	193, 234, 345, 328, 350, 0, 0, 351, 276, 395,
-	340, 405, 423, 424, 216, 303, 413, 387, 419, 434,
-	187, 213, 317, 380, 410, 371, 296, 391, 392, 266,
-	370, 242, 174, 274, 431, 185, 360, 201, 178, 382,
-	403, 198, 363, 0, 0, 0, 180, 401, 379, 293,
-	263, 264, 179, 0, 344, 220, 240, 211, 312, 398,
-	399, 210, 436, 189, 418, 182, 0, 417, 305, 394,
-	402, 294, 285, 181, 400, 292, 284, 269, 230, 250,
-	338, 279, 339, 251, 301, 300, 302, 0, 176, 0,
-	376, 411, 437, 194, 195, 196, 0, 229, 233, 239,
-	241, 0, 247, 254, 272, 316, 337, 335, 341, 0,
-	389, 406, 414, 421, 427, 428, 432, 429, 430, 433,
-	304, 190, 253, 372, 268, 277, 0, 0, 322, 353,
-	199, 409, 373, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 0, 0, 0, 424, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
+	0, 0, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
+	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 183, 273, 0, 342, 237, 435,
-	416, 412, 0, 0, 215, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 314, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 271, 0, 0, 0, 328, 0, 367,
+	208, 281, 278, 395, 232, 225, 221, 207, 255, 287,
+	326, 385, 320, 0, 275, 0, 0, 376, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 352, 0, 0, 261, 206, 175,
+	311, 377, 236, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 0, 204,
+	0, 0, 0, 0, 218, 259, 224, 217, 392, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
-	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
-	260, 265, 282, 287, 288, 289, 290, 306, 307, 308,
-	311, 314, 315, 318, 320, 321, 324, 330, 331, 332,
-	333, 334, 336, 343, 347, 355, 356, 357, 358, 359,
-	361, 362, 366, 367, 368, 369, 377, 381, 396, 397,
-	408, 420, 425, 246, 404, 426, 0, 281, 0, 0,
-	283, 231, 248, 258, 0, 415, 378, 188, 349, 238,
-	177, 205, 191, 212, 226, 228, 262, 291, 297, 326,
-	329, 243, 223, 203, 346, 200, 364, 384, 385, 386,
-	388, 295, 219, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 0, 0, 0, 0, 271, 0,
-	0, 0, 327, 0, 365, 208, 280, 278, 393, 232,
-	225, 221, 207, 255, 286, 325, 383, 319, 0, 275,
-	0, 0, 374, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	206, 175, 310, 375, 236, 0, 0, 0, 167, 168,
-	169, 0, 1072, 0, 0, 0, 0, 0, 0, 197,
-	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 299, 0, 0, 0, 0,
-	422, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	0, 267, 171, 186, 0, 0, 309, 348, 354, 0,
-	0, 0, 209, 0, 352, 323, 407, 193, 234, 345,
-	328, 350, 0, 0, 351, 276, 395, 340, 405, 423,
-	424, 216, 303, 413, 387, 419, 434, 187, 213, 317,
-	380, 410, 371, 296, 391, 392, 266, 370, 242, 174,
-	274, 431, 185, 360, 201, 178, 382, 403, 198, 363,
-	0, 0, 0, 180, 401, 379, 293, 263, 264, 179,
-	0, 344, 220, 240, 211, 312, 398, 399, 210, 436,
-	189, 418, 182, 0, 417, 305, 394, 402, 294, 285,
-	181, 400, 292, 284, 269, 230, 250, 338, 279, 339,
-	251, 301, 300, 302, 0, 176, 0, 376, 411, 437,
+	0, 244, 0, 300, 0, 0, 0, 0, 424, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
+	171, 186, 0, 0, 310, 350, 356, 0, 0, 0,
+	209, 0, 354, 324, 409, 193, 234, 347, 329, 0,
+	0, 353, 276, 397, 342, 407, 330, 0, 0, 280,
+	425, 426, 216, 304, 415, 389, 421, 436, 187, 213,
+	318, 382, 412, 373, 297, 393, 394, 266, 372, 242,
+	274, 433, 185, 362, 201, 178, 384, 405, 198, 365,
+	0, 0, 0, 180, 403, 381, 294, 263, 264, 179,
+	0, 346, 220, 240, 211, 313, 400, 401, 210, 438,
+	189, 420, 182, 0, 419, 306, 396, 404, 295, 286,
+	181, 402, 293, 285, 269, 230, 250, 340, 279, 341,
+	251, 302, 301, 303, 0, 176, 0, 378, 413, 439,
 	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
-	254, 272, 316, 337, 335, 341, 0, 389, 406, 414,
-	421, 427, 428, 432, 429, 430, 433, 304, 190, 253,
-	372, 268, 277, 0, 0, 322, 353, 199, 409, 373,
+	254, 272, 317, 339, 337, 343, 0, 391, 408, 416,
+	423, 429, 430, 434, 431, 432, 435, 305, 190, 253,
+	374, 268, 277, 0, 0, 323, 355, 199, 411, 375,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 183, 273, 0, 342, 237, 435, 416, 412, 0,
+	170, 183, 273, 1351, 344, 237, 437, 418, 414, 0,
 	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
-	227, 235, 245, 249, 252, 256, 257, 260, 265, 282,
-	287, 288, 289, 290, 306, 307, 308, 311, 314, 315,
-	318, 320, 321, 324, 330, 331, 332, 333, 334, 336,
-	343, 347, 355, 356, 357, 358, 359, 361, 362, 366,
-	367, 368, 369, 377, 381, 396, 397, 408, 420, 425,
-	246, 404, 426, 0, 281, 0, 0, 283, 231, 248,
-	258, 0, 415, 378, 188, 349, 238, 177, 205, 191,
-	212, 226, 228, 262, 291, 297, 326, 329, 243, 223,
-	203, 346, 200, 364, 384, 385, 386, 388, 295, 219,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 222,
-	0, 0, 0, 0, 0, 271, 0, 0, 0, 327,
-	0, 365, 208, 280, 278, 393, 232, 225, 221, 207,
-	255, 286, 325, 383, 319, 0, 275, 0, 0, 374,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 206, 175, 310,
-	375, 236, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 197, 0, 204, 0,
-	0, 0, 0, 218, 259, 224, 217, 390, 0, 0,
+	227, 235, 245, 249, 252, 256, 257, 260, 265, 283,
+	288, 289, 290, 291, 307, 308, 309, 312, 315, 316,
+	319, 321, 322, 325, 332, 333, 334, 335, 336, 338,
+	345, 349, 357, 358, 359, 360, 361, 363, 364, 368,
+	369, 370, 371, 379, 383, 398, 399, 410, 422, 427,
+	246, 406, 428, 0, 282, 0, 0, 284, 231, 248,
+	258, 0, 417, 380, 188, 351, 238, 177, 205, 191,
+	212, 226, 228, 262, 292, 298, 327, 331, 243, 223,
+	203, 348, 200, 366, 386, 387, 388, 390, 296, 219,
+	314, 0, 1234, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 328,
+	0, 367, 208, 281, 278, 395, 232, 225, 221, 207,
+	255, 287, 326, 385, 320, 0, 275, 0, 0, 376,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 352, 0, 0, 261,
+	206, 175, 311, 377, 236, 0, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 300, 0, 0, 0, 0,
+	424, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 267, 171, 186, 0, 0, 310, 350, 356, 0,
+	0, 0, 209, 0, 354, 324, 409, 193, 234, 347,
+	329, 0, 0, 353, 276, 397, 342, 407, 330, 0,
+	0, 280, 425, 426, 216, 304, 415, 389, 421, 436,
+	187, 213, 318, 382, 412, 373, 297, 393, 394, 266,
+	372, 242, 274, 433, 185, 362, 201, 178, 384, 405,
+	198, 365, 0, 0, 0, 180, 403, 381, 294, 263,
+	264, 179, 0, 346, 220, 240, 211, 313, 400, 401,
+	210, 438, 189, 420, 182, 0, 419, 306, 396, 404,
+	295, 286, 181, 402, 293, 285, 269, 230, 250, 340,
+	279, 341, 251, 302, 301, 303, 0, 176, 0, 378,
+	413, 439, 194, 195, 196, 0, 229, 233, 239, 241,
+	0, 247, 254, 272, 317, 339, 337, 343, 0, 391,
+	408, 416, 423, 429, 430, 434, 431, 432, 435, 305,
+	190, 253, 374, 268, 277, 0, 0, 323, 355, 199,
+	411, 375, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 299, 0, 0, 0, 0, 422, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 0, 267, 171,
-	186, 0, 0, 309, 348, 354, 0, 0, 0, 209,
-	0, 352, 323, 407, 193, 234, 345, 328, 350, 0,
-	0, 351, 276, 395, 340, 405, 423, 424, 216, 303,
-	413, 387, 419, 434, 187, 213, 317, 380, 410, 371,
-	296, 391, 392, 266, 370, 242, 174, 274, 431, 185,
-	360, 201, 178, 382, 403, 198, 363, 0, 0, 0,
-	180, 401, 379, 293, 263, 264, 179, 0, 344, 220,
-	240, 211, 312, 398, 399, 210, 436, 189, 418, 182,
-	0, 417, 305, 394, 402, 294, 285, 181, 400, 292,
-	284, 269, 230, 250, 338, 279, 339, 251, 301, 300,
-	302, 0, 176, 0, 376, 411, 437, 194, 195, 196,
-	0, 229, 233, 239, 241, 0, 247, 254, 272, 316,
-	337, 335, 341, 0, 389, 406, 414, 421, 427, 428,
-	432, 429, 430, 433, 304, 190, 253, 372, 268, 277,
-	0, 0, 322, 353, 199, 409, 373, 0, 0, 0,
+	0, 0, 170, 183, 273, 0, 344, 237, 437, 418,
+	414, 0, 0, 215, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 183, 273,
-	1342, 342, 237, 435, 416, 412, 0, 0, 215, 0,
+	0, 0, 0, 0, 0, 0, 172, 173, 184, 192,
+	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
+	265, 283, 288, 289, 290, 291, 307, 308, 309, 312,
+	315, 316, 319, 321, 322, 325, 332, 333, 334, 335,
+	336, 338, 345, 349, 357, 358, 359, 360, 361, 363,
+	364, 368, 369, 370, 371, 379, 383, 398, 399, 410,
+	422, 427, 246, 406, 428, 0, 282, 0, 0, 284,
+	231, 248, 258, 0, 417, 380, 188, 351, 238, 177,
+	205, 191, 212, 226, 228, 262, 292, 298, 327, 331,
+	243, 223, 203, 348, 200, 366, 386, 387, 388, 390,
+	296, 219, 314, 0, 1232, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 271, 0, 0,
+	0, 328, 0, 367, 208, 281, 278, 395, 232, 225,
+	221, 207, 255, 287, 326, 385, 320, 0, 275, 0,
+	0, 376, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 352, 0,
+	0, 261, 206, 175, 311, 377, 236, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
+	224, 217, 392, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
-	249, 252, 256, 257, 260, 265, 282, 287, 288, 289,
-	290, 306, 307, 308, 311, 314, 315, 318, 320, 321,
-	324, 330, 331, 332, 333, 334, 336, 343, 347, 355,
-	356, 357, 358, 359, 361, 362, 366, 367, 368, 369,
-	377, 381, 396, 397, 408, 420, 425, 246, 404, 426,
-	0, 281, 0, 0, 283, 231, 248, 258, 0, 415,
-	378, 188, 349, 238, 177, 205, 191, 212, 226, 228,
-	262, 291, 297, 326, 329, 243, 223, 203, 346, 200,
-	364, 384, 385, 386, 388, 295, 219, 313, 0, 1225,
-	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
-	0, 0, 271, 0, 0, 0, 327, 0, 365, 208,
-	280, 278, 393, 232, 225, 221, 207, 255, 286, 325,
-	383, 319, 0, 275, 0, 0, 374, 298, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 206, 175, 310, 375, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 244, 0, 300, 0, 0,
+	0, 0, 424, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 267, 171, 186, 0, 0, 310, 350,
+	356, 0, 0, 0, 209, 0, 354, 324, 409, 193,
+	234, 347, 329, 0, 0, 353, 276, 397, 342, 407,
+	330, 0, 0, 280, 425, 426, 216, 304, 415, 389,
+	421, 436, 187, 213, 318, 382, 412, 373, 297, 393,
+	394, 266, 372, 242, 274, 433, 185, 362, 201, 178,
+	384, 405, 198, 365, 0, 0, 0, 180, 403, 381,
+	294, 263, 264, 179, 0, 346, 220, 240, 211, 313,
+	400, 401, 210, 438, 189, 420, 182, 0, 419, 306,
+	396, 404, 295, 286, 181, 402, 293, 285, 269, 230,
+	250, 340, 279, 341, 251, 302, 301, 303, 0, 176,
+	0, 378, 413, 439, 194, 195, 196, 0, 229, 233,
+	239, 241, 0, 247, 254, 272, 317, 339, 337, 343,
+	0, 391, 408, 416, 423, 429, 430, 434, 431, 432,
+	435, 305, 190, 253, 374, 268, 277, 0, 0, 323,
+	355, 199, 411, 375, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 183, 273, 0, 344, 237,
+	437, 418, 414, 0, 0, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
+	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
+	257, 260, 265, 283, 288, 289, 290, 291, 307, 308,
+	309, 312, 315, 316, 319, 321, 322, 325, 332, 333,
+	334, 335, 336, 338, 345, 349, 357, 358, 359, 360,
+	361, 363, 364, 368, 369, 370, 371, 379, 383, 398,
+	399, 410, 422, 427, 246, 406, 428, 0, 282, 0,
+	0, 284, 231, 248, 258, 0, 417, 380, 188, 351,
+	238, 177, 205, 191, 212, 226, 228, 262, 292, 298,
+	327, 331, 243, 223, 203, 348, 200, 366, 386, 387,
+	388, 390, 296, 219, 314, 0, 1230, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 328, 0, 367, 208, 281, 278, 395,
+	232, 225, 221, 207, 255, 287, 326, 385, 320, 0,
+	275, 0, 0, 376, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	352, 0, 0, 261, 206, 175, 311, 377, 236, 0,
 	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
-	218, 259, 224, 217, 390, 0, 0, 0, 0, 0,
+	218, 259, 224, 217, 392, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 299,
-	0, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 300,
+	0, 0, 0, 0, 424, 0, 0, 0, 0, 0,
 	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
-	309, 348, 354, 0, 0, 0, 209, 0, 352, 323,
-	407, 193, 234, 345, 328, 350, 0, 0, 351, 276,
-	395, 340, 405, 423, 424, 216, 303, 413, 387, 419,
-	434, 187, 213, 317, 380, 410, 371, 296, 391, 392,
-	266, 370, 242, 174, 274, 431, 185, 360, 201, 178,
-	382, 403, 198, 363, 0, 0, 0, 180, 401, 379,
-	293, 263, 264, 179, 0, 344, 220, 240, 211, 312,
-	398, 399, 210, 436, 189, 418, 182, 0, 417, 305,
-	394, 402, 294, 285, 181, 400, 292, 284, 269, 230,
-	250, 338, 279, 339, 251, 301, 300, 302, 0, 176,
-	0, 376, 411, 437, 194, 195, 196, 0, 229, 233,
-	239, 241, 0, 247, 254, 272, 316, 337, 335, 341,
-	0, 389, 406, 414, 421, 427, 428, 432, 429, 430,
-	433, 304, 190, 253, 372, 268, 277, 0, 0, 322,
-	353, 199, 409, 373, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 183, 273, 0, 342, 237,
-	435, 416, 412, 0, 0, 215, 0, 0, 0, 0,
+	310, 350, 356, 0, 0, 0, 209, 0, 354, 324,
+	409, 193, 234, 347, 329, 0, 0, 353, 276, 397,
+	342, 407, 330, 0, 0, 280, 425, 426, 216, 304,
+	415, 389, 421, 436, 187, 213, 318, 382, 412, 373,
+	297, 393, 394, 266, 372, 242, 274, 433, 185, 362,
+	201, 178, 384, 405, 198, 365, 0, 0, 0, 180,
+	403, 381, 294, 263, 264, 179, 0, 346, 220, 240,
+	211, 313, 400, 401, 210, 438, 189, 420, 182, 0,
+	419, 306, 396, 404, 295, 286, 181, 402, 293, 285,
+	269, 230, 250, 340, 279, 341, 251, 302, 301, 303,
+	0, 176, 0, 378, 413, 439, 194, 195, 196, 0,
+	229, 233, 239, 241, 0, 247, 254, 272, 317, 339,
+	337, 343, 0, 391, 408, 416, 423, 429, 430, 434,
+	431, 432, 435, 305, 190, 253, 374, 268, 277, 0,
+	0, 323, 355, 199, 411, 375, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
-	257, 260, 265, 282, 287, 288, 289, 290, 306, 307,
-	308, 311, 314, 315, 318, 320, 321, 324, 330, 331,
-	332, 333, 334, 336, 343, 347, 355, 356, 357, 358,
-	359, 361, 362, 366, 367, 368, 369, 377, 381, 396,
-	397, 408, 420, 425, 246, 404, 426, 0, 281, 0,
-	0, 283, 231, 248, 258, 0, 415, 378, 188, 349,
-	238, 177, 205, 191, 212, 226, 228, 262, 291, 297,
-	326, 329, 243, 223, 203, 346, 200, 364, 384, 385,
-	386, 388, 295, 219, 313, 0, 1223, 0, 0, 0,
-	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
-	0, 0, 0, 327, 0, 365, 208, 280, 278, 393,
-	232, 225, 221, 207, 255, 286, 325, 383, 319, 0,
-	275, 0, 0, 374, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 183, 273, 0,
+	344, 237, 437, 418, 414, 0, 0, 215, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 206, 175, 310, 375, 236, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	197, 0, 204, 0, 0, 0, 0, 218, 259, 224,
-	217, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 299, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 0, 267, 171, 186, 0, 0, 309, 348, 354,
-	0, 0, 0, 209, 0, 352, 323, 407, 193, 234,
-	345, 328, 350, 0, 0, 351, 276, 395, 340, 405,
-	423, 424, 216, 303, 413, 387, 419, 434, 187, 213,
-	317, 380, 410, 371, 296, 391, 392, 266, 370, 242,
-	174, 274, 431, 185, 360, 201, 178, 382, 403, 198,
-	363, 0, 0, 0, 180, 401, 379, 293, 263, 264,
-	179, 0, 344, 220, 240, 211, 312, 398, 399, 210,
-	436, 189, 418, 182, 0, 417, 305, 394, 402, 294,
-	285, 181, 400, 292, 284, 269, 230, 250, 338, 279,
-	339, 251, 301, 300, 302, 0, 176, 0, 376, 411,
-	437, 194, 195, 196, 0, 229, 233, 239, 241, 0,
-	247, 254, 272, 316, 337, 335, 341, 0, 389, 406,
-	414, 421, 427, 428, 432, 429, 430, 433, 304, 190,
-	253, 372, 268, 277, 0, 0, 322, 353, 199, 409,
-	373, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 183, 273, 0, 342, 237, 435, 416, 412,
-	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
-	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
-	282, 287, 288, 289, 290, 306, 307, 308, 311, 314,
-	315, 318, 320, 321, 324, 330, 331, 332, 333, 334,
-	336, 343, 347, 355, 356, 357, 358, 359, 361, 362,
-	366, 367, 368, 369, 377, 381, 396, 397, 408, 420,
-	425, 246, 404, 426, 0, 281, 0, 0, 283, 231,
-	248, 258, 0, 415, 378, 188, 349, 238, 177, 205,
-	191, 212, 226, 228, 262, 291, 297, 326, 329, 243,
-	223, 203, 346, 200, 364, 384, 385, 386, 388, 295,
-	219, 313, 0, 1221, 0, 0, 0, 0, 0, 0,
-	222, 0, 0, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 0, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 197, 0, 204,
-	0, 0, 0, 0, 218, 259, 224, 217, 390, 0,
+	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
+	252, 256, 257, 260, 265, 283, 288, 289, 290, 291,
+	307, 308, 309, 312, 315, 316, 319, 321, 322, 325,
+	332, 333, 334, 335, 336, 338, 345, 349, 357, 358,
+	359, 360, 361, 363, 364, 368, 369, 370, 371, 379,
+	383, 398, 399, 410, 422, 427, 246, 406, 428, 0,
+	282, 0, 0, 284, 231, 248, 258, 0, 417, 380,
+	188, 351, 238, 177, 205, 191, 212, 226, 228, 262,
+	292, 298, 327, 331, 243, 223, 203, 348, 200, 366,
+	386, 387, 388, 390, 296, 219, 314, 0, 1228, 0,
+	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 0, 275, 0, 0, 376, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
+	0, 0, 218, 259, 224, 217, 392, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 0, 0, 0, 422, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 0, 0, 0, 424, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
+	0, 0, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
 	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 0, 0,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 313, 0,
-	1219, 0, 0, 0, 0, 0, 0, 222, 0, 0,
-	0, 0, 0, 271, 0, 0, 0, 327, 0, 365,
-	208, 280, 278, 393, 232, 225, 221, 207, 255, 286,
-	325, 383, 319, 0, 275, 0, 0, 374, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 206, 175, 310, 375, 236,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 197, 0, 204, 0, 0, 0,
-	0, 218, 259, 224, 217, 390, 0, 0, 0, 0,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 314, 0,
+	1226, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 271, 0, 0, 0, 328, 0, 367,
+	208, 281, 278, 395, 232, 225, 221, 207, 255, 287,
+	326, 385, 320, 0, 275, 0, 0, 376, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 352, 0, 0, 261, 206, 175,
+	311, 377, 236, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 197, 0, 204,
+	0, 0, 0, 0, 218, 259, 224, 217, 392, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	299, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
-	0, 309, 348, 354, 0, 0, 0, 209, 0, 352,
-	323, 407, 193, 234, 345, 328, 350, 0, 0, 351,
-	276, 395, 340, 405, 423, 424, 216, 303, 413, 387,
-	419, 434, 187, 213, 317, 380, 410, 371, 296, 391,
-	392, 266, 370, 242, 174, 274, 431, 185, 360, 201,
-	178, 382, 403, 198, 363, 0, 0, 0, 180, 401,
-	379, 293, 263, 264, 179, 0, 344, 220, 240, 211,
-	312, 398, 399, 210, 436, 189, 418, 182, 0, 417,
-	305, 394, 402, 294, 285, 181, 400, 292, 284, 269,
-	230, 250, 338, 279, 339, 251, 301, 300, 302, 0,
-	176, 0, 376, 411, 437, 194, 195, 196, 0, 229,
-	233, 239, 241, 0, 247, 254, 272, 316, 337, 335,
-	341, 0, 389, 406, 414, 421, 427, 428, 432, 429,
-	430, 433, 304, 190, 253, 372, 268, 277, 0, 0,
-	322, 353, 199, 409, 373, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 183, 273, 0, 342,
-	237, 435, 416, 412, 0, 0, 215, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
-	256, 257, 260, 265, 282, 287, 288, 289, 290, 306,
-	307, 308, 311, 314, 315, 318, 320, 321, 324, 330,
-	331, 332, 333, 334, 336, 343, 347, 355, 356, 357,
-	358, 359, 361, 362, 366, 367, 368, 369, 377, 381,
-	396, 397, 408, 420, 425, 246, 404, 426, 0, 281,
-	0, 0, 283, 231, 248, 258, 0, 415, 378, 188,
-	349, 238, 177, 205, 191, 212, 226, 228, 262, 291,
-	297, 326, 329, 243, 223, 203, 346, 200, 364, 384,
-	385, 386, 388, 295, 219, 313, 0, 1217, 0, 0,
-	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
-	271, 0, 0, 0, 327, 0, 365, 208, 280, 278,
-	393, 232, 225, 221, 207, 255, 286, 325, 383, 319,
-	0, 275, 0, 0, 374, 298, 0, 0, 0, 0,
+	0, 244, 0, 300, 0, 0, 0, 0, 424, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
+	171, 186, 0, 0, 310, 350, 356, 0, 0, 0,
+	209, 0, 354, 324, 409, 193, 234, 347, 329, 0,
+	0, 353, 276, 397, 342, 407, 330, 0, 0, 280,
+	425, 426, 216, 304, 415, 389, 421, 436, 187, 213,
+	318, 382, 412, 373, 297, 393, 394, 266, 372, 242,
+	274, 433, 185, 362, 201, 178, 384, 405, 198, 365,
+	0, 0, 0, 180, 403, 381, 294, 263, 264, 179,
+	0, 346, 220, 240, 211, 313, 400, 401, 210, 438,
+	189, 420, 182, 0, 419, 306, 396, 404, 295, 286,
+	181, 402, 293, 285, 269, 230, 250, 340, 279, 341,
+	251, 302, 301, 303, 0, 176, 0, 378, 413, 439,
+	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
+	254, 272, 317, 339, 337, 343, 0, 391, 408, 416,
+	423, 429, 430, 434, 431, 432, 435, 305, 190, 253,
+	374, 268, 277, 0, 0, 323, 355, 199, 411, 375,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 206, 175, 310, 375, 236, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
-	224, 217, 390, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 183, 273, 0, 344, 237, 437, 418, 414, 0,
+	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
+	227, 235, 245, 249, 252, 256, 257, 260, 265, 283,
+	288, 289, 290, 291, 307, 308, 309, 312, 315, 316,
+	319, 321, 322, 325, 332, 333, 334, 335, 336, 338,
+	345, 349, 357, 358, 359, 360, 361, 363, 364, 368,
+	369, 370, 371, 379, 383, 398, 399, 410, 422, 427,
+	246, 406, 428, 0, 282, 0, 0, 284, 231, 248,
+	258, 0, 417, 380, 188, 351, 238, 177, 205, 191,
+	212, 226, 228, 262, 292, 298, 327, 331, 243, 223,
+	203, 348, 200, 366, 386, 387, 388, 390, 296, 219,
+	314, 0, 1222, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 271, 0, 0, 0, 328,
+	0, 367, 208, 281, 278, 395, 232, 225, 221, 207,
+	255, 287, 326, 385, 320, 0, 275, 0, 0, 376,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 352, 0, 0, 261,
+	206, 175, 311, 377, 236, 0, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
+	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 299, 0, 0,
-	0, 0, 422, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 0, 267, 171, 186, 0, 0, 309, 348,
-	354, 0, 0, 0, 209, 0, 352, 323, 407, 193,
-	234, 345, 328, 350, 0, 0, 351, 276, 395, 340,
-	405, 423, 424, 216, 303, 413, 387, 419, 434, 187,
-	213, 317, 380, 410, 371, 296, 391, 392, 266, 370,
-	242, 174, 274, 431, 185, 360, 201, 178, 382, 403,
-	198, 363, 0, 0, 0, 180, 401, 379, 293, 263,
-	264, 179, 0, 344, 220, 240, 211, 312, 398, 399,
-	210, 436, 189, 418, 182, 0, 417, 305, 394, 402,
-	294, 285, 181, 400, 292, 284, 269, 230, 250, 338,
-	279, 339, 251, 301, 300, 302, 0, 176, 0, 376,
-	411, 437, 194, 195, 196, 0, 229, 233, 239, 241,
-	0, 247, 254, 272, 316, 337, 335, 341, 0, 389,
-	406, 414, 421, 427, 428, 432, 429, 430, 433, 304,
-	190, 253, 372, 268, 277, 0, 0, 322, 353, 199,
-	409, 373, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 300, 0, 0, 0, 0,
+	424, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 267, 171, 186, 0, 0, 310, 350, 356, 0,
+	0, 0, 209, 0, 354, 324, 409, 193, 234, 347,
+	329, 0, 0, 353, 276, 397, 342, 407, 330, 0,
+	0, 280, 425, 426, 216, 304, 415, 389, 421, 436,
+	187, 213, 318, 382, 412, 373, 297, 393, 394, 266,
+	372, 242, 274, 433, 185, 362, 201, 178, 384, 405,
+	198, 365, 0, 0, 0, 180, 403, 381, 294, 263,
+	264, 179, 0, 346, 220, 240, 211, 313, 400, 401,
+	210, 438, 189, 420, 182, 0, 419, 306, 396, 404,
+	295, 286, 181, 402, 293, 285, 269, 230, 250, 340,
+	279, 341, 251, 302, 301, 303, 0, 176, 0, 378,
+	413, 439, 194, 195, 196, 0, 229, 233, 239, 241,
+	0, 247, 254, 272, 317, 339, 337, 343, 0, 391,
+	408, 416, 423, 429, 430, 434, 431, 432, 435, 305,
+	190, 253, 374, 268, 277, 0, 0, 323, 355, 199,
+	411, 375, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 183, 273, 0, 342, 237, 435, 416,
-	412, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 183, 273, 0, 344, 237, 437, 418,
+	414, 0, 0, 215, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 172, 173, 184, 192,
 	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
-	265, 282, 287, 288, 289, 290, 306, 307, 308, 311,
-	314, 315, 318, 320, 321, 324, 330, 331, 332, 333,
-	334, 336, 343, 347, 355, 356, 357, 358, 359, 361,
-	362, 366, 367, 368, 369, 377, 381, 396, 397, 408,
-	420, 425, 246, 404, 426, 0, 281, 0, 0, 283,
-	231, 248, 258, 0, 415, 378, 188, 349, 238, 177,
-	205, 191, 212, 226, 228, 262, 291, 297, 326, 329,
-	243, 223, 203, 346, 200, 364, 384, 385, 386, 388,
-	295, 219, 313, 0, 1213, 0, 0, 0, 0, 0,
+	265, 283, 288, 289, 290, 291, 307, 308, 309, 312,
+	315, 316, 319, 321, 322, 325, 332, 333, 334, 335,
+	336, 338, 345, 349, 357, 358, 359, 360, 361, 363,
+	364, 368, 369, 370, 371, 379, 383, 398, 399, 410,
+	422, 427, 246, 406, 428, 0, 282, 0, 0, 284,
+	231, 248, 258, 0, 417, 380, 188, 351, 238, 177,
+	205, 191, 212, 226, 228, 262, 292, 298, 327, 331,
+	243, 223, 203, 348, 200, 366, 386, 387, 388, 390,
+	296, 219, 314, 0, 1220, 0, 0, 0, 0, 0,
 	0, 222, 0, 0, 0, 0, 0, 271, 0, 0,
-	0, 327, 0, 365, 208, 280, 278, 393, 232, 225,
-	221, 207, 255, 286, 325, 383, 319, 0, 275, 0,
-	0, 374, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 206,
-	175, 310, 375, 236, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 197, 0,
-	204, 0, 0, 0, 0, 218, 259, 224, 217, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 0, 367, 208, 281, 278, 395, 232, 225,
+	221, 207, 255, 287, 326, 385, 320, 0, 275, 0,
+	0, 376, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 352, 0,
+	0, 261, 206, 175, 311, 377, 236, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
+	224, 217, 392, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 299, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
-	267, 171, 186, 0, 0, 309, 348, 354, 0, 0,
-	0, 209, 0, 352, 323, 407, 193, 234, 345, 328,
-	350, 0, 0, 351, 276, 395, 340, 405, 423, 424,
-	216, 303, 413, 387, 419, 434, 187, 213, 317, 380,
-	410, 371, 296, 391, 392, 266, 370, 242, 174, 274,
-	431, 185, 360, 201, 178, 382, 403, 198, 363, 0,
-	0, 0, 180, 401, 379, 293, 263, 264, 179, 0,
-	344, 220, 240, 211, 312, 398, 399, 210, 436, 189,
-	418, 182, 0, 417, 305, 394, 402, 294, 285, 181,
-	400, 292, 284, 269, 230, 250, 338, 279, 339, 251,
-	301, 300, 302, 0, 176, 0, 376, 411, 437, 194,
-	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
-	272, 316, 337, 335, 341, 0, 389, 406, 414, 421,
-	427, 428, 432, 429, 430, 433, 304, 190, 253, 372,
-	268, 277, 0, 0, 322, 353, 199, 409, 373, 0,
+	0, 0, 0, 0, 0, 244, 0, 300, 0, 0,
+	0, 0, 424, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 267, 171, 186, 0, 0, 310, 350,
+	356, 0, 0, 0, 209, 0, 354, 324, 409, 193,
+	234, 347, 329, 0, 0, 353, 276, 397, 342, 407,
+	330, 0, 0, 280, 425, 426, 216, 304, 415, 389,
+	421, 436, 187, 213, 318, 382, 412, 373, 297, 393,
+	394, 266, 372, 242, 274, 433, 185, 362, 201, 178,
+	384, 405, 198, 365, 0, 0, 0, 180, 403, 381,
+	294, 263, 264, 179, 0, 346, 220, 240, 211, 313,
+	400, 401, 210, 438, 189, 420, 182, 0, 419, 306,
+	396, 404, 295, 286, 181, 402, 293, 285, 269, 230,
+	250, 340, 279, 341, 251, 302, 301, 303, 0, 176,
+	0, 378, 413, 439, 194, 195, 196, 0, 229, 233,
+	239, 241, 0, 247, 254, 272, 317, 339, 337, 343,
+	0, 391, 408, 416, 423, 429, 430, 434, 431, 432,
+	435, 305, 190, 253, 374, 268, 277, 0, 0, 323,
+	355, 199, 411, 375, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	183, 273, 0, 342, 237, 435, 416, 412, 0, 0,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 183, 273, 0, 344, 237,
+	437, 418, 414, 0, 0, 215, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
-	235, 245, 249, 252, 256, 257, 260, 265, 282, 287,
-	288, 289, 290, 306, 307, 308, 311, 314, 315, 318,
-	320, 321, 324, 330, 331, 332, 333, 334, 336, 343,
-	347, 355, 356, 357, 358, 359, 361, 362, 366, 367,
-	368, 369, 377, 381, 396, 397, 408, 420, 425, 246,
-	404, 426, 0, 281, 0, 0, 283, 231, 248, 258,
-	0, 415, 378, 188, 349, 238, 177, 205, 191, 212,
-	226, 228, 262, 291, 297, 326, 329, 243, 223, 203,
-	346, 200, 364, 384, 385, 386, 388, 295, 219, 313,
-	0, 1211, 0, 0, 0, 0, 0, 0, 222, 0,
-	0, 0, 0, 0, 271, 0, 0, 0, 327, 0,
-	365, 208, 280, 278, 393, 232, 225, 221, 207, 255,
-	286, 325, 383, 319, 0, 275, 0, 0, 374, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 206, 175, 310, 375,
-	236, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
-	0, 0, 218, 259, 224, 217, 390, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
+	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
+	257, 260, 265, 283, 288, 289, 290, 291, 307, 308,
+	309, 312, 315, 316, 319, 321, 322, 325, 332, 333,
+	334, 335, 336, 338, 345, 349, 357, 358, 359, 360,
+	361, 363, 364, 368, 369, 370, 371, 379, 383, 398,
+	399, 410, 422, 427, 246, 406, 428, 0, 282, 0,
+	0, 284, 231, 248, 258, 0, 417, 380, 188, 351,
+	238, 177, 205, 191, 212, 226, 228, 262, 292, 298,
+	327, 331, 243, 223, 203, 348, 200, 366, 386, 387,
+	388, 390, 296, 219, 314, 0, 1218, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 271,
+	0, 0, 0, 328, 0, 367, 208, 281, 278, 395,
+	232, 225, 221, 207, 255, 287, 326, 385, 320, 0,
+	275, 0, 0, 376, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	352, 0, 0, 261, 206, 175, 311, 377, 236, 0,
+	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
+	218, 259, 224, 217, 392, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 299, 0, 0, 0, 0, 422, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
-	0, 0, 309, 348, 354, 0, 0, 0, 209, 0,
-	352, 323, 407, 193, 234, 345, 328, 350, 0, 0,
-	351, 276, 395, 340, 405, 423, 424, 216, 303, 413,
-	387, 419, 434, 187, 213, 317, 380, 410, 371, 296,
-	391, 392, 266, 370, 242, 174, 274, 431, 185, 360,
-	201, 178, 382, 403, 198, 363, 0, 0, 0, 180,
-	401, 379, 293, 263, 264, 179, 0, 344, 220, 240,
-	211, 312, 398, 399, 210, 436, 189, 418, 182, 0,
-	417, 305, 394, 402, 294, 285, 181, 400, 292, 284,
-	269, 230, 250, 338, 279, 339, 251, 301, 300, 302,
-	0, 176, 0, 376, 411, 437, 194, 195, 196, 0,
-	229, 233, 239, 241, 0, 247, 254, 272, 316, 337,
-	335, 341, 0, 389, 406, 414, 421, 427, 428, 432,
-	429, 430, 433, 304, 190, 253, 372, 268, 277, 0,
-	0, 322, 353, 199, 409, 373, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 300,
+	0, 0, 0, 0, 424, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
+	310, 350, 356, 0, 0, 0, 209, 0, 354, 324,
+	409, 193, 234, 347, 329, 0, 0, 353, 276, 397,
+	342, 407, 330, 0, 0, 280, 425, 426, 216, 304,
+	415, 389, 421, 436, 187, 213, 318, 382, 412, 373,
+	297, 393, 394, 266, 372, 242, 274, 433, 185, 362,
+	201, 178, 384, 405, 198, 365, 0, 0, 0, 180,
+	403, 381, 294, 263, 264, 179, 0, 346, 220, 240,
+	211, 313, 400, 401, 210, 438, 189, 420, 182, 0,
+	419, 306, 396, 404, 295, 286, 181, 402, 293, 285,
+	269, 230, 250, 340, 279, 341, 251, 302, 301, 303,
+	0, 176, 0, 378, 413, 439, 194, 195, 196, 0,
+	229, 233, 239, 241, 0, 247, 254, 272, 317, 339,
+	337, 343, 0, 391, 408, 416, 423, 429, 430, 434,
+	431, 432, 435, 305, 190, 253, 374, 268, 277, 0,
+	0, 323, 355, 199, 411, 375, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 170, 183, 273, 0,
-	342, 237, 435, 416, 412, 0, 0, 215, 0, 0,
+	344, 237, 437, 418, 414, 0, 0, 215, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	172, 173, 184, 192, 202, 214, 227, 235, 245, 249,
-	252, 256, 257, 260, 265, 282, 287, 288, 289, 290,
-	306, 307, 308, 311, 314, 315, 318, 320, 321, 324,
-	330, 331, 332, 333, 334, 336, 343, 347, 355, 356,
-	357, 358, 359, 361, 362, 366, 367, 368, 369, 377,
-	381, 396, 397, 408, 420, 425, 246, 404, 426, 0,
-	281, 0, 0, 283, 231, 248, 258, 0, 415, 378,
-	188, 349, 238, 177, 205, 191, 212, 226, 228, 262,
-	291, 297, 326, 329, 243, 223, 203, 346, 200, 364,
-	384, 385, 386, 388, 295, 219, 313, 0, 1209, 0,
+	252, 256, 257, 260, 265, 283, 288, 289, 290, 291,
+	307, 308, 309, 312, 315, 316, 319, 321, 322, 325,
+	332, 333, 334, 335, 336, 338, 345, 349, 357, 358,
+	359, 360, 361, 363, 364, 368, 369, 370, 371, 379,
+	383, 398, 399, 410, 422, 427, 246, 406, 428, 0,
+	282, 0, 0, 284, 231, 248, 258, 0, 417, 380,
+	188, 351, 238, 177, 205, 191, 212, 226, 228, 262,
+	292, 298, 327, 331, 243, 223, 203, 348, 200, 366,
+	386, 387, 388, 390, 296, 219, 314, 0, 0, 0,
 	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
-	0, 271, 0, 0, 0, 327, 0, 365, 208, 280,
-	278, 393, 232, 225, 221, 207, 255, 286, 325, 383,
-	319, 0, 275, 0, 0, 374, 298, 0, 0, 0,
+	0, 271, 0, 0, 0, 328, 0, 367, 208, 281,
+	278, 395, 232, 225, 221, 207, 255, 287, 326, 385,
+	320, 0, 275, 0, 0, 376, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 206, 175, 310, 375, 236, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 197, 0, 204, 0, 0, 0, 0, 218,
-	259, 224, 217, 390, 0, 0, 0, 0, 0, 0,
+	0, 174, 352, 0, 0, 261, 206, 175, 311, 377,
+	236, 1192, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 0, 204, 0, 0,
+	0, 0, 218, 259, 224, 217, 392, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 299, 0,
-	0, 0, 0, 422, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 0, 267, 171, 186, 0, 0, 309,
-	348, 354, 0, 0, 0, 209, 0, 352, 323, 407,
-	193, 234, 345, 328, 350, 0, 0, 351, 276, 395,
-	340, 405, 423, 424, 216, 303, 413, 387, 419, 434,
-	187, 213, 317, 380, 410, 371, 296, 391, 392, 266,
-	370, 242, 174, 274, 431, 185, 360, 201, 178, 382,
-	403, 198, 363, 0, 0, 0, 180, 401, 379, 293,
-	263, 264, 179, 0, 344, 220, 240, 211, 312, 398,
-	399, 210, 436, 189, 418, 182, 0, 417, 305, 394,
-	402, 294, 285, 181, 400, 292, 284, 269, 230, 250,
-	338, 279, 339, 251, 301, 300, 302, 0, 176, 0,
-	376, 411, 437, 194, 195, 196, 0, 229, 233, 239,
-	241, 0, 247, 254, 272, 316, 337, 335, 341, 0,
-	389, 406, 414, 421, 427, 428, 432, 429, 430, 433,
-	304, 190, 253, 372, 268, 277, 0, 0, 322, 353,
-	199, 409, 373, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 300, 0, 0, 0, 0, 424, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 267, 171, 186,
+	0, 0, 310, 350, 356, 0, 0, 0, 209, 0,
+	354, 324, 409, 193, 234, 347, 329, 0, 0, 353,
+	276, 397, 342, 407, 330, 0, 0, 280, 425, 426,
+	216, 304, 415, 389, 421, 436, 187, 213, 318, 382,
+	412, 373, 297, 393, 394, 266, 372, 242, 274, 433,
+	185, 362, 201, 178, 384, 405, 198, 365, 0, 0,
+	0, 180, 403, 381, 294, 263, 264, 179, 0, 346,
+	220, 240, 211, 313, 400, 401, 210, 438, 189, 420,
+	182, 0, 419, 306, 396, 404, 295, 286, 181, 402,
+	293, 285, 269, 230, 250, 340, 279, 341, 251, 302,
+	301, 303, 0, 176, 0, 378, 413, 439, 194, 195,
+	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
+	317, 339, 337, 343, 0, 391, 408, 416, 423, 429,
+	430, 434, 431, 432, 435, 305, 190, 253, 374, 268,
+	277, 0, 0, 323, 355, 199, 411, 375, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 183, 273, 0, 342, 237, 435,
-	416, 412, 0, 0, 215, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
+	273, 0, 344, 237, 437, 418, 414, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
-	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
-	260, 265, 282, 287, 288, 289, 290, 306, 307, 308,
-	311, 314, 315, 318, 320, 321, 324, 330, 331, 332,
-	333, 334, 336, 343, 347, 355, 356, 357, 358, 359,
-	361, 362, 366, 367, 368, 369, 377, 381, 396, 397,
-	408, 420, 425, 246, 404, 426, 0, 281, 0, 0,
-	283, 231, 248, 258, 0, 415, 378, 188, 349, 238,
-	177, 205, 191, 212, 226, 228, 262, 291, 297, 326,
-	329, 243, 223, 203, 346, 200, 364, 384, 385, 386,
-	388, 295, 219, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 0, 0, 0, 0, 271, 0,
-	0, 0, 327, 0, 365, 208, 280, 278, 393, 232,
-	225, 221, 207, 255, 286, 325, 383, 319, 0, 275,
-	0, 0, 374, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	206, 175, 310, 375, 236, 1184, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 197,
-	0, 204, 0, 0, 0, 0, 218, 259, 224, 217,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
+	245, 249, 252, 256, 257, 260, 265, 283, 288, 289,
+	290, 291, 307, 308, 309, 312, 315, 316, 319, 321,
+	322, 325, 332, 333, 334, 335, 336, 338, 345, 349,
+	357, 358, 359, 360, 361, 363, 364, 368, 369, 370,
+	371, 379, 383, 398, 399, 410, 422, 427, 246, 406,
+	428, 0, 282, 0, 0, 284, 231, 248, 258, 0,
+	417, 380, 188, 351, 238, 177, 205, 191, 212, 226,
+	228, 262, 292, 298, 327, 331, 243, 223, 203, 348,
+	200, 366, 386, 387, 388, 390, 296, 219, 1092, 0,
+	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	271, 0, 0, 0, 328, 0, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	0, 275, 0, 0, 376, 299, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 352, 0, 0, 261, 206, 175, 311, 377, 236,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 0, 204, 0, 0, 0,
+	0, 218, 259, 224, 217, 392, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 299, 0, 0, 0, 0,
-	422, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	0, 267, 171, 186, 0, 0, 309, 348, 354, 0,
-	0, 0, 209, 0, 352, 323, 407, 193, 234, 345,
-	328, 350, 0, 0, 351, 276, 395, 340, 405, 423,
-	424, 216, 303, 413, 387, 419, 434, 187, 213, 317,
-	380, 410, 371, 296, 391, 392, 266, 370, 242, 174,
-	274, 431, 185, 360, 201, 178, 382, 403, 198, 363,
-	0, 0, 0, 180, 401, 379, 293, 263, 264, 179,
-	0, 344, 220, 240, 211, 312, 398, 399, 210, 436,
-	189, 418, 182, 0, 417, 305, 394, 402, 294, 285,
-	181, 400, 292, 284, 269, 230, 250, 338, 279, 339,
-	251, 301, 300, 302, 0, 176, 0, 376, 411, 437,
-	194, 195, 196, 0, 229, 233, 239, 241, 0, 247,
-	254, 272, 316, 337, 335, 341, 0, 389, 406, 414,
-	421, 427, 428, 432, 429, 430, 433, 304, 190, 253,
-	372, 268, 277, 0, 0, 322, 353, 199, 409, 373,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 183, 273, 0, 342, 237, 435, 416, 412, 0,
-	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	300, 0, 0, 0, 0, 424, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
+	0, 310, 350, 356, 0, 0, 0, 209, 0, 354,
+	324, 409, 193, 234, 347, 329, 0, 0, 353, 276,
+	397, 342, 407, 330, 0, 0, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	0, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	0, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 0, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 305, 190, 253, 374, 268, 277,
+	0, 0, 323, 355, 199, 411, 375, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 184, 192, 202, 214,
-	227, 235, 245, 249, 252, 256, 257, 260, 265, 282,
-	287, 288, 289, 290, 306, 307, 308, 311, 314, 315,
-	318, 320, 321, 324, 330, 331, 332, 333, 334, 336,
-	343, 347, 355, 356, 357, 358, 359, 361, 362, 366,
-	367, 368, 369, 377, 381, 396, 397, 408, 420, 425,
-	246, 404, 426, 0, 281, 0, 0, 283, 231, 248,
-	258, 0, 415, 378, 188, 349, 238, 177, 205, 191,
-	212, 226, 228, 262, 291, 297, 326, 329, 243, 223,
-	203, 346, 200, 364, 384, 385, 386, 388, 295, 219,
-	1085, 0, 0, 0, 0, 0, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
-	0, 0, 271, 0, 0, 0, 327, 0, 365, 208,
-	280, 278, 393, 232, 225, 221, 207, 255, 286, 325,
-	383, 319, 0, 275, 0, 0, 374, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 183, 273,
+	0, 344, 237, 437, 418, 414, 0, 0, 215, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 206, 175, 310, 375, 236, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 197, 0, 204, 0, 0, 0, 0,
-	218, 259, 224, 217, 390, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 0, 0, 284, 231, 248, 258, 0, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219, 314, 0, 0,
+	0, 0, 0, 0, 0, 1083, 222, 0, 0, 0,
+	0, 0, 271, 0, 0, 0, 328, 0, 367, 208,
+	281, 278, 395, 232, 225, 221, 207, 255, 287, 326,
+	385, 320, 0, 275, 0, 0, 376, 299, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 352, 0, 0, 261, 206, 175, 311,
+	377, 236, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 197, 0, 204, 0,
+	0, 0, 0, 218, 259, 224, 217, 392, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 299,
-	0, 0, 0, 0, 422, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 0, 267, 171, 186, 0, 0,
-	309, 348, 354, 0, 0, 0, 209, 0, 352, 323,
-	407, 193, 234, 345, 328, 350, 0, 0, 351, 276,
-	395, 340, 405, 423, 424, 216, 303, 413, 387, 419,
-	434, 187, 213, 317, 380, 410, 371, 296, 391, 392,
-	266, 370, 242, 174, 274, 431, 185, 360, 201, 178,
-	382, 403, 198, 363, 0, 0, 0, 180, 401, 379,
-	293, 263, 264, 179, 0, 344, 220, 240, 211, 312,
-	398, 399, 210, 436, 189, 418, 182, 0, 417, 305,
-	394, 402, 294, 285, 181, 400, 292, 284, 269, 230,
-	250, 338, 279, 339, 251, 301, 300, 302, 0, 176,
-	0, 376, 411, 437, 194, 195, 196, 0, 229, 233,
-	239, 241, 0, 247, 254, 272, 316, 337, 335, 341,
-	0, 389, 406, 414, 421, 427, 428, 432, 429, 430,
-	433, 304, 190, 253, 372, 268, 277, 0, 0, 322,
-	353, 199, 409, 373, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 183, 273, 0, 342, 237,
-	435, 416, 412, 0, 0, 215, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	184, 192, 202, 214, 227, 235, 245, 249, 252, 256,
-	257, 260, 265, 282, 287, 288, 289, 290, 306, 307,
-	308, 311, 314, 315, 318, 320, 321, 324, 330, 331,
-	332, 333, 334, 336, 343, 347, 355, 356, 357, 358,
-	359, 361, 362, 366, 367, 368, 369, 377, 381, 396,
-	397, 408, 420, 425, 246, 404, 426, 0, 281, 0,
-	0, 283, 231, 248, 258, 0, 415, 378, 188, 349,
-	238, 177, 205, 191, 212, 226, 228, 262, 291, 297,
-	326, 329, 243, 223, 203, 346, 200, 364, 384, 385,
-	386, 388, 295, 219, 313, 0, 0, 0, 0, 0,
-	0, 0, 1076, 222, 0, 0, 0, 0, 0, 271,
-	0, 0, 0, 327, 0, 365, 208, 280, 278, 393,
-	232, 225, 221, 207, 255, 286, 325, 383, 319, 0,
-	275, 0, 0, 374, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 206, 175, 310, 375, 236, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	197, 0, 204, 0, 0, 0, 0, 218, 259, 224,
-	217, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 299, 0, 0, 0,
-	0, 422, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 0, 267, 171, 186, 0, 0, 309, 348, 354,
-	0, 0, 0, 209, 0, 352, 323, 407, 193, 234,
-	345, 328, 350, 0, 0, 351, 276, 395, 340, 405,
-	423, 424, 216, 303, 413, 387, 419, 434, 187, 213,
-	317, 380, 410, 371, 296, 391, 392, 266, 370, 242,
-	174, 274, 431, 185, 360, 201, 178, 382, 403, 198,
-	363, 0, 0, 0, 180, 401, 379, 293, 263, 264,
-	179, 0, 344, 220, 240, 211, 312, 398, 399, 210,
-	436, 189, 418, 182, 0, 417, 305, 394, 402, 294,
-	285, 181, 400, 292, 284, 269, 230, 250, 338, 279,
-	339, 251, 301, 300, 302, 0, 176, 0, 376, 411,
-	437, 194, 195, 196, 0, 229, 233, 239, 241, 0,
-	247, 254, 272, 316, 337, 335, 341, 0, 389, 406,
-	414, 421, 427, 428, 432, 429, 430, 433, 304, 190,
-	253, 372, 268, 277, 0, 0, 322, 353, 199, 409,
-	373, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 183, 273, 0, 342, 237, 435, 416, 412,
-	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
-	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
-	282, 287, 288, 289, 290, 306, 307, 308, 311, 314,
-	315, 318, 320, 321, 324, 330, 331, 332, 333, 334,
-	336, 343, 347, 355, 356, 357, 358, 359, 361, 362,
-	366, 367, 368, 369, 377, 381, 396, 397, 408, 420,
-	425, 246, 404, 426, 0, 281, 0, 0, 283, 231,
-	248, 258, 0, 415, 378, 188, 349, 238, 177, 205,
-	191, 212, 226, 228, 262, 291, 297, 326, 329, 243,
-	223, 203, 346, 200, 364, 384, 385, 386, 388, 295,
-	219, 313, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 0, 0, 0, 0, 271, 0, 0, 0,
-	327, 0, 365, 208, 280, 278, 393, 232, 225, 221,
-	207, 255, 286, 325, 383, 319, 0, 275, 0, 0,
-	374, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 206, 175,
-	310, 375, 236, 0, 0, 0, 167, 168, 169, 0,
-	932, 0, 0, 0, 0, 0, 0, 197, 0, 204,
-	0, 0, 0, 0, 218, 259, 224, 217, 390, 0,
+	244, 0, 300, 0, 0, 0, 0, 424, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 267, 171,
+	186, 0, 0, 310, 350, 356, 0, 0, 0, 209,
+	0, 354, 324, 409, 193, 234, 347, 329, 0, 0,
+	353, 276, 397, 342, 407, 330, 0, 0, 280, 425,
+	426, 216, 304, 415, 389, 421, 436, 187, 213, 318,
+	382, 412, 373, 297, 393, 394, 266, 372, 242, 274,
+	433, 185, 362, 201, 178, 384, 405, 198, 365, 0,
+	0, 0, 180, 403, 381, 294, 263, 264, 179, 0,
+	346, 220, 240, 211, 313, 400, 401, 210, 438, 189,
+	420, 182, 0, 419, 306, 396, 404, 295, 286, 181,
+	402, 293, 285, 269, 230, 250, 340, 279, 341, 251,
+	302, 301, 303, 0, 176, 0, 378, 413, 439, 194,
+	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
+	272, 317, 339, 337, 343, 0, 391, 408, 416, 423,
+	429, 430, 434, 431, 432, 435, 305, 190, 253, 374,
+	268, 277, 0, 0, 323, 355, 199, 411, 375, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	183, 273, 0, 344, 237, 437, 418, 414, 0, 0,
+	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
+	235, 245, 249, 252, 256, 257, 260, 265, 283, 288,
+	289, 290, 291, 307, 308, 309, 312, 315, 316, 319,
+	321, 322, 325, 332, 333, 334, 335, 336, 338, 345,
+	349, 357, 358, 359, 360, 361, 363, 364, 368, 369,
+	370, 371, 379, 383, 398, 399, 410, 422, 427, 246,
+	406, 428, 0, 282, 0, 0, 284, 231, 248, 258,
+	0, 417, 380, 188, 351, 238, 177, 205, 191, 212,
+	226, 228, 262, 292, 298, 327, 331, 243, 223, 203,
+	348, 200, 366, 386, 387, 388, 390, 296, 219, 314,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 271, 0, 0, 0, 328, 0,
+	367, 208, 281, 278, 395, 232, 225, 221, 207, 255,
+	287, 326, 385, 320, 0, 275, 0, 0, 376, 299,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 352, 0, 0, 261, 206,
+	175, 311, 377, 236, 0, 0, 0, 167, 168, 169,
+	0, 939, 0, 0, 0, 0, 0, 0, 197, 0,
+	204, 0, 0, 0, 0, 218, 259, 224, 217, 392,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 299, 0, 0, 0, 0, 422, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 0, 267,
-	171, 186, 0, 0, 309, 348, 354, 0, 0, 0,
-	209, 0, 352, 323, 407, 193, 234, 345, 328, 350,
-	0, 0, 351, 276, 395, 340, 405, 423, 424, 216,
-	303, 413, 387, 419, 434, 187, 213, 317, 380, 410,
-	371, 296, 391, 392, 266, 370, 242, 174, 274, 431,
-	185, 360, 201, 178, 382, 403, 198, 363, 0, 0,
-	0, 180, 401, 379, 293, 263, 264, 179, 0, 344,
-	220, 240, 211, 312, 398, 399, 210, 436, 189, 418,
-	182, 0, 417, 305, 394, 402, 294, 285, 181, 400,
-	292, 284, 269, 230, 250, 338, 279, 339, 251, 301,
-	300, 302, 0, 176, 0, 376, 411, 437, 194, 195,
-	196, 0, 229, 233, 239, 241, 0, 247, 254, 272,
-	316, 337, 335, 341, 0, 389, 406, 414, 421, 427,
-	428, 432, 429, 430, 433, 304, 190, 253, 372, 268,
-	277, 0, 0, 322, 353, 199, 409, 373, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 183,
-	273, 0, 342, 237, 435, 416, 412, 0, 0, 215,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 184, 192, 202, 214, 227, 235,
-	245, 249, 252, 256, 257, 260, 265, 282, 287, 288,
-	289, 290, 306, 307, 308, 311, 314, 315, 318, 320,
-	321, 324, 330, 331, 332, 333, 334, 336, 343, 347,
-	355, 356, 357, 358, 359, 361, 362, 366, 367, 368,
-	369, 377, 381, 396, 397, 408, 420, 425, 246, 404,
-	426, 0, 281, 0, 0, 283, 231, 248, 258, 0,
-	415, 378, 188, 349, 238, 177, 205, 191, 212, 226,
-	228, 262, 291, 297, 326, 329, 243, 223, 203, 346,
-	200, 364, 384, 385, 386, 388, 295, 219, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
-	0, 0, 0, 271, 0, 0, 0, 327, 0, 365,
-	208, 280, 278, 393, 232, 225, 221, 207, 255, 286,
-	325, 383, 319, 0, 275, 0, 0, 374, 298, 0,
+	0, 0, 244, 0, 300, 0, 0, 0, 0, 424,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	267, 171, 186, 0, 0, 310, 350, 356, 0, 0,
+	0, 209, 0, 354, 324, 409, 193, 234, 347, 329,
+	0, 0, 353, 276, 397, 342, 407, 330, 0, 0,
+	280, 425, 426, 216, 304, 415, 389, 421, 436, 187,
+	213, 318, 382, 412, 373, 297, 393, 394, 266, 372,
+	242, 274, 433, 185, 362, 201, 178, 384, 405, 198,
+	365, 0, 0, 0, 180, 403, 381, 294, 263, 264,
+	179, 0, 346, 220, 240, 211, 313, 400, 401, 210,
+	438, 189, 420, 182, 0, 419, 306, 396, 404, 295,
+	286, 181, 402, 293, 285, 269, 230, 250, 340, 279,
+	341, 251, 302, 301, 303, 0, 176, 0, 378, 413,
+	439, 194, 195, 196, 0, 229, 233, 239, 241, 0,
+	247, 254, 272, 317, 339, 337, 343, 0, 391, 408,
+	416, 423, 429, 430, 434, 431, 432, 435, 305, 190,
+	253, 374, 268, 277, 0, 0, 323, 355, 199, 411,
+	375, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 183, 273, 0, 344, 237, 437, 418, 414,
+	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 206, 175, 310, 375, 236,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 197, 0, 204, 0, 0, 0,
-	0, 218, 259, 224, 217, 390, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 173, 184, 192, 202,
+	214, 227, 235, 245, 249, 252, 256, 257, 260, 265,
+	283, 288, 289, 290, 291, 307, 308, 309, 312, 315,
+	316, 319, 321, 322, 325, 332, 333, 334, 335, 336,
+	338, 345, 349, 357, 358, 359, 360, 361, 363, 364,
+	368, 369, 370, 371, 379, 383, 398, 399, 410, 422,
+	427, 246, 406, 428, 0, 282, 0, 0, 284, 231,
+	248, 258, 0, 417, 380, 188, 351, 238, 177, 205,
+	191, 212, 226, 228, 262, 292, 298, 327, 331, 243,
+	223, 203, 348, 200, 366, 386, 387, 388, 390, 296,
+	219, 314, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 0, 0, 0, 0, 271, 0, 0, 0,
+	328, 0, 367, 208, 281, 278, 395, 232, 225, 221,
+	207, 255, 287, 326, 385, 320, 0, 275, 0, 0,
+	376, 299, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 352, 0, 0,
+	261, 206, 175, 311, 377, 236, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	197, 0, 204, 0, 0, 0, 0, 218, 259, 224,
+	217, 392, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 491, 0, 244, 0,
-	299, 0, 0, 0, 0, 422, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
-	0, 309, 348, 354, 0, 0, 0, 209, 0, 352,
-	323, 407, 193, 234, 345, 328, 350, 0, 0, 351,
-	276, 395, 340, 405, 423, 424, 216, 303, 413, 387,
-	419, 434, 187, 213, 317, 380, 410, 371, 296, 391,
-	392, 266, 370, 242, 174, 274, 431, 185, 360, 201,
-	178, 382, 403, 198, 363, 0, 0, 0, 180, 401,
-	379, 293, 263, 264, 179, 0, 344, 220, 240, 211,
-	312, 398, 399, 210, 436, 189, 418, 182, 0, 417,
-	305, 394, 402, 294, 285, 181, 400, 292, 284, 269,
-	230, 250, 338, 279, 339, 251, 301, 300, 302, 0,
-	176, 0, 376, 411, 437, 194, 195, 196, 0, 229,
-	233, 239, 241, 0, 247, 254, 272, 316, 337, 335,
-	341, 0, 389, 406, 414, 421, 427, 428, 432, 429,
-	430, 433, 304, 190, 253, 372, 268, 277, 0, 0,
-	322, 353, 199, 409, 373, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 183, 273, 0, 342,
-	237, 435, 416, 412, 0, 0, 215, 0, 0, 0,
+	0, 0, 493, 0, 244, 0, 300, 0, 0, 0,
+	0, 424, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 267, 171, 186, 0, 0, 310, 350, 356,
+	0, 0, 0, 209, 0, 354, 324, 409, 193, 234,
+	347, 329, 0, 0, 353, 276, 397, 342, 407, 330,
+	0, 0, 280, 425, 426, 216, 304, 415, 389, 421,
+	436, 187, 213, 318, 382, 412, 373, 297, 393, 394,
+	266, 372, 242, 274, 433, 185, 362, 201, 178, 384,
+	405, 198, 365, 0, 0, 0, 180, 403, 381, 294,
+	263, 264, 179, 0, 346, 220, 240, 211, 313, 400,
+	401, 210, 438, 189, 420, 182, 0, 419, 306, 396,
+	404, 295, 286, 181, 402, 293, 285, 269, 230, 250,
+	340, 279, 341, 251, 302, 301, 303, 0, 176, 0,
+	378, 413, 439, 194, 195, 196, 0, 229, 233, 239,
+	241, 0, 247, 254, 272, 317, 339, 337, 343, 0,
+	391, 408, 416, 423, 429, 430, 434, 431, 432, 435,
+	305, 190, 253, 374, 268, 277, 0, 0, 323, 355,
+	199, 411, 375, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
-	256, 257, 260, 265, 282, 287, 288, 289, 290, 306,
-	307, 308, 311, 314, 315, 318, 320, 321, 324, 330,
-	331, 332, 333, 334, 336, 343, 347, 355, 356, 357,
-	358, 359, 361, 362, 366, 367, 368, 369, 377, 381,
-	396, 397, 408, 420, 425, 490, 404, 426, 0, 281,
-	0, 0, 283, 231, 248, 258, 0, 415, 378, 188,
-	349, 238, 177, 205, 191, 212, 226, 228, 262, 291,
-	297, 326, 329, 243, 223, 203, 346, 200, 364, 384,
-	385, 386, 388, 295, 219, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
-	271, 0, 0, 0, 327, 0, 365, 208, 280, 278,
-	393, 232, 225, 221, 207, 255, 286, 325, 383, 319,
-	0, 275, 0, 0, 374, 298, 0, 0, 0, 0,
+	0, 0, 0, 170, 183, 273, 0, 344, 237, 437,
+	418, 414, 0, 0, 215, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 206, 175, 310, 375, 236, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 197, 0, 204, 0, 0, 0, 0, 218, 259,
-	224, 217, 390, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 184,
+	192, 202, 214, 227, 235, 245, 249, 252, 256, 257,
+	260, 265, 283, 288, 289, 290, 291, 307, 308, 309,
+	312, 315, 316, 319, 321, 322, 325, 332, 333, 334,
+	335, 336, 338, 345, 349, 357, 358, 359, 360, 361,
+	363, 364, 368, 369, 370, 371, 379, 383, 398, 399,
+	410, 422, 427, 492, 406, 428, 0, 282, 0, 0,
+	284, 231, 248, 258, 0, 417, 380, 188, 351, 238,
+	177, 205, 191, 212, 226, 228, 262, 292, 298, 327,
+	331, 243, 223, 203, 348, 200, 366, 386, 387, 388,
+	390, 296, 219, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 271, 0,
+	0, 0, 328, 0, 367, 208, 281, 278, 395, 232,
+	225, 221, 207, 255, 287, 326, 385, 320, 0, 275,
+	0, 0, 376, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 352,
+	0, 0, 261, 206, 175, 311, 377, 236, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 197, 0, 204, 0, 0, 0, 0, 218,
+	259, 224, 217, 392, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 299, 0, 0,
-	440, 0, 422, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 0, 267, 171, 186, 0, 0, 309, 348,
-	354, 0, 0, 0, 209, 0, 352, 323, 407, 193,
-	234, 345, 328, 350, 0, 0, 351, 276, 395, 340,
-	405, 423, 424, 216, 303, 413, 387, 419, 434, 187,
-	213, 317, 380, 410, 371, 296, 391, 392, 266, 370,
-	242, 174, 274, 431, 185, 360, 201, 178, 382, 403,
-	198, 363, 0, 0, 0, 180, 401, 379, 293, 263,
-	264, 179, 0, 344, 220, 240, 211, 312, 398, 399,
-	210, 436, 189, 418, 182, 0, 417, 305, 394, 402,
-	294, 285, 181, 400, 292, 284, 269, 230, 250, 338,
-	279, 339, 251, 301, 300, 302, 0, 176, 0, 376,
-	411, 437, 194, 195, 196, 0, 229, 233, 239, 241,
-	0, 247, 254, 272, 316, 337, 335, 341, 0, 389,
-	406, 414, 421, 427, 428, 432, 429, 430, 433, 304,
-	190, 253, 372, 268, 277, 0, 0, 322, 353, 199,
-	409, 373, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 300, 0,
+	0, 442, 0, 424, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 267, 171, 186, 0, 0, 310,
+	350, 356, 0, 0, 0, 209, 0, 354, 324, 409,
+	193, 234, 347, 329, 0, 0, 353, 276, 397, 342,
+	407, 330, 0, 0, 280, 425, 426, 216, 304, 415,
+	389, 421, 436, 187, 213, 318, 382, 412, 373, 297,
+	393, 394, 266, 372, 242, 274, 433, 185, 362, 201,
+	178, 384, 405, 198, 365, 0, 0, 0, 180, 403,
+	381, 294, 263, 264, 179, 0, 346, 220, 240, 211,
+	313, 400, 401, 210, 438, 189, 420, 182, 0, 419,
+	306, 396, 404, 295, 286, 181, 402, 293, 285, 269,
+	230, 250, 340, 279, 341, 251, 302, 301, 303, 0,
+	176, 0, 378, 413, 439, 194, 195, 196, 0, 229,
+	233, 239, 241, 0, 247, 254, 272, 317, 339, 337,
+	343, 0, 391, 408, 416, 423, 429, 430, 434, 431,
+	432, 435, 305, 190, 253, 374, 268, 277, 0, 0,
+	323, 355, 199, 411, 375, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 183, 273, 0, 342, 237, 435, 416,
-	412, 0, 0, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 183, 273, 0, 344,
+	237, 437, 418, 414, 0, 0, 215, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 184, 192,
-	202, 214, 227, 235, 245, 249, 252, 256, 257, 260,
-	265, 282, 287, 288, 289, 290, 306, 307, 308, 311,
-	314, 315, 318, 320, 321, 324, 330, 331, 332, 333,
-	334, 336, 343, 347, 355, 356, 357, 358, 359, 361,
-	362, 366, 367, 368, 369, 377, 381, 396, 397, 408,
-	420, 425, 246, 404, 426, 0, 281, 0, 0, 283,
-	231, 248, 258, 0, 415, 378, 188, 349, 238, 177,
-	205, 191, 212, 226, 228, 262, 291, 297, 326, 329,
-	243, 223, 203, 346, 200, 364, 384, 385, 386, 388,
-	295, 219, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 222, 0, 0, 0, 0, 0, 271, 0, 0,
-	0, 327, 0, 365, 208, 280, 278, 393, 232, 225,
-	221, 207, 255, 286, 325, 383, 319, 0, 275, 0,
-	0, 374, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 206,
-	175, 310, 375, 236, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 197, 0,
-	204, 0, 0, 0, 0, 218, 259, 224, 217, 390,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 184, 192, 202, 214, 227, 235, 245, 249, 252,
+	256, 257, 260, 265, 283, 288, 289, 290, 291, 307,
+	308, 309, 312, 315, 316, 319, 321, 322, 325, 332,
+	333, 334, 335, 336, 338, 345, 349, 357, 358, 359,
+	360, 361, 363, 364, 368, 369, 370, 371, 379, 383,
+	398, 399, 410, 422, 427, 246, 406, 428, 0, 282,
+	0, 0, 284, 231, 248, 258, 0, 417, 380, 188,
+	351, 238, 177, 205, 191, 212, 226, 228, 262, 292,
+	298, 327, 331, 243, 223, 203, 348, 200, 366, 386,
+	387, 388, 390, 296, 219, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	271, 0, 0, 0, 328, 0, 367, 208, 281, 278,
+	395, 232, 225, 221, 207, 255, 287, 326, 385, 320,
+	0, 275, 0, 0, 376, 299, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 352, 0, 0, 261, 206, 175, 311, 377, 236,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 197, 0, 204, 0, 0, 0,
+	0, 218, 259, 224, 217, 392, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 299, 0, 0, 0, 0, 422,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
-	267, 171, 186, 0, 0, 309, 348, 354, 0, 0,
-	0, 209, 0, 352, 323, 407, 193, 234, 345, 328,
-	350, 0, 0, 351, 276, 395, 340, 405, 423, 424,
-	216, 303, 413, 387, 419, 434, 187, 213, 317, 380,
-	410, 371, 296, 391, 392, 266, 370, 242, 174, 274,
-	431, 185, 360, 201, 178, 382, 403, 198, 363, 0,
-	0, 0, 180, 401, 379, 293, 263, 264, 179, 0,
-	344, 220, 240, 211, 312, 398, 399, 210, 436, 189,
-	418, 182, 0, 417, 305, 394, 402, 294, 285, 181,
-	400, 292, 284, 269, 230, 250, 338, 279, 339, 251,
-	301, 300, 302, 0, 176, 0, 376, 411, 437, 194,
-	195, 196, 0, 229, 233, 239, 241, 0, 247, 254,
-	272, 316, 337, 335, 341, 0, 389, 406, 414, 421,
-	427, 428, 432, 429, 430, 433, 304, 190, 253, 372,
-	268, 277, 0, 0, 322, 353, 199, 409, 373, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	300, 0, 0, 0, 0, 424, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 267, 171, 186, 0,
+	0, 310, 350, 356, 0, 0, 0, 209, 0, 354,
+	324, 409, 193, 234, 347, 329, 0, 0, 353, 276,
+	397, 342, 407, 330, 0, 0, 280, 425, 426, 216,
+	304, 415, 389, 421, 436, 187, 213, 318, 382, 412,
+	373, 297, 393, 394, 266, 372, 242, 274, 433, 185,
+	362, 201, 178, 384, 405, 198, 365, 0, 0, 0,
+	180, 403, 381, 294, 263, 264, 179, 0, 346, 220,
+	240, 211, 313, 400, 401, 210, 438, 189, 420, 182,
+	0, 419, 306, 396, 404, 295, 286, 181, 402, 293,
+	285, 269, 230, 250, 340, 279, 341, 251, 302, 301,
+	303, 0, 176, 0, 378, 413, 439, 194, 195, 196,
+	0, 229, 233, 239, 241, 0, 247, 254, 272, 317,
+	339, 337, 343, 0, 391, 408, 416, 423, 429, 430,
+	434, 431, 432, 435, 305, 190, 253, 374, 268, 277,
+	0, 0, 323, 355, 199, 411, 375, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	183, 273, 0, 342, 237, 435, 416, 412, 0, 0,
-	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 183, 273,
+	0, 344, 237, 437, 418, 414, 0, 0, 215, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 184, 192, 202, 214, 227,
-	235, 245, 249, 252, 256, 257, 260, 265, 282, 287,
-	288, 289, 290, 306, 307, 308, 311, 314, 315, 318,
-	320, 321, 324, 330, 331, 332, 333, 334, 336, 343,
-	347, 355, 356, 357, 358, 359, 361, 362, 366, 367,
-	368, 369, 377, 381, 396, 397, 408, 420, 425, 246,
-	404, 426, 0, 281, 0, 0, 283, 231, 248, 258,
-	0, 415, 378, 188, 349, 238, 177, 205, 191, 212,
-	226, 228, 262, 291, 297, 326, 329, 243, 223, 203,
-	346, 200, 364, 384, 385, 386, 388, 295, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 184, 192, 202, 214, 227, 235, 245,
+	249, 252, 256, 257, 260, 265, 283, 288, 289, 290,
+	291, 307, 308, 309, 312, 315, 316, 319, 321, 322,
+	325, 332, 333, 334, 335, 336, 338, 345, 349, 357,
+	358, 359, 360, 361, 363, 364, 368, 369, 370, 371,
+	379, 383, 398, 399, 410, 422, 427, 246, 406, 428,
+	0, 282, 0, 0, 284, 231, 248, 258, 0, 417,
+	380, 188, 351, 238, 177, 205, 191, 212, 226, 228,
+	262, 292, 298, 327, 331, 243, 223, 203, 348, 200,
+	366, 386, 387, 388, 390, 296, 219,
 }
 
 var yyPact = [...]int{
-	2272, -1000, -341, 1639, -1000, -1000, -1000, -1000, -1000, -1000,
+	4778, -1000, -343, 1611, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1589, 1226, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 628, 1294, -1000, 1497, 200, -1000, 27703, 390,
-	-1000, 27246, 379, 261, 27703, -1000, 88, -1000, 72, 27703,
-	83, 26789, -1000, -1000, -286, 12589, 1465, -25, -28, 27703,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1240,
-	1549, 1567, 1584, 1079, 1645, -1000, 10748, 10748, 316, 316,
-	316, 8920, -1000, -1000, 16715, 27703, 27703, 209, -1000, 1497,
-	-1000, -1000, 164, -1000, 253, 1256, -1000, 1222, -1000, 564,
-	655, 256, 340, 339, 254, 252, 250, 248, 238, 237,
-	236, 235, 263, -1000, 600, 600, -167, -179, 953, 305,
-	305, 305, 326, 1481, 1475, -1000, 584, -1000, 600, 600,
-	138, 600, 600, 600, 600, 214, 212, 600, 600, 600,
-	600, 600, 600, 600, 600, 600, 600, 600, 600, 600,
-	600, 600, 381, 1497, 155, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1567, 1243, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 713, 1272, -1000, 1462, 2282, -1000, 28256, 469,
+	-1000, 27794, 468, 93, 28256, -1000, 87, -1000, 81, 28256,
+	92, 27332, -1000, -1000, -284, 13439, 1427, -22, -27, 28256,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1282,
+	1517, 1524, 1563, 1116, 1615, -1000, 11578, 11578, 405, 405,
+	405, 9268, -1000, -1000, 17148, 28256, 28256, 173, -1000, 1462,
+	-1000, -1000, 147, -1000, 362, 1248, -1000, 1244, -1000, 486,
+	532, 306, 345, 344, 305, 298, 288, 285, 270, 268,
+	261, 250, 313, -1000, 633, 633, -167, -170, 2414, 390,
+	390, 390, 418, 1440, 1436, -1000, 594, -1000, 633, 633,
+	125, 633, 633, 633, 633, 206, 204, 633, 633, 633,
+	633, 633, 633, 633, 633, 633, 633, 633, 633, 633,
+	633, 633, 186, 1462, 188, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3965,26 +4033,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 27703, 133,
-	27703, -1000, 515, 27703, 717, 717, 85, 717, 717, 717,
-	717, 91, 451, -29, -1000, 81, 217, 75, 139, 703,
-	419, 65, -1000, -1000, 157, 703, 86, -1000, 717, 7036,
-	7036, 7036, -1000, 1490, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 325, -1000, -1000, -1000, -1000, 27703, 26332, 278,
-	661, -1000, -1000, -1000, 66, -1000, -1000, 1149, 868, -1000,
-	12589, 2539, 1259, 1259, -1000, -1000, 459, -1000, -1000, 13960,
-	13960, 13960, 13960, 13960, 13960, 13960, 13960, 13960, 13960, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1259, 501, -1000, 12132, 1259, 1259, 1259,
-	1259, 1259, 1259, 1259, 1259, 12589, 1259, 1259, 1259, 1259,
-	1259, 1259, 1259, 1259, 1259, 1259, 1259, 1259, 1259, 1259,
-	1259, 1259, 1259, -1000, -1000, -1000, 27703, -1000, 1259, 90,
-	1589, -1000, 1226, -1000, -1000, -1000, 1499, 12589, 12589, 1589,
-	-1000, 1413, 10748, -1000, -1000, 1581, -1000, -1000, -1000, -1000,
-	-1000, 738, 1612, -1000, 15331, 493, 1611, 25875, -1000, 19470,
-	25418, 1204, 8449, -53, -1000, -1000, -1000, 643, 18556, -1000,
+	28256, 84, 28256, -1000, 552, 28256, 724, 724, 68, 724,
+	724, 724, 724, 91, 540, -35, -1000, 90, 162, 138,
+	167, 731, 262, 60, -1000, -1000, 158, 731, 72, -1000,
+	724, 7364, 7364, 7364, -1000, 1448, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 416, -1000, -1000, -1000, -1000, 28256,
+	26870, 293, 695, -1000, -1000, -1000, 7, -1000, -1000, 1179,
+	655, -1000, 13439, 2464, 1250, 1250, -1000, -1000, 504, -1000,
+	-1000, 14363, 14363, 14363, 14363, 14363, 14363, 14363, 14363, 14363,
+	14363, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1250, 531, -1000, 12977, 1250,
+	1250, 1250, 1250, 1250, 1250, 1250, 1250, 13439, 1250, 1250,
+	1250, 1250, 1250, 1250, 1250, 1250, 1250, 1250, 1250, 1250,
+	1250, 1250, 1250, 1250, 1250, -1000, -1000, -1000, 28256, -1000,
+	1250, 105, 1567, -1000, 1243, -1000, -1000, -1000, 1457, 13439,
+	13439, 1567, -1000, 1364, 11578, -1000, -1000, 1584, -1000, -1000,
+	-1000, -1000, -1000, 841, 1592, -1000, 15749, 518, 1591, 26408,
+	-1000, 19933, 25946, 1241, 8792, -61, -1000, -1000, -1000, 671,
+	19009, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1448, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1490, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3996,194 +4065,200 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1162, 28256, -1000, -1000, 3992,
+	1048, -1000, 1271, -1000, 1152, -1000, 1255, 1280, 464, 1048,
+	454, 448, 447, -1000, -106, -1000, -1000, -1000, -1000, -1000,
+	633, 633, 295, -1000, 1562, 2282, 3970, -1000, -1000, -1000,
+	25477, 1270, 1048, -1000, 1269, -1000, 1485, 386, 567, 567,
+	1048, -1000, -1000, 28256, 1048, 1481, 1475, 1048, 28256, 28256,
+	-1000, 25015, -1000, 24553, 24091, 943, 28256, 23629, 23167, 22705,
+	22243, 21781, -1000, 1345, -1000, 1233, -1000, -1000, -1000, 28256,
+	28256, 28256, 23, -1000, -1000, 28256, 1048, -1000, -1000, 939,
+	938, 633, 633, 937, 1021, 1019, 1012, 633, 633, 921,
+	1010, 1041, 245, 920, 918, 916, 973, 1009, 111, 966,
+	957, 912, 28256, 1268, 28256, -1000, 148, 588, 254, 667,
+	1462, 1426, 1240, 409, 463, 1048, 394, 394, -1000, 7840,
+	-1000, -1000, 1007, 13439, -1000, 733, 731, 731, -1000, -1000,
+	-1000, -1000, -1000, -1000, 724, 28256, 733, -1000, -1000, -1000,
+	731, 724, 28256, 724, 724, 724, 724, 731, 731, 731,
+	724, 28256, 28256, 28256, 28256, 28256, 28256, 28256, 28256, 28256,
+	7364, 7364, 7364, 607, 724, -1000, 1312, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 85, -1000, -1000, -1000, -1000,
+	-1000, 1611, -1000, -1000, -1000, -115, 1236, 21319, -1000, -289,
+	-290, -291, -292, -1000, -1000, -1000, -293, -295, -1000, -1000,
+	-1000, 13439, 13439, 13439, 13439, 829, 620, 14363, 873, 726,
+	14363, 14363, 14363, 14363, 14363, 14363, 14363, 14363, 14363, 14363,
+	14363, 14363, 14363, 14363, 14363, 664, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1048, -1000, 1607, 883, 883, 571,
+	571, 571, 571, 571, 571, 571, 571, 571, 14825, 9730,
+	7840, 1116, 1150, 1567, 11578, 11578, 13439, 13439, 12502, 12040,
+	11578, 1451, 717, 655, 28256, -1000, 1030, -1000, -1000, 13901,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1135, 27703, -1000, -1000, 4244, 967, -1000, 1287, -1000,
-	1128, -1000, 1269, 1300, 377, 967, 356, 354, 338, -1000,
-	-103, -1000, -1000, -1000, -1000, -1000, 600, 600, 260, 200,
-	4125, -1000, -1000, -1000, 24954, 1286, 967, -1000, 1285, -1000,
-	1510, 304, 487, 487, 967, -1000, -1000, 27703, 967, 1509,
-	1507, 27703, 27703, -1000, 24497, -1000, 24040, 23583, 877, 27703,
-	23126, 22669, 22212, 21755, 21298, -1000, 1352, -1000, 1292, -1000,
-	-1000, -1000, 27703, 27703, 27703, 34, -1000, -1000, 27703, 967,
-	-1000, -1000, 876, 875, 600, 600, 870, 993, 992, 981,
-	600, 600, 857, 979, 1028, 216, 856, 853, 846, 892,
-	975, 114, 812, 791, 831, 27703, 1282, 27703, -1000, 149,
-	567, 251, 641, 1497, 1464, 1203, 324, 376, 967, 307,
-	307, -1000, 7507, -1000, -1000, 974, 12589, -1000, 709, 703,
-	703, -1000, -1000, -1000, -1000, -1000, -1000, 717, 27703, 709,
-	-1000, -1000, -1000, 703, 717, 27703, 717, 717, 717, 717,
-	703, 703, 703, 717, 27703, 27703, 27703, 27703, 27703, 27703,
-	27703, 27703, 27703, 7036, 7036, 7036, 573, 717, -1000, 1368,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 82, -1000,
-	-1000, -1000, -1000, -1000, 1639, -1000, -1000, -1000, -115, 1199,
-	20841, -1000, -290, -291, -295, -296, -1000, -1000, -1000, -300,
-	-302, -1000, -1000, -1000, 12589, 12589, 12589, 12589, 750, 579,
-	13960, 859, 707, 13960, 13960, 13960, 13960, 13960, 13960, 13960,
-	13960, 13960, 13960, 13960, 13960, 13960, 13960, 13960, 647, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 967, -1000, 1634,
-	908, 908, 525, 525, 525, 525, 525, 525, 525, 525,
-	525, 14417, 9377, 7507, 1079, 1116, 1589, 10748, 10748, 12589,
-	12589, 11662, 11205, 10748, 1492, 674, 868, 27703, -1000, 1008,
-	-1000, -1000, 13503, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 27703, 27703, 10748, 10748, 10748,
-	10748, 10748, -1000, 1185, -1000, -174, 16258, 12589, 972, 1567,
-	1079, 1581, 1515, 1628, 546, 1100, 1178, -1000, 947, 1567,
-	18099, 1260, -1000, 1581, -1000, -1000, -1000, 27703, -1000, -1000,
-	20384, -1000, -1000, 6565, 27703, 234, 27703, -1000, 1257, 1390,
-	-1000, -1000, -1000, 1545, 17642, 27703, 1271, 1165, -1000, -1000,
-	491, 7978, -53, -1000, 7978, 1158, -1000, -75, -65, 9834,
-	523, -1000, -1000, -1000, 953, 14874, 1137, -1000, 16, -1000,
-	-1000, -1000, 1269, -1000, 1269, 1269, 1269, 1269, 34, 34,
-	34, 34, -1000, -1000, -1000, -1000, -1000, 1281, 1278, -1000,
-	1269, 1269, 1269, 1269, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 28256, 28256, 11578, 11578, 11578, 11578, 11578, -1000,
+	1224, -1000, -174, 16686, 13439, 1006, 1524, 1116, 1584, 1494,
+	1602, 590, 922, 1209, -1000, 781, 1524, 18547, 1210, -1000,
+	1584, -1000, -1000, -1000, 28256, -1000, -1000, 20857, -1000, -1000,
+	6888, 28256, 249, 28256, -1000, 1169, 1379, -1000, -1000, -1000,
+	1508, 18085, 28256, 1182, 1157, -1000, -1000, 515, 8316, -61,
+	-1000, 8316, 1186, -1000, -65, -58, 10192, 493, -1000, -1000,
+	-1000, 2414, 15287, 1068, -1000, 0, -1000, -1000, -1000, 1255,
+	-1000, 1255, 1255, 1255, 1255, 23, 23, 23, 23, -1000,
+	-1000, -1000, -1000, -1000, 1263, 1262, -1000, 1255, 1255, 1255,
+	1255, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1259, 1259,
+	1259, 1256, 1256, 376, -1000, 13439, 157, 28256, 1491, 910,
+	148, 403, 1292, 1048, 1048, 1048, 403, -1000, 1031, 1020,
+	-1000, 225, 1207, -1000, -1000, 1561, -1000, -1000, 619, 760,
+	758, 535, 28256, 108, 237, -1000, 370, -1000, 28256, 1258,
+	1471, 567, 1048, -1000, 1048, -1000, -1000, -1000, -1000, 507,
+	-1000, -1000, 1048, -1000, 1205, -1000, 1158, 785, 757, 759,
+	751, 1205, -1000, -1000, -129, 1205, -1000, 1205, -1000, 1205,
+	-1000, 1205, -1000, 1205, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 598, 28256, 108, 664, -1000, 408, -1000, -1000,
+	664, 664, -1000, -1000, -1000, -1000, 1004, 1003, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1277, 1277, 1277, 1270, 1270, 293, -1000, 12589, 134,
-	27703, 1519, 815, 149, 311, 1311, 967, 967, 967, 311,
-	-1000, 1023, 1014, -1000, 1177, -1000, -1000, 1582, -1000, -1000,
-	714, 700, 698, 535, 27703, 118, 233, -1000, 285, -1000,
-	27703, 1276, 1506, 487, 967, -1000, 967, -1000, -1000, -1000,
-	-1000, 477, -1000, -1000, 967, 1176, -1000, 1173, 792, 695,
-	765, 691, 1176, -1000, -1000, -130, 1176, -1000, 1176, -1000,
-	1176, -1000, 1176, -1000, 1176, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 543, 27703, 118, 647, -1000, 320, -1000,
-	-1000, 647, 647, -1000, -1000, -1000, -1000, 970, 961, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -341, 28256, -1000, 129, 665, 213,
+	273, 180, 28256, 307, 1511, 140, 202, 28256, 28256, 394,
+	1311, 28256, 1504, 28256, -1000, -1000, -1000, -1000, 655, 28256,
+	-1000, -1000, 724, 724, -1000, -1000, 28256, 724, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 724, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -339, 27703, -1000, 146, 624,
-	221, 249, 206, 27703, 182, 1558, 201, 189, 27703, 27703,
-	307, 1359, 27703, 1525, 27703, -1000, -1000, -1000, -1000, 868,
-	27703, -1000, -1000, 717, 717, -1000, -1000, 27703, 717, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 717, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 955, -1000, 27703, 27703, -1000, -1000, -1000, -1000,
-	-1000, 84, -57, 198, -1000, -1000, -1000, -1000, 1560, -1000,
-	868, 579, 675, 614, -1000, -1000, 839, -1000, -1000, 2703,
-	-1000, -1000, -1000, -1000, 859, 13960, 13960, 13960, 1180, 2703,
-	2672, 1261, 1194, 525, 727, 727, 548, 548, 548, 548,
-	548, 635, 635, -1000, -1000, -1000, -1000, 1008, -1000, -1000,
-	-1000, 1008, 10748, 10748, 1167, 1259, 476, -1000, 1240, -1000,
-	-1000, 1567, 1092, 1092, 957, 902, 630, 1607, 1092, 613,
-	1604, 1092, 1092, 10748, -1000, -1000, 680, -1000, 12589, 1008,
-	-1000, 885, 1163, 1159, 1092, 1008, 1008, 1092, 1092, 27703,
-	-1000, -281, -1000, -80, 534, 1259, -1000, 19927, -1000, -1000,
-	1008, 1149, -1000, 1499, -1000, -1000, 1456, -1000, 1400, 12589,
-	12589, 12589, -1000, -1000, -1000, 1499, 1564, -1000, 1426, 1419,
-	1594, 10748, 19470, 1581, -1000, -1000, -1000, 470, 1594, 1175,
-	1259, -1000, 27703, 19470, 19470, 19470, 19470, 19470, -1000, 1388,
-	1385, -1000, 1382, 1379, 1399, 27703, -1000, 1110, 1079, 17642,
-	234, 1148, 19470, 27703, -1000, -1000, 19470, 27703, 6094, -1000,
-	1158, -53, -83, -1000, -1000, -1000, -1000, 868, -1000, 956,
-	-1000, 176, -1000, 287, -1000, -1000, -1000, -1000, 682, -6,
-	-1000, -1000, 34, 34, -1000, -1000, 523, 848, 523, 523,
-	523, 950, 950, -1000, -1000, -1000, -1000, -1000, 810, -1000,
-	-1000, -1000, 768, -1000, -1000, 715, 1344, 134, -1000, -1000,
-	600, 938, 1470, 27703, -1000, -1000, 1104, 146, 27703, 683,
-	1356, -1000, 1311, 1311, 1311, 27703, -1000, -1000, -1000, -1000,
-	3719, 27703, 1107, -1000, 113, 27703, 1055, 27703, -1000, 1101,
-	27703, -1000, 967, -1000, -1000, 7507, -1000, 27703, 1259, -1000,
-	-1000, -1000, -1000, 359, 1496, 1495, 118, 113, 523, 967,
-	-1000, -1000, -1000, -1000, -1000, -342, 1097, 329, 120, 150,
-	27703, 27703, 27703, 27703, 27703, 509, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 172, 319, -1000, 27703, 27703, 440, -1000,
-	-1000, -1000, 703, -1000, -1000, 703, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1488, -60, -315, -1000, -310, -1000, -1000, -1000,
-	-1000, 1180, 2703, 2422, -1000, 13960, 13960, -1000, -1000, 1092,
-	1092, 10748, 7507, 1589, 1499, -1000, -1000, 506, 647, 506,
-	13960, 13960, -1000, 13960, 13960, -1000, -122, 1197, 631, -1000,
-	12589, 588, -1000, -1000, 13960, 13960, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 334, 332, 331, 27703, -1000,
-	-1000, -1000, 838, 924, 1409, 868, 868, -1000, -1000, 27703,
-	-1000, -1000, -1000, -1000, 1592, 12589, -1000, 1156, -1000, 5623,
-	1567, 1353, 27703, 1259, 1639, 15801, 27703, 1141, -1000, 610,
-	1390, 1305, 1347, 1370, -1000, -1000, -1000, -1000, 1383, -1000,
-	1354, -1000, -1000, -1000, -1000, -1000, 1079, 1594, 19470, 1084,
-	-1000, 1084, -1000, 444, -1000, -1000, -1000, -82, -76, -1000,
-	-1000, -1000, 953, -1000, -1000, -1000, 718, 13960, 1626, -1000,
-	919, 1504, -1000, 1503, -1000, -1000, 523, 523, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1088, -1000, 1082, 1154, 1077,
-	52, -1000, 1275, 1486, 600, 600, -1000, 757, -1000, 967,
-	-1000, -1000, 328, -1000, 1524, 27703, 1332, 1330, 1329, -1000,
-	1580, 1151, -1000, 27703, -1000, -1000, 27703, -1000, -1000, 1418,
-	134, 1070, -1000, -1000, -1000, 233, 27703, -1000, 908, 113,
-	-1000, -1000, -1000, -1000, -1000, -1000, 27703, 130, -1000, 1274,
-	960, -1000, 1306, -1000, -1000, -1000, -1000, 106, 210, -1000,
-	27703, 408, 1344, 27703, -1000, -1000, -1000, 717, 717, -1000,
-	1484, -1000, 967, -1000, 13960, 2703, 2703, -1000, -1000, 1008,
-	-1000, 1567, -1000, 1008, 1269, 1269, -1000, 1269, 1270, -1000,
-	1269, 71, 1269, 70, 1008, 1008, 2382, 2357, 2337, 2313,
-	1259, -111, -1000, 868, 12589, 2277, 1882, 1259, 1259, 1259,
-	1053, 913, 34, -1000, -1000, -1000, 1583, 1579, 868, -1000,
-	-1000, -1000, 1512, 1044, 1144, -1000, -1000, 10291, 1064, 1416,
-	436, 1053, 1589, 27703, 12589, -1000, -1000, 12589, 1267, -1000,
-	12589, -1000, -1000, -1000, 1589, 1589, 1084, -1000, -1000, 538,
-	-1000, -1000, -1000, -1000, -1000, 2703, 73, -1000, -1000, -1000,
-	-1000, -1000, 34, 903, 34, 752, -1000, 749, -1000, -1000,
-	-223, -1000, -1000, 1172, 1343, -1000, -1000, 27703, -1000, -1000,
-	27703, 27703, 27703, 27703, 27703, -1000, -1000, 230, -1000, 265,
-	1051, -1000, -176, -1000, -1000, 1266, -1000, -1000, -1000, 1027,
-	-1000, -134, 967, 27703, 27703, 27703, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2703, -1000, 1499, -1000, -1000, 184,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13960, 13960,
-	13960, 13960, 13960, 1567, 901, 868, 13960, 13960, 19013, 27703,
-	27703, 17172, 34, 20, -1000, 12589, 12589, 1493, -1000, 1259,
-	-1000, 1190, 27703, 1259, 27703, -1000, 1567, -1000, 868, 868,
-	27703, 868, 1567, -1000, -1000, 523, -1000, 523, 1022, 1020,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1266, -1000,
-	-1000, -1000, 1151, -1000, 228, 27703, -1000, 233, -1000, -182,
-	-188, 1528, 27703, -1000, -1000, 7507, -1000, -1000, 1263, 1310,
-	-1000, -1000, -1000, -1000, 885, 885, 885, 885, 197, 1008,
-	-1000, 885, 885, 1049, -1000, 1049, 1049, 534, -275, -1000,
-	1461, 1447, 868, 1149, 1616, -1000, 1259, 1639, 416, 1144,
-	-1000, -1000, 1047, -1000, -1000, -1000, -1000, -1000, 1527, 1259,
-	1181, -1000, -1000, -1000, 1226, 1041, 1145, -1000, 599, 27703,
-	27703, -1000, -1000, -1000, -1000, 1008, 153, -139, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 20, 264, -1000, 1444, 1447,
-	-1000, 1577, 1443, 1576, -1000, 27703, 1144, 27703, -1000, 1226,
-	13046, 27703, 208, -1000, 7507, 5152, 1038, -1000, -1000, 1407,
-	-126, -144, 1434, 1436, 1436, 1444, -1000, 1575, 1573, -1000,
-	899, 1570, 898, 1142, -1000, 208, 885, 1008, 1005, -1000,
-	-55, -1000, -1000, -1000, -1000, -1000, 1306, -1000, 1402, -1000,
-	1431, 799, -1000, -1000, -1000, -1000, 890, 879, -1000, 869,
-	-1000, -1000, -1000, -1000, 1315, 289, -1000, -1000, -134, -135,
-	-1000, 782, -1000, -1000, -1000, -1000, -1000, 1314, -1000, 1598,
-	135, -1000, -173, -1000, -1000, -1000, 1600, 568, 568, -1000,
-	-164, -1000, -1000, -1000, 290, 779, -1000, -1000, -1000, -1000,
-	-1000,
+	-1000, 1000, -1000, 28256, 28256, -1000, -1000, -1000, -1000, -1000,
+	29, -67, 179, -1000, -1000, -1000, -1000, 1515, -1000, 655,
+	620, 833, 656, -1000, -1000, 796, -1000, -1000, 2761, -1000,
+	-1000, -1000, -1000, 873, 14363, 14363, 14363, 639, 2761, 2780,
+	539, 882, 571, 708, 708, 569, 569, 569, 569, 569,
+	687, 687, -1000, -1000, -1000, -1000, 1030, -1000, -1000, -1000,
+	1030, 11578, 11578, 1200, 1250, 503, -1000, 1282, -1000, -1000,
+	1524, 1123, 1123, 878, 960, 645, 1589, 1123, 635, 1581,
+	1123, 1123, 11578, -1000, -1000, 742, -1000, 13439, 1030, -1000,
+	1294, 1193, 1190, 1123, 1030, 1030, 1123, 1123, 28256, -1000,
+	-281, -1000, -89, 496, 1250, -1000, 20395, -1000, -1000, 1030,
+	1179, -1000, 1457, -1000, -1000, 1424, -1000, 1359, 13439, 13439,
+	13439, -1000, -1000, -1000, 1457, 1539, -1000, 1384, 1371, 1577,
+	11578, 19933, 1584, -1000, -1000, -1000, 500, 1577, 1227, 1250,
+	-1000, 28256, 19933, 19933, 19933, 19933, 19933, -1000, 1338, 1337,
+	-1000, 1334, 1328, 1388, 28256, -1000, 1144, 1116, 18085, 249,
+	1184, 19933, 28256, -1000, -1000, 19933, 28256, 6412, -1000, 1186,
+	-61, -68, -1000, -1000, -1000, -1000, 655, -1000, 998, 246,
+	2372, -1000, 374, -1000, -1000, -1000, -1000, 472, 1, -1000,
+	-1000, 23, 23, -1000, -1000, 493, 799, 493, 493, 493,
+	990, 990, -1000, -1000, -1000, -1000, -1000, 907, -1000, -1000,
+	-1000, 902, -1000, -1000, 941, 1336, 157, -1000, -1000, 633,
+	988, 1431, 28256, -1000, -1000, 1058, 129, 28256, 722, 1310,
+	-1000, 1292, 1292, 1292, 28256, -1000, -1000, -1000, -1000, 219,
+	1097, 217, 216, -1000, 264, 28256, 1140, -1000, 1296, 28256,
+	1056, 28256, -1000, 1135, 28256, -1000, 1048, -1000, -1000, 7840,
+	-1000, 28256, 1250, -1000, -1000, -1000, -1000, 460, 1460, 1450,
+	108, 1296, 493, 1048, -1000, -1000, -1000, -1000, -1000, -344,
+	1131, 422, 114, 151, 28256, 28256, 28256, 28256, 28256, 494,
+	-1000, -1000, 626, -1000, -1000, -1000, -1000, -1000, 187, 407,
+	-1000, 28256, 28256, 462, -1000, -1000, -1000, 731, -1000, -1000,
+	731, -1000, -1000, -1000, -1000, -1000, -1000, 1446, -73, -313,
+	-1000, -310, -1000, -1000, -1000, -1000, 639, 2761, 2353, -1000,
+	14363, 14363, -1000, -1000, 1123, 1123, 11578, 7840, 1567, 1457,
+	-1000, -1000, 353, 664, 353, 14363, 14363, -1000, 14363, 14363,
+	-1000, -122, 1133, 640, -1000, 13439, 825, -1000, -1000, 14363,
+	14363, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	439, 427, 424, 28256, -1000, -1000, -1000, 951, 985, 1356,
+	655, 655, -1000, -1000, 28256, -1000, -1000, -1000, -1000, 1575,
+	13439, -1000, 1183, -1000, 5936, 1524, 1309, 28256, 1250, 1611,
+	16224, 28256, 1160, -1000, 648, 1379, 1285, 1308, 1342, -1000,
+	-1000, -1000, -1000, 1326, -1000, 1265, -1000, -1000, -1000, -1000,
+	-1000, 1116, 1577, 19933, 1154, -1000, 1154, -1000, 498, -1000,
+	-1000, -1000, -83, -85, -1000, -1000, -1000, -1000, -1000, 2414,
+	-1000, -1000, -1000, 807, 14363, 1598, -1000, 983, 1469, -1000,
+	1468, -1000, -1000, 493, 493, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1112, -1000, 1106, 1181, 1104, 74, -1000, 1277,
+	1444, 633, 633, -1000, 900, -1000, 1048, -1000, -1000, 420,
+	-1000, 1502, 28256, 1307, 1306, 1305, -1000, 1250, 893, 1250,
+	1252, 14363, 1250, 14363, 1250, 1554, 1180, -1000, 28256, -1000,
+	-1000, 28256, -1000, -1000, 88, 157, 1102, -1000, -1000, -1000,
+	237, 28256, -1000, 883, 1296, -1000, -1000, -1000, -1000, -1000,
+	-1000, 28256, 120, -1000, 1257, 1027, -1000, 1287, -1000, -1000,
+	-1000, -1000, 98, 209, -1000, 879, -1000, 28256, 451, 1336,
+	28256, -1000, -1000, -1000, 724, 724, -1000, 1443, -1000, 1048,
+	-1000, 14363, 2761, 2761, -1000, -1000, 1030, -1000, 1524, -1000,
+	1030, 1255, 1255, -1000, 1255, 1256, -1000, 1255, 71, 1255,
+	64, 1030, 1030, 2632, 2544, 2508, 1822, 1250, -114, -1000,
+	655, 13439, 1783, 1764, 1250, 1250, 1250, 1080, 982, 23,
+	-1000, -1000, -1000, 1573, 1552, 655, -1000, -1000, -1000, 1487,
+	1156, 1121, -1000, -1000, 11116, 1086, 1368, 490, 1080, 1567,
+	28256, 13439, -1000, -1000, 13439, 1254, -1000, 13439, -1000, -1000,
+	-1000, 1567, 1567, 1154, -1000, -1000, 575, -1000, -1000, -1000,
+	-1000, -1000, 2761, -17, -1000, -1000, -1000, -1000, -1000, 23,
+	972, 23, 872, -1000, 868, -1000, -1000, -214, -1000, -1000,
+	1174, 1335, -1000, -1000, 28256, -1000, -1000, 28256, 28256, 28256,
+	-1000, 237, -1000, 14363, 1250, 638, 1294, 28256, 1294, 28256,
+	28256, 28256, -1000, -1000, 314, -1000, 633, -1000, 351, 1078,
+	-1000, -168, -1000, -1000, 1253, -1000, -1000, -1000, 1047, -1000,
+	-130, 1048, 28256, 28256, 28256, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2761, -1000, 1457, -1000, -1000, 271,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14363, 14363,
+	14363, 14363, 14363, 1524, 971, 655, 14363, 14363, 19471, 28256,
+	28256, 17610, 23, -1, -1000, 13439, 13439, 1452, -1000, 1250,
+	-1000, 1247, 28256, 1250, 28256, -1000, 1524, -1000, 655, 655,
+	28256, 655, 1524, -1000, -1000, 493, -1000, 493, 1045, 1037,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1253, -1000,
+	-1000, -1000, 1078, 1294, 19471, 862, -1000, 1071, -1000, 1071,
+	1180, -1000, 232, 1250, 1048, 28256, -1000, 237, -1000, -179,
+	-180, 1506, 28256, -1000, -1000, 7840, -1000, -1000, 1251, 1290,
+	-1000, -1000, -1000, -1000, 1294, 1294, 1294, 1294, 388, 1030,
+	-1000, 1294, 1294, 1071, -1000, 1071, 1071, 496, -267, -1000,
+	1423, 1411, 655, 1179, 1597, -1000, 1250, 1611, 484, 1121,
+	-1000, -1000, 1076, -1000, -1000, -1000, -1000, -1000, 1495, -1000,
+	-1000, 1071, -1000, -1000, -1000, -1000, 1250, -320, 13439, -1000,
+	1234, -1000, -1000, -1000, 1243, 1062, 1165, -1000, 629, 28256,
+	28256, -1000, -1000, -1000, -1000, 1030, 161, -160, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1, 247, -1000, 1408, 1411,
+	-1000, 1543, 1419, 1541, -1000, 28256, 1121, 28256, -1000, 1243,
+	-1000, -320, 10654, -1000, 28256, 189, -1000, 7840, 5460, 1055,
+	-1000, -1000, 1340, -125, -164, 1412, 1409, 1409, 1408, -1000,
+	1537, 1528, -1000, 968, 1527, 950, 1074, -1000, 189, -1000,
+	1030, 1053, -1000, -54, -1000, -1000, -1000, -1000, -1000, 1287,
+	-1000, 1339, -1000, 1406, 835, -1000, -1000, -1000, -1000, 949,
+	948, -1000, 945, -1000, -1000, -320, 1297, 373, -1000, -1000,
+	-130, -150, -1000, 798, -1000, -1000, -1000, -1000, -1000, -1000,
+	1295, -1000, 1588, 135, -1000, -162, -1000, -1000, -1000, 1590,
+	501, 501, -1000, -166, -1000, -1000, -1000, 267, 899, -1000,
+	-1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1876, 1875, 11, 80, 88, 1874, 1873, 1872, 1871,
-	131, 128, 125, 1870, 1869, 1868, 1865, 1864, 1862, 1860,
-	1859, 1856, 1853, 1852, 1850, 58, 123, 39, 45, 138,
-	1849, 1848, 31, 1847, 1846, 1845, 121, 120, 562, 1844,
-	126, 1842, 1840, 1839, 1832, 1831, 1830, 1829, 1828, 1826,
-	1825, 1824, 1823, 1821, 1819, 212, 1818, 1816, 16, 1813,
-	33, 1812, 1811, 1810, 1808, 1807, 89, 1805, 1802, 1801,
-	117, 1799, 1798, 48, 261, 50, 81, 1797, 1796, 99,
-	129, 1795, 77, 93, 1791, 675, 1789, 44, 100, 86,
-	1788, 47, 1787, 1780, 64, 1779, 1778, 1777, 75, 1776,
-	1774, 3168, 1773, 67, 82, 14, 42, 1772, 1771, 1769,
-	1768, 38, 2186, 1767, 1763, 30, 1762, 1761, 136, 1760,
-	87, 28, 1759, 17, 18, 20, 1758, 84, 1757, 61,
-	51, 36, 1756, 85, 1755, 1754, 1753, 1752, 25, 1751,
-	78, 104, 65, 1750, 1748, 7, 6, 1747, 1746, 1745,
-	1743, 1741, 1740, 10, 1739, 5, 1737, 27, 1736, 8,
-	19, 76, 116, 35, 21, 1734, 124, 1731, 29, 112,
-	73, 110, 1730, 1728, 1724, 1003, 142, 1722, 1721, 56,
-	1719, 90, 106, 1718, 164, 1717, 1716, 74, 1294, 1725,
-	9, 115, 1715, 1714, 2166, 53, 79, 22, 1713, 68,
-	1708, 1702, 1698, 139, 119, 63, 851, 41, 1697, 1696,
-	1695, 1694, 1693, 1690, 1688, 24, 23, 26, 111, 32,
-	1687, 1684, 1683, 71, 43, 1681, 109, 108, 72, 98,
-	1680, 118, 103, 66, 1679, 40, 1678, 1677, 1676, 1674,
-	46, 1673, 1671, 1670, 1668, 107, 105, 69, 34, 1665,
-	37, 57, 102, 91, 1664, 15, 122, 13, 1662, 3,
-	0, 1661, 4, 130, 157, 114, 1659, 1658, 1, 1657,
-	2, 1656, 1653, 83, 1652, 1651, 1650, 1647, 2937, 518,
-	113, 1646, 127,
+	0, 1872, 1869, 12, 93, 88, 1867, 1866, 1865, 1864,
+	134, 133, 132, 1863, 1861, 1860, 1859, 1858, 1857, 1856,
+	1855, 1850, 1848, 1844, 1843, 56, 119, 40, 44, 140,
+	1841, 1840, 33, 1837, 1836, 1835, 130, 122, 640, 1834,
+	126, 1833, 1831, 1825, 1822, 1821, 1820, 1819, 1818, 1816,
+	1815, 1813, 1812, 1811, 1810, 237, 1809, 1808, 4, 1807,
+	35, 1806, 1804, 1803, 1802, 1801, 99, 1800, 1799, 1798,
+	115, 1797, 1795, 48, 162, 59, 82, 1793, 1789, 84,
+	127, 1786, 68, 102, 1785, 386, 1784, 64, 98, 89,
+	1783, 42, 1782, 1781, 50, 1780, 1778, 1776, 78, 1774,
+	1773, 3077, 1772, 75, 81, 19, 27, 1771, 1769, 1768,
+	1767, 39, 2927, 1766, 1765, 22, 1763, 1762, 146, 1761,
+	85, 25, 1760, 16, 36, 21, 1759, 87, 1758, 41,
+	51, 37, 1757, 86, 1756, 1755, 1754, 1753, 31, 1751,
+	79, 105, 10, 1749, 1748, 8, 18, 1747, 1744, 1743,
+	1740, 1739, 1738, 9, 1737, 6, 1734, 29, 1733, 100,
+	20, 77, 63, 30, 14, 1732, 129, 1731, 28, 113,
+	76, 114, 1730, 1729, 1727, 1055, 152, 1726, 1723, 45,
+	1721, 90, 103, 1720, 171, 1716, 1713, 53, 1227, 2459,
+	15, 118, 1712, 1711, 2106, 72, 80, 17, 1708, 71,
+	1706, 1705, 1704, 121, 125, 61, 965, 43, 1703, 1702,
+	1698, 1697, 1696, 1694, 1691, 254, 23, 94, 123, 32,
+	1690, 1689, 1688, 69, 47, 1687, 111, 110, 73, 128,
+	1686, 117, 104, 57, 1685, 58, 1683, 1682, 1681, 1680,
+	46, 1674, 1671, 1669, 1667, 109, 101, 67, 34, 1666,
+	38, 66, 106, 108, 26, 1665, 24, 1664, 74, 1663,
+	1661, 1660, 1659, 11, 124, 7, 1658, 5, 0, 1657,
+	3, 131, 167, 116, 1656, 1655, 1, 1651, 2, 1650,
+	1647, 83, 1623, 1622, 1620, 1619, 3265, 112, 120, 1617,
+	139,
 }
 
-//line sql.y:5211
+//line sql.y:5378
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4480,6 +4555,11 @@ func (st *yySymType) partSpecsUnion() []*PartitionSpec {
 	return v
 }
 
+func (st *yySymType) partitionOptionUnion() *PartitionOption {
+	v, _ := st.union.(*PartitionOption)
+	return v
+}
+
 func (st *yySymType) partitionsUnion() Partitions {
 	v, _ := st.union.(Partitions)
 	return v
@@ -4621,18 +4701,18 @@ func (st *yySymType) whensUnion() []*When {
 }
 
 var yyR1 = [...]int{
-	0, 276, 277, 277, 1, 1, 1, 1, 1, 1,
+	0, 284, 285, 285, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 260, 260, 260, 263, 263,
+	1, 1, 1, 1, 1, 268, 268, 268, 271, 271,
 	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
 	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 280, 280, 96, 96, 94, 94, 95, 95,
+	12, 12, 288, 288, 96, 96, 94, 94, 95, 95,
 	161, 161, 13, 14, 14, 171, 171, 170, 170, 170,
 	172, 172, 172, 172, 206, 206, 15, 15, 15, 15,
-	15, 71, 71, 262, 262, 261, 259, 259, 258, 258,
-	257, 23, 24, 33, 33, 33, 33, 34, 35, 264,
-	264, 236, 39, 39, 38, 38, 38, 38, 40, 40,
+	15, 71, 71, 270, 270, 269, 267, 267, 266, 266,
+	265, 23, 24, 33, 33, 33, 33, 34, 35, 272,
+	272, 236, 39, 39, 38, 38, 38, 38, 40, 40,
 	37, 37, 36, 36, 238, 238, 225, 225, 237, 237,
 	237, 237, 237, 237, 237, 224, 144, 144, 144, 144,
 	144, 144, 144, 144, 144, 144, 144, 208, 208, 208,
@@ -4646,78 +4726,80 @@ var yyR1 = [...]int{
 	247, 247, 247, 247, 235, 235, 244, 244, 244, 244,
 	244, 234, 234, 230, 230, 230, 231, 231, 232, 232,
 	229, 229, 233, 233, 246, 246, 245, 227, 227, 228,
-	228, 252, 252, 252, 252, 253, 269, 270, 268, 268,
-	268, 268, 268, 60, 60, 60, 183, 183, 183, 242,
+	228, 252, 252, 252, 252, 253, 277, 278, 276, 276,
+	276, 276, 276, 60, 60, 60, 183, 183, 183, 242,
 	242, 241, 241, 241, 243, 243, 240, 240, 240, 240,
 	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
 	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 178, 178, 178, 267, 267,
-	267, 267, 267, 267, 266, 266, 266, 239, 239, 239,
-	265, 265, 130, 130, 131, 131, 30, 30, 30, 30,
-	30, 30, 29, 29, 29, 25, 25, 25, 25, 25,
+	240, 240, 240, 240, 240, 178, 178, 178, 275, 275,
+	275, 275, 275, 275, 274, 274, 274, 239, 239, 239,
+	273, 273, 130, 130, 130, 131, 131, 30, 30, 30,
+	30, 30, 30, 29, 29, 29, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 31, 31, 26,
-	26, 26, 26, 26, 26, 26, 26, 26, 16, 16,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 31,
+	31, 26, 26, 26, 26, 26, 26, 26, 26, 26,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 222,
-	222, 222, 254, 254, 255, 255, 17, 22, 22, 18,
-	18, 18, 18, 19, 19, 41, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 222, 222, 222, 254, 254, 256,
+	256, 256, 256, 263, 263, 257, 257, 258, 259, 259,
+	259, 259, 259, 259, 259, 260, 260, 262, 262, 261,
+	261, 255, 255, 17, 22, 22, 18, 18, 18, 18,
+	19, 19, 41, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 271, 271, 177, 177, 185, 185, 176, 176,
-	199, 199, 199, 179, 179, 179, 180, 180, 275, 275,
-	275, 43, 43, 45, 45, 46, 47, 47, 201, 201,
-	202, 202, 48, 49, 61, 61, 61, 61, 61, 61,
-	63, 63, 63, 7, 7, 7, 7, 57, 57, 57,
-	6, 6, 44, 44, 51, 272, 272, 273, 274, 274,
-	274, 274, 52, 54, 20, 20, 20, 20, 20, 20,
-	78, 78, 66, 66, 66, 66, 66, 66, 66, 66,
-	66, 66, 66, 66, 72, 72, 72, 67, 67, 281,
-	55, 56, 56, 70, 70, 70, 64, 64, 64, 69,
-	69, 69, 75, 75, 77, 77, 77, 77, 77, 79,
-	79, 79, 79, 79, 79, 79, 74, 74, 76, 76,
-	76, 76, 192, 192, 192, 191, 191, 86, 86, 87,
-	87, 88, 88, 89, 89, 89, 128, 104, 104, 160,
-	160, 159, 159, 162, 162, 90, 90, 90, 90, 91,
-	91, 92, 92, 93, 93, 200, 200, 197, 197, 197,
-	196, 196, 97, 97, 97, 99, 98, 98, 98, 98,
-	100, 100, 102, 102, 101, 101, 103, 105, 105, 105,
-	105, 105, 106, 106, 85, 85, 85, 85, 85, 85,
-	85, 85, 174, 174, 108, 108, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 119, 119, 119, 119,
-	119, 119, 109, 109, 109, 109, 109, 109, 109, 73,
-	73, 120, 120, 120, 127, 121, 121, 112, 112, 112,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 279,
+	279, 177, 177, 185, 185, 176, 176, 199, 199, 199,
+	179, 179, 179, 180, 180, 283, 283, 283, 43, 43,
+	45, 45, 46, 47, 47, 201, 201, 202, 202, 48,
+	49, 61, 61, 61, 61, 61, 61, 63, 63, 63,
+	7, 7, 7, 7, 57, 57, 57, 6, 6, 44,
+	44, 51, 280, 280, 281, 282, 282, 282, 282, 52,
+	54, 20, 20, 20, 20, 20, 20, 78, 78, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
+	66, 72, 72, 72, 67, 67, 289, 55, 56, 56,
+	70, 70, 70, 64, 64, 64, 69, 69, 69, 75,
+	75, 77, 77, 77, 77, 77, 79, 79, 79, 79,
+	79, 79, 79, 74, 74, 76, 76, 76, 76, 192,
+	192, 192, 191, 191, 86, 86, 87, 87, 88, 88,
+	89, 89, 89, 128, 104, 104, 160, 160, 159, 159,
+	162, 162, 90, 90, 90, 90, 91, 91, 92, 92,
+	93, 93, 200, 200, 197, 197, 197, 196, 196, 97,
+	97, 97, 99, 98, 98, 98, 98, 100, 100, 102,
+	102, 101, 101, 103, 105, 105, 105, 105, 105, 106,
+	106, 85, 85, 85, 85, 85, 85, 85, 85, 174,
+	174, 108, 108, 107, 107, 107, 107, 107, 107, 107,
+	107, 107, 107, 119, 119, 119, 119, 119, 119, 109,
+	109, 109, 109, 109, 109, 109, 73, 73, 120, 120,
+	120, 127, 121, 121, 112, 112, 112, 112, 112, 112,
 	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
 	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 116, 116, 116, 116, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 115,
+	112, 112, 112, 112, 112, 112, 112, 112, 116, 116,
+	116, 116, 114, 114, 114, 114, 114, 114, 114, 114,
+	114, 114, 114, 114, 114, 114, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 282, 282, 118, 117, 117,
-	117, 117, 117, 117, 117, 68, 68, 68, 68, 68,
-	205, 205, 205, 207, 207, 207, 207, 207, 207, 207,
-	207, 207, 207, 207, 207, 207, 134, 134, 65, 65,
-	132, 132, 133, 135, 135, 129, 129, 129, 111, 111,
-	111, 111, 111, 111, 111, 111, 113, 113, 113, 136,
-	136, 137, 137, 138, 138, 139, 139, 140, 141, 141,
-	141, 142, 142, 142, 142, 32, 32, 32, 32, 32,
-	27, 27, 27, 27, 28, 28, 28, 80, 80, 80,
-	80, 82, 82, 81, 81, 58, 58, 59, 59, 59,
-	83, 83, 84, 84, 84, 84, 157, 157, 157, 143,
-	143, 143, 143, 149, 149, 149, 145, 145, 147, 147,
-	147, 148, 148, 148, 146, 154, 154, 156, 156, 155,
-	155, 151, 151, 152, 152, 153, 153, 153, 150, 150,
-	110, 110, 110, 110, 110, 158, 158, 158, 158, 163,
-	163, 123, 123, 125, 125, 124, 126, 164, 164, 168,
-	165, 165, 169, 169, 169, 169, 169, 166, 166, 167,
-	167, 193, 193, 193, 173, 173, 184, 184, 181, 181,
-	182, 182, 175, 175, 186, 186, 186, 53, 122, 122,
-	251, 251, 248, 189, 189, 190, 190, 194, 194, 198,
-	198, 195, 195, 187, 187, 187, 187, 187, 187, 187,
+	115, 115, 290, 290, 118, 117, 117, 117, 117, 117,
+	117, 117, 68, 68, 68, 68, 68, 205, 205, 205,
+	207, 207, 207, 207, 207, 207, 207, 207, 207, 207,
+	207, 207, 207, 134, 134, 65, 65, 132, 132, 133,
+	135, 135, 129, 129, 129, 111, 111, 111, 111, 111,
+	111, 111, 111, 113, 113, 113, 136, 136, 137, 137,
+	138, 138, 139, 139, 140, 141, 141, 141, 142, 142,
+	142, 142, 32, 32, 32, 32, 32, 27, 27, 27,
+	27, 28, 28, 28, 80, 80, 80, 80, 82, 82,
+	81, 81, 58, 58, 59, 59, 59, 83, 83, 84,
+	84, 84, 84, 157, 157, 157, 143, 143, 143, 143,
+	149, 149, 149, 145, 145, 147, 147, 147, 148, 148,
+	148, 146, 154, 154, 156, 156, 155, 155, 151, 151,
+	152, 152, 153, 153, 153, 150, 150, 110, 110, 110,
+	110, 110, 158, 158, 158, 158, 163, 163, 123, 123,
+	125, 125, 124, 126, 164, 164, 168, 165, 165, 169,
+	169, 169, 169, 169, 166, 166, 167, 167, 193, 193,
+	193, 173, 173, 184, 184, 181, 181, 182, 182, 175,
+	175, 186, 186, 186, 53, 122, 122, 251, 251, 248,
+	189, 189, 190, 190, 194, 194, 198, 198, 195, 195,
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
@@ -4731,6 +4813,7 @@ var yyR1 = [...]int{
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
 	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
@@ -4757,8 +4840,8 @@ var yyR1 = [...]int{
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 278, 279,
-	203, 204, 204, 204,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 286,
+	287, 203, 204, 204, 204,
 }
 
 var yyR2 = [...]int{
@@ -4773,7 +4856,7 @@ var yyR2 = [...]int{
 	2, 2, 2, 1, 1, 1, 2, 2, 6, 12,
 	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
 	3, 6, 4, 7, 8, 8, 8, 6, 3, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
+	1, 5, 0, 1, 1, 1, 2, 2, 0, 1,
 	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
 	3, 4, 3, 3, 3, 3, 0, 2, 3, 3,
 	4, 2, 3, 3, 2, 3, 2, 3, 1, 1,
@@ -4794,70 +4877,73 @@ var yyR2 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 0, 2, 0, 2, 0, 1, 5, 1,
-	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
-	5, 5, 6, 6, 5, 5, 2, 2, 2, 2,
-	3, 3, 3, 4, 1, 3, 5, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 4,
-	4, 2, 11, 3, 6, 8, 6, 6, 6, 13,
-	8, 6, 10, 5, 5, 5, 5, 5, 3, 7,
-	4, 4, 4, 4, 3, 3, 3, 7, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 0,
-	2, 2, 1, 3, 8, 8, 3, 3, 5, 7,
-	7, 6, 5, 3, 2, 3, 3, 3, 7, 3,
-	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
-	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
-	4, 4, 4, 4, 3, 3, 5, 2, 3, 3,
-	3, 3, 1, 1, 0, 1, 0, 1, 1, 1,
-	0, 2, 2, 0, 2, 2, 0, 2, 0, 1,
-	1, 2, 1, 1, 2, 1, 1, 5, 0, 1,
-	0, 1, 2, 3, 0, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	3, 3, 2, 2, 3, 1, 3, 2, 1, 2,
-	1, 2, 2, 4, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 2, 1,
-	3, 1, 1, 1, 3, 3, 3, 3, 7, 0,
-	3, 1, 3, 1, 3, 4, 4, 4, 3, 2,
-	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
-	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
-	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
-	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
+	0, 1, 0, 1, 2, 0, 2, 0, 1, 5,
+	1, 3, 7, 1, 3, 3, 1, 2, 2, 2,
+	5, 5, 5, 6, 6, 5, 5, 2, 2, 2,
+	2, 3, 3, 3, 4, 3, 1, 3, 5, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 4, 4, 2, 3, 11, 3, 6, 8, 6,
+	6, 6, 13, 8, 6, 10, 5, 5, 6, 7,
+	5, 5, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 7,
+	9, 6, 3, 0, 3, 0, 1, 5, 5, 6,
+	5, 4, 5, 4, 5, 0, 1, 0, 3, 0,
+	2, 0, 3, 3, 3, 5, 7, 7, 6, 5,
+	3, 2, 3, 3, 3, 7, 3, 3, 3, 3,
+	4, 7, 5, 2, 4, 4, 4, 4, 4, 5,
+	5, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 2, 4, 2, 4, 5, 4, 4, 4,
+	4, 3, 3, 5, 2, 3, 3, 3, 3, 1,
+	1, 0, 1, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 2, 0, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 5, 0, 1, 0, 1, 2,
+	3, 0, 3, 3, 3, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 1, 1, 3, 3, 2,
+	2, 3, 1, 3, 2, 1, 2, 1, 2, 2,
+	4, 3, 3, 6, 4, 7, 6, 1, 3, 2,
+	2, 2, 2, 1, 1, 1, 3, 2, 1, 1,
+	1, 0, 1, 1, 0, 3, 0, 2, 0, 2,
+	1, 2, 2, 0, 1, 1, 0, 1, 1, 0,
+	1, 0, 1, 2, 3, 4, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 2, 3, 5, 0,
+	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
+	1, 3, 3, 3, 3, 7, 0, 3, 1, 3,
+	1, 3, 4, 4, 4, 3, 2, 4, 0, 1,
+	0, 2, 0, 1, 0, 1, 2, 1, 1, 1,
+	2, 2, 1, 2, 3, 2, 3, 2, 2, 2,
+	1, 1, 3, 3, 0, 5, 4, 5, 5, 0,
+	2, 1, 3, 3, 3, 2, 3, 1, 2, 0,
+	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
+	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
+	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 4, 5,
+	5, 6, 4, 4, 6, 6, 6, 8, 8, 8,
+	8, 9, 8, 5, 4, 2, 2, 2, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
-	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 2, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
-	3, 0, 3, 1, 1, 0, 4, 0, 1, 1,
-	0, 3, 1, 3, 2, 1, 0, 2, 4, 0,
-	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
-	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
-	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
-	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
-	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	8, 8, 0, 2, 3, 4, 4, 4, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 1,
+	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
+	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
+	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
+	4, 4, 0, 2, 2, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 0, 3, 3, 3, 0, 3,
+	1, 1, 0, 4, 0, 1, 1, 0, 3, 1,
+	3, 2, 1, 0, 2, 4, 0, 9, 3, 5,
+	0, 3, 3, 0, 1, 0, 2, 2, 0, 2,
+	2, 2, 0, 2, 1, 2, 3, 3, 0, 2,
+	1, 2, 3, 4, 3, 0, 1, 2, 1, 5,
+	4, 4, 1, 3, 3, 5, 0, 5, 1, 3,
+	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
+	3, 3, 3, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 0, 2, 0, 3, 0,
+	1, 0, 1, 1, 5, 0, 1, 0, 1, 2,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4899,483 +4985,495 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 0, 1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -276, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -284, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
 	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -278, 6, 7, 8, -62, 10,
-	11, 31, -23, -33, 153, -34, -24, 154, -35, 156,
-	155, 192, 157, 185, 71, 231, 232, 234, 235, 236,
-	237, -63, 190, 191, 159, 35, 42, 32, 33, 36,
-	162, 81, 9, 336, 187, 186, 26, -277, 476, -70,
-	5, -138, 16, -3, -55, -281, -55, -55, -55, -55,
-	-55, -55, -236, -238, 81, 126, 81, -55, -39, -38,
-	-37, -36, -40, 30, -30, -31, -256, -29, -26, 158,
-	155, 200, 102, 103, 192, 193, 194, 157, 176, 191,
-	195, 190, 209, -25, 77, 32, 349, 352, -243, 154,
-	160, 161, 337, 105, 104, 72, 156, -240, 281, 453,
-	-40, 455, 95, 97, 454, 41, 165, 456, 457, 458,
-	459, 175, 460, 461, 462, 463, 469, 470, 471, 472,
-	106, 5, -55, -198, -194, -260, -188, 84, 85, 86,
-	346, 178, 380, 381, 225, 77, 281, 453, 231, 245,
-	239, 266, 258, 347, 382, 228, 179, 213, 450, 256,
-	314, 455, 383, 193, 286, 287, 288, 95, 234, 323,
-	468, 230, 384, 466, 97, 454, 76, 48, 41, 188,
-	254, 250, 456, 214, 385, 357, 207, 105, 102, 475,
-	248, 47, 28, 465, 104, 46, 457, 386, 458, 290,
-	271, 444, 45, 291, 194, 387, 80, 351, 452, 292,
-	249, 293, 224, 464, 159, 388, 436, 295, 445, 389,
-	272, 276, 390, 315, 296, 49, 391, 392, 446, 103,
-	393, 75, 459, 243, 244, 394, 222, 177, 317, 270,
-	175, 34, 297, 348, 226, 55, 201, 318, 43, 274,
-	42, 440, 395, 443, 269, 265, 50, 396, 397, 398,
-	399, 460, 268, 242, 264, 474, 219, 461, 59, 161,
-	278, 277, 279, 208, 313, 261, 400, 401, 402, 182,
-	78, 403, 251, 19, 404, 405, 298, 215, 406, 53,
-	407, 408, 321, 191, 409, 51, 462, 38, 196, 463,
-	410, 411, 412, 413, 414, 300, 415, 299, 273, 275,
-	203, 301, 350, 416, 247, 195, 467, 417, 183, 451,
-	197, 200, 190, 322, 184, 418, 419, 420, 421, 422,
-	229, 423, 424, 235, 469, 40, 425, 426, 427, 428,
-	223, 218, 316, 325, 58, 79, 283, 429, 449, 241,
-	216, 430, 232, 52, 470, 471, 472, 210, 473, 303,
-	106, 220, 221, 44, 262, 202, 431, 432, 252, 253,
-	267, 240, 263, 233, 437, 204, 304, 192, 433, 324,
-	217, 284, 354, 209, 305, 448, 353, 260, 257, 211,
-	434, 306, 166, 205, 206, 435, 438, 307, 308, 310,
-	311, 227, 309, 312, 212, 352, 255, 285, 164, -55,
-	164, -101, -194, 164, -166, 287, -185, 289, 302, 297,
-	307, 295, -177, 298, 300, 203, -275, 315, 164, 304,
-	153, 144, 290, 299, 308, 309, 312, 212, -271, -260,
-	458, 473, 314, 259, 294, 292, 316, 440, 311, 310,
-	-194, 233, -201, 238, -189, -260, -188, 236, -101, -61,
-	436, 157, -203, -203, -72, 440, 442, -121, -85, -107,
-	110, -112, 30, 24, -111, -108, -129, -126, -127, 144,
-	145, 147, 146, 148, 133, 134, 141, 111, 149, -116,
-	-114, -115, -117, 88, 87, 96, 89, 90, 91, 92,
-	98, 99, 100, -189, -194, -124, -278, 65, 66, 337,
-	338, 339, 340, 345, 341, 113, 54, 332, 326, 335,
-	334, 333, 330, 331, 328, 329, 343, 344, 169, 327,
-	163, 139, 336, -260, -188, 41, 303, 303, -101, -55,
-	-5, -4, -278, 6, 21, 22, -142, 18, 17, -279,
-	83, -64, -77, 60, 61, -79, 22, 37, 64, 62,
-	21, -56, -76, 135, -85, -194, -76, -175, 168, -175,
-	-175, -165, -206, 233, -169, 316, 315, -190, -167, -189,
-	-187, -166, 313, 158, 355, 109, 23, 25, 112, 144,
-	17, 113, 36, 160, 259, 176, 143, 172, 337, 153,
-	69, 356, 328, 329, 326, 332, 339, 340, 327, 289,
-	30, 11, 358, 26, 186, 22, 37, 137, 155, 116,
-	117, 189, 24, 187, 100, 361, 20, 72, 181, 12,
-	174, 14, 362, 363, 15, 169, 168, 128, 165, 67,
-	9, 149, 27, 125, 63, 364, 29, 365, 366, 367,
-	368, 65, 126, 18, 330, 331, 32, 441, 369, 345,
-	198, 139, 70, 56, 442, 110, 370, 371, 98, 372,
-	101, 73, 447, 107, 16, 68, 39, 373, 199, 374,
-	171, 375, 319, 376, 127, 156, 336, 66, 377, 163,
-	302, 6, 342, 31, 185, 173, 64, 378, 164, 115,
-	343, 344, 167, 99, 5, 170, 33, 10, 71, 74,
-	333, 334, 335, 54, 349, 114, 13, 379, 320, 108,
-	314, -237, 126, -224, -228, -189, 180, -253, 176, -101,
-	-246, -245, -189, -71, -184, 165, 174, 173, 170, -264,
-	107, 220, 327, 163, -36, -37, -166, 143, 197, 82,
-	82, -228, -227, -226, -265, 199, 180, -252, -244, 172,
-	181, -234, 173, 174, -229, 165, 29, -265, -229, 171,
-	181, 199, 199, 106, 199, 106, 199, 199, 199, 199,
-	199, 199, 199, 199, 199, 196, -235, 118, -235, 353,
-	353, -240, -265, -265, -265, 167, 34, 34, -186, -229,
-	167, 23, -235, -235, -166, 143, -235, -235, -235, -235,
-	207, 207, -235, -235, -235, -235, -235, -235, -235, -235,
-	-235, -235, -235, -235, -235, -235, -235, 164, -264, -80,
-	312, 227, 77, -38, 209, -22, -101, -184, 165, 166,
-	-264, -101, 150, -101, -179, 126, 13, -179, -176, 303,
-	301, 288, 293, -179, -179, -179, -179, 210, 286, -230,
-	165, 34, 177, 303, 210, 286, 210, 211, 210, 211,
-	296, 306, 210, -199, 12, 128, 327, 291, 295, 203,
-	164, 204, 166, 305, -260, 443, 211, -199, 303, 206,
-	-179, -204, -278, -190, 259, -204, -204, 31, 167, -189,
-	-57, -189, 88, -7, -3, -11, -10, -12, 118, -78,
-	303, -66, 144, 458, 444, 445, 446, 443, 300, 451,
-	449, 447, 210, 448, 82, 109, 107, 108, 125, -85,
-	-109, 128, 110, 126, 127, 112, 130, 129, 140, 133,
-	134, 135, 136, 137, 138, 139, 131, 132, 143, 118,
-	119, 120, 121, 122, 123, 124, -174, -278, -127, -278,
-	151, 152, -112, -112, -112, -112, -112, -112, -112, -112,
-	-112, -112, -278, 150, -2, -121, -4, -278, -278, -278,
-	-278, -278, -278, -278, -278, -134, -85, -278, -282, -278,
-	-282, -118, -278, -282, -118, -282, -118, -282, -282, -118,
-	-282, -118, -282, -282, -118, -278, -278, -278, -278, -278,
-	-278, -278, -203, -272, -273, -104, -101, -278, 227, -138,
-	-3, -55, -157, 20, 32, -85, -139, -140, -85, -138,
-	56, -74, -76, -79, 60, 61, 94, 12, -192, -191,
-	23, -189, 88, 150, 12, -102, 27, -101, -87, -88,
-	-89, -90, -104, -128, -278, 12, -94, -95, -101, -103,
-	-194, 82, 233, -169, -206, -171, -170, 317, 319, 118,
-	-193, -189, 88, 30, 83, 82, -101, -208, -211, -213,
-	-212, -214, -209, -210, 256, 257, 144, 260, 262, 263,
-	264, 265, 266, 267, 268, 269, 270, 271, 31, 188,
-	252, 253, 254, 255, 272, 273, 274, 275, 276, 277,
-	278, 279, 239, 258, 347, 240, 241, 242, 243, 244,
-	245, 247, 248, 249, 250, 251, -263, -260, 81, 83,
-	82, -215, 81, -80, 164, -260, 165, 165, 165, -55,
-	336, -235, -235, 196, -29, -26, -256, 16, -25, -26,
-	158, 102, 103, 155, 81, -224, 81, -233, -263, -260,
-	81, 29, 171, 170, -232, -229, -232, -233, -260, -129,
-	-189, -194, -260, 29, 29, -162, -189, -162, -162, 21,
-	-162, 21, -162, 21, 89, -189, -162, 21, -162, 21,
-	-162, 21, -162, 21, -162, 21, 30, 75, 76, 30,
-	78, 79, 80, -129, -129, -224, -166, -101, -260, 89,
-	89, -235, -235, 89, 88, 88, 88, -235, -235, 89,
-	88, -260, 88, -266, 182, 224, 226, 89, 89, 89,
-	89, 30, 88, -267, 30, 465, 464, 466, 467, 468,
-	89, 30, 89, 30, 89, -189, 81, -101, -83, 214,
-	153, 155, 158, 73, 88, 228, 118, 41, 82, 167,
-	164, -260, -181, 169, -181, -195, -194, -187, 88, -85,
-	-231, 12, 128, -199, -199, -179, -101, -231, -199, -179,
-	-101, -179, -179, -179, -179, -199, -199, -199, -179, -194,
-	-194, -101, -101, -101, -101, -101, -101, -101, -204, -204,
-	-204, -180, 126, -179, 73, -202, 236, 270, 437, 438,
-	439, 82, 349, -94, 443, 443, 443, 443, 443, 443,
-	-85, -85, -85, -85, -119, 98, 110, 99, 100, -112,
-	-120, -124, -127, 93, 128, 126, 127, 112, -112, -112,
+	-52, -53, -54, -4, -286, 6, 7, 8, -62, 10,
+	11, 31, -23, -33, 156, -34, -24, 157, -35, 159,
+	158, 195, 160, 188, 71, 236, 237, 239, 240, 241,
+	242, -63, 193, 194, 162, 35, 42, 32, 33, 36,
+	165, 84, 9, 341, 190, 189, 26, -285, 481, -70,
+	5, -138, 16, -3, -55, -289, -55, -55, -55, -55,
+	-55, -55, -236, -238, 84, 129, 84, -55, -39, -38,
+	-37, -36, -40, 30, -30, -31, -264, -29, -26, 161,
+	158, 202, 105, 106, 195, 196, 197, 160, 179, 194,
+	198, 193, 215, -25, 80, 32, 354, 357, -243, 157,
+	163, 164, 342, 108, 107, 72, 159, -240, 286, 458,
+	-40, 460, 98, 100, 459, 41, 168, 461, 462, 463,
+	464, 178, 465, 466, 467, 468, 474, 475, 476, 477,
+	109, 5, -55, -198, -194, -268, -188, 87, 88, 89,
+	351, 181, 385, 386, 74, 80, 286, 458, 236, 250,
+	244, 271, 263, 352, 387, 233, 182, 219, 455, 261,
+	319, 460, 388, 196, 291, 292, 293, 98, 239, 328,
+	473, 235, 389, 471, 100, 459, 79, 48, 41, 191,
+	259, 255, 461, 220, 390, 362, 213, 108, 105, 480,
+	253, 47, 28, 470, 107, 46, 462, 391, 463, 295,
+	276, 449, 45, 296, 197, 392, 83, 356, 457, 297,
+	254, 298, 230, 469, 162, 393, 441, 300, 450, 394,
+	277, 281, 395, 320, 301, 49, 396, 397, 451, 106,
+	398, 78, 464, 248, 249, 399, 228, 180, 322, 275,
+	178, 34, 302, 353, 231, 55, 203, 323, 43, 279,
+	210, 42, 445, 400, 448, 274, 270, 50, 401, 402,
+	403, 404, 465, 273, 247, 269, 479, 225, 466, 59,
+	164, 283, 282, 284, 214, 318, 266, 405, 406, 407,
+	185, 81, 408, 256, 19, 409, 410, 303, 221, 411,
+	53, 412, 413, 326, 194, 414, 51, 467, 38, 199,
+	207, 468, 415, 416, 417, 418, 419, 305, 420, 304,
+	278, 280, 205, 306, 355, 421, 252, 198, 472, 422,
+	186, 456, 75, 202, 193, 327, 187, 423, 424, 425,
+	426, 427, 234, 428, 429, 240, 474, 40, 430, 431,
+	432, 433, 229, 224, 321, 330, 58, 82, 288, 434,
+	454, 246, 222, 435, 237, 52, 475, 476, 477, 216,
+	478, 308, 109, 226, 227, 44, 267, 204, 436, 437,
+	257, 258, 272, 245, 268, 238, 442, 206, 309, 195,
+	438, 329, 223, 289, 359, 215, 310, 453, 358, 265,
+	262, 217, 439, 311, 169, 211, 212, 440, 443, 312,
+	313, 315, 316, 232, 314, 317, 218, 357, 260, 290,
+	167, -55, 167, -101, -194, 167, -166, 292, -185, 294,
+	307, 302, 312, 300, -177, 303, 305, 205, -283, 320,
+	167, 309, 156, 147, 295, 304, 313, 314, 317, 218,
+	-279, -268, 463, 478, 319, 264, 299, 297, 321, 445,
+	316, 315, -194, 238, -201, 243, -189, -268, -188, 241,
+	-101, -61, 441, 160, -203, -203, -72, 445, 447, -121,
+	-85, -107, 113, -112, 30, 24, -111, -108, -129, -126,
+	-127, 147, 148, 150, 149, 151, 136, 137, 144, 114,
+	152, -116, -114, -115, -117, 91, 90, 99, 92, 93,
+	94, 95, 101, 102, 103, -189, -194, -124, -286, 65,
+	66, 342, 343, 344, 345, 350, 346, 116, 54, 337,
+	331, 340, 339, 338, 335, 336, 333, 334, 348, 349,
+	172, 332, 166, 142, 341, -268, -188, 41, 308, 308,
+	-101, -55, -5, -4, -286, 6, 21, 22, -142, 18,
+	17, -287, 86, -64, -77, 60, 61, -79, 22, 37,
+	64, 62, 21, -56, -76, 138, -85, -194, -76, -175,
+	171, -175, -175, -165, -206, 238, -169, 321, 320, -190,
+	-167, -189, -187, -166, 318, 161, 360, 112, 23, 25,
+	115, 147, 17, 116, 36, 163, 264, 179, 146, 175,
+	342, 156, 69, 361, 333, 334, 331, 337, 344, 345,
+	332, 294, 30, 11, 363, 26, 189, 22, 37, 140,
+	158, 119, 120, 192, 24, 190, 103, 366, 20, 72,
+	184, 12, 177, 14, 367, 368, 15, 172, 171, 131,
+	168, 67, 9, 152, 27, 128, 63, 369, 29, 370,
+	371, 372, 373, 65, 129, 18, 208, 335, 336, 32,
+	446, 374, 350, 200, 142, 70, 56, 447, 113, 375,
+	376, 101, 377, 104, 76, 452, 110, 16, 68, 39,
+	378, 201, 379, 174, 209, 380, 324, 381, 130, 159,
+	341, 66, 382, 166, 307, 6, 347, 31, 188, 176,
+	64, 383, 167, 118, 348, 349, 170, 102, 5, 173,
+	33, 10, 71, 77, 338, 339, 340, 54, 354, 117,
+	13, 384, 325, 111, 319, -237, 129, -224, -228, -189,
+	183, -253, 179, -101, -246, -245, -189, -71, -184, 168,
+	177, 176, 173, -272, 110, 226, 332, 166, -36, -37,
+	-166, 146, 75, -258, 201, 85, 85, -228, -227, -226,
+	-273, 201, 183, -252, -244, 175, 184, -234, 176, 177,
+	-229, 168, 29, -273, -229, 174, 184, 179, 201, 201,
+	109, 201, 109, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 199, -235, 121, -235, 358, 358, -240, -273,
+	-273, -273, 170, 34, 34, -186, -229, 170, 23, -235,
+	-235, -166, 146, -235, -235, -235, -235, 213, 213, -235,
+	-235, -235, -235, -235, -235, -235, -235, -235, -235, -235,
+	-235, -235, -235, -235, 167, -272, -80, 317, 232, 80,
+	-38, 215, -22, -101, -184, 168, 169, -272, -101, 153,
+	-101, -179, 129, 13, -179, -176, 308, 306, 293, 298,
+	-179, -179, -179, -179, 216, 291, -230, 168, 34, 180,
+	308, 216, 291, 216, 217, 216, 217, 301, 311, 216,
+	-199, 12, 131, 332, 296, 300, 205, 167, 206, 169,
+	310, -268, 448, 217, -199, 308, 212, -179, -204, -286,
+	-190, 264, -204, -204, 31, 170, -189, -57, -189, 91,
+	-7, -3, -11, -10, -12, 121, -78, 308, -66, 147,
+	463, 449, 450, 451, 448, 305, 456, 454, 452, 216,
+	453, 85, 112, 110, 111, 128, -85, -109, 131, 113,
+	129, 130, 115, 133, 132, 143, 136, 137, 138, 139,
+	140, 141, 142, 134, 135, 146, 121, 122, 123, 124,
+	125, 126, 127, -174, -286, -127, -286, 154, 155, -112,
+	-112, -112, -112, -112, -112, -112, -112, -112, -112, -286,
+	153, -2, -121, -4, -286, -286, -286, -286, -286, -286,
+	-286, -286, -134, -85, -286, -290, -286, -290, -118, -286,
+	-290, -118, -290, -118, -290, -290, -118, -290, -118, -290,
+	-290, -118, -286, -286, -286, -286, -286, -286, -286, -203,
+	-280, -281, -104, -101, -286, 232, -138, -3, -55, -157,
+	20, 32, -85, -139, -140, -85, -138, 56, -74, -76,
+	-79, 60, 61, 97, 12, -192, -191, 23, -189, 91,
+	153, 12, -102, 27, -101, -87, -88, -89, -90, -104,
+	-128, -286, 12, -94, -95, -101, -103, -194, 85, 238,
+	-169, -206, -171, -170, 322, 324, 121, -193, -189, 91,
+	30, 86, 85, -101, -208, -211, -213, -212, -214, -209,
+	-210, 261, 262, 147, 265, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 31, 191, 257, 258, 259,
+	260, 277, 278, 279, 280, 281, 282, 283, 284, 244,
+	263, 352, 245, 246, 247, 248, 249, 250, 252, 253,
+	254, 255, 256, -271, -268, 84, 86, 85, -215, 84,
+	-80, 167, -268, 168, 168, 168, -55, 341, -235, -235,
+	199, 17, -29, -26, -264, 16, -25, -26, 161, 105,
+	106, 158, 84, -224, 84, -233, -271, -268, 84, 29,
+	174, 173, -232, -229, -232, -233, -268, -129, -189, -194,
+	-268, 29, 29, -268, -162, -189, -162, -162, 21, -162,
+	21, -162, 21, 92, -189, -162, 21, -162, 21, -162,
+	21, -162, 21, -162, 21, 30, 78, 79, 30, 81,
+	82, 83, -129, -129, -224, -166, -101, -268, 92, 92,
+	-235, -235, 92, 91, 91, 91, -235, -235, 92, 91,
+	-268, 91, -274, 185, 230, 231, 92, 92, 92, 92,
+	30, 91, -275, 30, 470, 469, 471, 472, 473, 92,
+	30, 92, 30, 92, -189, 84, -101, -83, 220, 156,
+	158, 161, 76, 91, 233, 121, 41, 85, 170, 167,
+	-268, -181, 172, -181, -195, -194, -187, 91, -85, -231,
+	12, 131, -199, -199, -179, -101, -231, -199, -179, -101,
+	-179, -179, -179, -179, -199, -199, -199, -179, -194, -194,
+	-101, -101, -101, -101, -101, -101, -101, -204, -204, -204,
+	-180, 129, -179, 76, -202, 241, 275, 442, 443, 444,
+	85, 354, -94, 448, 448, 448, 448, 448, 448, -85,
+	-85, -85, -85, -119, 101, 113, 102, 103, -112, -120,
+	-124, -127, 96, 131, 129, 130, 115, -112, -112, -112,
 	-112, -112, -112, -112, -112, -112, -112, -112, -112, -112,
-	-112, -112, -112, -205, -260, 88, 144, -260, -111, -111,
-	-189, -75, 22, 37, -74, -190, -195, -187, -70, -279,
-	-279, -138, -74, -74, -85, -85, -129, 88, -74, -129,
-	88, -74, -74, -69, 22, 37, -132, -133, 114, -129,
-	-279, -112, -189, -189, -74, -75, -75, -74, -74, 82,
-	-274, 319, 320, 441, -197, 199, -196, 23, -194, 88,
-	-122, -121, 88, -142, -279, -143, 27, 10, 128, 82,
-	19, 82, -141, 25, 26, -142, -113, -189, 89, 92,
-	-86, 82, 12, -79, -101, -191, 135, -195, -101, -161,
-	199, -101, 31, 82, -97, -99, -98, -100, 63, 67,
-	69, 64, 65, 66, 70, -200, 23, -87, -3, -278,
-	-101, -94, -280, 82, 12, 74, -280, 82, 150, -169,
-	-171, 82, 318, 320, 321, 73, 101, -85, -217, 143,
-	-242, -241, -240, -224, -226, -227, -228, 83, -144, -220,
-	284, -215, -215, -215, -215, -215, -216, -166, -216, -216,
-	-216, 81, 81, -215, -215, -215, -215, -218, 81, -218,
-	-218, -219, 81, -219, -253, -85, -250, -249, -247, -248,
-	175, 95, 349, 74, -245, -141, 89, -83, -182, 169,
-	-251, -248, -260, -260, -260, -182, -260, 88, -260, 88,
-	82, 17, -225, -224, -130, 224, -255, 199, -252, -246,
-	81, 29, -232, -233, -233, 150, -260, 82, 27, 106,
-	106, 106, 106, 349, 155, 31, -224, -130, -205, 167,
-	-205, -205, 88, 88, -178, 473, -94, -82, 216, 118,
-	205, 205, 164, 164, 218, -101, 229, 230, 228, 21,
-	217, 219, 221, 207, -101, -101, -181, 73, -96, -101,
-	24, -194, -101, -179, -179, -101, -179, -179, 88, -101,
-	-189, -66, 319, 349, 20, -67, 20, 98, 99, 100,
-	-120, -112, -112, -112, -73, 189, 109, -279, -279, -74,
-	-74, -278, 150, -5, -142, -279, -279, 82, 74, 23,
-	12, 12, -279, 12, 12, -279, -279, -74, -135, -133,
-	116, -85, -279, -279, 82, 82, -279, -279, -279, -279,
-	-279, -273, 440, 320, -105, 71, 168, 72, -278, -196,
-	-279, -157, 39, 47, 58, -85, -85, -140, -157, -173,
-	20, 12, 54, 54, -106, 13, -76, -87, -79, 150,
-	-106, -110, 31, 54, -3, -278, -278, -164, -168, -129,
-	-88, -89, -89, -88, -89, 63, 63, 63, 68, 63,
-	68, 63, -98, -194, -279, -279, -3, -161, 74, -87,
-	-101, -87, -103, -194, 135, -170, -172, 322, 319, 325,
-	-260, 88, 82, -240, -228, 98, 110, 30, 73, 281,
-	95, 171, 29, 170, -221, 285, -216, -216, -217, -260,
-	88, 144, -217, -217, -217, -223, 88, -223, 89, 89,
-	83, -32, -27, -28, 32, 77, -247, -235, 88, 38,
-	-189, 83, -82, -101, 110, 73, -251, -251, -251, -194,
-	16, -159, -189, 82, 83, -131, 225, -129, 83, -189,
-	83, -159, -233, -190, -189, -278, 164, 30, 30, -130,
-	-131, -217, -260, 475, 474, 83, 166, 223, -84, 332,
-	88, 84, -101, -101, -101, -101, -101, 158, 155, 208,
-	167, -94, -101, 82, -60, 184, 179, -199, -199, 32,
-	319, 452, 450, -73, 109, -112, -112, -279, -279, -75,
-	-190, -138, -157, -207, 144, 256, 188, 254, 250, 270,
-	261, 283, 252, 284, -205, -207, -112, -112, -112, -112,
-	346, -138, 117, -85, 115, -112, -112, 165, 165, 165,
-	-162, 40, 88, 88, 59, -101, -136, 14, -85, 135,
-	-142, -163, 73, -164, -123, -125, -124, -278, -158, -279,
-	-189, -162, -106, 82, 118, -92, -91, 73, 74, -93,
-	73, -91, 63, 63, -279, -106, -87, -106, -106, 150,
-	319, 323, 324, -240, 98, -112, 10, 88, 29, 29,
-	-217, -217, 83, 82, 83, 82, 83, 82, -183, 386,
-	110, -28, -27, -235, -235, 89, -260, 166, 24, -101,
-	73, 73, 73, 17, 82, -224, -129, 54, -250, 83,
-	-254, -255, -101, -111, -131, -101, -81, 214, 222, 81,
-	85, -262, 74, 205, 281, 205, -101, -60, -32, -101,
-	-179, -179, 32, -260, -112, -279, -142, -279, -215, -215,
-	-215, -219, -215, 244, -215, 244, -279, -279, 20, 20,
-	20, 20, -278, -65, 342, -85, 82, 82, -278, -278,
-	-278, -279, 88, -216, -137, 15, 17, 28, -163, 82,
-	-279, -279, 82, 54, 150, -279, -138, -168, -85, -85,
-	81, -85, -138, -106, -115, -216, 88, -216, 89, 89,
-	386, 30, 78, 79, 80, 30, 75, 76, -101, -101,
-	-101, -101, -159, -189, 201, 183, -279, 82, -222, 349,
-	352, -160, 81, 83, -259, 349, -261, -260, -189, -189,
-	-189, -157, -216, -260, -112, -112, -112, -112, -112, -142,
-	88, -112, -112, -159, -279, -159, -159, -197, -216, -146,
+	-112, -112, -205, -268, 91, 147, -268, -111, -111, -189,
+	-75, 22, 37, -74, -190, -195, -187, -70, -287, -287,
+	-138, -74, -74, -85, -85, -129, 91, -74, -129, 91,
+	-74, -74, -69, 22, 37, -132, -133, 117, -129, -287,
+	-112, -189, -189, -74, -75, -75, -74, -74, 85, -282,
+	324, 325, 446, -197, 201, -196, 23, -194, 91, -122,
+	-121, 91, -142, -287, -143, 27, 10, 131, 85, 19,
+	85, -141, 25, 26, -142, -113, -189, 92, 95, -86,
+	85, 12, -79, -101, -191, 138, -195, -101, -161, 201,
+	-101, 31, 85, -97, -99, -98, -100, 63, 67, 69,
+	64, 65, 66, 70, -200, 23, -87, -3, -286, -101,
+	-94, -288, 85, 12, 77, -288, 85, 153, -169, -171,
+	85, 323, 325, 326, 76, 104, -85, -217, 146, -242,
+	-241, -240, -224, -226, -227, -228, 86, -144, -220, 289,
+	-215, -215, -215, -215, -215, -216, -166, -216, -216, -216,
+	84, 84, -215, -215, -215, -215, -218, 84, -218, -218,
+	-219, 84, -219, -253, -85, -250, -249, -247, -248, 178,
+	98, 354, 77, -245, -141, 92, -83, -182, 172, -251,
+	-248, -268, -268, -268, -182, -268, 91, -268, 91, -259,
+	-260, 209, 210, 208, 85, 17, -225, -224, -130, 230,
+	-256, 201, -252, -246, 84, 29, -232, -233, -233, 153,
+	-268, 85, 27, 109, 109, 109, 109, 354, 158, 31,
+	-224, -130, -205, 170, -205, -205, 91, 91, -178, 478,
+	-94, -82, 222, 121, 211, 211, 167, 167, 224, -101,
+	234, 235, 87, 233, 21, 223, 225, 227, 213, -101,
+	-101, -181, 76, -96, -101, 24, -194, -101, -179, -179,
+	-101, -179, -179, 91, -101, -189, -66, 324, 354, 20,
+	-67, 20, 101, 102, 103, -120, -112, -112, -112, -73,
+	192, 112, -287, -287, -74, -74, -286, 153, -5, -142,
+	-287, -287, 85, 77, 23, 12, 12, -287, 12, 12,
+	-287, -287, -74, -135, -133, 119, -85, -287, -287, 85,
+	85, -287, -287, -287, -287, -287, -281, 445, 325, -105,
+	71, 171, 72, -286, -196, -287, -157, 39, 47, 58,
+	-85, -85, -140, -157, -173, 20, 12, 54, 54, -106,
+	13, -76, -87, -79, 153, -106, -110, 31, 54, -3,
+	-286, -286, -164, -168, -129, -88, -89, -89, -88, -89,
+	63, 63, 63, 68, 63, 68, 63, -98, -194, -287,
+	-287, -3, -161, 77, -87, -101, -87, -103, -194, 138,
+	-170, -172, 327, 324, 330, -268, 91, -257, -258, 85,
+	-240, -228, 101, 113, 30, 76, 286, 98, 174, 29,
+	173, -221, 290, -216, -216, -217, -268, 91, 147, -217,
+	-217, -217, -223, 91, -223, 92, 92, 86, -32, -27,
+	-28, 32, 80, -247, -235, 91, 38, -189, 86, -82,
+	-101, 113, 76, -251, -251, -251, -194, -261, 207, 87,
+	29, -286, 293, -286, 293, 16, -159, -189, 85, 86,
+	-131, 74, -129, 86, -189, 86, -159, -233, -190, -189,
+	-286, 167, 30, 30, -130, -131, -217, -268, 480, 479,
+	86, 169, 229, -84, 337, 91, 87, -101, -101, -101,
+	-101, -101, 161, 158, 92, 137, 214, 170, -94, -101,
+	85, -60, 187, 182, -199, -199, 32, 324, 457, 455,
+	-73, 112, -112, -112, -287, -287, -75, -190, -138, -157,
+	-207, 147, 261, 191, 259, 255, 275, 266, 288, 257,
+	289, -205, -207, -112, -112, -112, -112, 351, -138, 120,
+	-85, 118, -112, -112, 168, 168, 168, -162, 40, 91,
+	91, 59, -101, -136, 14, -85, 138, -142, -163, 76,
+	-164, -123, -125, -124, -286, -158, -287, -189, -162, -106,
+	85, 121, -92, -91, 76, 77, -93, 76, -91, 63,
+	63, -287, -106, -87, -106, -106, 153, 324, 328, 329,
+	-240, 101, -112, 10, 91, 29, 29, -217, -217, 86,
+	85, 86, 85, 86, 85, -183, 391, 113, -28, -27,
+	-235, -235, 92, -268, 169, 24, -101, 76, 76, 76,
+	-255, -286, 92, -286, -262, 80, -112, -286, -112, -286,
+	17, 85, -224, -129, 54, -263, 463, -250, 86, -254,
+	-256, -101, -111, -131, -101, -81, 220, 228, 84, 88,
+	-270, 77, 211, 286, 211, 92, -101, -60, -32, -101,
+	-179, -179, 32, -268, -112, -287, -142, -287, -215, -215,
+	-215, -219, -215, 249, -215, 249, -287, -287, 20, 20,
+	20, 20, -286, -65, 347, -85, 85, 85, -286, -286,
+	-286, -287, 91, -216, -137, 15, 17, 28, -163, 85,
+	-287, -287, 85, 54, 153, -287, -138, -168, -85, -85,
+	84, -85, -138, -106, -115, -216, 91, -216, 92, 92,
+	391, 30, 81, 82, 83, 30, 78, 79, -101, -101,
+	-101, -101, -254, -112, -286, 121, -287, -159, -287, -159,
+	-159, -189, 203, 131, -235, 186, -287, 85, -222, 354,
+	357, -160, 84, 86, -267, 354, -269, -268, -189, -189,
+	-189, -157, -216, -268, -112, -112, -112, -112, -112, -142,
+	91, -112, -112, -159, -287, -159, -159, -197, -216, -146,
 	-151, -176, -85, -121, 29, -125, 54, -3, -189, -123,
-	-189, -142, -159, -142, -217, -217, 83, 83, -160, 202,
-	-101, -255, 353, 353, 23, -159, -258, -257, -190, 81,
-	74, -279, -279, -279, -279, -68, 128, 349, -279, -279,
-	-279, -279, -279, -279, -105, -149, 436, -154, 43, -152,
-	-153, 44, -150, 45, 53, 10, -123, 150, 83, 23,
-	-278, 81, -3, 83, 82, 118, -159, -101, -279, 347,
-	70, 350, -146, 48, 262, -156, -155, 52, 44, -153,
-	17, 46, 17, -164, -189, -3, -112, 198, -159, -58,
-	349, -257, -239, -190, 88, 89, 83, 59, 348, 351,
-	-147, 50, -145, 49, -145, -155, 17, 17, 88, 17,
-	88, -58, -279, -279, 83, -59, 213, 440, -262, 59,
-	-148, 51, 73, 101, 88, 88, 88, -269, -270, 73,
-	176, -259, 349, 73, 101, -270, 73, 11, 10, 215,
-	350, -268, 184, 179, 182, 31, -268, 351, 178, 30,
-	98,
+	-189, -142, -159, -142, -217, -217, 86, 86, -160, -287,
+	-287, -159, -287, 92, -287, -287, 204, -124, -286, -268,
+	-101, -256, 358, 358, 23, -159, -266, -265, -190, 84,
+	77, -287, -287, -287, -287, -68, 131, 354, -287, -287,
+	-287, -287, -287, -287, -105, -149, 441, -154, 43, -152,
+	-153, 44, -150, 45, 53, 10, -123, 153, 86, 23,
+	-287, -124, -286, -263, 84, -3, 86, 85, 121, -159,
+	-101, -287, 352, 70, 355, -146, 48, 267, -156, -155,
+	52, 44, -153, 17, 46, 17, -164, -189, -3, -263,
+	200, -159, -58, 354, -265, -239, -190, 91, 92, 86,
+	59, 353, 356, -147, 50, -145, 49, -145, -155, 17,
+	17, 91, 17, 91, -58, -287, 86, -59, 219, 445,
+	-270, 59, -148, 51, 76, 104, 91, 91, 91, -263,
+	-277, -278, 76, 179, -267, 354, 76, 104, -278, 76,
+	11, 10, 221, 355, -276, 187, 182, 185, 31, -276,
+	356, 181, 30, 101,
 }
 
 var yyDef = [...]int{
 	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 823, 0, 559, 559, 559, 559, 559,
-	559, 559, 0, 0, 559, -2, -2, 559, 959, 0,
-	559, 0, 0, -2, 492, 493, 0, 495, -2, 0,
-	0, 504, 1370, 1370, 554, 0, 0, 0, 0, 0,
-	559, 1368, 55, 56, 510, 511, 512, 1, 3, 0,
-	563, 831, 0, 0, -2, 561, 0, 0, 942, 942,
-	942, 0, 86, 87, 0, 0, 0, -2, 90, -2,
-	114, 115, 0, 119, 368, 329, 371, 327, 357, -2,
+	31, 32, 33, 850, 0, 586, 586, 586, 586, 586,
+	586, 586, 0, 0, 586, -2, -2, 586, 986, 0,
+	586, 0, 0, -2, 519, 520, 0, 522, -2, 0,
+	0, 531, 1401, 1401, 581, 0, 0, 0, 0, 0,
+	586, 1399, 55, 56, 537, 538, 539, 1, 3, 0,
+	590, 858, 0, 0, -2, 588, 0, 0, 969, 969,
+	969, 0, 86, 87, 0, 0, 0, -2, 90, -2,
+	114, 115, 0, 119, 370, 330, 373, 328, 359, -2,
 	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 224, 224, 0, 0, -2, 320,
-	320, 320, 0, 0, 0, 354, 944, 274, 224, 224,
+	0, 0, 0, 333, 224, 224, 0, 0, -2, 320,
+	320, 320, 0, 0, 0, 356, 971, 274, 224, 224,
 	0, 224, 224, 224, 224, 0, 0, 224, 224, 224,
 	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 847, 118, 960, 957, 958, 35, 36, 37,
-	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109,
-	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119,
-	1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
-	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
-	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
-	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
-	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
-	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
-	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
-	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
-	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
-	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
-	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
-	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
-	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
-	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
-	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
-	1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289,
-	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
-	1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309,
-	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
-	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
-	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
-	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349,
-	1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359,
-	1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 0, 936,
-	0, 424, 644, 0, 483, 483, 0, 483, 483, 483,
-	483, 0, 0, 0, 436, 0, 0, 0, 0, 480,
-	0, 0, 455, 457, 0, 480, 0, 467, 483, 1371,
-	1371, 1371, 927, 0, 477, 475, 489, 490, 472, 473,
-	491, 494, 0, 499, 502, 953, 954, 0, 517, 0,
-	1176, 509, 522, 523, 0, 555, 556, 40, 695, 654,
-	0, 660, 662, 0, 697, 698, 699, 700, 701, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 727,
-	728, 729, 730, 808, 809, 810, 811, 812, 813, 814,
-	815, 664, 665, 805, 0, 916, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 796, 0, 765, 765, 765,
-	765, 765, 765, 765, 765, 765, 0, 0, 0, 0,
-	0, 0, 0, -2, -2, 1370, 0, 532, 0, 0,
-	823, 51, 0, 559, 564, 565, 866, 0, 0, 823,
-	1369, 0, 0, -2, -2, 575, 581, 582, 583, 584,
-	585, 560, 0, 588, 592, 0, 0, 0, 943, 0,
-	0, 72, 0, 1333, 920, -2, -2, 0, 0, 955,
-	956, 929, -2, 963, 964, 965, 966, 967, 968, 969,
-	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
-	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
-	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
-	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
-	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
-	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
-	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
-	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
-	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
-	-2, 0, 0, 128, 129, 0, 38, 250, 0, 124,
-	0, 244, 197, 847, 0, 0, 0, 0, 0, 559,
-	0, 937, 109, 110, 116, 117, 224, 224, 0, 118,
-	118, 336, 337, 338, 0, 0, -2, 248, 0, 321,
-	0, 0, 238, 238, 242, 240, 241, 0, 0, 0,
-	0, 0, 0, 348, 0, 349, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 408, 0, 225, 0, 366,
-	367, 275, 0, 0, 0, 0, 346, 347, 0, 0,
-	945, 946, 0, 0, 224, 224, 0, 0, 0, 0,
-	224, 224, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 108, 860,
-	0, 0, 0, -2, 0, 416, 0, 0, 0, 938,
-	938, 423, 0, 425, 426, 0, 0, 427, 0, 480,
-	480, 478, 479, 429, 430, 431, 432, 483, 0, 0,
-	233, 234, 235, 480, 483, 0, 483, 483, 483, 483,
-	480, 480, 480, 483, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1371, 1371, 1371, 486, 483, 464, 465,
-	468, 469, 1372, 1373, 974, 470, 471, 928, 500, 503,
-	520, 518, 519, 521, 513, 514, 515, 516, 0, 534,
-	535, 540, 0, 0, 0, 0, 546, 547, 548, 0,
-	0, 551, 552, 553, 0, 0, 0, 0, 0, 658,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
-	683, 684, 685, 686, 687, 688, 661, 0, 675, 0,
-	0, 0, 717, 718, 719, 720, 721, 722, 723, 724,
-	725, 0, 572, 0, 0, 0, 823, 0, 0, 0,
-	0, 0, 0, 0, 569, 0, 797, 0, 748, 0,
-	749, 757, 0, 750, 758, 751, 759, 752, 753, 760,
-	754, 761, 755, 756, 762, 0, 0, 0, 572, 572,
-	0, 0, 41, 524, 525, 0, 627, 948, 0, 831,
-	0, 574, 869, 0, 0, 832, 824, 825, 828, 831,
-	0, 597, 586, 576, 579, 580, 562, 0, 589, 593,
-	0, 595, 596, 0, 0, 70, 0, 643, 0, 599,
-	601, 602, 603, 625, 0, 0, 0, 0, 66, 68,
-	644, 0, 1333, 926, 0, 74, 75, 0, 0, 0,
-	212, 931, 932, 933, -2, 231, 0, 136, 204, 148,
-	149, 150, 197, 152, 197, 197, 197, 197, 208, 208,
-	208, 208, 180, 181, 182, 183, 184, 0, 0, 167,
-	197, 197, 197, 197, 187, 188, 189, 190, 191, 192,
-	193, 194, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 199, 199, 199, 201, 201, 0, 39, 0, 216,
-	0, 828, 0, 860, 940, 950, 0, 0, 0, 940,
-	92, 0, 0, 369, 330, 358, 370, 0, 333, 334,
-	-2, 0, 0, 320, 0, 322, 0, 232, 0, -2,
-	0, 0, 0, 238, 242, 239, 242, 230, 243, 350,
-	805, 0, 351, 352, 0, 388, 613, 0, 0, 0,
-	0, 0, 394, 395, 396, 0, 398, 399, 400, 401,
-	402, 403, 404, 405, 406, 407, 359, 360, 361, 362,
-	363, 364, 365, 0, 0, 322, 0, 355, 0, 276,
-	277, 0, 0, 280, 281, 282, 283, 0, 0, 286,
-	287, 288, 289, 290, 314, 315, 316, 291, 292, 293,
-	294, 295, 296, 297, 308, 309, 310, 311, 312, 313,
-	298, 299, 300, 301, 302, 305, 0, 102, 851, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	938, 0, 0, 0, 0, 645, 961, 962, 484, 485,
-	0, 236, 237, 483, 483, 433, 456, 0, 483, 437,
-	458, 438, 440, 439, 441, 460, 461, 483, 444, 481,
-	482, 445, 446, 447, 448, 449, 450, 451, 452, 453,
-	454, 462, 0, 463, 0, 0, 501, 505, 506, 507,
-	508, 0, 0, 537, 542, 543, 544, 545, 557, 550,
-	696, 655, 656, 657, 659, 676, 0, 678, 680, 666,
-	667, 691, 692, 693, 0, 0, 0, 0, 689, 671,
-	0, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 716, 780, 781, 782, 0, 714, 715,
-	726, 0, 0, 0, 573, 806, 0, -2, 0, 694,
-	915, 831, 0, 0, 0, 0, 699, 808, 0, 699,
-	808, 0, 0, 0, 570, 571, 803, 800, 0, 0,
-	766, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	527, 528, 530, 0, 647, 0, 628, 0, 630, 631,
-	0, 949, 533, 866, 52, 42, 0, 867, 0, 0,
-	0, 0, 827, 829, 830, 866, 0, 816, 0, 0,
-	652, 0, 0, 577, 48, 594, 590, 0, 652, 0,
-	0, 642, 0, 0, 0, 0, 0, 0, 632, 0,
-	0, 635, 0, 0, 0, 0, 626, 0, 0, 0,
-	-2, 0, 0, 0, 62, 63, 0, 0, 0, 921,
-	73, 0, 0, 78, 79, 922, 923, 924, 925, 0,
-	111, -2, 271, 130, 132, 133, 134, 125, 135, 206,
-	205, 151, 208, 208, 174, 175, 212, 0, 212, 212,
-	212, 0, 0, 168, 169, 170, 171, 162, 0, 163,
-	164, 165, 0, 166, 249, 0, 835, 217, 218, 220,
-	224, 0, 0, 0, 245, 246, 0, 851, 0, 0,
-	0, 951, 950, 950, 950, 0, 120, 121, 122, 123,
-	118, 0, 0, 126, 324, 0, 0, 0, 247, 0,
-	0, 226, 242, 227, 228, 0, 353, 0, 0, 390,
-	391, 392, 393, 0, 0, 0, 322, 324, 212, 0,
-	278, 279, 284, 285, 303, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 383, 384, 385, 386,
-	848, 849, 850, 0, 0, 417, 0, 0, 263, 64,
-	939, 422, 480, 443, 459, 480, 435, 442, 487, 466,
-	497, 541, 0, 0, 0, 549, 0, 677, 679, 681,
-	668, 689, 672, 0, 669, 0, 0, 663, 731, 0,
-	0, 572, 0, 823, 866, 735, 736, 0, 0, 0,
-	0, 0, 773, 0, 0, 774, 0, 823, 0, 801,
-	0, 0, 747, 767, 0, 0, 768, 769, 770, 771,
-	772, 526, 529, 531, 607, 0, 0, 0, 0, 629,
-	947, 44, 0, 0, 0, 833, 834, 826, 43, 0,
-	934, 935, 817, 818, 819, 0, 587, 598, 578, 0,
-	831, 909, 0, 0, 901, 0, 0, 652, 917, 0,
-	600, 621, 623, 0, 618, 633, 634, 636, 0, 638,
-	0, 640, 641, 604, 605, 606, 0, 652, 0, 652,
-	67, 652, 69, 0, 646, 76, 77, 0, 0, 83,
-	213, 214, 118, 273, 131, 137, 0, 0, 0, 141,
-	0, 0, 144, 146, 147, 207, 212, 212, 176, 209,
-	210, 211, 177, 178, 179, 0, 195, 0, 0, 0,
-	266, 88, 839, 838, 224, 224, 219, 0, 222, 0,
-	952, 198, 0, 101, 0, 0, 0, 0, 0, 107,
-	0, 328, 611, 0, 339, 340, 0, 323, 387, 0,
-	216, 0, 229, 806, 614, 0, 0, 341, 0, 324,
-	344, 345, 356, 306, 307, 304, 0, 0, 861, 862,
-	0, 865, 93, 376, 378, 377, 381, 0, 0, 374,
-	0, 263, 835, 0, 421, 264, 265, 483, 483, 536,
-	0, 539, 0, 670, 0, 690, 673, 732, 733, 0,
-	807, 831, 46, 0, 197, 197, 786, 197, 201, 789,
-	197, 791, 197, 794, 0, 0, 0, 0, 0, 0,
-	0, 798, 746, 804, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 871, 868, 45, 821, 0, 653, 591,
-	49, 53, 0, 909, 900, 911, 913, 0, 0, 0,
-	905, 0, 823, 0, 0, 615, 622, 0, 0, 616,
-	0, 617, 637, 639, -2, 823, 652, 60, 61, 0,
-	80, 81, 82, 272, 138, 139, 0, 142, 143, 145,
-	172, 173, 208, 0, 208, 0, 202, 0, 255, 267,
-	0, 836, 837, 0, 0, 221, 223, 0, 941, 103,
-	0, 0, 0, 0, 0, 127, 325, 0, 215, 0,
-	0, 412, 409, 342, 343, 609, 852, 853, 854, 0,
-	864, 96, 0, 0, 0, 0, 418, 419, 420, 65,
-	428, 434, 538, 558, 674, 734, 866, 737, 783, 208,
-	787, 788, 790, 792, 793, 795, 739, 738, 0, 0,
-	0, 0, 0, 831, 0, 802, 0, 0, 0, 0,
-	0, 627, 208, 891, 50, 0, 0, 0, 54, 0,
-	914, 0, 0, 0, 0, 71, 831, 918, 919, 619,
-	0, 624, 831, 59, 140, 212, 196, 212, 0, 0,
-	268, 840, 841, 842, 843, 844, 845, 846, 609, 104,
-	105, 106, 331, 612, 0, 0, 389, 0, 397, 0,
-	0, 0, 0, 863, 375, 0, 94, 95, 0, 0,
-	380, 47, 784, 785, 0, 0, 0, 0, 775, 0,
-	799, 0, 0, 0, 649, 0, 0, 647, 873, 872,
-	885, 898, 822, 820, 0, 912, 0, 904, 907, 903,
-	906, 57, 0, 58, 185, 186, 200, 203, 0, 0,
-	0, 413, 410, 411, 0, 0, 97, 98, 0, 0,
-	0, 740, 742, 741, 743, 0, 0, 0, 745, 763,
-	764, 648, 650, 651, 608, 891, 0, 884, 0, -2,
-	893, 0, 0, 0, 899, 0, 902, 0, 620, 0,
-	0, 0, 855, 610, 0, 0, 0, 382, 744, 0,
-	0, 0, 878, 876, 876, 886, 887, 0, 0, 894,
-	0, 0, 0, 910, 908, 855, 0, 0, 0, 372,
-	857, 99, 100, 317, 318, 319, 93, 776, 0, 779,
-	881, 0, 874, 877, 875, 888, 0, 0, 895, 0,
-	897, 89, 414, 415, 251, 0, 858, 859, 96, 777,
-	870, 0, 879, 880, 889, 890, 896, 252, 253, 0,
-	0, 379, 0, 882, 883, 254, 0, 0, 0, 856,
-	0, 256, 258, 259, 0, 0, 257, 778, 260, 261,
-	262,
+	224, 224, 874, 118, 987, 984, 985, 35, 36, 37,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
+	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
+	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
+	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
+	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208,
+	1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
+	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
+	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
+	1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248,
+	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
+	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
+	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
+	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
+	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
+	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
+	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318,
+	1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328,
+	1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
+	1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348,
+	1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358,
+	1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
+	1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378,
+	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
+	1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
+	0, 963, 0, 451, 671, 0, 510, 510, 0, 510,
+	510, 510, 510, 0, 0, 0, 463, 0, 0, 0,
+	0, 507, 0, 0, 482, 484, 0, 507, 0, 494,
+	510, 1402, 1402, 1402, 954, 0, 504, 502, 516, 517,
+	499, 500, 518, 521, 0, 526, 529, 980, 981, 0,
+	544, 0, 1205, 536, 549, 550, 0, 582, 583, 40,
+	722, 681, 0, 687, 689, 0, 724, 725, 726, 727,
+	728, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 754, 755, 756, 757, 835, 836, 837, 838, 839,
+	840, 841, 842, 691, 692, 832, 0, 943, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 823, 0, 792,
+	792, 792, 792, 792, 792, 792, 792, 792, 0, 0,
+	0, 0, 0, 0, 0, -2, -2, 1401, 0, 559,
+	0, 0, 850, 51, 0, 586, 591, 592, 893, 0,
+	0, 850, 1400, 0, 0, -2, -2, 602, 608, 609,
+	610, 611, 612, 587, 0, 615, 619, 0, 0, 0,
+	970, 0, 0, 72, 0, 1364, 947, -2, -2, 0,
+	0, 982, 983, 956, -2, 990, 991, 992, 993, 994,
+	995, 996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004,
+	1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014,
+	1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024,
+	1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034,
+	1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044,
+	1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
+	1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074,
+	1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084,
+	1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114,
+	1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124,
+	1125, 1126, 1127, 1128, -2, 0, 0, 128, 129, 0,
+	38, 250, 0, 124, 0, 244, 197, 874, 0, 0,
+	0, 0, 0, 586, 0, 964, 109, 110, 116, 117,
+	224, 224, 0, 374, 0, 118, 118, 337, 338, 339,
+	0, 0, -2, 248, 0, 321, 0, 0, 238, 238,
+	242, 240, 241, 0, 0, 0, 0, 0, 0, 0,
+	349, 0, 350, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 413, 0, 225, 0, 368, 369, 275, 0,
+	0, 0, 0, 347, 348, 0, 0, 972, 973, 0,
+	0, 224, 224, 0, 0, 0, 0, 224, 224, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 887, 0, 0, 0,
+	-2, 0, 443, 0, 0, 0, 965, 965, 450, 0,
+	452, 453, 0, 0, 454, 0, 507, 507, 505, 506,
+	456, 457, 458, 459, 510, 0, 0, 233, 234, 235,
+	507, 510, 0, 510, 510, 510, 510, 507, 507, 507,
+	510, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1402, 1402, 1402, 513, 510, 491, 492, 495, 496, 1403,
+	1404, 1001, 497, 498, 955, 527, 530, 547, 545, 546,
+	548, 540, 541, 542, 543, 0, 561, 562, 567, 0,
+	0, 0, 0, 573, 574, 575, 0, 0, 578, 579,
+	580, 0, 0, 0, 0, 0, 685, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 709, 710, 711, 712,
+	713, 714, 715, 688, 0, 702, 0, 0, 0, 744,
+	745, 746, 747, 748, 749, 750, 751, 752, 0, 599,
+	0, 0, 0, 850, 0, 0, 0, 0, 0, 0,
+	0, 596, 0, 824, 0, 775, 0, 776, 784, 0,
+	777, 785, 778, 786, 779, 780, 787, 781, 788, 782,
+	783, 789, 0, 0, 0, 599, 599, 0, 0, 41,
+	551, 552, 0, 654, 975, 0, 858, 0, 601, 896,
+	0, 0, 859, 851, 852, 855, 858, 0, 624, 613,
+	603, 606, 607, 589, 0, 616, 620, 0, 622, 623,
+	0, 0, 70, 0, 670, 0, 626, 628, 629, 630,
+	652, 0, 0, 0, 0, 66, 68, 671, 0, 1364,
+	953, 0, 74, 75, 0, 0, 0, 212, 958, 959,
+	960, -2, 231, 0, 136, 204, 148, 149, 150, 197,
+	152, 197, 197, 197, 197, 208, 208, 208, 208, 180,
+	181, 182, 183, 184, 0, 0, 167, 197, 197, 197,
+	197, 187, 188, 189, 190, 191, 192, 193, 194, 153,
+	154, 155, 156, 157, 158, 159, 160, 161, 199, 199,
+	199, 201, 201, 0, 39, 0, 216, 0, 855, 0,
+	887, 967, 977, 0, 0, 0, 967, 92, 0, 0,
+	371, 435, 331, 360, 372, 0, 334, 335, -2, 0,
+	0, 320, 0, 322, 0, 232, 0, -2, 0, 0,
+	0, 238, 242, 239, 242, 230, 243, 351, 832, 0,
+	352, 353, 0, 355, 393, 640, 0, 0, 0, 0,
+	0, 399, 400, 401, 0, 403, 404, 405, 406, 407,
+	408, 409, 410, 411, 412, 361, 362, 363, 364, 365,
+	366, 367, 0, 0, 322, 0, 357, 0, 276, 277,
+	0, 0, 280, 281, 282, 283, 0, 0, 286, 287,
+	288, 289, 290, 314, 315, 316, 291, 292, 293, 294,
+	295, 296, 297, 308, 309, 310, 311, 312, 313, 298,
+	299, 300, 301, 302, 305, 0, 102, 878, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 965,
+	0, 0, 0, 0, 672, 988, 989, 511, 512, 0,
+	236, 237, 510, 510, 460, 483, 0, 510, 464, 485,
+	465, 467, 466, 468, 487, 488, 510, 471, 508, 509,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	489, 0, 490, 0, 0, 528, 532, 533, 534, 535,
+	0, 0, 564, 569, 570, 571, 572, 584, 577, 723,
+	682, 683, 684, 686, 703, 0, 705, 707, 693, 694,
+	718, 719, 720, 0, 0, 0, 0, 716, 698, 0,
+	729, 730, 731, 732, 733, 734, 735, 736, 737, 738,
+	739, 740, 743, 807, 808, 809, 0, 741, 742, 753,
+	0, 0, 0, 600, 833, 0, -2, 0, 721, 942,
+	858, 0, 0, 0, 0, 726, 835, 0, 726, 835,
+	0, 0, 0, 597, 598, 830, 827, 0, 0, 793,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 554,
+	555, 557, 0, 674, 0, 655, 0, 657, 658, 0,
+	976, 560, 893, 52, 42, 0, 894, 0, 0, 0,
+	0, 854, 856, 857, 893, 0, 843, 0, 0, 679,
+	0, 0, 604, 48, 621, 617, 0, 679, 0, 0,
+	669, 0, 0, 0, 0, 0, 0, 659, 0, 0,
+	662, 0, 0, 0, 0, 653, 0, 0, 0, -2,
+	0, 0, 0, 62, 63, 0, 0, 0, 948, 73,
+	0, 0, 78, 79, 949, 950, 951, 952, 0, 425,
+	-2, 271, 130, 132, 133, 134, 125, 135, 206, 205,
+	151, 208, 208, 174, 175, 212, 0, 212, 212, 212,
+	0, 0, 168, 169, 170, 171, 162, 0, 163, 164,
+	165, 0, 166, 249, 0, 862, 217, 218, 220, 224,
+	0, 0, 0, 245, 246, 0, 878, 0, 0, 0,
+	978, 977, 977, 977, 0, 120, 121, 122, 123, 439,
+	0, 0, 0, 436, 118, 0, 0, 126, 325, 323,
+	0, 0, 247, 0, 0, 226, 242, 227, 228, 0,
+	354, 0, 0, 395, 396, 397, 398, 0, 0, 0,
+	322, 325, 212, 0, 278, 279, 284, 285, 303, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	386, 387, 0, 390, 391, 875, 876, 877, 0, 0,
+	444, 0, 0, 263, 64, 966, 449, 507, 470, 486,
+	507, 462, 469, 514, 493, 524, 568, 0, 0, 0,
+	576, 0, 704, 706, 708, 695, 716, 699, 0, 696,
+	0, 0, 690, 758, 0, 0, 599, 0, 850, 893,
+	762, 763, 0, 0, 0, 0, 0, 800, 0, 0,
+	801, 0, 850, 0, 828, 0, 0, 774, 794, 0,
+	0, 795, 796, 797, 798, 799, 553, 556, 558, 634,
+	0, 0, 0, 0, 656, 974, 44, 0, 0, 0,
+	860, 861, 853, 43, 0, 961, 962, 844, 845, 846,
+	0, 614, 625, 605, 0, 858, 936, 0, 0, 928,
+	0, 0, 679, 944, 0, 627, 648, 650, 0, 645,
+	660, 661, 663, 0, 665, 0, 667, 668, 631, 632,
+	633, 0, 679, 0, 679, 67, 679, 69, 0, 673,
+	76, 77, 0, 0, 83, 213, 214, 111, 426, 118,
+	273, 131, 137, 0, 0, 0, 141, 0, 0, 144,
+	146, 147, 207, 212, 212, 176, 209, 210, 211, 177,
+	178, 179, 0, 195, 0, 0, 0, 266, 88, 866,
+	865, 224, 224, 219, 0, 222, 0, 979, 198, 0,
+	101, 0, 0, 0, 0, 0, 107, 441, 0, 0,
+	437, 0, 0, 0, 0, 0, 329, 638, 0, 340,
+	341, 0, 324, 392, 423, 216, 0, 229, 833, 641,
+	0, 0, 342, 0, 325, 345, 346, 358, 306, 307,
+	304, 0, 0, 888, 889, 0, 892, 93, 379, 381,
+	380, 384, 0, 0, 388, 0, 377, 0, 263, 862,
+	0, 448, 264, 265, 510, 510, 563, 0, 566, 0,
+	697, 0, 717, 700, 759, 760, 0, 834, 858, 46,
+	0, 197, 197, 813, 197, 201, 816, 197, 818, 197,
+	821, 0, 0, 0, 0, 0, 0, 0, 825, 773,
+	831, 0, 0, 0, 0, 0, 0, 0, 0, 208,
+	898, 895, 45, 848, 0, 680, 618, 49, 53, 0,
+	936, 927, 938, 940, 0, 0, 0, 932, 0, 850,
+	0, 0, 642, 649, 0, 0, 643, 0, 644, 664,
+	666, -2, 850, 679, 60, 61, 0, 80, 81, 82,
+	272, 138, 139, 0, 142, 143, 145, 172, 173, 208,
+	0, 208, 0, 202, 0, 255, 267, 0, 863, 864,
+	0, 0, 221, 223, 0, 968, 103, 0, 0, 0,
+	427, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 127, 326, 0, 422, 224, 215, 0, 0,
+	417, 414, 343, 344, 636, 879, 880, 881, 0, 891,
+	96, 0, 0, 0, 0, 389, 445, 446, 447, 65,
+	455, 461, 565, 585, 701, 761, 893, 764, 810, 208,
+	814, 815, 817, 819, 820, 822, 766, 765, 0, 0,
+	0, 0, 0, 858, 0, 829, 0, 0, 0, 0,
+	0, 654, 208, 918, 50, 0, 0, 0, 54, 0,
+	941, 0, 0, 0, 0, 71, 858, 945, 946, 646,
+	0, 651, 858, 59, 140, 212, 196, 212, 0, 0,
+	268, 867, 868, 869, 870, 871, 872, 873, 636, 104,
+	105, 106, 0, 0, 0, 0, 431, 0, 433, 0,
+	332, 639, 0, 0, 0, 0, 394, 0, 402, 0,
+	0, 0, 0, 890, 378, 0, 94, 95, 0, 0,
+	383, 47, 811, 812, 0, 0, 0, 0, 802, 0,
+	826, 0, 0, 0, 676, 0, 0, 674, 900, 899,
+	912, 925, 849, 847, 0, 939, 0, 931, 934, 930,
+	933, 57, 0, 58, 185, 186, 200, 203, 0, 442,
+	428, 0, 430, 438, 432, 434, 0, 423, 0, 424,
+	0, 418, 415, 416, 0, 0, 97, 98, 0, 0,
+	0, 767, 769, 768, 770, 0, 0, 0, 772, 790,
+	791, 675, 677, 678, 635, 918, 0, 911, 0, -2,
+	920, 0, 0, 0, 926, 0, 929, 0, 647, 0,
+	429, 423, 0, 421, 0, 882, 637, 0, 0, 0,
+	385, 771, 0, 0, 0, 905, 903, 903, 913, 914,
+	0, 0, 921, 0, 0, 0, 937, 935, 882, 419,
+	0, 0, 375, 884, 99, 100, 317, 318, 319, 93,
+	803, 0, 806, 908, 0, 901, 904, 902, 915, 0,
+	0, 922, 0, 924, 89, 423, 251, 0, 885, 886,
+	96, 804, 897, 0, 906, 907, 916, 917, 923, 420,
+	252, 253, 0, 0, 382, 0, 909, 910, 254, 0,
+	0, 0, 883, 0, 256, 258, 259, 0, 0, 257,
+	805, 260, 261, 262,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
-	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 476,
-	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 114, 3, 3, 3, 141, 133, 3,
+	84, 86, 138, 136, 85, 137, 153, 139, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 481,
+	122, 121, 123, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 140, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 143, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 141,
+	3, 3, 3, 3, 132, 3, 144,
 }
 
 var yyTok2 = [...]int{
@@ -5386,13 +5484,13 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 80, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 112, 113, 114, 115,
-	116, 117, 121, 122, 123, 124, 125, 126, 127, 128,
-	131, 132, 137, 139, 142, 143, 144, 145, 146, 147,
-	148, 149, 151, 152, 153, 154, 155, 156, 157, 158,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 115,
+	116, 117, 118, 119, 120, 124, 125, 126, 127, 128,
+	129, 130, 131, 134, 135, 140, 142, 145, 146, 147,
+	148, 149, 150, 151, 152, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
@@ -5448,7 +5546,8 @@ var yyTok3 = [...]int{
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
-	57800, 475, 0,
+	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
+	57805, 480, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5798,68 +5897,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:425
+//line sql.y:435
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:430
+//line sql.y:440
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:431
+//line sql.y:441
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:435
+//line sql.y:445
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:468
+//line sql.y:478
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:474
+//line sql.y:484
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:478
+//line sql.y:488
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:482
+//line sql.y:492
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:487
+//line sql.y:497
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:491
+//line sql.y:501
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:497
+//line sql.y:507
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -5867,7 +5966,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:503
+//line sql.y:513
 		{
 			yyLOCAL = &Load{}
 		}
@@ -5875,7 +5974,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:509
+//line sql.y:519
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5888,7 +5987,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:518
+//line sql.y:528
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
@@ -5896,7 +5995,7 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:522
+//line sql.y:532
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
@@ -5904,7 +6003,7 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:526
+//line sql.y:536
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -5912,7 +6011,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:549
+//line sql.y:559
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5924,7 +6023,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:557
+//line sql.y:567
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
@@ -5932,7 +6031,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:563
+//line sql.y:573
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
@@ -5940,7 +6039,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:569
+//line sql.y:579
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
@@ -5948,7 +6047,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:577
+//line sql.y:587
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
@@ -5956,7 +6055,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:583
+//line sql.y:593
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -5964,7 +6063,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:587
+//line sql.y:597
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
@@ -5972,7 +6071,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:594
+//line sql.y:604
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -5988,7 +6087,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:606
+//line sql.y:616
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6002,7 +6101,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:618
+//line sql.y:628
 		{
 			yyLOCAL = InsertAct
 		}
@@ -6010,7 +6109,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:622
+//line sql.y:632
 		{
 			yyLOCAL = ReplaceAct
 		}
@@ -6018,7 +6117,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:628
+//line sql.y:638
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6026,7 +6125,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:634
+//line sql.y:644
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6034,7 +6133,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:638
+//line sql.y:648
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
@@ -6042,7 +6141,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:642
+//line sql.y:652
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
@@ -6050,32 +6149,32 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:646
+//line sql.y:656
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:651
+//line sql.y:661
 		{
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:652
+//line sql.y:662
 		{
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:656
+//line sql.y:666
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
 	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:660
+//line sql.y:670
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
@@ -6083,14 +6182,14 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:666
+//line sql.y:676
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:680
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6098,14 +6197,14 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:676
+//line sql.y:686
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:690
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6113,7 +6212,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:685
+//line sql.y:695
 		{
 			yyLOCAL = nil
 		}
@@ -6121,7 +6220,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:689
+//line sql.y:699
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
@@ -6129,7 +6228,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:695
+//line sql.y:705
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
@@ -6137,7 +6236,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:701
+//line sql.y:711
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
@@ -6145,7 +6244,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:715
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
@@ -6153,14 +6252,14 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:711
+//line sql.y:721
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:715
+//line sql.y:725
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
@@ -6168,7 +6267,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:721
+//line sql.y:731
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
@@ -6176,7 +6275,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:725
+//line sql.y:735
 		{
 			yyLOCAL = ReadWrite
 		}
@@ -6184,7 +6283,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:729
+//line sql.y:739
 		{
 			yyLOCAL = ReadOnly
 		}
@@ -6192,7 +6291,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:735
+//line sql.y:745
 		{
 			yyLOCAL = RepeatableRead
 		}
@@ -6200,7 +6299,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:739
+//line sql.y:749
 		{
 			yyLOCAL = ReadCommitted
 		}
@@ -6208,7 +6307,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:743
+//line sql.y:753
 		{
 			yyLOCAL = ReadUncommitted
 		}
@@ -6216,7 +6315,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:747
+//line sql.y:757
 		{
 			yyLOCAL = Serializable
 		}
@@ -6224,7 +6323,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:753
+//line sql.y:763
 		{
 			yyLOCAL = SessionScope
 		}
@@ -6232,7 +6331,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:757
+//line sql.y:767
 		{
 			yyLOCAL = GlobalScope
 		}
@@ -6240,7 +6339,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:763
+//line sql.y:773
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
@@ -6250,7 +6349,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:769
+//line sql.y:779
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6261,7 +6360,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:776
+//line sql.y:786
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6274,15 +6373,15 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:785
+//line sql.y:795
 		{
-			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
+			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str, Comments: Comments(yyDollar[2].strs)}
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:789
+//line sql.y:799
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
@@ -6292,7 +6391,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:796
+//line sql.y:806
 		{
 			yyLOCAL = false
 		}
@@ -6300,33 +6399,33 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:800
+//line sql.y:810
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:805
+//line sql.y:815
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:809
+//line sql.y:819
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:815
+//line sql.y:825
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:820
+//line sql.y:830
 		{
 			var v []VindexParam
 			yyLOCAL = v
@@ -6335,7 +6434,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:825
+//line sql.y:835
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
@@ -6343,7 +6442,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:831
+//line sql.y:841
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
@@ -6351,21 +6450,21 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:836
+//line sql.y:846
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:842
+//line sql.y:852
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 101:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:848
+//line sql.y:858
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6374,7 +6473,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:855
+//line sql.y:865
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
@@ -6383,7 +6482,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:862
+//line sql.y:872
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6392,7 +6491,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:867
+//line sql.y:877
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6401,7 +6500,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:872
+//line sql.y:882
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6410,7 +6509,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:877
+//line sql.y:887
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6419,7 +6518,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:884
+//line sql.y:894
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6428,25 +6527,26 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:891
+//line sql.y:901
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
 	case 111:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:902
+//line sql.y:912
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
+			yyLOCAL.PartitionOption = yyDollar[5].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:908
+//line sql.y:919
 		{
 			yyLOCAL = nil
 		}
@@ -6454,7 +6554,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:912
+//line sql.y:923
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
@@ -6462,7 +6562,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:918
+//line sql.y:929
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
@@ -6470,21 +6570,21 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:922
+//line sql.y:933
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:926
+//line sql.y:937
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:930
+//line sql.y:941
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
@@ -6492,7 +6592,7 @@ yydefault:
 	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:935
+//line sql.y:946
 		{
 			yyLOCAL = false
 		}
@@ -6500,39 +6600,39 @@ yydefault:
 	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:939
+//line sql.y:950
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:945
+//line sql.y:956
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:949
+//line sql.y:960
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:955
+//line sql.y:966
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:959
+//line sql.y:970
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:966
+//line sql.y:977
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
@@ -6540,7 +6640,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:970
+//line sql.y:981
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
@@ -6548,14 +6648,14 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:976
+//line sql.y:987
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:980
+//line sql.y:991
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
@@ -6563,7 +6663,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:986
+//line sql.y:997
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
@@ -6572,7 +6672,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:991
+//line sql.y:1002
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
@@ -6580,39 +6680,39 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:996
+//line sql.y:1007
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
 	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1000
+//line sql.y:1011
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
 	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1005
+//line sql.y:1016
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
 	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1009
+//line sql.y:1020
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1013
+//line sql.y:1024
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1019
+//line sql.y:1030
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
@@ -6621,7 +6721,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1029
+//line sql.y:1040
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
@@ -6629,7 +6729,7 @@ yydefault:
 	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1033
+//line sql.y:1044
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6639,7 +6739,7 @@ yydefault:
 	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1039
+//line sql.y:1050
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6649,7 +6749,7 @@ yydefault:
 	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1045
+//line sql.y:1056
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6658,7 +6758,7 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1050
+//line sql.y:1061
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6667,7 +6767,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1055
+//line sql.y:1066
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6676,7 +6776,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1060
+//line sql.y:1071
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6685,7 +6785,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1065
+//line sql.y:1076
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyPrimary
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6694,7 +6794,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1070
+//line sql.y:1081
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6703,7 +6803,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1075
+//line sql.y:1086
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUniqueKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6712,7 +6812,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1080
+//line sql.y:1091
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUnique
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6720,7 +6820,7 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1087
+//line sql.y:1098
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
@@ -6728,74 +6828,74 @@ yydefault:
 		}
 	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1098
+//line sql.y:1109
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
 	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1103
+//line sql.y:1114
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1109
+//line sql.y:1120
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1113
+//line sql.y:1124
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1117
+//line sql.y:1128
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1121
+//line sql.y:1132
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1125
+//line sql.y:1136
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1129
+//line sql.y:1140
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1133
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1137
+//line sql.y:1148
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1141
+//line sql.y:1152
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1147
+//line sql.y:1158
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6803,7 +6903,7 @@ yydefault:
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1153
+//line sql.y:1164
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6811,7 +6911,7 @@ yydefault:
 		}
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1159
+//line sql.y:1170
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6819,7 +6919,7 @@ yydefault:
 		}
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1165
+//line sql.y:1176
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6827,7 +6927,7 @@ yydefault:
 		}
 	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1171
+//line sql.y:1182
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6835,189 +6935,189 @@ yydefault:
 		}
 	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1190
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1183
+//line sql.y:1194
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1187
+//line sql.y:1198
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1191
+//line sql.y:1202
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1195
+//line sql.y:1206
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1201
+//line sql.y:1212
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1205
+//line sql.y:1216
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1209
+//line sql.y:1220
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1213
+//line sql.y:1224
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1217
+//line sql.y:1228
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1221
+//line sql.y:1232
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1225
+//line sql.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1229
+//line sql.y:1240
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1233
+//line sql.y:1244
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1237
+//line sql.y:1248
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1241
+//line sql.y:1252
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 185:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1253
+//line sql.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 186:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1258
+//line sql.y:1269
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1264
+//line sql.y:1275
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1268
+//line sql.y:1279
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1272
+//line sql.y:1283
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1276
+//line sql.y:1287
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1280
+//line sql.y:1291
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1284
+//line sql.y:1295
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1288
+//line sql.y:1299
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1292
+//line sql.y:1303
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1298
+//line sql.y:1309
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
 	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1303
+//line sql.y:1314
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
 	case 197:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1308
+//line sql.y:1319
 		{
 			yyLOCAL = nil
 		}
@@ -7025,20 +7125,20 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1312
+//line sql.y:1323
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1317
+//line sql.y:1328
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 200:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1321
+//line sql.y:1332
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7047,13 +7147,13 @@ yydefault:
 		}
 	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1329
+//line sql.y:1340
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1333
+//line sql.y:1344
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7061,7 +7161,7 @@ yydefault:
 		}
 	case 203:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1339
+//line sql.y:1350
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7071,7 +7171,7 @@ yydefault:
 	case 204:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1347
+//line sql.y:1358
 		{
 			yyLOCAL = false
 		}
@@ -7079,7 +7179,7 @@ yydefault:
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1351
+//line sql.y:1362
 		{
 			yyLOCAL = true
 		}
@@ -7087,7 +7187,7 @@ yydefault:
 	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1356
+//line sql.y:1367
 		{
 			yyLOCAL = false
 		}
@@ -7095,57 +7195,57 @@ yydefault:
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1360
+//line sql.y:1371
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1365
+//line sql.y:1376
 		{
 			yyVAL.str = ""
 		}
 	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1369
+//line sql.y:1380
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1373
+//line sql.y:1384
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1377
+//line sql.y:1388
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1382
+//line sql.y:1393
 		{
 			yyVAL.str = ""
 		}
 	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1386
+//line sql.y:1397
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1390
+//line sql.y:1401
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 215:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1397
+//line sql.y:1408
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
@@ -7153,7 +7253,7 @@ yydefault:
 	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1402
+//line sql.y:1413
 		{
 			yyLOCAL = nil
 		}
@@ -7161,7 +7261,7 @@ yydefault:
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1406
+//line sql.y:1417
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
@@ -7169,14 +7269,14 @@ yydefault:
 	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1412
+//line sql.y:1423
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1416
+//line sql.y:1427
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
@@ -7184,7 +7284,7 @@ yydefault:
 	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1422
+//line sql.y:1433
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
@@ -7192,7 +7292,7 @@ yydefault:
 	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1426
+//line sql.y:1437
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
@@ -7201,7 +7301,7 @@ yydefault:
 	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1431
+//line sql.y:1442
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -7209,27 +7309,27 @@ yydefault:
 	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1435
+//line sql.y:1446
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
 	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1441
+//line sql.y:1452
 		{
 			yyVAL.str = ""
 		}
 	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1445
+//line sql.y:1456
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1451
+//line sql.y:1462
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
@@ -7237,7 +7337,7 @@ yydefault:
 	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1455
+//line sql.y:1466
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
@@ -7245,7 +7345,7 @@ yydefault:
 	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1459
+//line sql.y:1470
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
@@ -7253,7 +7353,7 @@ yydefault:
 	case 229:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1463
+//line sql.y:1474
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
@@ -7261,100 +7361,100 @@ yydefault:
 	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1467
+//line sql.y:1478
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1472
+//line sql.y:1483
 		{
 			yyVAL.str = ""
 		}
 	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1476
+//line sql.y:1487
 		{
 			yyVAL.str = yyDollar[2].str
 		}
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1482
+//line sql.y:1493
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1486
+//line sql.y:1497
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1490
+//line sql.y:1501
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1497
+//line sql.y:1508
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1501
+//line sql.y:1512
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 238:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1506
+//line sql.y:1517
 		{
 			yyVAL.str = "key"
 		}
 	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1510
+//line sql.y:1521
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1516
+//line sql.y:1527
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1520
+//line sql.y:1531
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 242:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1525
+//line sql.y:1536
 		{
 			yyVAL.str = ""
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1529
+//line sql.y:1540
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1535
+//line sql.y:1546
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1539
+//line sql.y:1550
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
@@ -7362,7 +7462,7 @@ yydefault:
 	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1545
+//line sql.y:1556
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
@@ -7370,7 +7470,7 @@ yydefault:
 	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1551
+//line sql.y:1562
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7378,7 +7478,7 @@ yydefault:
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1555
+//line sql.y:1566
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7386,7 +7486,7 @@ yydefault:
 	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1561
+//line sql.y:1572
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7394,7 +7494,7 @@ yydefault:
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1565
+//line sql.y:1576
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7402,7 +7502,7 @@ yydefault:
 	case 251:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1571
+//line sql.y:1582
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion()}
 		}
@@ -7410,7 +7510,7 @@ yydefault:
 	case 252:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1575
+//line sql.y:1586
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion()}
 		}
@@ -7418,7 +7518,7 @@ yydefault:
 	case 253:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1579
+//line sql.y:1590
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnUpdate: yyDollar[11].ReferenceActionUnion()}
 		}
@@ -7426,7 +7526,7 @@ yydefault:
 	case 254:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1583
+//line sql.y:1594
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion(), OnUpdate: yyDollar[12].ReferenceActionUnion()}
 		}
@@ -7434,7 +7534,7 @@ yydefault:
 	case 255:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1589
+//line sql.y:1600
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
@@ -7442,7 +7542,7 @@ yydefault:
 	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1595
+//line sql.y:1606
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7450,7 +7550,7 @@ yydefault:
 	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1601
+//line sql.y:1612
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7458,7 +7558,7 @@ yydefault:
 	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1607
+//line sql.y:1618
 		{
 			yyLOCAL = Restrict
 		}
@@ -7466,7 +7566,7 @@ yydefault:
 	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1611
+//line sql.y:1622
 		{
 			yyLOCAL = Cascade
 		}
@@ -7474,7 +7574,7 @@ yydefault:
 	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1615
+//line sql.y:1626
 		{
 			yyLOCAL = NoAction
 		}
@@ -7482,7 +7582,7 @@ yydefault:
 	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1619
+//line sql.y:1630
 		{
 			yyLOCAL = SetDefault
 		}
@@ -7490,33 +7590,33 @@ yydefault:
 	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1623
+//line sql.y:1634
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
 	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1628
+//line sql.y:1639
 		{
 			yyVAL.str = ""
 		}
 	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1632
+//line sql.y:1643
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1636
+//line sql.y:1647
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 266:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1641
+//line sql.y:1652
 		{
 			yyLOCAL = true
 		}
@@ -7524,7 +7624,7 @@ yydefault:
 	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1645
+//line sql.y:1656
 		{
 			yyLOCAL = true
 		}
@@ -7532,7 +7632,7 @@ yydefault:
 	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1649
+//line sql.y:1660
 		{
 			yyLOCAL = false
 		}
@@ -7540,7 +7640,7 @@ yydefault:
 	case 269:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1654
+//line sql.y:1665
 		{
 			yyLOCAL = nil
 		}
@@ -7548,7 +7648,7 @@ yydefault:
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1658
+//line sql.y:1669
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -7556,21 +7656,21 @@ yydefault:
 	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1664
+//line sql.y:1675
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1668
+//line sql.y:1679
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
 	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1672
+//line sql.y:1683
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7578,14 +7678,14 @@ yydefault:
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1678
+//line sql.y:1689
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1682
+//line sql.y:1693
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7593,7 +7693,7 @@ yydefault:
 	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1688
+//line sql.y:1699
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7601,7 +7701,7 @@ yydefault:
 	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1692
+//line sql.y:1703
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7609,7 +7709,7 @@ yydefault:
 	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1696
+//line sql.y:1707
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
@@ -7617,7 +7717,7 @@ yydefault:
 	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1700
+//line sql.y:1711
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
@@ -7625,7 +7725,7 @@ yydefault:
 	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1704
+//line sql.y:1715
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7633,7 +7733,7 @@ yydefault:
 	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1708
+//line sql.y:1719
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7641,7 +7741,7 @@ yydefault:
 	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1712
+//line sql.y:1723
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7649,7 +7749,7 @@ yydefault:
 	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1716
+//line sql.y:1727
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7657,7 +7757,7 @@ yydefault:
 	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1720
+//line sql.y:1731
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7665,7 +7765,7 @@ yydefault:
 	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1724
+//line sql.y:1735
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7673,7 +7773,7 @@ yydefault:
 	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1728
+//line sql.y:1739
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7681,7 +7781,7 @@ yydefault:
 	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1732
+//line sql.y:1743
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7689,7 +7789,7 @@ yydefault:
 	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1736
+//line sql.y:1747
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].colIdent.String()}
 		}
@@ -7697,7 +7797,7 @@ yydefault:
 	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1740
+//line sql.y:1751
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7705,7 +7805,7 @@ yydefault:
 	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1744
+//line sql.y:1755
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -7713,7 +7813,7 @@ yydefault:
 	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1748
+//line sql.y:1759
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7721,7 +7821,7 @@ yydefault:
 	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1752
+//line sql.y:1763
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7729,7 +7829,7 @@ yydefault:
 	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1756
+//line sql.y:1767
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7737,7 +7837,7 @@ yydefault:
 	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1760
+//line sql.y:1771
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7745,7 +7845,7 @@ yydefault:
 	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1764
+//line sql.y:1775
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -7753,7 +7853,7 @@ yydefault:
 	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1768
+//line sql.y:1779
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7761,7 +7861,7 @@ yydefault:
 	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1772
+//line sql.y:1783
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -7769,7 +7869,7 @@ yydefault:
 	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1776
+//line sql.y:1787
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7777,7 +7877,7 @@ yydefault:
 	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1780
+//line sql.y:1791
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -7785,7 +7885,7 @@ yydefault:
 	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1784
+//line sql.y:1795
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7793,7 +7893,7 @@ yydefault:
 	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1788
+//line sql.y:1799
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -7801,7 +7901,7 @@ yydefault:
 	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1792
+//line sql.y:1803
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7809,7 +7909,7 @@ yydefault:
 	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1796
+//line sql.y:1807
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
@@ -7817,430 +7917,447 @@ yydefault:
 	case 304:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1800
+//line sql.y:1811
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1805
+//line sql.y:1816
 		{
 			yyVAL.str = ""
 		}
 	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1809
+//line sql.y:1820
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1813
+//line sql.y:1824
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1832
+//line sql.y:1843
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1836
+//line sql.y:1847
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1840
+//line sql.y:1851
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1845
+//line sql.y:1856
 		{
 			yyVAL.str = ""
 		}
 	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1851
+//line sql.y:1862
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 323:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *ColName
+//line sql.y:1866
+		{
+			// A bare FIRST has no column name.
+			yyLOCAL = &ColName{}
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1855
+//line sql.y:1871
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1860
+//line sql.y:1876
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1864
+//line sql.y:1880
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1869
+//line sql.y:1885
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1873
+//line sql.y:1889
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 329:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1877
+//line sql.y:1893
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 329:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1881
+//line sql.y:1897
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1901
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 331:
+	case 332:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1889
+//line sql.y:1905
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1895
+//line sql.y:1911
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1899
+//line sql.y:1915
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 334:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1903
+//line sql.y:1919
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 335:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1909
+//line sql.y:1925
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1913
+//line sql.y:1929
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1917
+//line sql.y:1933
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1921
+//line sql.y:1937
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1925
+//line sql.y:1941
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1929
+//line sql.y:1945
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1933
+//line sql.y:1949
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1937
+//line sql.y:1953
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1941
+//line sql.y:1957
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1945
+//line sql.y:1961
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1949
+//line sql.y:1965
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1953
+//line sql.y:1969
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1957
+//line sql.y:1973
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1961
+//line sql.y:1977
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1965
+//line sql.y:1981
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1969
+//line sql.y:1985
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1973
+//line sql.y:1989
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1977
+//line sql.y:1993
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1981
+//line sql.y:1997
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 355:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL AlterOption
+//line sql.y:2001
+		{
+			yyLOCAL = &DropKey{Type: CheckKeyType, Name: yyDollar[3].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1985
+//line sql.y:2005
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1989
+//line sql.y:2009
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 358:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1993
+//line sql.y:2013
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1999
+//line sql.y:2019
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2003
+//line sql.y:2023
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 359:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2009
+//line sql.y:2029
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2013
+//line sql.y:2033
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2017
+//line sql.y:2037
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2021
+//line sql.y:2041
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2025
+//line sql.y:2045
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2029
+//line sql.y:2049
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2033
+//line sql.y:2053
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2037
+//line sql.y:2057
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2041
+//line sql.y:2061
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2047
+//line sql.y:2067
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2053
+//line sql.y:2073
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8248,10 +8365,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2060
+//line sql.y:2080
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8259,28 +8376,39 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2067
+//line sql.y:2087
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2093
+		{
+			yyDollar[1].alterTableUnion().FullyParsed = true
+			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
+			yyDollar[1].alterTableUnion().PartitionOption = yyDollar[3].partitionOptionUnion()
+			yyLOCAL = yyDollar[1].alterTableUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2073
+//line sql.y:2100
 		{
-			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
+			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str, Comments: Comments(yyDollar[2].strs)}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2077
+//line sql.y:2104
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8288,10 +8416,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 377:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2084
+//line sql.y:2111
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8299,10 +8427,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 378:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2091
+//line sql.y:2118
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8315,10 +8443,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 379:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2103
+//line sql.y:2130
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8329,26 +8457,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 380:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2113
+//line sql.y:2140
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 381:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2117
+//line sql.y:2144
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 382:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2121
+//line sql.y:2148
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8362,10 +8490,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 383:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2134
+//line sql.y:2161
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8376,18 +8504,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 384:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2144
+//line sql.y:2171
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 385:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2148
+//line sql.y:2175
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8399,10 +8527,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2159
+//line sql.y:2186
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8410,10 +8538,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2166
+//line sql.y:2193
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8421,10 +8549,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 388:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2200
+		{
+			// PRIORITY is not a keyword, so that it can still be used as a column name.
+			if NewColIdent(yyDollar[5].str).Lowered() != "priority" {
+				yylex.Error("expecting priority")
+				return 1
+			}
+			yyLOCAL = &AlterMigration{
+				Type:     PriorityMigrationType,
+				UUID:     string(yyDollar[4].str),
+				Priority: NewIntLiteral(yyDollar[6].str),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 389:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2213
+		{
+			if NewColIdent(yyDollar[5].str).Lowered() != "priority" {
+				yylex.Error("expecting priority")
+				return 1
+			}
+			yyLOCAL = &AlterMigration{
+				Type:     PriorityMigrationType,
+				UUID:     string(yyDollar[4].str),
+				Priority: NewIntLiteral("-" + yyDollar[7].str),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 390:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2173
+//line sql.y:2225
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8432,282 +8593,467 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2180
+//line sql.y:2232
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 392:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2188
+//line sql.y:2240
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2192
+//line sql.y:2244
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 394:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2196
+//line sql.y:2248
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 395:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2200
+//line sql.y:2252
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2204
+//line sql.y:2256
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2208
+//line sql.y:2260
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2212
+//line sql.y:2264
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2216
+//line sql.y:2268
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2220
+//line sql.y:2272
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2224
+//line sql.y:2276
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 402:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2228
+//line sql.y:2280
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2232
+//line sql.y:2284
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2236
+//line sql.y:2288
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2240
+//line sql.y:2292
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2244
+//line sql.y:2296
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2248
+//line sql.y:2300
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2252
+//line sql.y:2304
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2256
+//line sql.y:2308
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2260
+//line sql.y:2312
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2264
+//line sql.y:2316
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2268
+//line sql.y:2320
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2272
+//line sql.y:2324
+		{
+			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
+		}
+		yyVAL.union = yyLOCAL
+	case 414:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2329
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 415:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2333
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 416:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:2337
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2344
+		{
+			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2348
+		{
+			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
+		}
+	case 419:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2354
+		{
+			// A single value is the limit of a RANGE partitioning, several values
+			// are the limits of the columns of a RANGE COLUMNS partitioning.
+			if len(yyDollar[6].valTupleUnion()) == 1 {
+				yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[6].valTupleUnion()[0], Engine: yyDollar[7].str}
+			} else {
+				yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[6].valTupleUnion(), Engine: yyDollar[7].str}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 420:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2364
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Engine: yyDollar[9].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 421:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2368
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, InValues: yyDollar[5].valTupleUnion(), Engine: yyDollar[6].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 422:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *PartitionDefinition
+//line sql.y:2372
+		{
+			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Engine: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 423:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2377
+		{
+			yyVAL.str = ""
+		}
+	case 424:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2381
+		{
+			yyVAL.str = yyDollar[3].colIdent.String()
+		}
+	case 425:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2386
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 426:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2390
+		{
+			yyLOCAL = yyDollar[1].partitionOptionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 427:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2396
+		{
+			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].literalUnion()
+			yyDollar[3].partitionOptionUnion().Definitions = yyDollar[5].partDefsUnion()
+			yyLOCAL = yyDollar[3].partitionOptionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 428:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2404
+		{
+			// HASH is not a keyword, so that it can still be used as the name of a vindex.
+			if NewColIdent(yyDollar[2].str).Lowered() != "hash" {
+				yylex.Error("expecting hash, key, range or list partitioning")
+				return 1
+			}
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: HashType, Expr: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 429:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2413
+		{
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: KeyType, KeyAlgorithm: yyDollar[3].literalUnion(), ColList: yyDollar[5].columnsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 430:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2417
+		{
+			yyLOCAL = &PartitionOption{Linear: yyDollar[1].booleanUnion(), Type: KeyType, KeyAlgorithm: yyDollar[3].literalUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 431:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2421
+		{
+			yyLOCAL = &PartitionOption{Type: RangeType, Expr: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 432:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2425
+		{
+			yyLOCAL = &PartitionOption{Type: RangeType, ColList: yyDollar[4].columnsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 433:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2429
 		{
-			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
+			yyLOCAL = &PartitionOption{Type: ListType, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:2277
+	case 434:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *PartitionOption
+//line sql.y:2433
 		{
-			yyLOCAL = false
+			yyLOCAL = &PartitionOption{Type: ListType, ColList: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 435:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2281
+//line sql.y:2438
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 436:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2285
+//line sql.y:2442
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*PartitionDefinition
-//line sql.y:2292
+	case 437:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2447
 		{
-			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2296
+		var yyLOCAL *Literal
+//line sql.y:2451
 		{
-			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
+			yyLOCAL = NewIntLiteral(yyDollar[3].str)
 		}
-	case 414:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *PartitionDefinition
-//line sql.y:2302
+		yyVAL.union = yyLOCAL
+	case 439:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2456
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *PartitionDefinition
-//line sql.y:2306
+	case 440:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:2460
 		{
-			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 441:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2465
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 442:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*PartitionDefinition
+//line sql.y:2469
+		{
+			yyLOCAL = yyDollar[2].partDefsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2312
+//line sql.y:2475
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2318
+//line sql.y:2481
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 445:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2322
+//line sql.y:2485
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 419:
+	case 446:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2328
+//line sql.y:2491
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 447:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2332
+//line sql.y:2495
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -8717,387 +9063,387 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 448:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2341
+//line sql.y:2504
 		{
-			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
+			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion(), Comments: Comments(yyDollar[2].strs)}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2345
+//line sql.y:2508
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2351
+//line sql.y:2514
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2355
+//line sql.y:2518
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2360
+//line sql.y:2523
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2366
+//line sql.y:2529
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2370
+//line sql.y:2533
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2374
+//line sql.y:2537
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2378
+//line sql.y:2541
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2382
+//line sql.y:2545
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2386
+//line sql.y:2549
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2390
+//line sql.y:2553
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2394
+//line sql.y:2557
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 461:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2398
+//line sql.y:2561
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 462:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2402
+//line sql.y:2565
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2406
+//line sql.y:2569
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2410
+//line sql.y:2573
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2414
+//line sql.y:2577
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2418
+//line sql.y:2581
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2422
+//line sql.y:2585
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2426
+//line sql.y:2589
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 469:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2430
+//line sql.y:2593
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2434
+//line sql.y:2597
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2438
+//line sql.y:2601
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2442
+//line sql.y:2605
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2446
+//line sql.y:2609
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2450
+//line sql.y:2613
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2454
+//line sql.y:2617
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2458
+//line sql.y:2621
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2462
+//line sql.y:2625
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2629
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2470
+//line sql.y:2633
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2474
+//line sql.y:2637
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2478
+//line sql.y:2641
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2482
+//line sql.y:2645
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2486
+//line sql.y:2649
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2653
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2494
+//line sql.y:2657
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 486:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2498
+//line sql.y:2661
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 487:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2665
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2669
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2673
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2515
+//line sql.y:2678
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2519
+//line sql.y:2682
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2523
+//line sql.y:2686
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 493:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2527
+//line sql.y:2690
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2531
+//line sql.y:2694
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2536
+//line sql.y:2699
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9105,1204 +9451,1204 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2550
+//line sql.y:2713
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2554
+//line sql.y:2717
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2721
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2564
+//line sql.y:2727
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 473:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2568
+//line sql.y:2731
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 474:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2574
+//line sql.y:2737
 		{
 			yyVAL.str = ""
 		}
-	case 475:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2578
+//line sql.y:2741
 		{
 			yyVAL.str = "extended "
 		}
-	case 476:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2584
+//line sql.y:2747
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2588
+//line sql.y:2751
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2594
+//line sql.y:2757
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 479:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2598
+//line sql.y:2761
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 480:
+	case 507:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2604
+//line sql.y:2767
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 481:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2771
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 482:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2612
+//line sql.y:2775
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 483:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2618
+//line sql.y:2781
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2622
+//line sql.y:2785
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2626
+//line sql.y:2789
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2632
+//line sql.y:2795
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2636
+//line sql.y:2799
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2642
+//line sql.y:2805
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2646
+//line sql.y:2809
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2650
+//line sql.y:2813
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2656
+//line sql.y:2819
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2660
+//line sql.y:2823
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2666
+//line sql.y:2829
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2670
+//line sql.y:2833
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2676
+//line sql.y:2839
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2682
+//line sql.y:2845
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 524:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2686
+//line sql.y:2849
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2691
+//line sql.y:2854
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 499:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2693
+//line sql.y:2856
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 500:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2696
+//line sql.y:2859
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 501:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2698
+//line sql.y:2861
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 502:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2703
+//line sql.y:2866
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2709
+//line sql.y:2872
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 531:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2714
+//line sql.y:2877
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2718
+//line sql.y:2881
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2722
+//line sql.y:2885
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2726
+//line sql.y:2889
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2730
+//line sql.y:2893
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2734
+//line sql.y:2897
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2740
+//line sql.y:2903
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 511:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2744
+//line sql.y:2907
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 512:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2748
+//line sql.y:2911
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 513:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2754
+//line sql.y:2917
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2758
+//line sql.y:2921
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2762
+//line sql.y:2925
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2766
+//line sql.y:2929
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2771
+//line sql.y:2934
 		{
 			yyVAL.str = ""
 		}
-	case 518:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2775
+//line sql.y:2938
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 519:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2779
+//line sql.y:2942
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 520:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2785
+//line sql.y:2948
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2789
+//line sql.y:2952
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2795
+//line sql.y:2958
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2799
+//line sql.y:2962
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2805
+//line sql.y:2968
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2811
+//line sql.y:2974
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2815
+//line sql.y:2978
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 527:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2821
+//line sql.y:2984
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2827
+//line sql.y:2990
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2831
+//line sql.y:2994
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2835
+//line sql.y:2998
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2839
+//line sql.y:3002
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2845
+//line sql.y:3008
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 560:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2851
+//line sql.y:3014
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2857
+//line sql.y:3020
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2861
+//line sql.y:3024
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 563:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2865
+//line sql.y:3028
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 564:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2869
+//line sql.y:3032
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 565:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2873
+//line sql.y:3036
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 566:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:3040
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2883
+//line sql.y:3046
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 541:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2887
+//line sql.y:3050
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 542:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2893
+//line sql.y:3056
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 543:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2897
+//line sql.y:3060
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 544:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2901
+//line sql.y:3064
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 545:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2905
+//line sql.y:3068
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 546:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2909
+//line sql.y:3072
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 547:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2913
+//line sql.y:3076
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 548:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2917
+//line sql.y:3080
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 549:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2921
+//line sql.y:3084
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 550:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2925
+//line sql.y:3088
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 551:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2929
+//line sql.y:3092
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 552:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3096
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 553:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2937
+//line sql.y:3100
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 554:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2942
+//line sql.y:3105
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2946
+//line sql.y:3109
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2950
+//line sql.y:3113
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3118
 		{
 			yyVAL.str = ""
 		}
-	case 558:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2959
+//line sql.y:3122
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 559:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2964
+//line sql.y:3127
 		{
 			setAllowComments(yylex, true)
 		}
-	case 560:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2968
+//line sql.y:3131
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 561:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2974
+//line sql.y:3137
 		{
 			yyVAL.strs = nil
 		}
-	case 562:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2978
+//line sql.y:3141
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 563:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2984
+//line sql.y:3147
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2988
+//line sql.y:3151
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2992
+//line sql.y:3155
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 593:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2997
+//line sql.y:3160
 		{
 			yyVAL.str = ""
 		}
-	case 567:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3001
+//line sql.y:3164
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 568:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3005
+//line sql.y:3168
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 569:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3010
+//line sql.y:3173
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3014
+//line sql.y:3177
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3018
+//line sql.y:3181
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3023
+//line sql.y:3186
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3027
+//line sql.y:3190
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3032
+//line sql.y:3195
 		{
 			yyVAL.strs = nil
 		}
-	case 575:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3036
+//line sql.y:3199
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 576:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3040
+//line sql.y:3203
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 577:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3044
+//line sql.y:3207
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 578:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3048
+//line sql.y:3211
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 579:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+//line sql.y:3217
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 580:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3058
+//line sql.y:3221
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 581:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3062
+//line sql.y:3225
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 582:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3066
+//line sql.y:3229
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 583:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3070
+//line sql.y:3233
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 584:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3074
+//line sql.y:3237
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 585:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3078
+//line sql.y:3241
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 586:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3084
+//line sql.y:3247
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3088
+//line sql.y:3251
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 588:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3094
+//line sql.y:3257
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3098
+//line sql.y:3261
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3102
+//line sql.y:3265
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 618:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3106
+//line sql.y:3269
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3111
+//line sql.y:3274
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 593:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3115
+//line sql.y:3278
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 594:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3119
+//line sql.y:3282
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 596:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3126
+//line sql.y:3289
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 597:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3131
+//line sql.y:3294
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3135
+//line sql.y:3298
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3141
+//line sql.y:3304
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3145
+//line sql.y:3308
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 603:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3155
+//line sql.y:3318
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3159
+//line sql.y:3322
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3163
+//line sql.y:3326
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3169
+//line sql.y:3332
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3175
+//line sql.y:3338
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 635:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3179
+//line sql.y:3342
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3184
+//line sql.y:3347
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3188
+//line sql.y:3351
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3194
+//line sql.y:3357
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3198
+//line sql.y:3361
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 613:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3204
+//line sql.y:3367
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3208
+//line sql.y:3371
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 615:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3221
+//line sql.y:3384
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3225
+//line sql.y:3388
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 644:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3229
+//line sql.y:3392
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3233
+//line sql.y:3396
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3239
+//line sql.y:3402
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 620:
+	case 647:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3241
+//line sql.y:3404
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 621:
+	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3245
+//line sql.y:3408
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 622:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3247
+//line sql.y:3410
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 623:
+	case 650:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3251
+//line sql.y:3414
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 624:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3253
+//line sql.y:3416
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 625:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3256
+//line sql.y:3419
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3258
+//line sql.y:3421
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3261
+//line sql.y:3424
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 628:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3265
+//line sql.y:3428
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 629:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3269
+//line sql.y:3432
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 631:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3276
+//line sql.y:3439
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 632:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3282
+//line sql.y:3445
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3286
+//line sql.y:3449
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3290
+//line sql.y:3453
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3296
+//line sql.y:3459
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 663:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3302
+//line sql.y:3465
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3306
+//line sql.y:3469
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3310
+//line sql.y:3473
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3314
+//line sql.y:3477
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3320
+//line sql.y:3483
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3324
+//line sql.y:3487
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10311,668 +10657,668 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3497
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 643:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3338
+//line sql.y:3501
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 644:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3344
+//line sql.y:3507
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 645:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3348
+//line sql.y:3511
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 646:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3354
+//line sql.y:3517
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 647:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3359
+//line sql.y:3522
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 675:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3363
+//line sql.y:3526
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 676:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3367
+//line sql.y:3530
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 677:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3371
+//line sql.y:3534
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3375
+//line sql.y:3538
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3380
+//line sql.y:3543
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3384
+//line sql.y:3547
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3390
+//line sql.y:3553
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3394
+//line sql.y:3557
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3398
+//line sql.y:3561
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3402
+//line sql.y:3565
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3406
+//line sql.y:3569
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3410
+//line sql.y:3573
 		{
 			yyLOCAL = &IsExpr{Operator: yyDollar[3].isExprOperatorUnion(), Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3414
+//line sql.y:3577
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3418
+//line sql.y:3581
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3424
+//line sql.y:3587
 		{
 			yyVAL.str = ""
 		}
-	case 663:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3428
+//line sql.y:3591
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 664:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3434
+//line sql.y:3597
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3438
+//line sql.y:3601
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3444
+//line sql.y:3607
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3448
+//line sql.y:3611
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 695:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3452
+//line sql.y:3615
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 696:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3456
+//line sql.y:3619
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 697:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3460
+//line sql.y:3623
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3464
+//line sql.y:3627
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 699:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3468
+//line sql.y:3631
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3472
+//line sql.y:3635
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 701:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3476
+//line sql.y:3639
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3480
+//line sql.y:3643
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3486
+//line sql.y:3649
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3490
+//line sql.y:3653
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3494
+//line sql.y:3657
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 706:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3498
+//line sql.y:3661
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3502
+//line sql.y:3665
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3506
+//line sql.y:3669
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3512
+//line sql.y:3675
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3516
+//line sql.y:3679
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3520
+//line sql.y:3683
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3524
+//line sql.y:3687
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3528
+//line sql.y:3691
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3532
+//line sql.y:3695
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3536
+//line sql.y:3699
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3541
+//line sql.y:3704
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3545
+//line sql.y:3708
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3551
+//line sql.y:3714
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3555
+//line sql.y:3718
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3559
+//line sql.y:3722
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3566
+//line sql.y:3729
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3572
+//line sql.y:3735
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3576
+//line sql.y:3739
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 697:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3745
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3749
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3590
+//line sql.y:3753
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3594
+//line sql.y:3757
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3598
+//line sql.y:3761
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3602
+//line sql.y:3765
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3606
+//line sql.y:3769
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3610
+//line sql.y:3773
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3614
+//line sql.y:3777
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3618
+//line sql.y:3781
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3622
+//line sql.y:3785
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3626
+//line sql.y:3789
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3630
+//line sql.y:3793
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3634
+//line sql.y:3797
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3638
+//line sql.y:3801
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3642
+//line sql.y:3805
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3646
+//line sql.y:3809
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3650
+//line sql.y:3813
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3654
+//line sql.y:3817
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3658
+//line sql.y:3821
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3662
+//line sql.y:3825
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3666
+//line sql.y:3829
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3670
+//line sql.y:3833
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3674
+//line sql.y:3837
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3678
+//line sql.y:3841
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3682
+//line sql.y:3845
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3686
+//line sql.y:3849
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3690
+//line sql.y:3853
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3694
+//line sql.y:3857
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3698
+//line sql.y:3861
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -10981,666 +11327,666 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 758:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3716
+//line sql.y:3879
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 759:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3720
+//line sql.y:3883
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 760:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3724
+//line sql.y:3887
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 761:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3728
+//line sql.y:3891
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 762:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3738
+//line sql.y:3901
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 763:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3742
+//line sql.y:3905
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 764:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3746
+//line sql.y:3909
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 765:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3750
+//line sql.y:3913
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 766:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3754
+//line sql.y:3917
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 767:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3758
+//line sql.y:3921
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 768:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3762
+//line sql.y:3925
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 769:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3766
+//line sql.y:3929
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 770:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3770
+//line sql.y:3933
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 771:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3774
+//line sql.y:3937
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 772:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3778
+//line sql.y:3941
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 773:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3782
+//line sql.y:3945
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 774:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3786
+//line sql.y:3949
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3790
+//line sql.y:3953
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3800
+//line sql.y:3963
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3967
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3808
+//line sql.y:3971
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3813
+//line sql.y:3976
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3818
+//line sql.y:3981
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3823
+//line sql.y:3986
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3829
+//line sql.y:3992
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3997
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3839
+//line sql.y:4002
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3843
+//line sql.y:4006
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3847
+//line sql.y:4010
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3852
+//line sql.y:4015
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3857
+//line sql.y:4020
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3862
+//line sql.y:4025
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 790:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3866
+//line sql.y:4029
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 791:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3870
+//line sql.y:4033
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 794:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3880
+//line sql.y:4043
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 795:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3890
+//line sql.y:4053
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 796:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3894
+//line sql.y:4057
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 797:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3898
+//line sql.y:4061
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 798:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3902
+//line sql.y:4065
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 799:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3906
+//line sql.y:4069
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3910
+//line sql.y:4073
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3914
+//line sql.y:4077
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 802:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3920
+//line sql.y:4083
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 803:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3924
+//line sql.y:4087
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3928
+//line sql.y:4091
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 805:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3932
+//line sql.y:4095
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3936
+//line sql.y:4099
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3942
+//line sql.y:4105
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 781:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3946
+//line sql.y:4109
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 782:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3950
+//line sql.y:4113
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 783:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3956
+//line sql.y:4119
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 811:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3960
+//line sql.y:4123
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 812:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3964
+//line sql.y:4127
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3968
+//line sql.y:4131
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3972
+//line sql.y:4135
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3976
+//line sql.y:4139
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3982
+//line sql.y:4145
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3986
+//line sql.y:4149
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3990
+//line sql.y:4153
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 819:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3994
+//line sql.y:4157
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3998
+//line sql.y:4161
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 821:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4002
+//line sql.y:4165
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4006
+//line sql.y:4169
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 823:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4011
+//line sql.y:4174
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4015
+//line sql.y:4178
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4020
+//line sql.y:4183
 		{
 			yyVAL.str = string("")
 		}
-	case 799:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4024
+//line sql.y:4187
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 800:
+	case 827:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4030
+//line sql.y:4193
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4034
+//line sql.y:4197
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 802:
+	case 829:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4040
+//line sql.y:4203
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 830:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4045
+//line sql.y:4208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4049
+//line sql.y:4212
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4055
+//line sql.y:4218
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4059
+//line sql.y:4222
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 834:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4063
+//line sql.y:4226
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4069
+//line sql.y:4232
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4073
+//line sql.y:4236
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4077
+//line sql.y:4240
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4081
+//line sql.y:4244
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4085
+//line sql.y:4248
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4089
+//line sql.y:4252
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4093
+//line sql.y:4256
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4098
+//line sql.y:4261
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4104
+//line sql.y:4267
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -11650,707 +11996,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4113
+//line sql.y:4276
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4117
+//line sql.y:4280
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 846:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4123
+//line sql.y:4286
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4127
+//line sql.y:4290
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4132
+//line sql.y:4295
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4136
+//line sql.y:4299
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 850:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4141
+//line sql.y:4304
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4145
+//line sql.y:4308
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 852:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4151
+//line sql.y:4314
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4155
+//line sql.y:4318
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 827:
+	case 854:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4161
+//line sql.y:4324
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 855:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4166
+//line sql.y:4329
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4170
+//line sql.y:4333
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4174
+//line sql.y:4337
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4179
+//line sql.y:4342
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 859:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4183
+//line sql.y:4346
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 860:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4187
+//line sql.y:4350
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 861:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4191
+//line sql.y:4354
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 862:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4196
+//line sql.y:4359
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4200
+//line sql.y:4363
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 864:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4204
+//line sql.y:4367
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4208
+//line sql.y:4371
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4212
+//line sql.y:4375
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 867:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4219
+//line sql.y:4382
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 868:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4223
+//line sql.y:4386
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 869:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4227
+//line sql.y:4390
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 870:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4231
+//line sql.y:4394
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 871:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4237
+//line sql.y:4400
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4241
+//line sql.y:4404
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 873:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4245
+//line sql.y:4408
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4250
+//line sql.y:4413
 		{
 			yyVAL.str = ""
 		}
-	case 848:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4254
+//line sql.y:4417
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 849:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4258
+//line sql.y:4421
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 850:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4262
+//line sql.y:4425
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 851:
+	case 878:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4267
+//line sql.y:4430
 		{
 			yyVAL.str = ""
 		}
-	case 852:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4271
+//line sql.y:4434
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 853:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4277
+//line sql.y:4440
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 854:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4281
+//line sql.y:4444
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 855:
+	case 882:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4286
+//line sql.y:4449
 		{
 			yyVAL.str = ""
 		}
-	case 856:
+	case 883:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4290
+//line sql.y:4453
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 857:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4295
+//line sql.y:4458
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 858:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4299
+//line sql.y:4462
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 859:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4303
+//line sql.y:4466
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 860:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4308
+//line sql.y:4471
 		{
 			yyVAL.str = ""
 		}
-	case 861:
+	case 888:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4312
+//line sql.y:4475
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 862:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4318
+//line sql.y:4481
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 863:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4322
+//line sql.y:4485
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 864:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4326
+//line sql.y:4489
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 865:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4330
+//line sql.y:4493
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 866:
+	case 893:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4335
+//line sql.y:4498
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4339
+//line sql.y:4502
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 895:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4343
+//line sql.y:4506
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4348
+//line sql.y:4511
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 897:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4352
+//line sql.y:4515
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4356
+//line sql.y:4519
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 899:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4360
+//line sql.y:4523
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4365
+//line sql.y:4528
 		{
 			yyVAL.str = ""
 		}
-	case 874:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4369
+//line sql.y:4532
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 875:
+	case 902:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4373
+//line sql.y:4536
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 876:
+	case 903:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4378
+//line sql.y:4541
 		{
 			yyVAL.str = ""
 		}
-	case 877:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4382
+//line sql.y:4545
 		{
 			yyVAL.str = " header"
 		}
-	case 878:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4387
+//line sql.y:4550
 		{
 			yyVAL.str = ""
 		}
-	case 879:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4391
+//line sql.y:4554
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 880:
+	case 907:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4395
+//line sql.y:4558
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 881:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4400
+//line sql.y:4563
 		{
 			yyVAL.str = ""
 		}
-	case 882:
+	case 909:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4404
+//line sql.y:4567
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 883:
+	case 910:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4408
+//line sql.y:4571
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 884:
+	case 911:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4414
+//line sql.y:4577
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 885:
+	case 912:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4419
+//line sql.y:4582
 		{
 			yyVAL.str = ""
 		}
-	case 886:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4423
+//line sql.y:4586
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 887:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4429
+//line sql.y:4592
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 888:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4433
+//line sql.y:4596
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 889:
+	case 916:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4439
+//line sql.y:4602
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 890:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4443
+//line sql.y:4606
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 891:
+	case 918:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4448
+//line sql.y:4611
 		{
 			yyVAL.str = ""
 		}
-	case 892:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4452
+//line sql.y:4615
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 893:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4458
+//line sql.y:4621
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 894:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4462
+//line sql.y:4625
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 895:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4468
+//line sql.y:4631
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 896:
+	case 923:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4472
+//line sql.y:4635
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 897:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4476
+//line sql.y:4639
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 898:
+	case 925:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4481
+//line sql.y:4644
 		{
 			yyVAL.str = ""
 		}
-	case 899:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4485
+//line sql.y:4648
 		{
 			yyVAL.str = " optionally"
 		}
-	case 900:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4498
+//line sql.y:4661
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4502
+//line sql.y:4665
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 929:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4506
+//line sql.y:4669
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 930:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4510
+//line sql.y:4673
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4514
+//line sql.y:4677
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4520
+//line sql.y:4683
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4524
+//line sql.y:4687
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4528
+//line sql.y:4691
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 908:
+	case 935:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4532
+//line sql.y:4695
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 909:
+	case 936:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4537
+//line sql.y:4700
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4541
+//line sql.y:4704
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4547
+//line sql.y:4710
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 939:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4551
+//line sql.y:4714
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 913:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4557
+//line sql.y:4720
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4561
+//line sql.y:4724
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4567
+//line sql.y:4730
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4573
+//line sql.y:4736
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12359,329 +12705,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4583
+//line sql.y:4746
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4587
+//line sql.y:4750
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 919:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4593
+//line sql.y:4756
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4599
+//line sql.y:4762
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4603
+//line sql.y:4766
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 922:
+	case 949:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4609
+//line sql.y:4772
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4613
+//line sql.y:4776
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4617
+//line sql.y:4780
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4621
+//line sql.y:4784
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4625
+//line sql.y:4788
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4633
+//line sql.y:4796
 		{
 			yyVAL.str = "charset"
 		}
-	case 931:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4643
+//line sql.y:4806
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4647
+//line sql.y:4810
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4651
+//line sql.y:4814
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4660
+//line sql.y:4823
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4662
+//line sql.y:4825
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4665
+//line sql.y:4828
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 966:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4667
+//line sql.y:4830
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 967:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4670
+//line sql.y:4833
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4672
+//line sql.y:4835
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 969:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4675
+//line sql.y:4838
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4677
+//line sql.y:4840
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4680
+//line sql.y:4843
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 945:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4682
+//line sql.y:4845
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 946:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4684
+//line sql.y:4847
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 947:
+	case 974:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4688
+//line sql.y:4851
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4693
+//line sql.y:4856
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4697
+//line sql.y:4860
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 977:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4702
+//line sql.y:4865
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4704
+//line sql.y:4867
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 979:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4708
+//line sql.y:4871
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4714
+//line sql.y:4877
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 954:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4718
+//line sql.y:4881
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 956:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4725
+//line sql.y:4888
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 957:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4731
+//line sql.y:4894
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 958:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4735
+//line sql.y:4898
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 959:
+	case 986:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4740
+//line sql.y:4903
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 960:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4744
+//line sql.y:4907
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 962:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4751
+//line sql.y:4914
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1368:
+	case 1399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5181
+//line sql.y:5348
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1369:
+	case 1400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5190
+//line sql.y:5357
 		{
 			decNesting(yylex)
 		}
-	case 1370:
+	case 1401:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5195
+//line sql.y:5362
 		{
 			skipToEnd(yylex)
 		}
-	case 1371:
+	case 1402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5200
+//line sql.y:5367
 		{
 			skipToEnd(yylex)
 		}
-	case 1372:
+	case 1403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5204
+//line sql.y:5371
 		{
 			skipToEnd(yylex)
 		}
-	case 1373:
+	case 1404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5208
+//line sql.y:5375
 		{
 			skipToEnd(yylex)
 		}
@@ -100,6 +100,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   indexColumn   *IndexColumn
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  partitionOption *PartitionOption
   showFilter    *ShowFilter
   optLike       *OptLike
   selectInto	  *SelectInto
@@ -170,6 +171,10 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE SQL_CALC_FOUND_ROWS
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+// A bare FIRST yields to a following AFTER or REMOVE, which are then read
+// as the column name of the FIRST clause.
+%nonassoc <str> LOWER_THAN_AFTER
+%nonassoc <str> AFTER REMOVE
 %left <str> ON USING INPLACE COPY ALGORITHM NONE SHARED EXCLUSIVE
 %token <empty> '(' ',' ')'
 %token <str> ID AT_ID AT_AT_ID HEX STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL COMPRESSION
@@ -209,11 +214,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> REVERT
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <str> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
-%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING REMOVE
+%token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> PARTITIONS LINEAR RANGE LIST
 %token <str> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <str> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
-%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
+%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST LAST
 
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE
@@ -401,8 +407,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <indexOption> index_option using_index_type
 %type <indexOptions> index_option_list index_option_list_opt using_opt
 %type <constraintInfo> constraint_info check_constraint_info
-%type <partDefs> partition_definitions
+%type <partDefs> partition_definitions partition_definitions_opt
 %type <partDef> partition_definition
+%type <partitionOption> partitions_options_opt partitions_options partitions_options_beginning
+%type <boolean> linear_opt
+%type <literal> partitions_opt key_algorithm_opt
+%type <str> partition_engine_opt
 %type <partSpec> partition_operation
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
@@ -783,7 +793,7 @@ create_statement:
   }
 | CREATE comment_opt replace_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
-    $$ = &CreateView{ViewName: $8.ToViewName(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
+    $$ = &CreateView{ViewName: $8.ToViewName(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12, Comments: Comments($2) }
   }
 | create_database_prefix create_options_opt
   {
@@ -898,10 +908,11 @@ database_or_schema:
 | SCHEMA
 
 table_spec:
-  '(' table_column_list ')' table_option_list_opt
+  '(' table_column_list ')' table_option_list_opt partitions_options_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.PartitionOption = $5
   }
 
 create_options_opt:
@@ -1851,6 +1862,11 @@ first_opt:
   {
     $$ = nil
   }
+| FIRST %prec LOWER_THAN_AFTER
+  {
+    // A bare FIRST has no column name.
+    $$ = &ColName{}
+  }
 | FIRST column_name
   {
     $$ = $2
@@ -1981,6 +1997,10 @@ alter_option:
   {
     $$ = &DropKey{Type:ForeignKeyType, Name:$4}
   }
+| DROP CHECK id_or_var
+  {
+    $$ = &DropKey{Type:CheckKeyType, Name:$3}
+  }
 | FORCE
   {
     $$ = &Force{}
@@ -2069,9 +2089,16 @@ alter_statement:
     $1.PartitionSpec = $2
     $$ = $1
   }
+| alter_table_prefix alter_commands_list partitions_options
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionOption = $3
+    $$ = $1
+  }
 | ALTER comment_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
-    $$ = &AlterView{ViewName: $7.ToViewName(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
+    $$ = &AlterView{ViewName: $7.ToViewName(), Algorithm:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11, Comments: Comments($2) }
   }
 | alter_database_prefix table_id_opt create_options
   {
@@ -2169,6 +2196,31 @@ alter_statement:
       UUID: string($4),
     }
   }
+| ALTER comment_opt VITESS_MIGRATION STRING ID INTEGRAL
+  {
+    // PRIORITY is not a keyword, so that it can still be used as a column name.
+    if NewColIdent($5).Lowered() != "priority" {
+      yylex.Error("expecting priority")
+      return 1
+    }
+    $$ = &AlterMigration{
+      Type: PriorityMigrationType,
+      UUID: string($4),
+      Priority: NewIntLiteral($6),
+    }
+  }
+| ALTER comment_opt VITESS_MIGRATION STRING ID '-' INTEGRAL
+  {
+    if NewColIdent($5).Lowered() != "priority" {
+      yylex.Error("expecting priority")
+      return 1
+    }
+    $$ = &AlterMigration{
+      Type: PriorityMigrationType,
+      UUID: string($4),
+      Priority: NewIntLiteral("-" + $7),
+    }
+  }
 | ALTER comment_opt VITESS_MIGRATION STRING CANCEL
   {
     $$ = &AlterMigration{
@@ -2298,13 +2350,124 @@ partition_definitions:
   }
 
 partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  PARTITION sql_id VALUES LESS THAN row_tuple partition_engine_opt
   {
-    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+    // A single value is the limit of a RANGE partitioning, several values
+    // are the limits of the columns of a RANGE COLUMNS partitioning.
+    if len($6) == 1 {
+      $$ = &PartitionDefinition{Name: $2, Limit: $6[0], Engine: $7}
+    } else {
+      $$ = &PartitionDefinition{Name: $2, Limit: $6, Engine: $7}
+    }
+  }
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Engine: $9}
+  }
+| PARTITION sql_id VALUES IN row_tuple partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, InValues: $5, Engine: $6}
+  }
+| PARTITION sql_id partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Engine: $3}
+  }
+
+partition_engine_opt:
+  {
+    $$ = ""
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| ENGINE equal_opt id_or_var
+  {
+    $$ = $3.String()
+  }
+
+partitions_options_opt:
+  {
+    $$ = nil
+  }
+| partitions_options
+  {
+    $$ = $1
+  }
+
+partitions_options:
+  PARTITION BY partitions_options_beginning partitions_opt partition_definitions_opt
   {
-    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+    $3.Partitions = $4
+    $3.Definitions = $5
+    $$ = $3
+  }
+
+partitions_options_beginning:
+  linear_opt ID openb value_expression closeb
+  {
+    // HASH is not a keyword, so that it can still be used as the name of a vindex.
+    if NewColIdent($2).Lowered() != "hash" {
+      yylex.Error("expecting hash, key, range or list partitioning")
+      return 1
+    }
+    $$ = &PartitionOption{Linear: $1, Type: HashType, Expr: $4}
+  }
+| linear_opt KEY key_algorithm_opt openb column_list closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyType, KeyAlgorithm: $3, ColList: $5}
+  }
+| linear_opt KEY key_algorithm_opt openb closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyType, KeyAlgorithm: $3}
+  }
+| RANGE openb value_expression closeb
+  {
+    $$ = &PartitionOption{Type: RangeType, Expr: $3}
+  }
+| RANGE COLUMNS openb column_list closeb
+  {
+    $$ = &PartitionOption{Type: RangeType, ColList: $4}
+  }
+| LIST openb value_expression closeb
+  {
+    $$ = &PartitionOption{Type: ListType, Expr: $3}
+  }
+| LIST COLUMNS openb column_list closeb
+  {
+    $$ = &PartitionOption{Type: ListType, ColList: $4}
+  }
+
+linear_opt:
+  {
+    $$ = false
+  }
+| LINEAR
+  {
+    $$ = true
+  }
+
+key_algorithm_opt:
+  {
+    $$ = nil
+  }
+| ALGORITHM '=' INTEGRAL
+  {
+    $$ = NewIntLiteral($3)
+  }
+
+partitions_opt:
+  {
+    $$ = nil
+  }
+| PARTITIONS INTEGRAL
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+partition_definitions_opt:
+  {
+    $$ = nil
+  }
+| openb partition_definitions closeb
+  {
+    $$ = $2
   }
 
 rename_statement:
@@ -2339,7 +2502,7 @@ drop_statement:
   }
 | DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
   {
-    $$ = &DropView{FromTables: $5, IfExists: $4}
+    $$ = &DropView{FromTables: $5, IfExists: $4, Comments: Comments($2)}
   }
 | DROP comment_opt database_or_schema exists_opt table_id
   {
@@ -4832,6 +4995,7 @@ reserved_keyword:
 | LEFT
 | LIKE
 | LIMIT
+| LINEAR
 | LOCALTIME
 | LOCALTIMESTAMP
 | LOCK
@@ -4859,6 +5023,7 @@ reserved_keyword:
 | PARTITION
 | PERCENT_RANK
 | PRIMARY
+| RANGE
 | RANK
 | READ
 | RECURSIVE
@@ -5017,6 +5182,7 @@ non_reserved_keyword:
 | LEVEL
 | LINES
 | LINESTRING
+| LIST
 | LOAD
 | LOCAL
 | LOCKED
@@ -5066,6 +5232,7 @@ non_reserved_keyword:
 | PACK_KEYS
 | PARSER
 | PARTITIONING
+| PARTITIONS
 | PASSWORD
 | PATH
 | PERSIST
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// reshardingJournalPath returns the path of the resharding journal of a
+// shard, which is the journal written to the shard when a Reshard switched
+// its writes to other shards. It's kept outside of the shard, so that it
+// outlives the deletion of the shard.
+func reshardingJournalPath(keyspace, shard string) string {
+	return path.Join(KeyspacesPath, keyspace, ReshardingJournalsPath, shard)
+}
+
+// SaveReshardingJournal saves the resharding journal of a shard.
+func (ts *Server) SaveReshardingJournal(ctx context.Context, keyspace, shard string, journal *binlogdatapb.Journal) error {
+	data, err := proto.Marshal(journal)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, reshardingJournalPath(keyspace, shard), data, nil)
+	return err
+}
+
+// GetReshardingJournal returns the resharding journal of a shard.
+// It returns a NoNode error if the shard was never resharded.
+func (ts *Server) GetReshardingJournal(ctx context.Context, keyspace, shard string) (*binlogdatapb.Journal, error) {
+	data, _, err := ts.globalCell.Get(ctx, reshardingJournalPath(keyspace, shard))
+	if err != nil {
+		return nil, err
+	}
+	journal := &binlogdatapb.Journal{}
+	if err := proto.Unmarshal(data, journal); err != nil {
+		return nil, vterrors.Wrapf(err, "bad resharding journal data: %q", data)
+	}
+	return journal, nil
+}
+
+// DeleteReshardingJournals deletes the resharding journals of the shards of
+// a keyspace.
+func (ts *Server) DeleteReshardingJournals(ctx context.Context, keyspace string) error {
+	children, err := ts.globalCell.ListDir(ctx, path.Join(KeyspacesPath, keyspace, ReshardingJournalsPath), false /*full*/)
+	switch {
+	case err == nil:
+	case IsErrType(err, NoNode):
+		return nil
+	default:
+		return err
+	}
+	for _, shard := range DirEntriesToStringArray(children) {
+		if err := ts.globalCell.Delete(ctx, reshardingJournalPath(keyspace, shard), nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+	}
+	return nil
+}
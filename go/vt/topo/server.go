@@ -88,6 +88,8 @@ const (
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
 
+	ReshardingJournalsPath = "resharding_journals"
+
 	ExternalClusterMySQL  = "mysql"
 	ExternalClusterVitess = "vitess"
 )
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the DistributedTransactions command group for vtctl.
+
+const twopcGroupName = "DistributedTransactions"
+
+func init() {
+	addCommandGroup(twopcGroupName)
+
+	addCommand(twopcGroupName, command{
+		"ListDistributedTransactions",
+		commandListDistributedTransactions,
+		"[-abandon_age <duration>] <keyspace>",
+		"Lists the unresolved distributed (2PC) transactions of all shards in the keyspace, as metadata manager and as participant. Only transactions older than -abandon_age are listed."})
+	addCommand(twopcGroupName, command{
+		"ReadDistributedTransaction",
+		commandReadDistributedTransaction,
+		"<dtid>",
+		"Prints the state of a distributed transaction, its participants and their prepared state."})
+	addCommand(twopcGroupName, command{
+		"ConcludeDistributedTransaction",
+		commandConcludeDistributedTransaction,
+		"<dtid>",
+		"Resolves a distributed transaction the same way vtgate would: a transaction in the PREPARE state is rolled back, otherwise the recorded decision is applied to all participants. The transaction metadata is then removed."})
+	addCommand(twopcGroupName, command{
+		"RollbackDistributedTransaction",
+		commandRollbackDistributedTransaction,
+		"<dtid>",
+		"Rolls back a distributed transaction on all participants and removes its metadata. Fails if the commit decision was already made."})
+}
+
+func commandListDistributedTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	abandonAge := subFlags.Duration("abandon_age", 0, "Only list the transactions that were created at least this long ago")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ListDistributedTransactions command")
+	}
+	if *abandonAge < 0 {
+		return fmt.Errorf("-abandon_age cannot be negative: %v", *abandonAge)
+	}
+
+	shards, err := wr.ListDistributedTransactions(ctx, subFlags.Arg(0), *abandonAge)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), shards)
+}
+
+func commandReadDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the ReadDistributedTransaction command")
+	}
+
+	dt, err := wr.ReadDistributedTransaction(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if dt == nil {
+		wr.Logger().Printf("Distributed transaction %v is resolved or does not exist\n", subFlags.Arg(0))
+		return nil
+	}
+	return printJSON(wr.Logger(), dt)
+}
+
+func commandConcludeDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the ConcludeDistributedTransaction command")
+	}
+	return wr.ConcludeDistributedTransaction(ctx, subFlags.Arg(0))
+}
+
+func commandRollbackDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the RollbackDistributedTransaction command")
+	}
+	return wr.RollbackDistributedTransaction(ctx, subFlags.Arg(0))
+}
@@ -117,6 +117,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -215,6 +217,9 @@ var commands = []commandGroup{
 			{"VReplicationExec", commandVReplicationExec,
 				"[-json] <tablet alias> <sql command>",
 				"Runs the given VReplication command on the remote tablet."},
+			{"GetHotRows", commandGetHotRows,
+				"<tablet alias>",
+				"Displays the row (ranges) for which the automatic hot row detection of the specified tablet saw lock wait timeouts or deadlocks, and whether their transactions are currently serialized."},
 		},
 	},
 	{
@@ -302,10 +307,10 @@ var commands = []commandGroup{
 				"[-ping-tablets] <keyspace name>",
 				"Validates that all nodes reachable from the specified keyspace are consistent."},
 			{"Reshard", commandReshard,
-				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] [-on_ddl=<action>] [-on_ddl_strategy=<strategy>] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='master,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
 			{"MoveTables", commandMoveTables,
-				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-on_ddl=<action>] [-on_ddl_strategy=<strategy>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`},
 			{"Migrate", commandMigrate,
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
@@ -321,7 +326,8 @@ var commands = []commandGroup{
 				`Externalize a backfilled vindex.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL. " +
+					`With an "external_sink" like {"type": "kafka", "address": "broker:9092", "topic": "changes"}, or {"type": "file", "address": "/dir", "topic": "changes", "format": "avro", "max_file_size": 1000000}, the row changes are written to the sink instead of the target tables.`},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -329,8 +335,8 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-tables=<t1,t2>] [-incremental] [-format=json] <keyspace.workflow> [create|resume|stop|show [<uuid>]]",
+				"Perform a diff of all tables in the workflow. Without an action, the diff runs in this process. With an action, the diff runs on the target master tablets, which record its progress: create starts a diff and prints its uuid, stop and resume stop and resume it where it left off, and show reports its progress, ETA and, once completed, its results. An incremental diff only compares the rows that changed since the previous completed diff, and is meant as a final check before SwitchWrites. show defaults to the most recent diff of the workflow."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -408,7 +414,7 @@ var commands = []commandGroup{
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
 			{"OnlineDDL", commandOnlineDDL,
-				"<keyspace> <command> [<migration_uuid>]",
+				"<keyspace> <command> [<migration_uuid>] [<priority>]",
 				"Operates on online DDL (migrations). Examples:" +
 					" \nvtctl OnlineDDL test_keyspace show 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
 					" \nvtctl OnlineDDL test_keyspace show all" +
@@ -416,8 +422,16 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace complete 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace priority 82fa54ac_e83e_11ea_96b7_f875a4d24e90 10",
 			},
+			{"GetGCTables", commandGetGCTables,
+				"<keyspace/shard>",
+				"Displays the tables going through the table garbage collection lifecycle on the master of the shard, along with their state (HOLD, PURGE, EVAC, DROP), the time at which they move to their next state, and their size."},
+			{"CancelGCTableDrop", commandCancelGCTableDrop,
+				"<keyspace/shard> <table name> [<original name>]",
+				"Cancels the drop of a table on the master of the shard, by renaming the table, which must be in HOLD state, back to its original name. If the original name is not given, it is read from the online DDL migration which dropped the table."},
 
 			{"ValidateVersionShard", commandValidateVersionShard,
 				"<keyspace/shard>",
@@ -451,6 +465,9 @@ var commands = []commandGroup{
 			{"RebuildVSchemaGraph", commandRebuildVSchemaGraph,
 				"[-cells=c1,c2,...]",
 				"Rebuilds the cell-specific SrvVSchema from the global VSchema objects in the provided cells (or all cells if none provided)."},
+			{"RefreshVSchemaViews", commandRefreshVSchemaViews,
+				"[-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
+				"Records the views found in the keyspace's schema into its VSchema, as tables of type view along with their definition, so that VTGate can route queries against them. Shows the result after application."},
 		},
 	},
 	{
@@ -1142,6 +1159,24 @@ func commandVReplicationExec(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	return nil
 }
 
+func commandGetHotRows(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetHotRows command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	hotRows, err := wr.GetHotRows(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), hotRows)
+}
+
 func commandExecuteHook(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1834,6 +1869,8 @@ func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.F
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	onDDL := subFlags.String("on_ddl", "IGNORE", onDDLHelp)
+	onDDLStrategy := subFlags.String("on_ddl_strategy", "", onDDLStrategyHelp)
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1845,10 +1882,14 @@ func commandReshard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.F
 	if err != nil {
 		return err
 	}
+	onDDLAction, err := parseOnDDL(*onDDL)
+	if err != nil {
+		return err
+	}
 	source := strings.Split(subFlags.Arg(1), ",")
 	target := strings.Split(subFlags.Arg(2), ",")
 	return wr.Reshard(ctx, keyspace, workflow, source, target, *skipSchemaCopy, *cells,
-		*tabletTypes, *autoStart, *stopAfterCopy)
+		*tabletTypes, *autoStart, *stopAfterCopy, onDDLAction, *onDDLStrategy)
 }
 
 func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -1866,6 +1907,8 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	onDDL := subFlags.String("on_ddl", "IGNORE", onDDLHelp)
+	onDDLStrategy := subFlags.String("on_ddl_strategy", "", onDDLStrategyHelp)
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1886,11 +1929,31 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		}
 	}
 
+	onDDLAction, err := parseOnDDL(*onDDL)
+	if err != nil {
+		return err
+	}
+
 	source := subFlags.Arg(0)
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
 	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes, *allTables,
-		*excludes, *autoStart, *stopAfterCopy, "")
+		*excludes, *autoStart, *stopAfterCopy, "", onDDLAction, *onDDLStrategy)
+}
+
+// The help of the -on_ddl and -on_ddl_strategy flags of the commands which create vreplication workflows
+const (
+	onDDLHelp         = "What to do when DDL is encountered in the VReplication stream. Possible values are IGNORE, STOP, EXEC, EXEC_IGNORE and EXEC_COORDINATED"
+	onDDLStrategyHelp = "With -on_ddl=EXEC_COORDINATED, the ddl strategy used to apply the DDLs (e.g. online, gh-ost or pt-osc). The DDLs are applied directly by default"
+)
+
+// parseOnDDL parses the value of the -on_ddl flag.
+func parseOnDDL(onDDL string) (binlogdatapb.OnDDLAction, error) {
+	action, ok := binlogdatapb.OnDDLAction_value[strings.ToUpper(onDDL)]
+	if !ok {
+		return 0, fmt.Errorf("invalid value for -on_ddl: %s", onDDL)
+	}
+	return binlogdatapb.OnDDLAction(action), nil
 }
 
 // VReplicationWorkflowAction defines subcommands passed to vtctl for movetables or reshard
@@ -1934,6 +1997,8 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	onDDL := subFlags.String("on_ddl", "IGNORE", onDDLHelp)
+	onDDLStrategy := subFlags.String("on_ddl_strategy", "", onDDLStrategyHelp)
 
 	// MoveTables and Migrate params
 	tables := subFlags.String("tables", "", "A table spec or a list of tables")
@@ -1969,6 +2034,10 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		wr.Logger().Errorf("keyspace %s not found", target)
 		return err
 	}
+	onDDLAction, err := parseOnDDL(*onDDL)
+	if err != nil {
+		return err
+	}
 
 	vrwp := &wrangler.VReplicationWorkflowParams{
 		TargetKeyspace: target,
@@ -1976,6 +2045,8 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		DryRun:         *dryRun,
 		AutoStart:      *autoStart,
 		StopAfterCopy:  *stopAfterCopy,
+		OnDDL:          onDDLAction,
+		OnDDLStrategy:  *onDDLStrategy,
 	}
 
 	printDetails := func() error {
@@ -2333,17 +2404,30 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	maxRows := subFlags.Int64("limit", math.MaxInt64, "Max rows to stop comparing after")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	incremental := subFlags.Bool("incremental", false, "With the create action, only compare the rows that changed since the previous completed vdiff of the workflow")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
+	if subFlags.NArg() < 1 || subFlags.NArg() > 3 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
 	if err != nil {
 		return err
 	}
+	if subFlags.NArg() > 1 {
+		options := &tabletmanagerdatapb.VDiffOptions{
+			SourceCell:                  *sourceCell,
+			TabletTypes:                 *tabletTypes,
+			FilteredReplicationWaitTime: int64(filteredReplicationWaitTime.Seconds()),
+			Incremental:                 *incremental,
+		}
+		if *tables != "" {
+			options.Tables = strings.Split(*tables, ",")
+		}
+		return tabletVDiff(ctx, wr, keyspace, workflow, subFlags.Arg(1), subFlags.Arg(2), options, *format)
+	}
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
@@ -2358,6 +2442,66 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+// tabletVDiff performs an action on a diff that runs on the target master tablets.
+func tabletVDiff(ctx context.Context, wr *wrangler.Wrangler, keyspace, workflow, action, vdiffUUID string, options *tabletmanagerdatapb.VDiffOptions, format string) error {
+	switch action {
+	case "create":
+		vdiffUUID, err := wr.VDiffCreate(ctx, keyspace, workflow, options)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("VDiff %s created on the target shards of %s.%s, use 'VDiff %s.%s show %s' to view its progress\n", vdiffUUID, keyspace, workflow, keyspace, workflow, vdiffUUID)
+		return nil
+	case "resume", "stop":
+		if vdiffUUID == "" {
+			return fmt.Errorf("the <uuid> of the vdiff is required to %s it", action)
+		}
+		if action == "resume" {
+			return wr.VDiffResume(ctx, keyspace, workflow, vdiffUUID)
+		}
+		return wr.VDiffStop(ctx, keyspace, workflow, vdiffUUID)
+	case "show":
+		summary, err := wr.VDiffShow(ctx, keyspace, workflow, vdiffUUID)
+		if err != nil {
+			return err
+		}
+		if format == "json" {
+			return printJSON(wr.Logger(), summary)
+		}
+		wr.Logger().Printf("VDiff %s is %s\n", summary.UUID, summary.State)
+		for _, shard := range sortedShardNames(summary.Shards) {
+			if lastError := summary.Shards[shard].LastError; lastError != "" {
+				wr.Logger().Printf("Error on shard %s: %s\n", shard, lastError)
+			}
+		}
+		if summary.State != "completed" {
+			eta := "unknown"
+			if !summary.ETA.IsZero() {
+				eta = summary.ETA.String()
+			}
+			wr.Logger().Printf("Progress: %.2f%% (%d of ~%d rows compared), ETA: %s\n", summary.Progress, summary.ProcessedRows, summary.TableRows, eta)
+		}
+		for _, table := range summary.Tables() {
+			if summary.Incremental[table] {
+				wr.Logger().Printf("Summary for %v (changed rows only): %+v\n", table, *summary.Reports[table])
+				continue
+			}
+			wr.Logger().Printf("Summary for %v: %+v\n", table, *summary.Reports[table])
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid VDiff action %q, expected one of create, resume, stop or show", action)
+}
+
+func sortedShardNames(shards map[string]*tabletmanagerdatapb.VDiffResponse) []string {
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2914,6 +3058,29 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 			uuid = arg
 			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='cancel' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
 		}
+	case "complete":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			uuid = arg
+			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='complete' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+		}
+	case "priority":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			if subFlags.NArg() < 4 {
+				return fmt.Errorf("priority value required")
+			}
+			priority, err := strconv.ParseInt(subFlags.Args()[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid priority: %s", subFlags.Args()[3])
+			}
+			uuid = arg
+			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set priority=%a where migration_uuid=%a`, sqltypes.Int64BindVariable(priority), sqltypes.StringBindVariable(arg))
+		}
 	case "cancel-all":
 		{
 			if arg != "" {
@@ -3003,6 +3170,43 @@ func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return err
 }
 
+func commandGetGCTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the GetGCTables command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	gcTables, err := wr.GetGCTables(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), gcTables)
+}
+
+func commandCancelGCTableDrop(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 && subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace/shard> and <table name> arguments are required for the CancelGCTableDrop command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	restoredName, err := wr.CancelGCTableDrop(ctx, keyspace, shard, subFlags.Arg(1), subFlags.Arg(2))
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Table %v restored to %v\n", subFlags.Arg(1), restoredName)
+	return nil
+}
+
 func commandValidateVersionShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -3124,6 +3328,42 @@ func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subF
 	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
 }
 
+func commandRefreshVSchemaViews(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the RefreshVSchemaViews command")
+	}
+	keyspace := subFlags.Arg(0)
+
+	vs, err := wr.RefreshVSchemaViews(ctx, keyspace, *dryRun)
+	if err != nil {
+		return err
+	}
+	b, err := json2.MarshalIndentPB(vs, "  ")
+	if err != nil {
+		wr.Logger().Errorf2(err, "Failed to marshal VSchema for display")
+	} else {
+		wr.Logger().Printf("New VSchema object:\n%s\n", b)
+	}
+
+	if *dryRun {
+		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
+		return nil
+	}
+	if *skipRebuild {
+		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return nil
+	}
+	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
+}
+
 func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	vschema := subFlags.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFile := subFlags.String("vschema_file", "", "Identifies the VTGate routing schema file")
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	twopczTemplate = template.Must(template.New("twopcz").Funcs(template.FuncMap{
+		"unixNano": func(ns int64) string { return time.Unix(0, ns).Format(time.RFC3339) },
+	}).Parse(`<!DOCTYPE html>
+<style type="text/css">
+	table.gridtable {
+		font-family: verdana,arial,sans-serif;
+		font-size: 11px;
+		border-width: 1px;
+		border-collapse: collapse;
+	}
+	table.gridtable th, table.gridtable td {
+		border-width: 1px;
+		padding: 5px;
+		border-style: solid;
+	}
+	table.gridtable th {
+		background-color: #dedede;
+		white-space: nowrap;
+	}
+</style>
+<h2>WARNING: Actions on this page can jeopardize data integrity.</h2>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+{{range .Keyspaces}}
+<h3>Keyspace {{.Keyspace}}</h3>
+{{if .Error}}<p>Error: {{.Error}}</p>{{end}}
+<table class="gridtable">
+	<thead><tr>
+		<th>Shard</th>
+		<th>Tablet</th>
+		<th>DTID</th>
+		<th>State</th>
+		<th>Created</th>
+		<th>Participants</th>
+		<th>Action</th>
+	</tr></thead>
+	{{range $shard := .Shards}}{{range .Transactions}}
+	<tr>
+		<td>{{$shard.Shard}}</td>
+		<td>{{$shard.TabletAlias}}</td>
+		<td>{{.Dtid}}</td>
+		<td>{{.State}}</td>
+		<td>{{unixNano .TimeCreated}}</td>
+		<td>{{range .Participants}}{{.Keyspace}}:{{.Shard}}<br>{{end}}</td>
+		<td><form method="post">
+			<input type="hidden" name="dtid" value="{{.Dtid}}"></input>
+			<input type="submit" name="Action" value="Conclude"></input>
+			<input type="submit" name="Action" value="Rollback"></input>
+		</form></td>
+	</tr>
+	{{end}}{{end}}
+</table>
+<table class="gridtable">
+	<thead><tr>
+		<th>Shard</th>
+		<th>Tablet</th>
+		<th>DTID</th>
+		<th>State</th>
+		<th>Created</th>
+		<th>Queries</th>
+	</tr></thead>
+	{{range $shard := .Shards}}{{range .Prepared}}
+	<tr>
+		<td>{{$shard.Shard}}</td>
+		<td>{{$shard.TabletAlias}}</td>
+		<td>{{.Dtid}}</td>
+		<td>{{if .Failed}}Failed{{else}}Prepared{{end}}</td>
+		<td>{{unixNano .TimeCreated}}</td>
+		<td>{{range .Queries}}{{.}}<br>{{end}}</td>
+	</tr>
+	{{end}}{{end}}
+</table>
+{{range .Shards}}{{if .Error}}<p>Shard {{.Shard}}: {{.Error}}</p>{{end}}{{end}}
+{{end}}
+`))
+)
+
+// keyspaceTransactions is the aggregated view of the unresolved
+// distributed transactions of a keyspace.
+type keyspaceTransactions struct {
+	Keyspace string
+	Shards   []*wrangler.ShardTransactions
+	Error    string `json:",omitempty"`
+}
+
+// initTwopcz registers the /twopcz page, which aggregates the unresolved
+// distributed transactions of all shards, and allows to conclude or
+// roll them back.
+func initTwopcz(ts *topo.Server) {
+	http.HandleFunc("/twopcz", func(w http.ResponseWriter, r *http.Request) {
+		wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+		twopczHandler(r.Context(), wr, w, r)
+	})
+}
+
+// twopczHandler serves the /twopcz page. The keyspace parameter restricts the
+// view to one keyspace, abandon_age hides the transactions that are younger
+// than the given duration, and format=json returns the data as JSON.
+func twopczHandler(ctx context.Context, wr *wrangler.Wrangler, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var msg string
+	if action := r.PostFormValue("Action"); action != "" {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		dtid := r.PostFormValue("dtid")
+		var err error
+		switch action {
+		case "Conclude":
+			err = wr.ConcludeDistributedTransaction(ctx, dtid)
+		case "Rollback":
+			err = wr.RollbackDistributedTransaction(ctx, dtid)
+		default:
+			err = fmt.Errorf("unknown action")
+		}
+		if err != nil {
+			msg = fmt.Sprintf("%s(%s): %v", action, dtid, err)
+		} else {
+			msg = fmt.Sprintf("%s(%s): completed.", action, dtid)
+		}
+	}
+
+	var abandonAge time.Duration
+	if s := r.FormValue("abandon_age"); s != "" {
+		var err error
+		if abandonAge, err = time.ParseDuration(s); err != nil {
+			http.Error(w, fmt.Sprintf("invalid abandon_age: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+	keyspaces := []string{r.FormValue("keyspace")}
+	if keyspaces[0] == "" {
+		var err error
+		if keyspaces, err = wr.TopoServer().GetKeyspaces(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sort.Strings(keyspaces)
+	}
+	results := make([]*keyspaceTransactions, 0, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		kt := &keyspaceTransactions{Keyspace: keyspace}
+		shards, err := wr.ListDistributedTransactions(ctx, keyspace, abandonAge)
+		if err != nil {
+			kt.Error = err.Error()
+		}
+		kt.Shards = shards
+		results = append(results, kt)
+	}
+
+	if r.FormValue("format") == "json" {
+		js, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(js)
+		return
+	}
+
+	data := struct {
+		Message   string
+		Keyspaces []*keyspaceTransactions
+	}{
+		Message:   msg,
+		Keyspaces: results,
+	}
+	if err := twopczTemplate.Execute(w, data); err != nil {
+		log.Errorf("twopcz: couldn't execute template: %v", err)
+	}
+}
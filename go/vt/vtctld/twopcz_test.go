@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var twopczConn *sandboxconn.SandboxConn
+
+func init() {
+	tabletconn.RegisterDialer("TwopczTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		if twopczConn == nil {
+			return nil, fmt.Errorf("tablet %d not found", tablet.Alias.Uid)
+		}
+		return twopczConn, nil
+	})
+}
+
+func TestTwopczHandler(t *testing.T) {
+	ctx := context.Background()
+	protocol := flag.Lookup("tablet_protocol").Value.String()
+	flag.Set("tablet_protocol", "TwopczTest")
+	defer flag.Set("tablet_protocol", protocol)
+
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, tablet))
+	_, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = tablet.Alias
+		return nil
+	})
+	require.NoError(t, err)
+
+	twopczConn = sandboxconn.NewSandboxConn(tablet)
+	defer func() { twopczConn = nil }()
+	metadata := &querypb.TransactionMetadata{
+		Dtid:         "ks:0:1234",
+		State:        querypb.TransactionState_COMMIT,
+		TimeCreated:  1,
+		Participants: []*querypb.Target{{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER}},
+	}
+	twopczConn.UnresolvedResults = []*querypb.TransactionMetadata{metadata}
+	twopczConn.PreparedResults = []*querypb.PreparedTransaction{{Dtid: "ks:0:1234", TimeCreated: 1, Queries: []string{"insert into t values(1)"}}}
+	wr := wrangler.New(logutil.NewMemoryLogger(), ts, nil)
+
+	// JSON view.
+	w := httptest.NewRecorder()
+	twopczHandler(ctx, wr, w, httptest.NewRequest("GET", "/twopcz?format=json", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var got []*keyspaceTransactions
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "ks", got[0].Keyspace)
+	require.Len(t, got[0].Shards, 1)
+	assert.Equal(t, "cell1-0000000100", got[0].Shards[0].TabletAlias)
+	assert.Len(t, got[0].Shards[0].Transactions, 1)
+	assert.Len(t, got[0].Shards[0].Prepared, 1)
+
+	// HTML view.
+	w = httptest.NewRecorder()
+	twopczHandler(ctx, wr, w, httptest.NewRequest("GET", "/twopcz?keyspace=ks", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "ks:0:1234")
+	assert.Contains(t, w.Body.String(), "insert into t values(1)")
+
+	// Conclude action.
+	twopczConn.ReadTransactionResults = []*querypb.TransactionMetadata{metadata}
+	form := url.Values{"dtid": {"ks:0:1234"}, "Action": {"Conclude"}}
+	r := httptest.NewRequest("POST", "/twopcz", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	twopczHandler(ctx, wr, w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "Conclude(ks:0:1234): completed.")
+	assert.EqualValues(t, 1, twopczConn.CommitPreparedCount.Get())
+	assert.EqualValues(t, 1, twopczConn.ConcludeTransactionCount.Get())
+
+	// Bad abandon age.
+	w = httptest.NewRecorder()
+	twopczHandler(ctx, wr, w, httptest.NewRequest("GET", "/twopcz?abandon_age=abc", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
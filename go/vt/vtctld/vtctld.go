@@ -123,6 +123,9 @@ func InitVtctld(ts *topo.Server) {
 			return "", wr.ReloadSchema(ctx, tabletAlias)
 		})
 
+	// Aggregated view of the unresolved distributed transactions.
+	initTwopcz(ts)
+
 	// Anything unrecognized gets redirected to the main app page.
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, appPrefix, http.StatusFound)
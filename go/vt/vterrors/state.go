@@ -43,6 +43,8 @@ const (
 	InnodbReadOnly
 	WrongNumberOfColumnsInSelect
 	CantDoThisInTransaction
+	RowIsReferenced
+	NoReferencedRow
 
 	// not found
 	BadDb
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/jsonutil"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 
@@ -296,6 +297,21 @@ type outputQuery struct {
 	sql    string
 }
 
+// DiffSchema returns the statements that migrate the given SQL schema to the declared one,
+// in the order they should be applied. Both schemas are sequences of CREATE TABLE and
+// CREATE VIEW statements.
+func DiffSchema(sqlSchema, declaredSchema string) ([]string, error) {
+	stmts, err := schemadiff.DiffSchemasSQL(sqlSchema, declaredSchema, &schemadiff.DiffHints{})
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		queries = append(queries, sqlparser.String(stmt))
+	}
+	return queries, nil
+}
+
 // ExplainsAsText returns a text representation of the explains in logical time
 // order
 func ExplainsAsText(explains []*Explain) string {
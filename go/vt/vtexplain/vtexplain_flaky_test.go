@@ -182,6 +182,22 @@ func TestErrors(t *testing.T) {
 	}
 }
 
+func TestDiffSchema(t *testing.T) {
+	queries, err := DiffSchema(
+		"create table t1 (id int primary key); create table t2 (id int primary key)",
+		"create table t1 (id int primary key, name varchar(64), key name_idx (name)); create view v as select id from t1",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"drop table t2",
+		"alter table t1 add column `name` varchar(64) after id, add key name_idx (`name`)",
+		"create view v as select id from t1",
+	}, queries)
+
+	_, err = DiffSchema("create table t1 (id int primary key)", "insert into t1 values (1)")
+	require.Error(t, err)
+}
+
 func TestJSONOutput(t *testing.T) {
 	sql := "select 1 from user where id = 1"
 	explains, err := Run(sql)
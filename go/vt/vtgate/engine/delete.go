@@ -134,6 +134,11 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if len(del.FKChildren) > 0 {
+		if err := del.enforceForeignKeys(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
+			return nil, err
+		}
+	}
 	if del.OwnedVindexQuery != "" {
 		err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
 		if err != nil {
@@ -153,6 +158,11 @@ func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 		return nil, err
 	}
 
+	if len(del.FKChildren) > 0 {
+		if err := del.enforceForeignKeys(vcursor, bindVars, rss); err != nil {
+			return nil, err
+		}
+	}
 	if del.OwnedVindexQuery != "" {
 		if err := del.deleteVindexEntries(vcursor, bindVars, rss); err != nil {
 			return nil, err
@@ -178,6 +188,11 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 			BindVariables: bindVars,
 		}
 	}
+	if len(del.FKChildren) > 0 {
+		if err := del.enforceForeignKeys(vcursor, bindVars, rss); err != nil {
+			return nil, err
+		}
+	}
 	if len(del.Table.Owned) > 0 {
 		err = del.deleteVindexEntries(vcursor, bindVars, rss)
 		if err != nil {
@@ -259,4 +274,12 @@ func addFieldsIfNotEmpty(dml DML, other map[string]interface{}) {
 	if len(dml.Values) > 0 {
 		other["Values"] = dml.Values
 	}
+	if len(dml.FKChildren) > 0 {
+		other["FKQuery"] = dml.FKQuery
+		fkChildren := make([]string, 0, len(dml.FKChildren))
+		for _, fk := range dml.FKChildren {
+			fkChildren = append(fkChildren, fmt.Sprintf("%s:%s", fk.Table.Name.String(), fk.Action))
+		}
+		other["FKChildren"] = fkChildren
+	}
 }
@@ -20,9 +20,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -335,3 +338,75 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
 	})
 }
+
+func TestDeleteForeignKeys(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:   Scatter,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_delete",
+			Table:    ks.Tables["t2"],
+			FKQuery:  "dummy_fk_query",
+			FKChildren: []*FKChild{{
+				Name:    "fk_c1",
+				Table:   &vindexes.Table{Name: sqlparser.NewTableIdent("c1")},
+				Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("t2_id")},
+				Offsets: []int{0},
+				Action:  vindexes.FKRestrict,
+			}, {
+				Name:    "fk_c2",
+				Table:   &vindexes.Table{Name: sqlparser.NewTableIdent("c2")},
+				Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("t2_id"), sqlparser.NewColIdent("t2_name")},
+				Offsets: []int{0, 1},
+				Action:  vindexes.FKCascade,
+			}},
+		},
+	}
+	parentResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|name",
+			"int64|varchar",
+		),
+		"1|a",
+		"2|b",
+		"1|a",
+		"null|c",
+	)
+
+	// The child rows of c2 are deleted, once c1 has no child rows.
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{parentResult}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: dummy_fk_query {} sharded.20-: dummy_fk_query {} false false`,
+		// The child rows may be in any shard. Duplicate and NULL parent values are skipped.
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select 1 from c1 where t2_id in (:fk_0_0, :fk_1_0) limit 1 lock in share mode {fk_0_0: type:INT64 value:"1" fk_1_0: type:INT64 value:"2" } ` +
+			`sharded.20-: select 1 from c1 where t2_id in (:fk_0_0, :fk_1_0) limit 1 lock in share mode {fk_0_0: type:INT64 value:"1" fk_1_0: type:INT64 value:"2" } false false`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: delete from c2 where (t2_id, t2_name) in ((:fk_0_0, :fk_0_1), (:fk_1_0, :fk_1_1)) {fk_0_0: type:INT64 value:"1" fk_0_1: type:VARCHAR value:"a" fk_1_0: type:INT64 value:"2" fk_1_1: type:VARCHAR value:"b" } ` +
+			`sharded.20-: delete from c2 where (t2_id, t2_name) in ((:fk_0_0, :fk_0_1), (:fk_1_0, :fk_1_1)) {fk_0_0: type:INT64 value:"1" fk_0_1: type:VARCHAR value:"a" fk_1_0: type:INT64 value:"2" fk_1_1: type:VARCHAR value:"b" } true false`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+
+	// The delete fails if c1 has child rows.
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{parentResult, sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1")}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Cannot delete or update a parent row: a foreign key constraint fails (fk_c1)")
+	assert.Equal(t, vterrors.RowIsReferenced, vterrors.ErrState(err))
+
+	// Nothing is enforced if no rows are deleted.
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: dummy_fk_query {} sharded.20-: dummy_fk_query {} false false`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+}
@@ -55,6 +55,13 @@ type DML struct {
 	// OwnedVindexQuery is used for updating changes in lookup vindexes.
 	OwnedVindexQuery string
 
+	// FKQuery selects the parent columns referenced by FKChildren from the rows modified by the query.
+	FKQuery string
+
+	// FKChildren are the cross-shard foreign keys which reference the table.
+	// They are enforced before the query is executed.
+	FKChildren []*FKChild
+
 	// Option to override the standard behavior and allow a multi-shard update
 	// to use single round trip autocommit.
 	MultiShardAutocommit bool
@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// FKChild is a foreign key which references the table of a Delete or an Update, and whose child rows
+// may live in other shards than their parent row. MySQL can't enforce such a foreign key, so the
+// primitive enforces it before it modifies the parent rows.
+type FKChild struct {
+	// Name describes the foreign key in errors.
+	Name string
+
+	// Table is the child table. It belongs to the keyspace of the parent table.
+	Table *vindexes.Table
+
+	// Columns are the referencing columns of the child table.
+	Columns []sqlparser.ColIdent
+
+	// Offsets are the offsets of the referenced parent columns in the rows returned by FKQuery,
+	// in the order of Columns.
+	Offsets []int
+
+	// Action is the referential action of the foreign key: restrict, no action or cascade.
+	Action string
+
+	// Values are the new values an Update sets to the referenced parent columns, keyed by the
+	// name of the child column which references them. They are nil for a Delete.
+	Values map[string]sqltypes.PlanValue
+}
+
+// enforceForeignKeys enforces the cross-shard foreign keys which reference the rows that the DML is about to
+// modify on the given shards. Restricting foreign keys fail the DML if child rows reference these rows, and
+// cascading ones delete or update the child rows.
+// Note: the commit order may be different from the DML order because it's possible
+// for DMLs to reuse existing transactions.
+func (dml *DML) enforceForeignKeys(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: dml.FKQuery, BindVariables: bindVars}
+	}
+	parentResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
+	for _, err := range errors {
+		if err != nil {
+			return err
+		}
+	}
+	if len(parentResult.Rows) == 0 {
+		return nil
+	}
+
+	// Child rows may live in any shard of the keyspace.
+	childRss, _, err := vcursor.ResolveDestinations(dml.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return err
+	}
+	for _, fk := range dml.FKChildren {
+		if err := fk.enforce(vcursor, bindVars, parentResult.Rows, childRss); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fk *FKChild) enforce(vcursor VCursor, bindVars map[string]*querypb.BindVariable, parentRows [][]sqltypes.Value, rss []*srvtopo.ResolvedShard) error {
+	// Resolve the new values of the referenced columns, for an Update.
+	newValues := make(map[int]sqltypes.Value, len(fk.Values))
+	for i, col := range fk.Columns {
+		pv, ok := fk.Values[col.String()]
+		if !ok {
+			continue
+		}
+		val, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return err
+		}
+		newValues[i] = val
+	}
+
+	rows, err := fk.referencedRows(parentRows, newValues)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	childBindVars := make(map[string]*querypb.BindVariable)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	switch {
+	case fk.Action != vindexes.FKCascade:
+		buf.Myprintf("select 1 from %v", fk.Table.Name)
+	case len(newValues) == 0:
+		buf.Myprintf("delete from %v", fk.Table.Name)
+	default:
+		buf.Myprintf("update %v set ", fk.Table.Name)
+		sep := ""
+		for i, col := range fk.Columns {
+			val, ok := newValues[i]
+			if !ok {
+				continue
+			}
+			name := fmt.Sprintf("fk_new%d", i)
+			childBindVars[name] = sqltypes.ValueBindVariable(val)
+			buf.Myprintf("%s%v = %v", sep, col, sqlparser.NewArgument(name))
+			sep = ", "
+		}
+	}
+	buf.Myprintf(" where %v", fk.whereExpr(rows, childBindVars))
+	if fk.Action != vindexes.FKCascade {
+		// The probe locks the child rows it reads, and the gaps around them, so that no child row can reference
+		// the parent rows until they are changed
+		buf.Myprintf(" limit 1%s", sqlparser.ShareModeStr)
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: buf.String(), BindVariables: childBindVars}
+	}
+	result, errors := vcursor.ExecuteMultiShard(rss, queries, fk.Action == vindexes.FKCascade /* rollbackOnError */, false /* canAutocommit */)
+	for _, err := range errors {
+		if err != nil {
+			return err
+		}
+	}
+	if fk.Action != vindexes.FKCascade && len(result.Rows) != 0 {
+		return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RowIsReferenced, "Cannot delete or update a parent row: a foreign key constraint fails (%s)", fk.Name)
+	}
+	return nil
+}
+
+// referencedRows returns the distinct values of the referenced parent columns, which child rows may reference.
+// Rows with a NULL referenced value are not referenced. For an Update, the rows whose referenced values don't
+// change are skipped as well.
+func (fk *FKChild) referencedRows(parentRows [][]sqltypes.Value, newValues map[int]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	seen := make(map[string]bool)
+outer:
+	for _, parentRow := range parentRows {
+		row := make([]sqltypes.Value, 0, len(fk.Offsets))
+		changed := len(newValues) == 0
+		keyParts := make([]string, 0, len(fk.Offsets))
+		for i, offset := range fk.Offsets {
+			val := parentRow[offset]
+			if val.IsNull() {
+				continue outer
+			}
+			if newVal, ok := newValues[i]; ok && !changed {
+				cmp, err := evalengine.NullsafeCompare(val, newVal)
+				if err != nil {
+					return nil, err
+				}
+				changed = cmp != 0
+			}
+			row = append(row, val)
+			keyParts = append(keyParts, val.String())
+		}
+		rowKey := strings.Join(keyParts, ",")
+		if !changed || seen[rowKey] {
+			continue
+		}
+		seen[rowKey] = true
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// whereExpr returns the condition which matches the child rows referencing the given parent rows.
+func (fk *FKChild) whereExpr(rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) sqlparser.Expr {
+	return inExpr(fk.Columns, rows, bindVars)
+}
+
+// FKParent is a foreign key of the table of an Insert, whose parent rows may live in other shards than the
+// child rows. MySQL can't enforce such a foreign key, so the primitive checks that the parent rows exist
+// before it inserts the child rows.
+type FKParent struct {
+	// Name describes the foreign key in errors.
+	Name string
+
+	// Table is the parent table. It belongs to the keyspace of the child table.
+	Table *vindexes.Table
+
+	// Columns are the referenced columns of the parent table.
+	Columns []sqlparser.ColIdent
+
+	// Values are the values the Insert gives to the referencing child columns, in the order of Columns.
+	// Values[i].Values[k] is the value of the i'th column in row k.
+	Values []sqltypes.PlanValue
+}
+
+// check returns an error if a parent row which the inserted rows reference does not exist. The probe locks
+// the parent rows it reads, so that they can't be deleted or changed until the child rows are inserted.
+func (fk *FKParent) check(vcursor VCursor, bindVars map[string]*querypb.BindVariable) error {
+	columnValues := make([][]sqltypes.Value, len(fk.Values))
+	for i, pv := range fk.Values {
+		vals, err := pv.ResolveList(bindVars)
+		if err != nil {
+			return err
+		}
+		columnValues[i] = vals
+	}
+
+	// Rows with a NULL referencing value don't reference a parent row.
+	var rows [][]sqltypes.Value
+	wanted := make(map[string]bool)
+outer:
+	for k := range columnValues[0] {
+		row := make([]sqltypes.Value, 0, len(columnValues))
+		for i := range columnValues {
+			val := columnValues[i][k]
+			if val.IsNull() {
+				continue outer
+			}
+			row = append(row, val)
+		}
+		rowKey := fkRowKey(row)
+		if wanted[rowKey] {
+			continue
+		}
+		wanted[rowKey] = true
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	probeBindVars := make(map[string]*querypb.BindVariable)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, col := range fk.Columns {
+		if i == 0 {
+			buf.Myprintf("select %v", col)
+		} else {
+			buf.Myprintf(", %v", col)
+		}
+	}
+	buf.Myprintf(" from %v where %v%s", fk.Table.Name, inExpr(fk.Columns, rows, probeBindVars), sqlparser.ShareModeStr)
+
+	// Parent rows may live in any shard of the keyspace.
+	rss, _, err := vcursor.ResolveDestinations(fk.Table.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: buf.String(), BindVariables: probeBindVars}
+	}
+	result, errors := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+	for _, err := range errors {
+		if err != nil {
+			return err
+		}
+	}
+	for _, row := range result.Rows {
+		delete(wanted, fkRowKey(row))
+	}
+	if len(wanted) != 0 {
+		return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoReferencedRow, "Cannot add or update a child row: a foreign key constraint fails (%s)", fk.Name)
+	}
+	return nil
+}
+
+func fkRowKey(row []sqltypes.Value) string {
+	keyParts := make([]string, 0, len(row))
+	for _, val := range row {
+		keyParts = append(keyParts, val.ToString())
+	}
+	return strings.Join(keyParts, ",")
+}
+
+// inExpr returns the condition which matches the rows whose columns have one of the given rows of values.
+func inExpr(columns []sqlparser.ColIdent, rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) sqlparser.Expr {
+	values := make(sqlparser.ValTuple, 0, len(rows))
+	for i, row := range rows {
+		tuple := make(sqlparser.ValTuple, 0, len(row))
+		for j, val := range row {
+			name := fmt.Sprintf("fk_%d_%d", i, j)
+			bindVars[name] = sqltypes.ValueBindVariable(val)
+			tuple = append(tuple, sqlparser.NewArgument(name))
+		}
+		if len(tuple) == 1 {
+			values = append(values, tuple[0])
+		} else {
+			values = append(values, tuple)
+		}
+	}
+	var left sqlparser.Expr
+	if len(columns) == 1 {
+		left = &sqlparser.ColName{Name: columns[0]}
+	} else {
+		cols := make(sqlparser.ValTuple, 0, len(columns))
+		for _, col := range columns {
+			cols = append(cols, &sqlparser.ColName{Name: col})
+		}
+		left = cols
+	}
+	return &sqlparser.ComparisonExpr{Operator: sqlparser.InOp, Left: left, Right: values}
+}
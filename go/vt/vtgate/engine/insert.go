@@ -84,6 +84,9 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
+	// FKParents are the cross-shard foreign keys of the table, whose parent rows must exist.
+	FKParents []*FKParent
+
 	// Insert does not take inputs
 	noInputs
 
@@ -252,12 +255,17 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	if err != nil {
 		return nil, err
 	}
+	for _, fk := range ins.FKParents {
+		if err := fk.check(vcursor, bindVars); err != nil {
+			return nil, err
+		}
+	}
 	rss, queries, err := ins.getInsertShardedRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
 	}
 
-	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && len(ins.FKParents) == 0 && vcursor.AutocommitApproval()
 	err = allowOnlyMaster(rss...)
 	if err != nil {
 		return nil, err
@@ -619,6 +627,13 @@ func (ins *Insert) description() PrimitiveDescription {
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if len(ins.FKParents) > 0 {
+		fkParents := make([]string, 0, len(ins.FKParents))
+		for _, fk := range ins.FKParents {
+			fkParents = append(fkParents, fk.Table.Name.String())
+		}
+		other["FKParents"] = fkParents
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -23,6 +23,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -316,6 +318,104 @@ func TestInsertShardedSimple(t *testing.T) {
 	})
 }
 
+func TestInsertShardedForeignKeys(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"p": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	require.NoError(t, err)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// 3 rows.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}, {
+					Value: sqltypes.NewInt64(3),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2", " mid3"},
+		" suffix",
+	)
+	ins.FKParents = []*FKParent{{
+		Name:    "fk_p",
+		Table:   ks.Tables["p"],
+		Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("email")},
+		Values: []sqltypes.PlanValue{{
+			// 3 rows.
+			Values: []sqltypes.PlanValue{{
+				Value: sqltypes.NewVarChar("a"),
+			}, {
+				// NULL
+			}, {
+				Key: "name",
+			}},
+		}},
+	}}
+	bindVars := map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("a")}
+	probe := `select email from p where email in (:fk_0_0) lock in share mode {fk_0_0: type:VARCHAR value:"a" }`
+
+	// The parent rows may be in any shard. Duplicate and NULL values are probed once and not at all.
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar"), "a")}
+	_, err = ins.Execute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: ` + probe + ` sharded.20-: ` + probe + ` false false`,
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1, mid3 suffix {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" _id_2: type:INT64 value:"3" name: type:VARBINARY value:"a" } ` +
+			`sharded.-20: prefix mid2 suffix {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" _id_2: type:INT64 value:"3" name: type:VARBINARY value:"a" } ` +
+			`true false`,
+	})
+
+	// The insert fails if a parent row does not exist.
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	_, err = ins.Execute(vc, bindVars, false)
+	require.EqualError(t, err, "Cannot add or update a child row: a foreign key constraint fails (fk_p)")
+	require.Equal(t, vterrors.NoReferencedRow, vterrors.ErrState(err))
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard sharded.-20: ` + probe + ` sharded.20-: ` + probe + ` false false`,
+	})
+}
+
 func TestInsertShardedFail(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -88,6 +88,28 @@ func (v *OnlineDDL) Execute(vcursor VCursor, bindVars map[string]*query.BindVari
 	if err != nil {
 		return result, err
 	}
+	if v.DDLStrategySetting.IsDryRun() {
+		// A dry run creates no migration. The tablets respond with a report per shard.
+		dryRunResult := &sqltypes.Result{}
+		for _, onlineDDL := range onlineDDLs {
+			s := Send{
+				Keyspace:          v.Keyspace,
+				TargetDestination: v.TargetDestination,
+				Query:             onlineDDL.SQL,
+				IsDML:             false,
+				SingleShardOnly:   false,
+			}
+			qr, err := s.Execute(vcursor, bindVars, wantfields)
+			if err != nil {
+				return dryRunResult, err
+			}
+			if dryRunResult.Fields == nil {
+				dryRunResult.Fields = qr.Fields
+			}
+			dryRunResult.Rows = append(dryRunResult.Rows, qr.Rows...)
+		}
+		return dryRunResult, nil
+	}
 	for _, onlineDDL := range onlineDDLs {
 		if onlineDDL.StrategySetting().IsSkipTopo() {
 			// Go directly to tablets, much like Send primitive does
@@ -93,6 +93,17 @@ func (v *RevertMigration) Execute(vcursor VCursor, bindVars map[string]*query.Bi
 		return result, err
 	}
 
+	if ddlStrategySetting.IsDryRun() {
+		// A dry run creates no migration. The tablets respond with a report per shard.
+		s := Send{
+			Keyspace:          v.Keyspace,
+			TargetDestination: v.TargetDestination,
+			Query:             onlineDDL.SQL,
+			IsDML:             false,
+			SingleShardOnly:   false,
+		}
+		return s.Execute(vcursor, bindVars, wantfields)
+	}
 	if ddlStrategySetting.IsSkipTopo() {
 		s := Send{
 			Keyspace:          v.Keyspace,
@@ -145,6 +145,11 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if len(upd.FKChildren) > 0 {
+		if err := upd.enforceForeignKeys(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
+			return nil, err
+		}
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
 			return nil, err
@@ -162,6 +167,11 @@ func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 	if err != nil {
 		return nil, err
 	}
+	if len(upd.FKChildren) > 0 {
+		if err := upd.enforceForeignKeys(vcursor, bindVars, rss); err != nil {
+			return nil, err
+		}
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
 			return nil, err
@@ -188,6 +198,12 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 		}
 	}
 
+	if len(upd.FKChildren) > 0 {
+		if err := upd.enforceForeignKeys(vcursor, bindVars, rss); err != nil {
+			return nil, err
+		}
+	}
+
 	// update any owned vindexes
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
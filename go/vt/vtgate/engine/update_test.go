@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -595,6 +596,61 @@ func TestUpdateInChangedVindex(t *testing.T) {
 	})
 }
 
+func TestUpdateForeignKeys(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: DML{
+			Opcode:   Equal,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_update",
+			Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:   []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			Table:    ks.Tables["t2"],
+			FKQuery:  "dummy_fk_query",
+			FKChildren: []*FKChild{{
+				Name:    "fk_c1",
+				Table:   &vindexes.Table{Name: sqlparser.NewTableIdent("c1")},
+				Columns: []sqlparser.ColIdent{sqlparser.NewColIdent("t2_code"), sqlparser.NewColIdent("t2_name")},
+				Offsets: []int{0, 1},
+				Action:  vindexes.FKCascade,
+				Values:  map[string]sqltypes.PlanValue{"t2_code": {Key: "code"}},
+			}},
+		},
+	}
+
+	// The child rows are updated to the new value, except those of the parent rows which already have it.
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"code|name",
+			"int64|varchar",
+		),
+		"1|a",
+		"5|b",
+	)}
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{"code": sqltypes.Int64BindVariable(5)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_fk_query {code: type:INT64 value:"5" } false false`,
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: update c1 set t2_code = :fk_new0 where (t2_code, t2_name) in ((:fk_0_0, :fk_0_1)) {fk_0_0: type:INT64 value:"1" fk_0_1: type:VARCHAR value:"a" fk_new0: type:INT64 value:"5" } ` +
+			`sharded.20-: update c1 set t2_code = :fk_new0 where (t2_code, t2_name) in ((:fk_0_0, :fk_0_1)) {fk_0_0: type:INT64 value:"1" fk_0_1: type:VARCHAR value:"a" fk_new0: type:INT64 value:"5" } true false`,
+		`ExecuteMultiShard sharded.-20: dummy_update {code: type:INT64 value:"5" } true true`,
+	})
+
+	// A restricting foreign key fails the update if child rows reference the parent rows.
+	upd.FKChildren[0].Action = vindexes.FKRestrict
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("code|name", "int64|varchar"), "1|a"),
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"),
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{"code": sqltypes.Int64BindVariable(5)}, false)
+	require.EqualError(t, err, "Cannot delete or update a parent row: a foreign key constraint fails (fk_c1)")
+}
+
 func TestUpdateNoStream(t *testing.T) {
 	upd := &Update{}
 	err := upd.StreamExecute(nil, nil, false, nil)
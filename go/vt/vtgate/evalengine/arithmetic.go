@@ -286,6 +286,9 @@ func minmax(v1, v2 sqltypes.Value, min bool) (sqltypes.Value, error) {
 
 func addNumeric(v1, v2 EvalResult) EvalResult {
 	v1, v2 = makeNumericAndprioritize(v1, v2)
+	if prepareDecimals(&v1, &v2) {
+		return decimalPlusDecimal(v1, v2)
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return intPlusInt(v1.ival, v2.ival)
@@ -304,6 +307,9 @@ func addNumeric(v1, v2 EvalResult) EvalResult {
 
 func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 	v1, v2 = makeNumericAndprioritize(v1, v2)
+	if prepareDecimals(&v1, &v2) {
+		return decimalPlusDecimal(v1, v2), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return intPlusIntWithError(v1.ival, v2.ival)
@@ -324,6 +330,9 @@ func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
+	if prepareDecimals(&v1, &v2) {
+		return decimalMinusDecimal(v1, v2), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		switch v2.typ {
@@ -351,6 +360,9 @@ func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 
 func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 	v1, v2 = makeNumericAndprioritize(v1, v2)
+	if prepareDecimals(&v1, &v2) {
+		return decimalTimesDecimal(v1, v2), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return intTimesIntWithError(v1.ival, v2.ival)
@@ -371,6 +383,9 @@ func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
+	if prepareDecimals(&v1, &v2) {
+		return decimalDivideDecimal(v1, v2), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return floatDivideAnyWithError(float64(v1.ival), v2)
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// ComparisonOp is the operator of a ComparisonExpr.
+	ComparisonOp int
+
+	// IsOp is the operator of an IsExpr.
+	IsOp int
+
+	// ComparisonExpr compares two values. It returns NULL if one
+	// of them is NULL, except for the null-safe equality.
+	ComparisonExpr struct {
+		Op          ComparisonOp
+		Left, Right Expr
+	}
+
+	// InExpr checks if a value is in a list.
+	InExpr struct {
+		Left Expr
+		List []Expr
+		Not  bool
+	}
+
+	// LikeExpr matches a value against a LIKE pattern.
+	LikeExpr struct {
+		Left, Pattern Expr
+		Escape        byte
+		Not           bool
+		// re is the compiled pattern if it's a literal.
+		re *regexp.Regexp
+	}
+
+	// IsExpr is an IS [NOT] NULL, TRUE or FALSE test.
+	IsExpr struct {
+		Op   IsOp
+		Expr Expr
+	}
+)
+
+// Comparison operators
+const (
+	EqualOp ComparisonOp = iota
+	NotEqualOp
+	LessThanOp
+	LessEqualOp
+	GreaterThanOp
+	GreaterEqualOp
+	NullSafeEqualOp
+)
+
+// Is operators
+const (
+	IsNullOp IsOp = iota
+	IsNotNullOp
+	IsTrueOp
+	IsNotTrueOp
+	IsFalseOp
+	IsNotFalseOp
+)
+
+var _ Expr = (*ComparisonExpr)(nil)
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*LikeExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+
+var comparisonOpStrings = map[ComparisonOp]string{
+	EqualOp:         "=",
+	NotEqualOp:      "!=",
+	LessThanOp:      "<",
+	LessEqualOp:     "<=",
+	GreaterThanOp:   ">",
+	GreaterEqualOp:  ">=",
+	NullSafeEqualOp: "<=>",
+}
+
+var isOpStrings = map[IsOp]string{
+	IsNullOp:     "is null",
+	IsNotNullOp:  "is not null",
+	IsTrueOp:     "is true",
+	IsNotTrueOp:  "is not true",
+	IsFalseOp:    "is false",
+	IsNotFalseOp: "is not false",
+}
+
+//Evaluate implements the Expr interface
+func (c *ComparisonExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(c.eval(env))
+}
+
+func (c *ComparisonExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	left, err := evaluateValue(env, c.Left)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := evaluateValue(env, c.Right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Op != NullSafeEqualOp && (left.IsNull() || right.IsNull()) {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := compareValues(left, right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.Op {
+	case EqualOp, NullSafeEqualOp:
+		return boolValue(cmp == 0), nil
+	case NotEqualOp:
+		return boolValue(cmp != 0), nil
+	case LessThanOp:
+		return boolValue(cmp < 0), nil
+	case LessEqualOp:
+		return boolValue(cmp <= 0), nil
+	case GreaterThanOp:
+		return boolValue(cmp > 0), nil
+	case GreaterEqualOp:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected comparison operator: %d", c.Op)
+}
+
+//Type implements the Expr interface
+func (c *ComparisonExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (c *ComparisonExpr) String() string {
+	return c.Left.String() + " " + comparisonOpStrings[c.Op] + " " + c.Right.String()
+}
+
+// compareValues compares two values like NullsafeCompare does, except
+// that dates are compared as dates even if one of them is a string.
+func compareValues(v1, v2 sqltypes.Value) (int, error) {
+	if !v1.IsNull() && !v2.IsNull() && (isTemporal(v1.Type()) || isTemporal(v2.Type())) {
+		t1, ok1 := parseDateTime(v1)
+		t2, ok2 := parseDateTime(v2)
+		if ok1 && ok2 {
+			return t1.compare(t2), nil
+		}
+	}
+	return NullsafeCompare(v1, v2)
+}
+
+//Evaluate implements the Expr interface
+func (in *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(in.eval(env))
+}
+
+func (in *InExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	left, err := evaluateValue(env, in.Left)
+	if err != nil || left.IsNull() {
+		return sqltypes.NULL, err
+	}
+	hasNull := false
+	for _, expr := range in.List {
+		val, err := evaluateValue(env, expr)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if val.IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := compareValues(left, val)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(!in.Not), nil
+		}
+	}
+	if hasNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(in.Not), nil
+}
+
+//Type implements the Expr interface
+func (in *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (in *InExpr) String() string {
+	list := make([]string, len(in.List))
+	for i, expr := range in.List {
+		list[i] = expr.String()
+	}
+	op := " in "
+	if in.Not {
+		op = " not in "
+	}
+	return in.Left.String() + op + "(" + strings.Join(list, ", ") + ")"
+}
+
+// NewLikeExpr returns a LIKE expression. The pattern is compiled
+// once if it's a literal.
+func NewLikeExpr(left, pattern Expr, escape byte, not bool) *LikeExpr {
+	like := &LikeExpr{Left: left, Pattern: pattern, Escape: escape, Not: not}
+	if literal, ok := pattern.(*Literal); ok {
+		if val := literal.Val.Value(); !val.IsNull() {
+			like.re = likeToRegexp(val.ToBytes(), escape)
+		}
+	}
+	return like
+}
+
+//Evaluate implements the Expr interface
+func (l *LikeExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(l.eval(env))
+}
+
+func (l *LikeExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	left, err := evaluateValue(env, l.Left)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	re := l.re
+	if re == nil {
+		pattern, err := evaluateValue(env, l.Pattern)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if pattern.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		re = likeToRegexp(pattern.ToBytes(), l.Escape)
+	}
+	if left.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(re.Match(left.ToBytes()) != l.Not), nil
+}
+
+//Type implements the Expr interface
+func (l *LikeExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (l *LikeExpr) String() string {
+	op := " like "
+	if l.Not {
+		op = " not like "
+	}
+	return l.Left.String() + op + l.Pattern.String()
+}
+
+// likeToRegexp converts a LIKE pattern into a regular expression.
+func likeToRegexp(pattern []byte, escape byte) *regexp.Regexp {
+	var buf strings.Builder
+	buf.WriteString(`(?s)\A`)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == escape && i+1 < len(pattern):
+			i++
+			buf.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		case c == '%':
+			buf.WriteString(".*")
+		case c == '_':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	buf.WriteString(`\z`)
+	return regexp.MustCompile(buf.String())
+}
+
+//Evaluate implements the Expr interface
+func (is *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(is.eval(env))
+}
+
+func (is *IsExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := evaluateValue(env, is.Expr)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch is.Op {
+	case IsNullOp:
+		return boolValue(val.IsNull()), nil
+	case IsNotNullOp:
+		return boolValue(!val.IsNull()), nil
+	case IsTrueOp:
+		return boolValue(IsTrue(val)), nil
+	case IsNotTrueOp:
+		return boolValue(!IsTrue(val)), nil
+	case IsFalseOp:
+		return boolValue(!val.IsNull() && !IsTrue(val)), nil
+	case IsNotFalseOp:
+		return boolValue(val.IsNull() || IsTrue(val)), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected is operator: %d", is.Op)
+}
+
+//Type implements the Expr interface
+func (is *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (is *IsExpr) String() string {
+	return fmt.Sprintf("%s %s", is.Expr.String(), isOpStrings[is.Op])
+}
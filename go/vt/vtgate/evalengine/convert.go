@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// ConvertExpr is a CAST or CONVERT. For strings, Length is the
+// maximum length, or -1. For decimals, Length and Scale are the
+// precision and the scale. For dates, Length is the precision
+// of the fractional seconds.
+type ConvertExpr struct {
+	Expr       Expr
+	ResultType querypb.Type
+	Length     int
+	Scale      int
+}
+
+var _ Expr = (*ConvertExpr)(nil)
+
+//Evaluate implements the Expr interface
+func (c *ConvertExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(c.eval(env))
+}
+
+func (c *ConvertExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := evaluateValue(env, c.Expr)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	switch c.ResultType {
+	case sqltypes.Int64, sqltypes.Uint64, sqltypes.Float64:
+		return castValue(val, c.ResultType)
+	case sqltypes.VarChar:
+		b := val.ToBytes()
+		if c.Length >= 0 && utf8.RuneCount(b) > c.Length {
+			b = []byte(string([]rune(string(b))[:c.Length]))
+		}
+		return sqltypes.MakeTrusted(sqltypes.VarChar, b), nil
+	case sqltypes.VarBinary:
+		b := val.ToBytes()
+		if c.Length >= 0 {
+			if len(b) > c.Length {
+				b = b[:c.Length]
+			} else {
+				// BINARY(N) pads the value with 0x00 bytes.
+				b = append(append([]byte{}, b...), make([]byte, c.Length-len(b))...)
+			}
+		}
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, b), nil
+	case sqltypes.Decimal:
+		return toDecimal(val, c.Scale)
+	case sqltypes.Date, sqltypes.Datetime:
+		t, ok := parseDateTime(val)
+		if !ok {
+			return sqltypes.NULL, nil
+		}
+		if c.ResultType == sqltypes.Date {
+			return sqltypes.MakeTrusted(sqltypes.Date, []byte(t.formatDate())), nil
+		}
+		return sqltypes.MakeTrusted(sqltypes.Datetime, []byte(t.formatDateTime(c.fsp()))), nil
+	case sqltypes.Time:
+		t, ok := parseDateTime(val)
+		if !ok {
+			if t, ok = parseClock(val); !ok {
+				return sqltypes.NULL, nil
+			}
+		}
+		return sqltypes.MakeTrusted(sqltypes.Time, []byte(t.formatTime(c.fsp()))), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected conversion to %v", c.ResultType)
+}
+
+// fsp returns the precision of the fractional seconds of a date.
+func (c *ConvertExpr) fsp() int {
+	if c.Length < 0 {
+		return 0
+	}
+	return c.Length
+}
+
+//Type implements the Expr interface
+func (c *ConvertExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return c.ResultType, nil
+}
+
+//String implements the Expr interface
+func (c *ConvertExpr) String() string {
+	return fmt.Sprintf("convert(%s, %s)", c.Expr.String(), c.ResultType.String())
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// intervalUnits lists the supported units of intervals, as a number
+// of months for the units of variable length, or as a duration in
+// microseconds for the others.
+var intervalUnits = map[string]struct {
+	months       int64
+	microseconds int64
+}{
+	"microsecond": {microseconds: 1},
+	"second":      {microseconds: 1e6},
+	"minute":      {microseconds: 60 * 1e6},
+	"hour":        {microseconds: 3600 * 1e6},
+	"day":         {microseconds: 24 * 3600 * 1e6},
+	"week":        {microseconds: 7 * 24 * 3600 * 1e6},
+	"month":       {months: 1},
+	"quarter":     {months: 3},
+	"year":        {months: 12},
+}
+
+// IntervalExpr adds an interval to a date, or subtracts it.
+type IntervalExpr struct {
+	Date, Amount Expr
+	Unit         string
+	Subtract     bool
+}
+
+var _ Expr = (*IntervalExpr)(nil)
+
+// NewIntervalExpr returns an expression that adds an interval of the
+// given unit to a date, or subtracts it. The unit must be lowercase.
+func NewIntervalExpr(date, amount Expr, unit string, subtract bool) (*IntervalExpr, error) {
+	if _, ok := intervalUnits[unit]; !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported interval unit: %s", unit)
+	}
+	return &IntervalExpr{Date: date, Amount: amount, Unit: unit, Subtract: subtract}, nil
+}
+
+//Evaluate implements the Expr interface
+func (ie *IntervalExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(ie.eval(env))
+}
+
+func (ie *IntervalExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	date, err := evaluateValue(env, ie.Date)
+	if err != nil || date.IsNull() {
+		return sqltypes.NULL, err
+	}
+	amount, err := evaluateValue(env, ie.Amount)
+	if err != nil || amount.IsNull() {
+		return sqltypes.NULL, err
+	}
+	t, ok := parseDateTime(date)
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	n := toInt(amount)
+	if ie.Subtract {
+		n = -n
+	}
+	unit := intervalUnits[ie.Unit]
+	if unit.months != 0 {
+		t = t.addMonths(n * unit.months)
+	} else {
+		t = t.addMicroseconds(n * unit.microseconds)
+	}
+	if ie.resultType(env) == sqltypes.Date {
+		return sqltypes.MakeTrusted(sqltypes.Date, []byte(t.formatDate())), nil
+	}
+	fsp := 0
+	if t.microsecond != 0 {
+		fsp = 6
+	}
+	return sqltypes.MakeTrusted(sqltypes.Datetime, []byte(t.formatDateTime(fsp))), nil
+}
+
+// resultType returns DATE if a date is moved by a number of days,
+// and DATETIME otherwise.
+func (ie *IntervalExpr) resultType(env ExpressionEnv) querypb.Type {
+	if typeOf(env, ie.Date) == sqltypes.Date && intervalUnits[ie.Unit].microseconds%(24*3600*1e6) == 0 {
+		return sqltypes.Date
+	}
+	return sqltypes.Datetime
+}
+
+//Type implements the Expr interface
+func (ie *IntervalExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return ie.resultType(env), nil
+}
+
+//String implements the Expr interface
+func (ie *IntervalExpr) String() string {
+	op := " + "
+	if ie.Subtract {
+		op = " - "
+	}
+	return ie.Date.String() + op + "interval " + ie.Amount.String() + " " + ie.Unit
+}
+
+func isTemporal(typ querypb.Type) bool {
+	switch typ {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return true
+	}
+	return false
+}
+
+// dateTime is a MySQL date, datetime or time. Times can have
+// more than 24 hours, and be negative.
+type dateTime struct {
+	year, month, day     int
+	hour, minute, second int
+	microsecond          int
+	negative             bool
+}
+
+var dateTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseDateTime parses a date or a datetime. It returns false if the value
+// isn't a valid date, and MySQL would then return NULL.
+func parseDateTime(val sqltypes.Value) (dateTime, bool) {
+	if val.IsNull() || sqltypes.IsNumber(val.Type()) || val.Type() == sqltypes.Time {
+		return dateTime{}, false
+	}
+	s := strings.TrimSpace(val.ToString())
+	for _, layout := range dateTimeLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return fromTime(t), true
+		}
+	}
+	return dateTime{}, false
+}
+
+var clockRe = regexp.MustCompile(`^(-)?(\d+):(\d{1,2}):(\d{1,2})(?:\.(\d{1,6}))?$`)
+
+// parseClock parses a time.
+func parseClock(val sqltypes.Value) (dateTime, bool) {
+	if val.IsNull() {
+		return dateTime{}, false
+	}
+	match := clockRe.FindStringSubmatch(strings.TrimSpace(val.ToString()))
+	if match == nil {
+		return dateTime{}, false
+	}
+	var t dateTime
+	t.negative = match[1] != ""
+	t.hour, _ = strconv.Atoi(match[2])
+	t.minute, _ = strconv.Atoi(match[3])
+	t.second, _ = strconv.Atoi(match[4])
+	if match[5] != "" {
+		t.microsecond, _ = strconv.Atoi((match[5] + "00000")[:6])
+	}
+	if t.minute > 59 || t.second > 59 {
+		return dateTime{}, false
+	}
+	return t, true
+}
+
+func fromTime(t time.Time) dateTime {
+	return dateTime{
+		year:        t.Year(),
+		month:       int(t.Month()),
+		day:         t.Day(),
+		hour:        t.Hour(),
+		minute:      t.Minute(),
+		second:      t.Second(),
+		microsecond: t.Nanosecond() / 1000,
+	}
+}
+
+func (t dateTime) toTime() time.Time {
+	return time.Date(t.year, time.Month(t.month), t.day, t.hour, t.minute, t.second, t.microsecond*1000, time.UTC)
+}
+
+func (t dateTime) compare(other dateTime) int {
+	t1, t2 := t.toTime(), other.toTime()
+	switch {
+	case t1.Before(t2):
+		return -1
+	case t1.After(t2):
+		return 1
+	}
+	return 0
+}
+
+// days returns the number of days since the epoch.
+func (t dateTime) days() int64 {
+	return time.Date(t.year, time.Month(t.month), t.day, 0, 0, 0, 0, time.UTC).Unix() / (24 * 3600)
+}
+
+// addMonths adds months to a date. The day is limited to the last
+// day of the resulting month, like MySQL does.
+func (t dateTime) addMonths(n int64) dateTime {
+	months := int64(t.year)*12 + int64(t.month-1) + n
+	t.year, t.month = int(months/12), int(months%12)+1
+	if last := time.Date(t.year, time.Month(t.month)+1, 0, 0, 0, 0, 0, time.UTC).Day(); t.day > last {
+		t.day = last
+	}
+	return t
+}
+
+func (t dateTime) addMicroseconds(n int64) dateTime {
+	return fromTime(t.toTime().Add(time.Duration(n) * time.Microsecond))
+}
+
+func (t dateTime) formatDate() string {
+	return fmt.Sprintf("%04d-%02d-%02d", t.year, t.month, t.day)
+}
+
+func (t dateTime) formatDateTime(fsp int) string {
+	return t.formatDate() + " " + t.formatTime(fsp)
+}
+
+// formatTime formats the time with fsp digits of fractional seconds.
+func (t dateTime) formatTime(fsp int) string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.hour, t.minute, t.second)
+	if t.negative {
+		s = "-" + s
+	}
+	if fsp > 0 {
+		s += "." + fmt.Sprintf("%06d", t.microsecond)[:fsp]
+	}
+	return s
+}
+
+// format formats the date with the specifiers of DATE_FORMAT.
+// The unknown specifiers are replaced with the character after the %.
+func (t dateTime) format(layout string) string {
+	tt := t.toTime()
+	hour12 := t.hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	ampm := "AM"
+	if t.hour >= 12 {
+		ampm = "PM"
+	}
+	var buf strings.Builder
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i+1 == len(layout) {
+			buf.WriteByte(layout[i])
+			continue
+		}
+		i++
+		switch layout[i] {
+		case 'Y':
+			fmt.Fprintf(&buf, "%04d", t.year)
+		case 'y':
+			fmt.Fprintf(&buf, "%02d", t.year%100)
+		case 'm':
+			fmt.Fprintf(&buf, "%02d", t.month)
+		case 'c':
+			fmt.Fprintf(&buf, "%d", t.month)
+		case 'M':
+			buf.WriteString(tt.Month().String())
+		case 'b':
+			buf.WriteString(tt.Month().String()[:3])
+		case 'd':
+			fmt.Fprintf(&buf, "%02d", t.day)
+		case 'e':
+			fmt.Fprintf(&buf, "%d", t.day)
+		case 'j':
+			fmt.Fprintf(&buf, "%03d", tt.YearDay())
+		case 'W':
+			buf.WriteString(tt.Weekday().String())
+		case 'a':
+			buf.WriteString(tt.Weekday().String()[:3])
+		case 'H':
+			fmt.Fprintf(&buf, "%02d", t.hour)
+		case 'k':
+			fmt.Fprintf(&buf, "%d", t.hour)
+		case 'h', 'I':
+			fmt.Fprintf(&buf, "%02d", hour12)
+		case 'l':
+			fmt.Fprintf(&buf, "%d", hour12)
+		case 'i':
+			fmt.Fprintf(&buf, "%02d", t.minute)
+		case 's', 'S':
+			fmt.Fprintf(&buf, "%02d", t.second)
+		case 'f':
+			fmt.Fprintf(&buf, "%06d", t.microsecond)
+		case 'p':
+			buf.WriteString(ampm)
+		case 'T':
+			fmt.Fprintf(&buf, "%02d:%02d:%02d", t.hour, t.minute, t.second)
+		case 'r':
+			fmt.Fprintf(&buf, "%02d:%02d:%02d %s", hour12, t.minute, t.second, ampm)
+		default:
+			buf.WriteByte(layout[i])
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// Decimals are kept as their text in EvalResult.bytes, and the arithmetic
+// between decimals and integers is exact, like in MySQL: the scale of a sum
+// or a difference is the largest scale of the operands, the scale of a
+// product is the sum of their scales, and a quotient gets divPrecisionIncrement
+// more digits than the dividend. As soon as a float is involved, the decimal
+// is converted to a float.
+
+// divPrecisionIncrement is the default of the div_precision_increment
+// variable of MySQL.
+const divPrecisionIncrement = 4
+
+// newDecimalResult validates the text of a decimal.
+func newDecimalResult(raw []byte) (EvalResult, error) {
+	if _, ok := new(big.Rat).SetString(string(raw)); !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse value: '%s'", raw)
+	}
+	return EvalResult{typ: sqltypes.Decimal, bytes: raw}, nil
+}
+
+// decimalOf returns the value and the scale of a decimal or of an integer.
+func decimalOf(v EvalResult) (*big.Rat, int) {
+	switch v.typ {
+	case sqltypes.Int64:
+		return new(big.Rat).SetInt64(v.ival), 0
+	case sqltypes.Uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v.uval)), 0
+	}
+	r, _ := new(big.Rat).SetString(string(v.bytes))
+	if r == nil {
+		r = new(big.Rat)
+	}
+	return r, decimalScale(v.bytes)
+}
+
+// decimalScale returns the number of digits after the decimal point.
+func decimalScale(b []byte) int {
+	s := string(b)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// makeDecimal formats a decimal with the given scale. Halves are
+// rounded away from zero.
+func makeDecimal(r *big.Rat, scale int) EvalResult {
+	return EvalResult{typ: sqltypes.Decimal, bytes: []byte(r.FloatString(scale))}
+}
+
+// decimalToFloat converts a decimal to a float.
+func decimalToFloat(v EvalResult) EvalResult {
+	fval, _ := strconv.ParseFloat(string(v.bytes), 64)
+	return EvalResult{typ: sqltypes.Float64, fval: fval}
+}
+
+// prepareDecimals returns true if an arithmetic operation between v1 and v2
+// must be done on decimals. If one of them is a decimal and the other a
+// float, the decimal is converted to a float, and false is returned.
+func prepareDecimals(v1, v2 *EvalResult) bool {
+	if v1.typ != sqltypes.Decimal && v2.typ != sqltypes.Decimal {
+		return false
+	}
+	if v1.typ == sqltypes.Float64 || v2.typ == sqltypes.Float64 {
+		if v1.typ == sqltypes.Decimal {
+			*v1 = decimalToFloat(*v1)
+		}
+		if v2.typ == sqltypes.Decimal {
+			*v2 = decimalToFloat(*v2)
+		}
+		return false
+	}
+	return true
+}
+
+func decimalPlusDecimal(v1, v2 EvalResult) EvalResult {
+	r1, s1 := decimalOf(v1)
+	r2, s2 := decimalOf(v2)
+	return makeDecimal(r1.Add(r1, r2), maxScale(s1, s2))
+}
+
+func decimalMinusDecimal(v1, v2 EvalResult) EvalResult {
+	r1, s1 := decimalOf(v1)
+	r2, s2 := decimalOf(v2)
+	return makeDecimal(r1.Sub(r1, r2), maxScale(s1, s2))
+}
+
+func decimalTimesDecimal(v1, v2 EvalResult) EvalResult {
+	r1, s1 := decimalOf(v1)
+	r2, s2 := decimalOf(v2)
+	return makeDecimal(r1.Mul(r1, r2), s1+s2)
+}
+
+// decimalDivideDecimal divides two decimals. Like in MySQL, a division
+// by zero returns NULL.
+func decimalDivideDecimal(v1, v2 EvalResult) EvalResult {
+	r1, s1 := decimalOf(v1)
+	r2, _ := decimalOf(v2)
+	if r2.Sign() == 0 {
+		return EvalResult{typ: sqltypes.Null}
+	}
+	return makeDecimal(r1.Quo(r1, r2), s1+divPrecisionIncrement)
+}
+
+func compareDecimals(v1, v2 EvalResult) int {
+	r1, _ := decimalOf(v1)
+	r2, _ := decimalOf(v2)
+	return r1.Cmp(r2)
+}
+
+// decimalToInt64 rounds a decimal to an integer.
+func decimalToInt64(v EvalResult) int64 {
+	r, _ := decimalOf(v)
+	i, _ := strconv.ParseInt(r.FloatString(0), 10, 64)
+	return i
+}
+
+func maxScale(s1, s2 int) int {
+	if s1 > s2 {
+		return s1
+	}
+	return s2
+}
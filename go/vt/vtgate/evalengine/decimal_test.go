@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func newDecimal(s string) sqltypes.Value {
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(s))
+}
+
+func TestDecimalArithmetics(t *testing.T) {
+	tests := []struct {
+		operator string
+		f        func(a, b sqltypes.Value) (sqltypes.Value, error)
+		v1, v2   sqltypes.Value
+		out      sqltypes.Value
+	}{{
+		operator: "+",
+		f:        Add,
+		v1:       newDecimal("0.1"),
+		v2:       newDecimal("0.2"),
+		out:      newDecimal("0.3"),
+	}, {
+		operator: "+",
+		f:        Add,
+		v1:       newDecimal("1.25"),
+		v2:       sqltypes.NewInt64(2),
+		out:      newDecimal("3.25"),
+	}, {
+		operator: "+",
+		f:        Add,
+		v1:       sqltypes.NewUint64(1),
+		v2:       newDecimal("12345678901234567890.1"),
+		out:      newDecimal("12345678901234567891.1"),
+	}, {
+		operator: "+",
+		f:        Add,
+		v1:       newDecimal("1.5"),
+		v2:       sqltypes.NewFloat64(0.25),
+		out:      sqltypes.NewFloat64(1.75),
+	}, {
+		operator: "-",
+		f:        Subtract,
+		v1:       newDecimal("1.00"),
+		v2:       newDecimal("0.1"),
+		out:      newDecimal("0.90"),
+	}, {
+		operator: "-",
+		f:        Subtract,
+		v1:       sqltypes.NewInt64(1),
+		v2:       newDecimal("1.5"),
+		out:      newDecimal("-0.5"),
+	}, {
+		operator: "*",
+		f:        Multiply,
+		v1:       newDecimal("1.10"),
+		v2:       newDecimal("1.1"),
+		out:      newDecimal("1.210"),
+	}, {
+		operator: "*",
+		f:        Multiply,
+		v1:       newDecimal("2.5"),
+		v2:       sqltypes.NewInt64(-3),
+		out:      newDecimal("-7.5"),
+	}, {
+		operator: "/",
+		f:        Divide,
+		v1:       newDecimal("1.0"),
+		v2:       sqltypes.NewInt64(3),
+		out:      newDecimal("0.33333"),
+	}, {
+		operator: "/",
+		f:        Divide,
+		v1:       sqltypes.NewInt64(2),
+		v2:       newDecimal("3"),
+		out:      newDecimal("0.6667"),
+	}, {
+		operator: "/",
+		f:        Divide,
+		v1:       newDecimal("1.0"),
+		v2:       newDecimal("0.00"),
+		out:      sqltypes.NULL,
+	}}
+	for _, tcase := range tests {
+		t.Run(fmt.Sprintf("%s%s%s", tcase.v1.String(), tcase.operator, tcase.v2.String()), func(t *testing.T) {
+			got, err := tcase.f(tcase.v1, tcase.v2)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+}
+
+func TestDecimalExpressions(t *testing.T) {
+	env := ExpressionEnv{Row: []sqltypes.Value{newDecimal("19.99"), sqltypes.NewInt64(3)}}
+	price := NewTypedColumn(0, sqltypes.Decimal)
+	quantity := NewTypedColumn(1, sqltypes.Int32)
+
+	total := &BinaryOp{Expr: &Multiplication{}, Left: price, Right: quantity}
+	typ, err := total.Type(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Decimal, typ)
+	result, err := total.Evaluate(env)
+	require.NoError(t, err)
+	assert.Equal(t, newDecimal("59.97"), result.Value())
+
+	// The decimals are compared exactly.
+	cmp := &ComparisonExpr{Op: EqualOp, Left: total, Right: &Literal{Val: EvalResult{typ: sqltypes.Decimal, bytes: []byte("59.970")}}}
+	result, err = cmp.Evaluate(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), result.Value())
+
+	// A NULL operand returns NULL.
+	env.Row[1] = sqltypes.NULL
+	result, err = total.Evaluate(env)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NULL, result.Value())
+}
+
+func TestDecimalCompareAndConvert(t *testing.T) {
+	cmp, err := NullsafeCompare(newDecimal("0.30"), newDecimal("0.3"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmp)
+
+	cmp, err = NullsafeCompare(newDecimal("9007199254740993.1"), newDecimal("9007199254740993.2"))
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	cmp, err = NullsafeCompare(sqltypes.NewInt64(2), newDecimal("1.5"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+
+	h1, err := NullsafeHashcode(newDecimal("2.0"))
+	require.NoError(t, err)
+	h2, err := NullsafeHashcode(sqltypes.NewInt64(2))
+	require.NoError(t, err)
+	assert.Equal(t, h2, h1)
+
+	f, err := ToFloat64(newDecimal("2.5"))
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, f)
+
+	_, err = Add(newDecimal("1.2.3"), sqltypes.NewInt64(1))
+	assert.EqualError(t, err, "could not parse value: '1.2.3'")
+
+	assert.Equal(t, sqltypes.NewInt64(3), NullsafeAdd(newDecimal("2.5"), sqltypes.NULL, sqltypes.Int64))
+}
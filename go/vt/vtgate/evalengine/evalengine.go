@@ -104,6 +104,8 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 		return float64(num.uval), nil
 	case sqltypes.Float64:
 		return num.fval, nil
+	case sqltypes.Decimal:
+		return decimalToFloat(num).fval, nil
 	}
 
 	if sqltypes.IsText(num.typ) || sqltypes.IsBinary(num.typ) {
@@ -157,7 +159,9 @@ func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{uval: uval, typ: sqltypes.Uint64}, nil
-	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+	case v.Type() == sqltypes.Decimal:
+		return newDecimalResult(raw)
+	case v.IsFloat():
 		fval, err := strconv.ParseFloat(string(raw), 64)
 		if err != nil {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
@@ -206,6 +210,8 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
 		case sqltypes.Float64, sqltypes.Float32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
+		case sqltypes.Decimal:
+			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, decimalToInt64(v), 10))
 		}
 	case sqltypes.IsUnsigned(resultType):
 		switch v.typ {
@@ -215,6 +221,8 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
 		case sqltypes.Float64, sqltypes.Float32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
+		case sqltypes.Decimal:
+			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(decimalToInt64(v)), 10))
 		}
 	case sqltypes.IsFloat(resultType) || resultType == sqltypes.Decimal:
 		switch v.typ {
@@ -228,6 +236,11 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 				format = 'f'
 			}
 			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, float64(v.fval), format, -1, 64))
+		case sqltypes.Decimal:
+			if resultType == sqltypes.Decimal {
+				return sqltypes.MakeTrusted(resultType, v.bytes)
+			}
+			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, decimalToFloat(v).fval, 'g', -1, 64))
 		}
 	default:
 		return sqltypes.MakeTrusted(resultType, v.bytes)
@@ -245,6 +258,8 @@ func hashCode(v EvalResult) int64 {
 		val = float64(v.uval)
 	case sqltypes.Float64:
 		val = v.fval
+	case sqltypes.Decimal:
+		val = decimalToFloat(v).fval
 	}
 
 	// this will not work for ±0, NaN and ±Inf,
@@ -253,6 +268,10 @@ func hashCode(v EvalResult) int64 {
 }
 
 func compareNumeric(v1, v2 EvalResult) (int, error) {
+	if prepareDecimals(&v1, &v2) {
+		return compareDecimals(v1, v2), nil
+	}
+
 	// Equalize the types.
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -137,6 +137,9 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	if lVal.typ == sqltypes.Null || rVal.typ == sqltypes.Null {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
@@ -192,7 +195,10 @@ func (m *Multiplication) Type(left querypb.Type) querypb.Type {
 }
 
 //Type implements the BinaryExpr interface
-func (d *Division) Type(querypb.Type) querypb.Type {
+func (d *Division) Type(left querypb.Type) querypb.Type {
+	if left == sqltypes.Decimal {
+		return sqltypes.Decimal
+	}
 	return sqltypes.Float64
 }
 
@@ -277,11 +283,17 @@ func (c *Column) String() string {
 
 func mergeNumericalTypes(ltype, rtype querypb.Type) querypb.Type {
 	switch ltype {
+	case sqltypes.Null:
+		return rtype
 	case sqltypes.Int64:
-		if rtype == sqltypes.Uint64 || rtype == sqltypes.Float64 {
+		if rtype == sqltypes.Uint64 || rtype == sqltypes.Float64 || rtype == sqltypes.Decimal {
 			return rtype
 		}
 	case sqltypes.Uint64:
+		if rtype == sqltypes.Float64 || rtype == sqltypes.Decimal {
+			return rtype
+		}
+	case sqltypes.Decimal:
 		if rtype == sqltypes.Float64 {
 			return rtype
 		}
@@ -315,6 +327,8 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 			fval = 0
 		}
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
+	case sqltypes.Decimal:
+		return newDecimalResult(val.Value)
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
 	case sqltypes.Null:
@@ -91,6 +91,7 @@ func TestBinaryOpTypes(t *testing.T) {
 				{sqltypes.Int64, sqltypes.Float64, sqltypes.Float64},
 				{sqltypes.Uint64, sqltypes.Float64, sqltypes.Float64},
 				{sqltypes.Float64, sqltypes.Float64, sqltypes.Float64},
+				{sqltypes.Decimal, sqltypes.Int64, sqltypes.Decimal},
 			},
 		},
 	}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// CaseExpr is a CASE expression. If Base is set, its value is compared
+	// with the conditions. Otherwise, the conditions are predicates.
+	CaseExpr struct {
+		Base  Expr
+		Whens []CaseWhen
+		Else  Expr
+	}
+
+	// CaseWhen is a WHEN ... THEN ... of a CaseExpr.
+	CaseWhen struct {
+		Cond, Val Expr
+	}
+)
+
+var _ Expr = (*CaseExpr)(nil)
+
+//Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(c.eval(env))
+}
+
+func (c *CaseExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	var base sqltypes.Value
+	if c.Base != nil {
+		var err error
+		if base, err = evaluateValue(env, c.Base); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	result := c.Else
+	for _, when := range c.Whens {
+		cond, err := evaluateValue(env, when.Cond)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		var matches bool
+		if c.Base != nil {
+			if !base.IsNull() && !cond.IsNull() {
+				cmp, err := compareValues(base, cond)
+				if err != nil {
+					return sqltypes.NULL, err
+				}
+				matches = cmp == 0
+			}
+		} else {
+			matches = IsTrue(cond)
+		}
+		if matches {
+			result = when.Val
+			break
+		}
+	}
+	if result == nil {
+		return sqltypes.NULL, nil
+	}
+	val, err := evaluateValue(env, result)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return castValue(val, c.resultType(env))
+}
+
+func (c *CaseExpr) resultType(env ExpressionEnv) querypb.Type {
+	results := make([]Expr, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		results = append(results, when.Val)
+	}
+	if c.Else != nil {
+		results = append(results, c.Else)
+	}
+	return commonType(env, results)
+}
+
+//Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return c.resultType(env), nil
+}
+
+//String implements the Expr interface
+func (c *CaseExpr) String() string {
+	var buf strings.Builder
+	buf.WriteString("case")
+	if c.Base != nil {
+		buf.WriteString(" " + c.Base.String())
+	}
+	for _, when := range c.Whens {
+		buf.WriteString(" when " + when.Cond.String() + " then " + when.Val.String())
+	}
+	if c.Else != nil {
+		buf.WriteString(" else " + c.Else.String())
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// FuncExpr is a call to a scalar function. All the arguments are
+// evaluated before calling the function.
+type FuncExpr struct {
+	Name string
+	Args []Expr
+	fn   scalarFunc
+}
+
+var _ Expr = (*FuncExpr)(nil)
+
+// scalarFunc describes a scalar function. maxArgs is -1 if the
+// function accepts any number of arguments. If nullIfNullArg is
+// set, the function returns NULL if any argument is NULL, and
+// call is only invoked with non-null arguments. call receives
+// the type returned by resultType.
+type scalarFunc struct {
+	minArgs, maxArgs int
+	nullIfNullArg    bool
+	resultType       func(env ExpressionEnv, args []Expr) querypb.Type
+	call             func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error)
+}
+
+// HasFunction returns true if the scalar function is supported.
+func HasFunction(name string) bool {
+	_, ok := scalarFuncs[resolveFuncAlias(name)]
+	return ok
+}
+
+// NewFuncExpr returns a call to a scalar function. The name must be
+// lowercase.
+func NewFuncExpr(name string, args []Expr) (*FuncExpr, error) {
+	name = resolveFuncAlias(name)
+	fn, ok := scalarFuncs[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to %s", name)
+	}
+	return &FuncExpr{Name: name, Args: args, fn: fn}, nil
+}
+
+//Evaluate implements the Expr interface
+func (f *FuncExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(f.eval(env))
+}
+
+func (f *FuncExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	args, err := evaluateValues(env, f.Args)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if f.fn.nullIfNullArg {
+		for _, arg := range args {
+			if arg.IsNull() {
+				return sqltypes.NULL, nil
+			}
+		}
+	}
+	return f.fn.call(f.fn.resultType(env, f.Args), args)
+}
+
+//Type implements the Expr interface
+func (f *FuncExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return f.fn.resultType(env, f.Args), nil
+}
+
+//String implements the Expr interface
+func (f *FuncExpr) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = arg.String()
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func fixedType(typ querypb.Type) func(ExpressionEnv, []Expr) querypb.Type {
+	return func(ExpressionEnv, []Expr) querypb.Type {
+		return typ
+	}
+}
+
+func firstArgStringType(env ExpressionEnv, args []Expr) querypb.Type {
+	return stringType(env, args[0])
+}
+
+func allArgsStringType(env ExpressionEnv, args []Expr) querypb.Type {
+	return stringType(env, args...)
+}
+
+// scalarFuncs contains the supported functions by name. The aliases
+// are resolved by scalarFuncAliases.
+var scalarFuncs map[string]scalarFunc
+
+var scalarFuncAliases = map[string]string{
+	"lcase":            "lower",
+	"ucase":            "upper",
+	"character_length": "char_length",
+	"substring":        "substr",
+	"mid":              "substr",
+	"dayofmonth":       "day",
+}
+
+func resolveFuncAlias(name string) string {
+	if alias, ok := scalarFuncAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+func init() {
+	scalarFuncs = map[string]scalarFunc{
+		"concat": {
+			minArgs: 1, maxArgs: -1, nullIfNullArg: true,
+			resultType: allArgsStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				var buf bytes.Buffer
+				for _, arg := range args {
+					buf.Write(arg.ToBytes())
+				}
+				return sqltypes.MakeTrusted(typ, buf.Bytes()), nil
+			},
+		},
+		"concat_ws": {
+			minArgs: 2, maxArgs: -1,
+			resultType: allArgsStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if args[0].IsNull() {
+					return sqltypes.NULL, nil
+				}
+				var parts [][]byte
+				for _, arg := range args[1:] {
+					if !arg.IsNull() {
+						parts = append(parts, arg.ToBytes())
+					}
+				}
+				return sqltypes.MakeTrusted(typ, bytes.Join(parts, args[0].ToBytes())), nil
+			},
+		},
+		"lower": {
+			minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				return sqltypes.MakeTrusted(typ, bytes.ToLower(args[0].ToBytes())), nil
+			},
+		},
+		"upper": {
+			minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				return sqltypes.MakeTrusted(typ, bytes.ToUpper(args[0].ToBytes())), nil
+			},
+		},
+		"length": {
+			minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+			resultType: fixedType(sqltypes.Int64),
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				return sqltypes.NewInt64(int64(len(args[0].ToBytes()))), nil
+			},
+		},
+		"char_length": {
+			minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+			resultType: fixedType(sqltypes.Int64),
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if args[0].IsBinary() {
+					return sqltypes.NewInt64(int64(len(args[0].ToBytes()))), nil
+				}
+				return sqltypes.NewInt64(int64(utf8.RuneCount(args[0].ToBytes()))), nil
+			},
+		},
+		"trim":  trimFunc(bytes.Trim),
+		"ltrim": trimFunc(bytes.TrimLeft),
+		"rtrim": trimFunc(bytes.TrimRight),
+		"left": {
+			minArgs: 2, maxArgs: 2, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				chars := stringChars(args[0], typ)
+				n := clamp(toInt(args[1]), len(chars))
+				return joinChars(typ, chars[:n]), nil
+			},
+		},
+		"right": {
+			minArgs: 2, maxArgs: 2, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				chars := stringChars(args[0], typ)
+				n := clamp(toInt(args[1]), len(chars))
+				return joinChars(typ, chars[len(chars)-n:]), nil
+			},
+		},
+		"substr": {
+			minArgs: 2, maxArgs: 3, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				chars := stringChars(args[0], typ)
+				// The position is 1-based, and counts from the end if negative.
+				pos := toInt(args[1])
+				switch {
+				case pos > 0:
+					pos--
+				case pos < 0:
+					pos += int64(len(chars))
+				}
+				if toInt(args[1]) == 0 || pos < 0 || pos >= int64(len(chars)) {
+					return sqltypes.MakeTrusted(typ, nil), nil
+				}
+				chars = chars[pos:]
+				if len(args) == 3 {
+					chars = chars[:clamp(toInt(args[2]), len(chars))]
+				}
+				return joinChars(typ, chars), nil
+			},
+		},
+		"replace": {
+			minArgs: 3, maxArgs: 3, nullIfNullArg: true,
+			resultType: firstArgStringType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if len(args[1].ToBytes()) == 0 {
+					return sqltypes.MakeTrusted(typ, args[0].ToBytes()), nil
+				}
+				return sqltypes.MakeTrusted(typ, bytes.ReplaceAll(args[0].ToBytes(), args[1].ToBytes(), args[2].ToBytes())), nil
+			},
+		},
+		"ifnull": {
+			minArgs: 2, maxArgs: 2,
+			resultType: commonType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if args[0].IsNull() {
+					return castValue(args[1], typ)
+				}
+				return castValue(args[0], typ)
+			},
+		},
+		"coalesce": {
+			minArgs: 1, maxArgs: -1,
+			resultType: commonType,
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				for _, arg := range args {
+					if !arg.IsNull() {
+						return castValue(arg, typ)
+					}
+				}
+				return sqltypes.NULL, nil
+			},
+		},
+		"if": {
+			minArgs: 3, maxArgs: 3,
+			resultType: func(env ExpressionEnv, args []Expr) querypb.Type { return commonType(env, args[1:]) },
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if IsTrue(args[0]) {
+					return castValue(args[1], typ)
+				}
+				return castValue(args[2], typ)
+			},
+		},
+		"nullif": {
+			minArgs: 2, maxArgs: 2,
+			resultType: func(env ExpressionEnv, args []Expr) querypb.Type { return typeOf(env, args[0]) },
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				if args[0].IsNull() || args[1].IsNull() {
+					return args[0], nil
+				}
+				cmp, err := compareValues(args[0], args[1])
+				if err != nil || cmp == 0 {
+					return sqltypes.NULL, err
+				}
+				return args[0], nil
+			},
+		},
+		"date": {
+			minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+			resultType: fixedType(sqltypes.Date),
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				t, ok := parseDateTime(args[0])
+				if !ok {
+					return sqltypes.NULL, nil
+				}
+				return sqltypes.MakeTrusted(sqltypes.Date, []byte(t.formatDate())), nil
+			},
+		},
+		"year":   datePartFunc(func(t dateTime) int { return t.year }),
+		"month":  datePartFunc(func(t dateTime) int { return t.month }),
+		"day":    datePartFunc(func(t dateTime) int { return t.day }),
+		"hour":   timePartFunc(func(t dateTime) int { return t.hour }),
+		"minute": timePartFunc(func(t dateTime) int { return t.minute }),
+		"second": timePartFunc(func(t dateTime) int { return t.second }),
+		"date_format": {
+			minArgs: 2, maxArgs: 2, nullIfNullArg: true,
+			resultType: fixedType(sqltypes.VarChar),
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				t, ok := parseDateTime(args[0])
+				if !ok {
+					return sqltypes.NULL, nil
+				}
+				return sqltypes.MakeTrusted(sqltypes.VarChar, []byte(t.format(args[1].ToString()))), nil
+			},
+		},
+		"datediff": {
+			minArgs: 2, maxArgs: 2, nullIfNullArg: true,
+			resultType: fixedType(sqltypes.Int64),
+			call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+				t1, ok1 := parseDateTime(args[0])
+				t2, ok2 := parseDateTime(args[1])
+				if !ok1 || !ok2 {
+					return sqltypes.NULL, nil
+				}
+				return sqltypes.NewInt64(t1.days() - t2.days()), nil
+			},
+		},
+	}
+}
+
+func trimFunc(trim func([]byte, string) []byte) scalarFunc {
+	return scalarFunc{
+		minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+		resultType: firstArgStringType,
+		call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+			return sqltypes.MakeTrusted(typ, trim(args[0].ToBytes(), " ")), nil
+		},
+	}
+}
+
+func datePartFunc(part func(dateTime) int) scalarFunc {
+	return scalarFunc{
+		minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+		resultType: fixedType(sqltypes.Int64),
+		call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+			t, ok := parseDateTime(args[0])
+			if !ok {
+				return sqltypes.NULL, nil
+			}
+			return sqltypes.NewInt64(int64(part(t))), nil
+		},
+	}
+}
+
+// timePartFunc is like datePartFunc, but it also accepts times.
+func timePartFunc(part func(dateTime) int) scalarFunc {
+	return scalarFunc{
+		minArgs: 1, maxArgs: 1, nullIfNullArg: true,
+		resultType: fixedType(sqltypes.Int64),
+		call: func(typ querypb.Type, args []sqltypes.Value) (sqltypes.Value, error) {
+			t, ok := parseDateTime(args[0])
+			if !ok {
+				if t, ok = parseClock(args[0]); !ok {
+					return sqltypes.NULL, nil
+				}
+			}
+			return sqltypes.NewInt64(int64(part(t))), nil
+		},
+	}
+}
+
+// stringChars splits a string into characters, or into bytes
+// if it's a binary string.
+func stringChars(val sqltypes.Value, typ querypb.Type) [][]byte {
+	b := val.ToBytes()
+	chars := make([][]byte, 0, len(b))
+	for len(b) > 0 {
+		size := 1
+		if typ != sqltypes.VarBinary {
+			_, size = utf8.DecodeRune(b)
+		}
+		chars = append(chars, b[:size])
+		b = b[size:]
+	}
+	return chars
+}
+
+func joinChars(typ querypb.Type, chars [][]byte) sqltypes.Value {
+	return sqltypes.MakeTrusted(typ, bytes.Join(chars, nil))
+}
+
+// clamp returns n limited to the [0, limit] range.
+func clamp(n int64, limit int) int {
+	switch {
+	case n < 0:
+		return 0
+	case n > int64(limit):
+		return limit
+	}
+	return int(n)
+}
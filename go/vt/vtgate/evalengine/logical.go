@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// LogicalOp is the operator of a LogicalExpr.
+	LogicalOp int
+
+	// LogicalExpr is an AND, OR or XOR. NULL is an unknown value.
+	LogicalExpr struct {
+		Op          LogicalOp
+		Left, Right Expr
+	}
+
+	// NotExpr is a NOT.
+	NotExpr struct {
+		Expr Expr
+	}
+)
+
+// Logical operators
+const (
+	AndOp LogicalOp = iota
+	OrOp
+	XorOp
+)
+
+var _ Expr = (*LogicalExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+
+var logicalOpStrings = map[LogicalOp]string{
+	AndOp: "and",
+	OrOp:  "or",
+	XorOp: "xor",
+}
+
+//Evaluate implements the Expr interface
+func (l *LogicalExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(l.eval(env))
+}
+
+func (l *LogicalExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	left, err := evaluateValue(env, l.Left)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := evaluateValue(env, l.Right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch l.Op {
+	case AndOp:
+		if (!left.IsNull() && !IsTrue(left)) || (!right.IsNull() && !IsTrue(right)) {
+			return valueFalse, nil
+		}
+		if left.IsNull() || right.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return valueTrue, nil
+	case OrOp:
+		if IsTrue(left) || IsTrue(right) {
+			return valueTrue, nil
+		}
+		if left.IsNull() || right.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return valueFalse, nil
+	case XorOp:
+		if left.IsNull() || right.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return boolValue(IsTrue(left) != IsTrue(right)), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected logical operator: %d", l.Op)
+}
+
+//Type implements the Expr interface
+func (l *LogicalExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (l *LogicalExpr) String() string {
+	return "(" + l.Left.String() + " " + logicalOpStrings[l.Op] + " " + l.Right.String() + ")"
+}
+
+//Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	return resultOf(n.eval(env))
+}
+
+func (n *NotExpr) eval(env ExpressionEnv) (sqltypes.Value, error) {
+	val, err := evaluateValue(env, n.Expr)
+	if err != nil || val.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!IsTrue(val)), nil
+}
+
+//Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Expr.String()
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the helpers of the expressions that work on typed
+// values, like the columns of a table: unlike Column, the strings keep
+// their type, which tells the non-binary strings from the binary ones.
+
+// TypedColumn is a column of the row whose type is known.
+type TypedColumn struct {
+	Offset     int
+	ColumnType querypb.Type
+}
+
+var _ Expr = (*TypedColumn)(nil)
+
+// NewTypedColumn returns a column of the given type.
+func NewTypedColumn(offset int, typ querypb.Type) Expr {
+	return &TypedColumn{Offset: offset, ColumnType: typ}
+}
+
+// Evaluate implements the Expr interface
+func (c *TypedColumn) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	if c.Offset >= len(env.Row) {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "index out of range, colnum: %d, len(values): %d", c.Offset, len(env.Row))
+	}
+	return resultOf(env.Row[c.Offset], nil)
+}
+
+// Type implements the Expr interface. The integers and the floats
+// are evaluated as 64 bit values.
+func (c *TypedColumn) Type(ExpressionEnv) (querypb.Type, error) {
+	switch {
+	case sqltypes.IsSigned(c.ColumnType):
+		return sqltypes.Int64, nil
+	case sqltypes.IsUnsigned(c.ColumnType):
+		return sqltypes.Uint64, nil
+	case sqltypes.IsFloat(c.ColumnType):
+		return sqltypes.Float64, nil
+	}
+	return c.ColumnType, nil
+}
+
+// String implements the Expr interface
+func (c *TypedColumn) String() string {
+	return fmt.Sprintf("column %d from the input", c.Offset)
+}
+
+// NewLiteral returns a literal expression of any type.
+func NewLiteral(v sqltypes.Value) (Expr, error) {
+	val, err := resultOf(v, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Literal{Val: val}, nil
+}
+
+// IsTrue returns whether a value is true: it's not NULL, and it's
+// not zero once converted to a number.
+func IsTrue(v sqltypes.Value) bool {
+	return !v.IsNull() && toFloat(v) != 0
+}
+
+var (
+	valueTrue  = sqltypes.NewInt64(1)
+	valueFalse = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return valueTrue
+	}
+	return valueFalse
+}
+
+// resultOf converts the value returned by an expression. Unlike
+// newEvalResult, the strings, the dates and the other types that
+// aren't numbers keep their type.
+func resultOf(v sqltypes.Value, err error) (EvalResult, error) {
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if sqltypes.IsNumber(v.Type()) {
+		return newEvalResult(v)
+	}
+	return EvalResult{typ: v.Type(), bytes: v.Raw()}, nil
+}
+
+// evaluateValue evaluates an expression into a value.
+func evaluateValue(env ExpressionEnv, expr Expr) (sqltypes.Value, error) {
+	result, err := expr.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return result.Value(), nil
+}
+
+// evaluateValues evaluates a list of expressions.
+func evaluateValues(env ExpressionEnv, exprs []Expr) ([]sqltypes.Value, error) {
+	values := make([]sqltypes.Value, len(exprs))
+	for i, expr := range exprs {
+		val, err := evaluateValue(env, expr)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = val
+	}
+	return values, nil
+}
+
+// numberPrefixRe matches the numeric prefix of a string, which is how
+// MySQL converts strings to numbers.
+var numberPrefixRe = regexp.MustCompile(`^\s*[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?`)
+
+// numberPrefix returns the numeric prefix of a string, or "0".
+func numberPrefix(b []byte) string {
+	if s := strings.TrimSpace(string(numberPrefixRe.Find(b))); s != "" {
+		return s
+	}
+	return "0"
+}
+
+// toFloat converts a value to a number the way MySQL does:
+// the strings that are not numbers are converted to 0.
+func toFloat(val sqltypes.Value) float64 {
+	if val.IsNull() {
+		return 0
+	}
+	if sqltypes.IsNumber(val.Type()) {
+		if f, err := ToFloat64(val); err == nil {
+			return f
+		}
+	}
+	f, _ := strconv.ParseFloat(numberPrefix(val.ToBytes()), 64)
+	return f
+}
+
+// toInt converts a value to an integer the way MySQL does.
+// Fractional numbers are rounded.
+func toInt(val sqltypes.Value) int64 {
+	if val.IsIntegral() {
+		if i, err := ToInt64(val); err == nil {
+			return i
+		}
+		if u, err := ToUint64(val); err == nil {
+			return int64(u)
+		}
+	}
+	switch val.Type() {
+	case sqltypes.Decimal:
+		if result, err := newEvalResult(val); err == nil {
+			return decimalToInt64(result)
+		}
+	case sqltypes.Float32, sqltypes.Float64:
+	default:
+		if i, err := strconv.ParseInt(numberPrefix(val.ToBytes()), 10, 64); err == nil {
+			return i
+		}
+	}
+	return int64(math.Round(toFloat(val)))
+}
+
+// toDecimal converts a value to a decimal with the given scale.
+// Halves are rounded away from zero.
+func toDecimal(val sqltypes.Value, scale int) (sqltypes.Value, error) {
+	s := val.ToString()
+	if !sqltypes.IsNumber(val.Type()) {
+		s = numberPrefix(val.ToBytes())
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot convert %s to decimal", s)
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(r.FloatString(scale))), nil
+}
+
+// castValue converts a value to the type of an expression.
+func castValue(val sqltypes.Value, typ querypb.Type) (sqltypes.Value, error) {
+	if val.IsNull() || val.Type() == typ || typ == sqltypes.Null {
+		return val, nil
+	}
+	switch {
+	case sqltypes.IsSigned(typ):
+		return sqltypes.NewInt64(toInt(val)), nil
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.NewUint64(uint64(toInt(val))), nil
+	case sqltypes.IsFloat(typ):
+		return sqltypes.NewFloat64(toFloat(val)), nil
+	case typ == sqltypes.Decimal && !sqltypes.IsNumber(val.Type()):
+		return toDecimal(val, decimalScale(val.ToBytes()))
+	}
+	return Cast(val, typ)
+}
+
+// typeOf returns the type of an expression, or NULL if it's unknown.
+func typeOf(env ExpressionEnv, expr Expr) querypb.Type {
+	typ, err := expr.Type(env)
+	if err != nil {
+		return sqltypes.Null
+	}
+	return typ
+}
+
+// commonType returns the type of an expression that can return
+// the values of any of the expressions.
+func commonType(env ExpressionEnv, exprs []Expr) querypb.Type {
+	var types []querypb.Type
+	for _, expr := range exprs {
+		if typ := typeOf(env, expr); typ != sqltypes.Null {
+			types = append(types, typ)
+		}
+	}
+	if len(types) == 0 {
+		return sqltypes.Null
+	}
+	same, numbers, temporals := true, true, true
+	for _, typ := range types {
+		same = same && typ == types[0]
+		numbers = numbers && sqltypes.IsNumber(typ)
+		temporals = temporals && isTemporal(typ)
+	}
+	switch {
+	case same:
+		return types[0]
+	case numbers:
+		result := sqltypes.Int64
+		for _, typ := range types {
+			switch {
+			case sqltypes.IsFloat(typ):
+				return sqltypes.Float64
+			case typ == sqltypes.Decimal:
+				result = sqltypes.Decimal
+			}
+		}
+		return result
+	case temporals:
+		return sqltypes.Datetime
+	}
+	return stringType(env, exprs...)
+}
+
+// stringType returns the type of a string computed from the expressions:
+// it's a non-binary string if any of them is.
+func stringType(env ExpressionEnv, exprs ...Expr) querypb.Type {
+	for _, expr := range exprs {
+		if sqltypes.IsText(typeOf(env, expr)) {
+			return sqltypes.VarChar
+		}
+	}
+	return sqltypes.VarBinary
+}
@@ -108,7 +108,7 @@ const pathScatterStats = "/debug/scatter_stats"
 const pathVSchema = "/debug/vschema"
 
 // NewExecutor creates a new Executor.
-func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize, warnOnShardedOnly bool, streamSize int, cacheCfg *cache.Config) *Executor {
+func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize, warnOnShardedOnly bool, streamSize int, cacheCfg *cache.Config, schemaTracker SchemaInfo) *Executor {
 	e := &Executor{
 		serv:            serv,
 		cell:            cell,
@@ -122,7 +122,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 	}
 
 	vschemaacl.Init()
-	e.vm = &VSchemaManager{e: e}
+	e.vm = &VSchemaManager{e: e, schema: schemaTracker}
 	e.vm.watchSrvVSchema(ctx, cell)
 
 	executorOnce.Do(func() {
@@ -396,7 +396,7 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
-func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, planType string, logStats *LogStats, nonTxResponse func(query string) (*sqltypes.Result, error), ignoreMaxMemoryRows bool) (*sqltypes.Result, error) {
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, planType string, logStats *LogStats, nonTxResponse func(query string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	logStats.ShardQueries = uint64(len(safeSession.ShardSessions))
@@ -405,32 +405,87 @@ func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession
 		logStats.ExecuteTime = time.Since(execStart)
 	}()
 
-	if len(safeSession.ShardSessions) == 0 {
-		if safeSession.InTransaction() {
-			// Storing, as this needs to be executed just after starting transaction on the shard.
-			safeSession.StoreSavepoint(sql)
-			return &sqltypes.Result{}, nil
-		}
+	if !safeSession.InTransaction() {
 		return nonTxResponse(sql)
 	}
-	var rss []*srvtopo.ResolvedShard
-	for _, shardSession := range safeSession.ShardSessions {
-		rss = append(rss, &srvtopo.ResolvedShard{
-			Target:  shardSession.Target,
-			Gateway: e.resolver.resolver.GetGateway(),
-		})
-	}
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: sql}
-	}
-	qr, errs := e.ExecuteMultiShard(ctx, rss, queries, safeSession, false /*autocommit*/, ignoreMaxMemoryRows)
-	err := vterrors.Aggregate(errs)
+
+	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
 		return nil, err
 	}
-	safeSession.StoreSavepoint(sql)
-	return qr, nil
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		if err := e.txConn.Savepoint(ctx, safeSession, sql); err != nil {
+			return nil, err
+		}
+		safeSession.SetSavepoint(stmt.Name)
+	case *sqlparser.SRollback:
+		if !safeSession.HasSavepoint(stmt.Name) {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT %s does not exist", stmt.Name.String())
+		}
+		if err := e.txConn.Savepoint(ctx, safeSession, sql); err != nil {
+			return nil, err
+		}
+		safeSession.RollbackToSavepoint(stmt.Name)
+	case *sqlparser.Release:
+		if !safeSession.HasSavepoint(stmt.Name) {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT %s does not exist", stmt.Name.String())
+		}
+		if err := e.txConn.Savepoint(ctx, safeSession, sql); err != nil {
+			return nil, err
+		}
+		safeSession.ReleaseSavepoint(stmt.Name)
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected savepoint statement: %s", sql)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// setStmtSavepoint sets the savepoint protecting the DML statement being
+// executed on the resolved shards it is about to write to, if they need
+// it. The savepoint is set with the margin comments of the statement.
+func (e *Executor) setStmtSavepoint(ctx context.Context, safeSession *SafeSession, rss []*srvtopo.ResolvedShard, marginComments sqlparser.MarginComments) error {
+	shardSessions := safeSession.StmtSavepointShards(rss)
+	if len(shardSessions) == 0 {
+		return nil
+	}
+	query := marginComments.Leading + sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(stmtSavepointName)}) + marginComments.Trailing
+	if err := e.txConn.ExecuteShardSessions(ctx, safeSession, shardSessions, query); err != nil {
+		return err
+	}
+	safeSession.SetStmtSavepoint(shardSessions)
+	return nil
+}
+
+// endStmt releases the savepoint protecting the DML statement which was
+// executed, on the shards where it was set.
+func (e *Executor) endStmt(ctx context.Context, safeSession *SafeSession) {
+	shardSessions := safeSession.EndStmt()
+	if len(shardSessions) == 0 {
+		return
+	}
+	query := sqlparser.String(&sqlparser.Release{Name: sqlparser.NewColIdent(stmtSavepointName)})
+	if err := e.txConn.ExecuteShardSessions(ctx, safeSession, shardSessions, query); err != nil {
+		log.Warningf("Unable to release the savepoint of the statement: %v", err)
+	}
+}
+
+// rollbackPartialExec undoes a DML statement which failed after it
+// partially executed. If the statement can be rolled back on its own,
+// the transaction stays open and the original error is returned.
+// Otherwise, the whole transaction is rolled back.
+func (e *Executor) rollbackPartialExec(ctx context.Context, safeSession *SafeSession, err error) error {
+	if !safeSession.MustRollback() {
+		if savepointShards, joinedShards, ok := safeSession.StmtRollbackShards(); ok {
+			rbErr := e.txConn.RollbackStmt(ctx, safeSession, savepointShards, joinedShards)
+			if rbErr == nil {
+				return vterrors.Wrap(err, "statement rolled back due to partial DML execution")
+			}
+			log.Warningf("Unable to roll back partial DML execution of the statement, rolling back the transaction: %v", rbErr)
+		}
+	}
+	_ = e.txConn.Rollback(ctx, safeSession)
+	return vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back due to partial DML execution: %v", err)
 }
 
 // CloseSession releases the current connection, which rollbacks open transactions and closes reserved connections.
@@ -1032,6 +1087,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
 	query, comments := sqlparser.SplitMarginComments(sql)
+	safeSession.SetQueryTags(sqlparser.ParseQueryTags(comments))
 	vc, _ := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
 	vc.SetIgnoreMaxMemoryRows(true)
 
@@ -1514,6 +1570,7 @@ func (e *Executor) startVStream(ctx context.Context, rss []*srvtopo.ResolvedShar
 		filter:     filter,
 		send:       callback,
 		rss:        rss,
+		lags:       make(map[string]int64),
 	}
 	vs.stream(ctx)
 	return nil
@@ -18,7 +18,6 @@ package vtgate
 
 import (
 	"reflect"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -129,6 +128,7 @@ func TestUpdateEqual(t *testing.T) {
 	}
 
 	wantQueries = []*querypb.BoundQuery{
+		stmtSavepointQuery,
 		{
 			Sql: "delete from name_lastname_keyspace_id_map where `name` = :name and lastname = :lastname and keyspace_id = :keyspace_id",
 			BindVariables: map[string]*querypb.BindVariable{
@@ -145,6 +145,7 @@ func TestUpdateEqual(t *testing.T) {
 				"keyspace_id_0": sqltypes.BytesBindVariable([]byte("\026k@\264J\272K\326")),
 			},
 		},
+		stmtReleaseQuery,
 	}
 
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
@@ -444,6 +445,7 @@ func TestDeleteEqual(t *testing.T) {
 	}
 
 	wantQueries = []*querypb.BoundQuery{
+		stmtSavepointQuery,
 		{
 			Sql: "delete from name_lastname_keyspace_id_map where `name` = :name and lastname = :lastname and keyspace_id = :keyspace_id",
 			BindVariables: map[string]*querypb.BindVariable{
@@ -452,6 +454,7 @@ func TestDeleteEqual(t *testing.T) {
 				"keyspace_id": sqltypes.BytesBindVariable([]byte("\026k@\264J\272K\326")),
 			},
 		},
+		stmtReleaseQuery,
 	}
 
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
@@ -605,13 +608,13 @@ func TestInsertSharded(t *testing.T) {
 	if sbc1.Queries != nil {
 		t.Errorf("sbc1.Queries: %+v, want nil\n", sbc1.Queries)
 	}
-	wantQueries = []*querypb.BoundQuery{{
+	wantQueries = []*querypb.BoundQuery{stmtSavepointQuery, {
 		Sql: "insert into name_user_map(`name`, user_id) values (:name_0, :user_id_0)",
 		BindVariables: map[string]*querypb.BindVariable{
 			"name_0":    sqltypes.BytesBindVariable([]byte("myname2")),
 			"user_id_0": sqltypes.Uint64BindVariable(3),
 		},
-	}}
+	}, stmtReleaseQuery}
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
 		t.Errorf("sbclookup.Queries: \n%+v, want \n%+v\n", sbclookup.Queries, wantQueries)
 	}
@@ -1244,25 +1247,68 @@ func TestInsertPartialFail1(t *testing.T) {
 }
 
 // If a statement gets broken up into two, and the second one fails
-// after successful execution of the first, then the transaction must
-// be rolled back due to partial execution.
+// after successful execution of the first, then the statement must be
+// rolled back due to partial execution. The transaction had no changes
+// before the statement, so all the shards are rolled back, but the
+// session stays in the transaction.
 func TestInsertPartialFail2(t *testing.T) {
-	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	executor, sbc1, _, sbclookup := createLegacyExecutorEnv()
 
 	// Make the second DML fail, it should result in a rollback.
 	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
 
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
 	_, err := executor.Execute(
 		context.Background(),
 		"TestExecute",
-		NewSafeSession(&vtgatepb.Session{InTransaction: true}),
+		session,
 		"insert into user(id, v, name) values (1, 2, 'myname')",
 		nil,
 	)
-	want := "transaction rolled back"
-	if err == nil || !strings.HasPrefix(err.Error(), want) {
-		t.Errorf("insert first DML fail: %v, must start with %s", err, want)
-	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "statement rolled back due to partial DML execution")
+	assert.EqualValues(t, 1, sbclookup.RollbackCount.Get())
+	assert.True(t, session.InTransaction())
+	assert.Empty(t, session.ShardSessions)
+}
+
+// If the transaction already made changes when the statement partially
+// executes, the statement is rolled back to the savepoint set before it,
+// and the earlier changes are kept.
+func TestInsertPartialFail3(t *testing.T) {
+	executor, sbc1, _, sbclookup := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "update music_user_map set user_id = 1 where music_id = 1", nil)
+	require.NoError(t, err)
+	sbclookup.Queries = nil
+
+	// Make the second DML fail, it should result in a rollback to the savepoint.
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executor.Execute(
+		context.Background(),
+		"TestExecute",
+		session,
+		"insert into user(id, v, name) values (1, 2, 'myname')",
+		nil,
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "statement rolled back due to partial DML execution")
+	assert.EqualValues(t, 0, sbclookup.RollbackCount.Get())
+	assert.True(t, session.InTransaction())
+	assert.Len(t, session.ShardSessions, 1)
+	assert.Empty(t, session.Savepoints)
+
+	var got []string
+	for _, q := range sbclookup.Queries {
+		got = append(got, q.Sql)
+	}
+	assert.Equal(t, []string{
+		"savepoint _vt_stmt_savepoint",
+		"insert into name_user_map(`name`, user_id) values (:name_0, :user_id_0)",
+		"rollback to _vt_stmt_savepoint",
+		"release savepoint _vt_stmt_savepoint",
+	}, got)
 }
 
 func TestMultiInsertSharded(t *testing.T) {
@@ -1337,7 +1383,7 @@ func TestMultiInsertSharded(t *testing.T) {
 	if sbc2.Queries != nil {
 		t.Errorf("sbc2.Queries: %+v, want nil\n", sbc2.Queries)
 	}
-	wantQueries = []*querypb.BoundQuery{{
+	wantQueries = []*querypb.BoundQuery{stmtSavepointQuery, {
 		Sql: "insert into name_user_map(`name`, user_id) values (:name_0, :user_id_0), (:name_1, :user_id_1)",
 		BindVariables: map[string]*querypb.BindVariable{
 			"name_0":    sqltypes.BytesBindVariable([]byte("myname1")),
@@ -1345,7 +1391,7 @@ func TestMultiInsertSharded(t *testing.T) {
 			"name_1":    sqltypes.BytesBindVariable([]byte("myname2")),
 			"user_id_1": sqltypes.Uint64BindVariable(2),
 		},
-	}}
+	}, stmtReleaseQuery}
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
 		t.Errorf("sbclookup.Queries: \n%+v, want \n%+v\n", sbclookup.Queries, wantQueries)
 	}
@@ -1356,7 +1402,7 @@ func TestMultiInsertSharded(t *testing.T) {
 	sbc2.Queries = nil
 	_, err = executorExec(executor, "insert into user2(id, `name`, lastname) values (2, 'myname', 'mylastname'), (3, 'myname2', 'mylastname2')", nil)
 	require.NoError(t, err)
-	wantQueries = []*querypb.BoundQuery{{
+	wantQueries = []*querypb.BoundQuery{stmtSavepointQuery, {
 		Sql: "insert into user2(id, `name`, lastname) values (:_id_0, :_name_0, :_lastname_0)",
 		BindVariables: map[string]*querypb.BindVariable{
 			"_id_0":       sqltypes.Int64BindVariable(2),
@@ -1366,11 +1412,11 @@ func TestMultiInsertSharded(t *testing.T) {
 			"_name_1":     sqltypes.BytesBindVariable([]byte("myname2")),
 			"_lastname_1": sqltypes.BytesBindVariable([]byte("mylastname2")),
 		},
-	}}
+	}, stmtReleaseQuery}
 	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
 		t.Errorf("sbc1.Queries:\n%+v, want\n%+v\n", sbc1.Queries, wantQueries)
 	}
-	wantQueries = []*querypb.BoundQuery{{
+	wantQueries = []*querypb.BoundQuery{stmtSavepointQuery, {
 		Sql: "insert into name_lastname_keyspace_id_map(`name`, lastname, keyspace_id) values (:name_0, :lastname_0, :keyspace_id_0), (:name_1, :lastname_1, :keyspace_id_1)",
 		BindVariables: map[string]*querypb.BindVariable{
 			"name_0":        sqltypes.BytesBindVariable([]byte("myname")),
@@ -1380,7 +1426,7 @@ func TestMultiInsertSharded(t *testing.T) {
 			"lastname_1":    sqltypes.BytesBindVariable([]byte("mylastname2")),
 			"keyspace_id_1": sqltypes.BytesBindVariable([]byte("N\261\220\311\242\372\026\234")),
 		},
-	}}
+	}, stmtReleaseQuery}
 	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
 		t.Errorf("sbclookup.Queries: \n%+v, want \n%+v\n", sbclookup.Queries, wantQueries)
 	}
@@ -262,6 +262,28 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	}
 }
 
+func TestExecutorTransactionQueryTags(t *testing.T) {
+	executor, _, _, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "/*request_id='r1'*/ begin", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"request_id": "r1"}, session.TransactionQueryTags)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from main1 /*route='%2Fcart'*/", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, sbclookup.Options)
+	assert.Equal(t, map[string]string{"request_id": "r1", "route": "/cart"}, sbclookup.Options[len(sbclookup.Options)-1].QueryTags)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	assert.Nil(t, session.TransactionQueryTags)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from main1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, sbclookup.Options[len(sbclookup.Options)-1].QueryTags)
+}
+
 func TestExecutorDeleteMetadata(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -2076,16 +2098,9 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	require.NoError(t, err)
 	_, err = exec(executor, session, "rollback")
 	require.NoError(t, err)
+	// Savepoint a was released before the transaction started on any
+	// shard, so it is never sent to the shards.
 	sbc1WantQueries := []*querypb.BoundQuery{{
-		Sql:           "savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "rollback to a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "release savepoint a",
-		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
 		Sql:           "select id from `user` where id = 1",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
@@ -2100,6 +2115,48 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	}}
 
 	sbc2WantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from `user` where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, sbc1WantQueries, sbc1.Queries, "")
+	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "")
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint a", 0)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to a", 0)
+	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint a", 0)
+	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 1", 1)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint b", 1)
+	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to b", 1)
+	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint b", 1)
+	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 3", 1)
+	testQueryLog(t, logChan, "TestExecute", "ROLLBACK", "rollback", 2)
+}
+
+func TestExecutorSavepointReplay(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: false, TargetString: "@master"})
+	_, err := exec(executor, session, "select id from user where id = 1")
+	require.NoError(t, err)
+	_, err = exec(executor, session, "savepoint a")
+	require.NoError(t, err)
+	// sbc2 joins the transaction after savepoint a, which is replayed there.
+	_, err = exec(executor, session, "select id from user where id = 3")
+	require.NoError(t, err)
+	_, err = exec(executor, session, "rollback to a")
+	require.NoError(t, err)
+	_, err = exec(executor, session, "release savepoint b")
+	require.EqualError(t, err, "SAVEPOINT b does not exist")
+	_, err = exec(executor, session, "release savepoint a")
+	require.NoError(t, err)
+	_, err = exec(executor, session, "rollback to a")
+	require.EqualError(t, err, "SAVEPOINT a does not exist")
+	assert.True(t, session.InTransaction())
+	assert.Empty(t, session.Savepoints)
+
+	sbc1WantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from `user` where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
 		Sql:           "savepoint a",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
@@ -2108,30 +2165,22 @@ func TestExecutorSavepointInTx(t *testing.T) {
 	}, {
 		Sql:           "release savepoint a",
 		BindVariables: map[string]*querypb.BindVariable{},
-	}, {
-		Sql:           "savepoint b",
+	}}
+	sbc2WantQueries := []*querypb.BoundQuery{{
+		Sql:           "savepoint a",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "rollback to b",
+		Sql:           "select id from `user` where id = 3",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "release savepoint b",
+		Sql:           "rollback to a",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}, {
-		Sql:           "select id from `user` where id = 3",
+		Sql:           "release savepoint a",
 		BindVariables: map[string]*querypb.BindVariable{},
 	}}
 	utils.MustMatch(t, sbc1WantQueries, sbc1.Queries, "")
 	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "")
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint a", 0)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to a", 0)
-	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint a", 0)
-	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 1", 1)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT", "savepoint b", 1)
-	testQueryLog(t, logChan, "TestExecute", "SAVEPOINT_ROLLBACK", "rollback to b", 1)
-	testQueryLog(t, logChan, "TestExecute", "RELEASE", "release savepoint b", 1)
-	testQueryLog(t, logChan, "TestExecute", "SELECT", "select id from user where id = 3", 1)
-	testQueryLog(t, logChan, "TestExecute", "ROLLBACK", "rollback", 2)
 }
 
 func TestExecutorSavepointWithoutTx(t *testing.T) {
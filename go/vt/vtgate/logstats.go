@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"io"
@@ -185,3 +186,69 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	)
 	return err
 }
+
+// LogRecord is the structured query log record of a LogStats.
+// See streamlog.RecordSchemaVersion for compatibility rules.
+type LogRecord struct {
+	streamlog.RecordHeader
+	Method          string          `json:"method"`
+	RemoteAddr      string          `json:"remote_addr"`
+	Username        string          `json:"username"`
+	ImmediateCaller string          `json:"immediate_caller"`
+	EffectiveCaller string          `json:"effective_caller"`
+	StartTime       time.Time       `json:"start_time"`
+	EndTime         time.Time       `json:"end_time"`
+	TotalTime       float64         `json:"total_time"`
+	PlanTime        float64         `json:"plan_time"`
+	ExecuteTime     float64         `json:"execute_time"`
+	CommitTime      float64         `json:"commit_time"`
+	StmtType        string          `json:"stmt_type"`
+	SQL             string          `json:"sql"`
+	BindVars        json.RawMessage `json:"bind_vars"`
+	ShardQueries    uint64          `json:"shard_queries"`
+	RowsAffected    uint64          `json:"rows_affected"`
+	RowsReturned    uint64          `json:"rows_returned"`
+	Keyspace        string          `json:"keyspace"`
+	Table           string          `json:"table"`
+	TabletType      string          `json:"tablet_type"`
+	Error           string          `json:"error"`
+}
+
+// Record returns the structured query log record, or nil if the query
+// is filtered out. It implements streamlog.Recorder.
+func (stats *LogStats) Record() interface{} {
+	if !streamlog.ShouldEmitLog(stats.SQL, stats.RowsAffected, stats.RowsReturned) {
+		return nil
+	}
+
+	bindVars := json.RawMessage(`"[REDACTED]"`)
+	if !*streamlog.RedactDebugUIQueries {
+		bindVars = json.RawMessage(sqltypes.FormatBindVariables(stats.BindVariables, true, true))
+	}
+
+	remoteAddr, username := stats.RemoteAddrUsername()
+	return &LogRecord{
+		RecordHeader:    streamlog.NewRecordHeader("vtgate"),
+		Method:          stats.Method,
+		RemoteAddr:      remoteAddr,
+		Username:        username,
+		ImmediateCaller: stats.ImmediateCaller(),
+		EffectiveCaller: stats.EffectiveCaller(),
+		StartTime:       stats.StartTime,
+		EndTime:         stats.EndTime,
+		TotalTime:       stats.TotalTime().Seconds(),
+		PlanTime:        stats.PlanTime.Seconds(),
+		ExecuteTime:     stats.ExecuteTime.Seconds(),
+		CommitTime:      stats.CommitTime.Seconds(),
+		StmtType:        stats.StmtType,
+		SQL:             stats.SQL,
+		BindVars:        bindVars,
+		ShardQueries:    stats.ShardQueries,
+		RowsAffected:    stats.RowsAffected,
+		RowsReturned:    stats.RowsReturned,
+		Keyspace:        stats.Keyspace,
+		Table:           stats.Table,
+		TabletType:      stats.TabletType,
+		Error:           stats.ErrorStr(),
+	}
+}
@@ -187,6 +187,46 @@ func TestLogStatsRowThreshold(t *testing.T) {
 	}
 }
 
+func TestLogStatsRecord(t *testing.T) {
+	defer func() {
+		*streamlog.RedactDebugUIQueries = false
+		*streamlog.QueryLogFilterTag = ""
+	}()
+	logStats := NewLogStats(context.Background(), "test", "sql1", map[string]*querypb.BindVariable{"intVal": sqltypes.Int64BindVariable(1)})
+	logStats.StartTime = time.Date(2017, time.January, 1, 1, 2, 3, 0, time.UTC)
+	logStats.EndTime = time.Date(2017, time.January, 1, 1, 2, 4, 0, time.UTC)
+	logStats.Keyspace = "ks"
+	logStats.Table = "table"
+	logStats.TabletType = "MASTER"
+	logStats.StmtType = "SELECT"
+	logStats.ShardQueries = 2
+	logStats.RowsReturned = 3
+
+	*streamlog.RedactDebugUIQueries = false
+	b, err := json.Marshal(logStats.Record())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"schema_version":1,"component":"vtgate","method":"test","remote_addr":"","username":"","immediate_caller":"","effective_caller":"","start_time":"2017-01-01T01:02:03Z","end_time":"2017-01-01T01:02:04Z","total_time":1,"plan_time":0,"execute_time":0,"commit_time":0,"stmt_type":"SELECT","sql":"sql1","bind_vars":{"intVal":{"type":"INT64","value":1}},"shard_queries":2,"rows_affected":0,"rows_returned":3,"keyspace":"ks","table":"table","tablet_type":"MASTER","error":""}`
+	if string(b) != want {
+		t.Errorf("logstats record: got:\n%s\nwant:\n%s", b, want)
+	}
+
+	*streamlog.RedactDebugUIQueries = true
+	b, err = json.Marshal(logStats.Record())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"bind_vars":"[REDACTED]"`) {
+		t.Errorf("logstats record: bind vars not redacted: %s", b)
+	}
+
+	*streamlog.QueryLogFilterTag = "LOG_THIS_QUERY"
+	if record := logStats.Record(); record != nil {
+		t.Errorf("logstats record: got %v, want nil for filtered query", record)
+	}
+}
+
 func TestLogStatsContextHTML(t *testing.T) {
 	html := "HtmlContext"
 	callInfo := &fakecallinfo.FakeCallInfo{
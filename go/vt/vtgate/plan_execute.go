@@ -32,6 +32,11 @@ import (
 func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
 	// 1: Prepare before planning and execution
 
+	// Record the query tags before starting an implicit transaction, so that
+	// the tags of the first statement apply to the whole transaction.
+	query, comments := sqlparser.SplitMarginComments(sql)
+	safeSession.SetQueryTags(sqlparser.ParseQueryTags(comments))
+
 	// Start an implicit transaction if necessary.
 	err := e.startTxIfNecessary(ctx, safeSession)
 	if err != nil {
@@ -42,7 +47,6 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
 
-	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
 	if err != nil {
 		return 0, nil, err
@@ -92,19 +96,19 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Savepoint", logStats, func(_ string) (*sqltypes.Result, error) {
 			// Safely to ignore as there is no transaction.
 			return &sqltypes.Result{}, nil
-		}, vcursor.ignoreMaxMemoryRows)
+		})
 		return sqlparser.StmtSavepoint, qr, err
 	case sqlparser.StmtSRollback:
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Rollback Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
 			// Error as there is no transaction, so there is no savepoint that exists.
 			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
-		}, vcursor.ignoreMaxMemoryRows)
+		})
 		return sqlparser.StmtSRollback, qr, err
 	case sqlparser.StmtRelease:
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Release Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
 			// Error as there is no transaction, so there is no savepoint that exists.
 			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
-		}, vcursor.ignoreMaxMemoryRows)
+		})
 		return sqlparser.StmtRelease, qr, err
 	}
 
@@ -154,6 +158,12 @@ func (e *Executor) insideTransaction(ctx context.Context, safeSession *SafeSessi
 	// at the beginning, but never after.
 	safeSession.SetAutocommittable(mustCommit)
 
+	// A statement of a transaction started by the application is protected
+	// by a savepoint, so that a partial failure does not abort the transaction.
+	if !mustCommit && safeSession.StartStmt() {
+		defer e.endStmt(ctx, safeSession)
+	}
+
 	// Execute!
 	stmtType, result, err := f(logStats, safeSession)
 	if err != nil {
@@ -184,10 +194,10 @@ func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *
 		errCount := e.logExecutionEnd(logStats, execStart, plan, err, qr)
 		plan.AddStats(1, time.Since(logStats.StartTime), uint64(logStats.ShardQueries), logStats.RowsAffected, logStats.RowsReturned, errCount)
 
-		// Check if there was partial DML execution. If so, rollback the transaction.
+		// Check if there was partial DML execution. If so, rollback the statement,
+		// or the transaction if the statement can't be rolled back on its own.
 		if err != nil && safeSession.InTransaction() && vcursor.rollbackOnPartialExec {
-			_ = e.txConn.Rollback(ctx, safeSession)
-			err = vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back due to partial DML execution: %v", err)
+			err = e.rollbackPartialExec(ctx, safeSession, err)
 		}
 		return plan.Type, qr, err
 	}
@@ -51,5 +51,8 @@ func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		edel.KsidVindex = ksidVindex
 	}
 
+	if err := buildForeignKeys(&edel.DML, nil, del.Where, del.OrderBy, del.Limit); err != nil {
+		return nil, err
+	}
 	return edel, nil
 }
@@ -187,6 +187,130 @@ func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limi
 	return buf.String()
 }
 
+// buildForeignKeys adds to the DML the cross-shard foreign keys which reference its table, along with the
+// query which selects the referenced columns. MySQL enforces the shard-local foreign keys by itself.
+// An update only enforces the foreign keys whose referenced columns it changes, and it can only change
+// them to values. The update is nil for a delete.
+func buildForeignKeys(edml *engine.DML, update *sqlparser.Update, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) error {
+	table := edml.Table
+	if update != nil {
+		if err := checkParentForeignKeys(table, update.Exprs); err != nil {
+			return err
+		}
+	}
+	var selectCols []sqlparser.ColIdent
+	offsetOf := func(col sqlparser.ColIdent) int {
+		for i, selectCol := range selectCols {
+			if selectCol.Equal(col) {
+				return i
+			}
+		}
+		selectCols = append(selectCols, col)
+		return len(selectCols) - 1
+	}
+	for _, fk := range table.ChildForeignKeys {
+		if fk.ShardLocal {
+			continue
+		}
+		fkChild := &engine.FKChild{
+			Name:    fk.String(),
+			Table:   fk.Table,
+			Columns: fk.Columns,
+			Action:  fk.OnDelete,
+		}
+		if update != nil {
+			fkChild.Action = fk.OnUpdate
+			for i, parentCol := range fk.ParentColumns {
+				for _, assignment := range update.Exprs {
+					if !parentCol.Equal(assignment.Name.Name) {
+						continue
+					}
+					pv, err := sqlparser.NewPlanValue(assignment.Expr)
+					if err != nil || sqlparser.IsSimpleTuple(assignment.Expr) {
+						return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column %v is referenced by cross-shard foreign key %v, it can only be updated to a value", parentCol, fk)
+					}
+					if fkChild.Values == nil {
+						fkChild.Values = make(map[string]sqltypes.PlanValue)
+					}
+					fkChild.Values[fk.Columns[i].String()] = pv
+				}
+			}
+			if len(fkChild.Values) == 0 {
+				// The referenced columns are not changed.
+				continue
+			}
+		}
+		if fkChild.Action == vindexes.FKCascade {
+			if err := checkForeignKeyCascade(fk, fkChild.Values); err != nil {
+				return err
+			}
+		}
+		for _, col := range fk.ParentColumns {
+			fkChild.Offsets = append(fkChild.Offsets, offsetOf(col))
+		}
+		edml.FKChildren = append(edml.FKChildren, fkChild)
+	}
+	if len(edml.FKChildren) == 0 {
+		return nil
+	}
+	if limit != nil && len(orderBy) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Table %s is referenced by cross-shard foreign keys", table.Name.String())
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, col := range selectCols {
+		if i == 0 {
+			buf.Myprintf("select %v", col)
+		} else {
+			buf.Myprintf(", %v", col)
+		}
+	}
+	buf.Myprintf(" from %v%v%v%v for update", table.Name, where, orderBy, limit)
+	edml.FKQuery = buf.String()
+	return nil
+}
+
+// checkParentForeignKeys returns an error if the assignments of an update, or of an insert's ON DUPLICATE KEY
+// UPDATE clause, write the columns of a cross-shard foreign key of the table, since vtgate does not check that
+// the parent rows they then reference exist.
+func checkParentForeignKeys(table *vindexes.Table, exprs sqlparser.UpdateExprs) error {
+	for _, fk := range table.ForeignKeys {
+		if fk.ShardLocal {
+			continue
+		}
+		for _, col := range fk.Columns {
+			for _, assignment := range exprs {
+				if col.Equal(assignment.Name.Name) {
+					return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column %v is part of cross-shard foreign key %v, it can't be updated", col, fk)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkForeignKeyCascade returns an error if vtgate can't cascade the deletion or the update of parent rows to
+// the child rows of a cross-shard foreign key. The values are the new values of the child columns, for an update.
+func checkForeignKeyCascade(fk *vindexes.ForeignKey, values map[string]sqltypes.PlanValue) error {
+	child := fk.Table
+	if len(child.Owned) > 0 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cascading cross-shard foreign key %v to table %s, which owns vindexes", fk, child.Name.String())
+	}
+	for _, childFK := range child.ChildForeignKeys {
+		if !childFK.ShardLocal {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cascading cross-shard foreign key %v to table %s, which is referenced by cross-shard foreign key %v", fk, child.Name.String(), childFK)
+		}
+	}
+	for _, cv := range child.ColumnVindexes {
+		for _, col := range cv.Columns {
+			if _, ok := values[col.String()]; ok {
+				return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cascading cross-shard foreign key %v updates column %v of vindex %s", fk, col, cv.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func generateQuery(statement sqlparser.Statement) string {
 	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	statement.Format(buf)
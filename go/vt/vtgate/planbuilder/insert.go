@@ -124,6 +124,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
 			return nil, errors.New("unsupported: DML cannot change vindex column")
 		}
+		if err := checkParentForeignKeys(table, sqlparser.UpdateExprs(ins.OnDup)); err != nil {
+			return nil, err
+		}
 		eins.Opcode = engine.InsertShardedIgnore
 	}
 	if len(ins.Columns) == 0 {
@@ -162,6 +165,11 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 			return nil, err
 		}
 	}
+	fkParents, err := buildFKParents(ins, table, rows)
+	if err != nil {
+		return nil, err
+	}
+	eins.FKParents = fkParents
 
 	// Fill out the 3-d Values structure. Please see documentation of Insert.Values for details.
 	routeValues := make([]sqltypes.PlanValue, len(eins.Table.ColumnVindexes))
@@ -194,6 +202,49 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildFKParents returns the cross-shard foreign keys of the table, with the values which the insert gives to
+// their columns. MySQL enforces the shard-local foreign keys by itself. A column which the insert omits is
+// taken to be NULL, and so to reference no parent row.
+func buildFKParents(ins *sqlparser.Insert, table *vindexes.Table, rows sqlparser.Values) ([]*engine.FKParent, error) {
+	var fkParents []*engine.FKParent
+	for _, fk := range table.ForeignKeys {
+		if fk.ShardLocal {
+			continue
+		}
+		fkParent := &engine.FKParent{
+			Name:    fk.String(),
+			Table:   fk.ParentTable,
+			Columns: fk.ParentColumns,
+			Values:  make([]sqltypes.PlanValue, len(fk.Columns)),
+		}
+		for i, col := range fk.Columns {
+			fkParent.Values[i].Values = make([]sqltypes.PlanValue, len(rows))
+			colNum := -1
+			for j, column := range ins.Columns {
+				if col.Equal(column) {
+					colNum = j
+					break
+				}
+			}
+			if colNum == -1 {
+				continue
+			}
+			for rowNum, row := range rows {
+				if _, ok := row[colNum].(*sqlparser.Default); ok {
+					continue
+				}
+				pv, err := sqlparser.NewPlanValue(row[colNum])
+				if err != nil {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column %v is part of cross-shard foreign key %v, it can only be inserted as a value", col, fk)
+				}
+				fkParent.Values[i].Values[rowNum] = pv
+			}
+		}
+		fkParents = append(fkParents, fkParent)
+	}
+	return fkParents, nil
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
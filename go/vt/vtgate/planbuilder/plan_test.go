@@ -188,6 +188,8 @@ func TestPlan(t *testing.T) {
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "view_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "foreign_key_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "ddl_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "flush_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
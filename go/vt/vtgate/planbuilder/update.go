@@ -50,6 +50,9 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 	if len(eupd.ChangedVindexValues) != 0 {
 		eupd.KsidVindex = ksidVindex
 	}
+	if err := buildForeignKeys(&eupd.DML, upd, upd.Where, upd.OrderBy, upd.Limit); err != nil {
+		return nil, err
+	}
 	return eupd, nil
 }
 
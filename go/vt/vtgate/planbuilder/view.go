@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// expandViews replaces every reference to a view found in the vschema with a derived table
+// built from the view's definition, aliased by the view's name. The planner can then route
+// the underlying tables of the view, which it can't do for the view itself in a sharded keyspace.
+// Views nested in a view's definition are expanded as well.
+func expandViews(stmt sqlparser.SelectStatement, vschema ContextVSchema) {
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		aliasedTable, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+		if !ok {
+			return true
+		}
+		vindexTable, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || vindexTable == nil || vindexTable.Type != vindexes.TypeView || vindexTable.ViewDefinition == nil {
+			return true
+		}
+		aliasedTable.Expr = &sqlparser.DerivedTable{Select: viewDefinitionFor(vindexTable)}
+		if aliasedTable.As.IsEmpty() {
+			aliasedTable.As = tableName.Name
+		}
+		return true
+	}, nil)
+}
+
+// viewDefinitionFor returns a copy of the view's definition, where unqualified table names
+// are qualified by the view's keyspace, so that they resolve to the same keyspace as the view.
+func viewDefinitionFor(view *vindexes.Table) sqlparser.SelectStatement {
+	definition := sqlparser.CloneSelectStatement(view.ViewDefinition)
+	_ = sqlparser.Rewrite(definition, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.ColName:
+			// cloning does not copy column names, and the planner annotates them with metadata
+			colName := *node
+			colName.Metadata = nil
+			cursor.Replace(&colName)
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && view.Keyspace != nil {
+				tableName.Qualifier = sqlparser.NewTableIdent(view.Keyspace.Name)
+				node.Expr = tableName
+			}
+		}
+		return true
+	}, nil)
+	return definition
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/servenv"
 )
 
 var (
@@ -38,6 +39,9 @@ var (
 
 	// queryLogToFile controls whether query logs are sent to a file
 	queryLogToFile = flag.String("log_queries_to_file", "", "Enable query logging to the specified file")
+
+	// structuredQueryLog configures the structured JSON query log of vtgate
+	structuredQueryLog = streamlog.RegisterStructuredLogFlags("vtgate")
 )
 
 func initQueryLogger(vtg *VTGate) error {
@@ -60,5 +64,14 @@ func initQueryLogger(vtg *VTGate) error {
 		}
 	}
 
+	if structuredQueryLog.Enabled() {
+		sl, err := QueryLogger.LogStructured("vtgate", structuredQueryLog)
+		if err != nil {
+			return err
+		}
+		// Flush and close the sinks once vtgate stops serving queries.
+		servenv.OnClose(sl.Stop)
+	}
+
 	return nil
 }
@@ -23,6 +23,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -40,6 +42,7 @@ type SafeSession struct {
 	mustRollback    bool
 	autocommitState autocommitState
 	commitOrder     vtgatepb.CommitOrder
+	stmtSavepoint   *stmtSavepoint
 
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
@@ -71,6 +74,36 @@ const (
 	autocommitted
 )
 
+// stmtSavepoint keeps track of how to undo the DML statement being
+// executed in a transaction, if it fails after it partially executed,
+// for example on some of the shards it targets. Undoing the statement
+// does not abort the whole transaction.
+//
+// The shards which were already in the transaction when the statement
+// started are rolled back to a savepoint set before the statement first
+// wrote to them. The shards which joined the transaction during the
+// statement had no changes before it, so they are rolled back entirely.
+//
+// The savepoint costs a round trip, so it is only set before a write
+// which may be followed by another one in the same statement: a write
+// on several shards, or a vindex write. The last write of a statement
+// on a single shard is atomic, and needs no savepoint. If a shard of the
+// transaction was changed without the savepoint anyway, the statement
+// can't be undone on its own.
+type stmtSavepoint struct {
+	// txShards are the shard sessions which were in the transaction when
+	// the statement started, mapped to whether the savepoint was set.
+	txShards map[*vtgatepb.Session_ShardSession]bool
+	// unprotected is set once the statement changed a shard of txShards
+	// on which the savepoint was not set.
+	unprotected bool
+	// vindexWrites counts the vindex writes being executed.
+	vindexWrites int
+}
+
+// stmtSavepointName is the name of the implicit statement savepoint.
+const stmtSavepointName = "_vt_stmt_savepoint"
+
 // NewSafeSession returns a new SafeSession based on the Session
 func NewSafeSession(sessn *vtgatepb.Session) *SafeSession {
 	if sessn == nil {
@@ -100,7 +133,9 @@ func (session *SafeSession) ResetTx() {
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
+	session.stmtSavepoint = nil
 	session.Savepoints = nil
+	session.TransactionQueryTags = nil
 	if !session.Session.InReservedConn {
 		session.ShardSessions = nil
 		session.PreSessions = nil
@@ -116,7 +151,9 @@ func (session *SafeSession) Reset() {
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
+	session.stmtSavepoint = nil
 	session.Savepoints = nil
+	session.TransactionQueryTags = nil
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
@@ -177,19 +214,11 @@ func (session *SafeSession) InTransaction() bool {
 func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) (transactionID int64, reservedID int64, alias *topodatapb.TabletAlias) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	sessions := session.ShardSessions
-	switch session.commitOrder {
-	case vtgatepb.CommitOrder_PRE:
-		sessions = session.PreSessions
-	case vtgatepb.CommitOrder_POST:
-		sessions = session.PostSessions
-	}
-	for _, shardSession := range sessions {
-		if keyspace == shardSession.Target.Keyspace && tabletType == shardSession.Target.TabletType && shard == shardSession.Target.Shard {
-			return shardSession.TransactionId, shardSession.ReservedId, shardSession.TabletAlias
-		}
+	shardSession := session.findShardSessionLocked(&querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: tabletType})
+	if shardSession == nil {
+		return 0, 0, nil
 	}
-	return 0, 0, nil
+	return shardSession.TransactionId, shardSession.ReservedId, shardSession.TabletAlias
 }
 
 func addOrUpdate(shardSession *vtgatepb.Session_ShardSession, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
@@ -339,11 +368,276 @@ func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.Options = options
 }
 
-// StoreSavepoint stores the savepoint and release savepoint queries in the session
-func (session *SafeSession) StoreSavepoint(sql string) {
+// SetQueryTags sets the query tags of the statement being executed.
+// Tags of a statement executed outside of a transaction are recorded as
+// the transaction tags, so that a statement starting a transaction tags
+// every statement within it. Inside a transaction, the statement tags are
+// merged over the transaction tags.
+func (session *SafeSession) SetQueryTags(tags map[string]string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.InTransaction {
+		session.TransactionQueryTags = tags
+	}
+	var effective map[string]string
+	if len(session.TransactionQueryTags) > 0 || len(tags) > 0 {
+		effective = make(map[string]string, len(session.TransactionQueryTags)+len(tags))
+		for k, v := range session.TransactionQueryTags {
+			effective[k] = v
+		}
+		for k, v := range tags {
+			effective[k] = v
+		}
+	}
+	if effective == nil && session.Options == nil {
+		return
+	}
+	session.GetOrCreateOptions().QueryTags = effective
+}
+
+// savepointIndex returns the position in the session savepoints of
+// the statement setting the named savepoint, or -1 if there is none.
+// It must be called with the lock held.
+func (session *SafeSession) savepointIndex(name sqlparser.ColIdent) int {
+	for i := len(session.Savepoints) - 1; i >= 0; i-- {
+		stmt, err := sqlparser.Parse(session.Savepoints[i])
+		if err != nil {
+			continue
+		}
+		if sp, ok := stmt.(*sqlparser.Savepoint); ok && sp.Name.Equal(name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasSavepoint returns true if the named savepoint is set in the transaction.
+func (session *SafeSession) HasSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.savepointIndex(name) >= 0
+}
+
+// SetSavepoint records that the named savepoint was set. The savepoints
+// of the session are replayed on the shards that join the transaction
+// later, so that rolling back to a savepoint also undoes their changes.
+// As in MySQL, setting a savepoint replaces the one with the same name.
+func (session *SafeSession) SetSavepoint(name sqlparser.ColIdent) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if idx := session.savepointIndex(name); idx >= 0 {
+		session.Savepoints = append(session.Savepoints[:idx], session.Savepoints[idx+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, sqlparser.String(&sqlparser.Savepoint{Name: name}))
+}
+
+// RollbackToSavepoint records that the transaction was rolled back to
+// the named savepoint: the savepoints set after it no longer exist.
+// It returns false if the savepoint does not exist.
+func (session *SafeSession) RollbackToSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	idx := session.savepointIndex(name)
+	if idx < 0 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:idx+1]
+	return true
+}
+
+// ReleaseSavepoint records that the named savepoint was released, along
+// with the savepoints set after it. It returns false if the savepoint
+// does not exist.
+func (session *SafeSession) ReleaseSavepoint(name sqlparser.ColIdent) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	idx := session.savepointIndex(name)
+	if idx < 0 {
+		return false
+	}
+	session.Savepoints = session.Savepoints[:idx]
+	return true
+}
+
+// StartStmt marks the start of the outermost execution of a DML
+// statement in a transaction. It returns false if a statement is already
+// being executed, in which case the call must not be matched by EndStmt.
+func (session *SafeSession) StartStmt() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint != nil {
+		return false
+	}
+	txShards := make(map[*vtgatepb.Session_ShardSession]bool)
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, s := range sessions {
+			if s.TransactionId != 0 {
+				txShards[s] = false
+			}
+		}
+	}
+	session.stmtSavepoint = &stmtSavepoint{txShards: txShards}
+	return true
+}
+
+// EndStmt marks the end of the execution of a statement. It returns the
+// shard sessions on which the statement savepoint must be released.
+func (session *SafeSession) EndStmt() []*vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint == nil {
+		return nil
+	}
+	var savepointShards []*vtgatepb.Session_ShardSession
+	for s, set := range session.stmtSavepoint.txShards {
+		if set && s.TransactionId != 0 {
+			savepointShards = append(savepointShards, s)
+		}
+	}
+	session.stmtSavepoint = nil
+	return savepointShards
+}
+
+// StartVindexWrite marks the start of a vindex write of the statement.
+// The vindex write is followed by the write of the statement itself, so
+// it must be protected by the statement savepoint.
+func (session *SafeSession) StartVindexWrite() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint != nil {
+		session.stmtSavepoint.vindexWrites++
+	}
+}
+
+// EndVindexWrite marks the end of a vindex write of the statement.
+func (session *SafeSession) EndVindexWrite() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint != nil {
+		session.stmtSavepoint.vindexWrites--
+	}
+}
+
+// InVindexWrite returns true if a vindex write of the statement is
+// being executed.
+func (session *SafeSession) InVindexWrite() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.stmtSavepoint != nil && session.stmtSavepoint.vindexWrites > 0
+}
+
+// StmtSavepointShards returns the shard sessions of the resolved shards
+// which need the statement savepoint before the statement writes to
+// them: the ones which were in the transaction when the statement
+// started, and on which the savepoint was not set yet.
+func (session *SafeSession) StmtSavepointShards(rss []*srvtopo.ResolvedShard) []*vtgatepb.Session_ShardSession {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	session.Savepoints = append(session.Savepoints, sql)
+	if session.stmtSavepoint == nil {
+		return nil
+	}
+	var shardSessions []*vtgatepb.Session_ShardSession
+	for _, rs := range rss {
+		s := session.findShardSessionLocked(rs.Target)
+		if set, ok := session.stmtSavepoint.txShards[s]; ok && !set {
+			shardSessions = append(shardSessions, s)
+		}
+	}
+	return shardSessions
+}
+
+// SetStmtSavepoint records that the statement savepoint was set on the
+// shard sessions.
+func (session *SafeSession) SetStmtSavepoint(shardSessions []*vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint == nil {
+		return
+	}
+	for _, s := range shardSessions {
+		session.stmtSavepoint.txShards[s] = true
+	}
+}
+
+// RecordStmtWrite records that the statement changed the resolved shards
+// without setting the savepoint first.
+func (session *SafeSession) RecordStmtWrite(rss []*srvtopo.ResolvedShard) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint == nil {
+		return
+	}
+	for _, rs := range rss {
+		s := session.findShardSessionLocked(rs.Target)
+		if set, ok := session.stmtSavepoint.txShards[s]; ok && !set {
+			session.stmtSavepoint.unprotected = true
+		}
+	}
+}
+
+// StmtRollbackShards returns how to undo the statement being executed:
+// the shard sessions to roll back to the statement savepoint, and the
+// ones which joined the transaction during the statement, to roll back
+// entirely. It returns false if the statement can't be undone on its own.
+func (session *SafeSession) StmtRollbackShards() (savepointShards, joinedShards []*vtgatepb.Session_ShardSession, ok bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.stmtSavepoint == nil || session.stmtSavepoint.unprotected {
+		return nil, nil, false
+	}
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, s := range sessions {
+			if s.TransactionId == 0 {
+				continue
+			}
+			set, ok := session.stmtSavepoint.txShards[s]
+			switch {
+			case !ok:
+				joinedShards = append(joinedShards, s)
+			case set:
+				savepointShards = append(savepointShards, s)
+			}
+		}
+	}
+	return savepointShards, joinedShards, true
+}
+
+// RemoveRolledBackShards forgets the shard sessions whose transaction was
+// rolled back, while staying in the transaction. The shard sessions of
+// reserved connections are kept.
+func (session *SafeSession) RemoveRolledBackShards() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	keep := func(sessions []*vtgatepb.Session_ShardSession) []*vtgatepb.Session_ShardSession {
+		var kept []*vtgatepb.Session_ShardSession
+		for _, s := range sessions {
+			if s.TransactionId != 0 || s.ReservedId != 0 {
+				kept = append(kept, s)
+			}
+		}
+		return kept
+	}
+	session.PreSessions = keep(session.PreSessions)
+	session.ShardSessions = keep(session.ShardSessions)
+	session.PostSessions = keep(session.PostSessions)
+}
+
+// findShardSessionLocked returns the shard session of the target in the
+// current commit order, or nil if there is none.
+func (session *SafeSession) findShardSessionLocked(target *querypb.Target) *vtgatepb.Session_ShardSession {
+	sessions := session.ShardSessions
+	switch session.commitOrder {
+	case vtgatepb.CommitOrder_PRE:
+		sessions = session.PreSessions
+	case vtgatepb.CommitOrder_POST:
+		sessions = session.PostSessions
+	}
+	for _, shardSession := range sessions {
+		if target.Keyspace == shardSession.Target.Keyspace && target.TabletType == shardSession.Target.TabletType && target.Shard == shardSession.Target.Shard {
+			return shardSession
+		}
+	}
+	return nil
 }
 
 // InReservedConn returns true if the session needs to execute on a dedicated connection
@@ -418,7 +712,9 @@ func (session *SafeSession) ResetAll() {
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
+	session.stmtSavepoint = nil
 	session.Savepoints = nil
+	session.TransactionQueryTags = nil
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
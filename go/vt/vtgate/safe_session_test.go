@@ -20,11 +20,14 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 )
 
 func TestFailToMultiShardWhenSetToSingleDb(t *testing.T) {
@@ -67,3 +70,98 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestSetQueryTags(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{})
+
+	// No tags and no options: nothing to do.
+	session.SetQueryTags(nil)
+	assert.Nil(t, session.Options)
+
+	// Outside of a transaction, the statement tags become the transaction tags.
+	session.SetQueryTags(map[string]string{"request_id": "1", "route": "/a"})
+	assert.Equal(t, map[string]string{"request_id": "1", "route": "/a"}, session.Options.QueryTags)
+
+	// Inside a transaction, statement tags are merged over the transaction tags.
+	session.Session.InTransaction = true
+	session.SetQueryTags(map[string]string{"route": "/b", "action": "save"})
+	assert.Equal(t, map[string]string{"request_id": "1", "route": "/b", "action": "save"}, session.Options.QueryTags)
+	session.SetQueryTags(nil)
+	assert.Equal(t, map[string]string{"request_id": "1", "route": "/a"}, session.Options.QueryTags)
+
+	// Once the transaction is over, its tags no longer apply.
+	session.ResetTx()
+	session.SetQueryTags(nil)
+	assert.Nil(t, session.Options.QueryTags)
+}
+
+func TestSavepoints(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	name := sqlparser.NewColIdent
+
+	session.SetSavepoint(name("a"))
+	session.SetSavepoint(name("b"))
+	session.SetSavepoint(name("c"))
+	assert.Equal(t, []string{"savepoint a", "savepoint b", "savepoint c"}, session.Savepoints)
+	assert.True(t, session.HasSavepoint(name("B")))
+	assert.False(t, session.HasSavepoint(name("d")))
+
+	// Setting a savepoint again moves it to the end.
+	session.SetSavepoint(name("a"))
+	assert.Equal(t, []string{"savepoint b", "savepoint c", "savepoint a"}, session.Savepoints)
+
+	// Rolling back to a savepoint keeps it, but not the later ones.
+	assert.True(t, session.RollbackToSavepoint(name("c")))
+	assert.Equal(t, []string{"savepoint b", "savepoint c"}, session.Savepoints)
+	assert.False(t, session.RollbackToSavepoint(name("a")))
+
+	// Releasing a savepoint also releases the later ones.
+	assert.True(t, session.ReleaseSavepoint(name("b")))
+	assert.Empty(t, session.Savepoints)
+	assert.False(t, session.ReleaseSavepoint(name("b")))
+}
+
+func TestStmtSavepoint(t *testing.T) {
+	target := func(shard string) *querypb.Target {
+		return &querypb.Target{Keyspace: "ks", Shard: shard, TabletType: topodatapb.TabletType_MASTER}
+	}
+	rs := func(shard string) []*srvtopo.ResolvedShard {
+		return []*srvtopo.ResolvedShard{{Target: target(shard)}}
+	}
+	shard0 := &vtgatepb.Session_ShardSession{Target: target("0"), TransactionId: 1}
+	shard1 := &vtgatepb.Session_ShardSession{Target: target("1"), TransactionId: 2}
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, ShardSessions: []*vtgatepb.Session_ShardSession{shard0, shard1}})
+
+	require.True(t, session.StartStmt())
+	// A recursive execution doesn't own the statement.
+	assert.False(t, session.StartStmt())
+
+	// The savepoint is only needed on the shards which were in the transaction.
+	assert.Equal(t, []*vtgatepb.Session_ShardSession{shard0}, session.StmtSavepointShards(rs("0")))
+	assert.Empty(t, session.StmtSavepointShards(rs("2")))
+	session.SetStmtSavepoint([]*vtgatepb.Session_ShardSession{shard0})
+	assert.Empty(t, session.StmtSavepointShards(rs("0")))
+
+	// shard2 joins the transaction during the statement.
+	shard2 := &vtgatepb.Session_ShardSession{Target: target("2"), TransactionId: 3}
+	session.ShardSessions = append(session.ShardSessions, shard2)
+	savepointShards, joinedShards, ok := session.StmtRollbackShards()
+	require.True(t, ok)
+	assert.Equal(t, []*vtgatepb.Session_ShardSession{shard0}, savepointShards)
+	assert.Equal(t, []*vtgatepb.Session_ShardSession{shard2}, joinedShards)
+
+	// Once a shard of the transaction changed without the savepoint, the
+	// statement can't be undone on its own.
+	session.RecordStmtWrite(rs("1"))
+	_, _, ok = session.StmtRollbackShards()
+	assert.False(t, ok)
+
+	// The savepoint is released where it was set.
+	assert.Equal(t, []*vtgatepb.Session_ShardSession{shard0}, session.EndStmt())
+	assert.Empty(t, session.EndStmt())
+
+	shard2.TransactionId = 0
+	session.RemoveRolledBackShards()
+	assert.Equal(t, []*vtgatepb.Session_ShardSession{shard0, shard1}, session.ShardSessions)
+	assert.True(t, session.InTransaction())
+}
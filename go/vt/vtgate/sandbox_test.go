@@ -106,6 +106,10 @@ type sandbox struct {
 	// DialMustFail specifies how often sandboxDialer must fail before succeeding
 	DialMustFail int
 
+	// DialConns specifies the connections sandboxDialer returns for the
+	// tablets of a shard, keyed by shard
+	DialConns map[string]*sandboxconn.SandboxConn
+
 	// KeyspaceServedFrom specifies the served-from keyspace for vertical resharding
 	KeyspaceServedFrom string
 
@@ -127,6 +131,7 @@ func (s *sandbox) Reset() {
 	s.SrvKeyspaceMustFail = 0
 	s.DialCounter = 0
 	s.DialMustFail = 0
+	s.DialConns = nil
 	s.KeyspaceServedFrom = ""
 	s.ShardSpec = DefaultShardSpec
 	s.SrvKeyspaceCallback = nil
@@ -317,6 +322,9 @@ func sandboxDialer(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (que
 		sand.DialMustFail--
 		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "conn error")
 	}
+	if sbc, ok := sand.DialConns[tablet.Shard]; ok {
+		return sbc, nil
+	}
 	sbc := sandboxconn.NewSandboxConn(tablet)
 	return sbc, nil
 }
@@ -271,13 +271,13 @@ func (stc *ScatterConn) ExecuteMultiShard(
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
 						info.actionNeeded = reserveBegin
-						innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, opts)
+						innerqr, transactionID, reservedID, alias, err = reserveBeginExecute(ctx, rs, qs, session, queries[i].Sql, queries[i].BindVariables, opts)
 					})
 				}
 			case reserve:
 				innerqr, reservedID, alias, err = qs.ReserveExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, transactionID, opts)
 			case reserveBegin:
-				innerqr, transactionID, reservedID, alias, err = qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, opts)
+				innerqr, transactionID, reservedID, alias, err = reserveBeginExecute(ctx, rs, qs, session, queries[i].Sql, queries[i].BindVariables, opts)
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on query execution: %v", info.actionNeeded)
 			}
@@ -320,6 +320,34 @@ func checkAndResetShardSession(info *shardActionInfo, err error, session *SafeSe
 	return retry
 }
 
+// reserveBeginExecute reserves a connection, begins a transaction on it and
+// executes the query. ReserveBeginExecute runs its pre-queries before the
+// transaction begins, so the savepoints of the session are set afterwards,
+// on the tablet which reserved the connection.
+func reserveBeginExecute(ctx context.Context, rs *srvtopo.ResolvedShard, qs queryservice.QueryService, session *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, opts *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
+	savepoints := session.Savepoints
+	if len(savepoints) == 0 {
+		return qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), sql, bindVars, opts)
+	}
+	_, transactionID, reservedID, alias, err := qs.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), savepoints[0], nil, opts)
+	if err != nil {
+		return nil, transactionID, reservedID, alias, err
+	}
+	if alias != nil {
+		qs, err = rs.Gateway.QueryServiceByAlias(alias, rs.Target)
+		if err != nil {
+			return nil, transactionID, reservedID, alias, err
+		}
+	}
+	for _, savepoint := range savepoints[1:] {
+		if _, err := qs.Execute(ctx, rs.Target, savepoint, nil, transactionID, reservedID, opts); err != nil {
+			return nil, transactionID, reservedID, alias, err
+		}
+	}
+	qr, err := qs.Execute(ctx, rs.Target, sql, bindVars, transactionID, reservedID, opts)
+	return qr, transactionID, reservedID, alias, err
+}
+
 func getQueryService(rs *srvtopo.ResolvedShard, info *shardActionInfo) (queryservice.QueryService, error) {
 	_, usingLegacyGw := rs.Gateway.(*DiscoveryGateway)
 	if usingLegacyGw &&
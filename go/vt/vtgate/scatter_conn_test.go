@@ -128,6 +128,30 @@ func TestReservedOnMultiReplica(t *testing.T) {
 	}
 }
 
+func TestReservedBeginSavepoints(t *testing.T) {
+	keyspace := "keyspace"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+
+	// The savepoints are set once the transaction began on the reserved
+	// connection, before the query.
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, InReservedConn: true, Savepoints: []string{"savepoint a", "savepoint b"}})
+	executeOnShards(t, res, keyspace, sc, session, []key.Destination{key.DestinationShard("0")})
+	assert.EqualValues(t, 1, sbc0.ReserveCount.Get())
+	assert.EqualValues(t, 1, sbc0.BeginCount.Get())
+	var got []string
+	for _, query := range sbc0.Queries {
+		got = append(got, query.Sql)
+	}
+	assert.Equal(t, []string{"savepoint a", "savepoint b", "query1"}, got)
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].TransactionId)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId)
+}
+
 func TestReservedBeginTableDriven(t *testing.T) {
 	type testAction struct {
 		transaction, reserved    bool
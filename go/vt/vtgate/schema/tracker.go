@@ -0,0 +1,321 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	sqlSelectColumns = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+	sqlSelectColumnsForTables = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() and table_name in ::tableNames order by table_name, ordinal_position"
+)
+
+type (
+	// keyspaceTables maps table names to their columns
+	keyspaceTables map[string][]vindexes.Column
+
+	// keyspaceUpdate is a pending reload of the tables of a keyspace
+	keyspaceUpdate struct {
+		// th is the health of the master tablet to load the tables from
+		th *discovery.TabletHealth
+		// all is set to reload all the tables, rather than the changed ones
+		all     bool
+		changed map[string]bool
+	}
+
+	// Tracker keeps track of the columns of the tables of each keyspace, as found by the master tablets.
+	// All the tables of a keyspace are loaded the first time a master tablet of the keyspace is seen in
+	// the health stream. Tables are then reloaded whenever a master tablet signals they changed. Since
+	// health updates may be dropped, all the tables are also reloaded every reloadInterval.
+	Tracker struct {
+		ch             chan *discovery.TabletHealth
+		reloadInterval time.Duration
+		cancel         context.CancelFunc
+		// work is notified whenever an update is pending
+		work chan struct{}
+
+		mu      sync.Mutex
+		tables  map[string]keyspaceTables
+		masters map[string]*discovery.TabletHealth
+		pending map[string]*keyspaceUpdate
+		// signal is called whenever the tracked schema changes
+		signal func()
+	}
+)
+
+// NewTracker creates the tracker object. It consumes the tablet health updates sent on ch, and reloads all
+// the tables every reloadInterval.
+func NewTracker(ch chan *discovery.TabletHealth, reloadInterval time.Duration) *Tracker {
+	return &Tracker{
+		ch:             ch,
+		reloadInterval: reloadInterval,
+		work:           make(chan struct{}, 1),
+		tables:         make(map[string]keyspaceTables),
+		masters:        make(map[string]*discovery.TabletHealth),
+		pending:        make(map[string]*keyspaceUpdate),
+	}
+}
+
+// Start starts consuming the health stream. The tables are loaded by a separate goroutine,
+// so that the health stream is never held up by the queries.
+func (t *Tracker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go func() {
+		for {
+			select {
+			case th := <-t.ch:
+				t.queueUpdate(th)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		ticker := time.NewTicker(t.reloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.work:
+			case <-ticker.C:
+				t.queueReload()
+			case <-ctx.Done():
+				return
+			}
+			if t.updateSchema(ctx) {
+				t.mu.Lock()
+				signal := t.signal
+				t.mu.Unlock()
+				if signal != nil {
+					signal()
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops consuming the health stream.
+func (t *Tracker) Stop() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// RegisterSignalReceiver registers the function to call whenever the tracked schema changes.
+func (t *Tracker) RegisterSignalReceiver(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// GetColumns returns the tracked columns of the given table, or nil if the table is unknown.
+func (t *Tracker) GetColumns(ks string, tbl string) []vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[ks][tbl]
+}
+
+// Tables returns a copy of the tracked tables of the given keyspace, along with their columns.
+func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tables := make(map[string][]vindexes.Column, len(t.tables[ks]))
+	for tbl, columns := range t.tables[ks] {
+		tables[tbl] = columns
+	}
+	return tables
+}
+
+// queueUpdate queues the reload of the tables that the health update of a serving master tablet reports
+// as changed, or of all the tables of its keyspace if they are not loaded yet.
+func (t *Tracker) queueUpdate(th *discovery.TabletHealth) {
+	if th.Target == nil || th.Target.TabletType != topodatapb.TabletType_MASTER || !th.Serving {
+		return
+	}
+	ks := th.Target.Keyspace
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.masters[ks] = th
+	_, loaded := t.tables[ks]
+	changed := th.Stats.GetTableSchemaChanged()
+	if loaded && len(changed) == 0 {
+		return
+	}
+	update := t.pendingUpdate(ks)
+	update.th = th
+	update.all = update.all || !loaded
+	for _, tbl := range changed {
+		update.changed[tbl] = true
+	}
+	t.notify()
+}
+
+// queueReload queues the reload of all the tables of the keyspaces that have a known master tablet.
+func (t *Tracker) queueReload() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for ks, th := range t.masters {
+		update := t.pendingUpdate(ks)
+		update.th = th
+		update.all = true
+	}
+}
+
+// pendingUpdate returns the pending update of the keyspace, creating it if needed. It is called with the lock held.
+func (t *Tracker) pendingUpdate(ks string) *keyspaceUpdate {
+	update, ok := t.pending[ks]
+	if !ok {
+		update = &keyspaceUpdate{changed: make(map[string]bool)}
+		t.pending[ks] = update
+	}
+	return update
+}
+
+// notify wakes up the goroutine that loads the tables, unless it's already due to wake up.
+func (t *Tracker) notify() {
+	select {
+	case t.work <- struct{}{}:
+	default:
+	}
+}
+
+// updateSchema runs the pending updates, and returns true if the tracked schema changed.
+func (t *Tracker) updateSchema(ctx context.Context) bool {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = make(map[string]*keyspaceUpdate)
+	t.mu.Unlock()
+
+	changed := false
+	for ks, update := range pending {
+		var tableNames []string
+		if !update.all {
+			for tbl := range update.changed {
+				tableNames = append(tableNames, tbl)
+			}
+			sort.Strings(tableNames)
+		}
+		columns, err := loadColumns(ctx, update.th, tableNames)
+		if err != nil {
+			// The tables get loaded again on the next reload
+			log.Warningf("Error loading the schema of keyspace %s from tablet %v: %v", ks, update.th.Tablet.GetAlias(), err)
+			continue
+		}
+		if t.applyUpdate(ks, tableNames, columns) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// applyUpdate saves the loaded tables of a keyspace, and returns true if they changed. All the tables
+// of the keyspace are replaced if no table names are given.
+func (t *Tracker) applyUpdate(ks string, tableNames []string, columns keyspaceTables) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tables, loaded := t.tables[ks]
+	if !loaded || len(tableNames) == 0 {
+		t.tables[ks] = columns
+		return !loaded || !tables.equal(columns)
+	}
+	changed := false
+	// Dropped tables are the changed tables which have no columns
+	for _, tbl := range tableNames {
+		if !equalColumns(tables[tbl], columns[tbl]) {
+			changed = true
+		}
+		delete(tables, tbl)
+	}
+	for tbl, cols := range columns {
+		tables[tbl] = cols
+	}
+	return changed
+}
+
+// equal returns true if both hold the same tables with the same columns
+func (kt keyspaceTables) equal(other keyspaceTables) bool {
+	if len(kt) != len(other) {
+		return false
+	}
+	for tbl, columns := range kt {
+		otherColumns, ok := other[tbl]
+		if !ok || !equalColumns(columns, otherColumns) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalColumns returns true if both list the same columns with the same types
+func equalColumns(a, b []vindexes.Column) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name.String() != b[i].Name.String() || a[i].Type != b[i].Type {
+			return false
+		}
+	}
+	return true
+}
+
+// loadColumns reads the columns of the given tables from the tablet, or those of all the tables if none are given.
+func loadColumns(ctx context.Context, th *discovery.TabletHealth, tableNames []string) (keyspaceTables, error) {
+	query := sqlSelectColumns
+	var bindVars map[string]*querypb.BindVariable
+	if len(tableNames) > 0 {
+		query = sqlSelectColumnsForTables
+		tableNamesBindVar, err := sqltypes.BuildBindVariable(tableNames)
+		if err != nil {
+			return nil, err
+		}
+		bindVars = map[string]*querypb.BindVariable{"tableNames": tableNamesBindVar}
+	}
+	qr, err := th.Conn.Execute(ctx, th.Target, query, bindVars, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(keyspaceTables)
+	for _, row := range qr.Rows {
+		tbl := row[0].ToString()
+		columnType := sqlparser.ColumnType{
+			Type:     row[2].ToString(),
+			Unsigned: strings.Contains(strings.ToLower(row[3].ToString()), "unsigned"),
+		}
+		tables[tbl] = append(tables[tbl], vindexes.Column{
+			Name: sqlparser.NewColIdent(row[1].ToString()),
+			Type: columnType.SQLType(),
+		})
+	}
+	return tables, nil
+}
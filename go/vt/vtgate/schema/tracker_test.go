@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var columnsFields = sqltypes.MakeTestFields("table_name|column_name|data_type|column_type", "varchar|varchar|varchar|varchar")
+
+func newTabletHealth(tabletType topodatapb.TabletType, conn *sandboxconn.SandboxConn, changed ...string) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Conn:    conn,
+		Tablet:  conn.Tablet(),
+		Target:  &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: tabletType},
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{TableSchemaChanged: changed},
+	}
+}
+
+func TestTrackerUpdateSchema(t *testing.T) {
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{Keyspace: "ks", Shard: "-80", Type: topodatapb.TabletType_MASTER}
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	tracker := NewTracker(nil, time.Minute)
+
+	// Replicas are ignored
+	tracker.queueUpdate(newTabletHealth(topodatapb.TabletType_REPLICA, sbc))
+	assert.False(t, tracker.updateSchema(ctx))
+	assert.Empty(t, sbc.Queries)
+
+	// The first time the keyspace is seen, all its tables are loaded
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(columnsFields,
+		"t1|id|int|int(11)",
+		"t1|name|varchar|varchar(64)",
+		"t2|id|bigint|bigint(20) unsigned",
+	)})
+	tracker.queueUpdate(newTabletHealth(topodatapb.TabletType_MASTER, sbc))
+	assert.True(t, tracker.updateSchema(ctx))
+	require.Len(t, sbc.Queries, 1)
+	assert.Equal(t, sqlSelectColumns, sbc.Queries[0].Sql)
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32},
+		{Name: sqlparser.NewColIdent("name"), Type: querypb.Type_VARCHAR},
+	}, tracker.GetColumns("ks", "t1"))
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_UINT64},
+	}, tracker.GetColumns("ks", "t2"))
+
+	// Once loaded, the keyspace is only reloaded when tables changed
+	tracker.queueUpdate(newTabletHealth(topodatapb.TabletType_MASTER, sbc))
+	assert.False(t, tracker.updateSchema(ctx))
+	assert.Len(t, sbc.Queries, 1)
+
+	// t1 is altered, t2 is dropped. The changes of consecutive updates are loaded together
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(columnsFields,
+		"t1|id|int|int(11)",
+	)})
+	tracker.queueUpdate(newTabletHealth(topodatapb.TabletType_MASTER, sbc, "t1"))
+	tracker.queueUpdate(newTabletHealth(topodatapb.TabletType_MASTER, sbc, "t2"))
+	assert.True(t, tracker.updateSchema(ctx))
+	require.Len(t, sbc.Queries, 2)
+	assert.Equal(t, sqlSelectColumnsForTables, sbc.Queries[1].Sql)
+	assert.Equal(t, map[string][]vindexes.Column{
+		"t1": {{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32}},
+	}, tracker.Tables("ks"))
+	assert.Empty(t, tracker.Tables("other"))
+
+	// The periodic reload loads all the tables, and only reports actual changes
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(columnsFields,
+		"t1|id|int|int(11)",
+	)})
+	tracker.queueReload()
+	assert.False(t, tracker.updateSchema(ctx))
+	require.Len(t, sbc.Queries, 3)
+	assert.Equal(t, sqlSelectColumns, sbc.Queries[2].Sql)
+
+	// A table created while a schema change signal was lost is found by the periodic reload
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(columnsFields,
+		"t1|id|int|int(11)",
+		"t3|id|int|int(11)",
+	)})
+	tracker.queueReload()
+	assert.True(t, tracker.updateSchema(ctx))
+	assert.Len(t, tracker.GetColumns("ks", "t3"), 1)
+}
+
+func TestTrackerSignal(t *testing.T) {
+	tablet := &topodatapb.Tablet{Keyspace: "ks", Shard: "-80", Type: topodatapb.TabletType_MASTER}
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(columnsFields, "t1|id|int|int(11)")})
+
+	ch := make(chan *discovery.TabletHealth)
+	tracker := NewTracker(ch, time.Minute)
+	signaled := make(chan struct{}, 1)
+	tracker.RegisterSignalReceiver(func() {
+		signaled <- struct{}{}
+	})
+	tracker.Start()
+	defer tracker.Stop()
+
+	ch <- newTabletHealth(topodatapb.TabletType_MASTER, sbc)
+	select {
+	case <-signaled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tracker did not signal the schema change")
+	}
+	assert.Len(t, tracker.GetColumns("ks", "t1"), 1)
+}
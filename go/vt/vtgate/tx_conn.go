@@ -26,15 +26,26 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/dtids"
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+var (
+	// twopcCommits counts the 2PC commits by the phase they ended in:
+	// "Success", or the phase that failed.
+	twopcCommits = stats.NewCountersWithSingleLabel("TwopcCommits", "2PC commits by outcome", "Phase")
+
+	// twopcResolutions counts the resolved distributed transactions by decision.
+	twopcResolutions = stats.NewCountersWithSingleLabel("TwopcResolutions", "Resolved distributed transactions by decision", "Decision")
+)
+
 // TxConn is used for executing transactional requests.
 type TxConn struct {
 	gateway Gateway
@@ -135,7 +146,7 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 }
 
 // commit2PC will not used the pinned tablets - to make sure we use the current source, we need to use the gateway's queryservice
-func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
+func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) (err error) {
 	if len(session.PreSessions) != 0 || len(session.PostSessions) != 0 {
 		_ = txc.Rollback(ctx, session)
 		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "pre or post actions not allowed for 2PC commits")
@@ -146,19 +157,29 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return txc.commitNormal(ctx, session)
 	}
 
+	phase := "CreateTransaction"
+	defer func() {
+		if err != nil {
+			twopcCommits.Add(phase, 1)
+			return
+		}
+		twopcCommits.Add("Success", 1)
+	}()
+
 	participants := make([]*querypb.Target, 0, len(session.ShardSessions)-1)
 	for _, s := range session.ShardSessions[1:] {
 		participants = append(participants, s.Target)
 	}
 	mmShard := session.ShardSessions[0]
 	dtid := dtids.New(mmShard)
-	err := txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
+	err = txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
 	if err != nil {
 		// Normal rollback is safe because nothing was prepared yet.
 		_ = txc.Rollback(ctx, session)
 		return err
 	}
 
+	phase = "Prepare"
 	err = txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
 		return txc.gateway.Prepare(ctx, s.Target, s.TransactionId, dtid)
 	})
@@ -172,11 +193,13 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
+	phase = "StartCommit"
 	err = txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
 	if err != nil {
 		return err
 	}
 
+	phase = "CommitPrepared"
 	err = txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
 		return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
 	})
@@ -184,6 +207,7 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
+	phase = "ConcludeTransaction"
 	return txc.gateway.ConcludeTransaction(ctx, mmShard.Target, dtid)
 }
 
@@ -194,10 +218,77 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	}
 	defer session.ResetTx()
 
+	err := txc.rollbackShards(ctx, session)
+	if err != nil {
+		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("rollback encountered an error and connection to all shard for this session is released: %v", err)})
+		if session.InReservedConn() {
+			_ = txc.Release(ctx, session)
+		}
+	}
+	return err
+}
+
+// Savepoint executes a SAVEPOINT, ROLLBACK TO SAVEPOINT or RELEASE SAVEPOINT
+// statement on all the shards participating in the transaction, including
+// the ones used for vindex lookups. Shards joining the transaction later
+// replay the savepoints of the session when they begin.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, query string) error {
+	if !session.InTransaction() {
+		return nil
+	}
+	var allsessions []*vtgatepb.Session_ShardSession
+	allsessions = append(allsessions, session.PreSessions...)
+	allsessions = append(allsessions, session.ShardSessions...)
+	allsessions = append(allsessions, session.PostSessions...)
+	return txc.ExecuteShardSessions(ctx, session, allsessions, query)
+}
+
+// ExecuteShardSessions executes the query in the transaction of each of
+// the shard sessions.
+func (txc *TxConn) ExecuteShardSessions(ctx context.Context, session *SafeSession, shardSessions []*vtgatepb.Session_ShardSession, query string) error {
+	if len(shardSessions) == 0 {
+		return nil
+	}
+	return txc.runSessions(ctx, shardSessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		if s.TransactionId == 0 {
+			return nil
+		}
+		qs, err := txc.queryService(s.TabletAlias)
+		if err != nil {
+			return err
+		}
+		_, err = qs.Execute(ctx, s.Target, query, nil, s.TransactionId, s.ReservedId, session.Options)
+		return err
+	})
+}
+
+// RollbackStmt undoes the statement being executed, while the session
+// stays in the transaction: the shards in savepointShards are rolled back
+// to the statement savepoint, and the ones in joinedShards, which joined
+// the transaction during the statement, are rolled back entirely.
+func (txc *TxConn) RollbackStmt(ctx context.Context, session *SafeSession, savepointShards, joinedShards []*vtgatepb.Session_ShardSession) error {
+	query := sqlparser.String(&sqlparser.SRollback{Name: sqlparser.NewColIdent(stmtSavepointName)})
+	if err := txc.ExecuteShardSessions(ctx, session, savepointShards, query); err != nil {
+		return err
+	}
+	if err := txc.rollbackShardSessions(ctx, joinedShards); err != nil {
+		return err
+	}
+	session.RemoveRolledBackShards()
+	return nil
+}
+
+func (txc *TxConn) rollbackShards(ctx context.Context, session *SafeSession) error {
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
+	return txc.rollbackShardSessions(ctx, allsessions)
+}
 
-	err := txc.runSessions(ctx, allsessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+func (txc *TxConn) rollbackShardSessions(ctx context.Context, shardSessions []*vtgatepb.Session_ShardSession) error {
+	if len(shardSessions) == 0 {
+		return nil
+	}
+	return txc.runSessions(ctx, shardSessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
 		if s.TransactionId == 0 {
 			return nil
 		}
@@ -213,13 +304,6 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 		s.ReservedId = reservedID
 		return nil
 	})
-	if err != nil {
-		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("rollback encountered an error and connection to all shard for this session is released: %v", err)})
-		if session.InReservedConn() {
-			_ = txc.Release(ctx, session)
-		}
-	}
-	return err
 }
 
 //Release releases the reserved connection and/or rollbacks the transaction
@@ -336,10 +420,12 @@ func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 		if err := txc.resumeRollback(ctx, mmShard.Target, transaction); err != nil {
 			return err
 		}
+		twopcResolutions.Add("Rollback", 1)
 	case querypb.TransactionState_COMMIT:
 		if err := txc.resumeCommit(ctx, mmShard.Target, transaction); err != nil {
 			return err
 		}
+		twopcResolutions.Add("Commit", 1)
 	default:
 		// Should never happen.
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state: %v", transaction.State)
@@ -723,6 +723,7 @@ func TestTxConnCommit2PC(t *testing.T) {
 	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
 	sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
 	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
+	successes := twopcCommits.Counts()["Success"]
 	require.NoError(t,
 		sc.txConn.Commit(ctx, session))
 	assert.EqualValues(t, 1, sbc0.CreateTransactionCount.Get(), "sbc0.CreateTransactionCount")
@@ -730,6 +731,7 @@ func TestTxConnCommit2PC(t *testing.T) {
 	assert.EqualValues(t, 1, sbc0.StartCommitCount.Get(), "sbc0.StartCommitCount")
 	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+	assert.EqualValues(t, successes+1, twopcCommits.Counts()["Success"], "twopcCommits[Success]")
 }
 
 func TestTxConnCommit2PCOneParticipant(t *testing.T) {
@@ -773,6 +775,7 @@ func TestTxConnCommit2PCPrepareFail(t *testing.T) {
 
 	sbc1.MustFailPrepare = 1
 	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
+	prepareFailures := twopcCommits.Counts()["Prepare"]
 	err := sc.txConn.Commit(ctx, session)
 	want := "error: err"
 	require.Error(t, err)
@@ -782,6 +785,7 @@ func TestTxConnCommit2PCPrepareFail(t *testing.T) {
 	assert.EqualValues(t, 0, sbc0.StartCommitCount.Get(), "sbc0.StartCommitCount")
 	assert.EqualValues(t, 0, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 0, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+	assert.EqualValues(t, prepareFailures+1, twopcCommits.Counts()["Prepare"], "twopcCommits[Prepare]")
 }
 
 func TestTxConnCommit2PCStartCommitFail(t *testing.T) {
@@ -971,12 +975,14 @@ func TestTxConnResolveOnCommit(t *testing.T) {
 			TabletType: topodatapb.TabletType_MASTER,
 		}},
 	}}
+	commits := twopcResolutions.Counts()["Commit"]
 	require.NoError(t,
 		sc.txConn.Resolve(ctx, dtid))
 	assert.EqualValues(t, 0, sbc0.SetRollbackCount.Get(), "sbc0.SetRollbackCount")
 	assert.EqualValues(t, 0, sbc1.RollbackPreparedCount.Get(), "sbc1.RollbackPreparedCount")
 	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+	assert.EqualValues(t, commits+1, twopcResolutions.Counts()["Commit"], "twopcResolutions[Commit]")
 }
 
 func TestTxConnResolveInvalidDTID(t *testing.T) {
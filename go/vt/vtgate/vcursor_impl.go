@@ -72,6 +72,7 @@ type iExecute interface {
 	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
+	setStmtSavepoint(ctx context.Context, safeSession *SafeSession, rss []*srvtopo.ResolvedShard, marginComments sqlparser.MarginComments) error
 	ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error
 	ExecuteVStream(ctx context.Context, rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
 
@@ -384,6 +385,10 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 		// For autocommit, we have to create an independent session.
 		session = NewAutocommitSession(vc.safeSession.Session)
 	} else {
+		if rollbackOnError {
+			session.StartVindexWrite()
+			defer session.EndVindexWrite()
+		}
 		session.SetCommitOrder(co)
 		defer session.SetCommitOrder(vtgatepb.CommitOrder_NORMAL)
 	}
@@ -398,11 +403,23 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 // ExecuteMultiShard is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(queries)))
+	// A DML on several shards can fail on some of them only, and a vindex
+	// write is followed by the write of the statement itself.
+	protect := rollbackOnError && (len(rss) > 1 || vc.safeSession.InVindexWrite())
+	if protect {
+		if err := vc.executor.setStmtSavepoint(vc.ctx, vc.safeSession, rss, vc.marginComments); err != nil {
+			return nil, []error{err}
+		}
+	}
 	qr, errs := vc.executor.ExecuteMultiShard(vc.ctx, rss, commentedShardQueries(queries, vc.marginComments), vc.safeSession, autocommit, vc.ignoreMaxMemoryRows)
 
-	if errs == nil && rollbackOnError {
+	// The statement is partially executed if it succeeded on any shard.
+	if rollbackOnError && len(errs) < len(rss) {
 		vc.rollbackOnPartialExec = true
 	}
+	if rollbackOnError && !protect && len(errs) == 0 {
+		vc.safeSession.RecordStmtWrite(rss)
+	}
 	return qr, errs
 }
 
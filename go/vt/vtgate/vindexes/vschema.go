@@ -17,11 +17,13 @@ limitations under the License.
 package vindexes
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"sort"
+	"strings"
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
@@ -49,6 +51,14 @@ var TabletTypeSuffix = map[topodatapb.TabletType]string{
 const (
 	TypeSequence  = "sequence"
 	TypeReference = "reference"
+	TypeView      = "view"
+)
+
+// The following constants represent the referential actions of foreign keys.
+const (
+	FKRestrict = "restrict"
+	FKNoAction = "no action"
+	FKCascade  = "cascade"
 )
 
 // VSchema represents the denormalized version of SrvVSchema,
@@ -58,6 +68,8 @@ type VSchema struct {
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+	// addedTables holds the names of the unique tables which AddTable added
+	addedTables map[string]bool
 }
 
 // RoutingRule represents one routing rule.
@@ -90,6 +102,99 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+
+	// ViewDefinition is the SELECT statement that defines the view, set only for tables of type view.
+	// The planner inlines it as a derived table wherever the view is referenced.
+	ViewDefinition sqlparser.SelectStatement `json:"-"`
+
+	// ForeignKeys are the foreign keys of the table, which reference parent tables.
+	ForeignKeys []*ForeignKey `json:"foreign_keys,omitempty"`
+	// ChildForeignKeys are the foreign keys of other tables which reference the table.
+	ChildForeignKeys []*ForeignKey `json:"-"`
+}
+
+// ForeignKey represents a foreign key of a child table, which references a parent table of the same keyspace.
+type ForeignKey struct {
+	Name          string
+	Table         *Table
+	Columns       []sqlparser.ColIdent
+	ParentTable   *Table
+	ParentColumns []sqlparser.ColIdent
+	OnDelete      string
+	OnUpdate      string
+	// ShardLocal is true if the child rows always live in the shard of the parent row they reference.
+	// MySQL then enforces the foreign key by itself. Otherwise, vtgate enforces it.
+	ShardLocal bool
+}
+
+// MarshalJSON returns a JSON representation of ForeignKey.
+func (fk *ForeignKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name          string               `json:"name,omitempty"`
+		Columns       []sqlparser.ColIdent `json:"columns"`
+		ParentTable   string               `json:"parent_table"`
+		ParentColumns []sqlparser.ColIdent `json:"parent_columns"`
+		OnDelete      string               `json:"on_delete"`
+		OnUpdate      string               `json:"on_update"`
+		ShardLocal    bool                 `json:"shard_local,omitempty"`
+	}{
+		Name:          fk.Name,
+		Columns:       fk.Columns,
+		ParentTable:   fk.ParentTable.Name.String(),
+		ParentColumns: fk.ParentColumns,
+		OnDelete:      fk.OnDelete,
+		OnUpdate:      fk.OnUpdate,
+		ShardLocal:    fk.ShardLocal,
+	})
+}
+
+// String returns the name of the foreign key, or a description of it if it has no name.
+func (fk *ForeignKey) String() string {
+	if fk.Name != "" {
+		return fk.Name
+	}
+	return fmt.Sprintf("%s(%s) references %s(%s)", fk.Table.Name.String(), joinColumns(fk.Columns), fk.ParentTable.Name.String(), joinColumns(fk.ParentColumns))
+}
+
+// isShardLocal returns true if the child rows of the foreign key always live in the shard of their parent row.
+// This is the case if the keyspace is unsharded, if the parent table is a reference table, which has all its rows
+// in every shard, if both tables are pinned to the same keyspace id, or if the child table and the parent table
+// share their primary vindex, on columns that the foreign key maps to each other.
+func (fk *ForeignKey) isShardLocal() bool {
+	if !fk.Table.Keyspace.Sharded || fk.ParentTable.Type == TypeReference {
+		return true
+	}
+	if fk.Table.Pinned != nil || fk.ParentTable.Pinned != nil {
+		return bytes.Equal(fk.Table.Pinned, fk.ParentTable.Pinned)
+	}
+	if len(fk.Table.ColumnVindexes) == 0 || len(fk.ParentTable.ColumnVindexes) == 0 {
+		return false
+	}
+	childVindex, parentVindex := fk.Table.ColumnVindexes[0], fk.ParentTable.ColumnVindexes[0]
+	if childVindex.Name != parentVindex.Name || len(childVindex.Columns) != len(parentVindex.Columns) {
+		return false
+	}
+	for i, col := range childVindex.Columns {
+		referenced := false
+		for j, fkCol := range fk.Columns {
+			if fkCol.Equal(col) && fk.ParentColumns[j].Equal(parentVindex.Columns[i]) {
+				referenced = true
+				break
+			}
+		}
+		if !referenced {
+			return false
+		}
+	}
+	return true
+}
+
+func joinColumns(cols []sqlparser.ColIdent) string {
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.String())
+	}
+	return strings.Join(names, ", ")
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -249,6 +354,20 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 				return fmt.Errorf("sequence table has to be in an unsharded keyspace or must be pinned: %s", tname)
 			}
 			t.Type = table.Type
+		case TypeView:
+			if table.Pinned != "" || len(table.ColumnVindexes) > 0 || table.AutoIncrement != nil {
+				return fmt.Errorf("view cannot have vindexes, auto increment or pinning: %s", tname)
+			}
+			stmt, err := sqlparser.Parse(table.ViewDefinition)
+			if err != nil {
+				return fmt.Errorf("could not parse the definition of view %s: %v", tname, err)
+			}
+			viewDefinition, ok := stmt.(sqlparser.SelectStatement)
+			if !ok {
+				return fmt.Errorf("definition of view %s is not a select statement: %s", tname, table.ViewDefinition)
+			}
+			t.Type = table.Type
+			t.ViewDefinition = viewDefinition
 		default:
 			return fmt.Errorf("unidentified table type %s", table.Type)
 		}
@@ -260,8 +379,8 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Pinned = decoded
 		}
 
-		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
-		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
+		// If keyspace is sharded, then any table that's not a reference, a view or pinned must have vindexes.
+		if keyspace.Sharded && t.Type != TypeReference && t.Type != TypeView && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("missing primary col vindex for table: %s", tname)
 		}
 
@@ -340,9 +459,68 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		}
 		ksvschema.Tables[tname] = t
 	}
+	return resolveForeignKeys(ks, ksvschema)
+}
+
+// resolveForeignKeys links the foreign keys of the tables of a keyspace to their parent tables, which must belong
+// to the same keyspace. The tables are visited in order of their names, so that the foreign keys referencing
+// a table are always listed in the same order.
+func resolveForeignKeys(ks *vschemapb.Keyspace, ksvschema *KeyspaceSchema) error {
+	tnames := make([]string, 0, len(ks.Tables))
+	for tname := range ks.Tables {
+		tnames = append(tnames, tname)
+	}
+	sort.Strings(tnames)
+	for _, tname := range tnames {
+		t := ksvschema.Tables[tname]
+		for _, fkInfo := range ks.Tables[tname].ForeignKeys {
+			parent := ksvschema.Tables[fkInfo.ParentTable]
+			if parent == nil {
+				return fmt.Errorf("parent table %s of a foreign key of table %s not found in keyspace %s", fkInfo.ParentTable, tname, ksvschema.Keyspace.Name)
+			}
+			if (t.Type != "" && t.Type != TypeReference) || (parent.Type != "" && parent.Type != TypeReference) {
+				return fmt.Errorf("foreign keys are only supported between regular and reference tables: %s references %s", tname, fkInfo.ParentTable)
+			}
+			if len(fkInfo.Columns) == 0 || len(fkInfo.Columns) != len(fkInfo.ParentColumns) {
+				return fmt.Errorf("foreign key of table %s must reference as many columns of table %s as it has columns", tname, fkInfo.ParentTable)
+			}
+			fk := &ForeignKey{
+				Name:        fkInfo.Name,
+				Table:       t,
+				ParentTable: parent,
+			}
+			for i := range fkInfo.Columns {
+				fk.Columns = append(fk.Columns, sqlparser.NewColIdent(fkInfo.Columns[i]))
+				fk.ParentColumns = append(fk.ParentColumns, sqlparser.NewColIdent(fkInfo.ParentColumns[i]))
+			}
+			var err error
+			if fk.OnDelete, err = foreignKeyAction(fkInfo.OnDelete); err != nil {
+				return fmt.Errorf("invalid on_delete of foreign key %v: %v", fk, err)
+			}
+			if fk.OnUpdate, err = foreignKeyAction(fkInfo.OnUpdate); err != nil {
+				return fmt.Errorf("invalid on_update of foreign key %v: %v", fk, err)
+			}
+			fk.ShardLocal = fk.isShardLocal()
+			t.ForeignKeys = append(t.ForeignKeys, fk)
+			parent.ChildForeignKeys = append(parent.ChildForeignKeys, fk)
+		}
+	}
 	return nil
 }
 
+// foreignKeyAction normalizes the referential action of a foreign key, which defaults to restrict.
+func foreignKeyAction(action string) (string, error) {
+	switch action = strings.ToLower(strings.TrimSpace(action)); action {
+	case "":
+		return FKRestrict, nil
+	case FKRestrict, FKNoAction, FKCascade:
+		return action, nil
+	case "set null", "set default":
+		return "", fmt.Errorf("unsupported referential action: %s", action)
+	}
+	return "", fmt.Errorf("unknown referential action: %s", action)
+}
+
 func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	for ksname, ks := range source.Keyspaces {
 		ksvschema := vschema.Keyspaces[ksname]
@@ -493,6 +671,33 @@ func (vschema *VSchema) findTable(keyspace, tablename string) (*Table, error) {
 	return table, nil
 }
 
+// AddTable adds a table which the vschema does not define to an unsharded keyspace, such as a table
+// found by schema tracking. Tables of sharded keyspaces need a vindex, so they can't be added.
+// An added table doesn't shadow a table of the same name which the vschema defines: it is then only
+// found qualified by its keyspace.
+func (vschema *VSchema) AddTable(ksName string, table *Table) {
+	ks, ok := vschema.Keyspaces[ksName]
+	if !ok || ks.Keyspace.Sharded {
+		return
+	}
+	tname := table.Name.String()
+	if _, ok := ks.Tables[tname]; ok {
+		return
+	}
+	table.Keyspace = ks.Keyspace
+	ks.Tables[tname] = table
+	if _, ok := vschema.uniqueTables[tname]; !ok {
+		if vschema.addedTables == nil {
+			vschema.addedTables = make(map[string]bool)
+		}
+		vschema.addedTables[tname] = true
+		vschema.uniqueTables[tname] = table
+	} else if vschema.addedTables[tname] {
+		// Added to more than one keyspace
+		vschema.uniqueTables[tname] = nil
+	}
+}
+
 // FindRoutedTable finds a table checking the routing rules.
 func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType topodatapb.TabletType) (*Table, error) {
 	qualified := tablename
@@ -2114,6 +2114,196 @@ func TestBadShardedSequence(t *testing.T) {
 	}
 }
 
+func TestShardedView(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"v1": {
+						Type:           "view",
+						ViewDefinition: "select id, val from t1 where val > 0",
+					},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	v1 := got.Keyspaces["sharded"].Tables["v1"]
+	assert.Equal(t, TypeView, v1.Type)
+	assert.Equal(t, "select id, val from t1 where val > 0", sqlparser.String(v1.ViewDefinition))
+}
+
+func TestBadView(t *testing.T) {
+	testcases := []struct {
+		table *vschemapb.Table
+		err   string
+	}{{
+		table: &vschemapb.Table{Type: "view", ViewDefinition: "insert into t1 values (1)"},
+		err:   "definition of view v1 is not a select statement: insert into t1 values (1)",
+	}, {
+		table: &vschemapb.Table{Type: "view", ViewDefinition: "select 1 from dual", ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+		err:   "view cannot have vindexes, auto increment or pinning: v1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err, func(t *testing.T) {
+			bad := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"sharded": {
+						Sharded: true,
+						Tables: map[string]*vschemapb.Table{
+							"v1": tcase.table,
+						},
+					},
+				},
+			}
+			got, _ := BuildVSchema(&bad)
+			assert.EqualError(t, got.Keyspaces["sharded"].Error, tcase.err)
+		})
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"customer": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+					},
+					"corder": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "customer_id", Name: "hash"}},
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Name:          "fk_corder_customer",
+							Columns:       []string{"customer_id"},
+							ParentTable:   "customer",
+							ParentColumns: []string{"id"},
+							OnDelete:      "CASCADE",
+						}},
+					},
+					"review": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Columns:       []string{"customer_id"},
+							ParentTable:   "customer",
+							ParentColumns: []string{"id"},
+							OnUpdate:      "no action",
+						}},
+					},
+					"country": {
+						Type: "reference",
+					},
+					"address": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Columns:       []string{"country_id"},
+							ParentTable:   "country",
+							ParentColumns: []string{"id"},
+						}},
+					},
+				},
+			},
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"p": {},
+					"c": {
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Columns:       []string{"p_id"},
+							ParentTable:   "p",
+							ParentColumns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	got, err := BuildVSchema(&good)
+	require.NoError(t, err)
+	ks := got.Keyspaces["sharded"]
+	require.NoError(t, ks.Error)
+
+	// The child rows of corder are in the shard of their customer, but not those of review.
+	customer, corder, review := ks.Tables["customer"], ks.Tables["corder"], ks.Tables["review"]
+	require.Len(t, corder.ForeignKeys, 1)
+	fk := corder.ForeignKeys[0]
+	assert.Equal(t, customer, fk.ParentTable)
+	assert.Equal(t, FKCascade, fk.OnDelete)
+	assert.Equal(t, FKRestrict, fk.OnUpdate)
+	assert.True(t, fk.ShardLocal)
+	require.Len(t, review.ForeignKeys, 1)
+	assert.Equal(t, FKRestrict, review.ForeignKeys[0].OnDelete)
+	assert.Equal(t, FKNoAction, review.ForeignKeys[0].OnUpdate)
+	assert.False(t, review.ForeignKeys[0].ShardLocal)
+	assert.Equal(t, "review(customer_id) references customer(id)", review.ForeignKeys[0].String())
+	assert.Equal(t, []*ForeignKey{fk, review.ForeignKeys[0]}, customer.ChildForeignKeys)
+
+	// Reference tables have all their rows in every shard.
+	address := ks.Tables["address"]
+	require.Len(t, address.ForeignKeys, 1)
+	assert.True(t, address.ForeignKeys[0].ShardLocal)
+
+	// Foreign keys of unsharded keyspaces are always shard local.
+	c := got.Keyspaces["unsharded"].Tables["c"]
+	require.Len(t, c.ForeignKeys, 1)
+	assert.True(t, c.ForeignKeys[0].ShardLocal)
+}
+
+func TestBadForeignKeys(t *testing.T) {
+	testcases := []struct {
+		fk  *vschemapb.ForeignKey
+		err string
+	}{{
+		fk:  &vschemapb.ForeignKey{Columns: []string{"p_id"}, ParentTable: "other", ParentColumns: []string{"id"}},
+		err: "parent table other of a foreign key of table c not found in keyspace sharded",
+	}, {
+		fk:  &vschemapb.ForeignKey{Columns: []string{"p_id", "p_name"}, ParentTable: "p", ParentColumns: []string{"id"}},
+		err: "foreign key of table c must reference as many columns of table p as it has columns",
+	}, {
+		fk:  &vschemapb.ForeignKey{Columns: []string{"p_id"}, ParentTable: "p", ParentColumns: []string{"id"}, OnDelete: "set null"},
+		err: "invalid on_delete of foreign key c(p_id) references p(id): unsupported referential action: set null",
+	}, {
+		fk:  &vschemapb.ForeignKey{Name: "fk", Columns: []string{"p_id"}, ParentTable: "p", ParentColumns: []string{"id"}, OnUpdate: "nullify"},
+		err: "invalid on_update of foreign key fk: unknown referential action: nullify",
+	}, {
+		fk:  &vschemapb.ForeignKey{Columns: []string{"p_id"}, ParentTable: "v", ParentColumns: []string{"id"}},
+		err: "foreign keys are only supported between regular and reference tables: c references v",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err, func(t *testing.T) {
+			bad := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"sharded": {
+						Sharded: true,
+						Vindexes: map[string]*vschemapb.Vindex{
+							"hash": {Type: "hash"},
+						},
+						Tables: map[string]*vschemapb.Table{
+							"p": {
+								ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+							},
+							"v": {
+								Type:           "view",
+								ViewDefinition: "select id from p",
+							},
+							"c": {
+								ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+								ForeignKeys:    []*vschemapb.ForeignKey{tcase.fk},
+							},
+						},
+					},
+				},
+			}
+			got, _ := BuildVSchema(&bad)
+			assert.EqualError(t, got.Keyspaces["sharded"].Error, tcase.err)
+		})
+	}
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -38,6 +39,15 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	schema            SchemaInfo
+	// buildMu serializes the builds of the vschema by the SrvVSchema watch and by Rebuild,
+	// so that a vschema built from an older SrvVSchema never replaces a newer one
+	buildMu sync.Mutex
+}
+
+// SchemaInfo is an interface to the schema tracker.
+type SchemaInfo interface {
+	Tables(ks string) map[string][]vindexes.Column
 }
 
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
@@ -72,6 +82,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		// to use the previous value if it was set, or an
 		// empty vschema if it wasn't.
 		log.Infof("Received vschema update")
+		vm.buildMu.Lock()
+		defer vm.buildMu.Unlock()
 		switch {
 		case err == nil:
 			// Good case, we can try to save that value.
@@ -102,6 +114,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 				if vschemaCounters != nil {
 					vschemaCounters.Add("Parsing", 1)
 				}
+			} else {
+				vm.updateFromSchema(vschema)
 			}
 		}
 		if v == nil {
@@ -162,3 +176,53 @@ func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vsch
 
 	return err
 }
+
+// Rebuild rebuilds the vschema from the latest SrvVSchema and the tracked schema, and saves it.
+// It is called by the schema tracker whenever the tracked schema changes. Saving the vschema
+// clears the plan cache, so that queries are planned against the new schema.
+func (vm *VSchemaManager) Rebuild() {
+	vm.buildMu.Lock()
+	defer vm.buildMu.Unlock()
+	vm.mu.Lock()
+	v := vm.currentSrvVschema
+	vm.mu.Unlock()
+	if v == nil {
+		return
+	}
+
+	vschema, err := vindexes.BuildVSchema(v)
+	if err != nil {
+		log.Warningf("Error creating VSchema from the tracked schema: %v", err)
+		return
+	}
+	vm.updateFromSchema(vschema)
+	vm.e.SaveVSchema(vschema, NewVSchemaStats(vschema, ""))
+	log.Infof("Rebuilt VSchema with the tracked schema")
+}
+
+// updateFromSchema merges the tracked schema into the vschema. The columns of a table become authoritative,
+// unless the vschema already lists them authoritatively. Tables missing from the vschema are added to
+// unsharded keyspaces.
+func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
+	if vm.schema == nil {
+		return
+	}
+	for ksName, ks := range vschema.Keyspaces {
+		for tblName, columns := range vm.schema.Tables(ksName) {
+			vTbl := ks.Tables[tblName]
+			if vTbl == nil {
+				vschema.AddTable(ksName, &vindexes.Table{
+					Name:                    sqlparser.NewTableIdent(tblName),
+					Columns:                 columns,
+					ColumnListAuthoritative: true,
+				})
+				continue
+			}
+			if vTbl.ColumnListAuthoritative {
+				continue
+			}
+			vTbl.Columns = columns
+			vTbl.ColumnListAuthoritative = true
+		}
+	}
+}
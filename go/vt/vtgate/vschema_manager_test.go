@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+type fakeSchemaInfo map[string]map[string][]vindexes.Column
+
+func (f fakeSchemaInfo) Tables(ks string) map[string][]vindexes.Column {
+	return f[ks]
+}
+
+func TestVSchemaUpdateFromSchema(t *testing.T) {
+	cols := []vindexes.Column{{
+		Name: sqlparser.NewColIdent("id"),
+		Type: querypb.Type_INT64,
+	}, {
+		Name: sqlparser.NewColIdent("name"),
+		Type: querypb.Type_VARCHAR,
+	}}
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+					},
+					"t2": {
+						ColumnVindexes:          []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+						Columns:                 []*vschemapb.Column{{Name: "id", Type: querypb.Type_INT64}},
+						ColumnListAuthoritative: true,
+					},
+				},
+			},
+			"uks": {},
+		},
+	}
+	vschema, err := vindexes.BuildVSchema(srvVSchema)
+	require.NoError(t, err)
+
+	vm := &VSchemaManager{schema: fakeSchemaInfo{
+		"ks": {
+			"t1": cols,
+			"t2": cols,
+			// tables of sharded keyspaces need a vindex, so t3 can't be added
+			"t3": cols,
+		},
+		"uks": {
+			"u1": cols,
+		},
+	}}
+	vm.updateFromSchema(vschema)
+
+	ks := vschema.Keyspaces["ks"]
+	assert.Equal(t, cols, ks.Tables["t1"].Columns)
+	assert.True(t, ks.Tables["t1"].ColumnListAuthoritative)
+	// authoritative columns of the vschema are kept
+	assert.Len(t, ks.Tables["t2"].Columns, 1)
+	assert.NotContains(t, ks.Tables, "t3")
+
+	u1, err := vschema.FindTable("", "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "uks", u1.Keyspace.Name)
+	assert.Equal(t, cols, u1.Columns)
+	assert.True(t, u1.ColumnListAuthoritative)
+}
+
+func TestVSchemaUpdateFromSchemaUniqueTables(t *testing.T) {
+	cols := []vindexes.Column{{
+		Name: sqlparser.NewColIdent("id"),
+		Type: querypb.Type_INT64,
+	}}
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+					},
+				},
+			},
+			"uks1": {},
+			"uks2": {},
+		},
+	}
+	vschema, err := vindexes.BuildVSchema(srvVSchema)
+	require.NoError(t, err)
+
+	vm := &VSchemaManager{schema: fakeSchemaInfo{
+		"ks": {
+			"t1": cols,
+		},
+		"uks1": {
+			"t1": cols,
+			"u1": cols,
+		},
+		"uks2": {
+			"u1": cols,
+		},
+	}}
+	vm.updateFromSchema(vschema)
+
+	// a tracked table doesn't shadow the table the vschema defines
+	t1, err := vschema.FindTable("", "t1")
+	require.NoError(t, err)
+	assert.Equal(t, "ks", t1.Keyspace.Name)
+	t1, err = vschema.FindTable("uks1", "t1")
+	require.NoError(t, err)
+	assert.Equal(t, "uks1", t1.Keyspace.Name)
+	assert.True(t, t1.ColumnListAuthoritative)
+
+	// a table tracked in two keyspaces must be qualified
+	_, err = vschema.FindTable("", "u1")
+	assert.EqualError(t, err, "ambiguous table reference: u1")
+	u1, err := vschema.FindTable("uks2", "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "uks2", u1.Keyspace.Name)
+}
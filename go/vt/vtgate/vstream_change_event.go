@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The ops of a change event. They're the same as Debezium's.
+const (
+	changeOpCreate = "c"
+	changeOpUpdate = "u"
+	changeOpDelete = "d"
+	changeOpRead   = "r"
+)
+
+// changeEventer converts the FIELD and ROW events of one shard stream
+// into self-describing CHANGE events. The fields of a table are sent
+// only once by vstreamer, so they're remembered for the subsequent rows.
+// The gtid of a transaction is known only at its end: the CHANGE events
+// are completed when the transaction is sent.
+type changeEventer struct {
+	keyspace string
+	shard    string
+	fields   map[string][]*querypb.Field
+	pending  []*pendingChange
+}
+
+// pendingChange is a CHANGE event that waits for the gtid of its transaction.
+type pendingChange struct {
+	event     *binlogdatapb.VEvent
+	table     string
+	fields    []*querypb.Field
+	rowChange *binlogdatapb.RowChange
+}
+
+func newChangeEventer(keyspace, shard string) *changeEventer {
+	return &changeEventer{
+		keyspace: keyspace,
+		shard:    shard,
+		fields:   make(map[string][]*querypb.Field),
+	}
+}
+
+func (ce *changeEventer) setFields(fieldEvent *binlogdatapb.FieldEvent) {
+	ce.fields[fieldEvent.TableName] = fieldEvent.Fields
+}
+
+// changeEvents returns one CHANGE event for each row change of a ROW event.
+func (ce *changeEventer) changeEvents(event *binlogdatapb.VEvent) ([]*binlogdatapb.VEvent, error) {
+	fields, ok := ce.fields[event.RowEvent.TableName]
+	if !ok {
+		return nil, fmt.Errorf("no fields received for table %s in %s/%s", event.RowEvent.TableName, ce.keyspace, ce.shard)
+	}
+	events := make([]*binlogdatapb.VEvent, 0, len(event.RowEvent.RowChanges))
+	for _, rowChange := range event.RowEvent.RowChanges {
+		ev := &binlogdatapb.VEvent{
+			Type:        binlogdatapb.VEventType_CHANGE,
+			Timestamp:   event.Timestamp,
+			CurrentTime: event.CurrentTime,
+		}
+		ce.pending = append(ce.pending, &pendingChange{
+			event:     ev,
+			table:     event.RowEvent.TableName,
+			fields:    fields,
+			rowChange: rowChange,
+		})
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// complete encodes the pending CHANGE events of a transaction. The gtid
+// is taken from the GTID event of the transaction. If there's none, the
+// transaction is part of the copy phase: the rows are snapshot reads at
+// the current position of the stream.
+func (ce *changeEventer) complete(eventss [][]*binlogdatapb.VEvent, pos string) {
+	if len(ce.pending) == 0 {
+		return
+	}
+	gtid, snapshot := pos, false
+	for _, events := range eventss {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_GTID:
+				gtid = event.Gtid
+			case binlogdatapb.VEventType_LASTPK:
+				snapshot = true
+			}
+		}
+	}
+	for _, pc := range ce.pending {
+		pc.event.ChangeEvent = string(ce.marshal(pc, gtid, snapshot))
+	}
+	ce.pending = nil
+}
+
+// marshal encodes a change as a json object in the format of a Debezium
+// envelope:
+// {"op":"u","before":{...},"after":{...},"source":{"keyspace":...,"shard":...,"gtid":...,"table":...,"ts_ms":...},"ts_ms":...,"fields":[...]}
+// Numbers are encoded as json numbers, except decimals, which are encoded
+// as strings to preserve their precision. Binary values are base64 encoded.
+func (ce *changeEventer) marshal(pc *pendingChange, gtid string, snapshot bool) []byte {
+	var before, after []sqltypes.Value
+	if pc.rowChange.Before != nil {
+		before = sqltypes.MakeRowTrusted(pc.fields, pc.rowChange.Before)
+	}
+	if pc.rowChange.After != nil {
+		after = sqltypes.MakeRowTrusted(pc.fields, pc.rowChange.After)
+	}
+	op := changeOpUpdate
+	switch {
+	case snapshot:
+		op = changeOpRead
+	case before == nil:
+		op = changeOpCreate
+	case after == nil:
+		op = changeOpDelete
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"op":`)
+	writeJSONString(buf, op)
+	buf.WriteString(`,"before":`)
+	writeJSONRow(buf, pc.fields, before)
+	buf.WriteString(`,"after":`)
+	writeJSONRow(buf, pc.fields, after)
+	buf.WriteString(`,"source":{"keyspace":`)
+	writeJSONString(buf, ce.keyspace)
+	buf.WriteString(`,"shard":`)
+	writeJSONString(buf, ce.shard)
+	buf.WriteString(`,"gtid":`)
+	writeJSONString(buf, gtid)
+	buf.WriteString(`,"table":`)
+	writeJSONString(buf, pc.table)
+	fmt.Fprintf(buf, `,"ts_ms":%d}`, pc.event.Timestamp*1000)
+	fmt.Fprintf(buf, `,"ts_ms":%d`, pc.event.CurrentTime/1e6)
+	buf.WriteString(`,"fields":[`)
+	for i, field := range pc.fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`{"name":`)
+		writeJSONString(buf, field.Name)
+		buf.WriteString(`,"type":`)
+		writeJSONString(buf, field.Type.String())
+		buf.WriteString(`,"optional":`)
+		if field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) != 0 {
+			buf.WriteString("false")
+		} else {
+			buf.WriteString("true")
+		}
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			buf.WriteString(`,"key":true`)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteString("]}")
+	return buf.Bytes()
+}
+
+func writeJSONRow(buf *bytes.Buffer, fields []*querypb.Field, row []sqltypes.Value) {
+	if row == nil {
+		buf.WriteString("null")
+		return
+	}
+	buf.WriteByte('{')
+	for i, field := range fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		writeJSONString(buf, field.Name)
+		buf.WriteByte(':')
+		writeJSONValue(buf, row[i])
+	}
+	buf.WriteByte('}')
+}
+
+func writeJSONValue(buf *bytes.Buffer, v sqltypes.Value) {
+	switch {
+	case v.IsNull():
+		buf.WriteString("null")
+	case v.IsIntegral() || v.IsFloat():
+		buf.Write(v.Raw())
+	case v.IsBinary():
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(v.Raw()))
+	default:
+		writeJSONString(buf, v.ToString())
+	}
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	// Marshaling a string cannot fail.
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,13 +28,22 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 )
 
 // vstreamManager manages vstream requests.
@@ -41,6 +51,12 @@ type vstreamManager struct {
 	resolver *srvtopo.Resolver
 	toposerv srvtopo.Server
 	cell     string
+
+	// mu protects lastStreamID and streams.
+	mu           sync.Mutex
+	lastStreamID int64
+	// streams contains the active vstreams, keyed by their id.
+	streams map[int64]*vstream
 }
 
 // vstream contains the metadata for one VStream request.
@@ -63,6 +79,7 @@ type vstream struct {
 	// Other input parameters
 	tabletType topodatapb.TabletType
 	filter     *binlogdatapb.Filter
+	tables     []*vtgatepb.VStreamTable
 	resolver   *srvtopo.Resolver
 
 	cancel context.CancelFunc
@@ -73,6 +90,19 @@ type vstream struct {
 	// about the same time as each other. Note that there is no exact ordering of events across shards
 	minimizeSkew bool
 
+	// this interval is set by the client, default 0
+	// if set, a heartbeat event is sent for each shard that had no other events to send during the interval
+	heartbeatInterval time.Duration
+
+	// lagMu protects lags.
+	lagMu sync.Mutex
+	// the replication lag of each shard in seconds, keyed by streamId. streamId is of the form <keyspace>.<shard>
+	lags map[string]int64
+
+	// this flag is set by the client, default false
+	// if true, row changes are sent as self-describing CHANGE events instead of FIELD and ROW events
+	changeEvents bool
+
 	// mutex used to synchronize access to skew detection parameters
 	skewMu sync.Mutex
 	// channel is created whenever there is a skew detected. closing it implies the current skew has been fixed
@@ -102,7 +132,47 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 		resolver: resolver,
 		toposerv: serv,
 		cell:     cell,
+		streams:  make(map[int64]*vstream),
+	}
+}
+
+// registerStats exports the replication lag of the shards of the active vstreams.
+func (vsm *vstreamManager) registerStats() {
+	stats.NewGaugesFuncWithMultiLabels(
+		"VStreamReplicationLagSeconds",
+		"Replication lag of the shards of the active VStreams, in seconds",
+		[]string{"Stream", "Keyspace", "Shard"},
+		vsm.replicationLags)
+}
+
+// replicationLags returns the lags of all the shards of the active vstreams.
+// The keys are of the form <stream id>.<keyspace>.<shard>.
+func (vsm *vstreamManager) replicationLags() map[string]int64 {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+	lags := make(map[string]int64)
+	for id, vs := range vsm.streams {
+		vs.lagMu.Lock()
+		for streamID, lag := range vs.lags {
+			lags[fmt.Sprintf("%d.%s", id, streamID)] = lag
+		}
+		vs.lagMu.Unlock()
 	}
+	return lags
+}
+
+func (vsm *vstreamManager) addStream(vs *vstream) int64 {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+	vsm.lastStreamID++
+	vsm.streams[vsm.lastStreamID] = vs
+	return vsm.lastStreamID
+}
+
+func (vsm *vstreamManager) removeStream(id int64) {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+	delete(vsm.streams, id)
 }
 
 func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
@@ -115,15 +185,21 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		vgtid:      vgtid,
 		tabletType: tabletType,
 		filter:     filter,
+		tables:     flags.Tables,
 		send:       send,
 		resolver:   vsm.resolver,
 		journaler:  make(map[int64]*journalEvent),
 
 		minimizeSkew:       flags.MinimizeSkew,
+		heartbeatInterval:  time.Duration(flags.HeartbeatInterval) * time.Second,
+		lags:               make(map[string]int64),
+		changeEvents:       flags.ChangeEvents,
 		skewTimeoutSeconds: 10 * 60,
 		timestamps:         make(map[string]int64),
 		vsm:                vsm,
 	}
+	id := vsm.addStream(vs)
+	defer vsm.removeStream(id)
 	return vs.stream(ctx)
 }
 
@@ -131,6 +207,19 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
 	filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (*binlogdatapb.VGtid, *binlogdatapb.Filter, *vtgatepb.VStreamFlags, error) {
 
+	if flags != nil && len(flags.Tables) != 0 {
+		if filter != nil {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a filter cannot be specified along with a list of tables")
+		}
+		for _, table := range flags.Tables {
+			if table.Name == "" {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table name must be specified: %v", table)
+			}
+			if strings.HasPrefix(table.Name, "/") && len(table.Columns) != 0 {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "columns cannot be specified for a regular expression: %v", table)
+			}
+		}
+	}
 	if filter == nil {
 		filter = &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
@@ -184,16 +273,122 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 					Gtid:     sgtid.Gtid,
 				})
 			}
+		} else if sgtid.Gtid == "current" {
+			sgtids, err := vsm.currentShardGtids(ctx, tabletType, sgtid)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			newvgtid.ShardGtids = append(newvgtid.ShardGtids, sgtids...)
 		} else {
+			// A shard that is no longer serving is streamed from its
+			// tablets up to its resharding journal, which then moves the
+			// stream to the shards that took over its key range.
 			newvgtid.ShardGtids = append(newvgtid.ShardGtids, sgtid)
 		}
 	}
+	newvgtid.ShardGtids = dedupShardGtids(newvgtid.ShardGtids, vgtid.ShardGtids)
+
+	for _, sgtid := range newvgtid.ShardGtids {
+		if len(flags.Tables) != 0 && len(tableFilter(flags.Tables, sgtid.Keyspace).Rules) == 0 {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "no table is specified for keyspace %s", sgtid.Keyspace)
+		}
+	}
 
 	//TODO add tablepk validations
 
 	return newvgtid, filter, flags, nil
 }
 
+// currentShardGtids returns the ShardGtids of the serving shards to stream
+// from the current position of sgtid. A shard that is no longer serving,
+// like the source of a Reshard, is replaced by the serving shards that cover
+// its key range.
+func (vsm *vstreamManager) currentShardGtids(ctx context.Context, tabletType topodatapb.TabletType, sgtid *binlogdatapb.ShardGtid) ([]*binlogdatapb.ShardGtid, error) {
+	_, _, allShards, err := vsm.resolver.GetKeyspaceShards(ctx, sgtid.Keyspace, tabletType)
+	if err != nil {
+		return nil, err
+	}
+	for _, shard := range allShards {
+		if shard.Name == sgtid.Shard {
+			return []*binlogdatapb.ShardGtid{sgtid}, nil
+		}
+	}
+	_, keyRange, err := topo.ValidateShardName(sgtid.Shard)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid shard %s: %v", sgtid.Shard, err)
+	}
+	var sgtids []*binlogdatapb.ShardGtid
+	for _, shard := range allShards {
+		if key.KeyRangesIntersect(keyRange, shard.KeyRange) {
+			sgtids = append(sgtids, &binlogdatapb.ShardGtid{
+				Keyspace: sgtid.Keyspace,
+				Shard:    shard.Name,
+				Gtid:     sgtid.Gtid,
+			})
+		}
+	}
+	if len(sgtids) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "shard %s/%s is not serving", sgtid.Keyspace, sgtid.Shard)
+	}
+	return sgtids, nil
+}
+
+// dedupShardGtids removes the duplicate shards of sgtids, which can come
+// from replacing retired shards with the current ones. A shard that is
+// requested explicitly keeps its own position.
+func dedupShardGtids(sgtids, requested []*binlogdatapb.ShardGtid) []*binlogdatapb.ShardGtid {
+	isRequested := make(map[*binlogdatapb.ShardGtid]bool, len(requested))
+	for _, sgtid := range requested {
+		isRequested[sgtid] = true
+	}
+	explicit := make(map[string]bool)
+	for _, sgtid := range sgtids {
+		if isRequested[sgtid] {
+			explicit[sgtid.Keyspace+"/"+sgtid.Shard] = true
+		}
+	}
+	seen := make(map[string]bool)
+	deduped := make([]*binlogdatapb.ShardGtid, 0, len(sgtids))
+	for _, sgtid := range sgtids {
+		name := sgtid.Keyspace + "/" + sgtid.Shard
+		if seen[name] || (explicit[name] && !isRequested[sgtid]) {
+			continue
+		}
+		seen[name] = true
+		deduped = append(deduped, sgtid)
+	}
+	return deduped
+}
+
+// tableFilter builds the filter of a keyspace from the table subscriptions.
+// The columns are projected with a select expression, and the excluded
+// columns are pruned by vstreamer.
+func tableFilter(tables []*vtgatepb.VStreamTable, keyspace string) *binlogdatapb.Filter {
+	filter := &binlogdatapb.Filter{}
+	for _, table := range tables {
+		if table.Keyspace != "" && table.Keyspace != keyspace {
+			continue
+		}
+		rule := &binlogdatapb.Rule{
+			Match:          table.Name,
+			ExcludeColumns: table.ExcludeColumns,
+		}
+		if len(table.Columns) != 0 {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select ")
+			prefix := ""
+			for _, column := range table.Columns {
+				buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(column))
+				prefix = ", "
+			}
+			buf.Myprintf(" from %v", sqlparser.NewTableIdent(table.Name))
+			rule.Filter = buf.String()
+		}
+		filter.Rules = append(filter.Rules, rule)
+	}
+	return filter
+}
+
 func (vsm *vstreamManager) RecordStreamDelay() {
 	vstreamSkewDelayCount.Add(1)
 }
@@ -231,6 +426,10 @@ func (vs *vstream) startOneStream(ctx context.Context, sgtid *binlogdatapb.Shard
 	}()
 }
 
+// retiredShardPickTimeout is how long a tablet of a retired shard is
+// looked for before the stream fails.
+var retiredShardPickTimeout = 30 * time.Second
+
 // MaxSkew is the threshold for a skew to be detected. Since MySQL timestamps are in seconds we account for
 // two round-offs: one for the actual event and another while accounting for the clock skew
 const MaxSkew = int64(2)
@@ -326,8 +525,26 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	// It will be closed when all journal events converge.
 	var journalDone chan struct{}
 
+	streamID := fmt.Sprintf("%s.%s", sgtid.Keyspace, sgtid.Shard)
+	defer vs.removeLag(streamID)
+	// lastSent is the time events were last sent for this shard. It's
+	// used to decide when a heartbeat must be sent.
+	var lastSent time.Time
+
+	// retired is set if the shard is no longer serving, like the source
+	// shards of a Reshard that has switched its writes. Its tablets are
+	// not routed to by the gateway, but they still have the binlogs up
+	// to the resharding journal.
+	retired := false
+
 	errCount := 0
 	for {
+		// The fields are resent by vstreamer on every new stream.
+		var ce *changeEventer
+		if vs.changeEvents {
+			ce = newChangeEventer(sgtid.Keyspace, sgtid.Shard)
+		}
+
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -352,8 +569,20 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			// Unreachable.
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected number or shards: %v", rss)
 		}
+		var qs queryservice.QueryService = rss[0].Gateway
+		target := rss[0].Target
+		if retired {
+			qs, target, err = vs.dialRetiredShard(ctx, sgtid)
+			if err != nil {
+				return err
+			}
+		}
+		filter := vs.filter
+		if len(vs.tables) != 0 {
+			filter = tableFilter(vs.tables, sgtid.Keyspace)
+		}
 		// Safe to access sgtid.Gtid here (because it can't change until streaming begins).
-		err = rss[0].Gateway.VStream(ctx, rss[0].Target, sgtid.Gtid, sgtid.TablePKs, vs.filter, func(events []*binlogdatapb.VEvent) error {
+		err = qs.VStream(ctx, target, sgtid.Gtid, sgtid.TablePKs, filter, func(events []*binlogdatapb.VEvent) error {
 			// We received a valid event. Reset error count.
 			errCount = 0
 
@@ -372,6 +601,11 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			for _, event := range events {
 				switch event.Type {
 				case binlogdatapb.VEventType_FIELD:
+					if ce != nil {
+						// The fields are sent as part of the change events.
+						ce.setFields(event.FieldEvent)
+						break
+					}
 					// Update table names and send.
 					// If we're streaming from multiple keyspaces, this will disambiguate
 					// duplicate table names.
@@ -379,6 +613,14 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					ev.FieldEvent.TableName = sgtid.Keyspace + "." + ev.FieldEvent.TableName
 					sendevents = append(sendevents, ev)
 				case binlogdatapb.VEventType_ROW:
+					if ce != nil {
+						changes, err := ce.changeEvents(event)
+						if err != nil {
+							return err
+						}
+						sendevents = append(sendevents, changes...)
+						break
+					}
 					// Update table names and send.
 					ev := proto.Clone(event).(*binlogdatapb.VEvent)
 					ev.RowEvent.TableName = sgtid.Keyspace + "." + ev.RowEvent.TableName
@@ -386,6 +628,10 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 				case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
 					sendevents = append(sendevents, event)
 					eventss = append(eventss, sendevents)
+					if ce != nil {
+						ce.complete(eventss, sgtid.Gtid)
+					}
+					vs.recordLag(streamID, event)
 
 					if err := vs.alignStreams(ctx, event, sgtid.Keyspace, sgtid.Shard); err != nil {
 						return err
@@ -394,15 +640,33 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					if err := vs.sendAll(sgtid, eventss); err != nil {
 						return err
 					}
+					lastSent = time.Now()
 					eventss = nil
 					sendevents = nil
 				case binlogdatapb.VEventType_HEARTBEAT:
-					// Remove all heartbeat events for now.
-					// Otherwise they can accumulate indefinitely if there are no real events.
-					// TODO(sougou): figure out a model for this.
+					// The heartbeat events of the tablet are not sent: they can accumulate
+					// indefinitely if there are no real events. Instead, if the client asked
+					// for heartbeats, one is sent per interval if the shard had nothing else
+					// to send, and never in the middle of a transaction.
+					vs.recordLag(streamID, event)
 					if err := vs.alignStreams(ctx, event, sgtid.Keyspace, sgtid.Shard); err != nil {
 						return err
 					}
+					if vs.heartbeatInterval == 0 || len(eventss) != 0 || len(sendevents) != 0 || time.Since(lastSent) < vs.heartbeatInterval {
+						break
+					}
+					heartbeat := &binlogdatapb.VEvent{
+						Type:                  binlogdatapb.VEventType_HEARTBEAT,
+						Keyspace:              sgtid.Keyspace,
+						Shard:                 sgtid.Shard,
+						Timestamp:             event.Timestamp,
+						CurrentTime:           event.CurrentTime,
+						ReplicationLagSeconds: event.ReplicationLagSeconds,
+					}
+					if err := vs.sendAll(sgtid, [][]*binlogdatapb.VEvent{{heartbeat}}); err != nil {
+						return err
+					}
+					lastSent = time.Now()
 
 				case binlogdatapb.VEventType_JOURNAL:
 					journal := event.Journal
@@ -430,6 +694,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			}
 			return nil
 		})
+		if retired {
+			qs.Close(ctx)
+		}
 		// If stream was ended (by a journal event), return nil without checking for error.
 		select {
 		case <-journalDone:
@@ -444,6 +711,27 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 			log.Errorf("vstream for %s/%s error: %v", sgtid.Keyspace, sgtid.Shard, err)
 			return err
 		}
+		if !retired && vs.isRetired(ctx, sgtid) {
+			je, jerr := vs.joinReshardingJournal(ctx, sgtid)
+			if jerr != nil {
+				return jerr
+			}
+			if je != nil {
+				// The stream is already at the journal.
+				journalDone = je.done
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-journalDone:
+					return nil
+				}
+			}
+			// The stream continues from a tablet of the shard until it
+			// reaches the journal, which then moves it to the new shards.
+			log.Infof("vstream for %s/%s: the shard is not serving, streaming up to its resharding journal: %v", sgtid.Keyspace, sgtid.Shard, err)
+			retired = true
+			continue
+		}
 		errCount++
 		if errCount >= 3 {
 			log.Errorf("vstream for %s/%s had three consecutive failures: %v", sgtid.Keyspace, sgtid.Shard, err)
@@ -453,6 +741,117 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	}
 }
 
+// isRetired returns true if the shard is not one of the serving shards of
+// its keyspace.
+func (vs *vstream) isRetired(ctx context.Context, sgtid *binlogdatapb.ShardGtid) bool {
+	if vs.resolver == nil {
+		return false
+	}
+	_, _, allShards, err := vs.resolver.GetKeyspaceShards(ctx, sgtid.Keyspace, vs.tabletType)
+	if err != nil {
+		return false
+	}
+	for _, shard := range allShards {
+		if shard.Name == sgtid.Shard {
+			return false
+		}
+	}
+	return true
+}
+
+// joinReshardingJournal joins the stream of a retired shard to the journal
+// that the Reshard wrote to the shard, if the position of the stream is at
+// the journal already. The journal is read from the topo, where it outlives
+// the shard. It returns nil if the stream must go on up to the journal.
+func (vs *vstream) joinReshardingJournal(ctx context.Context, sgtid *binlogdatapb.ShardGtid) (*journalEvent, error) {
+	ts, err := vs.vsm.toposerv.GetTopoServer()
+	if err != nil {
+		return nil, err
+	}
+	journal, err := ts.GetReshardingJournal(ctx, sgtid.Keyspace, sgtid.Shard)
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Safe to access sgtid.Gtid here (because only this stream changes it).
+	pos, err := mysql.DecodePosition(sgtid.Gtid)
+	if err != nil {
+		return nil, err
+	}
+	journalPos, err := mysql.DecodePosition(journal.LocalPosition)
+	if err != nil {
+		return nil, err
+	}
+	if !pos.AtLeast(journalPos) {
+		return nil, nil
+	}
+	return vs.getJournalEvent(ctx, sgtid, journal)
+}
+
+// dialRetiredShard connects to a tablet of a shard that is not serving.
+// The tablet is picked from the topo, because the healthcheck only
+// returns serving tablets.
+func (vs *vstream) dialRetiredShard(ctx context.Context, sgtid *binlogdatapb.ShardGtid) (queryservice.QueryService, *querypb.Target, error) {
+	ts, err := vs.vsm.toposerv.GetTopoServer()
+	if err != nil {
+		return nil, nil, err
+	}
+	// The events of the shard between the position and the journal are
+	// only in its binlogs. If the shard was deleted, they can't be sent.
+	if _, err := ts.GetShard(ctx, sgtid.Keyspace, sgtid.Shard); err != nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "shard %s/%s is not serving and can't be streamed from %s up to its resharding journal: %v", sgtid.Keyspace, sgtid.Shard, sgtid.Gtid, err)
+	}
+	tp, err := discovery.NewTabletPicker(ts, []string{vs.vsm.cell}, sgtid.Keyspace, sgtid.Shard, vs.tabletType.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	pickCtx, cancel := context.WithTimeout(ctx, retiredShardPickTimeout)
+	defer cancel()
+	tablet, err := tp.PickForStreaming(pickCtx)
+	if err != nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "shard %s/%s is not serving and none of its tablets can be reached to stream from %s up to its resharding journal: %v", sgtid.Keyspace, sgtid.Shard, sgtid.Gtid, err)
+	}
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return nil, nil, err
+	}
+	target := &querypb.Target{
+		Keyspace:   tablet.Keyspace,
+		Shard:      tablet.Shard,
+		TabletType: tablet.Type,
+	}
+	return conn, target, nil
+}
+
+// recordLag records the replication lag of a shard. The lag of a binlog
+// event is the time elapsed since it was committed. If the shard is idle,
+// the source tablet reports its replication lag in the heartbeats.
+func (vs *vstream) recordLag(streamID string, event *binlogdatapb.VEvent) {
+	var lag int64
+	switch {
+	case event.Type == binlogdatapb.VEventType_HEARTBEAT:
+		lag = event.ReplicationLagSeconds
+	case event.Timestamp != 0 && event.CurrentTime != 0:
+		lag = event.CurrentTime/1e9 - event.Timestamp
+		if lag < 0 {
+			lag = 0
+		}
+	default:
+		return
+	}
+	vs.lagMu.Lock()
+	defer vs.lagMu.Unlock()
+	vs.lags[streamID] = lag
+}
+
+func (vs *vstream) removeLag(streamID string) {
+	vs.lagMu.Lock()
+	defer vs.lagMu.Unlock()
+	delete(vs.lags, streamID)
+}
+
 // sendAll sends a group of events together while holding the lock.
 func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdatapb.VEvent) error {
 	vs.mu.Lock()
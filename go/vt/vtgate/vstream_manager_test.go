@@ -25,6 +25,7 @@ import (
 
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -37,10 +38,13 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 )
 
 var mu sync.Mutex
@@ -211,6 +215,72 @@ func TestVStreamEvents(t *testing.T) {
 	<-ch
 }
 
+// TestVStreamChangeEvents ensures that the FIELD and ROW events are
+// converted to CHANGE events if the change_events flag is set.
+func TestVStreamChangeEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	fields := sqltypes.MakeTestFields("id|name|data", "int64|varchar|varbinary")
+	fields[0].Flags = uint32(querypb.MySqlFlag_PRI_KEY_FLAG | querypb.MySqlFlag_NOT_NULL_FLAG)
+	row1 := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("\x01")})
+	row2 := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b"), sqltypes.NULL})
+	send := []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t0", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, Timestamp: 1, RowEvent: &binlogdatapb.RowEvent{
+			TableName: "t0",
+			RowChanges: []*binlogdatapb.RowChange{
+				{After: row1},
+				{Before: row1, After: row2},
+				{Before: row2},
+			},
+		}},
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}
+	sbc0.AddVStreamEvents(send, nil)
+
+	source := `"source":{"keyspace":"TestVStream","shard":"-20","gtid":"gtid01","table":"t0","ts_ms":1000},"ts_ms":0,` +
+		`"fields":[{"name":"id","type":"INT64","optional":false,"key":true},{"name":"name","type":"VARCHAR","optional":true},{"name":"data","type":"VARBINARY","optional":true}]}`
+	want := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"c","before":null,"after":{"id":1,"name":"a","data":"AQ=="},` + source},
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"u","before":{"id":1,"name":"a","data":"AQ=="},"after":{"id":1,"name":"b","data":null},` + source},
+		{Type: binlogdatapb.VEventType_CHANGE, ChangeEvent: `{"op":"d","before":{"id":1,"name":"b","data":null},"after":null,` + source},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{ChangeEvents: true}, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	verifyEvents(t, ch, want)
+}
+
 // TestVStreamChunks ensures that a transaction that's broken
 // into chunks is sent together.
 func TestVStreamChunks(t *testing.T) {
@@ -429,6 +499,66 @@ func TestVStreamHeartbeat(t *testing.T) {
 	verifyEvents(t, ch, want)
 }
 
+func TestVStreamHeartbeatInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	// The first heartbeat is sent, the second one is within the interval.
+	send0 := []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_HEARTBEAT, Timestamp: 100, CurrentTime: 100e9, ReplicationLagSeconds: 5},
+	}
+	sbc0.AddVStreamEvents(send0, nil)
+	sbc0.AddVStreamEvents(send0, nil)
+	want0 := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{{
+		Type:                  binlogdatapb.VEventType_HEARTBEAT,
+		Keyspace:              name,
+		Shard:                 "-20",
+		CurrentTime:           100e9,
+		ReplicationLagSeconds: 5,
+	}}}
+
+	send1 := []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 90, CurrentTime: 100e9},
+	}
+	sbc0.AddVStreamEvents(send1, nil)
+	want1 := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT, CurrentTime: 100e9},
+	}}
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{HeartbeatInterval: 60}, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	verifyEvents(t, ch, want0, want1)
+
+	// The lag of the commit is the time elapsed since it was committed.
+	assert.Equal(t, map[string]int64{"1.TestVStream.-20": 10}, vsm.replicationLags())
+}
+
 func TestVStreamJournalOneToMany(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -850,6 +980,363 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 	cancel()
 }
 
+func TestVStreamRetiredShards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The keyspace was resharded from -80 to -40,40-80, and then from -40
+	// to -20,20-40. The stream resumes from a position of -80.
+	name := "TestVStreamRetired"
+	// The retired shards are dialed directly: other tests may have
+	// changed the protocol.
+	protocol := *tabletconn.TabletProtocol
+	*tabletconn.TabletProtocol = "sandbox"
+	defer func() { *tabletconn.TabletProtocol = protocol }()
+	sand := createSandbox(name)
+	sand.ShardSpec = "-20-40-80-"
+	serv := newSandboxForCells([]string{"aa"})
+	ts, _ := serv.GetTopoServer()
+	require.NoError(t, ts.CreateKeyspace(ctx, name, &topodatapb.Keyspace{}))
+	sand.DialConns = make(map[string]*sandboxconn.SandboxConn)
+	for i, shard := range []string{"-80", "-40"} {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "aa", Uid: uint32(100 + i)},
+			Hostname: "1.1.1.1",
+			Keyspace: name,
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		require.NoError(t, ts.CreateShard(ctx, name, shard))
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		_, err := ts.UpdateShardFields(ctx, name, shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+		sand.DialConns[shard] = sandboxconn.NewSandboxConn(tablet)
+	}
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, serv, "aa")
+	sbc4080 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "40-80", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc20 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc2040 := hc.AddTestTablet("aa", "1.1.1.1", 1003, name, "20-40", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	sbc80 := sand.DialConns["-80"]
+	sbc80.ExpectVStreamStartPos("pos80")
+	sbc80.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t0"}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+		{Type: binlogdatapb.VEventType_JOURNAL, Journal: &binlogdatapb.Journal{
+			Id:            1,
+			MigrationType: binlogdatapb.MigrationType_SHARDS,
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-40",
+				Gtid:     "pos40",
+			}, {
+				Keyspace: name,
+				Shard:    "40-80",
+				Gtid:     "pos4080",
+			}},
+			Participants: []*binlogdatapb.KeyspaceShard{{
+				Keyspace: name,
+				Shard:    "-80",
+			}},
+		}},
+	}, nil)
+	sbc40 := sand.DialConns["-40"]
+	sbc40.ExpectVStreamStartPos("pos40")
+	sbc40.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid02"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+		{Type: binlogdatapb.VEventType_JOURNAL, Journal: &binlogdatapb.Journal{
+			Id:            2,
+			MigrationType: binlogdatapb.MigrationType_SHARDS,
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "pos20",
+			}, {
+				Keyspace: name,
+				Shard:    "20-40",
+				Gtid:     "pos2040",
+			}},
+			Participants: []*binlogdatapb.KeyspaceShard{{
+				Keyspace: name,
+				Shard:    "-40",
+			}},
+		}},
+	}, nil)
+	sbc4080.ExpectVStreamStartPos("pos4080")
+	sbc4080.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid03"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc20.ExpectVStreamStartPos("pos20")
+	sbc20.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid04"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc2040.ExpectVStreamStartPos("pos2040")
+	sbc2040.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid05"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-80",
+			Gtid:     "pos80",
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, false)
+	want1 := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-80",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStreamRetired.t0"}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+	verifyEvents(t, ch, want1)
+
+	// The events of the new shards can come in any order, but they all
+	// end up in the same VGTID.
+	want := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "40-80",
+			Gtid:     "gtid03",
+		}, {
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "gtid04",
+		}, {
+			Keyspace: name,
+			Shard:    "20-40",
+			Gtid:     "gtid05",
+		}},
+	}
+	timer := time.NewTimer(10 * time.Second)
+	defer timer.Stop()
+	for {
+		select {
+		case got := <-ch:
+			if proto.Equal(got.Events[0].Vgtid, want) {
+				return
+			}
+		case <-timer.C:
+			t.Fatalf("vgtid never became %v", want)
+		}
+	}
+}
+
+func TestVStreamDeletedShards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The keyspace was resharded from -80 to -40,40-80, and -80 was
+	// deleted. Its journal is kept in the topo.
+	name := "TestVStreamDeleted"
+	sand := createSandbox(name)
+	sand.ShardSpec = "-40-80-"
+	serv := newSandboxForCells([]string{"aa"})
+	ts, _ := serv.GetTopoServer()
+	require.NoError(t, ts.CreateKeyspace(ctx, name, &topodatapb.Keyspace{}))
+	const journalPos = "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"
+	require.NoError(t, ts.SaveReshardingJournal(ctx, name, "-80", &binlogdatapb.Journal{
+		Id:            1,
+		MigrationType: binlogdatapb.MigrationType_SHARDS,
+		LocalPosition: journalPos,
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-40",
+			Gtid:     "pos40",
+		}, {
+			Keyspace: name,
+			Shard:    "40-80",
+			Gtid:     "pos4080",
+		}},
+		Participants: []*binlogdatapb.KeyspaceShard{{
+			Keyspace: name,
+			Shard:    "-80",
+		}},
+	}))
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, serv, "aa")
+	sbc40 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-40", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc4080 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "40-80", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	// A position before the journal can't be continued, because the
+	// events up to the journal are gone with the shard.
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-80",
+			Gtid:     "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-9",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{}, func(events []*binlogdatapb.VEvent) error {
+		t.Errorf("unexpected events: %v", events)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "shard TestVStreamDeleted/-80 is not serving and can't be streamed from MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-9 up to its resharding journal")
+
+	// A position at the journal continues on the shards of the journal.
+	sbc40.ExpectVStreamStartPos("pos40")
+	sbc40.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	sbc4080.ExpectVStreamStartPos("pos4080")
+	sbc4080.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid02"},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}, nil)
+	vgtid = &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-80",
+			Gtid:     journalPos,
+		}},
+	}
+	ch := startVStream(ctx, t, vsm, vgtid, false)
+	want := map[string]string{
+		"-40":   "gtid01",
+		"40-80": "gtid02",
+	}
+	timer := time.NewTimer(10 * time.Second)
+	defer timer.Stop()
+	waitForVGtid(t, ch, timer, func(vgtid *binlogdatapb.VGtid) bool {
+		got := make(map[string]string)
+		for _, sgtid := range vgtid.ShardGtids {
+			got[sgtid.Shard] = sgtid.Gtid
+		}
+		return assert.ObjectsAreEqual(want, got)
+	})
+}
+
+// waitForVGtid reads the responses of a vstream until the vgtid of one of
+// them matches.
+func waitForVGtid(t *testing.T, ch <-chan *binlogdatapb.VStreamResponse, timer *time.Timer, match func(*binlogdatapb.VGtid) bool) {
+	t.Helper()
+	for {
+		select {
+		case got := <-ch:
+			if match(got.Events[0].Vgtid) {
+				return
+			}
+		case <-timer.C:
+			t.Fatalf("vgtid never matched")
+		}
+	}
+}
+
+func TestResolveVStreamRetiredShards(t *testing.T) {
+	name := "TestVStreamRetired"
+	sand := createSandbox(name)
+	sand.ShardSpec = "-40-80-"
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	testcases := []struct {
+		input  *binlogdatapb.VGtid
+		output *binlogdatapb.VGtid
+	}{{
+		input: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-80",
+				Gtid:     "current",
+			}},
+		},
+		output: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-40",
+				Gtid:     "current",
+			}, {
+				Keyspace: name,
+				Shard:    "40-80",
+				Gtid:     "current",
+			}},
+		},
+	}, {
+		input: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "0",
+				Gtid:     "current",
+			}},
+		},
+		output: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-40",
+				Gtid:     "current",
+			}, {
+				Keyspace: name,
+				Shard:    "40-80",
+				Gtid:     "current",
+			}, {
+				Keyspace: name,
+				Shard:    "80-",
+				Gtid:     "current",
+			}},
+		},
+	}, {
+		// A retired shard with a position is streamed up to its journal.
+		// A shard that is also requested is not duplicated.
+		input: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-80",
+				Gtid:     "pos",
+			}},
+		},
+		output: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-80",
+				Gtid:     "pos",
+			}},
+		},
+	}, {
+		input: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-80",
+				Gtid:     "current",
+			}, {
+				Keyspace: name,
+				Shard:    "40-80",
+				Gtid:     "pos",
+			}},
+		},
+		output: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-40",
+				Gtid:     "current",
+			}, {
+				Keyspace: name,
+				Shard:    "40-80",
+				Gtid:     "pos",
+			}},
+		},
+	}}
+	for _, tcase := range testcases {
+		vgtid, _, _, err := vsm.resolveParams(context.Background(), topodatapb.TabletType_MASTER, tcase.input, nil, nil)
+		require.NoError(t, err, tcase.input)
+		assert.Equal(t, tcase.output, vgtid, tcase.input)
+	}
+}
+
 func TestResolveVStreamParams(t *testing.T) {
 	name := "TestVStream"
 	_ = createSandbox(name)
@@ -994,6 +1481,74 @@ func TestResolveVStreamParams(t *testing.T) {
 
 }
 
+func TestResolveVStreamTables(t *testing.T) {
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+			Shard:    "-20",
+			Gtid:     "current",
+		}},
+	}
+	testcases := []struct {
+		filter *binlogdatapb.Filter
+		tables []*vtgatepb.VStreamTable
+		err    string
+	}{{
+		tables: []*vtgatepb.VStreamTable{{Name: "t1"}},
+	}, {
+		filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1"}}},
+		tables: []*vtgatepb.VStreamTable{{Name: "t1"}},
+		err:    "a filter cannot be specified along with a list of tables",
+	}, {
+		tables: []*vtgatepb.VStreamTable{{Columns: []string{"id"}}},
+		err:    "table name must be specified",
+	}, {
+		tables: []*vtgatepb.VStreamTable{{Name: "/t.*", Columns: []string{"id"}}},
+		err:    "columns cannot be specified for a regular expression",
+	}, {
+		tables: []*vtgatepb.VStreamTable{{Keyspace: "other", Name: "t1"}},
+		err:    "no table is specified for keyspace TestVStream",
+	}}
+	for _, tcase := range testcases {
+		_, _, _, err := vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, vgtid, tcase.filter, &vtgatepb.VStreamFlags{Tables: tcase.tables})
+		if tcase.err != "" {
+			require.Error(t, err, tcase.tables)
+			assert.Contains(t, err.Error(), tcase.err)
+			continue
+		}
+		require.NoError(t, err, tcase.tables)
+	}
+}
+
+func TestVStreamTableFilter(t *testing.T) {
+	tables := []*vtgatepb.VStreamTable{{
+		Name:    "t1",
+		Columns: []string{"id", "name"},
+	}, {
+		Keyspace:       "ks1",
+		Name:           "/t2.*",
+		ExcludeColumns: []string{"data"},
+	}, {
+		Keyspace: "ks2",
+		Name:     "t3",
+	}}
+	want := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, `name` from t1",
+		}, {
+			Match:          "/t2.*",
+			ExcludeColumns: []string{"data"},
+		}},
+	}
+	got := tableFilter(tables, "ks1")
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+}
+
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
 	gw := NewTabletGateway(context.Background(), hc, serv, cell)
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -83,6 +84,10 @@ var (
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
 	warnShardedOnly   = flag.Bool("warn_sharded_only", false, "If any features that are only available in unsharded mode are used, query execution warnings will be added to the session")
 
+	// schema tracking flags
+	enableSchemaChangeSignal   = flag.Bool("schema_change_signal", false, "Enable the schema tracker. The columns of the tables reported by the master tablets are merged into the VSchema. Tablets need to have -queryserver-config-schema-change-signal enabled for changes to be tracked")
+	schemaChangeReloadInterval = flag.Duration("schema_change_reload_interval", 5*time.Minute, "How often the schema tracker reloads all the tables, in case it missed the schema change signal of a tablet")
+
 	foreignKeyMode = flag.String("foreign_key_mode", "allow", "This is to provide how to handle foreign key constraint in create/alter table. Valid values are: allow, disallow")
 )
 
@@ -190,14 +195,22 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
 	resolver := NewResolver(srvResolver, serv, cell, sc)
 	vsm := newVStreamManager(srvResolver, serv, cell)
+	vsm.registerStats()
 	cacheCfg := &cache.Config{
 		MaxEntries:     *queryPlanCacheSize,
 		MaxMemoryUsage: *queryPlanCacheMemory,
 		LFU:            *queryPlanCacheLFU,
 	}
 
+	var si SchemaInfo // default nil
+	var st *vtschema.Tracker
+	if *enableSchemaChangeSignal {
+		st = vtschema.NewTracker(gw.hc.Subscribe(), *schemaChangeReloadInterval)
+		si = st
+	}
+
 	rpcVTGate = &VTGate{
-		executor: NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg),
+		executor: NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, si),
 		resolver: resolver,
 		vsm:      vsm,
 		txConn:   tc,
@@ -237,6 +250,12 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 			f(rpcVTGate)
 		}
 	})
+	if st != nil {
+		st.RegisterSignalReceiver(rpcVTGate.executor.vm.Rebuild)
+		st.Start()
+		servenv.OnTerm(st.Stop)
+	}
+
 	rpcVTGate.registerDebugHealthHandler()
 	err := initQueryLogger(rpcVTGate)
 	if err != nil {
@@ -531,6 +550,7 @@ func LegacyInit(ctx context.Context, hc discovery.LegacyHealthCheck, serv srvtop
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
 	resolver := NewResolver(srvResolver, serv, cell, sc)
 	vsm := newVStreamManager(srvResolver, serv, cell)
+	vsm.registerStats()
 	cacheCfg := &cache.Config{
 		MaxEntries:     *queryPlanCacheSize,
 		MaxMemoryUsage: *queryPlanCacheMemory,
@@ -538,7 +558,7 @@ func LegacyInit(ctx context.Context, hc discovery.LegacyHealthCheck, serv srvtop
 	}
 
 	rpcVTGate = &VTGate{
-		executor: NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg),
+		executor: NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, nil),
 		resolver: resolver,
 		vsm:      vsm,
 		txConn:   tc,
@@ -444,6 +444,27 @@ func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *queryp
 	return response.Metadata, nil
 }
 
+// UnresolvedTransactions returns the unresolved 2pc transactions of the tablet.
+func (conn *gRPCQueryClient) UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return nil, nil, tabletconn.ConnClosed
+	}
+
+	req := &querypb.UnresolvedTransactionsRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		AbandonAge:        abandonAge,
+	}
+	response, err := conn.c.UnresolvedTransactions(ctx, req)
+	if err != nil {
+		return nil, nil, tabletconn.ErrorFromGRPC(err)
+	}
+	return response.Transactions, response.Prepared, nil
+}
+
 // BeginExecute starts a transaction and runs an Execute.
 func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
@@ -69,6 +69,10 @@ func (b *BenchmarkService) ReadTransaction(ctx context.Context, target *querypb.
 	panic("should not be called")
 }
 
+func (b *BenchmarkService) UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	panic("should not be called")
+}
+
 func (b *BenchmarkService) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	panic("should not be called")
 }
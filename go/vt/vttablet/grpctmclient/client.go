@@ -217,6 +217,20 @@ func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tab
 	return response.Permissions, nil
 }
 
+// GetHotRows is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetHotRows(ctx, &tabletmanagerdatapb.GetHotRowsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.HotRows, nil
+}
+
 //
 // Various read-write methods
 //
@@ -635,6 +649,51 @@ func (client *Client) VReplicationWaitForPos(ctx context.Context, tablet *topoda
 	return nil
 }
 
+// VDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	return c.VDiff(ctx, req)
+}
+
+//
+// Table GC related functions
+//
+
+// GetGCTables is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetGCTables(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetGCTables(ctx, &tabletmanagerdatapb.GetGCTablesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tables, nil
+}
+
+// CancelGCTableDrop is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CancelGCTableDrop(ctx context.Context, tablet *topodatapb.Tablet, tableName, originalName string) (string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.CancelGCTableDrop(ctx, &tabletmanagerdatapb.CancelGCTableDropRequest{
+		TableName:    tableName,
+		OriginalName: originalName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.RestoredName, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -96,6 +96,17 @@ func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatap
 	return response, err
 }
 
+func (s *server) GetHotRows(ctx context.Context, request *tabletmanagerdatapb.GetHotRowsRequest) (response *tabletmanagerdatapb.GetHotRowsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetHotRows", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetHotRowsResponse{}
+	hotRows, err := s.tm.GetHotRows(ctx)
+	if err == nil {
+		response.HotRows = hotRows
+	}
+	return response, err
+}
+
 //
 // Various read-write methods
 //
@@ -353,6 +364,36 @@ func (s *server) VReplicationWaitForPos(ctx context.Context, request *tabletmana
 	return &tabletmanagerdatapb.VReplicationWaitForPosResponse{}, err
 }
 
+func (s *server) VDiff(ctx context.Context, request *tabletmanagerdatapb.VDiffRequest) (response *tabletmanagerdatapb.VDiffResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "VDiff", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response, err = s.tm.VDiff(ctx, request)
+	return response, err
+}
+
+//
+// Table GC related functions
+//
+
+func (s *server) GetGCTables(ctx context.Context, request *tabletmanagerdatapb.GetGCTablesRequest) (response *tabletmanagerdatapb.GetGCTablesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetGCTables", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetGCTablesResponse{}
+	tables, err := s.tm.GetGCTables(ctx)
+	if err == nil {
+		response.Tables = tables
+	}
+	return response, err
+}
+
+func (s *server) CancelGCTableDrop(ctx context.Context, request *tabletmanagerdatapb.CancelGCTableDropRequest) (response *tabletmanagerdatapb.CancelGCTableDropResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "CancelGCTableDrop", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CancelGCTableDropResponse{}
+	response.RestoredName, err = s.tm.CancelGCTableDrop(ctx, request.TableName, request.OriginalName)
+	return response, err
+}
+
 //
 // Reparenting related functions
 //
@@ -0,0 +1,400 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"syscall"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	dryRunStatusOK    = "ok"
+	dryRunStatusError = "error"
+)
+
+// unconvertibleColumnTypes are MySQL data types which a migration may not convert to or from another type
+var unconvertibleColumnTypes = map[string]bool{
+	"json":               true,
+	"geometry":           true,
+	"point":              true,
+	"linestring":         true,
+	"polygon":            true,
+	"multipoint":         true,
+	"multilinestring":    true,
+	"multipolygon":       true,
+	"geometrycollection": true,
+}
+
+// isUnsupportedColumnTypeChange returns true when a column may not be migrated from one data type to the other
+func isUnsupportedColumnTypeChange(fromType, toType string) bool {
+	fromType = strings.ToLower(fromType)
+	toType = strings.ToLower(toType)
+	if fromType == toType {
+		return false
+	}
+	return unconvertibleColumnTypes[fromType] || unconvertibleColumnTypes[toType]
+}
+
+// dryRunReport is the outcome of the pre-flight checks of a migration on this shard
+type dryRunReport struct {
+	table              string
+	actionStr          string
+	strategy           schema.DDLStrategy
+	failures           []string
+	tableRows          int64
+	dataLength         int64
+	indexLength        int64
+	estimatedDiskBytes int64
+	freeDiskBytes      int64
+	sharedUniqueKeys   []string
+}
+
+// fail records a failed check
+func (r *dryRunReport) fail(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// toResult returns the report as a single row result
+func (r *dryRunReport) toResult(keyspace, shard string) *sqltypes.Result {
+	status := dryRunStatusOK
+	if len(r.failures) > 0 {
+		status = dryRunStatusError
+	}
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "keyspace", Type: sqltypes.VarChar},
+			{Name: "shard", Type: sqltypes.VarChar},
+			{Name: "mysql_table", Type: sqltypes.VarChar},
+			{Name: "ddl_action", Type: sqltypes.VarChar},
+			{Name: "strategy", Type: sqltypes.VarChar},
+			{Name: "status", Type: sqltypes.VarChar},
+			{Name: "message", Type: sqltypes.VarChar},
+			{Name: "table_rows", Type: sqltypes.Int64},
+			{Name: "data_length", Type: sqltypes.Int64},
+			{Name: "index_length", Type: sqltypes.Int64},
+			{Name: "estimated_disk_bytes", Type: sqltypes.Int64},
+			{Name: "free_disk_bytes", Type: sqltypes.Int64},
+			{Name: "shared_unique_keys", Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.NewVarChar(keyspace),
+				sqltypes.NewVarChar(shard),
+				sqltypes.NewVarChar(r.table),
+				sqltypes.NewVarChar(r.actionStr),
+				sqltypes.NewVarChar(string(r.strategy)),
+				sqltypes.NewVarChar(status),
+				sqltypes.NewVarChar(strings.Join(r.failures, "; ")),
+				sqltypes.NewInt64(r.tableRows),
+				sqltypes.NewInt64(r.dataLength),
+				sqltypes.NewInt64(r.indexLength),
+				sqltypes.NewInt64(r.estimatedDiskBytes),
+				sqltypes.NewInt64(r.freeDiskBytes),
+				sqltypes.NewVarChar(strings.Join(r.sharedUniqueKeys, ",")),
+			},
+		},
+	}
+}
+
+// DryRunMigration runs the pre-flight checks of a migration on this shard, and estimates its impact.
+// It does not create a migration. The result is a single row report, so that running the dry run on
+// all shards produces a per-shard report.
+func (e *Executor) DryRunMigration(ctx context.Context, stmt sqlparser.Statement) (*sqltypes.Result, error) {
+	onlineDDL, err := schema.OnlineDDLFromCommentedStatement(stmt)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error in dry run of migration %s: %v", sqlparser.String(stmt), err)
+	}
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return nil, ErrExecutorNotWritableTablet
+	}
+	ddlAction, actionStr, err := onlineDDL.GetActionStr()
+	if err != nil {
+		return nil, err
+	}
+	if ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL); err == nil {
+		// We strip out any VT query comments because our simplified parser doesn't work well with comments
+		ddlStmt.SetComments(sqlparser.Comments{})
+		onlineDDL.SQL = sqlparser.String(ddlStmt)
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	report := &dryRunReport{
+		table:         onlineDDL.Table,
+		actionStr:     actionStr,
+		strategy:      onlineDDL.Strategy,
+		freeDiskBytes: -1,
+	}
+	if err := e.dryRunChecks(ctx, conn, onlineDDL, ddlAction, report); err != nil {
+		return nil, err
+	}
+	return report.toResult(e.keyspace, e.shard), nil
+}
+
+// dryRunChecks validates the migration the way executeMigration would see it. Failed checks are
+// recorded in the report; a returned error means the checks could not run.
+func (e *Executor) dryRunChecks(ctx context.Context, conn *dbconnpool.DBConnection, onlineDDL *schema.OnlineDDL, ddlAction sqlparser.DDLAction, report *dryRunReport) error {
+	if ddlAction == sqlparser.RevertDDLAction {
+		revertUUID, err := onlineDDL.GetRevertUUID()
+		if err != nil {
+			report.fail("%v", err)
+			return nil
+		}
+		revertMigration, row, err := e.readMigration(ctx, revertUUID)
+		if err != nil {
+			report.fail("cannot read migration %s: %v", revertUUID, err)
+			return nil
+		}
+		report.table = revertMigration.Table
+		if err := e.validateMigrationRevertible(ctx, revertMigration); err != nil {
+			report.fail("%v", err)
+		}
+		if algorithm := row["ddl_algorithm"].ToString(); algorithm != "" {
+			report.fail("migration %s was applied with algorithm=%s and cannot be reverted", revertUUID, algorithm)
+		}
+		return nil
+	}
+
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		report.fail("%v", err)
+		return nil
+	}
+	exists, err := e.tableExists(ctx, onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	isDeclarative := onlineDDL.StrategySetting().IsDeclarative()
+	switch ddlAction {
+	case sqlparser.CreateDDLAction:
+		if !exists {
+			return nil
+		}
+		if createView, ok := ddlStmt.(*sqlparser.CreateView); ok {
+			// An existing view is replaced by CREATE OR REPLACE VIEW, or by any declarative CREATE VIEW
+			if !createView.IsReplace && !isDeclarative {
+				report.fail("view %s already exists", onlineDDL.Table)
+				return nil
+			}
+			report.actionStr = sqlparser.AlterStr
+			return nil
+		}
+		if !isDeclarative {
+			if !ddlStmt.GetIfNotExists() {
+				report.fail("table %s already exists", onlineDDL.Table)
+			}
+			return nil
+		}
+		// A declarative CREATE on an existing table turns into an ALTER, if at all
+		alterTable, err := e.evaluateDeclarativeDiff(ctx, onlineDDL)
+		if err != nil {
+			report.fail("%v", err)
+			return nil
+		}
+		if alterTable == nil {
+			return nil
+		}
+		report.actionStr = sqlparser.AlterStr
+		onlineDDL.SQL = sqlparser.String(alterTable)
+		return e.dryRunAlter(ctx, conn, onlineDDL, report)
+	case sqlparser.DropDDLAction:
+		if !exists && !ddlStmt.GetIfExists() && !isDeclarative {
+			report.fail("table %s does not exist", onlineDDL.Table)
+		}
+	case sqlparser.AlterDDLAction:
+		if !exists {
+			report.fail("table %s does not exist", onlineDDL.Table)
+			return nil
+		}
+		if _, ok := ddlStmt.(*sqlparser.AlterView); ok {
+			// Views hold no data, and are altered directly
+			return nil
+		}
+		return e.dryRunAlter(ctx, conn, onlineDDL, report)
+	}
+	return nil
+}
+
+// dryRunAlter checks an ALTER migration: foreign keys, the analysis vreplication runs on the original and the
+// migrated table, and the disk space needed to copy the table.
+func (e *Executor) dryRunAlter(ctx context.Context, conn *dbconnpool.DBConnection, onlineDDL *schema.OnlineDDL, report *dryRunReport) error {
+	{
+		query, err := sqlparser.ParseAndBind(sqlSelectCountForeignKeys,
+			sqltypes.StringBindVariable(e.dbName),
+			sqltypes.StringBindVariable(onlineDDL.Table),
+			sqltypes.StringBindVariable(e.dbName),
+			sqltypes.StringBindVariable(onlineDDL.Table),
+		)
+		if err != nil {
+			return err
+		}
+		rs, err := conn.ExecuteFetch(query, 1, true)
+		if err != nil {
+			return err
+		}
+		if row := rs.Named().Row(); row != nil && row.AsInt64("count_fk", 0) > 0 {
+			report.fail("table %s has or is referenced by foreign keys, which online DDL does not support", onlineDDL.Table)
+		}
+	}
+	{
+		query, err := sqlparser.ParseAndBind(sqlSelectTableSize,
+			sqltypes.StringBindVariable(e.dbName),
+			sqltypes.StringBindVariable(onlineDDL.Table),
+		)
+		if err != nil {
+			return err
+		}
+		rs, err := conn.ExecuteFetch(query, 1, true)
+		if err != nil {
+			return err
+		}
+		if row := rs.Named().Row(); row != nil {
+			report.tableRows = row.AsInt64("TABLE_ROWS", 0)
+			report.dataLength = row.AsInt64("DATA_LENGTH", 0)
+			report.indexLength = row.AsInt64("INDEX_LENGTH", 0)
+		}
+		// The migration copies the table in full, data and indexes, before the original table is dropped
+		report.estimatedDiskBytes = report.dataLength + report.indexLength
+	}
+	if freeDiskBytes, err := e.readDatadirFreeBytes(conn); err == nil {
+		report.freeDiskBytes = freeDiskBytes
+		if report.estimatedDiskBytes > freeDiskBytes {
+			report.fail("migration needs an estimated %d bytes of disk space, but only %d bytes are available", report.estimatedDiskBytes, freeDiskBytes)
+		}
+	}
+
+	// Apply the ALTER on an empty copy of the table, which we then analyze like a vreplication migration would.
+	shadowTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return err
+	}
+	{
+		parsed := sqlparser.BuildParsedQuery(sqlCreateTableLike, shadowTableName, onlineDDL.Table)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return err
+		}
+		defer func() {
+			parsed := sqlparser.BuildParsedQuery(sqlDropTable, shadowTableName)
+			_, _ = conn.ExecuteFetch(parsed.Query, 0, false)
+			// Nothing bad happens for not checking the error code. The table is GC/HOLD. If we
+			// can't drop it now, it still gets collected later by tablegc mechanism
+		}()
+	}
+	alterOptions := e.parseAlterOptions(ctx, onlineDDL)
+	{
+		parsed := sqlparser.BuildParsedQuery(sqlAlterTableOptions, shadowTableName, alterOptions)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			report.fail("%v", err)
+			return nil
+		}
+	}
+	v := NewVRepl(onlineDDL.UUID, e.keyspace, e.shard, e.dbName, onlineDDL.Table, shadowTableName, alterOptions)
+	if err := v.analyze(ctx, conn); err != nil {
+		report.fail("%v", err)
+		return nil
+	}
+
+	sourceUniqueKeys, err := v.getCandidateUniqueKeys(ctx, conn, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetUniqueKeys, err := v.getCandidateUniqueKeys(ctx, conn, v.targetTable)
+	if err != nil {
+		return err
+	}
+	sharedUniqueKeys, err := v.getSharedUniqueKeys(sourceUniqueKeys, targetUniqueKeys)
+	if err != nil {
+		return err
+	}
+	for _, uniqueKey := range sharedUniqueKeys {
+		report.sharedUniqueKeys = append(report.sharedUniqueKeys, uniqueKey.Name)
+	}
+	if len(sharedUniqueKeys) == 0 {
+		report.fail("found no shared unique key between `%s` and its migrated table", onlineDDL.Table)
+	}
+
+	sourceColumnTypes, err := e.readColumnTypes(ctx, conn, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetColumnTypes, err := e.readColumnTypes(ctx, conn, v.targetTable)
+	if err != nil {
+		return err
+	}
+	for _, sourceColumn := range v.sourceSharedColumns.Names() {
+		targetColumn := v.sharedColumnsMap[sourceColumn]
+		fromType, toType := sourceColumnTypes[strings.ToLower(sourceColumn)], targetColumnTypes[strings.ToLower(targetColumn)]
+		if isUnsupportedColumnTypeChange(fromType, toType) {
+			report.fail("column %s changes type from %s to %s, which is not supported", sourceColumn, fromType, toType)
+		}
+	}
+	return nil
+}
+
+// readColumnTypes returns the data types of a table's columns, mapped by lower case column name
+func (e *Executor) readColumnTypes(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) (map[string]string, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectColumnTypes,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := conn.ExecuteFetch(query, math.MaxInt64, true)
+	if err != nil {
+		return nil, err
+	}
+	columnTypes := map[string]string{}
+	for _, row := range rs.Named().Rows {
+		columnTypes[strings.ToLower(row.AsString("COLUMN_NAME", ""))] = row.AsString("DATA_TYPE", "")
+	}
+	return columnTypes, nil
+}
+
+// readDatadirFreeBytes returns the free disk space in MySQL's data directory. This assumes
+// MySQL runs on the same host as this tablet, which is the case for managed MySQL.
+func (e *Executor) readDatadirFreeBytes(conn *dbconnpool.DBConnection) (int64, error) {
+	rs, err := conn.ExecuteFetch(sqlSelectDatadir, 1, true)
+	if err != nil {
+		return 0, err
+	}
+	row := rs.Named().Row()
+	if row == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "unexpected result for @@datadir: %+v", rs.Rows)
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(row.AsString("datadir", ""), &stat); err != nil {
+		return 0, err
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
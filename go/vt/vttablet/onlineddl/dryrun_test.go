@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUnsupportedColumnTypeChange(t *testing.T) {
+	tt := []struct {
+		fromType    string
+		toType      string
+		unsupported bool
+	}{
+		{fromType: "int", toType: "int"},
+		{fromType: "int", toType: "bigint"},
+		{fromType: "varchar", toType: "text"},
+		{fromType: "json", toType: "JSON"},
+		{fromType: "json", toType: "text", unsupported: true},
+		{fromType: "text", toType: "json", unsupported: true},
+		{fromType: "point", toType: "geometry", unsupported: true},
+		{fromType: "blob", toType: "polygon", unsupported: true},
+	}
+	for _, ts := range tt {
+		t.Run(ts.fromType+"->"+ts.toType, func(t *testing.T) {
+			assert.Equal(t, ts.unsupported, isUnsupportedColumnTypeChange(ts.fromType, ts.toType))
+		})
+	}
+}
+
+func TestDryRunReportResult(t *testing.T) {
+	report := &dryRunReport{
+		table:              "t",
+		actionStr:          "alter",
+		strategy:           "online",
+		tableRows:          1000,
+		dataLength:         16384,
+		indexLength:        4096,
+		estimatedDiskBytes: 20480,
+		freeDiskBytes:      -1,
+		sharedUniqueKeys:   []string{"PRIMARY", "uk"},
+	}
+	row := report.toResult("ks", "-80").Named().Row()
+	require.NotNil(t, row)
+	assert.Equal(t, "ok", row.AsString("status", ""))
+	assert.Equal(t, "", row.AsString("message", ""))
+	assert.Equal(t, int64(20480), row.AsInt64("estimated_disk_bytes", 0))
+	assert.Equal(t, int64(-1), row.AsInt64("free_disk_bytes", 0))
+	assert.Equal(t, "PRIMARY,uk", row.AsString("shared_unique_keys", ""))
+
+	report.fail("table %s does not exist", "t")
+	report.fail("second failure")
+	row = report.toResult("ks", "-80").Named().Row()
+	assert.Equal(t, "error", row.AsString("status", ""))
+	assert.Equal(t, "table t does not exist; second failure", row.AsString("message", ""))
+}
@@ -48,6 +48,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -55,13 +56,11 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
-	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/golang/protobuf/proto"
-	"github.com/jmoiron/sqlx"
-	"github.com/skeema/tengo"
 )
 
 var (
@@ -77,6 +76,8 @@ var vexecUpdateTemplates = []string{
 	`update _vt.schema_migrations set migration_status='val' where mysql_schema='val'`,
 	`update _vt.schema_migrations set migration_status='val' where migration_uuid='val' and mysql_schema='val'`,
 	`update _vt.schema_migrations set migration_status='val' where migration_uuid='val' and mysql_schema='val' and shard='val'`,
+	`update _vt.schema_migrations set priority='val' where migration_uuid='val' and mysql_schema='val'`,
+	`update _vt.schema_migrations set priority='val' where migration_uuid='val' and mysql_schema='val' and shard='val'`,
 }
 
 var vexecInsertTemplates = []string{
@@ -105,17 +106,20 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
+var maxConcurrentOnlineDDLs = flag.Int("max_concurrent_online_ddl", 4, "Maximum number of online DDL migrations that may run concurrently on a tablet. Only vreplication migrations on distinct tables, submitted with -allow-concurrent, run concurrently")
 var migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
 
 const (
-	maxPasswordLength             = 32 // MySQL's *replication* password may not exceed 32 characters
-	staleMigrationMinutes         = 10
-	progressPctStarted    float64 = 0
-	progressPctFull       float64 = 100.0
-	etaSecondsUnknown             = -1
-	etaSecondsNow                 = 0
-	databasePoolSize              = 3
-	cutOverThreshold              = 3 * time.Second
+	maxPasswordLength                   = 32 // MySQL's *replication* password may not exceed 32 characters
+	staleMigrationMinutes               = 10
+	progressPctStarted          float64 = 0
+	progressPctFull             float64 = 100.0
+	etaSecondsUnknown                   = -1
+	etaSecondsNow                       = 0
+	databasePoolSize                    = 3
+	cutOverThreshold                    = 3 * time.Second
+	inPlaceAlterLockWaitTimeout         = 5 * time.Second
+	throttlerOnlineDDLApp               = "online-ddl"
 )
 
 var (
@@ -144,13 +148,14 @@ type Executor struct {
 	shard    string
 	dbName   string
 
-	initMutex             sync.Mutex
-	migrationMutex        sync.Mutex
-	vreplMigrationRunning int64
-	ghostMigrationRunning int64
-	ptoscMigrationRunning int64
-	lastMigrationUUID     string
-	tickReentranceFlag    int64
+	initMutex      sync.Mutex
+	migrationMutex sync.Mutex
+	// ownedRunningMigrations maps the UUIDs of migrations started by this executor to their *schema.OnlineDDL
+	ownedRunningMigrations sync.Map
+	ghostMigrationRunning  int64
+	ptoscMigrationRunning  int64
+	tickReentranceFlag     int64
+	throttlerClient        *throttle.Client
 
 	ticks             *timer.Timer
 	isOpen            bool
@@ -180,11 +185,13 @@ func newGCTableRetainTime() time.Time {
 }
 
 // NewExecutor creates a new gh-ost executor.
-func NewExecutor(env tabletenv.Env, tabletAlias topodatapb.TabletAlias, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Executor {
+func NewExecutor(env tabletenv.Env, tabletAlias topodatapb.TabletAlias, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType, lagThrottler *throttle.Throttler) *Executor {
 	return &Executor{
 		env:         env,
 		tabletAlias: &tabletAlias,
 
+		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerOnlineDDLApp, throttle.ThrottleCheckPrimaryWrite),
+
 		pool: connpool.NewPool(env, "OnlineDDLExecutorPool", tabletenv.ConnPoolConfig{
 			Size:               databasePoolSize,
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
@@ -290,7 +297,7 @@ func (e *Executor) triggerNextCheckInterval() {
 
 // isAnyMigrationRunning sees if there's any migration running right now
 func (e *Executor) isAnyMigrationRunning() bool {
-	if atomic.LoadInt64(&e.vreplMigrationRunning) > 0 {
+	if e.countOwnedRunningMigrations() > 0 {
 		return true
 	}
 	if atomic.LoadInt64(&e.ghostMigrationRunning) > 0 {
@@ -302,6 +309,67 @@ func (e *Executor) isAnyMigrationRunning() bool {
 	return false
 }
 
+// countOwnedRunningMigrations returns the number of migrations started by this executor and not yet known to be over
+func (e *Executor) countOwnedRunningMigrations() (count int) {
+	e.ownedRunningMigrations.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// isOwnedRunningMigration returns true when the given migration was started by this executor
+func (e *Executor) isOwnedRunningMigration(uuid string) bool {
+	_, ok := e.ownedRunningMigrations.Load(uuid)
+	return ok
+}
+
+// isConcurrentMigration returns true when the given migration may run alongside other migrations:
+// only vreplication ALTER migrations that were submitted with -allow-concurrent qualify
+func isConcurrentMigration(onlineDDL *schema.OnlineDDL) bool {
+	if onlineDDL.Strategy != schema.DDLStrategyOnline {
+		return false
+	}
+	if !onlineDDL.StrategySetting().IsAllowConcurrent() {
+		return false
+	}
+	ddlAction, err := onlineDDL.GetAction()
+	if err != nil {
+		return false
+	}
+	return ddlAction == sqlparser.AlterDDLAction
+}
+
+// isMigrationRunnable sees if the given migration may start, given the migrations already running:
+// either nothing else runs, or both the given migration and all running migrations are concurrent
+// migrations, on distinct tables, and below the concurrency limit.
+func (e *Executor) isMigrationRunnable(onlineDDL *schema.OnlineDDL) bool {
+	if atomic.LoadInt64(&e.ghostMigrationRunning) > 0 || atomic.LoadInt64(&e.ptoscMigrationRunning) > 0 {
+		return false
+	}
+	countRunning := 0
+	conflictFound := false
+	e.ownedRunningMigrations.Range(func(_, val interface{}) bool {
+		runningMigration, ok := val.(*schema.OnlineDDL)
+		if !ok || runningMigration.UUID == onlineDDL.UUID {
+			return true
+		}
+		countRunning++
+		if !isConcurrentMigration(runningMigration) || !isConcurrentMigration(onlineDDL) || runningMigration.Table == onlineDDL.Table {
+			conflictFound = true
+			return false
+		}
+		return true
+	})
+	if conflictFound {
+		return false
+	}
+	if countRunning > 0 && countRunning >= *maxConcurrentOnlineDDLs {
+		return false
+	}
+	return true
+}
+
 func (e *Executor) ghostPanicFlagFileName(uuid string) string {
 	return path.Join(os.TempDir(), fmt.Sprintf("ghost.%s.panic.flag", uuid))
 }
@@ -417,6 +485,19 @@ func (e *Executor) tableExists(ctx context.Context, tableName string) (bool, err
 	return (row != nil), nil
 }
 
+// showCreateTable reads the CREATE TABLE statement of the given table, as normalized by MySQL
+func (e *Executor) showCreateTable(conn *dbconnpool.DBConnection, tableName string) (*sqlparser.CreateTable, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, tableName)
+	rs, err := conn.ExecuteFetch(parsed.Query, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rs.Rows) != 1 || len(rs.Rows[0]) != 2 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for SHOW CREATE TABLE %s", tableName)
+	}
+	return schemadiff.ParseCreateTable(rs.Rows[0][1].ToString())
+}
+
 func (e *Executor) parseAlterOptions(ctx context.Context, onlineDDL *schema.OnlineDDL) string {
 	// Temporary hack (2020-08-11)
 	// Because sqlparser does not do full blown ALTER TABLE parsing,
@@ -661,7 +742,7 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 	// make sure there's no vreplication workflow running under same name
 	_ = e.terminateVReplMigration(ctx, onlineDDL.UUID)
 
-	if e.isAnyMigrationRunning() {
+	if !e.isMigrationRunnable(onlineDDL) {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -675,8 +756,7 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 	}
 	defer conn.Close()
 
-	atomic.StoreInt64(&e.vreplMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 	if err := e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown); err != nil {
 		return err
 	}
@@ -736,7 +816,7 @@ func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schem
 // Validation included testing the backend MySQL server and the gh-ost binary itself
 // Execution runs first a dry run, then an actual migration
 func (e *Executor) ExecuteWithGhost(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
-	if e.isAnyMigrationRunning() {
+	if !e.isMigrationRunnable(onlineDDL) {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -886,10 +966,11 @@ export ONLINE_DDL_PASSWORD
 	}
 
 	atomic.StoreInt64(&e.ghostMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
 		defer atomic.StoreInt64(&e.ghostMigrationRunning, 0)
+		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -927,7 +1008,7 @@ export ONLINE_DDL_PASSWORD
 // Validation included testing the backend MySQL server and the pt-online-schema-change binary itself
 // Execution runs first a dry run, then an actual migration
 func (e *Executor) ExecuteWithPTOSC(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
-	if e.isAnyMigrationRunning() {
+	if !e.isMigrationRunnable(onlineDDL) {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -1108,10 +1189,11 @@ export MYSQL_PWD
 	}
 
 	atomic.StoreInt64(&e.ptoscMigrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
 		defer atomic.StoreInt64(&e.ptoscMigrationRunning, 0)
+		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -1196,7 +1278,7 @@ func (e *Executor) readPendingMigrationsUUIDs(ctx context.Context) (uuids []stri
 }
 
 // terminateMigration attempts to interrupt and hard-stop a running migration
-func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, lastMigrationUUID string) (foundRunning bool, err error) {
+func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) (foundRunning bool, err error) {
 	switch onlineDDL.Strategy {
 	case schema.DDLStrategyOnline:
 		// migration could have started by a different tablet. We need to actively verify if it is running
@@ -1227,7 +1309,7 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 	case schema.DDLStrategyGhost:
 		if atomic.LoadInt64(&e.ghostMigrationRunning) > 0 {
 			// double check: is the running migration the very same one we wish to cancel?
-			if onlineDDL.UUID == lastMigrationUUID {
+			if e.isOwnedRunningMigration(onlineDDL.UUID) {
 				// assuming all goes well in next steps, we can already report that there has indeed been a migration
 				foundRunning = true
 			}
@@ -1264,7 +1346,7 @@ func (e *Executor) CancelMigration(ctx context.Context, uuid string, terminateRu
 	}
 
 	if terminateRunningMigration {
-		migrationFound, err := e.terminateMigration(ctx, onlineDDL, e.lastMigrationUUID)
+		migrationFound, err := e.terminateMigration(ctx, onlineDDL)
 		defer e.updateMigrationMessage(ctx, onlineDDL.UUID, message)
 
 		if migrationFound {
@@ -1314,13 +1396,17 @@ func (e *Executor) CancelPendingMigrations(ctx context.Context, message string)
 }
 
 // scheduleNextMigration attemps to schedule a single migration to run next.
-// possibly there's no migrations to run. Possibly there's a migration running right now,
-// in which cases nothing happens.
+// possibly there's no migrations to run. Possibly there's already a migration ready to run,
+// in which cases nothing happens. Whether a ready migration may run alongside migrations that
+// are already running is decided by runNextMigration.
+// Queued migrations are considered by descending priority, then by submission order. A migration
+// is skipped while the current time is outside its schedule window, or while the migration it
+// should run after is not yet complete.
 func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
+	if atomic.LoadInt64(&e.ghostMigrationRunning) > 0 || atomic.LoadInt64(&e.ptoscMigrationRunning) > 0 {
 		return ErrExecutorMigrationAlreadyRunning
 	}
 
@@ -1342,9 +1428,66 @@ func (e *Executor) scheduleNextMigration(ctx context.Context) error {
 		}
 	} // Cool, seems like no migration is ready. Let's try and make a single 'queued' migration 'ready'
 
-	_, err := e.execQuery(ctx, sqlScheduleSingleMigration)
+	r, err := e.execQuery(ctx, sqlSelectQueuedMigrations)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		isSchedulable, err := e.isQueuedMigrationSchedulable(ctx, uuid, row["run_after_migration"].ToString(), row["schedule_window"].ToString(), now)
+		if err != nil {
+			// A single migration's bookkeeping must not hold back the rest of the queue
+			log.Errorf("scheduleNextMigration: cannot check migration %s: %v", uuid, err)
+			continue
+		}
+		if !isSchedulable {
+			continue
+		}
+		query, err := sqlparser.ParseAndBind(sqlScheduleMigration,
+			sqltypes.StringBindVariable(uuid),
+		)
+		if err != nil {
+			return err
+		}
+		_, err = e.execQuery(ctx, query)
+		return err
+	}
+	return nil
+}
 
-	return err
+// isQueuedMigrationSchedulable checks the scheduling constraints of a queued migration: the given time must
+// fall within its schedule window, if any, and the migration it runs after, if any, must be complete.
+// While the migration it runs after is failed or cancelled, the migration stays queued, as that migration may be retried.
+func (e *Executor) isQueuedMigrationSchedulable(ctx context.Context, uuid string, runAfter string, scheduleWindow string, now time.Time) (bool, error) {
+	windows, err := schema.ParseScheduleWindows(scheduleWindow)
+	if err != nil {
+		// The window was validated on submission; this migration can never be scheduled
+		log.Errorf("migration %s: %v", uuid, err)
+		return false, nil
+	}
+	if !schema.IsInScheduleWindows(windows, now) {
+		return false, nil
+	}
+	if runAfter == "" {
+		return true, nil
+	}
+	runAfterMigration, _, err := e.readMigration(ctx, runAfter)
+	if err == ErrMigrationNotFound {
+		// The migration we depend on may not have been submitted on this shard yet
+		return false, e.updateMigrationMessage(ctx, uuid, fmt.Sprintf("waiting for migration %s, which is not submitted", runAfter))
+	}
+	if err != nil {
+		return false, err
+	}
+	switch runAfterMigration.Status {
+	case schema.OnlineDDLStatusComplete:
+		return true, nil
+	case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+		// The migration we depend on will only complete if retried. Until then, this one waits
+		return false, e.updateMigrationMessage(ctx, uuid, fmt.Sprintf("waiting for migration %s, which is %s; retry it, or cancel this migration", runAfter, runAfterMigration.Status))
+	}
+	return false, nil
 }
 
 func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigration *schema.OnlineDDL) (err error) {
@@ -1355,6 +1498,14 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	}
 	switch action {
 	case sqlparser.AlterDDLAction:
+		isView, err := e.isView(ctx, revertMigration.Table)
+		if err != nil {
+			return err
+		}
+		if isView {
+			// ALTER VIEW migrations run directly whatever the strategy, and are revertible
+			break
+		}
 		if revertMigration.Strategy != schema.DDLStrategyOnline {
 			return fmt.Errorf("can only revert a %s strategy migration. Migration %s has %s strategy", schema.DDLStrategyOnline, revertMigration.UUID, revertMigration.Strategy)
 		}
@@ -1497,6 +1648,19 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 		}
 	case sqlparser.AlterStr:
 		{
+			isView, err := e.isView(ctx, revertMigration.Table)
+			if err != nil {
+				return err
+			}
+			if isView {
+				if err := e.executeRevertAlterView(ctx, onlineDDL, revertMigration, row["artifacts"].ToString()); err != nil {
+					return err
+				}
+				return nil
+			}
+			if algorithm := row["ddl_algorithm"].ToString(); algorithm != "" {
+				return fmt.Errorf("cannot run migration %s reverting %s: migration was applied with algorithm=%s and left no table to revert to", onlineDDL.UUID, revertMigration.UUID, algorithm)
+			}
 			if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
 				return err
 			}
@@ -1512,120 +1676,33 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 }
 
 // evaluateDeclarativeDiff is called for -declarative CREATE statements, where the table already exists. The function generates a SQL diff, which can be:
-// - empty, in which case the migration is noop and implicitly successful, or
-// - non-empty, in which case the migration turns to be an ALTER
-func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alterClause string, err error) {
-
-	// Modify the CREATE TABLE statement to indicate a different, made up table name, known as the "comparison table"
+// - nil, in which case the migration is noop and implicitly successful, or
+// - an ALTER TABLE statement, in which case the migration turns to be an ALTER
+func (e *Executor) evaluateDeclarativeDiff(ctx context.Context, onlineDDL *schema.OnlineDDL) (alterTable *sqlparser.AlterTable, err error) {
 	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	comparisonTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
-	if err != nil {
-		return "", err
+	declaredCreateTable, ok := ddlStmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "expected CREATE TABLE in declarative migration %s: %s", onlineDDL.UUID, onlineDDL.SQL)
 	}
 
 	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer conn.Close()
 
-	{
-		// Create the comparison table
-		ddlStmt.SetTable("", comparisonTableName)
-		modifiedCreateSQL := sqlparser.String(ddlStmt)
-
-		if _, err := conn.ExecuteFetch(modifiedCreateSQL, 0, false); err != nil {
-			return "", err
-		}
-
-		defer func() {
-			// Drop the comparison table
-			parsed := sqlparser.BuildParsedQuery(sqlDropTable, comparisonTableName)
-			_, _ = conn.ExecuteFetch(parsed.Query, 0, false)
-			// Nothing bad happens for not checking the error code. The table is GC/HOLD. If we
-			// can't drop it now, it still gets collected later by tablegc mechanism
-		}()
-
-	}
-
-	// Compare the existing (to be potentially migrated) table with the declared (newly created) table:
-	// all things are tengo related
-	if err := func() error {
-		variables, err := e.readMySQLVariables(ctx)
-		if err != nil {
-			return err
-		}
-		flavor := tengo.ParseFlavor(variables.version, variables.versionComment)
-
-		// Create a temporary account for tengo to use
-		onlineDDLPassword, err := e.createOnlineDDLUser(ctx)
-		if err != nil {
-			return err
-		}
-		defer e.dropOnlineDDLUser(ctx)
-
-		// tengo requires sqlx.DB
-		cfg := mysqldriver.NewConfig()
-		cfg.User = onlineDDLUser
-		cfg.Passwd = onlineDDLPassword
-		cfg.Net = "tcp"
-		cfg.Addr = fmt.Sprintf("%s:%d", variables.host, variables.port)
-		cfg.DBName = e.dbName
-		cfg.ParseTime = true
-		cfg.InterpolateParams = true
-		cfg.Timeout = 1 * time.Second
-		mysqlDSN := cfg.FormatDSN()
-
-		db, err := sqlx.Open("mysql", mysqlDSN)
-		if err != nil {
-			return err
-		}
-		defer db.Close()
-
-		// Read existing table
-		existingTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, onlineDDL.Table, flavor)
-		if err != nil {
-			return err
-		}
-		// Read comparison table
-		comparisonTable, err := tengo.QuerySchemaTable(ctx, db, e.dbName, comparisonTableName, flavor)
-		if err != nil {
-			return err
-		}
-		// We created the comparison tablein same schema as original table, but under different name (because obviously we can't have
-		// two tables with identical name in same schema). It is our preference to create the table in the same schema.
-		// unfortunately, tengo does not allow comparing tables with different names. After asking tengo to read table info, we cheat
-		// and override the name of the table:
-		comparisonTable.Name = existingTable.Name
-		// We also override `.CreateStatement` (output of SHOW CREATE TABLE), because tengo has a validation, where if it doesn't
-		// find any ALTER changes, then the CreateStatement-s must be identical (or else it errors with UnsupportedDiffError)
-		comparisonTable.CreateStatement, err = schema.ReplaceTableNameInCreateTableStatement(comparisonTable.CreateStatement, existingTable.Name)
-		if err != nil {
-			return err
-		}
-		// Diff the two tables
-		diff := tengo.NewAlterTable(existingTable, comparisonTable)
-		if diff == nil {
-			// No change. alterClause remains empty
-			return nil
-		}
-		mods := tengo.StatementModifiers{
-			AllowUnsafe: true,
-			NextAutoInc: tengo.NextAutoIncIfIncreased,
-		}
-		alterClause, err = diff.Clauses(mods)
-		if err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return "", err
+	// Compare the existing (to be potentially migrated) table with the declared table. schemadiff
+	// normalizes the declared table the way MySQL would report it in SHOW CREATE TABLE
+	existingCreateTable, err := e.showCreateTable(conn, onlineDDL.Table)
+	if err != nil {
+		return nil, err
 	}
-
-	return alterClause, nil
+	hints := &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementApplyHigher}
+	// alterTable is nil when there is no change
+	return schemadiff.DiffTables(existingCreateTable, declaredCreateTable, hints)
 }
 
 // executeMigration executes a single migration. It analyzes the migration type:
@@ -1678,38 +1755,46 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			// - ALTER the table, if it exists and is different, or
 			// - Implicitly do nothing, if the table exists and is identical to CREATE statement
 
-			{
-				// Sanity: reject IF NOT EXISTS statements, because they don't make sense (or are ambiguous) in declarative mode
-				ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
-				if err != nil {
-					return failMigration(err)
-				}
-				if ddlStmt.GetIfNotExists() {
-					return failMigration(vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "strategy is declarative. IF NOT EXISTS does not work in declarative mode for migration %v", onlineDDL.UUID))
-				}
+			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+			if err != nil {
+				return failMigration(err)
+			}
+			// Sanity: reject IF NOT EXISTS statements, because they don't make sense (or are ambiguous) in declarative mode
+			if ddlStmt.GetIfNotExists() {
+				return failMigration(vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "strategy is declarative. IF NOT EXISTS does not work in declarative mode for migration %v", onlineDDL.UUID))
 			}
 			exists, err := e.tableExists(ctx, onlineDDL.Table)
 			if err != nil {
 				return failMigration(err)
 			}
 			if exists {
-				alterClause, err := e.evaluateDeclarativeDiff(ctx, onlineDDL)
+				if createView, ok := ddlStmt.(*sqlparser.CreateView); ok {
+					// A view holds no data. Its definition is replaced as a whole, so we convert this migration into an ALTER VIEW
+					if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+						return failMigration(err)
+					}
+					ddlAction = sqlparser.AlterDDLAction
+					onlineDDL.SQL = sqlparser.String(alterViewFromCreateView(createView))
+					_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, onlineDDL.SQL)
+					break
+				}
+				alterTable, err := e.evaluateDeclarativeDiff(ctx, onlineDDL)
 				if err != nil {
 					return failMigration(err)
 				}
-				if alterClause == "" {
+				if alterTable == nil {
 					// No diff! We mark this CREATE as implicitly sucessful
 					_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow)
 					_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, "no change")
 					return nil
 				}
-				// alterClause is non empty. We convert this migration into an ALTER
+				// alterTable is non empty. We convert this migration into an ALTER
 				if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
 					return failMigration(err)
 				}
 				ddlAction = sqlparser.AlterDDLAction
-				onlineDDL.SQL = fmt.Sprintf("ALTER TABLE `%s` %s", onlineDDL.Table, alterClause)
-				_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, alterClause)
+				onlineDDL.SQL = sqlparser.String(alterTable)
+				_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, onlineDDL.SQL)
 			} else {
 				{
 					// table does not exist, so this declarative CREATE turns out to really be an actual CREATE. No further action is needed here.
@@ -1775,6 +1860,23 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			e.migrationMutex.Lock()
 			defer e.migrationMutex.Unlock()
 
+			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+			if err != nil {
+				return failMigration(err)
+			}
+			if createView, ok := ddlStmt.(*sqlparser.CreateView); ok && createView.IsReplace {
+				// CREATE OR REPLACE VIEW on an existing view is effectively an ALTER VIEW
+				isView, err := e.isView(ctx, onlineDDL.Table)
+				if err != nil {
+					return failMigration(err)
+				}
+				if isView {
+					if err := e.executeAlterView(ctx, onlineDDL); err != nil {
+						return failMigration(err)
+					}
+					return nil
+				}
+			}
 			sentryArtifactTableName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
 			if err != nil {
 				return failMigration(err)
@@ -1784,10 +1886,6 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			if err := e.updateArtifacts(ctx, onlineDDL.UUID, sentryArtifactTableName); err != nil {
 				return err
 			}
-			ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
-			if err != nil {
-				return failMigration(err)
-			}
 			if ddlStmt.GetIfNotExists() {
 				// This is a CREATE TABLE IF NOT EXISTS
 				// We want to know if the table actually exists before running this migration.
@@ -1810,12 +1908,39 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			return nil
 		}()
 	case sqlparser.AlterDDLAction:
+		ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+		if err != nil {
+			return failMigration(err)
+		}
+		if _, ok := ddlStmt.(*sqlparser.AlterView); ok {
+			// Views hold no data. An ALTER VIEW runs directly, whatever the strategy
+			go func() {
+				e.migrationMutex.Lock()
+				defer e.migrationMutex.Unlock()
+
+				if err := e.executeAlterView(ctx, onlineDDL); err != nil {
+					failMigration(err)
+				}
+			}()
+			return nil
+		}
 		switch onlineDDL.Strategy {
 		case schema.DDLStrategyOnline:
 			go func() {
 				e.migrationMutex.Lock()
 				defer e.migrationMutex.Unlock()
 
+				if onlineDDL.StrategySetting().IsPreferInstantDDL() {
+					// See if MySQL can apply this change without copying the table
+					applied, err := e.executeInPlaceAlter(ctx, onlineDDL)
+					if err != nil {
+						failMigration(err)
+						return
+					}
+					if applied {
+						return
+					}
+				}
 				if err := e.ExecuteWithVReplication(ctx, onlineDDL, nil); err != nil {
 					failMigration(err)
 				}
@@ -1860,10 +1985,6 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if e.isAnyMigrationRunning() {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
 	r, err := e.execQuery(ctx, sqlSelectReadyMigration)
 	if err != nil {
 		return err
@@ -1888,6 +2009,19 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 				onlineDDL.SQL = sqlparser.String(ddlStmt)
 			}
 		}
+		if e.isOwnedRunningMigration(onlineDDL.UUID) {
+			// already started by this executor, and just not marked as 'running' yet
+			return nil
+		}
+		if !e.isMigrationRunnable(onlineDDL) {
+			return ErrExecutorMigrationAlreadyRunning
+		}
+		if e.isAnyMigrationRunning() && !e.throttlerClient.ThrottleCheckOK(ctx) {
+			// Concurrent migrations share the tablet throttler. We do not add yet another
+			// migration while the throttler pushes back.
+			return nil
+		}
+		e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 		e.executeMigration(ctx, onlineDDL)
 		// the query should only ever return a single row at the most
 		// but let's make it also explicit here that we only run a single migration
@@ -2078,8 +2212,10 @@ func (e *Executor) isVReplMigrationRunning(ctx context.Context, uuid string) (is
 	return false, s, nil
 }
 
-// reviewRunningMigrations iterates migrations in 'running' state. Normally there's only one running, which was
-// spawned by this tablet; but vreplication migrations could also resume from failure.
+// reviewRunningMigrations iterates migrations in 'running' state. These are normally migrations spawned by this
+// tablet, either a single one or multiple concurrent vreplication migrations; but vreplication migrations could
+// also resume from failure.
+// Migrations that are ready are cut over one at a time: cut-overs run under migrationMutex, each to completion.
 func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning int, cancellable []string, err error) {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
@@ -2088,11 +2224,12 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	if err != nil {
 		return countRunnning, cancellable, err
 	}
-	// we identify running vreplication migrations in this function
-	atomic.StoreInt64(&e.vreplMigrationRunning, 0)
+	runningUUIDs := map[string]bool{}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
+		runningUUIDs[uuid] = true
 		strategy := schema.DDLStrategy(row["strategy"].ToString())
+		postponeCompletion := row.AsBool("postpone_completion", false)
 		switch strategy {
 		case schema.DDLStrategyOnline:
 			{
@@ -2102,18 +2239,18 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 					return countRunnning, cancellable, err
 				}
 				if running {
-					// This VRepl migration may have started from outside this tablet, so
-					// vreplMigrationRunning could be zero. Whatever the case is, we're under
-					// migrationMutex lock and it's now safe to ensure vreplMigrationRunning is 1
-					atomic.StoreInt64(&e.vreplMigrationRunning, 1)
 					_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
 					isReady, err := e.isVReplMigrationReadyToCutOver(ctx, s)
 					if err != nil {
 						return countRunnning, cancellable, err
 					}
-					if isReady {
+					_ = e.updateMigrationReadyToComplete(ctx, uuid, isReady)
+					// A migration with postponed completion keeps running and stays in sync with the
+					// original table, until the user explicitly completes it.
+					if isReady && !postponeCompletion {
 						if err := e.cutOverVReplMigration(ctx, s); err != nil {
-							return countRunnning, cancellable, err
+							// a failed cut-over is retried on next review; other running migrations are still reviewed
+							log.Errorf("Executor.reviewRunningMigrations: cut-over failed for migration %s: %v", uuid, err)
 						}
 					}
 				}
@@ -2129,25 +2266,35 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 				if running {
 					_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
 				}
-				if uuid != e.lastMigrationUUID {
-					// This executor can only spawn one migration at a time. And that
-					// migration is identified by e.lastMigrationUUID.
-					// If we find a _running_ migration that does not have this UUID, it _must_
-					// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
-					cancellable = append(cancellable, uuid)
-				}
 			}
 		}
 		countRunnning++
 
-		if uuid != e.lastMigrationUUID {
-			// This executor can only run one migration at a time. And that
-			// migration is identified by e.lastMigrationUUID.
-			// If we find a _running_ migration that does not have this UUID, it _must_
+		if !e.isOwnedRunningMigration(uuid) {
+			// This executor keeps track of all migrations it has started.
+			// If we find a _running_ migration that this executor does not own, it _must_
 			// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
 			cancellable = append(cancellable, uuid)
 		}
 	}
+	// Owned migrations that are no longer running have either completed, failed or been cancelled.
+	e.ownedRunningMigrations.Range(func(key, _ interface{}) bool {
+		uuid, _ := key.(string)
+		if runningUUIDs[uuid] {
+			return true
+		}
+		onlineDDL, _, err := e.readMigration(ctx, uuid)
+		switch {
+		case err == ErrMigrationNotFound:
+			e.ownedRunningMigrations.Delete(uuid)
+		case err != nil:
+		case onlineDDL.Status == schema.OnlineDDLStatusComplete,
+			onlineDDL.Status == schema.OnlineDDLStatusFailed,
+			onlineDDL.Status == schema.OnlineDDLStatusCancelled:
+			e.ownedRunningMigrations.Delete(uuid)
+		}
+		return true
+	})
 	return countRunnning, cancellable, err
 }
 
@@ -2459,6 +2606,30 @@ func (e *Executor) updateETASeconds(ctx context.Context, uuid string, etaSeconds
 	return err
 }
 
+func (e *Executor) updateMigrationReadyToComplete(ctx context.Context, uuid string, isReady bool) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationReadyToComplete,
+		sqltypes.BoolBindVariable(isReady),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationDDLAlgorithm(ctx context.Context, uuid string, algorithm string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationDDLAlgorithm,
+		sqltypes.StringBindVariable(algorithm),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationProgress(ctx context.Context, uuid string, progress float64) error {
 	if progress <= 0 {
 		// progress starts at 0, and can only increase.
@@ -2511,6 +2682,45 @@ func (e *Executor) RetryMigration(ctx context.Context, uuid string) (result *sql
 	return e.execQuery(ctx, query)
 }
 
+// CompleteMigration clears the postpone_completion flag of a given migration, so that it may
+// cut over once ready
+func (e *Executor) CompleteMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in COMPLETE: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	query, err := sqlparser.ParseAndBind(sqlUpdateCompleteMigration,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer e.triggerNextCheckInterval()
+	return e.execQuery(ctx, query)
+}
+
+// PriorityMigration sets the priority of a given queued migration. Queued migrations with higher
+// priority are scheduled first.
+func (e *Executor) PriorityMigration(ctx context.Context, uuid string, priority int64) (result *sqltypes.Result, err error) {
+	if !schema.IsOnlineDDLUUID(uuid) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "Not a valid migration ID in PRIORITY: %s", uuid)
+	}
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationPriority,
+		sqltypes.Int64BindVariable(priority),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer e.triggerNextCheckInterval()
+	return e.execQuery(ctx, query)
+}
+
 // SubmitMigration inserts a new migration request
 func (e *Executor) SubmitMigration(
 	ctx context.Context,
@@ -2525,6 +2735,10 @@ func (e *Executor) SubmitMigration(
 	if err != nil {
 		return nil, err
 	}
+	priority, err := onlineDDL.StrategySetting().Priority()
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Error submitting migration %s: %v", sqlparser.String(stmt), err)
+	}
 
 	query, err := sqlparser.ParseAndBind(sqlInsertMigration,
 		sqltypes.StringBindVariable(onlineDDL.UUID),
@@ -2539,6 +2753,10 @@ func (e *Executor) SubmitMigration(
 		sqltypes.StringBindVariable(onlineDDL.RequestContext),
 		sqltypes.StringBindVariable(string(schema.OnlineDDLStatusQueued)),
 		sqltypes.StringBindVariable(e.TabletAliasString()),
+		sqltypes.BoolBindVariable(onlineDDL.StrategySetting().IsPostponeCompletion()),
+		sqltypes.Int64BindVariable(priority),
+		sqltypes.StringBindVariable(onlineDDL.StrategySetting().RunAfter()),
+		sqltypes.StringBindVariable(onlineDDL.StrategySetting().ScheduleWindow()),
 	)
 	if err != nil {
 		return nil, err
@@ -2682,6 +2900,26 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 		vx.ReplaceInsertColumnVal("shard", vx.ToStringVal(e.shard))
 		vx.ReplaceInsertColumnVal("mysql_schema", vx.ToStringVal(e.dbName))
 		vx.AddOrReplaceInsertColumnVal("tablet", vx.ToStringVal(e.TabletAliasString()))
+		// Flags derived from strategy options have their own columns
+		strategy, _ := vx.ColumnStringVal(vx.InsertCols, "strategy")
+		options, _ := vx.ColumnStringVal(vx.InsertCols, "options")
+		strategySetting := schema.NewDDLStrategySetting(schema.DDLStrategy(strategy), options)
+		if strategySetting.IsPostponeCompletion() {
+			vx.AddOrReplaceInsertColumnVal("postpone_completion", sqlparser.NewIntLiteral("1"))
+		}
+		priority, err := strategySetting.Priority()
+		if err != nil {
+			return nil, err
+		}
+		if priority != 0 {
+			vx.AddOrReplaceInsertColumnVal("priority", sqlparser.NewIntLiteral(fmt.Sprintf("%d", priority)))
+		}
+		if runAfter := strategySetting.RunAfter(); runAfter != "" {
+			vx.AddOrReplaceInsertColumnVal("run_after_migration", vx.ToStringVal(runAfter))
+		}
+		if scheduleWindow := strategySetting.ScheduleWindow(); scheduleWindow != "" {
+			vx.AddOrReplaceInsertColumnVal("schedule_window", vx.ToStringVal(scheduleWindow))
+		}
 		e.triggerNextCheckInterval()
 		return response(e.execQuery(ctx, vx.Query))
 	case *sqlparser.Update:
@@ -2699,6 +2937,17 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return sqltypes.ResultToProto3(emptyResult), nil
 			}
 		}
+		if priorityVal, err := vx.ColumnStringVal(vx.UpdateCols, "priority"); err == nil {
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			priority, err := strconv.ParseInt(priorityVal, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid priority: %s", priorityVal)
+			}
+			return response(e.PriorityMigration(ctx, uuid, priority))
+		}
 		statusVal, err := vx.ColumnStringVal(vx.UpdateCols, "migration_status")
 		if err != nil {
 			return nil, err
@@ -2721,9 +2970,18 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, fmt.Errorf("Unexpetced UUID: %s", uuid)
 			}
 			return response(e.CancelPendingMigrations(ctx, "cancel-all by user"))
+		case completeMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.CompleteMigration(ctx, uuid))
 		default:
-			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s",
-				statusVal, retryMigrationHint, cancelMigrationHint)
+			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s, %s",
+				statusVal, retryMigrationHint, cancelMigrationHint, completeMigrationHint)
 		}
 	default:
 		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
@@ -19,3 +19,55 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/schema"
+)
+
+func TestIsMigrationRunnable(t *testing.T) {
+	newMigration := func(uuid, table, strategy, options, sql string) *schema.OnlineDDL {
+		return &schema.OnlineDDL{
+			UUID:     uuid,
+			Table:    table,
+			Strategy: schema.DDLStrategy(strategy),
+			Options:  options,
+			SQL:      sql,
+		}
+	}
+	concurrent1 := newMigration("1", "t1", "online", "-allow-concurrent", "alter table t1 engine=innodb")
+	concurrent2 := newMigration("2", "t2", "online", "-allow-concurrent", "alter table t2 engine=innodb")
+	concurrent3 := newMigration("3", "t3", "online", "-allow-concurrent", "alter table t3 engine=innodb")
+	sameTable := newMigration("4", "t1", "online", "-allow-concurrent", "alter table t1 add column i int")
+	nonConcurrent := newMigration("5", "t5", "online", "", "alter table t5 engine=innodb")
+	ghost := newMigration("6", "t6", "gh-ost", "-allow-concurrent", "alter table t6 engine=innodb")
+	drop := newMigration("7", "t7", "online", "-allow-concurrent", "drop table t7")
+
+	defer func(max int) { *maxConcurrentOnlineDDLs = max }(*maxConcurrentOnlineDDLs)
+	*maxConcurrentOnlineDDLs = 2
+
+	e := &Executor{}
+	assert.True(t, e.isMigrationRunnable(nonConcurrent))
+	assert.True(t, e.isMigrationRunnable(concurrent1))
+
+	e.ownedRunningMigrations.Store(concurrent1.UUID, concurrent1)
+	assert.True(t, e.isMigrationRunnable(concurrent1))
+	assert.True(t, e.isMigrationRunnable(concurrent2))
+	assert.False(t, e.isMigrationRunnable(sameTable))
+	assert.False(t, e.isMigrationRunnable(nonConcurrent))
+	assert.False(t, e.isMigrationRunnable(ghost))
+	assert.False(t, e.isMigrationRunnable(drop))
+
+	e.ownedRunningMigrations.Store(concurrent2.UUID, concurrent2)
+	assert.False(t, e.isMigrationRunnable(concurrent3))
+
+	e.ownedRunningMigrations.Delete(concurrent1.UUID)
+	assert.True(t, e.isMigrationRunnable(concurrent3))
+
+	e.ownedRunningMigrations.Delete(concurrent2.UUID)
+	e.ownedRunningMigrations.Store(nonConcurrent.UUID, nonConcurrent)
+	assert.False(t, e.isMigrationRunnable(concurrent1))
+}
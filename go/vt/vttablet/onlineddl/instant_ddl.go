@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	ddlAlgorithmInstant = "instant"
+	ddlAlgorithmInplace = "inplace"
+)
+
+var mysqlVersionRegexp = regexp.MustCompile(`^([0-9]+)[.]([0-9]+)[.]([0-9]+)`)
+
+// isInstantDDLCapable returns true when the given MySQL version supports ALGORITHM=INSTANT, which
+// was introduced in MySQL 8.0.12
+func isInstantDDLCapable(version string) bool {
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		return false
+	}
+	submatch := mysqlVersionRegexp.FindStringSubmatch(version)
+	if submatch == nil {
+		return false
+	}
+	var versionNumbers [3]int
+	for i := range versionNumbers {
+		versionNumbers[i], _ = strconv.Atoi(submatch[i+1])
+	}
+	switch {
+	case versionNumbers[0] != 8:
+		return versionNumbers[0] > 8
+	case versionNumbers[1] != 0:
+		return versionNumbers[1] > 0
+	default:
+		return versionNumbers[2] >= 12
+	}
+}
+
+// analyzeInPlaceAlter returns the algorithm by which MySQL can apply the given ALTER TABLE onto the given
+// table as a metadata-only change, without copying the table: "instant" or "inplace". It returns an empty
+// string when the ALTER requires a table copy, or when we can't tell.
+// The analysis is conservative. The returned algorithm is explicitly requested when running the ALTER, so
+// that MySQL rejects, rather than silently copies, any change it can't apply by that algorithm.
+func analyzeInPlaceAlter(createTable *sqlparser.CreateTable, alterTable *sqlparser.AlterTable, instantDDLCapable bool) string {
+	if len(alterTable.AlterOptions) == 0 || alterTable.PartitionSpec != nil || alterTable.PartitionOption != nil {
+		return ""
+	}
+	instantAddColumnSupported := instantDDLCapable
+	for _, index := range createTable.TableSpec.Indexes {
+		if index.Info.Fulltext {
+			// A table with a FULLTEXT index does not support instant ADD COLUMN
+			instantAddColumnSupported = false
+		}
+	}
+	for _, option := range createTable.TableSpec.Options {
+		if strings.EqualFold(option.Name, "ROW_FORMAT") && strings.EqualFold(option.String, "COMPRESSED") {
+			// Neither does a compressed table
+			instantAddColumnSupported = false
+		}
+	}
+	algorithm := ddlAlgorithmInplace
+	if instantDDLCapable {
+		// MySQL 8.0 applies all of the below metadata changes instantly
+		algorithm = ddlAlgorithmInstant
+	}
+	for _, alterOption := range alterTable.AlterOptions {
+		switch alterOption := alterOption.(type) {
+		case *sqlparser.AlterColumn:
+			// SET DEFAULT, DROP DEFAULT
+		case *sqlparser.RenameIndex:
+		case *sqlparser.AddColumns:
+			if !instantAddColumnSupported {
+				return ""
+			}
+			if alterOption.First != nil || alterOption.After != nil {
+				// Only supported when adding as last column
+				return ""
+			}
+			for _, column := range alterOption.Columns {
+				if column.Type.Options != nil && column.Type.Options.Autoincrement {
+					return ""
+				}
+			}
+		default:
+			// Including explicit ALGORITHM and LOCK options, which we respect
+			return ""
+		}
+	}
+	return algorithm
+}
+
+// executeInPlaceAlter attempts to apply an ALTER migration directly on the table, without a table copy, by
+// means of ALGORITHM=INSTANT or ALGORITHM=INPLACE. It returns true when the migration has been so applied,
+// and false when the migration must run via vreplication.
+func (e *Executor) executeInPlaceAlter(ctx context.Context, onlineDDL *schema.OnlineDDL) (applied bool, err error) {
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return false, err
+	}
+	alterTable, ok := ddlStmt.(*sqlparser.AlterTable)
+	if !ok {
+		return false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "expected ALTER TABLE statement in migration %s, got: %s", onlineDDL.UUID, onlineDDL.SQL)
+	}
+	variables, err := e.readMySQLVariables(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	createTable, err := e.showCreateTable(conn, onlineDDL.Table)
+	if err != nil {
+		return false, err
+	}
+	algorithm := analyzeInPlaceAlter(createTable, alterTable, isInstantDDLCapable(variables.version))
+	if algorithm == "" {
+		return false, nil
+	}
+
+	alterTable = sqlparser.CloneRefOfAlterTable(alterTable)
+	alterTable.SetComments(nil)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, sqlparser.AlgorithmValue(algorithm))
+	query := sqlparser.String(alterTable)
+
+	// The ALTER waits on a metadata lock held by any open transaction on the table. Keep that wait short,
+	// as we hold migrationMutex, and run the migration via vreplication if the lock can't be had.
+	lockWaitTimeoutQuery, err := sqlparser.ParseAndBind(sqlSetLockWaitTimeout,
+		sqltypes.Int64BindVariable(int64(inPlaceAlterLockWaitTimeout.Seconds())),
+	)
+	if err != nil {
+		return false, err
+	}
+	if _, err := conn.ExecuteFetch(lockWaitTimeoutQuery, 0, false); err != nil {
+		return false, err
+	}
+
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown)
+	if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+		if merr, ok := err.(*mysql.SQLError); ok {
+			switch merr.Num {
+			case mysql.ERAlterOperationNotSupported, mysql.ERAlterOperationNotSupportedReason:
+				// MySQL can't apply the change with the requested algorithm. We fall back to vreplication.
+				log.Infof("migration %s: cannot apply with algorithm=%s, falling back to vreplication: %v", onlineDDL.UUID, algorithm, err)
+				return false, nil
+			case mysql.ERLockWaitTimeout:
+				// The table is busy. We fall back to vreplication, which does not need a long metadata lock.
+				log.Infof("migration %s: timed out waiting for metadata lock with algorithm=%s, falling back to vreplication: %v", onlineDDL.UUID, algorithm, err)
+				return false, nil
+			}
+		}
+		return false, err
+	}
+	if err := e.updateMigrationDDLAlgorithm(ctx, onlineDDL.UUID, algorithm); err != nil {
+		return true, err
+	}
+	_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, fmt.Sprintf("applied with algorithm=%s", algorithm))
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow)
+	return true, nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestIsInstantDDLCapable(t *testing.T) {
+	tt := []struct {
+		version string
+		capable bool
+	}{
+		{version: "5.7.30-log"},
+		{version: "8.0.11"},
+		{version: "8.0.12", capable: true},
+		{version: "8.0.23-14", capable: true},
+		{version: "8.1.0", capable: true},
+		{version: "10.5.9-MariaDB-log"},
+		{version: "unknown"},
+	}
+	for _, ts := range tt {
+		t.Run(ts.version, func(t *testing.T) {
+			assert.Equal(t, ts.capable, isInstantDDLCapable(ts.version))
+		})
+	}
+}
+
+func TestAnalyzeInPlaceAlter(t *testing.T) {
+	tt := []struct {
+		name      string
+		create    string
+		alter     string
+		capable   bool
+		algorithm string
+	}{
+		{
+			name:      "add column at end",
+			alter:     "alter table t add column i int",
+			capable:   true,
+			algorithm: ddlAlgorithmInstant,
+		},
+		{
+			name:  "add column at end, no instant DDL",
+			alter: "alter table t add column i int",
+		},
+		{
+			name:    "add column first",
+			alter:   "alter table t add column i int first",
+			capable: true,
+		},
+		{
+			name:    "add column after",
+			alter:   "alter table t add column i int after id",
+			capable: true,
+		},
+		{
+			name:    "add auto_increment column",
+			alter:   "alter table t add column i int auto_increment",
+			capable: true,
+		},
+		{
+			name:    "add column, fulltext index",
+			create:  "create table t (id int primary key, v varchar(32), fulltext key v_idx (v))",
+			alter:   "alter table t add column i int",
+			capable: true,
+		},
+		{
+			name:    "add column, compressed",
+			create:  "create table t (id int primary key, v varchar(32)) row_format=compressed",
+			alter:   "alter table t add column i int",
+			capable: true,
+		},
+		{
+			name:      "set default",
+			alter:     "alter table t alter column v set default 'x'",
+			capable:   true,
+			algorithm: ddlAlgorithmInstant,
+		},
+		{
+			name:      "drop default, no instant DDL",
+			alter:     "alter table t alter column v drop default",
+			algorithm: ddlAlgorithmInplace,
+		},
+		{
+			name:      "rename index, no instant DDL",
+			alter:     "alter table t rename index v_idx to v_idx2",
+			algorithm: ddlAlgorithmInplace,
+		},
+		{
+			name:      "multiple changes",
+			alter:     "alter table t alter column v set default 'x', rename index v_idx to v_idx2, add column i int",
+			capable:   true,
+			algorithm: ddlAlgorithmInstant,
+		},
+		{
+			name:    "copy",
+			alter:   "alter table t modify column v varchar(64)",
+			capable: true,
+		},
+		{
+			name:    "mixed with copy",
+			alter:   "alter table t add column i int, drop column v",
+			capable: true,
+		},
+		{
+			name:    "explicit algorithm",
+			alter:   "alter table t add column i int, algorithm=copy",
+			capable: true,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			if ts.create == "" {
+				ts.create = "create table t (id int primary key, v varchar(32), key v_idx (v))"
+			}
+			createStmt, err := sqlparser.Parse(ts.create)
+			require.NoError(t, err)
+			createTable, ok := createStmt.(*sqlparser.CreateTable)
+			require.True(t, ok)
+			alterStmt, err := sqlparser.Parse(ts.alter)
+			require.NoError(t, err)
+			alterTable, ok := alterStmt.(*sqlparser.AlterTable)
+			require.True(t, ok)
+
+			assert.Equal(t, ts.algorithm, analyzeInPlaceAlter(createTable, alterTable, ts.capable))
+		})
+	}
+}
@@ -56,6 +56,12 @@ const (
 	alterSchemaMigrationsTableMessage            = "ALTER TABLE _vt.schema_migrations add column message TEXT NOT NULL"
 	alterSchemaMigrationsTableTableCompleteIndex = "ALTER TABLE _vt.schema_migrations add KEY table_complete_idx (migration_status, keyspace(64), mysql_table(64), completed_timestamp)"
 	alterSchemaMigrationsTableETASeconds         = "ALTER TABLE _vt.schema_migrations add column eta_seconds bigint NOT NULL DEFAULT -1"
+	alterSchemaMigrationsTablePostponeCompletion = "ALTER TABLE _vt.schema_migrations add column postpone_completion tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableReadyToComplete    = "ALTER TABLE _vt.schema_migrations add column ready_to_complete tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableDDLAlgorithm       = "ALTER TABLE _vt.schema_migrations add column ddl_algorithm varchar(16) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTablePriority           = "ALTER TABLE _vt.schema_migrations add column priority bigint NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableRunAfter           = "ALTER TABLE _vt.schema_migrations add column run_after_migration varchar(64) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableScheduleWindow     = "ALTER TABLE _vt.schema_migrations add column schedule_window varchar(256) NOT NULL DEFAULT ''"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -70,20 +76,22 @@ const (
 		requested_timestamp,
 		migration_context,
 		migration_status,
-		tablet
+		tablet,
+		postpone_completion,
+		priority,
+		run_after_migration,
+		schedule_window
 	) VALUES (
-		%a, %a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(NOW()), %a, %a, %a
+		%a, %a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(NOW()), %a, %a, %a, %a, %a, %a, %a
 	)`
 
-	sqlScheduleSingleMigration = `UPDATE _vt.schema_migrations
+	sqlScheduleMigration = `UPDATE _vt.schema_migrations
 		SET
 			migration_status='ready',
 			ready_timestamp=NOW()
 		WHERE
 			migration_status='queued'
-		ORDER BY
-			requested_timestamp ASC
-		LIMIT 1
+			AND migration_uuid=%a
 	`
 	sqlUpdateMySQLTable = `UPDATE _vt.schema_migrations
 			SET mysql_table=%a
@@ -105,6 +113,16 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateMigrationReadyToComplete = `UPDATE _vt.schema_migrations
+			SET ready_to_complete=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationDDLAlgorithm = `UPDATE _vt.schema_migrations
+			SET ddl_algorithm=%a
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMigrationStartedTimestamp = `UPDATE _vt.schema_migrations
 			SET started_timestamp=IFNULL(started_timestamp, NOW())
 		WHERE
@@ -145,6 +163,17 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateCompleteMigration = `UPDATE _vt.schema_migrations
+			SET postpone_completion=0
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationPriority = `UPDATE _vt.schema_migrations
+			SET priority=%a
+		WHERE
+			migration_status='queued'
+			AND migration_uuid=%a
+	`
 	sqlRetryMigrationWhere = `UPDATE _vt.schema_migrations
 		SET
 			migration_status='queued',
@@ -183,7 +212,8 @@ const (
 	`
 	sqlSelectRunningMigrations = `SELECT
 			migration_uuid,
-			strategy
+			strategy,
+			postpone_completion
 		FROM _vt.schema_migrations
 		WHERE
 			migration_status='running'
@@ -206,6 +236,18 @@ const (
 		WHERE
 			migration_status='ready'
 	`
+	sqlSelectQueuedMigrations = `SELECT
+			migration_uuid,
+			run_after_migration,
+			schedule_window
+		FROM _vt.schema_migrations
+		WHERE
+			migration_status='queued'
+		ORDER BY
+			priority DESC,
+			requested_timestamp ASC,
+			id ASC
+	`
 	sqlSelectStaleMigrations = `SELECT
 			migration_uuid
 		FROM _vt.schema_migrations
@@ -249,7 +291,8 @@ const (
 			ddl_action,
 			artifacts,
 			tablet,
-			migration_context
+			migration_context,
+			ddl_algorithm
 		FROM _vt.schema_migrations
 		WHERE
 			migration_uuid=%a
@@ -297,10 +340,76 @@ const (
 	sqlDropTable         = "DROP TABLE `%a`"
 	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
 	sqlShowColumnsFrom   = "SHOW COLUMNS FROM `%a`"
-	sqlStartVReplStream  = "UPDATE _vt.vreplication set state='Running' where db_name=%a and workflow=%a"
-	sqlStopVReplStream   = "UPDATE _vt.vreplication set state='Stopped' where db_name=%a and workflow=%a"
-	sqlDeleteVReplStream = "DELETE FROM _vt.vreplication where db_name=%a and workflow=%a"
-	sqlReadVReplStream   = `SELECT
+	sqlSelectUniqueKeys  = `SELECT
+			UNIQUES.INDEX_NAME,
+			UNIQUES.COLUMN_NAMES,
+			UNIQUES.has_nullable,
+			LOCATE('auto_increment', COLUMNS.EXTRA) > 0 AS is_auto_increment
+		FROM information_schema.COLUMNS INNER JOIN (
+			SELECT
+				INDEX_NAME,
+				GROUP_CONCAT(COLUMN_NAME ORDER BY SEQ_IN_INDEX ASC) AS COLUMN_NAMES,
+				SUBSTRING_INDEX(GROUP_CONCAT(COLUMN_NAME ORDER BY SEQ_IN_INDEX ASC), ',', 1) AS FIRST_COLUMN_NAME,
+				SUM(NULLABLE='YES') > 0 AS has_nullable
+			FROM information_schema.STATISTICS
+			WHERE
+				TABLE_SCHEMA=%a
+				AND TABLE_NAME=%a
+				AND NON_UNIQUE=0
+			GROUP BY
+				INDEX_NAME
+		) AS UNIQUES ON (
+			COLUMNS.COLUMN_NAME = UNIQUES.FIRST_COLUMN_NAME
+		)
+		WHERE
+			COLUMNS.TABLE_SCHEMA=%a
+			AND COLUMNS.TABLE_NAME=%a
+		ORDER BY
+			UNIQUES.INDEX_NAME='PRIMARY' DESC,
+			UNIQUES.INDEX_NAME ASC
+	`
+	sqlSelectColumnTypes = `SELECT
+			COLUMN_NAME,
+			DATA_TYPE
+		FROM information_schema.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlSelectTableSize = `SELECT
+			TABLE_ROWS,
+			DATA_LENGTH,
+			INDEX_LENGTH
+		FROM information_schema.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlSelectTableType = `SELECT
+			TABLE_TYPE
+		FROM information_schema.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlShowCreateView         = "SHOW CREATE VIEW `%a`"
+	sqlSelectCountForeignKeys = `SELECT
+			COUNT(*) AS count_fk
+		FROM information_schema.KEY_COLUMN_USAGE
+		WHERE
+			REFERENCED_TABLE_NAME IS NOT NULL
+			AND (
+				(TABLE_SCHEMA=%a AND TABLE_NAME=%a)
+				OR (REFERENCED_TABLE_SCHEMA=%a AND REFERENCED_TABLE_NAME=%a)
+			)
+	`
+	sqlSelectDatadir      = "SELECT @@global.datadir AS datadir"
+	sqlShowCreateTable    = "SHOW CREATE TABLE `%a`"
+	sqlSetLockWaitTimeout = "SET @@session.lock_wait_timeout=%a"
+	sqlStartVReplStream   = "UPDATE _vt.vreplication set state='Running' where db_name=%a and workflow=%a"
+	sqlStopVReplStream    = "UPDATE _vt.vreplication set state='Stopped' where db_name=%a and workflow=%a"
+	sqlDeleteVReplStream  = "DELETE FROM _vt.vreplication where db_name=%a and workflow=%a"
+	sqlReadVReplStream    = `SELECT
 			id,
 			workflow,
 			source,
@@ -328,6 +437,7 @@ const (
 	retryMigrationHint     = "retry"
 	cancelMigrationHint    = "cancel"
 	cancelAllMigrationHint = "cancel-all"
+	completeMigrationHint  = "complete"
 )
 
 var (
@@ -356,4 +466,10 @@ var applyDDL = []string{
 	alterSchemaMigrationsTableMessage,
 	alterSchemaMigrationsTableTableCompleteIndex,
 	alterSchemaMigrationsTableETASeconds,
+	alterSchemaMigrationsTablePostponeCompletion,
+	alterSchemaMigrationsTableReadyToComplete,
+	alterSchemaMigrationsTableDDLAlgorithm,
+	alterSchemaMigrationsTablePriority,
+	alterSchemaMigrationsTableRunAfter,
+	alterSchemaMigrationsTableScheduleWindow,
 }
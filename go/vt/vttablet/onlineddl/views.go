@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// viewDefinerRegexp matches the DEFINER clause in SHOW CREATE VIEW output, e.g. DEFINER=`root`@`localhost`
+var viewDefinerRegexp = regexp.MustCompile("(?i)DEFINER=`[^`]*`@`[^`]*`\\s*")
+
+// isView returns true when the given table exists and is a view
+func (e *Executor) isView(ctx context.Context, tableName string) (bool, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectTableType,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return false, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return false, nil
+	}
+	return row["TABLE_TYPE"].ToString() == "VIEW", nil
+}
+
+// showCreateView reads the CREATE VIEW statement of the given view, as normalized by MySQL. The DEFINER
+// clause is stripped, so that a view created from the statement is defined by the executing user.
+func (e *Executor) showCreateView(conn *dbconnpool.DBConnection, viewName string) (*sqlparser.CreateView, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateView, viewName)
+	rs, err := conn.ExecuteFetch(parsed.Query, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rs.Rows) != 1 || len(rs.Rows[0]) < 2 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for SHOW CREATE VIEW %s", viewName)
+	}
+	createViewSQL := viewDefinerRegexp.ReplaceAllString(rs.Rows[0][1].ToString(), "")
+	stmt, err := sqlparser.Parse(createViewSQL)
+	if err != nil {
+		return nil, err
+	}
+	createView, ok := stmt.(*sqlparser.CreateView)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "expected CREATE VIEW in SHOW CREATE VIEW %s. Got %v", viewName, createViewSQL)
+	}
+	return createView, nil
+}
+
+// alterViewFromCreateView converts a CREATE [OR REPLACE] VIEW statement into the equivalent ALTER VIEW
+func alterViewFromCreateView(createView *sqlparser.CreateView) *sqlparser.AlterView {
+	return &sqlparser.AlterView{
+		ViewName:    createView.ViewName,
+		Algorithm:   createView.Algorithm,
+		Definer:     createView.Definer,
+		Security:    createView.Security,
+		Columns:     createView.Columns,
+		Select:      createView.Select,
+		CheckOption: createView.CheckOption,
+	}
+}
+
+// executeAlterView applies an ALTER VIEW, or a CREATE OR REPLACE VIEW on an existing view. Before the view
+// is modified, its current definition is copied into an artifact view, which a revert swaps back in.
+// Views hold no data, so this runs directly regardless of the migration's strategy.
+func (e *Executor) executeAlterView(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+		return err
+	}
+	createView, err := e.showCreateView(conn, onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	artifactViewName, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return err
+	}
+	createView.ViewName = sqlparser.TableName{Name: sqlparser.NewTableIdent(artifactViewName)}
+	if _, err := conn.ExecuteFetch(sqlparser.String(createView), 0, false); err != nil {
+		return err
+	}
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, artifactViewName); err != nil {
+		return err
+	}
+	_, err = e.executeDirectly(ctx, onlineDDL)
+	return err
+}
+
+// executeRevertAlterView reverts an ALTER migration on a view, by swapping the view with the artifact view
+// that holds its previous definition. The artifact then holds the reverted definition, so that the revert
+// is itself revertible.
+func (e *Executor) executeRevertAlterView(ctx context.Context, onlineDDL *schema.OnlineDDL, revertMigration *schema.OnlineDDL, artifacts string) error {
+	artifactViews := textutil.SplitDelimitedList(artifacts)
+	if len(artifactViews) != 1 {
+		return fmt.Errorf("cannot run migration %s reverting %s: found %d artifact views, expected 1", onlineDDL.UUID, revertMigration.UUID, len(artifactViews))
+	}
+	artifactView := artifactViews[0]
+	exists, err := e.tableExists(ctx, artifactView)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("cannot run migration %s reverting %s: artifact view %s not found", onlineDDL.UUID, revertMigration.UUID, artifactView)
+	}
+	swapView, err := schema.GenerateGCTableName(schema.HoldTableGCState, newGCTableRetainTime())
+	if err != nil {
+		return err
+	}
+	if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+		return err
+	}
+	if err := e.updateMySQLTable(ctx, onlineDDL.UUID, revertMigration.Table); err != nil {
+		return err
+	}
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, artifactView); err != nil {
+		return err
+	}
+	onlineDDL.SQL = sqlparser.BuildParsedQuery(sqlSwapTables,
+		revertMigration.Table, swapView,
+		artifactView, revertMigration.Table,
+		swapView, artifactView,
+	).Query
+	_, err = e.executeDirectly(ctx, onlineDDL)
+	return err
+}
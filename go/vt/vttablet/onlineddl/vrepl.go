@@ -95,7 +95,7 @@ func NewVRepl(workflow, keyspace, shard, dbName, sourceTable, targetTable, alter
 // candidate for chunking
 func (v *VRepl) getCandidateUniqueKeys(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) (uniqueKeys [](*vrepl.UniqueKey), err error) {
 
-	query, err := sqlparser.ParseAndBind(sqlShowColumnsFrom,
+	query, err := sqlparser.ParseAndBind(sqlSelectUniqueKeys,
 		sqltypes.StringBindVariable(v.dbName),
 		sqltypes.StringBindVariable(tableName),
 		sqltypes.StringBindVariable(v.dbName),
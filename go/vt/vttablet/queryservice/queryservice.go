@@ -78,6 +78,11 @@ type QueryService interface {
 	// ReadTransaction returns the metadata for the specified dtid.
 	ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (metadata *querypb.TransactionMetadata, err error)
 
+	// UnresolvedTransactions returns the 2pc transactions which are older than
+	// abandonAge seconds and not resolved yet: the ones coordinated by the
+	// tablet, and the ones it prepared as a participant.
+	UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error)
+
 	// Query execution
 	Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error)
 	// Currently always called with transactionID = 0
@@ -58,21 +58,22 @@ type SandboxConn struct {
 
 	// These Count vars report how often the corresponding
 	// functions were called.
-	ExecCount                sync2.AtomicInt64
-	BeginCount               sync2.AtomicInt64
-	CommitCount              sync2.AtomicInt64
-	RollbackCount            sync2.AtomicInt64
-	AsTransactionCount       sync2.AtomicInt64
-	PrepareCount             sync2.AtomicInt64
-	CommitPreparedCount      sync2.AtomicInt64
-	RollbackPreparedCount    sync2.AtomicInt64
-	CreateTransactionCount   sync2.AtomicInt64
-	StartCommitCount         sync2.AtomicInt64
-	SetRollbackCount         sync2.AtomicInt64
-	ConcludeTransactionCount sync2.AtomicInt64
-	ReadTransactionCount     sync2.AtomicInt64
-	ReserveCount             sync2.AtomicInt64
-	ReleaseCount             sync2.AtomicInt64
+	ExecCount                   sync2.AtomicInt64
+	BeginCount                  sync2.AtomicInt64
+	CommitCount                 sync2.AtomicInt64
+	RollbackCount               sync2.AtomicInt64
+	AsTransactionCount          sync2.AtomicInt64
+	PrepareCount                sync2.AtomicInt64
+	CommitPreparedCount         sync2.AtomicInt64
+	RollbackPreparedCount       sync2.AtomicInt64
+	CreateTransactionCount      sync2.AtomicInt64
+	StartCommitCount            sync2.AtomicInt64
+	SetRollbackCount            sync2.AtomicInt64
+	ConcludeTransactionCount    sync2.AtomicInt64
+	ReadTransactionCount        sync2.AtomicInt64
+	UnresolvedTransactionsCount sync2.AtomicInt64
+	ReserveCount                sync2.AtomicInt64
+	ReleaseCount                sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -92,6 +93,10 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// UnresolvedResults and PreparedResults are returned by UnresolvedTransactions.
+	UnresolvedResults []*querypb.TransactionMetadata
+	PreparedResults   []*querypb.PreparedTransaction
+
 	MessageIDs []*querypb.Value
 
 	// vstream expectations.
@@ -360,6 +365,15 @@ func (sbc *SandboxConn) ReadTransaction(ctx context.Context, target *querypb.Tar
 	return nil, nil
 }
 
+// UnresolvedTransactions is part of the QueryService interface.
+func (sbc *SandboxConn) UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	sbc.UnresolvedTransactionsCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return nil, nil, err
+	}
+	return sbc.UnresolvedResults, sbc.PreparedResults, nil
+}
+
 // BeginExecute is part of the QueryService interface.
 func (sbc *SandboxConn) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	transactionID, alias, err := sbc.begin(ctx, target, preQueries, reservedID, options)
@@ -504,7 +518,7 @@ func (sbc *SandboxConn) QueryServiceByAlias(_ *topodatapb.TabletAlias, _ *queryp
 func (sbc *SandboxConn) HandlePanic(err *error) {
 }
 
-//ReserveBeginExecute implements the QueryService interface
+// ReserveBeginExecute implements the QueryService interface
 func (sbc *SandboxConn) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
 	reservedID := sbc.reserve(ctx, target, preQueries, bindVariables, 0, options)
 	result, transactionID, alias, err := sbc.BeginExecute(ctx, target, nil, sql, bindVariables, reservedID, options)
@@ -517,7 +531,7 @@ func (sbc *SandboxConn) ReserveBeginExecute(ctx context.Context, target *querypb
 	return result, transactionID, reservedID, alias, nil
 }
 
-//ReserveExecute implements the QueryService interface
+// ReserveExecute implements the QueryService interface
 func (sbc *SandboxConn) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	reservedID := sbc.reserve(ctx, target, preQueries, bindVariables, transactionID, options)
 	result, err := sbc.Execute(ctx, target, sql, bindVariables, transactionID, reservedID, options)
@@ -541,7 +555,7 @@ func (sbc *SandboxConn) reserve(ctx context.Context, target *querypb.Target, pre
 	return sbc.ReserveID.Add(1)
 }
 
-//Release implements the QueryService interface
+// Release implements the QueryService interface
 func (sbc *SandboxConn) Release(ctx context.Context, target *querypb.Target, transactionID, reservedID int64) error {
 	sbc.ReleaseCount.Add(1)
 	return sbc.getError()
@@ -606,7 +620,7 @@ func (sbc *SandboxConn) getTxReservedID(txID int64) int64 {
 	return sbc.txIDToRID[txID]
 }
 
-//StringQueries returns the queries executed as a slice of strings
+// StringQueries returns the queries executed as a slice of strings
 func (sbc *SandboxConn) StringQueries() []string {
 	result := make([]string, len(sbc.Queries))
 	for i, query := range sbc.Queries {
@@ -358,6 +358,32 @@ func (f *FakeQueryService) ReadTransaction(ctx context.Context, target *querypb.
 	return Metadata, nil
 }
 
+// AbandonAge is a test abandon age for 2pc transactions.
+const AbandonAge int64 = 30
+
+// Prepared is a test list of prepared transactions.
+var Prepared = []*querypb.PreparedTransaction{{
+	Dtid:        "aa",
+	Failed:      true,
+	TimeCreated: 1,
+	Queries:     []string{"insert into t values(1)"},
+}}
+
+// UnresolvedTransactions is part of the queryservice.QueryService interface
+func (f *FakeQueryService) UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	if f.HasError {
+		return nil, nil, f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "UnresolvedTransactions", target)
+	if abandonAge != AbandonAge {
+		f.t.Errorf("UnresolvedTransactions: invalid abandonAge: got %d expected %d", abandonAge, AbandonAge)
+	}
+	return []*querypb.TransactionMetadata{Metadata}, Prepared, nil
+}
+
 // ExecuteQuery is a fake test query.
 const ExecuteQuery = "executeQuery"
 
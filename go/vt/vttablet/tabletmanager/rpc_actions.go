@@ -56,6 +56,25 @@ func (tm *TabletManager) GetPermissions(ctx context.Context) (*tabletmanagerdata
 	return mysqlctl.GetPermissions(tm.MysqlDaemon)
 }
 
+// GetHotRows returns the row (ranges) tracked by the automatic hot row
+// detection of the query service.
+func (tm *TabletManager) GetHotRows(ctx context.Context) ([]*tabletmanagerdatapb.HotRow, error) {
+	var result []*tabletmanagerdatapb.HotRow
+	for _, hr := range tm.QueryServiceControl.HotRows() {
+		var protectedUntil int64
+		if !hr.ProtectedUntil.IsZero() {
+			protectedUntil = hr.ProtectedUntil.Unix()
+		}
+		result = append(result, &tabletmanagerdatapb.HotRow{
+			Key:            hr.Key,
+			Table:          hr.Table,
+			Contentions:    int64(hr.Contentions),
+			ProtectedUntil: protectedUntil,
+		})
+	}
+	return result, nil
+}
+
 // SetReadOnly makes the mysql instance read-only or read-write.
 func (tm *TabletManager) SetReadOnly(ctx context.Context, rdonly bool) error {
 	if err := tm.lock(ctx); err != nil {
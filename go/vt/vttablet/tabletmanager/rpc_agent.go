@@ -44,6 +44,8 @@ type RPCTM interface {
 
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
+	GetHotRows(ctx context.Context) ([]*tabletmanagerdatapb.HotRow, error)
+
 	// Various read-write methods
 
 	SetReadOnly(ctx context.Context, rdonly bool) error
@@ -104,6 +106,13 @@ type RPCTM interface {
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
 
+	// VDiff API
+	VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+
+	// Table GC API
+	GetGCTables(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error)
+	CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error)
+
 	// Reparenting related functions
 
 	ResetReplication(ctx context.Context) error
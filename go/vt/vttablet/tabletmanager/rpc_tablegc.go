@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// GetGCTables returns the tables going through the table garbage collection lifecycle.
+func (tm *TabletManager) GetGCTables(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error) {
+	gcTables, err := tm.QueryServiceControl.GCTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*tabletmanagerdatapb.GCTable
+	for _, t := range gcTables {
+		result = append(result, &tabletmanagerdatapb.GCTable{
+			Name:        t.Name,
+			State:       string(t.State),
+			Uuid:        t.UUID,
+			Time:        t.Time.Unix(),
+			IsView:      t.IsView,
+			TableRows:   t.TableRows,
+			DataLength:  t.DataLength,
+			IndexLength: t.IndexLength,
+		})
+	}
+	return result, nil
+}
+
+// CancelGCTableDrop restores a table in HOLD state to its original name, and returns that name.
+// If originalName is empty, the name is read from the online DDL migration which dropped the table.
+func (tm *TabletManager) CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error) {
+	if err := tm.lock(ctx); err != nil {
+		return "", err
+	}
+	defer tm.unlock()
+	return tm.QueryServiceControl.CancelGCTableDrop(ctx, tableName, originalName)
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	defaultTabletTypes                 = "master,replica,rdonly"
+	defaultFilteredReplicationWaitTime = 30 * time.Second
+)
+
+// controller runs one vdiff: it diffs the tables of the workflow one
+// after the other, skipping the ones that were completed by a previous run.
+type controller struct {
+	vde      *Engine
+	id       int64
+	uuid     string
+	workflow string
+	options  *tabletmanagerdatapb.VDiffOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newController(ctx context.Context, vde *Engine, id int64, uuid, workflow string, options *tabletmanagerdatapb.VDiffOptions) *controller {
+	ctx, cancel := context.WithCancel(ctx)
+	ct := &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     uuid,
+		workflow: workflow,
+		options:  options,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go ct.run(ctx)
+	return ct
+}
+
+// Stop stops the vdiff and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) isDone() bool {
+	select {
+	case <-ct.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+	log.Infof("VDiff %s: starting for workflow %s", ct.uuid, ct.workflow)
+	err := ct.runDiffs(ctx)
+	if ctx.Err() != nil {
+		// The vdiff was stopped, or the engine is closing. The recorded
+		// state is left as is, so that the vdiff can be resumed.
+		log.Infof("VDiff %s: stopped: %v", ct.uuid, err)
+		return
+	}
+	if err != nil {
+		log.Errorf("VDiff %s: failed: %v", ct.uuid, err)
+		query := fmt.Sprintf(sqlSetVDiffState, encodeString(ErrorState), encodeString(binlogplayer.MessageTruncate(err.Error())), ct.id)
+		if _, err := ct.vde.execWithDDL(ctx, query); err != nil {
+			log.Errorf("VDiff %s: could not record the error: %v", ct.uuid, err)
+		}
+		return
+	}
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlCompleteVDiff, ct.id)); err != nil {
+		log.Errorf("VDiff %s: could not record the completion: %v", ct.uuid, err)
+		return
+	}
+	log.Infof("VDiff %s: completed", ct.uuid)
+}
+
+func (ct *controller) runDiffs(ctx context.Context) error {
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlStartVDiff, ct.id)); err != nil {
+		return err
+	}
+	sources, err := ct.readStreams()
+	if err != nil {
+		return err
+	}
+	plans, err := ct.buildPlans(ctx, sources)
+	if err != nil {
+		return err
+	}
+	progress, err := ct.vde.readTableProgress(ctx, ct.id)
+	if err != nil {
+		return err
+	}
+	byTable := make(map[string]*tableProgress, len(progress))
+	for _, tp := range progress {
+		byTable[tp.table] = tp
+	}
+	var baseline map[string]*tableProgress
+	if ct.options.Incremental {
+		if baseline, err = ct.readBaseline(ctx); err != nil {
+			return err
+		}
+	}
+	for _, plan := range plans {
+		tp, ok := byTable[plan.table]
+		if !ok {
+			// Unreachable: the rows were just inserted.
+			return fmt.Errorf("progress of table %s not found", plan.table)
+		}
+		if tp.state == CompletedState {
+			continue
+		}
+		if ct.options.Incremental {
+			base := baseline[plan.table]
+			err := canDiffIncrementally(plan, base)
+			if err == nil {
+				if err := ct.diffTableIncremental(ctx, plan, sources, base); err != nil {
+					return vterrors.Wrapf(err, "table %s", plan.table)
+				}
+				continue
+			}
+			log.Infof("VDiff %s: table %s will be fully diffed: %v", ct.uuid, plan.table, err)
+		}
+		if err := ct.diffTable(ctx, plan, sources, tp); err != nil {
+			return vterrors.Wrapf(err, "table %s", plan.table)
+		}
+	}
+	return nil
+}
+
+// readStreams returns the sources of the streams of the workflow, by stream id.
+func (ct *controller) readStreams() (map[int]*binlogdatapb.BinlogSource, error) {
+	qr, err := ct.vde.vre.Exec(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("workflow %s not found", ct.workflow)
+	}
+	sources := make(map[int]*binlogdatapb.BinlogSource)
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		bls := &binlogdatapb.BinlogSource{}
+		if err := proto.UnmarshalText(row[1].ToString(), bls); err != nil {
+			return nil, err
+		}
+		if bls.ExternalMysql != "" {
+			return nil, fmt.Errorf("workflow %s replicates from an external mysql, which the tablet-side vdiff does not support", ct.workflow)
+		}
+		sources[int(id)] = bls
+	}
+	return sources, nil
+}
+
+// readBaseline returns the tables that were completed by the last completed
+// vdiff of the workflow, which incremental vdiffs start from.
+func (ct *controller) readBaseline(ctx context.Context) (map[string]*tableProgress, error) {
+	qr, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlGetBaselineVDiff, encodeString(ct.vde.dbName), encodeString(ct.workflow), ct.id))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		log.Infof("VDiff %s: no previous vdiff was completed for workflow %s, all the tables will be fully diffed", ct.uuid, ct.workflow)
+		return nil, nil
+	}
+	id, err := qr.Rows[0][0].ToInt64()
+	if err != nil {
+		return nil, err
+	}
+	progress, err := ct.vde.readTableProgress(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	baseline := make(map[string]*tableProgress, len(progress))
+	for _, tp := range progress {
+		if tp.state == CompletedState {
+			baseline[tp.table] = tp
+		}
+	}
+	return baseline, nil
+}
+
+// buildPlans builds the plans of the tables of the workflow, and records
+// them in _vt.vdiff_table if they are not there yet.
+func (ct *controller) buildPlans(ctx context.Context, sources map[int]*binlogdatapb.BinlogSource) ([]*tablePlan, error) {
+	qr, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlGetTableRows, encodeString(ct.vde.dbName)))
+	if err != nil {
+		return nil, err
+	}
+	tableRows := make(map[string]int64)
+	for _, row := range qr.Rows {
+		table := row[0].ToString()
+		if schema.IsInternalOperationTableName(table) {
+			continue
+		}
+		rows, _ := row[1].ToInt64()
+		tableRows[table] = rows
+	}
+	tables := make([]string, 0, len(tableRows))
+	for table := range tableRows {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	include := make(map[string]bool, len(ct.options.Tables))
+	for _, table := range ct.options.Tables {
+		include[table] = true
+	}
+	filtered := len(include) != 0
+	var plans []*tablePlan
+	for _, table := range tables {
+		if filtered && !include[table] {
+			continue
+		}
+		rules, err := workflowRules(table, sources)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		plan, err := buildTablePlan(table, rules)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+		delete(include, table)
+	}
+	if len(include) != 0 {
+		var missing []string
+		for table := range include {
+			missing = append(missing, table)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("tables %v are not part of workflow %s", missing, ct.workflow)
+	}
+	if len(plans) == 0 {
+		return nil, fmt.Errorf("workflow %s has no table to diff", ct.workflow)
+	}
+	for _, plan := range plans {
+		if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlNewVDiffTable, ct.id, encodeString(plan.table), tableRows[plan.table])); err != nil {
+			return nil, err
+		}
+	}
+	return plans, nil
+}
+
+// diffTable diffs one table, starting after the recorded lastpk. Like the
+// in-process VDiff, it stops the target streams, waits for the sources to
+// catch up, snapshots the sources, fast-forwards the target streams to the
+// snapshot positions, snapshots the target, and restarts the streams.
+func (ct *controller) diffTable(ctx context.Context, plan *tablePlan, sources map[int]*binlogdatapb.BinlogSource, tp *tableProgress) error {
+	log.Infof("VDiff %s: diffing table %s, lastpk: %v", ct.uuid, plan.table, tp.lastpk)
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateTableState, encodeString(StartedState), ct.id, encodeString(plan.table))); err != nil {
+		return err
+	}
+
+	// The streams are cancelled when the table is done.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	restartStreams, err := ct.stopStreams()
+	if err != nil {
+		return err
+	}
+	defer restartStreams()
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, ct.waitTime())
+	defer waitCancel()
+
+	ids := plan.streamIDs()
+	streamers := make(map[int]*shardStreamer, len(ids))
+	for _, id := range ids {
+		streamer, err := ct.startSource(ctx, waitCtx, id, sources[id], plan.sourceQueries[id], tp.lastpk)
+		if err != nil {
+			return err
+		}
+		streamers[id] = streamer
+	}
+
+	// Fast forward the target streams to the source snapshots.
+	for _, id := range ids {
+		query := fmt.Sprintf(sqlSyncStream, encodeString(streamers[id].gtid), id)
+		if _, err := ct.vde.vre.Exec(query); err != nil {
+			return err
+		}
+		if err := ct.vde.vre.WaitForPos(waitCtx, id, streamers[id].gtid); err != nil {
+			return vterrors.Wrapf(err, "waiting for stream %d to reach the source snapshot", id)
+		}
+	}
+	verifiedPos := make(map[int]string, len(ids))
+	for _, id := range ids {
+		verifiedPos[id] = streamers[id].gtid
+	}
+	if err := ct.setVerifiedPos(ctx, plan.table, verifiedPos); err != nil {
+		return err
+	}
+
+	first := streamers[ids[0]]
+	columns := plan.targetColumns
+	if columns == nil {
+		for _, field := range first.fields {
+			columns = append(columns, field.Name)
+		}
+	}
+	targetQuery := buildTargetQuery(plan.table, columns)
+	target := newShardStreamer("target")
+	go target.stream(ctx, func(send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		querypbTarget := &querypb.Target{
+			Keyspace:   ct.vde.tablet.Keyspace,
+			Shard:      ct.vde.tablet.Shard,
+			TabletType: topodatapb.TabletType_MASTER,
+		}
+		return ct.vde.qs.VStreamRows(ctx, querypbTarget, targetQuery, tp.lastpk, send)
+	})
+	if err := target.waitReady(waitCtx); err != nil {
+		return err
+	}
+	// Both sides are snapshotted: the streams can be restarted.
+	if err := restartStreams(); err != nil {
+		return err
+	}
+
+	td, err := newTableDiffer(plan.table, target.fields, target.pkfields, tp.report)
+	if err != nil {
+		return err
+	}
+	if tp.lastpk != nil {
+		if rows := sqltypes.Proto3ToResult(tp.lastpk).Rows; len(rows) == 1 {
+			td.lastpk = rows[0]
+		}
+	}
+	rowSources := make([]rowSource, 0, len(ids))
+	for _, id := range ids {
+		if err := td.validateSource(streamers[id]); err != nil {
+			return err
+		}
+		rowSources = append(rowSources, streamers[id])
+	}
+	td.source = newMergeStreamer(rowSources, td.pkCols)
+	td.target = target
+
+	saveProgress := func() error {
+		return ct.saveProgress(ctx, td)
+	}
+	if err := td.diff(ctx, saveProgress); err != nil {
+		if ctx.Err() == nil {
+			return err
+		}
+		// Record what was compared before the stop, using a fresh
+		// context because ctx is cancelled.
+		saveCtx, saveCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer saveCancel()
+		if serr := ct.saveProgress(saveCtx, td); serr != nil {
+			log.Errorf("VDiff %s: could not save the progress of table %s: %v", ct.uuid, plan.table, serr)
+		}
+		return err
+	}
+	if err := ct.saveProgress(ctx, td); err != nil {
+		return err
+	}
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateTableState, encodeString(CompletedState), ct.id, encodeString(plan.table))); err != nil {
+		return err
+	}
+	log.Infof("VDiff %s: table %s completed: %+v", ct.uuid, plan.table, *td.report)
+	return nil
+}
+
+// stopStreams stops the streams of the workflow. The returned function
+// restarts them. It can be called more than once.
+func (ct *controller) stopStreams() (func() error, error) {
+	var restartOnce sync.Once
+	var restartErr error
+	restartStreams := func() error {
+		restartOnce.Do(func() {
+			_, restartErr = ct.vde.vre.Exec(fmt.Sprintf(sqlRestartStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+			if restartErr != nil {
+				log.Errorf("VDiff %s: could not restart the streams of workflow %s: %v", ct.uuid, ct.workflow, restartErr)
+			}
+		})
+		return restartErr
+	}
+	if _, err := ct.vde.vre.Exec(fmt.Sprintf(sqlStopStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow))); err != nil {
+		// Some streams may have been stopped.
+		restartStreams()
+		return nil, err
+	}
+	return restartStreams, nil
+}
+
+// waitTime is how long to wait for the sources and the target to reach a position.
+func (ct *controller) waitTime() time.Duration {
+	if ct.options.FilteredReplicationWaitTime > 0 {
+		return time.Duration(ct.options.FilteredReplicationWaitTime) * time.Second
+	}
+	return defaultFilteredReplicationWaitTime
+}
+
+// readStreamPos returns the current position of a stream.
+func (ct *controller) readStreamPos(id int) (string, error) {
+	qr, err := ct.vde.vre.Exec(fmt.Sprintf(sqlReadStreamPos, id))
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].ToString() == "" {
+		return "", fmt.Errorf("stream %d has not started", id)
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
+
+// startSource picks a source tablet for the stream, waits for it to reach
+// the position of the stream, and starts streaming the rows of the table.
+// It returns once the snapshot position of the source is known.
+func (ct *controller) startSource(ctx, waitCtx context.Context, id int, bls *binlogdatapb.BinlogSource, query string, lastpk *querypb.QueryResult) (*shardStreamer, error) {
+	pos, err := ct.readStreamPos(id)
+	if err != nil {
+		return nil, err
+	}
+	tablet, err := ct.pickSourceTablet(waitCtx, bls, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	streamer := newShardStreamer(fmt.Sprintf("%s/%s", bls.Keyspace, bls.Shard))
+	go streamer.stream(ctx, func(send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+		target := &querypb.Target{
+			Keyspace:   bls.Keyspace,
+			Shard:      bls.Shard,
+			TabletType: tablet.Type,
+		}
+		return conn.VStreamRows(ctx, target, query, lastpk, send)
+	})
+	if err := streamer.waitReady(waitCtx); err != nil {
+		return nil, err
+	}
+	return streamer, nil
+}
+
+// pickSourceTablet picks a tablet of the source of a stream, and waits
+// for it to reach pos.
+func (ct *controller) pickSourceTablet(waitCtx context.Context, bls *binlogdatapb.BinlogSource, pos string) (*topodatapb.Tablet, error) {
+	cells := []string{ct.vde.tablet.Alias.Cell}
+	if ct.options.SourceCell != "" {
+		cells = strings.Split(ct.options.SourceCell, ",")
+	}
+	tabletTypes := ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	tp, err := discovery.NewTabletPicker(ct.vde.ts, cells, bls.Keyspace, bls.Shard, tabletTypes)
+	if err != nil {
+		return nil, err
+	}
+	tablet, err := tp.PickForStreaming(waitCtx)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("VDiff %s: waiting for source tablet %s to reach position %s", ct.uuid, topoproto.TabletAliasString(tablet.Alias), pos)
+	if err := ct.vde.tmc.WaitForPosition(waitCtx, tablet, pos); err != nil {
+		return nil, vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return tablet, nil
+}
+
+// saveProgress records the lastpk and the report of the table.
+func (ct *controller) saveProgress(ctx context.Context, td *tableDiffer) error {
+	lastpk, err := encodeLastPK(td.pkFields, td.lastpk)
+	if err != nil {
+		return err
+	}
+	return ct.saveReport(ctx, td.table, lastpk, td.report)
+}
+
+func (ct *controller) saveReport(ctx context.Context, table, lastpk string, dr *DiffReport) error {
+	report, err := json.Marshal(dr)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(sqlUpdateTableReport, encodeString(lastpk), dr.ProcessedRows, encodeString(string(report)), ct.id, encodeString(table))
+	_, err = ct.vde.execWithDDL(ctx, query)
+	return err
+}
+
+// setVerifiedPos records the source positions at which the rows of the table are verified.
+func (ct *controller) setVerifiedPos(ctx context.Context, table string, positions map[int]string) error {
+	verifiedPos, err := json.Marshal(positions)
+	if err != nil {
+		return err
+	}
+	_, err = ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlSetVerifiedPos, encodeString(string(verifiedPos)), ct.id, encodeString(table)))
+	return err
+}
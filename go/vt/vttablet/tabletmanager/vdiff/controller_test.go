@@ -0,0 +1,247 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// fakeVREngine answers the queries of the vdiffs with exec.
+type fakeVREngine struct {
+	exec func(query string) (*sqltypes.Result, error)
+}
+
+func (vre *fakeVREngine) Exec(query string) (*sqltypes.Result, error) {
+	return vre.exec(query)
+}
+
+func (vre *fakeVREngine) WaitForPos(ctx context.Context, id int, pos string) error {
+	return nil
+}
+
+var (
+	streamFields     = sqltypes.MakeTestFields("id|source", "int64|varchar")
+	tableRowsFields  = sqltypes.MakeTestFields("table_name|table_rows", "varchar|int64")
+	vdiffStateFields = sqltypes.MakeTestFields("id|vdiff_uuid|workflow|options", "int64|varchar|varchar|varchar")
+)
+
+// workflowStreams returns the streams of a workflow that replicates t1.
+func workflowStreams() *sqltypes.Result {
+	return sqltypes.MakeTestResult(streamFields, `1|keyspace:"src" shard:"0" filter:<rules:<match:"t1" > > `)
+}
+
+// controllerOf returns the running controller of a vdiff.
+func controllerOf(vde *Engine, id int64) *controller {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.controllers[id]
+}
+
+func waitDone(t *testing.T, ct *controller) {
+	t.Helper()
+	require.NotNil(t, ct)
+	select {
+	case <-ct.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for vdiff %s", ct.uuid)
+	}
+}
+
+// expectCreate sets the expectations of the creation of a vdiff of wf1.
+func expectCreate(t *testing.T, dbClient *binlogplayer.MockDBClient, uuid string, id uint64) {
+	options, err := json.Marshal(&tabletmanagerdatapb.VDiffOptions{})
+	require.NoError(t, err)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetActiveVDiff, "'vt_ks'", "'wf1'"), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlNewVDiff, encodeString(uuid), "'wf1'", "'ks'", "'0'", "'vt_ks'", "'pending'", encodeString(string(options))), &sqltypes.Result{InsertID: id}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlStartVDiff, id), &sqltypes.Result{}, nil)
+}
+
+func TestControllerCompletes(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	defer vde.cancel()
+	vde.vre = &fakeVREngine{exec: func(query string) (*sqltypes.Result, error) {
+		return workflowStreams(), nil
+	}}
+
+	// t1 was completed by a previous run of the vdiff: it's not diffed
+	// again, and the vdiff completes. t2 is not part of the workflow.
+	expectCreate(t, dbClient, "uuid1", 1)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetTableRows, "'vt_ks'"), sqltypes.MakeTestResult(tableRowsFields, "t1|10", "t2|5"), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlNewVDiffTable, 1, "'t1'", 10), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffTables, 1), sqltypes.MakeTestResult(vdiffTableFields,
+		`t1|completed||10|{"ProcessedRows":10,"MatchingRows":10}||0`,
+	), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlCompleteVDiff, 1), &sqltypes.Result{}, nil)
+
+	resp, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: CreateAction, VdiffUuid: "uuid1"})
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{VdiffUuid: "uuid1", State: PendingState}, resp)
+	waitDone(t, controllerOf(vde, 1))
+	dbClient.Wait()
+
+	// Only one vdiff of a workflow can run at a time.
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetActiveVDiff, "'vt_ks'", "'wf1'"), sqltypes.MakeTestResult(sqltypes.MakeTestFields("vdiff_uuid", "varchar"), "uuid2"), nil)
+	_, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: CreateAction})
+	assert.EqualError(t, err, "vdiff uuid2 is already running for workflow wf1")
+
+	// A completed vdiff can neither be stopped nor resumed.
+	for _, action := range []string{StopAction, ResumeAction} {
+		dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields, "1|uuid1|wf1|completed||1|2"), nil)
+		_, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: action, VdiffUuid: "uuid1"})
+		assert.Error(t, err)
+	}
+}
+
+func TestControllerError(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	defer vde.cancel()
+	// The streams are deleted after the vdiff is created.
+	calls := 0
+	vde.vre = &fakeVREngine{exec: func(query string) (*sqltypes.Result, error) {
+		calls++
+		if calls == 1 {
+			return workflowStreams(), nil
+		}
+		return &sqltypes.Result{}, nil
+	}}
+
+	expectCreate(t, dbClient, "uuid1", 1)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlSetVDiffState, "'error'", "'workflow wf1 not found'", 1), &sqltypes.Result{}, nil)
+	_, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: CreateAction, VdiffUuid: "uuid1"})
+	require.NoError(t, err)
+	waitDone(t, controllerOf(vde, 1))
+	dbClient.Wait()
+}
+
+func TestControllerStopResume(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	defer vde.cancel()
+
+	// The controller blocks while reading the streams until it's stopped.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+	vde.vre = &fakeVREngine{exec: func(query string) (*sqltypes.Result, error) {
+		calls++
+		switch calls {
+		case 1:
+			return workflowStreams(), nil
+		case 2:
+			close(started)
+			<-release
+			return nil, fmt.Errorf("stopped")
+		}
+		return &sqltypes.Result{}, nil
+	}}
+
+	expectCreate(t, dbClient, "uuid1", 1)
+	_, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: CreateAction, VdiffUuid: "uuid1"})
+	require.NoError(t, err)
+	<-started
+	dbClient.Wait()
+
+	ct := controllerOf(vde, 1)
+	cancel := ct.cancel
+	ct.cancel = func() {
+		cancel()
+		close(release)
+	}
+	// The state is recorded before the controller is stopped, and
+	// the stopped controller does not record an error.
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields, "1|uuid1|wf1|started||1|0"), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlStopVDiff, 1), &sqltypes.Result{RowsAffected: 1}, nil)
+	resp, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: StopAction, VdiffUuid: "uuid1"})
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{VdiffUuid: "uuid1", State: StoppedState}, resp)
+	assert.True(t, ct.isDone())
+	assert.Nil(t, controllerOf(vde, 1))
+	dbClient.Wait()
+
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields, "1|uuid1|wf1|stopped||1|0"), nil)
+	_, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: StopAction, VdiffUuid: "uuid1"})
+	assert.EqualError(t, err, "vdiff uuid1 cannot be stopped: it is stopped")
+
+	// The resumed vdiff starts a new controller, which fails
+	// because the streams are gone.
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields, "1|uuid1|wf1|stopped||1|0"), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlResumeVDiff, 1), &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffsByState, "'vt_ks'", "'pending'"), sqltypes.MakeTestResult(vdiffStateFields, "1|uuid1|wf1|{}"), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlStartVDiff, 1), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlSetVDiffState, "'error'", "'workflow wf1 not found'", 1), &sqltypes.Result{}, nil)
+	resp, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: ResumeAction, VdiffUuid: "uuid1"})
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{VdiffUuid: "uuid1", State: PendingState}, resp)
+	waitDone(t, controllerOf(vde, 1))
+	dbClient.Wait()
+
+	// A vdiff of another workflow cannot be resumed.
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields, "1|uuid1|wf1|error||1|0"), nil)
+	_, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf2", Action: ResumeAction, VdiffUuid: "uuid1"})
+	assert.EqualError(t, err, "vdiff uuid1 not found for workflow wf2")
+}
+
+func TestEngineResumesVDiffs(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	vde.cancel()
+	vde.isOpen = false
+	vde.ts = memorytopo.NewServer("cell1")
+
+	// The controllers of the running vdiffs wait until the engine is closed.
+	started := make(chan struct{})
+	vde.vre = &fakeVREngine{exec: func(query string) (*sqltypes.Result, error) {
+		close(started)
+		<-vde.ctx.Done()
+		return nil, vde.ctx.Err()
+	}}
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffsByState, "'vt_ks'", "'pending', 'started'"), sqltypes.MakeTestResult(vdiffStateFields, `2|uuid2|wf1|{"tables":["t1"]}`), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlStartVDiff, 2), &sqltypes.Result{}, nil)
+	vde.Open(context.Background())
+	<-started
+	dbClient.Wait()
+
+	ct := controllerOf(vde, 2)
+	require.NotNil(t, ct)
+	assert.Equal(t, "uuid2", ct.uuid)
+	assert.Equal(t, []string{"t1"}, ct.options.Tables)
+
+	// Closing the engine leaves the recorded state as is.
+	vde.Close()
+	assert.True(t, ct.isDone())
+	assert.False(t, vde.IsOpen())
+	_, err := vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: ShowAction})
+	assert.EqualError(t, err, "vdiff engine is closed")
+}
@@ -0,0 +1,458 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/withddl"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var withDDL = withddl.New([]string{
+	"create database if not exists _vt",
+	createVDiffTable,
+	createVDiffTableTable,
+	alterVDiffTableAddVerifiedPos,
+	alterVDiffTableAddIncremental,
+})
+
+// openRetryInterval is the time to wait before retrying to load
+// the vdiffs to resume. It can be changed to a smaller value for tests.
+var openRetryInterval = sync2.NewAtomicDuration(5 * time.Second)
+
+// VREngine is the subset of the vreplication engine the vdiffs use
+// to control the streams of the workflow being diffed.
+type VREngine interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the vdiffs of the workflows that replicate into this tablet.
+// A vdiff compares the rows of the tables of the workflow with the rows of
+// the source shards that belong to this tablet's shard. Its progress is
+// recorded in the _vt.vdiff and _vt.vdiff_table tables, so that it can be
+// resumed where it left off if the tablet restarts or stops being the master.
+type Engine struct {
+	// mu synchronizes isOpen, controllers and the contexts.
+	mu     sync.Mutex
+	isOpen bool
+	// controllers contains the running vdiffs by id.
+	controllers map[int64]*controller
+	// wg is used to wait for the resume loop.
+	wg sync.WaitGroup
+
+	// ctx is the root context for all controllers.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	tablet          *topodatapb.Tablet
+	vre             VREngine
+	qs              queryservice.QueryService
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+}
+
+// NewEngine creates a new Engine. The vdiffs run against the local
+// tablet server qs, and use vre to control the target streams.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, vre VREngine, qs queryservice.QueryService) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		tablet:      tablet,
+		vre:         vre,
+		qs:          qs,
+		tmc:         tmclient.NewTabletManagerClient(),
+	}
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, vre VREngine, qs queryservice.QueryService, tmc tmclient.TabletManagerClient, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		tablet:          tablet,
+		vre:             vre,
+		qs:              qs,
+		tmc:             tmc,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+	vde.wg.Add(1)
+	go vde.resumeAll(vde.ctx)
+}
+
+// resumeAll starts the controllers of the vdiffs that are pending or were
+// running. It keeps retrying until it succeeds or the engine is closed.
+func (vde *Engine) resumeAll(ctx context.Context) {
+	defer vde.wg.Done()
+	for {
+		err := func() error {
+			qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlGetVDiffsByState, encodeString(vde.dbName), "'pending', 'started'"))
+			if err != nil {
+				return err
+			}
+			vde.mu.Lock()
+			defer vde.mu.Unlock()
+			if ctx.Err() != nil {
+				// The engine was closed.
+				return nil
+			}
+			for _, row := range qr.Named().Rows {
+				if err := vde.startLocked(row); err != nil {
+					log.Errorf("VDiff %s could not be resumed: %v", row.AsString("vdiff_uuid", ""), err)
+				}
+			}
+			return nil
+		}()
+		if err == nil {
+			return
+		}
+		log.Errorf("Error loading the vdiffs to resume: %v, will keep retrying.", err)
+		timer := time.NewTimer(openRetryInterval.Get())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// startLocked starts a controller for the vdiff described by row,
+// unless one is already running. It must be called with the lock held.
+func (vde *Engine) startLocked(row sqltypes.RowNamedValues) error {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return err
+	}
+	if ct, ok := vde.controllers[id]; ok && !ct.isDone() {
+		return nil
+	}
+	options := &tabletmanagerdatapb.VDiffOptions{}
+	if s := row.AsString("options", ""); s != "" {
+		if err := json.Unmarshal([]byte(s), options); err != nil {
+			return err
+		}
+	}
+	vde.controllers[id] = newController(vde.ctx, vde, id, row.AsString("vdiff_uuid", ""), row.AsString("workflow", ""), options)
+	return nil
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops all the running vdiffs. They are left in their current
+// state, and will be resumed by the next Open.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	if !vde.isOpen {
+		vde.mu.Unlock()
+		return
+	}
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	vde.mu.Unlock()
+
+	// The resume loop needs the lock, so it must be waited for outside of it.
+	vde.wg.Wait()
+	log.Infof("VDiff Engine: closed")
+}
+
+// PerformVDiffAction executes a VDiff request.
+func (vde *Engine) PerformVDiffAction(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if !vde.IsOpen() {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+	if req.Workflow == "" {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "workflow must be specified")
+	}
+	switch req.Action {
+	case CreateAction:
+		return vde.create(ctx, req)
+	case ResumeAction:
+		return vde.resume(ctx, req)
+	case StopAction:
+		return vde.stop(ctx, req)
+	case ShowAction:
+		return vde.show(ctx, req)
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vdiff action: %q", req.Action)
+}
+
+func (vde *Engine) create(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	qr, err := vde.vre.Exec(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(vde.dbName), encodeString(req.Workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s not found on tablet %v", req.Workflow, vde.tablet.Alias)
+	}
+	qr, err = vde.execWithDDL(ctx, fmt.Sprintf(sqlGetActiveVDiff, encodeString(vde.dbName), encodeString(req.Workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already running for workflow %s", qr.Rows[0][0].ToString(), req.Workflow)
+	}
+
+	vdiffUUID := req.VdiffUuid
+	if vdiffUUID == "" {
+		vdiffUUID = uuid.New().String()
+	}
+	options := req.Options
+	if options == nil {
+		options = &tabletmanagerdatapb.VDiffOptions{}
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(sqlNewVDiff, encodeString(vdiffUUID), encodeString(req.Workflow), encodeString(vde.tablet.Keyspace),
+		encodeString(vde.tablet.Shard), encodeString(vde.dbName), encodeString(PendingState), encodeString(string(optionsJSON)))
+	qr, err = vde.execWithDDL(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		// The vdiff will be started by the next Open.
+		return &tabletmanagerdatapb.VDiffResponse{VdiffUuid: vdiffUUID, State: PendingState}, nil
+	}
+	id := int64(qr.InsertID)
+	vde.controllers[id] = newController(vde.ctx, vde, id, vdiffUUID, req.Workflow, options)
+	return &tabletmanagerdatapb.VDiffResponse{VdiffUuid: vdiffUUID, State: PendingState}, nil
+}
+
+func (vde *Engine) resume(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	id, state, err := vde.lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if state != StoppedState && state != ErrorState {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s cannot be resumed: it is %s", req.VdiffUuid, state)
+	}
+	if _, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlResumeVDiff, id)); err != nil {
+		return nil, err
+	}
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlGetVDiffsByState, encodeString(vde.dbName), encodeString(PendingState)))
+	if err != nil {
+		return nil, err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	for _, row := range qr.Named().Rows {
+		if rowID, _ := row.ToInt64("id"); rowID == id && vde.isOpen {
+			if err := vde.startLocked(row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &tabletmanagerdatapb.VDiffResponse{VdiffUuid: req.VdiffUuid, State: PendingState}, nil
+}
+
+func (vde *Engine) stop(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	id, state, err := vde.lookup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if state != PendingState && state != StartedState {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s cannot be stopped: it is %s", req.VdiffUuid, state)
+	}
+	// The state must be changed before the controller is stopped,
+	// so that the controller does not record an error.
+	if _, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlStopVDiff, id)); err != nil {
+		return nil, err
+	}
+
+	vde.mu.Lock()
+	ct, ok := vde.controllers[id]
+	delete(vde.controllers, id)
+	vde.mu.Unlock()
+	if ok {
+		ct.Stop()
+	}
+	return &tabletmanagerdatapb.VDiffResponse{VdiffUuid: req.VdiffUuid, State: StoppedState}, nil
+}
+
+func (vde *Engine) show(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	var query string
+	if req.VdiffUuid == "" || req.VdiffUuid == LastVDiff {
+		query = fmt.Sprintf(sqlGetLastVDiff, encodeString(vde.dbName), encodeString(req.Workflow))
+	} else {
+		query = fmt.Sprintf(sqlGetVDiffByUUID, encodeString(vde.dbName), encodeString(req.VdiffUuid))
+	}
+	qr, err := vde.execWithDDL(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s not found for workflow %s", req.VdiffUuid, req.Workflow)
+	}
+	row := qr.Named().Row()
+	if row.AsString("workflow", "") != req.Workflow {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s not found for workflow %s", req.VdiffUuid, req.Workflow)
+	}
+	response := &tabletmanagerdatapb.VDiffResponse{
+		VdiffUuid:   row.AsString("vdiff_uuid", ""),
+		State:       row.AsString("state", ""),
+		LastError:   row.AsString("last_error", ""),
+		StartedAt:   row.AsInt64("started_at", 0),
+		CompletedAt: row.AsInt64("completed_at", 0),
+	}
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	tables, err := vde.readTableProgress(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, tp := range tables {
+		response.Tables = append(response.Tables, tp.toProto())
+	}
+	return response, nil
+}
+
+// lookup returns the id and state of the vdiff of the request.
+func (vde *Engine) lookup(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (int64, string, error) {
+	if req.VdiffUuid == "" {
+		return 0, "", vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "vdiff uuid must be specified")
+	}
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlGetVDiffByUUID, encodeString(vde.dbName), encodeString(req.VdiffUuid)))
+	if err != nil {
+		return 0, "", err
+	}
+	row := qr.Named().Row()
+	if row == nil || row.AsString("workflow", "") != req.Workflow {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s not found for workflow %s", req.VdiffUuid, req.Workflow)
+	}
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return 0, "", err
+	}
+	return id, row.AsString("state", ""), nil
+}
+
+// readTableProgress returns the recorded progress of the tables of a vdiff.
+func (vde *Engine) readTableProgress(ctx context.Context, id int64) ([]*tableProgress, error) {
+	qr, err := vde.execWithDDL(ctx, fmt.Sprintf(sqlGetVDiffTables, id))
+	if err != nil {
+		return nil, err
+	}
+	var tables []*tableProgress
+	for _, row := range qr.Named().Rows {
+		tp := &tableProgress{
+			table:     row.AsString("table_name", ""),
+			state:     row.AsString("state", ""),
+			tableRows: row.AsInt64("table_rows", 0),
+			report:    &DiffReport{},
+		}
+		tp.incremental = row.AsInt64("incremental", 0) != 0
+		if s := row.AsString("verified_pos", ""); s != "" {
+			if err := json.Unmarshal([]byte(s), &tp.verifiedPos); err != nil {
+				return nil, err
+			}
+		}
+		if s := row.AsString("report", ""); s != "" {
+			if err := json.Unmarshal([]byte(s), tp.report); err != nil {
+				return nil, err
+			}
+		}
+		if s := row.AsString("lastpk", ""); s != "" {
+			if tp.lastpk, err = decodeLastPK(s); err != nil {
+				return nil, err
+			}
+		}
+		tables = append(tables, tp)
+	}
+	return tables, nil
+}
+
+// execWithDDL executes a query against the vdiff tables, creating them if needed.
+func (vde *Engine) execWithDDL(ctx context.Context, query string) (*sqltypes.Result, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	return withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+}
+
+func encodeString(in string) string {
+	buf := bytes.NewBuffer(nil)
+	sqltypes.NewVarChar(in).EncodeSQL(buf)
+	return buf.String()
+}
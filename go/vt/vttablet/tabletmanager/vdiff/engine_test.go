@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	vdiffFields      = sqltypes.MakeTestFields("id|vdiff_uuid|workflow|state|last_error|started_at|completed_at", "int64|varchar|varchar|varchar|varchar|int64|int64")
+	vdiffTableFields = sqltypes.MakeTestFields("table_name|state|lastpk|table_rows|report|verified_pos|incremental", "varchar|varchar|varbinary|int64|varbinary|varbinary|int64")
+)
+
+// newTestEngine returns an open engine whose queries go to dbClient.
+func newTestEngine(dbClient binlogplayer.DBClient) *Engine {
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	vde := NewTestEngine(nil, tablet, nil, nil, nil, func() binlogplayer.DBClient { return dbClient }, "vt_ks")
+	vde.ctx, vde.cancel = context.WithCancel(context.Background())
+	vde.isOpen = true
+	return vde
+}
+
+func TestReport(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := newTestEngine(dbClient)
+	defer vde.cancel()
+
+	// The progress of the differ is saved, and reported by show.
+	td, err := newTableDiffer("t1", testFields, testFields[:1], &DiffReport{})
+	require.NoError(t, err)
+	td.source = newFakeRowSource(testFields, "1|a", "2|b", "3|c")
+	td.target = newFakeRowSource(testFields, "1|a", "2|x", "4|d")
+	require.NoError(t, td.diff(ctx, func() error { return nil }))
+
+	report := `{"ProcessedRows":4,"MatchingRows":1,"MismatchedRows":1,"ExtraRowsSource":1,"ExtraRowsTarget":1}`
+	lastpk := `fields:<name:"id" type:INT64 > rows:<lengths:1 values:"4" > `
+	dbClient.ExpectRequest(fmt.Sprintf(sqlUpdateTableReport, encodeString(lastpk), 4, encodeString(report), 1, "'t1'"), &sqltypes.Result{}, nil)
+	ct := &controller{vde: vde, id: 1, uuid: "uuid1", workflow: "wf1"}
+	require.NoError(t, ct.saveProgress(ctx, td))
+
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetLastVDiff, "'vt_ks'", "'wf1'"), sqltypes.MakeTestResult(vdiffFields,
+		"1|uuid1|wf1|completed||1600000000|1600000100",
+	), nil)
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffTables, 1), sqltypes.MakeTestResult(vdiffTableFields,
+		fmt.Sprintf("t1|completed|%s|3|%s||0", lastpk, report),
+		`t2|started||10|{"ProcessedRows":5,"MatchingRows":5}||1`,
+		"t3|pending||7|||0",
+	), nil)
+	resp, err := vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf1", Action: ShowAction, VdiffUuid: LastVDiff})
+	require.NoError(t, err)
+	dbClient.Wait()
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{
+		VdiffUuid:   "uuid1",
+		State:       CompletedState,
+		StartedAt:   1600000000,
+		CompletedAt: 1600000100,
+		Tables: []*tabletmanagerdatapb.VDiffTableReport{{
+			TableName:       "t1",
+			State:           CompletedState,
+			TableRows:       3,
+			ProcessedRows:   4,
+			MatchingRows:    1,
+			MismatchedRows:  1,
+			ExtraRowsSource: 1,
+			ExtraRowsTarget: 1,
+		}, {
+			TableName:     "t2",
+			State:         StartedState,
+			TableRows:     10,
+			ProcessedRows: 5,
+			MatchingRows:  5,
+			Incremental:   true,
+		}, {
+			TableName: "t3",
+			State:     PendingState,
+			TableRows: 7,
+		}},
+	}, resp)
+
+	// A vdiff of another workflow is not reported.
+	dbClient.ExpectRequest(fmt.Sprintf(sqlGetVDiffByUUID, "'vt_ks'", "'uuid1'"), sqltypes.MakeTestResult(vdiffFields,
+		"1|uuid1|wf1|completed||1600000000|1600000100",
+	), nil)
+	_, err = vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf2", Action: ShowAction, VdiffUuid: "uuid1"})
+	assert.EqualError(t, err, "vdiff uuid1 not found for workflow wf2")
+}
@@ -0,0 +1,484 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// An incremental vdiff only compares the rows that changed since the tables
+// were verified by the previous completed vdiff of the workflow. The changed
+// rows are collected from the binlogs of the sources, starting at the
+// recorded verified positions. The last image of each row in the binlogs is
+// its current source value: binlogs are in full row image mode.
+
+// targetBatchSize is the number of target rows read per query.
+const targetBatchSize = 100
+
+// canDiffIncrementally returns an error if the table cannot be diffed
+// incrementally from base, its progress in the previous vdiff.
+func canDiffIncrementally(plan *tablePlan, base *tableProgress) error {
+	if base == nil {
+		return fmt.Errorf("it was not verified by the previous vdiff")
+	}
+	// The rows that differed and did not change since
+	// would not be compared again.
+	if dr := base.report; dr.MismatchedRows+dr.ExtraRowsSource+dr.ExtraRowsTarget != 0 {
+		return fmt.Errorf("the previous vdiff found differences")
+	}
+	for id := range plan.sourceQueries {
+		if base.verifiedPos[id] == "" {
+			return fmt.Errorf("the previous vdiff did not record the position of stream %d", id)
+		}
+	}
+	return nil
+}
+
+// changedRow is the last image of a changed row. row is nil if it was deleted.
+type changedRow struct {
+	pk  []sqltypes.Value
+	row []sqltypes.Value
+}
+
+// changedRows collects the rows of a table that changed, by pk.
+type changedRows struct {
+	table   string
+	pkNames []string
+	// fields are the fields of the rows, and pkCols the
+	// indexes of the pk columns in them.
+	fields []*querypb.Field
+	pkCols []int
+	rows   map[string]*changedRow
+}
+
+func newChangedRows(table string, pkNames []string) *changedRows {
+	return &changedRows{
+		table:   table,
+		pkNames: pkNames,
+		rows:    make(map[string]*changedRow),
+	}
+}
+
+func (cr *changedRows) setFields(fields []*querypb.Field) error {
+	if cr.fields != nil {
+		if !sameColumns(fieldNames(cr.fields), fieldNames(fields)) {
+			return fmt.Errorf("the columns of table %s changed since the previous vdiff, run a full vdiff", cr.table)
+		}
+		cr.fields = fields
+		return nil
+	}
+	var pkCols []int
+	for _, pk := range cr.pkNames {
+		found := false
+		for i, field := range fields {
+			if strings.EqualFold(field.Name, pk) {
+				pkCols = append(pkCols, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("table %s: primary key column %s is not replicated by the workflow", cr.table, pk)
+		}
+	}
+	cr.fields = fields
+	cr.pkCols = pkCols
+	return nil
+}
+
+func (cr *changedRows) add(rowChange *binlogdatapb.RowChange) error {
+	if cr.fields == nil {
+		return fmt.Errorf("received a row of table %s before its fields", cr.table)
+	}
+	if rowChange.Before != nil {
+		before := sqltypes.MakeRowTrusted(cr.fields, rowChange.Before)
+		pk := pkValues(before, cr.pkCols)
+		cr.rows[pkKey(pk)] = &changedRow{pk: pk}
+	}
+	if rowChange.After != nil {
+		after := sqltypes.MakeRowTrusted(cr.fields, rowChange.After)
+		pk := pkValues(after, cr.pkCols)
+		cr.rows[pkKey(pk)] = &changedRow{pk: pk, row: after}
+	}
+	return nil
+}
+
+// merge adds the rows of another source. A row that moved from one source
+// to the other is deleted in one of them: its image is kept.
+func (cr *changedRows) merge(other *changedRows) error {
+	if other.fields == nil {
+		return nil
+	}
+	if cr.fields == nil {
+		cr.fields = other.fields
+		cr.pkCols = other.pkCols
+	} else if !sameColumns(fieldNames(cr.fields), fieldNames(other.fields)) {
+		return fmt.Errorf("table %s: the sources of the workflow have different columns", cr.table)
+	}
+	for key, row := range other.rows {
+		if existing, ok := cr.rows[key]; ok && existing.row != nil {
+			continue
+		}
+		cr.rows[key] = row
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of the rows in a stable order.
+func (cr *changedRows) sortedKeys() []string {
+	keys := make([]string, 0, len(cr.rows))
+	for key := range cr.rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func pkValues(row []sqltypes.Value, pkCols []int) []sqltypes.Value {
+	pk := make([]sqltypes.Value, len(pkCols))
+	for i, col := range pkCols {
+		pk[i] = row[col]
+	}
+	return pk
+}
+
+// pkKey encodes pk values into a map key.
+func pkKey(pk []sqltypes.Value) string {
+	var buf strings.Builder
+	for _, value := range pk {
+		value.EncodeSQL(&buf)
+		buf.WriteByte(',')
+	}
+	return buf.String()
+}
+
+func fieldNames(fields []*querypb.Field) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	return names
+}
+
+// sourceTableName returns the name of the table a source query selects from.
+func sourceTableName(query string) (string, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return "", fmt.Errorf("unexpected source query: %v", query)
+	}
+	aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return "", fmt.Errorf("unexpected source query: %v", query)
+	}
+	tableName, err := aliased.TableName()
+	if err != nil {
+		return "", err
+	}
+	return tableName.Name.String(), nil
+}
+
+// buildTargetPKQuery returns the query that selects the target rows of the listed pks.
+func buildTargetPKQuery(table string, columns []string, pkCols []int, pks [][]sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString(buildTargetQuery(table, columns))
+	buf.Myprintf(" where (")
+	for i, col := range pkCols {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(columns[col]))
+	}
+	buf.Myprintf(") in (")
+	for i, pk := range pks {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("(")
+		for j, value := range pk {
+			if j != 0 {
+				buf.Myprintf(", ")
+			}
+			value.EncodeSQL(buf)
+		}
+		buf.Myprintf(")")
+	}
+	buf.Myprintf(")")
+	return buf.String()
+}
+
+// diffTableIncremental compares the rows of the table that changed since
+// base was verified. It stops the target streams, collects the rows that
+// changed on the sources up to the positions where the streams stopped,
+// reads the corresponding target rows, and restarts the streams. The
+// streams are stopped while the binlogs are read: an incremental vdiff
+// is meant to be run shortly after a previous one.
+func (ct *controller) diffTableIncremental(ctx context.Context, plan *tablePlan, sources map[int]*binlogdatapb.BinlogSource, base *tableProgress) error {
+	log.Infof("VDiff %s: incrementally diffing table %s from positions %v", ct.uuid, plan.table, base.verifiedPos)
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlStartIncrementalTable, ct.id, encodeString(plan.table))); err != nil {
+		return err
+	}
+
+	pkNames, err := ct.readPKColumns(ctx, plan.table)
+	if err != nil {
+		return err
+	}
+
+	restartStreams, err := ct.stopStreams()
+	if err != nil {
+		return err
+	}
+	defer restartStreams()
+
+	changes := newChangedRows(plan.table, pkNames)
+	positions := make(map[int]string)
+	for _, id := range plan.streamIDs() {
+		pos, err := ct.readStreamPos(id)
+		if err != nil {
+			return err
+		}
+		waitCtx, waitCancel := context.WithTimeout(ctx, ct.waitTime())
+		tablet, err := ct.pickSourceTablet(waitCtx, sources[id], pos)
+		waitCancel()
+		if err != nil {
+			return err
+		}
+		streamChanges := newChangedRows(plan.table, pkNames)
+		if err := ct.streamChanges(ctx, tablet, sources[id], plan.sourceQueries[id], base.verifiedPos[id], pos, streamChanges); err != nil {
+			return vterrors.Wrapf(err, "stream %d", id)
+		}
+		if err := changes.merge(streamChanges); err != nil {
+			return err
+		}
+		positions[id] = pos
+	}
+
+	targetRows, err := ct.readTargetRows(ctx, plan, changes)
+	if err != nil {
+		return err
+	}
+	if err := restartStreams(); err != nil {
+		return err
+	}
+
+	report, err := compareChangedRows(changes, targetRows)
+	if err != nil {
+		return err
+	}
+	if err := ct.saveReport(ctx, plan.table, "", report); err != nil {
+		return err
+	}
+	if err := ct.setVerifiedPos(ctx, plan.table, positions); err != nil {
+		return err
+	}
+	if _, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlUpdateTableState, encodeString(CompletedState), ct.id, encodeString(plan.table))); err != nil {
+		return err
+	}
+	log.Infof("VDiff %s: table %s completed: %+v", ct.uuid, plan.table, *report)
+	return nil
+}
+
+// streamChanges collects the rows of the table that changed on the source
+// tablet between the from and to positions.
+func (ct *controller) streamChanges(ctx context.Context, tablet *topodatapb.Tablet, bls *binlogdatapb.BinlogSource, query, from, to string, changes *changedRows) error {
+	fromPos, err := mysql.DecodePosition(from)
+	if err != nil {
+		return err
+	}
+	toPos, err := mysql.DecodePosition(to)
+	if err != nil {
+		return err
+	}
+	if fromPos.AtLeast(toPos) {
+		return nil
+	}
+	table, err := sourceTableName(query)
+	if err != nil {
+		return err
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  table,
+			Filter: query,
+		}},
+	}
+
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+	target := &querypb.Target{
+		Keyspace:   bls.Keyspace,
+		Shard:      bls.Shard,
+		TabletType: tablet.Type,
+	}
+	var pos mysql.Position
+	err = conn.VStream(ctx, target, from, nil, filter, func(events []*binlogdatapb.VEvent) error {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_FIELD:
+				if err := changes.setFields(event.FieldEvent.Fields); err != nil {
+					return err
+				}
+			case binlogdatapb.VEventType_ROW:
+				for _, rowChange := range event.RowEvent.RowChanges {
+					if err := changes.add(rowChange); err != nil {
+						return err
+					}
+				}
+			case binlogdatapb.VEventType_GTID:
+				if pos, err = mysql.DecodePosition(event.Gtid); err != nil {
+					return err
+				}
+			case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+				if !pos.IsZero() && pos.AtLeast(toPos) {
+					return io.EOF
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// readPKColumns returns the names of the pk columns of a target table.
+func (ct *controller) readPKColumns(ctx context.Context, table string) ([]string, error) {
+	qr, err := ct.vde.execWithDDL(ctx, fmt.Sprintf(sqlGetPKColumns, encodeString(ct.vde.dbName), encodeString(table)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key", table)
+	}
+	pkNames := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		pkNames = append(pkNames, row[0].ToString())
+	}
+	return pkNames, nil
+}
+
+// readTargetRows reads the target rows of the changed pks, by pk key.
+func (ct *controller) readTargetRows(ctx context.Context, plan *tablePlan, changes *changedRows) (map[string][]sqltypes.Value, error) {
+	targetRows := make(map[string][]sqltypes.Value)
+	if len(changes.rows) == 0 {
+		return targetRows, nil
+	}
+	columns := plan.targetColumns
+	if columns == nil {
+		columns = fieldNames(changes.fields)
+	}
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	keys := changes.sortedKeys()
+	for len(keys) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		batch := keys
+		if len(batch) > targetBatchSize {
+			batch = batch[:targetBatchSize]
+		}
+		keys = keys[len(batch):]
+		pks := make([][]sqltypes.Value, len(batch))
+		for i, key := range batch {
+			pks[i] = changes.rows[key].pk
+		}
+		qr, err := dbClient.ExecuteFetch(buildTargetPKQuery(plan.table, columns, changes.pkCols, pks), len(batch))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range qr.Rows {
+			targetRows[pkKey(pkValues(row, changes.pkCols))] = row
+		}
+	}
+	return targetRows, nil
+}
+
+// compareChangedRows compares the last source images of the changed rows
+// with the target rows. A row that was deleted on both sides matches.
+func compareChangedRows(changes *changedRows, targetRows map[string][]sqltypes.Value) (*DiffReport, error) {
+	report := &DiffReport{}
+	allCols := make([]int, len(changes.fields))
+	for i := range allCols {
+		allCols[i] = i
+	}
+	logged := 0
+	logDiff := func(format string, args ...interface{}) {
+		if logged < maxLoggedDiffs {
+			log.Errorf("VDiff [table=%v] "+format, append([]interface{}{changes.table}, args...)...)
+			logged++
+		}
+	}
+	for _, key := range changes.sortedKeys() {
+		sourceRow := changes.rows[key].row
+		targetRow := targetRows[key]
+		report.ProcessedRows++
+		switch {
+		case sourceRow == nil && targetRow == nil:
+			report.MatchingRows++
+		case targetRow == nil:
+			logDiff("Extra row on source: %v", sourceRow)
+			report.ExtraRowsSource++
+		case sourceRow == nil:
+			logDiff("Extra row on target: %v", targetRow)
+			report.ExtraRowsTarget++
+		default:
+			c, err := compareColumns(sourceRow, targetRow, allCols)
+			if err != nil {
+				return nil, err
+			}
+			if c != 0 {
+				logDiff("Different content in same PK: %v != %v", sourceRow, targetRow)
+				report.MismatchedRows++
+			} else {
+				report.MatchingRows++
+			}
+		}
+	}
+	return report, nil
+}
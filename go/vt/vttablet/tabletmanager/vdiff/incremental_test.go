@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// testRowChange builds a row change of testFields. An empty image is nil.
+func testRowChange(before, after string) *binlogdatapb.RowChange {
+	rowChange := &binlogdatapb.RowChange{}
+	if before != "" {
+		rowChange.Before = sqltypes.RowToProto3(sqltypes.MakeTestResult(testFields, before).Rows[0])
+	}
+	if after != "" {
+		rowChange.After = sqltypes.RowToProto3(sqltypes.MakeTestResult(testFields, after).Rows[0])
+	}
+	return rowChange
+}
+
+func TestChangedRows(t *testing.T) {
+	source1 := newChangedRows("t1", []string{"id"})
+	assert.EqualError(t, source1.add(testRowChange("", "1|a")), "received a row of table t1 before its fields")
+	require.NoError(t, source1.setFields(testFields))
+	for _, rowChange := range []*binlogdatapb.RowChange{
+		testRowChange("", "1|a"),
+		testRowChange("1|a", "1|b"),
+		testRowChange("", "2|c"),
+		testRowChange("2|c", ""),
+		// The pk of the row changes.
+		testRowChange("3|d", "4|d"),
+	} {
+		require.NoError(t, source1.add(rowChange))
+	}
+
+	// The row moved from the second source to the first one.
+	source2 := newChangedRows("t1", []string{"id"})
+	require.NoError(t, source2.setFields(testFields))
+	require.NoError(t, source2.add(testRowChange("4|x", "")))
+	require.NoError(t, source2.add(testRowChange("", "5|e")))
+
+	changes := newChangedRows("t1", []string{"id"})
+	require.NoError(t, changes.merge(source1))
+	require.NoError(t, changes.merge(source2))
+	require.NoError(t, changes.merge(newChangedRows("t1", []string{"id"})))
+
+	targetRows := make(map[string][]sqltypes.Value)
+	for _, row := range sqltypes.MakeTestResult(testFields, "1|b", "2|c", "4|x").Rows {
+		targetRows[pkKey(row[:1])] = row
+	}
+	report, err := compareChangedRows(changes, targetRows)
+	require.NoError(t, err)
+	// 1 matches, 2 is extra on the target, 3 was deleted on both
+	// sides, 4 differs and 5 is extra on the source.
+	assert.Equal(t, &DiffReport{
+		ProcessedRows:   5,
+		MatchingRows:    2,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+	}, report)
+
+	other := newChangedRows("t1", []string{"id"})
+	require.NoError(t, other.setFields(testFields[:1]))
+	assert.EqualError(t, changes.merge(other), "table t1: the sources of the workflow have different columns")
+	assert.EqualError(t, source1.setFields(testFields[:1]), "the columns of table t1 changed since the previous vdiff, run a full vdiff")
+	assert.EqualError(t, newChangedRows("t1", []string{"id2"}).setFields(testFields), "table t1: primary key column id2 is not replicated by the workflow")
+}
+
+func TestCanDiffIncrementally(t *testing.T) {
+	plan := &tablePlan{
+		table:         "t1",
+		sourceQueries: map[int]string{1: "select * from t1", 2: "select * from t1"},
+	}
+	base := &tableProgress{
+		table:       "t1",
+		state:       CompletedState,
+		report:      &DiffReport{ProcessedRows: 10, MatchingRows: 10},
+		verifiedPos: map[int]string{1: "MySQL56/a:1-10", 2: "MySQL56/b:1-10"},
+	}
+	assert.NoError(t, canDiffIncrementally(plan, base))
+	assert.EqualError(t, canDiffIncrementally(plan, nil), "it was not verified by the previous vdiff")
+
+	delete(base.verifiedPos, 2)
+	assert.EqualError(t, canDiffIncrementally(plan, base), "the previous vdiff did not record the position of stream 2")
+
+	base.report.MismatchedRows = 1
+	assert.EqualError(t, canDiffIncrementally(plan, base), "the previous vdiff found differences")
+}
+
+func TestBuildTargetPKQuery(t *testing.T) {
+	pks := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarChar("b'c")},
+	}
+	query := buildTargetPKQuery("t1", []string{"c1", "c2", "c3"}, []int{0, 2}, pks)
+	assert.Equal(t, "select c1, c2, c3 from t1 where (c1, c3) in ((1, 'a'), (2, 'b\\'c'))", query)
+
+	table, err := sourceTableName("select c1, c2 from t2 where in_keyrange('-80')")
+	require.NoError(t, err)
+	assert.Equal(t, "t2", table)
+}
+
+func TestChangedColumns(t *testing.T) {
+	cr := newChangedRows("t1", []string{"id"})
+	require.NoError(t, cr.setFields(testFields))
+
+	// The columns are compared by name and position: the
+	// rows of the binlogs are positional.
+	assert.Error(t, cr.setFields(sqltypes.MakeTestFields("val|id", "varchar|int64")))
+	assert.Error(t, cr.setFields(sqltypes.MakeTestFields("id|val2", "int64|varchar")))
+	assert.Error(t, cr.setFields(sqltypes.MakeTestFields("id|val|val2", "int64|varchar|varchar")))
+
+	// A type change keeps the columns, the new fields are used for the next rows.
+	newFields := sqltypes.MakeTestFields("id|val", "int64|int64")
+	require.NoError(t, cr.setFields(newFields))
+	require.NoError(t, cr.add(&binlogdatapb.RowChange{After: sqltypes.RowToProto3(sqltypes.MakeTestResult(newFields, "1|12").Rows[0])}))
+	assert.Equal(t, sqltypes.NewInt64(12), cr.rows[pkKey([]sqltypes.Value{sqltypes.NewInt64(1)})].row[1])
+
+	// A source whose columns differ cannot be merged.
+	other := newChangedRows("t1", []string{"id"})
+	require.NoError(t, other.setFields([]*querypb.Field{{Name: "val", Type: querypb.Type_VARCHAR}, {Name: "id", Type: querypb.Type_INT64}}))
+	assert.Error(t, cr.merge(other))
+}
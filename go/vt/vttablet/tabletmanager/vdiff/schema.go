@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1000),
+  keyspace varbinary(256),
+  shard varchar(255) not null,
+  db_name varbinary(255),
+  state varbinary(64),
+  options varbinary(4096),
+  created_at timestamp default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(1024),
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name(64), workflow(64))
+)`
+
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) not null,
+  table_name varbinary(128) not null,
+  state varbinary(64),
+  lastpk varbinary(2000),
+  table_rows bigint(20) not null default 0,
+  rows_compared bigint(20) not null default 0,
+  report varbinary(1024),
+  created_at timestamp default current_timestamp,
+  updated_at timestamp default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name)
+)`
+
+	// verified_pos records, by stream id, the source positions at which
+	// the rows of the table were verified. It's used as the starting
+	// point of the next incremental vdiff.
+	alterVDiffTableAddVerifiedPos = "alter table _vt.vdiff_table add column verified_pos varbinary(10000)"
+	alterVDiffTableAddIncremental = "alter table _vt.vdiff_table add column incremental tinyint(1) not null default 0"
+
+	sqlNewVDiff = "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values (%s, %s, %s, %s, %s, %s, %s)"
+	// sqlGetVDiffsByState returns the vdiffs of the database that are in one of the listed states.
+	sqlGetVDiffsByState = "select id, vdiff_uuid, workflow, options from _vt.vdiff where db_name = %s and state in (%s)"
+	sqlGetVDiffByUUID   = "select id, vdiff_uuid, workflow, state, last_error, unix_timestamp(started_at) as started_at, unix_timestamp(completed_at) as completed_at from _vt.vdiff where db_name = %s and vdiff_uuid = %s"
+	sqlGetLastVDiff     = "select id, vdiff_uuid, workflow, state, last_error, unix_timestamp(started_at) as started_at, unix_timestamp(completed_at) as completed_at from _vt.vdiff where db_name = %s and workflow = %s order by id desc limit 1"
+	sqlGetActiveVDiff   = "select vdiff_uuid from _vt.vdiff where db_name = %s and workflow = %s and state in ('pending', 'started')"
+	sqlSetVDiffState    = "update _vt.vdiff set state = %s, last_error = %s where id = %d"
+	sqlStartVDiff       = "update _vt.vdiff set state = 'started', last_error = '', started_at = coalesce(started_at, now()) where id = %d"
+	sqlCompleteVDiff    = "update _vt.vdiff set state = 'completed', completed_at = now() where id = %d"
+	sqlResumeVDiff      = "update _vt.vdiff set state = 'pending', last_error = '' where id = %d and state in ('stopped', 'error')"
+	sqlStopVDiff        = "update _vt.vdiff set state = 'stopped' where id = %d and state in ('pending', 'started')"
+	// sqlGetBaselineVDiff returns the last completed vdiff that precedes a vdiff.
+	sqlGetBaselineVDiff = "select id from _vt.vdiff where db_name = %s and workflow = %s and state = 'completed' and id < %d order by id desc limit 1"
+
+	sqlNewVDiffTable     = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state, table_rows) values (%d, %s, 'pending', %d)"
+	sqlGetVDiffTables    = "select table_name, state, lastpk, table_rows, report, verified_pos, incremental from _vt.vdiff_table where vdiff_id = %d order by table_name"
+	sqlUpdateTableState  = "update _vt.vdiff_table set state = %s where vdiff_id = %d and table_name = %s"
+	sqlUpdateTableReport = "update _vt.vdiff_table set lastpk = %s, rows_compared = %d, report = %s where vdiff_id = %d and table_name = %s"
+	// sqlSetVerifiedPos keeps the first recorded positions: if the diff of the table
+	// was resumed, its first rows were verified at the positions of the first run.
+	sqlSetVerifiedPos = "update _vt.vdiff_table set verified_pos = coalesce(verified_pos, %s) where vdiff_id = %d and table_name = %s"
+	// sqlStartIncrementalTable resets the report, because an
+	// interrupted incremental diff of a table restarts from scratch.
+	sqlStartIncrementalTable = "update _vt.vdiff_table set state = 'started', incremental = 1, verified_pos = null, report = null, rows_compared = 0 where vdiff_id = %d and table_name = %s"
+
+	sqlGetPKColumns = "select column_name from information_schema.key_column_usage where table_schema = %s and table_name = %s and constraint_name = 'PRIMARY' order by ordinal_position"
+
+	sqlGetTableRows = "select table_name, table_rows from information_schema.tables where table_schema = %s and table_type = 'BASE TABLE'"
+
+	sqlGetWorkflowStreams = "select id, source from _vt.vreplication where db_name = %s and workflow = %s"
+	sqlStopStreams        = "update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where db_name = %s and workflow = %s"
+	sqlReadStreamPos      = "select pos from _vt.vreplication where id = %d"
+	sqlSyncStream         = "update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d"
+	sqlRestartStreams     = "update _vt.vreplication set state = 'Running', message = '', stop_pos = '' where db_name = %s and workflow = %s"
+)
+
+// The states of a vdiff and of its tables.
+const (
+	PendingState   = "pending"
+	StartedState   = "started"
+	StoppedState   = "stopped"
+	CompletedState = "completed"
+	ErrorState     = "error"
+)
+
+// The actions that can be requested through the VDiff RPC.
+const (
+	CreateAction = "create"
+	ResumeAction = "resume"
+	StopAction   = "stop"
+	ShowAction   = "show"
+
+	// LastVDiff can be used as the uuid of a show action
+	// to select the most recent vdiff of the workflow.
+	LastVDiff = "last"
+)
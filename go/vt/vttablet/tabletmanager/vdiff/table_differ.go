@@ -0,0 +1,454 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// progressInterval is how often the progress of a table diff is saved.
+// It can be changed to a smaller value for tests.
+var progressInterval = 10 * time.Second
+
+// maxLoggedDiffs is the number of differences logged per table and run.
+const maxLoggedDiffs = 10
+
+// DiffReport is the summary of differences for one table.
+// It has the same fields as the report of the in-process VDiff.
+type DiffReport struct {
+	ProcessedRows   int
+	MatchingRows    int
+	MismatchedRows  int
+	ExtraRowsSource int
+	ExtraRowsTarget int
+}
+
+// tableProgress is the recorded progress of the diff of one table.
+type tableProgress struct {
+	table     string
+	state     string
+	tableRows int64
+	lastpk    *querypb.QueryResult
+	report    *DiffReport
+	// verifiedPos contains the source positions at which the
+	// rows were verified, by stream id.
+	verifiedPos map[int]string
+	incremental bool
+}
+
+func (tp *tableProgress) toProto() *tabletmanagerdatapb.VDiffTableReport {
+	return &tabletmanagerdatapb.VDiffTableReport{
+		TableName:       tp.table,
+		State:           tp.state,
+		TableRows:       tp.tableRows,
+		ProcessedRows:   int64(tp.report.ProcessedRows),
+		MatchingRows:    int64(tp.report.MatchingRows),
+		MismatchedRows:  int64(tp.report.MismatchedRows),
+		ExtraRowsSource: int64(tp.report.ExtraRowsSource),
+		ExtraRowsTarget: int64(tp.report.ExtraRowsTarget),
+		Incremental:     tp.incremental,
+	}
+}
+
+func encodeLastPK(fields []*querypb.Field, lastpk []sqltypes.Value) (string, error) {
+	if lastpk == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	err := proto.CompactText(&buf, &querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(lastpk)},
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func decodeLastPK(s string) (*querypb.QueryResult, error) {
+	qr := &querypb.QueryResult{}
+	if err := proto.UnmarshalText(s, qr); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer receives the rows of one VStreamRows call. The stream
+// runs in its own goroutine, and the rows are consumed with next().
+type shardStreamer struct {
+	name string
+
+	// ready is closed when the first response was received,
+	// or the stream failed before sending one.
+	ready     chan struct{}
+	readyOnce sync.Once
+	// fields, pkfields and gtid are set by the first response.
+	fields   []*querypb.Field
+	pkfields []*querypb.Field
+	gtid     string
+
+	results chan [][]sqltypes.Value
+	rows    [][]sqltypes.Value
+	// err is set before results is closed.
+	err error
+}
+
+func newShardStreamer(name string) *shardStreamer {
+	return &shardStreamer{
+		name:    name,
+		ready:   make(chan struct{}),
+		results: make(chan [][]sqltypes.Value, 1),
+	}
+}
+
+// stream runs streamRows and feeds its responses to the consumer.
+// It's meant to be called as a goroutine.
+func (ss *shardStreamer) stream(ctx context.Context, streamRows func(send func(*binlogdatapb.VStreamRowsResponse) error) error) {
+	defer close(ss.results)
+	defer ss.markReady()
+
+	ss.err = streamRows(func(response *binlogdatapb.VStreamRowsResponse) error {
+		if response.Fields != nil {
+			ss.fields = response.Fields
+			ss.pkfields = response.Pkfields
+			ss.gtid = response.Gtid
+			ss.markReady()
+		}
+		if len(response.Rows) == 0 {
+			return nil
+		}
+		rows := make([][]sqltypes.Value, len(response.Rows))
+		for i, row := range response.Rows {
+			rows[i] = sqltypes.MakeRowTrusted(ss.fields, row)
+		}
+		select {
+		case ss.results <- rows:
+		case <-ctx.Done():
+			return fmt.Errorf("stream %s: %v", ss.name, ctx.Err())
+		}
+		return nil
+	})
+}
+
+func (ss *shardStreamer) markReady() {
+	ss.readyOnce.Do(func() { close(ss.ready) })
+}
+
+// waitReady waits for the first response of the stream.
+func (ss *shardStreamer) waitReady(ctx context.Context) error {
+	select {
+	case <-ss.ready:
+	case <-ctx.Done():
+		return fmt.Errorf("stream %s: %v", ss.name, ctx.Err())
+	}
+	if ss.fields == nil {
+		if ss.err == nil {
+			return fmt.Errorf("stream %s ended without sending fields", ss.name)
+		}
+		return fmt.Errorf("stream %s: %v", ss.name, ss.err)
+	}
+	return nil
+}
+
+// next returns the next row, or nil at the end of the stream.
+func (ss *shardStreamer) next() ([]sqltypes.Value, error) {
+	for len(ss.rows) == 0 {
+		rows, ok := <-ss.results
+		if !ok {
+			if ss.err != nil {
+				return nil, fmt.Errorf("stream %s: %v", ss.name, ss.err)
+			}
+			return nil, nil
+		}
+		ss.rows = rows
+	}
+	row := ss.rows[0]
+	ss.rows = ss.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// mergeStreamer
+
+// rowSource returns the rows of a table, ordered by pk.
+type rowSource interface {
+	next() ([]sqltypes.Value, error)
+}
+
+// mergeStreamer merges the rows of several streams, which are
+// individually ordered by pk, into one ordered stream.
+type mergeStreamer struct {
+	streams []rowSource
+	pkCols  []int
+	heads   [][]sqltypes.Value
+}
+
+func newMergeStreamer(streams []rowSource, pkCols []int) rowSource {
+	if len(streams) == 1 {
+		return streams[0]
+	}
+	return &mergeStreamer{
+		streams: streams,
+		pkCols:  pkCols,
+	}
+}
+
+func (ms *mergeStreamer) next() ([]sqltypes.Value, error) {
+	if ms.heads == nil {
+		ms.heads = make([][]sqltypes.Value, len(ms.streams))
+		for i, stream := range ms.streams {
+			row, err := stream.next()
+			if err != nil {
+				return nil, err
+			}
+			ms.heads[i] = row
+		}
+	}
+	min := -1
+	for i, row := range ms.heads {
+		if row == nil {
+			continue
+		}
+		if min == -1 {
+			min = i
+			continue
+		}
+		c, err := compareColumns(row, ms.heads[min], ms.pkCols)
+		if err != nil {
+			return nil, err
+		}
+		if c < 0 {
+			min = i
+		}
+	}
+	if min == -1 {
+		return nil, nil
+	}
+	row := ms.heads[min]
+	next, err := ms.streams[min].next()
+	if err != nil {
+		return nil, err
+	}
+	ms.heads[min] = next
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// tableDiffer
+
+// tableDiffer compares the rows of one table, ordered by pk.
+type tableDiffer struct {
+	table string
+	// pkCols and compareCols are the indexes of the pk
+	// and non-pk columns in the rows.
+	pkCols      []int
+	compareCols []int
+	pkFields    []*querypb.Field
+
+	source rowSource
+	target rowSource
+
+	// report and lastpk reflect all the rows compared so far,
+	// including the ones compared by previous runs.
+	report *DiffReport
+	lastpk []sqltypes.Value
+}
+
+// newTableDiffer creates a tableDiffer for rows that have the given fields.
+// The pks are the target pks: the rows are ordered by them.
+func newTableDiffer(table string, fields, pkfields []*querypb.Field, report *DiffReport) (*tableDiffer, error) {
+	td := &tableDiffer{
+		table:    table,
+		pkFields: pkfields,
+		report:   report,
+	}
+	isPK := make(map[int]bool)
+	for _, pk := range pkfields {
+		found := false
+		for i, field := range fields {
+			if strings.EqualFold(field.Name, pk.Name) {
+				td.pkCols = append(td.pkCols, i)
+				isPK[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("table %s: primary key column %s is not replicated by the workflow", table, pk.Name)
+		}
+	}
+	if len(td.pkCols) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key", table)
+	}
+	for i := range fields {
+		if !isPK[i] {
+			td.compareCols = append(td.compareCols, i)
+		}
+	}
+	return td, nil
+}
+
+// validateSource verifies that the source rows are ordered the same way as
+// the target rows, and that the target lastpk can be used to resume the source.
+func (td *tableDiffer) validateSource(source *shardStreamer) error {
+	if len(source.fields) != len(td.pkCols)+len(td.compareCols) {
+		return fmt.Errorf("table %s: the source %s has %d columns, the target has %d", td.table, source.name, len(source.fields), len(td.pkCols)+len(td.compareCols))
+	}
+	if len(source.pkfields) != len(td.pkCols) {
+		return fmt.Errorf("table %s: the primary keys of the source %s and the target differ, use the in-process VDiff", td.table, source.name)
+	}
+	for i, pkCol := range td.pkCols {
+		if !strings.EqualFold(source.fields[pkCol].Name, source.pkfields[i].Name) {
+			return fmt.Errorf("table %s: the primary keys of the source %s and the target differ, use the in-process VDiff", td.table, source.name)
+		}
+	}
+	return nil
+}
+
+// diff compares the rows until both sides are exhausted. saveProgress
+// is called periodically, and can record td.report and td.lastpk.
+func (td *tableDiffer) diff(ctx context.Context, saveProgress func() error) error {
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	var loggedSource, loggedTarget, loggedMismatch int
+	advanceSource := true
+	advanceTarget := true
+	lastSave := time.Now()
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if time.Since(lastSave) >= progressInterval {
+			if err := saveProgress(); err != nil {
+				return err
+			}
+			lastSave = time.Now()
+		}
+		if advanceSource {
+			if sourceRow, err = td.source.next(); err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = td.target.next(); err != nil {
+				return err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			return nil
+		}
+		advanceSource = true
+		advanceTarget = true
+
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = compareColumns(sourceRow, targetRow, td.pkCols); err != nil {
+				return err
+			}
+		}
+		td.report.ProcessedRows++
+		switch {
+		case c < 0:
+			if loggedSource < maxLoggedDiffs {
+				log.Errorf("VDiff [table=%v] Extra row %v on source: %v", td.table, td.report.ExtraRowsSource, sourceRow)
+				loggedSource++
+			}
+			td.report.ExtraRowsSource++
+			td.setLastPK(sourceRow)
+			advanceTarget = false
+			continue
+		case c > 0:
+			if loggedTarget < maxLoggedDiffs {
+				log.Errorf("VDiff [table=%v] Extra row %v on target: %v", td.table, td.report.ExtraRowsTarget, targetRow)
+				loggedTarget++
+			}
+			td.report.ExtraRowsTarget++
+			td.setLastPK(targetRow)
+			advanceSource = false
+			continue
+		}
+
+		c, err = compareColumns(sourceRow, targetRow, td.compareCols)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			if loggedMismatch < maxLoggedDiffs {
+				log.Errorf("VDiff [table=%v] Different content %v in same PK: %v != %v", td.table, td.report.MismatchedRows, sourceRow, targetRow)
+				loggedMismatch++
+			}
+			td.report.MismatchedRows++
+		default:
+			td.report.MatchingRows++
+		}
+		td.setLastPK(sourceRow)
+	}
+}
+
+func (td *tableDiffer) setLastPK(row []sqltypes.Value) {
+	if td.lastpk == nil {
+		td.lastpk = make([]sqltypes.Value, len(td.pkCols))
+	}
+	for i, pkCol := range td.pkCols {
+		td.lastpk[i] = row[pkCol]
+	}
+}
+
+// compareColumns compares the listed columns of two rows. Text columns are
+// compared byte by byte, like the in-process VDiff does when weight strings
+// are not available.
+func compareColumns(row1, row2 []sqltypes.Value, cols []int) (int, error) {
+	for _, col := range cols {
+		var c int
+		var err error
+		if row1[col].IsText() && row2[col].IsText() {
+			c = bytes.Compare(row1[col].ToBytes(), row2[col].ToBytes())
+		} else {
+			c, err = evalengine.NullsafeCompare(row1[col], row2[col])
+		}
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// fakeRowSource returns the rows it was created with.
+type fakeRowSource struct {
+	rows [][]sqltypes.Value
+}
+
+func newFakeRowSource(fields []*querypb.Field, values ...string) *fakeRowSource {
+	result := sqltypes.MakeTestResult(fields, values...)
+	return &fakeRowSource{rows: result.Rows}
+}
+
+func (frs *fakeRowSource) next() ([]sqltypes.Value, error) {
+	if len(frs.rows) == 0 {
+		return nil, nil
+	}
+	row := frs.rows[0]
+	frs.rows = frs.rows[1:]
+	return row, nil
+}
+
+var testFields = sqltypes.MakeTestFields("id|val", "int64|varchar")
+
+func TestTableDiffer(t *testing.T) {
+	td, err := newTableDiffer("t1", testFields, testFields[:1], &DiffReport{})
+	require.NoError(t, err)
+	td.source = newFakeRowSource(testFields, "1|a", "2|b", "3|c", "5|e")
+	td.target = newFakeRowSource(testFields, "1|a", "2|x", "4|d", "5|e", "6|f")
+
+	require.NoError(t, td.diff(context.Background(), func() error { return nil }))
+	assert.Equal(t, &DiffReport{
+		ProcessedRows:   6,
+		MatchingRows:    2,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 2,
+	}, td.report)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(6)}, td.lastpk)
+}
+
+func TestTableDifferRows(t *testing.T) {
+	pkFields := sqltypes.MakeTestFields("id1|id2|val", "int64|varchar|varchar")
+	testcases := []struct {
+		name   string
+		fields []*querypb.Field
+		pks    []*querypb.Field
+		source []string
+		target []string
+		report DiffReport
+		lastpk string
+	}{{
+		name:   "empty",
+		fields: testFields,
+		pks:    testFields[:1],
+		report: DiffReport{},
+	}, {
+		name:   "matching",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"1|a", "2|b", "3|c"},
+		target: []string{"1|a", "2|b", "3|c"},
+		report: DiffReport{ProcessedRows: 3, MatchingRows: 3},
+		lastpk: "3",
+	}, {
+		name:   "missing on target",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"1|a", "2|b", "3|c"},
+		target: []string{"2|b"},
+		report: DiffReport{ProcessedRows: 3, MatchingRows: 1, ExtraRowsSource: 2},
+		lastpk: "3",
+	}, {
+		name:   "empty target",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"1|a", "2|b"},
+		report: DiffReport{ProcessedRows: 2, ExtraRowsSource: 2},
+		lastpk: "2",
+	}, {
+		name:   "extra on target",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"2|b"},
+		target: []string{"1|a", "2|b", "3|c"},
+		report: DiffReport{ProcessedRows: 3, MatchingRows: 1, ExtraRowsTarget: 2},
+		lastpk: "3",
+	}, {
+		name:   "empty source",
+		fields: testFields,
+		pks:    testFields[:1],
+		target: []string{"1|a", "2|b"},
+		report: DiffReport{ProcessedRows: 2, ExtraRowsTarget: 2},
+		lastpk: "2",
+	}, {
+		name:   "mismatched",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"1|a", "2|b", "3|c"},
+		target: []string{"1|x", "2|b", "3|C"},
+		report: DiffReport{ProcessedRows: 3, MatchingRows: 1, MismatchedRows: 2},
+		lastpk: "3",
+	}, {
+		name:   "null values",
+		fields: testFields,
+		pks:    testFields[:1],
+		source: []string{"1|null", "2|null", "3|c"},
+		target: []string{"1|null", "2|b", "3|null"},
+		report: DiffReport{ProcessedRows: 3, MatchingRows: 1, MismatchedRows: 2},
+		lastpk: "3",
+	}, {
+		name:   "composite pk",
+		fields: pkFields,
+		pks:    pkFields[:2],
+		source: []string{"1|a|x", "1|b|y", "2|a|z"},
+		target: []string{"1|b|y", "1|c|w", "2|a|v"},
+		report: DiffReport{ProcessedRows: 4, MismatchedRows: 1, MatchingRows: 1, ExtraRowsSource: 1, ExtraRowsTarget: 1},
+		lastpk: "2|a",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			td, err := newTableDiffer("t1", tcase.fields, tcase.pks, &DiffReport{})
+			require.NoError(t, err)
+			td.source = newFakeRowSource(tcase.fields, tcase.source...)
+			td.target = newFakeRowSource(tcase.fields, tcase.target...)
+
+			require.NoError(t, td.diff(context.Background(), func() error { return nil }))
+			assert.Equal(t, &tcase.report, td.report)
+			if tcase.lastpk == "" {
+				assert.Nil(t, td.lastpk)
+				return
+			}
+			assert.Equal(t, sqltypes.MakeTestResult(tcase.pks, tcase.lastpk).Rows[0], td.lastpk)
+		})
+	}
+}
+
+func TestTableDifferProgress(t *testing.T) {
+	defer func(saved time.Duration) { progressInterval = saved }(progressInterval)
+	progressInterval = 0
+
+	// The report of a previous run is added to.
+	td, err := newTableDiffer("t1", testFields, testFields[:1], &DiffReport{ProcessedRows: 2, MatchingRows: 1, MismatchedRows: 1})
+	require.NoError(t, err)
+	td.source = newFakeRowSource(testFields, "3|c", "4|d")
+	td.target = newFakeRowSource(testFields, "3|c", "4|x")
+
+	var saved []DiffReport
+	require.NoError(t, td.diff(context.Background(), func() error {
+		saved = append(saved, *td.report)
+		return nil
+	}))
+	assert.Equal(t, &DiffReport{ProcessedRows: 4, MatchingRows: 2, MismatchedRows: 2}, td.report)
+	// The progress is saved before each comparison, the caller saves the final one.
+	assert.Equal(t, []DiffReport{
+		{ProcessedRows: 2, MatchingRows: 1, MismatchedRows: 1},
+		{ProcessedRows: 3, MatchingRows: 2, MismatchedRows: 1},
+		{ProcessedRows: 4, MatchingRows: 2, MismatchedRows: 2},
+	}, saved)
+
+	// A failure to save the progress stops the diff.
+	td, err = newTableDiffer("t1", testFields, testFields[:1], &DiffReport{})
+	require.NoError(t, err)
+	td.source = newFakeRowSource(testFields, "1|a")
+	td.target = newFakeRowSource(testFields, "1|a")
+	err = td.diff(context.Background(), func() error { return fmt.Errorf("save failed") })
+	assert.EqualError(t, err, "save failed")
+	assert.Equal(t, &DiffReport{}, td.report)
+
+	// A cancelled diff stops before comparing.
+	td.source = newFakeRowSource(testFields, "1|a")
+	td.target = newFakeRowSource(testFields, "1|a")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, td.diff(ctx, func() error { return nil }))
+	assert.Equal(t, &DiffReport{}, td.report)
+}
+
+func TestTableProgressToProto(t *testing.T) {
+	tp := &tableProgress{
+		table:       "t1",
+		state:       CompletedState,
+		tableRows:   10,
+		report:      &DiffReport{ProcessedRows: 9, MatchingRows: 5, MismatchedRows: 2, ExtraRowsSource: 1, ExtraRowsTarget: 1},
+		incremental: true,
+	}
+	assert.Equal(t, &tabletmanagerdatapb.VDiffTableReport{
+		TableName:       "t1",
+		State:           CompletedState,
+		TableRows:       10,
+		ProcessedRows:   9,
+		MatchingRows:    5,
+		MismatchedRows:  2,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+		Incremental:     true,
+	}, tp.toProto())
+}
+
+func TestTableDifferErrors(t *testing.T) {
+	_, err := newTableDiffer("t1", testFields, sqltypes.MakeTestFields("id2", "int64"), &DiffReport{})
+	assert.EqualError(t, err, "table t1: primary key column id2 is not replicated by the workflow")
+
+	_, err = newTableDiffer("t1", testFields, nil, &DiffReport{})
+	assert.EqualError(t, err, "table t1 has no primary key")
+
+	td, err := newTableDiffer("t1", testFields, testFields[:1], &DiffReport{})
+	require.NoError(t, err)
+	source := newShardStreamer("-80")
+	source.fields = testFields
+	source.pkfields = testFields[1:]
+	assert.EqualError(t, td.validateSource(source), "table t1: the primary keys of the source -80 and the target differ, use the in-process VDiff")
+	source.pkfields = testFields[:1]
+	assert.NoError(t, td.validateSource(source))
+	source.fields = testFields[:1]
+	assert.EqualError(t, td.validateSource(source), "table t1: the source -80 has 1 columns, the target has 2")
+}
+
+func TestMergeStreamer(t *testing.T) {
+	ms := newMergeStreamer([]rowSource{
+		newFakeRowSource(testFields, "1|a", "4|d", "5|e"),
+		newFakeRowSource(testFields),
+		newFakeRowSource(testFields, "2|b", "3|c", "6|f"),
+	}, []int{0})
+	var got []string
+	for {
+		row, err := ms.next()
+		require.NoError(t, err)
+		if row == nil {
+			break
+		}
+		got = append(got, row[1].ToString())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, got)
+}
+
+func TestShardStreamer(t *testing.T) {
+	ctx := context.Background()
+	ss := newShardStreamer("-80")
+	go ss.stream(ctx, func(send func(*binlogdatapb.VStreamRowsResponse) error) error {
+		if err := send(&binlogdatapb.VStreamRowsResponse{
+			Fields:   testFields,
+			Pkfields: testFields[:1],
+			Gtid:     "MySQL56/a:1-10",
+		}); err != nil {
+			return err
+		}
+		if err := send(&binlogdatapb.VStreamRowsResponse{
+			Rows: []*querypb.Row{sqltypes.RowToProto3(sqltypes.MakeTestResult(testFields, "1|a").Rows[0])},
+		}); err != nil {
+			return err
+		}
+		return fmt.Errorf("connection lost")
+	})
+	require.NoError(t, ss.waitReady(ctx))
+	assert.Equal(t, "MySQL56/a:1-10", ss.gtid)
+	row, err := ss.next()
+	require.NoError(t, err)
+	assert.Equal(t, "a", row[1].ToString())
+	_, err = ss.next()
+	assert.EqualError(t, err, "stream -80: connection lost")
+}
+
+func TestLastPKEncoding(t *testing.T) {
+	s, err := encodeLastPK(testFields[:1], nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", s)
+
+	s, err = encodeLastPK(testFields[:1], []sqltypes.Value{sqltypes.NewInt64(12)})
+	require.NoError(t, err)
+	qr, err := decodeLastPK(s)
+	require.NoError(t, err)
+	result := sqltypes.Proto3ToResult(qr)
+	assert.Equal(t, "id", result.Fields[0].Name)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(12)}, result.Rows[0])
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// tablePlan describes how to fetch the rows of one table of the workflow.
+// The rows are fetched with VStreamRows, from the sources and from the
+// target, so that the comparison can restart from the last compared pk.
+// The source rowstreamers also take care of the in_keyrange filtering.
+type tablePlan struct {
+	table string
+	// sourceQueries contains the query to send to the source of each
+	// stream, by stream id. Streams that don't replicate the table
+	// are not listed.
+	sourceQueries map[int]string
+	// targetColumns lists the target columns in the order of the
+	// source select list. It's nil if the filter is a 'select *'.
+	targetColumns []string
+}
+
+// buildTablePlan builds the plan for one target table. rules contains the
+// rules of the streams that replicate the table, by stream id.
+func buildTablePlan(table string, rules map[int]*binlogdatapb.Rule) (*tablePlan, error) {
+	tp := &tablePlan{
+		table:         table,
+		sourceQueries: make(map[int]string),
+	}
+	ids := make([]int, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for i, id := range ids {
+		query, columns, err := analyzeFilter(table, rules[id].Filter)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			tp.targetColumns = columns
+		} else if !sameColumns(tp.targetColumns, columns) {
+			return nil, fmt.Errorf("table %s: the streams of the workflow select different columns", table)
+		}
+		tp.sourceQueries[id] = query
+	}
+	return tp, nil
+}
+
+// analyzeFilter returns the source query for the filter of a rule, and the
+// list of target columns. Only plain column references are supported: the
+// rows of both sides must be comparable column by column.
+func analyzeFilter(table, filter string) (string, []string, error) {
+	switch {
+	case filter == "":
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+		return buf.String(), nil, nil
+	case key.IsKeyRange(filter):
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table), sqlparser.NewStrLiteral(filter))
+		return buf.String(), nil, nil
+	}
+	statement, err := sqlparser.Parse(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return "", nil, fmt.Errorf("table %s: unexpected filter: %v", table, filter)
+	}
+	if len(sel.GroupBy) != 0 {
+		return "", nil, fmt.Errorf("table %s: aggregates are not supported by the tablet-side vdiff, use the in-process VDiff", table)
+	}
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
+		if len(sel.SelectExprs) != 1 {
+			return "", nil, fmt.Errorf("table %s: unexpected filter: %v", table, filter)
+		}
+		return filter, nil, nil
+	}
+	var columns []string
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return "", nil, fmt.Errorf("table %s: unexpected filter: %v", table, filter)
+		}
+		colName, ok := aliased.Expr.(*sqlparser.ColName)
+		if !ok {
+			return "", nil, fmt.Errorf("table %s: expression %v is not supported by the tablet-side vdiff, use the in-process VDiff", table, sqlparser.String(aliased.Expr))
+		}
+		if !aliased.As.IsEmpty() {
+			columns = append(columns, aliased.As.String())
+		} else {
+			columns = append(columns, colName.Name.String())
+		}
+	}
+	return filter, columns, nil
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// buildTargetQuery returns the query that selects the target columns
+// in the same order as the source rows.
+func buildTargetQuery(table string, columns []string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	prefix := ""
+	for _, col := range columns {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(col))
+		prefix = ", "
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(table))
+	return buf.String()
+}
+
+// workflowRules returns the rules of the streams that replicate the table,
+// by stream id.
+func workflowRules(table string, sources map[int]*binlogdatapb.BinlogSource) (map[int]*binlogdatapb.Rule, error) {
+	rules := make(map[int]*binlogdatapb.Rule)
+	for id, bls := range sources {
+		if bls.Filter == nil {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table, bls.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == vreplication.ExcludeStr {
+			continue
+		}
+		rules[id] = rule
+	}
+	return rules, nil
+}
+
+// streamIDs returns the sorted ids of the streams that replicate the table.
+func (tp *tablePlan) streamIDs() []int {
+	ids := make([]int, 0, len(tp.sourceQueries))
+	for id := range tp.sourceQueries {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestAnalyzeFilter(t *testing.T) {
+	testcases := []struct {
+		filter  string
+		query   string
+		columns []string
+		err     string
+	}{{
+		filter: "",
+		query:  "select * from t1",
+	}, {
+		filter: "-80",
+		query:  "select * from t1 where in_keyrange('-80')",
+	}, {
+		filter: "select * from t1 where in_keyrange('80-')",
+		query:  "select * from t1 where in_keyrange('80-')",
+	}, {
+		filter:  "select c1, c2 as c3 from t1",
+		query:   "select c1, c2 as c3 from t1",
+		columns: []string{"c1", "c3"},
+	}, {
+		filter: "select c1, count(*) as cnt from t1 group by c1",
+		err:    "table t1: aggregates are not supported by the tablet-side vdiff, use the in-process VDiff",
+	}, {
+		filter: "select c1, concat(c2, c3) as c4 from t1",
+		err:    "table t1: expression concat(c2, c3) is not supported by the tablet-side vdiff, use the in-process VDiff",
+	}, {
+		filter: "delete from t1",
+		err:    "table t1: unexpected filter: delete from t1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			query, columns, err := analyzeFilter("t1", tcase.filter)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.query, query)
+			assert.Equal(t, tcase.columns, columns)
+		})
+	}
+}
+
+func TestBuildTablePlan(t *testing.T) {
+	sources := map[int]*binlogdatapb.BinlogSource{
+		1: {Filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, val from t1 where in_keyrange('-80')",
+		}, {
+			Match:  "t2",
+			Filter: "exclude",
+		}}}},
+		2: {Filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, val from t1 where in_keyrange('80-')",
+		}}}},
+	}
+
+	rules, err := workflowRules("t1", sources)
+	require.NoError(t, err)
+	tp, err := buildTablePlan("t1", rules)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]string{
+		1: "select id, val from t1 where in_keyrange('-80')",
+		2: "select id, val from t1 where in_keyrange('80-')",
+	}, tp.sourceQueries)
+	assert.Equal(t, []string{"id", "val"}, tp.targetColumns)
+	assert.Equal(t, "select id, val from t1", buildTargetQuery("t1", tp.targetColumns))
+
+	rules, err = workflowRules("t2", sources)
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	sources[2].Filter.Rules[0].Filter = "select id from t1 where in_keyrange('80-')"
+	rules, err = workflowRules("t1", sources)
+	require.NoError(t, err)
+	_, err = buildTablePlan("t1", rules)
+	assert.EqualError(t, err, "table t1: the streams of the workflow select different columns")
+}
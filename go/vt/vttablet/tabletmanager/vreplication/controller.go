@@ -116,17 +116,7 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		if v := params["tablet_types"]; v != "" {
 			tabletTypesStr = v
 		}
-		log.Infof("creating tablet picker for source keyspace/shard %v/%v with cell: %v and tabletTypes: %v", ct.source.Keyspace, ct.source.Shard, cell, tabletTypesStr)
-		cells := strings.Split(cell, ",")
-
-		sourceTopo := ts
-		if ct.source.ExternalCluster != "" {
-			sourceTopo, err = sourceTopo.OpenExternalVitessClusterServer(ctx, ct.source.ExternalCluster)
-			if err != nil {
-				return nil, err
-			}
-		}
-		tp, err := discovery.NewTabletPicker(sourceTopo, cells, ct.source.Keyspace, ct.source.Shard, tabletTypesStr)
+		tp, err := newSourceTabletPicker(ctx, ts, &ct.source, cell, tabletTypesStr)
 		if err != nil {
 			return nil, err
 		}
@@ -270,6 +260,22 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 	return fmt.Errorf("missing source")
 }
 
+// newSourceTabletPicker creates a tablet picker for the source of a stream.
+func newSourceTabletPicker(ctx context.Context, ts *topo.Server, source *binlogdatapb.BinlogSource, cell, tabletTypesStr string) (*discovery.TabletPicker, error) {
+	log.Infof("creating tablet picker for source keyspace/shard %v/%v with cell: %v and tabletTypes: %v", source.Keyspace, source.Shard, cell, tabletTypesStr)
+	cells := strings.Split(cell, ",")
+
+	sourceTopo := ts
+	if source.ExternalCluster != "" {
+		var err error
+		sourceTopo, err = sourceTopo.OpenExternalVitessClusterServer(ctx, source.ExternalCluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return discovery.NewTabletPicker(sourceTopo, cells, source.Keyspace, source.Shard, tabletTypesStr)
+}
+
 func (ct *controller) setMessage(dbClient binlogplayer.DBClient, message string) error {
 	ct.blpStats.History.Add(&binlogplayer.StatsHistoryRecord{
 		Time:    time.Now(),
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// The EXEC_COORDINATED on_ddl action applies each DDL once for all the
+// streams of a workflow, which matters when a target shard has several
+// streams, like during a Reshard or a MoveTables from a sharded keyspace.
+// Each stream pauses when it reaches the DDL, and records that it's waiting
+// in its message. Once all the streams of the workflow on this tablet have
+// reached the DDL, the last one applies it, moves the positions of all the
+// streams past it, and they all resume.
+
+// OnlineDDLSubmitter submits online schema migrations. It's implemented
+// by the online DDL executor of the tablet server.
+type OnlineDDLSubmitter interface {
+	SubmitMigration(ctx context.Context, stmt sqlparser.Statement) (*sqltypes.Result, error)
+}
+
+// migrationPollInterval is how often the status of an online schema
+// migration is checked. It can be changed to a smaller value for tests.
+var migrationPollInterval = sync2.NewAtomicDuration(5 * time.Second)
+
+const (
+	sqlReadWorkflowStreamIDs = "select id from _vt.vreplication where db_name = %s and workflow = (select workflow from _vt.vreplication where id = %d)"
+	sqlSetStreamsMessage     = "update _vt.vreplication set message=%s where id in (%s)"
+	sqlSetStreamPos          = "update _vt.vreplication set pos=%s, message='' where id=%d"
+	sqlReadMigrationStatus   = "select migration_status, message from _vt.schema_migrations where migration_uuid = %s"
+)
+
+// coordinatedDDL is a DDL that the streams of a workflow apply together.
+type coordinatedDDL struct {
+	key       string
+	statement string
+	strategy  string
+	// streams contains the ids of all the streams of the workflow.
+	streams []int
+	// positions contains the position of the DDL in each stream
+	// that reached it, by stream id.
+	positions map[int]mysql.Position
+	// done is closed once the DDL was applied or failed. err is set before.
+	done chan struct{}
+	err  error
+}
+
+// SetOnlineDDLSubmitter sets the submitter used to apply coordinated DDLs
+// that have an online ddl strategy.
+func (vre *Engine) SetOnlineDDLSubmitter(submitter OnlineDDLSubmitter) {
+	vre.onlineDDL = submitter
+}
+
+// registerDDL records that the stream reached a coordinated DDL. It returns
+// the DDL to wait for and, if the stream is the last one to reach it, true:
+// the stream must then apply the DDL with applyCoordinatedDDL.
+func (vre *Engine) registerDDL(vr *vreplicator, statement string, pos mysql.Position) (*coordinatedDDL, bool, error) {
+	qr, err := vr.dbClient.ExecuteFetch(fmt.Sprintf(sqlReadWorkflowStreamIDs, encodeString(vre.dbName), vr.id), -1)
+	if err != nil {
+		return nil, false, err
+	}
+	streams := make([]int, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		id, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return nil, false, err
+		}
+		streams = append(streams, int(id))
+	}
+	sort.Ints(streams)
+	key := fmt.Sprintf("%v", streams)
+
+	vre.ddlMu.Lock()
+	defer vre.ddlMu.Unlock()
+	ddl, ok := vre.ddls[key]
+	if !ok {
+		ddl = &coordinatedDDL{
+			key:       key,
+			statement: statement,
+			strategy:  vr.source.OnDdlStrategy,
+			streams:   streams,
+			positions: make(map[int]mysql.Position),
+			done:      make(chan struct{}),
+		}
+		vre.ddls[key] = ddl
+	} else if ddl.statement != statement {
+		return nil, false, fmt.Errorf("the streams of the workflow reached different DDLs: %q and %q, they must be applied manually", ddl.statement, statement)
+	}
+	ddl.positions[int(vr.id)] = pos
+	for _, id := range streams {
+		if _, ok := ddl.positions[id]; !ok {
+			log.Infof("Stream %d reached DDL %s, waiting for stream %d", vr.id, statement, id)
+			return ddl, false, nil
+		}
+	}
+	delete(vre.ddls, key)
+	return ddl, true, nil
+}
+
+// unregisterDDL is called if a stream stops while waiting for a DDL.
+// It will register again when it replays the DDL.
+func (vre *Engine) unregisterDDL(vr *vreplicator, ddl *coordinatedDDL) {
+	vre.ddlMu.Lock()
+	defer vre.ddlMu.Unlock()
+	if vre.ddls[ddl.key] != ddl {
+		// The DDL is being applied.
+		return
+	}
+	delete(ddl.positions, int(vr.id))
+	if len(ddl.positions) == 0 {
+		delete(vre.ddls, ddl.key)
+	}
+}
+
+// applyCoordinatedDDL applies the DDL, moves the positions of all the
+// streams past it, and releases the waiting streams.
+func (vre *Engine) applyCoordinatedDDL(ctx context.Context, vr *vreplicator, ddl *coordinatedDDL) error {
+	defer close(ddl.done)
+	ddl.err = func() error {
+		ids := make([]string, 0, len(ddl.streams))
+		for _, id := range ddl.streams {
+			ids = append(ids, fmt.Sprintf("%d", id))
+		}
+		message := binlogplayer.MessageTruncate(fmt.Sprintf("Applying DDL for the streams of the workflow: %s", ddl.statement))
+		if _, err := vr.dbClient.ExecuteFetch(fmt.Sprintf(sqlSetStreamsMessage, encodeString(message), strings.Join(ids, ", ")), 0); err != nil {
+			return err
+		}
+		// It's impossible to save the positions transactionally with the statement.
+		// So, we apply the DDL first, and then save the positions.
+		// Manual intervention may be needed if there is a partial
+		// failure here.
+		if err := vre.execCoordinatedDDL(ctx, vr, ddl); err != nil {
+			return err
+		}
+		if err := vr.dbClient.Begin(); err != nil {
+			return err
+		}
+		for _, id := range ddl.streams {
+			query := fmt.Sprintf(sqlSetStreamPos, encodeString(mysql.EncodePosition(ddl.positions[id])), id)
+			if _, err := vr.dbClient.ExecuteFetch(query, 0); err != nil {
+				vr.dbClient.Rollback()
+				return err
+			}
+		}
+		return vr.dbClient.Commit()
+	}()
+	if ddl.err != nil {
+		log.Errorf("Coordinated DDL %s failed: %v", ddl.statement, ddl.err)
+	}
+	return ddl.err
+}
+
+// execCoordinatedDDL applies the DDL directly or, if the source has an
+// online ddl strategy, as an online schema migration.
+func (vre *Engine) execCoordinatedDDL(ctx context.Context, vr *vreplicator, ddl *coordinatedDDL) error {
+	setting, err := schema.ParseDDLStrategy(ddl.strategy)
+	if err != nil {
+		return err
+	}
+	if setting.Strategy.IsDirect() {
+		_, err := vr.dbClient.ExecuteWithRetry(ctx, ddl.statement)
+		return err
+	}
+	ddlStmt, _, err := schema.ParseOnlineDDLStatement(ddl.statement)
+	if err != nil {
+		return err
+	}
+	switch ddlStmt.(type) {
+	case *sqlparser.CreateTable, *sqlparser.AlterTable, *sqlparser.DropTable:
+	default:
+		// Online DDL only supports the statements above.
+		_, err := vr.dbClient.ExecuteWithRetry(ctx, ddl.statement)
+		return err
+	}
+	if vre.onlineDDL == nil {
+		return fmt.Errorf("online DDL is not available on this tablet, cannot apply %s with strategy %s", ddl.statement, ddl.strategy)
+	}
+	// The migrations are submitted to this tablet only.
+	if !setting.IsSkipTopo() {
+		setting.Options = strings.TrimSpace(setting.Options + " -skip-topo")
+	}
+	onlineDDLs, err := schema.NewOnlineDDLs("", ddlStmt, setting, fmt.Sprintf("vreplication:%s", ddl.key))
+	if err != nil {
+		return err
+	}
+	for _, onlineDDL := range onlineDDLs {
+		stmt, err := sqlparser.Parse(onlineDDL.SQL)
+		if err != nil {
+			return err
+		}
+		if _, err := vre.onlineDDL.SubmitMigration(ctx, stmt); err != nil {
+			return err
+		}
+		log.Infof("Submitted migration %s for coordinated DDL %s", onlineDDL.UUID, ddl.statement)
+		if err := waitForMigration(ctx, vr.dbClient, onlineDDL.UUID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForMigration waits for an online schema migration to complete.
+func waitForMigration(ctx context.Context, dbClient *vdbClient, uuid string) error {
+	query := fmt.Sprintf(sqlReadMigrationStatus, encodeString(uuid))
+	for {
+		qr, err := dbClient.ExecuteFetch(query, 1)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) != 1 {
+			return fmt.Errorf("migration %s not found", uuid)
+		}
+		switch status := schema.OnlineDDLStatus(qr.Rows[0][0].ToString()); status {
+		case schema.OnlineDDLStatusComplete:
+			return nil
+		case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled:
+			return fmt.Errorf("migration %s is %s: %s", uuid, status, qr.Rows[0][1].ToString())
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(migrationPollInterval.Get()):
+		}
+	}
+}
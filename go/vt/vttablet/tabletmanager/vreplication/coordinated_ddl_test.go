@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// newCoordinatedStream returns a stream of a workflow made of streams 1 and 2.
+func newCoordinatedStream(t *testing.T, vre *Engine, id uint32) (*vreplicator, *binlogplayer.MockDBClient) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vr := &vreplicator{
+		vre:      vre,
+		id:       id,
+		dbClient: newVDBClient(dbClient, binlogplayer.NewStats()),
+		source:   &binlogdatapb.BinlogSource{OnDdl: binlogdatapb.OnDDLAction_EXEC_COORDINATED},
+		stats:    binlogplayer.NewStats(),
+	}
+	return vr, dbClient
+}
+
+func expectWorkflowStreams(dbClient *binlogplayer.MockDBClient, id uint32) {
+	dbClient.ExpectRequest(fmt.Sprintf(sqlReadWorkflowStreamIDs, "'db'", id), sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2"), nil)
+}
+
+func TestCoordinatedDDLStreamsAtDifferentTimes(t *testing.T) {
+	ctx := context.Background()
+	vre := &Engine{dbName: "db", ddls: make(map[string]*coordinatedDDL)}
+	vr1, dbClient1 := newCoordinatedStream(t, vre, 1)
+	vr2, dbClient2 := newCoordinatedStream(t, vre, 2)
+	pos1, err := mysql.DecodePosition("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10")
+	require.NoError(t, err)
+	pos2, err := mysql.DecodePosition("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-12")
+	require.NoError(t, err)
+	statement := "alter table t1 add column val varchar(128)"
+
+	// Stream 1 reaches the DDL first and waits for stream 2.
+	expectWorkflowStreams(dbClient1, 1)
+	ddl, isLast, err := vre.registerDDL(vr1, statement, pos1)
+	require.NoError(t, err)
+	assert.False(t, isLast)
+	dbClient1.Wait()
+
+	// Stream 1 is stopped while waiting: it's forgotten, and registers again when it replays the DDL.
+	vre.unregisterDDL(vr1, ddl)
+	assert.Empty(t, vre.ddls)
+	expectWorkflowStreams(dbClient1, 1)
+	ddl, isLast, err = vre.registerDDL(vr1, statement, pos1)
+	require.NoError(t, err)
+	assert.False(t, isLast)
+	dbClient1.Wait()
+
+	// A stream that reaches another DDL is an error, and does not affect the waiting stream.
+	expectWorkflowStreams(dbClient2, 2)
+	_, _, err = vre.registerDDL(vr2, "drop table t1", pos2)
+	assert.EqualError(t, err, `the streams of the workflow reached different DDLs: "alter table t1 add column val varchar(128)" and "drop table t1", they must be applied manually`)
+	dbClient2.Wait()
+
+	// Stream 2 reaches the DDL later: it's the last one, and applies it for both streams.
+	expectWorkflowStreams(dbClient2, 2)
+	ddl2, isLast, err := vre.registerDDL(vr2, statement, pos2)
+	require.NoError(t, err)
+	assert.True(t, isLast)
+	assert.Equal(t, ddl, ddl2)
+	assert.Empty(t, vre.ddls)
+	dbClient2.Wait()
+
+	// Stopping stream 1 while the DDL is being applied keeps its position.
+	vre.unregisterDDL(vr1, ddl)
+	assert.Equal(t, map[int]mysql.Position{1: pos1, 2: pos2}, ddl.positions)
+
+	dbClient2.ExpectRequest("update _vt.vreplication set message='Applying DDL for the streams of the workflow: alter table t1 add column val varchar(128)' where id in (1, 2)", &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest(statement, &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest("begin", &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest(fmt.Sprintf("update _vt.vreplication set pos='%s', message='' where id=1", mysql.EncodePosition(pos1)), &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest(fmt.Sprintf("update _vt.vreplication set pos='%s', message='' where id=2", mysql.EncodePosition(pos2)), &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest("commit", &sqltypes.Result{}, nil)
+	require.NoError(t, vre.applyCoordinatedDDL(ctx, vr2, ddl))
+	dbClient2.Wait()
+
+	// The waiting stream is released.
+	select {
+	case <-ddl.done:
+	default:
+		t.Fatal("the waiting stream was not released")
+	}
+	assert.NoError(t, ddl.err)
+}
+
+func TestCoordinatedDDLFailure(t *testing.T) {
+	ctx := context.Background()
+	vre := &Engine{dbName: "db", ddls: make(map[string]*coordinatedDDL)}
+	vr1, dbClient1 := newCoordinatedStream(t, vre, 1)
+	vr2, dbClient2 := newCoordinatedStream(t, vre, 2)
+	statement := "alter table t1 add column val varchar(128)"
+
+	expectWorkflowStreams(dbClient1, 1)
+	ddl, isLast, err := vre.registerDDL(vr1, statement, mysql.Position{})
+	require.NoError(t, err)
+	assert.False(t, isLast)
+	expectWorkflowStreams(dbClient2, 2)
+	_, isLast, err = vre.registerDDL(vr2, statement, mysql.Position{})
+	require.NoError(t, err)
+	assert.True(t, isLast)
+
+	// The failure is reported to the waiting stream, and no position is moved.
+	dbClient2.ExpectRequestRE("update _vt.vreplication set message='Applying DDL.*", &sqltypes.Result{}, nil)
+	dbClient2.ExpectRequest(statement, nil, mysql.NewSQLError(mysql.ERDupFieldName, mysql.SSUnknownSQLState, "duplicate column"))
+	assert.EqualError(t, vre.applyCoordinatedDDL(ctx, vr2, ddl), "duplicate column (errno 1060) (sqlstate HY000)")
+	dbClient2.Wait()
+	<-ddl.done
+	assert.EqualError(t, ddl.err, "duplicate column (errno 1060) (sqlstate HY000)")
+
+	// Both streams will register again when they are retried.
+	assert.Empty(t, vre.ddls)
+}
@@ -121,6 +121,12 @@ type Engine struct {
 	ec        *externalConnector
 
 	throttlerClient *throttle.Client
+
+	// ddlMu synchronizes ddls. It's separate from mu because the
+	// streams wait for the coordinated DDLs while the Engine closes.
+	ddlMu     sync.Mutex
+	ddls      map[string]*coordinatedDDL
+	onlineDDL OnlineDDLSubmitter
 }
 
 type journalEvent struct {
@@ -138,6 +144,7 @@ func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mys
 		cell:            cell,
 		mysqld:          mysqld,
 		journaler:       make(map[string]*journalEvent),
+		ddls:            make(map[string]*coordinatedDDL),
 		ec:              newExternalConnector(config.ExternalConnections),
 		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
 	}
@@ -170,6 +177,7 @@ func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, db
 		dbClientFactoryDba:      dbClientFactoryDba,
 		dbName:                  dbname,
 		journaler:               make(map[string]*journalEvent),
+		ddls:                    make(map[string]*coordinatedDDL),
 		ec:                      newExternalConnector(externalConfig),
 	}
 	return vre
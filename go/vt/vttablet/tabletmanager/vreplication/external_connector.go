@@ -23,6 +23,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/grpcclient"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -51,6 +52,13 @@ type VStreamerClient interface {
 
 	// VStreamRows streams rows of a table from the specified starting point.
 	VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+	// VStreamResults streams the results of a query.
+	VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
+
+	// Execute runs a read query. Unlike VStreamResults, it takes no snapshot,
+	// and so locks no table.
+	Execute(ctx context.Context, query string) (*sqltypes.Result, error)
 }
 
 type externalConnector struct {
@@ -141,6 +149,19 @@ func (c *mysqlConnector) VStreamRows(ctx context.Context, query string, lastpk *
 	return c.vstreamer.StreamRows(ctx, query, row, send)
 }
 
+func (c *mysqlConnector) VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	return c.vstreamer.StreamResults(ctx, query, send)
+}
+
+func (c *mysqlConnector) Execute(ctx context.Context, query string) (*sqltypes.Result, error) {
+	conn, err := dbconnpool.NewDBConnection(ctx, c.env.Config().DB.AllPrivsWithDB())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.ExecuteFetch(query, 10000, true)
+}
+
 //-----------------------------------------------------------
 
 type tabletConnector struct {
@@ -177,3 +198,11 @@ func (tc *tabletConnector) VStream(ctx context.Context, startPos string, tablePK
 func (tc *tabletConnector) VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	return tc.qs.VStreamRows(ctx, tc.target, query, lastpk, send)
 }
+
+func (tc *tabletConnector) VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	return tc.qs.VStreamResults(ctx, tc.target, query, send)
+}
+
+func (tc *tabletConnector) Execute(ctx context.Context, query string) (*sqltypes.Result, error) {
+	return tc.qs.Execute(ctx, tc.target, query, nil, 0, 0, nil)
+}
@@ -180,9 +180,30 @@ type TablePlan struct {
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
+	// MinMax, SelectMinMax, SourceMinMax and UpdateMinMax are used
+	// by vplayer to recompute the min and max aggregates of a group
+	// from the sources, when a row that had the minimum or the maximum
+	// value is deleted or updated.
+	MinMax       []*minMaxColumn
+	SelectMinMax *sqlparser.ParsedQuery
+	SourceMinMax *sqlparser.ParsedQuery
+	UpdateMinMax *sqlparser.ParsedQuery
 	Stats        *binlogplayer.Stats
 }
 
+// minMaxColumn is a min or max aggregate of the target table.
+type minMaxColumn struct {
+	// Name is the name of the target column.
+	Name string
+	// Source is the name of the aggregated source column.
+	Source string
+	IsMin  bool
+}
+
+// sourceQuerier runs a query on each source of the workflow, and returns
+// their results.
+type sourceQuerier func(query string) ([]*sqltypes.Result, error)
+
 // MarshalJSON performs a custom JSON Marshalling.
 func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 	v := struct {
@@ -195,6 +216,9 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update       *sqlparser.ParsedQuery `json:",omitempty"`
 		Delete       *sqlparser.ParsedQuery `json:",omitempty"`
 		PKReferences []string               `json:",omitempty"`
+		SelectMinMax *sqlparser.ParsedQuery `json:",omitempty"`
+		SourceMinMax *sqlparser.ParsedQuery `json:",omitempty"`
+		UpdateMinMax *sqlparser.ParsedQuery `json:",omitempty"`
 	}{
 		TargetName:   tp.TargetName,
 		SendRule:     tp.SendRule.Match,
@@ -205,6 +229,9 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Update:       tp.Update,
 		Delete:       tp.Delete,
 		PKReferences: tp.PKReferences,
+		SelectMinMax: tp.SelectMinMax,
+		SourceMinMax: tp.SourceMinMax,
+		UpdateMinMax: tp.UpdateMinMax,
 	}
 	return json.Marshal(&v)
 }
@@ -272,7 +299,7 @@ func (tp *TablePlan) isOutsidePKRange(bindvars map[string]*querypb.BindVariable,
 	return false
 }
 
-func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error), querier sourceQuerier) (*sqltypes.Result, error) {
 	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
 	var before, after bool
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
@@ -301,15 +328,32 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 		if tp.Delete == nil {
 			return nil, nil
 		}
-		return execParsedQuery(tp.Delete, bindvars, executor)
+		qr, err := execParsedQuery(tp.Delete, bindvars, executor)
+		if err != nil {
+			return nil, err
+		}
+		if err := tp.recomputeMinMax(bindvars, false, executor, querier); err != nil {
+			return nil, err
+		}
+		return qr, nil
 	case before && after:
 		if !tp.pkChanged(bindvars) {
-			return execParsedQuery(tp.Update, bindvars, executor)
+			qr, err := execParsedQuery(tp.Update, bindvars, executor)
+			if err != nil {
+				return nil, err
+			}
+			if err := tp.recomputeMinMax(bindvars, true, executor, querier); err != nil {
+				return nil, err
+			}
+			return qr, nil
 		}
 		if tp.Delete != nil {
 			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
 				return nil, err
 			}
+			if err := tp.recomputeMinMax(bindvars, false, executor, querier); err != nil {
+				return nil, err
+			}
 		}
 		return execParsedQuery(tp.Insert, bindvars, executor)
 	}
@@ -317,6 +361,98 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	return nil, nil
 }
 
+// recomputeMinMax recomputes the min and max aggregates of the group of
+// the before image if the old value of the row was the minimum or the
+// maximum of the group, because it can't be known if another row of the
+// group has the same value, or what the next value is. The new values
+// are computed with a min and max query on the rows of the group in each
+// source of the workflow, since the rows of a group can come from several
+// source shards.
+// The sources may be ahead of the target, but the result converges:
+// the rows inserted later are applied with least or greatest, and the
+// rows deleted later trigger another recomputation if needed.
+// If inGroup is set, the row was updated and is still in the group.
+func (tp *TablePlan) recomputeMinMax(bindvars map[string]*querypb.BindVariable, inGroup bool, executor func(string) (*sqltypes.Result, error), querier sourceQuerier) error {
+	if len(tp.MinMax) == 0 {
+		return nil
+	}
+	qr, err := execParsedQuery(tp.SelectMinMax, bindvars, executor)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		// The group is out of the range of the copied rows.
+		return nil
+	}
+	recompute := false
+	for i, col := range tp.MinMax {
+		before, _ := sqltypes.BindVariableToValue(bindvars["b_"+col.Source])
+		if before.IsNull() {
+			continue
+		}
+		if inGroup {
+			if after, _ := sqltypes.BindVariableToValue(bindvars["a_"+col.Source]); valsEqual(before, after) {
+				continue
+			}
+		}
+		if current := qr.Rows[0][i]; current.IsNull() || aggregateEqual(current, before) {
+			recompute = true
+			break
+		}
+	}
+	if !recompute {
+		return nil
+	}
+
+	query, err := tp.SourceMinMax.GenerateQuery(bindvars, nil)
+	if err != nil {
+		return err
+	}
+	results, err := querier(query)
+	if err != nil {
+		return err
+	}
+	values := make([]sqltypes.Value, len(tp.MinMax))
+	for _, result := range results {
+		if len(result.Rows) != 1 || len(result.Rows[0]) != len(tp.MinMax) {
+			return fmt.Errorf("unexpected result for %s: %v", query, result.Rows)
+		}
+		for i, col := range tp.MinMax {
+			val := result.Rows[0][i]
+			if val.IsNull() {
+				continue
+			}
+			if values[i].IsNull() {
+				values[i] = val
+				continue
+			}
+			cmp, err := evalengine.NullsafeCompare(val, values[i])
+			if err != nil {
+				return err
+			}
+			if (col.IsMin && cmp < 0) || (!col.IsMin && cmp > 0) {
+				values[i] = val
+			}
+		}
+	}
+	for i, col := range tp.MinMax {
+		bindvars["m_"+col.Name] = sqltypes.ValueBindVariable(values[i])
+	}
+	_, err = execParsedQuery(tp.UpdateMinMax, bindvars, executor)
+	return err
+}
+
+// aggregateEqual returns true if the value of an aggregate is equal to
+// the value of a source column. Their types can be different.
+func aggregateEqual(aggregate, val sqltypes.Value) bool {
+	if sqltypes.IsNumber(aggregate.Type()) && sqltypes.IsNumber(val.Type()) {
+		if cmp, err := evalengine.NullsafeCompare(aggregate, val); err == nil {
+			return cmp == 0
+		}
+	}
+	return aggregate.ToString() == val.ToString()
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
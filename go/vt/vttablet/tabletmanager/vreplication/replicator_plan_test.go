@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -45,6 +46,9 @@ type TestTablePlan struct {
 	Update       string   `json:",omitempty"`
 	Delete       string   `json:",omitempty"`
 	PKReferences []string `json:",omitempty"`
+	SelectMinMax string   `json:",omitempty"`
+	SourceMinMax string   `json:",omitempty"`
+	UpdateMinMax string   `json:",omitempty"`
 }
 
 func TestBuildPlayerPlan(t *testing.T) {
@@ -316,6 +320,64 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// aggregates
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, count(c2) as cnt, sum(c2) as s, avg(c2) as a, min(c2) as mn, max(c3) as mx from t2 where c4 > 1 group by c1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3 from t2 where c4 > 1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,cnt,s,a,mn,mx)",
+					InsertValues: "(:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c2, 0),:a_c2,:a_c2,:a_c3)",
+					InsertOnDup:  "on duplicate key update cnt=cnt+values(cnt), s=s+ifnull(values(s), 0), mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), a=s/nullif(cnt, 0)",
+					Insert:       "insert into t1(c1,cnt,s,a,mn,mx) values (:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c2, 0),:a_c2,:a_c2,:a_c3) on duplicate key update cnt=cnt+values(cnt), s=s+ifnull(values(s), 0), mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), a=s/nullif(cnt, 0)",
+					Update:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), s=s-ifnull(:b_c2, 0)+ifnull(:a_c2, 0), mn=least(ifnull(mn, :a_c2), ifnull(:a_c2, mn)), mx=greatest(ifnull(mx, :a_c3), ifnull(:a_c3, mx)), a=s/nullif(cnt, 0) where c1=:b_c1",
+					Delete:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1), s=s-ifnull(:b_c2, 0), mn=mn, mx=mx, a=s/nullif(cnt, 0) where c1=:b_c1",
+					SelectMinMax: "select mn, mx from t1 where c1=:b_c1",
+					SourceMinMax: "select min(c2), max(c3) from t2 where (c4 > 1) and c1 <=> :b_c1",
+					UpdateMinMax: "update t1 set mn=:m_mn, mx=:m_mx where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3, pk1, pk2 from t2 where c4 > 1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,cnt,s,a,mn,mx)",
+					InsertValues: "(:a_c1,if(:a_c2 is null, 0, 1),ifnull(:a_c2, 0),:a_c2,:a_c2,:a_c3)",
+					InsertOnDup:  "on duplicate key update cnt=cnt+values(cnt), s=s+ifnull(values(s), 0), mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), a=s/nullif(cnt, 0)",
+					Insert:       "insert into t1(c1,cnt,s,a,mn,mx) select :a_c1, if(:a_c2 is null, 0, 1), ifnull(:a_c2, 0), :a_c2, :a_c2, :a_c3 from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update cnt=cnt+values(cnt), s=s+ifnull(values(s), 0), mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx)), a=s/nullif(cnt, 0)",
+					Update:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1)+if(:a_c2 is null, 0, 1), s=s-ifnull(:b_c2, 0)+ifnull(:a_c2, 0), mn=least(ifnull(mn, :a_c2), ifnull(:a_c2, mn)), mx=greatest(ifnull(mx, :a_c3), ifnull(:a_c3, mx)), a=s/nullif(cnt, 0) where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set cnt=cnt-if(:b_c2 is null, 0, 1), s=s-ifnull(:b_c2, 0), mn=mn, mx=mx, a=s/nullif(cnt, 0) where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					SelectMinMax: "select mn, mx from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					SourceMinMax: "select min(c2), max(c3) from t2 where (c4 > 1) and c1 <=> :b_c1",
+					UpdateMinMax: "update t1 set mn=:m_mn, mx=:m_mx where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
@@ -549,7 +611,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 				Filter: "select * from t1, t2",
 			}},
 		},
-		err: "unexpected: select * from t1, t2",
+		err: "unsupported join: select * from t1, t2",
 	}, {
 		// no join
 		input: &binlogdatapb.Filter{
@@ -558,7 +620,7 @@ func TestBuildPlayerPlan(t *testing.T) {
 				Filter: "select * from t1 join t2",
 			}},
 		},
-		err: "unexpected: select * from t1 join t2",
+		err: "unsupported join: select * from t1 join t2",
 	}, {
 		// no subqueries
 		input: &binlogdatapb.Filter{
@@ -595,6 +657,15 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "unexpected: hour(distinct c1)",
+	}, {
+		// no distinct aggregates
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, count(distinct c2) as a from t1 group by c1",
+			}},
+		},
+		err: "unsupported distinct aggregate: count(distinct c2)",
 	}, {
 		// funcs need alias
 		input: &binlogdatapb.Filter{
@@ -605,14 +676,41 @@ func TestBuildPlayerPlan(t *testing.T) {
 		},
 		err: "expression needs an alias: hour(c1)",
 	}, {
-		// only count(*)
+		// no count(distinct)
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
 				Match:  "t1",
-				Filter: "select count(c1) as c from t1",
+				Filter: "select count(distinct c1) as c from t1",
 			}},
 		},
-		err: "only count(*) is supported: count(c1)",
+		err: "unsupported distinct aggregate: count(distinct c1)",
+	}, {
+		// min needs a group by
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, min(c2) as c from t1",
+			}},
+		},
+		err: "aggregate expression requires a group by: c",
+	}, {
+		// avg needs sum and count
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, avg(c2) as a, sum(c2) as s, count(c3) as c from t1 group by c1",
+			}},
+		},
+		err: "avg(c2) requires sum(c2) and count(c2) in the select list: a",
+	}, {
+		// no complex expr in min
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, min(c2 + 1) as m from t1 group by c1",
+			}},
+		},
+		err: "unexpected: min(c2 + 1)",
 	}, {
 		// no sum(*)
 		input: &binlogdatapb.Filter{
@@ -768,3 +866,106 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestSourceMinMax(t *testing.T) {
+	testcases := []struct {
+		filter string
+		want   string
+		err    string
+	}{{
+		// the key range of the stream is left out, because each group is
+		// on a single target shard
+		filter: "select c1, min(c2) as mn from t2 where in_keyrange(c1, 'hash', '-80') and c3 > 1 group by c1",
+		want:   "select min(c2) from t2 where (c3 > 1) and c1 <=> :b_c1",
+	}, {
+		filter: "select c1, min(c2) as mn from t2 where in_keyrange('-80') group by c1",
+		err:    "min and max aggregates need the columns of the key range: in_keyrange('-80')",
+	}, {
+		filter: "select c1, max(c2) as mx from t2 where in_keyrange(c3, 'hash', '-80') group by c1",
+		err:    "min and max aggregates need the key range columns to be grouped by: in_keyrange(c3, 'hash', '-80')",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			input := &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: tcase.filter,
+				}},
+			}
+			PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+				"t1": {&PrimaryKeyInfo{Name: "c1"}},
+			}
+			plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, binlogplayer.NewStats())
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, plan.TablePlans["t2"].SourceMinMax.Query)
+		})
+	}
+}
+
+func TestRecomputeMinMax(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, min(c2) as mn, max(c2) as mx from t2 group by c1",
+		}},
+	}
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	tplan, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t2", Fields: fields})
+	require.NoError(t, err)
+
+	var queries []string
+	results := map[string]*sqltypes.Result{
+		"select mn, mx from t1 where c1=1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("mn|mx", "int64|int64"), "5|9"),
+	}
+	executor := func(query string) (*sqltypes.Result, error) {
+		queries = append(queries, query)
+		if qr, ok := results[query]; ok {
+			return qr, nil
+		}
+		return &sqltypes.Result{}, nil
+	}
+	// The rows of the group are on two sources.
+	var streamed []string
+	querier := func(query string) ([]*sqltypes.Result, error) {
+		streamed = append(streamed, query)
+		fields := sqltypes.MakeTestFields("min(c2)|max(c2)", "int64|int64")
+		return []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "6|9"),
+			sqltypes.MakeTestResult(fields, "7|12"),
+		}, nil
+	}
+
+	// Deleting the minimum recomputes the group.
+	_, err = tplan.applyChange(&binlogdatapb.RowChange{
+		Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(5)}),
+	}, executor, querier)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"select min(c2), max(c2) from t2 where c1 <=> 1"}, streamed)
+	assert.Equal(t, []string{
+		"update t1 set mn=mn, mx=mx where c1=1",
+		"select mn, mx from t1 where c1=1",
+		"update t1 set mn=6, mx=12 where c1=1",
+	}, queries)
+
+	// Updating a value that is neither the minimum nor the maximum doesn't.
+	queries, streamed = nil, nil
+	_, err = tplan.applyChange(&binlogdatapb.RowChange{
+		Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(8)}),
+		After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(10)}),
+	}, executor, querier)
+	require.NoError(t, err)
+	assert.Nil(t, streamed)
+	assert.Equal(t, []string{
+		"update t1 set mn=least(ifnull(mn, 10), ifnull(10, mn)), mx=greatest(ifnull(mx, 10), ifnull(10, mx)) where c1=1",
+		"select mn, mx from t1 where c1=1",
+	}, queries)
+}
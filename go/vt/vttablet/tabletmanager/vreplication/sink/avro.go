@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// The avro files are object container files that use the null codec.
+// All the tables share the schema below. The rows are maps of the
+// column names to their values. Binary values are avro bytes, and
+// all the other values are strings.
+const avroSchema = `{"type":"record","name":"RowChange","namespace":"io.vitess","fields":[` +
+	`{"name":"keyspace","type":"string"},` +
+	`{"name":"shard","type":"string"},` +
+	`{"name":"table","type":"string"},` +
+	`{"name":"op","type":"string"},` +
+	`{"name":"gtid","type":"string"},` +
+	`{"name":"ts","type":"long"},` +
+	`{"name":"statement","type":"string"},` +
+	`{"name":"before","type":["null",{"type":"map","values":["null","string","bytes"]}]},` +
+	`{"name":"after","type":["null",{"type":"map","values":["null","string","bytes"]}]}]}`
+
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// avroHeader returns the header of a container file.
+func avroHeader(sync []byte) []byte {
+	buf := &bytes.Buffer{}
+	buf.Write(avroMagic)
+	avroLong(buf, 2)
+	avroString(buf, "avro.schema")
+	avroBytes(buf, []byte(avroSchema))
+	avroString(buf, "avro.codec")
+	avroBytes(buf, []byte("null"))
+	avroLong(buf, 0)
+	buf.Write(sync)
+	return buf.Bytes()
+}
+
+// avroBlock returns a block of a container file with count records.
+func avroBlock(count int, data, sync []byte) []byte {
+	buf := &bytes.Buffer{}
+	avroLong(buf, int64(count))
+	avroLong(buf, int64(len(data)))
+	buf.Write(data)
+	buf.Write(sync)
+	return buf.Bytes()
+}
+
+// avroRecord appends the encoding of a record to buf.
+func avroRecord(buf *bytes.Buffer, r *Record) {
+	avroString(buf, r.Keyspace)
+	avroString(buf, r.Shard)
+	avroString(buf, r.Table)
+	avroString(buf, r.Op)
+	avroString(buf, r.Gtid)
+	avroLong(buf, r.Timestamp)
+	avroString(buf, r.Statement)
+	avroRow(buf, r, r.Before)
+	avroRow(buf, r, r.After)
+}
+
+func avroRow(buf *bytes.Buffer, r *Record, row []sqltypes.Value) {
+	if row == nil {
+		// The null branch of the union.
+		avroLong(buf, 0)
+		return
+	}
+	avroLong(buf, 1)
+	if len(row) != 0 {
+		avroLong(buf, int64(len(row)))
+		for i, v := range row {
+			avroString(buf, r.Fields[i].Name)
+			switch {
+			case v.IsNull():
+				avroLong(buf, 0)
+			case isBinary(v.Type()):
+				avroLong(buf, 2)
+				avroBytes(buf, v.Raw())
+			default:
+				avroLong(buf, 1)
+				avroBytes(buf, v.Raw())
+			}
+		}
+	}
+	// The end of the map.
+	avroLong(buf, 0)
+}
+
+// avroLong appends a zig-zag encoded variable length integer.
+func avroLong(buf *bytes.Buffer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	buf.Write(b[:n])
+}
+
+func avroBytes(buf *bytes.Buffer, b []byte) {
+	avroLong(buf, int64(len(b)))
+	buf.Write(b)
+}
+
+func avroString(buf *bytes.Buffer, s string) {
+	avroLong(buf, int64(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bufio"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// fakeBroker is an in-process stand-in for a single kafka broker. It
+// answers Metadata v4 and Produce v3 requests, and keeps the records
+// it receives in memory.
+type fakeBroker struct {
+	t          *testing.T
+	listener   net.Listener
+	partitions int32
+
+	mu sync.Mutex
+	// records contains the received records by topic and partition.
+	records map[string]map[int32][]kafkaMessage
+	// produceError, if set, is returned for the next produce request.
+	produceError int16
+	// batches is the number of received record batches.
+	batches int
+}
+
+func newFakeBroker(t *testing.T, partitions int32) *fakeBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fb := &fakeBroker{
+		t:          t,
+		listener:   listener,
+		partitions: partitions,
+		records:    make(map[string]map[int32][]kafkaMessage),
+	}
+	go fb.serve()
+	return fb
+}
+
+func (fb *fakeBroker) addr() string {
+	return fb.listener.Addr().String()
+}
+
+func (fb *fakeBroker) close() {
+	fb.listener.Close()
+}
+
+func (fb *fakeBroker) setProduceError(code int16) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.produceError = code
+}
+
+func (fb *fakeBroker) topicRecords(topic string) map[int32][]kafkaMessage {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	result := make(map[int32][]kafkaMessage)
+	for partition, msgs := range fb.records[topic] {
+		result[partition] = append([]kafkaMessage(nil), msgs...)
+	}
+	return result
+}
+
+func (fb *fakeBroker) batchCount() int {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.batches
+}
+
+func (fb *fakeBroker) serve() {
+	for {
+		conn, err := fb.listener.Accept()
+		if err != nil {
+			return
+		}
+		go fb.handle(conn)
+	}
+}
+
+func (fb *fakeBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		msg, err := readKafkaMessage(r)
+		if err != nil {
+			return
+		}
+		req := &kafkaDecoder{b: msg}
+		apiKey := req.int16()
+		apiVersion := req.int16()
+		correlationID := req.int32()
+		// client_id.
+		req.nullableString()
+
+		resp := &kafkaEncoder{}
+		resp.int32(0)
+		resp.int32(correlationID)
+		switch {
+		case apiKey == kafkaMetadataKey && apiVersion == kafkaMetadataVersion:
+			err = fb.metadata(req, resp)
+		case apiKey == kafkaProduceKey && apiVersion == kafkaProduceVersion:
+			err = fb.produce(req, resp)
+		default:
+			err = fmt.Errorf("unsupported request %d v%d", apiKey, apiVersion)
+		}
+		if err != nil {
+			fb.t.Errorf("fake broker: %v", err)
+			return
+		}
+		b := resp.Bytes()
+		size := &kafkaEncoder{}
+		size.int32(int32(len(b) - 4))
+		copy(b, size.Bytes())
+		if _, err := conn.Write(b); err != nil {
+			return
+		}
+	}
+}
+
+func (fb *fakeBroker) metadata(req *kafkaDecoder, resp *kafkaEncoder) error {
+	var topics []string
+	for n := req.int32(); n > 0; n-- {
+		topics = append(topics, req.string())
+	}
+	// allow_auto_topic_creation.
+	req.int8()
+	if req.err != nil {
+		return req.err
+	}
+	host, port, _ := net.SplitHostPort(fb.addr())
+	portNum, _ := strconv.Atoi(port)
+
+	// throttle_time_ms.
+	resp.int32(0)
+	resp.int32(1)
+	resp.int32(0)
+	resp.string(host)
+	resp.int32(int32(portNum))
+	// Null rack and cluster id.
+	resp.int16(-1)
+	resp.int16(-1)
+	// controller_id.
+	resp.int32(0)
+	resp.int32(int32(len(topics)))
+	for _, topic := range topics {
+		resp.int16(0)
+		resp.string(topic)
+		resp.int8(0)
+		resp.int32(fb.partitions)
+		for i := int32(0); i < fb.partitions; i++ {
+			resp.int16(0)
+			resp.int32(i)
+			// The leader, replicas and isr.
+			resp.int32(0)
+			resp.int32(1)
+			resp.int32(0)
+			resp.int32(1)
+			resp.int32(0)
+		}
+	}
+	return nil
+}
+
+func (fb *fakeBroker) produce(req *kafkaDecoder, resp *kafkaEncoder) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	// transactional_id, acks and timeout.
+	req.nullableString()
+	if acks := req.int16(); acks != -1 {
+		return fmt.Errorf("acks: %d, want -1", acks)
+	}
+	req.int32()
+	code := fb.produceError
+	fb.produceError = 0
+
+	type result struct {
+		topic      string
+		partitions []int32
+	}
+	var results []result
+	for topics := req.int32(); topics > 0; topics-- {
+		res := result{topic: req.string()}
+		for n := req.int32(); n > 0; n-- {
+			partition := req.int32()
+			batch := req.bytes()
+			if len(batch) > kafkaMaxBatchBytes {
+				return fmt.Errorf("batch of %d bytes, more than %d", len(batch), kafkaMaxBatchBytes)
+			}
+			msgs, err := decodeRecordBatch(batch)
+			if err != nil {
+				return err
+			}
+			fb.batches++
+			if code == 0 {
+				if fb.records[res.topic] == nil {
+					fb.records[res.topic] = make(map[int32][]kafkaMessage)
+				}
+				fb.records[res.topic][partition] = append(fb.records[res.topic][partition], msgs...)
+			}
+			res.partitions = append(res.partitions, partition)
+		}
+		results = append(results, res)
+	}
+	if req.err != nil {
+		return req.err
+	}
+	resp.int32(int32(len(results)))
+	for _, res := range results {
+		resp.string(res.topic)
+		resp.int32(int32(len(res.partitions)))
+		for _, partition := range res.partitions {
+			resp.int32(partition)
+			resp.int16(code)
+			// base_offset and log_append_time.
+			resp.int64(int64(len(fb.records[res.topic][partition])))
+			resp.int64(-1)
+		}
+	}
+	// throttle_time_ms.
+	resp.int32(0)
+	return nil
+}
+
+// decodeRecordBatch decodes a v2 record batch and verifies its crc.
+func decodeRecordBatch(b []byte) ([]kafkaMessage, error) {
+	d := &kafkaDecoder{b: b}
+	// base_offset.
+	d.int64()
+	length := d.int32()
+	// partition_leader_epoch.
+	d.int32()
+	if magic := d.int8(); magic != 2 {
+		return nil, fmt.Errorf("magic: %d, want 2", magic)
+	}
+	crc := d.uint32()
+	if d.err != nil {
+		return nil, d.err
+	}
+	if int(length) != len(b)-12 {
+		return nil, fmt.Errorf("batch length: %d, want %d", length, len(b)-12)
+	}
+	if got := crc32.Checksum(d.b, crc32c); got != crc {
+		return nil, fmt.Errorf("crc: %x, want %x", got, crc)
+	}
+	// attributes, last_offset_delta, timestamps, producer_id, producer_epoch and base_sequence.
+	d.int16()
+	d.int32()
+	d.int64()
+	d.int64()
+	d.int64()
+	d.int16()
+	d.int32()
+	var msgs []kafkaMessage
+	for n := d.int32(); n > 0; n-- {
+		size := d.varint()
+		rec := &kafkaDecoder{b: d.read(int(size))}
+		rec.int8()
+		rec.varint()
+		rec.varint()
+		key := rec.varbytes()
+		value := rec.varbytes()
+		rec.varint()
+		if rec.err != nil {
+			return nil, rec.err
+		}
+		msgs = append(msgs, kafkaMessage{key: key, value: value})
+	}
+	return msgs, d.err
+}
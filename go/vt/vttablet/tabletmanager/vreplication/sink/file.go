@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// fileSink writes the records to files named <topic>.<sequence>.<format>
+// in a directory. A new file is started every time the sink is created, so
+// that a file that was partially written by a stream that failed is never
+// appended to. The files are rotated when they reach a size or an age.
+// Files are only rotated after a flush, so a transaction is never split
+// across files.
+type fileSink struct {
+	dir     string
+	prefix  string
+	format  string
+	maxSize int64
+	maxAge  time.Duration
+
+	// seq is the sequence number of the last file, and is
+	// initialized from the files in dir when the first file is created.
+	seq     int
+	file    *os.File
+	size    int64
+	created time.Time
+	// sync is the sync marker of the current avro file.
+	sync []byte
+
+	pending bytes.Buffer
+	count   int
+}
+
+func newFileSink(spec *binlogdatapb.ExternalSink) *fileSink {
+	format := spec.Format
+	if format == "" {
+		format = FormatJSON
+	}
+	return &fileSink{
+		dir:     spec.Address,
+		prefix:  spec.Topic,
+		format:  format,
+		maxSize: spec.MaxFileSize,
+		maxAge:  time.Duration(spec.MaxFileAgeSeconds) * time.Second,
+		seq:     -1,
+	}
+}
+
+// Write implements the Sink interface.
+func (fs *fileSink) Write(records []*Record) error {
+	for _, r := range records {
+		if fs.format == FormatAvro {
+			avroRecord(&fs.pending, r)
+		} else {
+			b, err := json.Marshal(r)
+			if err != nil {
+				return err
+			}
+			fs.pending.Write(b)
+			fs.pending.WriteByte('\n')
+		}
+		fs.count++
+	}
+	return nil
+}
+
+// Flush implements the Sink interface.
+func (fs *fileSink) Flush() error {
+	if fs.count != 0 {
+		if fs.file == nil {
+			if err := fs.create(); err != nil {
+				return err
+			}
+		}
+		data := fs.pending.Bytes()
+		if fs.format == FormatAvro {
+			data = avroBlock(fs.count, data, fs.sync)
+		}
+		if err := fs.write(data); err != nil {
+			return err
+		}
+		if err := fs.file.Sync(); err != nil {
+			return err
+		}
+		fs.pending.Reset()
+		fs.count = 0
+	}
+	if fs.file != nil && fs.mustRotate() {
+		return fs.closeFile()
+	}
+	return nil
+}
+
+// Close implements the Sink interface. The records that were
+// not flushed are discarded.
+func (fs *fileSink) Close() error {
+	if fs.file == nil {
+		return nil
+	}
+	return fs.closeFile()
+}
+
+func (fs *fileSink) mustRotate() bool {
+	if fs.maxSize > 0 && fs.size >= fs.maxSize {
+		return true
+	}
+	return fs.maxAge > 0 && time.Since(fs.created) >= fs.maxAge
+}
+
+func (fs *fileSink) create() error {
+	if fs.seq < 0 {
+		seq, err := lastFileSequence(fs.dir, fs.prefix, fs.format)
+		if err != nil {
+			return err
+		}
+		fs.seq = seq
+	}
+	fs.seq++
+	name := filepath.Join(fs.dir, fmt.Sprintf("%s.%06d.%s", fs.prefix, fs.seq, fs.format))
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	fs.file = file
+	fs.size = 0
+	fs.created = time.Now()
+	if fs.format == FormatAvro {
+		fs.sync = make([]byte, 16)
+		if _, err := rand.Read(fs.sync); err != nil {
+			return err
+		}
+		return fs.write(avroHeader(fs.sync))
+	}
+	return nil
+}
+
+func (fs *fileSink) write(data []byte) error {
+	n, err := fs.file.Write(data)
+	fs.size += int64(n)
+	return err
+}
+
+func (fs *fileSink) closeFile() error {
+	err := fs.file.Close()
+	fs.file = nil
+	return err
+}
+
+// lastFileSequence returns the highest sequence number of the
+// files of the sink in dir, or 0 if there are none.
+func lastFileSequence(dir, prefix, format string) (int, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	last := 0
+	for _, file := range files {
+		name := file.Name()
+		if !strings.HasPrefix(name, prefix+".") || !strings.HasSuffix(name, "."+format) {
+			continue
+		}
+		seq, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, prefix+"."), "."+format))
+		if err != nil {
+			continue
+		}
+		if seq > last {
+			last = seq
+		}
+	}
+	return last, nil
+}
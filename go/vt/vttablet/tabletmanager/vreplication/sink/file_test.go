@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestFileSinkJSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeFile, Address: dir, Topic: "wf", MaxFileSize: 300})
+	require.NoError(t, err)
+
+	// Nothing is written before a flush, and empty flushes don't create files.
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(1, "a"))}))
+	requireFiles(t, dir)
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Flush())
+	requireFiles(t, dir, "wf.000001.json")
+
+	// The first file is rotated once it's larger than the limit.
+	require.NoError(t, s.Write([]*Record{
+		testRecord(OpUpdate, testRow(1, "a"), testRow(1, "b")),
+		testRecord(OpDelete, testRow(1, "b"), nil),
+	}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(2, "c"))}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Close())
+	requireFiles(t, dir, "wf.000001.json", "wf.000002.json")
+
+	var ops []string
+	for _, name := range []string{"wf.000001.json", "wf.000002.json"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+			var record map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &record), line)
+			ops = append(ops, record["op"].(string))
+		}
+	}
+	assert.Equal(t, []string{"insert", "update", "delete", "insert"}, ops)
+
+	// A new sink continues the sequence of the existing files.
+	s, err = New(&binlogdatapb.ExternalSink{Type: TypeFile, Address: dir, Topic: "wf"})
+	require.NoError(t, err)
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(3, "d"))}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Close())
+	requireFiles(t, dir, "wf.000001.json", "wf.000002.json", "wf.000003.json")
+}
+
+func TestFileSinkRotateByAge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeFile, Address: dir, Topic: "wf", MaxFileAgeSeconds: 1})
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(1, "a"))}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(2, "a"))}))
+	require.NoError(t, s.Flush())
+	requireFiles(t, dir, "wf.000001.json")
+
+	s.(*fileSink).created = time.Now().Add(-2 * time.Second)
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(3, "a"))}))
+	require.NoError(t, s.Flush())
+	requireFiles(t, dir, "wf.000001.json", "wf.000002.json")
+}
+
+func TestFileSinkAvro(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeFile, Address: dir, Topic: "wf", Format: FormatAvro})
+	require.NoError(t, err)
+	require.NoError(t, s.Write([]*Record{
+		testRecord(OpInsert, nil, testRow(1, "a")),
+		testRecord(OpUpdate, testRow(1, "a"), testRow(1, "b")),
+	}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Write([]*Record{{Keyspace: "ks", Shard: "-80", Op: OpDDL, Statement: "alter table t1 add column c int"}}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Close())
+	requireFiles(t, dir, "wf.000001.avro")
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "wf.000001.avro"))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data, avroMagic))
+	d := &kafkaDecoder{b: data[len(avroMagic):]}
+	meta := make(map[string]string)
+	for n := d.varint(); n > 0; n-- {
+		key := string(d.varbytes())
+		meta[key] = string(d.varbytes())
+	}
+	require.Equal(t, int64(0), d.varint())
+	assert.Equal(t, avroSchema, meta["avro.schema"])
+	assert.Equal(t, "null", meta["avro.codec"])
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(meta["avro.schema"]), &schema))
+	sync := d.read(16)
+
+	type avroRow map[string]string
+	readRow := func(d *kafkaDecoder) avroRow {
+		if d.varint() == 0 {
+			return nil
+		}
+		row := avroRow{}
+		for n := d.varint(); n > 0; n = d.varint() {
+			for ; n > 0; n-- {
+				name := string(d.varbytes())
+				switch d.varint() {
+				case 0:
+					row[name] = "NULL"
+				default:
+					row[name] = string(d.varbytes())
+				}
+			}
+		}
+		return row
+	}
+	var ops []string
+	var rows []avroRow
+	for len(d.b) > 0 {
+		count := d.varint()
+		block := &kafkaDecoder{b: d.read(int(d.varint()))}
+		for ; count > 0; count-- {
+			// keyspace, shard and table.
+			block.varbytes()
+			block.varbytes()
+			block.varbytes()
+			ops = append(ops, string(block.varbytes()))
+			// gtid, ts and statement.
+			block.varbytes()
+			block.varint()
+			block.varbytes()
+			rows = append(rows, readRow(block), readRow(block))
+		}
+		require.NoError(t, block.err)
+		require.Empty(t, block.b)
+		require.Equal(t, sync, d.read(16))
+	}
+	require.NoError(t, d.err)
+	assert.Equal(t, []string{"insert", "update", "ddl"}, ops)
+	assert.Equal(t, []avroRow{
+		nil, {"id": "1", "name": "a", "price": "1.10", "data": "NULL"},
+		{"id": "1", "name": "a", "price": "1.10", "data": "NULL"}, {"id": "1", "name": "b", "price": "1.10", "data": "NULL"},
+		nil, nil,
+	}, rows)
+}
+
+func requireFiles(t *testing.T, dir string, want ...string) {
+	t.Helper()
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	var got []string
+	for _, file := range files {
+		got = append(got, file.Name())
+	}
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,619 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// The kafka sink is a producer that speaks the kafka wire protocol.
+// It fetches the partitions of the topic and their leaders with a
+// Metadata request, and sends the records with Produce requests that
+// wait for the acknowledgement of all the in-sync replicas. Records
+// are distributed to the partitions by the hash of their key, so that
+// the changes of a row are ordered. A failed request fails the stream,
+// which restarts from its last saved position.
+
+// The versions of the requests. Produce v3 is the first version that
+// uses the v2 record batches.
+const (
+	kafkaProduceKey      = 0
+	kafkaProduceVersion  = 3
+	kafkaMetadataKey     = 3
+	kafkaMetadataVersion = 4
+	kafkaClientID        = "vitess"
+)
+
+// kafkaTimeout is the timeout of the kafka requests. It can be changed for tests.
+var kafkaTimeout = 30 * time.Second
+
+// kafkaMaxBatchBytes is the maximum size of a record batch. Brokers reject
+// the batches that are larger than their message.max.bytes, which is
+// 1048588 by default. It can be changed for tests.
+var kafkaMaxBatchBytes = 1000000
+
+// kafkaBatchOverhead is the size of the header of a record batch.
+const kafkaBatchOverhead = 61
+
+var (
+	crc32c           = crc32.MakeTable(crc32.Castagnoli)
+	errKafkaTruncate = errors.New("kafka message is truncated")
+)
+
+type kafkaMessage struct {
+	key, value []byte
+}
+
+type kafkaSink struct {
+	brokers []string
+	topic   string
+
+	// leaders contains the leader broker of each partition of the
+	// topic, and addrs the addresses of the brokers. They're fetched
+	// on the first write, and after an error.
+	leaders []int32
+	addrs   map[int32]string
+	conns   map[int32]*kafkaConn
+
+	pending       map[int32][]kafkaMessage
+	correlationID int32
+}
+
+func newKafkaSink(spec *binlogdatapb.ExternalSink) *kafkaSink {
+	return &kafkaSink{
+		brokers: splitAddress(spec.Address),
+		topic:   spec.Topic,
+		conns:   make(map[int32]*kafkaConn),
+		pending: make(map[int32][]kafkaMessage),
+	}
+}
+
+// Write implements the Sink interface.
+func (ks *kafkaSink) Write(records []*Record) error {
+	if ks.leaders == nil {
+		if err := ks.fetchMetadata(); err != nil {
+			return err
+		}
+	}
+	for _, r := range records {
+		value, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		key := r.Key()
+		h := fnv.New32a()
+		h.Write(key)
+		partition := int32(h.Sum32() % uint32(len(ks.leaders)))
+		msg := kafkaMessage{key: key, value: value}
+		if size := kafkaBatchOverhead + recordSize(0, msg); size > kafkaMaxBatchBytes {
+			return fmt.Errorf("kafka record for key %s is %d bytes, more than the maximum of %d bytes", key, size, kafkaMaxBatchBytes)
+		}
+		ks.pending[partition] = append(ks.pending[partition], msg)
+	}
+	return nil
+}
+
+// Flush implements the Sink interface. The messages of a partition are
+// split into record batches of at most kafkaMaxBatchBytes. A produce
+// request contains at most one batch per partition, so that the batches
+// of a partition are appended in order.
+func (ks *kafkaSink) Flush() error {
+	for len(ks.pending) > 0 {
+		batches := make(map[int32][]kafkaMessage)
+		byLeader := make(map[int32][]int32)
+		for partition, msgs := range ks.pending {
+			batches[partition] = msgs[:batchLen(msgs)]
+			leader := ks.leaders[partition]
+			byLeader[leader] = append(byLeader[leader], partition)
+		}
+		for leader, partitions := range byLeader {
+			sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+			if err := ks.produce(leader, partitions, batches); err != nil {
+				ks.reset()
+				return err
+			}
+			for _, partition := range partitions {
+				if rest := ks.pending[partition][len(batches[partition]):]; len(rest) > 0 {
+					ks.pending[partition] = rest
+				} else {
+					delete(ks.pending, partition)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Close implements the Sink interface.
+func (ks *kafkaSink) Close() error {
+	ks.reset()
+	return nil
+}
+
+// reset closes the connections and forgets the metadata.
+func (ks *kafkaSink) reset() {
+	for id, conn := range ks.conns {
+		conn.close()
+		delete(ks.conns, id)
+	}
+	ks.leaders = nil
+	ks.addrs = nil
+}
+
+func (ks *kafkaSink) produce(leader int32, partitions []int32, batches map[int32][]kafkaMessage) error {
+	conn, ok := ks.conns[leader]
+	if !ok {
+		addr, ok := ks.addrs[leader]
+		if !ok {
+			return fmt.Errorf("unknown kafka broker %d", leader)
+		}
+		var err error
+		if conn, err = dialKafka(addr); err != nil {
+			return err
+		}
+		ks.conns[leader] = conn
+	}
+
+	req := &kafkaEncoder{}
+	// Null transactional id.
+	req.int16(-1)
+	// acks=all.
+	req.int16(-1)
+	req.int32(int32(kafkaTimeout / time.Millisecond))
+	req.int32(1)
+	req.string(ks.topic)
+	req.int32(int32(len(partitions)))
+	now := time.Now()
+	for _, partition := range partitions {
+		req.int32(partition)
+		req.bytes(encodeRecordBatch(batches[partition], now))
+	}
+	ks.correlationID++
+	resp, err := conn.roundTrip(kafkaProduceKey, kafkaProduceVersion, ks.correlationID, req.Bytes())
+	if err != nil {
+		return err
+	}
+
+	acked := make(map[int32]bool)
+	for topics := resp.int32(); topics > 0; topics-- {
+		topic := resp.string()
+		for n := resp.int32(); n > 0; n-- {
+			partition := resp.int32()
+			code := resp.int16()
+			// base_offset and log_append_time.
+			resp.int64()
+			resp.int64()
+			if resp.err != nil {
+				break
+			}
+			if code != 0 {
+				return fmt.Errorf("kafka produce to %s/%d failed with error code %d", topic, partition, code)
+			}
+			if topic == ks.topic {
+				acked[partition] = true
+			}
+		}
+	}
+	if resp.err != nil {
+		return fmt.Errorf("invalid kafka produce response: %v", resp.err)
+	}
+	for _, partition := range partitions {
+		if !acked[partition] {
+			return fmt.Errorf("kafka produce to %s/%d was not acknowledged", ks.topic, partition)
+		}
+	}
+	return nil
+}
+
+// fetchMetadata fetches the partitions of the topic from the first
+// bootstrap broker that answers.
+func (ks *kafkaSink) fetchMetadata() error {
+	var lastErr error
+	for _, addr := range ks.brokers {
+		if lastErr = ks.fetchMetadataFrom(addr); lastErr == nil {
+			return nil
+		}
+		log.Warningf("Could not fetch the metadata of kafka topic %s from %s: %v", ks.topic, addr, lastErr)
+	}
+	return lastErr
+}
+
+func (ks *kafkaSink) fetchMetadataFrom(addr string) error {
+	conn, err := dialKafka(addr)
+	if err != nil {
+		return err
+	}
+	defer conn.close()
+
+	req := &kafkaEncoder{}
+	req.int32(1)
+	req.string(ks.topic)
+	// allow_auto_topic_creation.
+	req.int8(1)
+	ks.correlationID++
+	resp, err := conn.roundTrip(kafkaMetadataKey, kafkaMetadataVersion, ks.correlationID, req.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// throttle_time_ms.
+	resp.int32()
+	addrs := make(map[int32]string)
+	for n := resp.int32(); n > 0 && resp.err == nil; n-- {
+		id := resp.int32()
+		host := resp.string()
+		port := resp.int32()
+		// rack.
+		resp.nullableString()
+		addrs[id] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	// cluster_id and controller_id.
+	resp.nullableString()
+	resp.int32()
+	var leaders []int32
+	for topics := resp.int32(); topics > 0 && resp.err == nil; topics-- {
+		code := resp.int16()
+		topic := resp.string()
+		// is_internal.
+		resp.int8()
+		partitions := make(map[int32]int32)
+		for n := resp.int32(); n > 0 && resp.err == nil; n-- {
+			// error_code.
+			resp.int16()
+			partition := resp.int32()
+			partitions[partition] = resp.int32()
+			resp.int32Array()
+			resp.int32Array()
+		}
+		if topic != ks.topic || resp.err != nil {
+			continue
+		}
+		if code != 0 {
+			return fmt.Errorf("kafka topic %s is not available: error code %d", topic, code)
+		}
+		leaders = make([]int32, len(partitions))
+		for i := range leaders {
+			leader, ok := partitions[int32(i)]
+			if !ok || leader < 0 {
+				return fmt.Errorf("partition %d of kafka topic %s has no leader", i, topic)
+			}
+			leaders[i] = leader
+		}
+	}
+	if resp.err != nil {
+		return fmt.Errorf("invalid kafka metadata response: %v", resp.err)
+	}
+	if len(leaders) == 0 {
+		return fmt.Errorf("kafka topic %s has no partitions", ks.topic)
+	}
+	ks.leaders = leaders
+	ks.addrs = addrs
+	return nil
+}
+
+// batchLen returns the number of messages at the start of msgs that fit
+// in a record batch. It's at least one, because the size of each message
+// is checked when it's written.
+func batchLen(msgs []kafkaMessage) int {
+	size := kafkaBatchOverhead
+	for i, msg := range msgs {
+		size += recordSize(i, msg)
+		if size > kafkaMaxBatchBytes && i > 0 {
+			return i
+		}
+	}
+	return len(msgs)
+}
+
+// recordSize returns the encoded size of a record, including its length.
+func recordSize(offsetDelta int, msg kafkaMessage) int {
+	n := 1 + varintLen(0) + varintLen(int64(offsetDelta)) + varbytesLen(msg.key) + varbytesLen(msg.value) + varintLen(0)
+	return varintLen(int64(n)) + n
+}
+
+func varintLen(v int64) int {
+	var b [binary.MaxVarintLen64]byte
+	return binary.PutVarint(b[:], v)
+}
+
+func varbytesLen(b []byte) int {
+	if b == nil {
+		return varintLen(-1)
+	}
+	return varintLen(int64(len(b))) + len(b)
+}
+
+// encodeRecordBatch encodes the messages as a v2 record batch.
+func encodeRecordBatch(msgs []kafkaMessage, now time.Time) []byte {
+	ts := now.UnixNano() / int64(time.Millisecond)
+
+	// The part of the batch that's covered by the crc.
+	body := &kafkaEncoder{}
+	// attributes: no compression.
+	body.int16(0)
+	// last_offset_delta.
+	body.int32(int32(len(msgs) - 1))
+	// first and max timestamps.
+	body.int64(ts)
+	body.int64(ts)
+	// producer_id, producer_epoch and base_sequence: no idempotence.
+	body.int64(-1)
+	body.int16(-1)
+	body.int32(-1)
+	body.int32(int32(len(msgs)))
+	for i, msg := range msgs {
+		rec := &kafkaEncoder{}
+		// attributes and timestamp_delta.
+		rec.int8(0)
+		rec.varint(0)
+		rec.varint(int64(i))
+		rec.varbytes(msg.key)
+		rec.varbytes(msg.value)
+		// No headers.
+		rec.varint(0)
+		body.varint(int64(rec.Len()))
+		body.Write(rec.Bytes())
+	}
+
+	batch := &kafkaEncoder{}
+	// base_offset.
+	batch.int64(0)
+	// batch_length counts the bytes after itself.
+	batch.int32(int32(4 + 1 + 4 + body.Len()))
+	// partition_leader_epoch.
+	batch.int32(-1)
+	// magic.
+	batch.int8(2)
+	batch.uint32(crc32.Checksum(body.Bytes(), crc32c))
+	batch.Write(body.Bytes())
+	return batch.Bytes()
+}
+
+// kafkaConn is a connection to a broker.
+type kafkaConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func dialKafka(addr string) (*kafkaConn, error) {
+	conn, err := net.DialTimeout("tcp", addr, kafkaTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConn{conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+// roundTrip sends a request and returns a decoder for the body of the response.
+func (kc *kafkaConn) roundTrip(apiKey, apiVersion int16, correlationID int32, body []byte) (*kafkaDecoder, error) {
+	req := &kafkaEncoder{}
+	req.int32(0)
+	req.int16(apiKey)
+	req.int16(apiVersion)
+	req.int32(correlationID)
+	req.string(kafkaClientID)
+	req.Write(body)
+	msg := req.Bytes()
+	binary.BigEndian.PutUint32(msg, uint32(len(msg)-4))
+
+	if err := kc.conn.SetDeadline(time.Now().Add(kafkaTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := kc.conn.Write(msg); err != nil {
+		return nil, err
+	}
+	resp, err := readKafkaMessage(kc.r)
+	if err != nil {
+		return nil, err
+	}
+	d := &kafkaDecoder{b: resp}
+	if id := d.int32(); d.err == nil && id != correlationID {
+		return nil, fmt.Errorf("unexpected kafka correlation id %d, want %d", id, correlationID)
+	}
+	return d, d.err
+}
+
+func (kc *kafkaConn) close() {
+	kc.conn.Close()
+}
+
+// readKafkaMessage reads a size delimited message.
+func readKafkaMessage(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, binary.BigEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// kafkaEncoder encodes the primitive types of the kafka protocol.
+type kafkaEncoder struct {
+	bytes.Buffer
+}
+
+func (e *kafkaEncoder) int8(v int8) {
+	e.WriteByte(byte(v))
+}
+
+func (e *kafkaEncoder) int16(v int16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) int32(v int32) {
+	e.uint32(uint32(v))
+}
+
+func (e *kafkaEncoder) uint32(v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) int64(v int64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.WriteString(s)
+}
+
+func (e *kafkaEncoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.Write(b)
+}
+
+// varint encodes a zig-zag variable length integer.
+func (e *kafkaEncoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.Write(b[:n])
+}
+
+func (e *kafkaEncoder) varbytes(b []byte) {
+	if b == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(b)))
+	e.Write(b)
+}
+
+// kafkaDecoder decodes the primitive types of the kafka protocol.
+// After an error, err is set and all the values are zero.
+type kafkaDecoder struct {
+	b   []byte
+	err error
+}
+
+func (d *kafkaDecoder) read(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.b) < n {
+		d.err = errKafkaTruncate
+		return nil
+	}
+	v := d.b[:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if b := d.read(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.read(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	return int32(d.uint32())
+}
+
+func (d *kafkaDecoder) uint32() uint32 {
+	if b := d.read(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.read(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) string() string {
+	return string(d.read(int(d.int16())))
+}
+
+func (d *kafkaDecoder) nullableString() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.read(int(n)))
+}
+
+func (d *kafkaDecoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.read(int(n))
+}
+
+func (d *kafkaDecoder) int32Array() []int32 {
+	n := d.int32()
+	var values []int32
+	for ; n > 0 && d.err == nil; n-- {
+		values = append(values, d.int32())
+	}
+	return values
+}
+
+func (d *kafkaDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.err = errKafkaTruncate
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *kafkaDecoder) varbytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.read(int(n))
+}
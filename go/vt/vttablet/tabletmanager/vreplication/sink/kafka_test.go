@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestKafkaSink(t *testing.T) {
+	broker := newFakeBroker(t, 4)
+	defer broker.close()
+
+	// The first bootstrap broker is not reachable.
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeKafka, Address: "127.0.0.1:1, " + broker.addr(), Topic: "changes"})
+	require.NoError(t, err)
+	defer s.Close()
+
+	var records []*Record
+	for i := int64(1); i <= 20; i++ {
+		records = append(records, testRecord(OpInsert, nil, testRow(i, "a")))
+	}
+	records = append(records, testRecord(OpUpdate, testRow(3, "a"), testRow(3, "b")))
+	require.NoError(t, s.Write(records))
+	assert.Empty(t, broker.topicRecords("changes"))
+	require.NoError(t, s.Flush())
+
+	got := broker.topicRecords("changes")
+	assert.Greater(t, len(got), 1, "records should be spread over the partitions")
+	count := 0
+	for _, msgs := range got {
+		count += len(msgs)
+		var ops []string
+		for _, msg := range msgs {
+			var value map[string]interface{}
+			require.NoError(t, json.Unmarshal(msg.value, &value))
+			var key map[string]interface{}
+			require.NoError(t, json.Unmarshal(msg.key, &key))
+			assert.Equal(t, value["after"].(map[string]interface{})["id"], key["id"])
+			if key["id"].(float64) == 3 {
+				ops = append(ops, value["op"].(string))
+			}
+		}
+		// The changes of a row are in the same partition, in order.
+		if ops != nil {
+			assert.Equal(t, []string{"insert", "update"}, ops)
+		}
+	}
+	assert.Equal(t, 21, count)
+
+	// An empty flush doesn't send anything.
+	require.NoError(t, s.Flush())
+}
+
+func TestKafkaSinkBatchSize(t *testing.T) {
+	defer func(max int) {
+		kafkaMaxBatchBytes = max
+	}(kafkaMaxBatchBytes)
+	kafkaMaxBatchBytes = 1000
+
+	broker := newFakeBroker(t, 1)
+	defer broker.close()
+
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeKafka, Address: broker.addr(), Topic: "changes"})
+	require.NoError(t, err)
+	defer s.Close()
+
+	var records []*Record
+	for i := int64(1); i <= 20; i++ {
+		records = append(records, testRecord(OpInsert, nil, testRow(i, "a")))
+	}
+	require.NoError(t, s.Write(records))
+	require.NoError(t, s.Flush())
+
+	// The records are split into several batches, which are appended in order.
+	assert.Greater(t, broker.batchCount(), 1)
+	got := broker.topicRecords("changes")[0]
+	require.Len(t, got, 20)
+	for i, msg := range got {
+		var key map[string]interface{}
+		require.NoError(t, json.Unmarshal(msg.key, &key))
+		assert.Equal(t, float64(i+1), key["id"])
+	}
+
+	// A record that doesn't fit in a batch is rejected.
+	kafkaMaxBatchBytes = 100
+	err = s.Write([]*Record{testRecord(OpInsert, nil, testRow(21, "a"))})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "more than the maximum of 100 bytes")
+}
+
+func TestKafkaSinkError(t *testing.T) {
+	broker := newFakeBroker(t, 1)
+	defer broker.close()
+
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeKafka, Address: broker.addr(), Topic: "changes"})
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(1, "a"))}))
+	// NOT_ENOUGH_REPLICAS.
+	broker.setProduceError(19)
+	assert.EqualError(t, s.Flush(), "kafka produce to changes/0 failed with error code 19")
+	assert.Empty(t, broker.topicRecords("changes"))
+
+	// The sink fetches the metadata again after an error.
+	require.NoError(t, s.Write([]*Record{testRecord(OpInsert, nil, testRow(2, "a"))}))
+	require.NoError(t, s.Flush())
+	assert.Len(t, broker.topicRecords("changes")[0], 2)
+}
+
+func TestKafkaSinkUnavailable(t *testing.T) {
+	s, err := New(&binlogdatapb.ExternalSink{Type: TypeKafka, Address: "127.0.0.1:1", Topic: "changes"})
+	require.NoError(t, err)
+	defer s.Close()
+	err = s.Write([]*Record{testRecord(OpInsert, nil, testRow(1, "a"))})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sink contains the external systems that a vreplication stream
+// can write its row changes to, instead of the local MySQL.
+package sink
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The types of sinks.
+const (
+	TypeFile  = "file"
+	TypeKafka = "kafka"
+)
+
+// The formats of the records of a file sink.
+const (
+	FormatJSON = "json"
+	FormatAvro = "avro"
+)
+
+// The operations of a Record.
+const (
+	OpInsert = "insert"
+	OpUpdate = "update"
+	OpDelete = "delete"
+	OpDDL    = "ddl"
+)
+
+// Record is a change written to a sink.
+type Record struct {
+	Keyspace string
+	Shard    string
+	Table    string
+	Op       string
+	// Gtid is the position of the source after the transaction of the change.
+	Gtid string
+	// Timestamp is the time of the transaction, in seconds since the epoch.
+	Timestamp int64
+	// Statement is set for DDLs.
+	Statement string
+	// Fields describes the values of Before and After.
+	Fields []*querypb.Field
+	// Before is nil for inserts, and After is nil for deletes.
+	Before []sqltypes.Value
+	After  []sqltypes.Value
+}
+
+// Sink is an external system that receives the row changes of a stream.
+// Write may buffer the records. Once Flush returns, all the records
+// written before must be durable: the stream then saves its position.
+type Sink interface {
+	Write(records []*Record) error
+	Flush() error
+	Close() error
+}
+
+// Validate returns an error if the specification of a sink is invalid.
+func Validate(spec *binlogdatapb.ExternalSink) error {
+	if spec.Address == "" {
+		return fmt.Errorf("external sink address is required")
+	}
+	if spec.Topic == "" {
+		return fmt.Errorf("external sink topic is required")
+	}
+	switch spec.Type {
+	case TypeFile:
+		switch spec.Format {
+		case "", FormatJSON, FormatAvro:
+		default:
+			return fmt.Errorf("unsupported external sink format: %s", spec.Format)
+		}
+		if spec.MaxFileSize < 0 || spec.MaxFileAgeSeconds < 0 {
+			return fmt.Errorf("external sink rotation limits cannot be negative")
+		}
+	case TypeKafka:
+		if spec.Format != "" && spec.Format != FormatJSON {
+			return fmt.Errorf("unsupported format for a kafka sink: %s", spec.Format)
+		}
+	default:
+		return fmt.Errorf("unsupported external sink type: %s", spec.Type)
+	}
+	return nil
+}
+
+// New creates a sink from its specification.
+func New(spec *binlogdatapb.ExternalSink) (Sink, error) {
+	if err := Validate(spec); err != nil {
+		return nil, err
+	}
+	if spec.Type == TypeKafka {
+		return newKafkaSink(spec), nil
+	}
+	return newFileSink(spec), nil
+}
+
+// MarshalJSON encodes the record as a json object. The rows are objects
+// with the columns in the order of the fields. Numbers are encoded as json
+// numbers, except decimals, which are encoded as strings to preserve their
+// precision. Binary values are base64 encoded.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"keyspace":`)
+	writeJSONString(buf, r.Keyspace)
+	buf.WriteString(`,"shard":`)
+	writeJSONString(buf, r.Shard)
+	if r.Table != "" {
+		buf.WriteString(`,"table":`)
+		writeJSONString(buf, r.Table)
+	}
+	buf.WriteString(`,"op":`)
+	writeJSONString(buf, r.Op)
+	buf.WriteString(`,"gtid":`)
+	writeJSONString(buf, r.Gtid)
+	fmt.Fprintf(buf, `,"ts":%d`, r.Timestamp)
+	if r.Op == OpDDL {
+		buf.WriteString(`,"statement":`)
+		writeJSONString(buf, r.Statement)
+	} else {
+		buf.WriteString(`,"before":`)
+		r.writeJSONRow(buf, r.Before)
+		buf.WriteString(`,"after":`)
+		r.writeJSONRow(buf, r.After)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (r *Record) writeJSONRow(buf *bytes.Buffer, row []sqltypes.Value) {
+	if row == nil {
+		buf.WriteString("null")
+		return
+	}
+	buf.WriteByte('{')
+	for i, field := range r.Fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		writeJSONString(buf, field.Name)
+		buf.WriteByte(':')
+		writeJSONValue(buf, row[i])
+	}
+	buf.WriteByte('}')
+}
+
+func writeJSONValue(buf *bytes.Buffer, v sqltypes.Value) {
+	switch {
+	case v.IsNull():
+		buf.WriteString("null")
+	case v.IsIntegral() || v.IsFloat():
+		buf.Write(v.Raw())
+	case isBinary(v.Type()):
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(v.Raw()))
+	default:
+		writeJSONString(buf, v.ToString())
+	}
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	// Marshaling a string cannot fail.
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
+
+// isBinary returns true if the values of the type are not text.
+func isBinary(typ querypb.Type) bool {
+	switch typ {
+	case querypb.Type_BINARY, querypb.Type_VARBINARY, querypb.Type_BLOB, querypb.Type_BIT, querypb.Type_GEOMETRY:
+		return true
+	}
+	return false
+}
+
+// Key returns the key of the record, which is used to partition the
+// records of a kafka sink: the changes of a row have the same key.
+// It's a json object with the table and the primary key columns, or
+// the table only if the primary key is not known.
+func (r *Record) Key() []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"table":`)
+	writeJSONString(buf, r.Table)
+	row := r.After
+	if row == nil {
+		row = r.Before
+	}
+	if row != nil {
+		for i, field := range r.Fields {
+			if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+				continue
+			}
+			buf.WriteByte(',')
+			writeJSONString(buf, field.Name)
+			buf.WriteByte(':')
+			writeJSONValue(buf, row[i])
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+// splitAddress splits a comma separated list of addresses.
+func splitAddress(address string) []string {
+	var addrs []string
+	for _, addr := range strings.Split(address, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
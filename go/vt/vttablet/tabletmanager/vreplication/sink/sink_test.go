@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func testRecord(op string, before, after []sqltypes.Value) *Record {
+	return &Record{
+		Keyspace:  "ks",
+		Shard:     "-80",
+		Table:     "t1",
+		Op:        op,
+		Gtid:      "MySQL56/00000000-0000-0000-0000-000000000001:1-10",
+		Timestamp: 1600000000,
+		Fields: []*querypb.Field{{
+			Name:  "id",
+			Type:  querypb.Type_INT64,
+			Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG),
+		}, {
+			Name: "name",
+			Type: querypb.Type_VARCHAR,
+		}, {
+			Name: "price",
+			Type: querypb.Type_DECIMAL,
+		}, {
+			Name: "data",
+			Type: querypb.Type_BLOB,
+		}},
+		Before: before,
+		After:  after,
+	}
+}
+
+func testRow(id int64, name string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.NewInt64(id),
+		sqltypes.NewVarChar(name),
+		sqltypes.MakeTrusted(querypb.Type_DECIMAL, []byte("1.10")),
+		sqltypes.NULL,
+	}
+}
+
+func TestRecordJSON(t *testing.T) {
+	r := testRecord(OpUpdate, testRow(1, "a\"b"), testRow(1, "c"))
+	r.After[3] = sqltypes.MakeTrusted(querypb.Type_BLOB, []byte{0, 1, 2})
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	want := `{"keyspace":"ks","shard":"-80","table":"t1","op":"update","gtid":"MySQL56/00000000-0000-0000-0000-000000000001:1-10","ts":1600000000,` +
+		`"before":{"id":1,"name":"a\"b","price":"1.10","data":null},` +
+		`"after":{"id":1,"name":"c","price":"1.10","data":"AAEC"}}`
+	assert.Equal(t, want, string(b))
+
+	r = testRecord(OpInsert, nil, testRow(2, "d"))
+	b, err = json.Marshal(r)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"before":null,"after":{"id":2,`)
+
+	r = &Record{Keyspace: "ks", Shard: "0", Op: OpDDL, Gtid: "pos", Statement: "alter table t1 add column c int"}
+	b, err = json.Marshal(r)
+	require.NoError(t, err)
+	assert.Equal(t, `{"keyspace":"ks","shard":"0","op":"ddl","gtid":"pos","ts":0,"statement":"alter table t1 add column c int"}`, string(b))
+}
+
+func TestRecordKey(t *testing.T) {
+	assert.Equal(t, `{"table":"t1","id":1}`, string(testRecord(OpInsert, nil, testRow(1, "a")).Key()))
+	assert.Equal(t, `{"table":"t1","id":2}`, string(testRecord(OpDelete, testRow(2, "a"), nil).Key()))
+
+	r := testRecord(OpInsert, nil, testRow(1, "a"))
+	r.Fields[0].Flags = 0
+	assert.Equal(t, `{"table":"t1"}`, string(r.Key()))
+}
+
+func TestValidate(t *testing.T) {
+	testcases := []struct {
+		spec *binlogdatapb.ExternalSink
+		err  string
+	}{{
+		spec: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp", Topic: "t"},
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp", Topic: "t", Format: "avro", MaxFileSize: 10},
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "kafka", Address: "b1:9092,b2:9092", Topic: "t"},
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "file", Topic: "t"},
+		err:  "external sink address is required",
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp"},
+		err:  "external sink topic is required",
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "s3", Address: "/tmp", Topic: "t"},
+		err:  "unsupported external sink type: s3",
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp", Topic: "t", Format: "csv"},
+		err:  "unsupported external sink format: csv",
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp", Topic: "t", MaxFileAgeSeconds: -1},
+		err:  "external sink rotation limits cannot be negative",
+	}, {
+		spec: &binlogdatapb.ExternalSink{Type: "kafka", Address: "b1:9092", Topic: "t", Format: "avro"},
+		err:  "unsupported format for a kafka sink: avro",
+	}}
+	for _, tcase := range testcases {
+		err := Validate(tcase.spec)
+		if tcase.err == "" {
+			assert.NoError(t, err, "%v", tcase.spec)
+			continue
+		}
+		assert.EqualError(t, err, tcase.err, "%v", tcase.spec)
+	}
+}
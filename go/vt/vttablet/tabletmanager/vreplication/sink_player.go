@@ -0,0 +1,344 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication/sink"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// newSink creates the sinks. It can be changed for tests.
+var newSink = sink.New
+
+// sinkPlayer writes the row changes of a stream to an external sink
+// instead of applying them to the local MySQL. Streams with a sink have
+// no copy phase: if they have no position, they start from the current
+// position of the source, which is saved as soon as it's received. The rules of the filter are sent to the source
+// as is, and the rows they select are written to the sink.
+// The records of each transaction are written when its commit is
+// received. The sink is flushed and the position saved after each batch
+// of events that contained records, and at most once every idleTimeout
+// otherwise. If the stream fails, the records that were written after
+// the last saved position are sent again.
+type sinkPlayer struct {
+	vr       *vreplicator
+	sink     sink.Sink
+	startPos mysql.Position
+	stopPos  mysql.Position
+
+	pos mysql.Position
+	// fields contains the fields of the tables, from their field events.
+	fields map[string][]*querypb.Field
+	// txRecords contains the records of the current transaction.
+	txRecords []*sink.Record
+	// unsaved is set if the position changed since it was last saved,
+	// and unflushed if records were written since the last flush.
+	unsaved       bool
+	unflushed     bool
+	timestamp     int64
+	timeLastSaved time.Time
+}
+
+func newSinkPlayer(vr *vreplicator, settings binlogplayer.VRSettings) *sinkPlayer {
+	return &sinkPlayer{
+		vr:            vr,
+		startPos:      settings.StartPos,
+		stopPos:       settings.StopPos,
+		pos:           settings.StartPos,
+		fields:        make(map[string][]*querypb.Field),
+		timeLastSaved: time.Now(),
+	}
+}
+
+// replicateToSink runs a stream that has an external sink.
+func (vr *vreplicator) replicateToSink(ctx context.Context) error {
+	settings, err := binlogplayer.ReadVRSettings(vr.dbClient, vr.id)
+	if err != nil {
+		return fmt.Errorf("error reading VReplication settings: %v", err)
+	}
+	if settings.State == binlogplayer.BlpStopped {
+		return nil
+	}
+	if err := vr.setState(binlogplayer.BlpRunning, ""); err != nil {
+		vr.stats.ErrorCounts.Add([]string{"Replicate"}, 1)
+		return err
+	}
+	return newSinkPlayer(vr, settings).play(ctx)
+}
+
+func (sp *sinkPlayer) play(ctx context.Context) error {
+	if !sp.stopPos.IsZero() && sp.startPos.AtLeast(sp.stopPos) {
+		log.Infof("Stop position %v already reached: %v", sp.startPos, sp.stopPos)
+		return sp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stop position %v already reached: %v", sp.startPos, sp.stopPos))
+	}
+	s, err := newSink(sp.vr.source.ExternalSink)
+	if err != nil {
+		return err
+	}
+	sp.sink = s
+	defer sp.sink.Close()
+
+	startPos := "current"
+	if !sp.startPos.IsZero() {
+		startPos = mysql.EncodePosition(sp.startPos)
+	}
+	log.Infof("Starting VReplication sink player id: %v, startPos: %v, stop: %v, sink: %v", sp.vr.id, startPos, sp.stopPos, sp.vr.source.ExternalSink)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	relay := newRelayLog(ctx, *relayLogMaxItems, *relayLogMaxSize)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- sp.vr.sourceVStreamer.VStream(ctx, startPos, nil, sp.vr.source.Filter, func(events []*binlogdatapb.VEvent) error {
+			return relay.Send(events)
+		})
+	}()
+	applyErr := make(chan error, 1)
+	go func() {
+		applyErr <- sp.applyEvents(ctx, relay)
+	}()
+
+	select {
+	case err := <-applyErr:
+		cancel()
+		<-streamErr
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	case err := <-streamErr:
+		cancel()
+		<-applyErr
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if err == nil || err == io.EOF {
+			return errors.New("vstream ended")
+		}
+		return err
+	}
+}
+
+func (sp *sinkPlayer) applyEvents(ctx context.Context, relay *relayLog) error {
+	for {
+		items, err := relay.Fetch()
+		if err != nil {
+			return err
+		}
+		for _, events := range items {
+			for _, event := range events {
+				if err := sp.applyEvent(event); err != nil {
+					if err != io.EOF {
+						sp.vr.stats.ErrorCounts.Add([]string{"Apply"}, 1)
+						log.Errorf("Error applying event to sink: %s", err.Error())
+					}
+					return err
+				}
+			}
+		}
+		if sp.unflushed || time.Since(sp.timeLastSaved) >= idleTimeout {
+			if err := sp.checkpoint(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// applyEvent processes an event. It returns io.EOF if the stream must stop.
+func (sp *sinkPlayer) applyEvent(event *binlogdatapb.VEvent) error {
+	if event.Timestamp != 0 {
+		sp.timestamp = event.Timestamp
+	}
+	switch event.Type {
+	case binlogdatapb.VEventType_GTID:
+		pos, err := mysql.DecodePosition(event.Gtid)
+		if err != nil {
+			return err
+		}
+		sp.pos = pos
+	case binlogdatapb.VEventType_BEGIN, binlogdatapb.VEventType_ROLLBACK:
+		sp.txRecords = nil
+	case binlogdatapb.VEventType_FIELD:
+		sp.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+	case binlogdatapb.VEventType_ROW:
+		return sp.addRowEvent(event.RowEvent)
+	case binlogdatapb.VEventType_COMMIT:
+		if len(sp.txRecords) != 0 {
+			gtid := mysql.EncodePosition(sp.pos)
+			for _, record := range sp.txRecords {
+				record.Gtid = gtid
+				record.Timestamp = event.Timestamp
+			}
+			if err := sp.sink.Write(sp.txRecords); err != nil {
+				return err
+			}
+			sp.txRecords = nil
+			sp.unflushed = true
+		}
+		sp.unsaved = true
+		if err := sp.saveStartPos(); err != nil {
+			return err
+		}
+		return sp.checkStopPos()
+	case binlogdatapb.VEventType_OTHER:
+		sp.unsaved = true
+		if err := sp.saveStartPos(); err != nil {
+			return err
+		}
+		return sp.checkStopPos()
+	case binlogdatapb.VEventType_DDL:
+		switch sp.vr.source.OnDdl {
+		case binlogdatapb.OnDDLAction_STOP:
+			sp.unsaved = true
+			if err := sp.checkpoint(); err != nil {
+				return err
+			}
+			if err := sp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s", event.Statement)); err != nil {
+				return err
+			}
+			return io.EOF
+		case binlogdatapb.OnDDLAction_IGNORE:
+		default:
+			// The other actions forward the DDL to the sink.
+			record := &sink.Record{
+				Keyspace:  sp.vr.source.Keyspace,
+				Shard:     sp.vr.source.Shard,
+				Op:        sink.OpDDL,
+				Gtid:      mysql.EncodePosition(sp.pos),
+				Timestamp: event.Timestamp,
+				Statement: event.Statement,
+			}
+			if err := sp.sink.Write([]*sink.Record{record}); err != nil {
+				return err
+			}
+			sp.unflushed = true
+		}
+		sp.unsaved = true
+		return sp.checkStopPos()
+	case binlogdatapb.VEventType_JOURNAL:
+		// The source shards were migrated. The workflow must be
+		// recreated to stream from the new shards.
+		if err := sp.checkpoint(); err != nil {
+			return err
+		}
+		if err := sp.vr.setState(binlogplayer.BlpStopped, "Stopped at journal event: the source was migrated"); err != nil {
+			return err
+		}
+		return io.EOF
+	case binlogdatapb.VEventType_HEARTBEAT:
+		sp.vr.stats.RecordHeartbeat(time.Now().Unix())
+	}
+	return nil
+}
+
+func (sp *sinkPlayer) addRowEvent(rowEvent *binlogdatapb.RowEvent) error {
+	fields, ok := sp.fields[rowEvent.TableName]
+	if !ok {
+		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
+	}
+	for _, change := range rowEvent.RowChanges {
+		record := &sink.Record{
+			Keyspace: sp.vr.source.Keyspace,
+			Shard:    sp.vr.source.Shard,
+			Table:    rowEvent.TableName,
+			Fields:   fields,
+		}
+		if change.Before != nil {
+			record.Before = sqltypes.MakeRowTrusted(fields, change.Before)
+		}
+		if change.After != nil {
+			record.After = sqltypes.MakeRowTrusted(fields, change.After)
+		}
+		switch {
+		case change.Before == nil:
+			record.Op = sink.OpInsert
+		case change.After == nil:
+			record.Op = sink.OpDelete
+		default:
+			record.Op = sink.OpUpdate
+		}
+		sp.txRecords = append(sp.txRecords, record)
+	}
+	return nil
+}
+
+// saveStartPos saves the first position of a stream that started from
+// the current position of the source. Otherwise, if the stream failed
+// before its position was saved, it would restart from the new current
+// position, and the events in between would be lost.
+func (sp *sinkPlayer) saveStartPos() error {
+	if !sp.startPos.IsZero() {
+		return nil
+	}
+	if err := sp.checkpoint(); err != nil {
+		return err
+	}
+	sp.startPos = sp.pos
+	return nil
+}
+
+// checkStopPos saves the position and stops the stream if the stop
+// position was reached.
+func (sp *sinkPlayer) checkStopPos() error {
+	if sp.stopPos.IsZero() || !sp.pos.AtLeast(sp.stopPos) {
+		return nil
+	}
+	if err := sp.checkpoint(); err != nil {
+		return err
+	}
+	log.Infof("Stopped at position: %v", sp.stopPos)
+	if err := sp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at position %v", sp.stopPos)); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// checkpoint flushes the sink and then saves the position.
+func (sp *sinkPlayer) checkpoint() error {
+	if !sp.unsaved {
+		return nil
+	}
+	if sp.unflushed {
+		if err := sp.sink.Flush(); err != nil {
+			return fmt.Errorf("error flushing external sink: %v", err)
+		}
+		sp.unflushed = false
+	}
+	update := binlogplayer.GenerateUpdatePos(sp.vr.id, sp.pos, time.Now().Unix(), sp.timestamp, 0)
+	if _, err := sp.vr.dbClient.Execute(update); err != nil {
+		return fmt.Errorf("error %v updating position", err)
+	}
+	sp.unsaved = false
+	sp.timeLastSaved = time.Now()
+	sp.vr.stats.SetLastPosition(sp.pos)
+	return nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication/sink"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// memorySink keeps the records in memory. Its flushes can fail.
+type memorySink struct {
+	pending  []*sink.Record
+	flushed  []*sink.Record
+	flushErr error
+}
+
+func (ms *memorySink) Write(records []*sink.Record) error {
+	ms.pending = append(ms.pending, records...)
+	return nil
+}
+
+func (ms *memorySink) Flush() error {
+	if ms.flushErr != nil {
+		return ms.flushErr
+	}
+	ms.flushed = append(ms.flushed, ms.pending...)
+	ms.pending = nil
+	return nil
+}
+
+func (ms *memorySink) Close() error {
+	return nil
+}
+
+func TestSinkPlayer(t *testing.T) {
+	const gtid0 = "MySQL56/00000000-0000-0000-0000-000000000001:1-9"
+	const gtid1 = "MySQL56/00000000-0000-0000-0000-000000000001:1-10"
+	const gtid2 = "MySQL56/00000000-0000-0000-0000-000000000001:1-11"
+	const gtid3 = "MySQL56/00000000-0000-0000-0000-000000000001:1-12"
+	pos0, err := mysql.DecodePosition(gtid0)
+	require.NoError(t, err)
+	pos3, err := mysql.DecodePosition(gtid3)
+	require.NoError(t, err)
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	stats := binlogplayer.NewStats()
+	vr := &vreplicator{
+		id: 1,
+		source: &binlogdatapb.BinlogSource{
+			Keyspace:     "ks",
+			Shard:        "-80",
+			OnDdl:        binlogdatapb.OnDDLAction_EXEC,
+			ExternalSink: &binlogdatapb.ExternalSink{Type: sink.TypeFile},
+		},
+		dbClient: newVDBClient(dbClient, stats),
+		stats:    stats,
+	}
+	ms := &memorySink{}
+	sp := newSinkPlayer(vr, binlogplayer.VRSettings{StartPos: pos0, StopPos: pos3})
+	sp.sink = ms
+
+	fields := []*querypb.Field{{
+		Name:  "id",
+		Type:  querypb.Type_INT64,
+		Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG),
+	}, {
+		Name: "val",
+		Type: querypb.Type_VARCHAR,
+	}}
+	row := func(id, val string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar(id), sqltypes.NewVarChar(val)})
+	}
+	events := []*binlogdatapb.VEvent{{
+		Type:       binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{TableName: "t1", Fields: fields},
+	}, {
+		Type: binlogdatapb.VEventType_BEGIN,
+	}, {
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "t1",
+			RowChanges: []*binlogdatapb.RowChange{
+				{After: row("1", "a")},
+				{Before: row("1", "a"), After: row("1", "b")},
+				{Before: row("1", "b")},
+			},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: gtid1,
+	}, {
+		Type:      binlogdatapb.VEventType_COMMIT,
+		Timestamp: 100,
+	}}
+	for _, event := range events {
+		require.NoError(t, sp.applyEvent(event))
+	}
+	assert.Len(t, ms.pending, 3)
+	assert.Empty(t, ms.flushed)
+
+	// The sink is flushed before the position is saved.
+	dbClient.ExpectRequestRE(fmt.Sprintf("update _vt.vreplication set pos='%s', time_updated=.*, transaction_timestamp=100, rows_copied=0, message='' where id=1", gtid1), &sqltypes.Result{}, nil)
+	require.NoError(t, sp.checkpoint())
+	dbClient.Wait()
+	require.Len(t, ms.flushed, 3)
+	var ops []string
+	for _, record := range ms.flushed {
+		assert.Equal(t, "ks", record.Keyspace)
+		assert.Equal(t, "-80", record.Shard)
+		assert.Equal(t, "t1", record.Table)
+		assert.Equal(t, gtid1, record.Gtid)
+		assert.Equal(t, int64(100), record.Timestamp)
+		ops = append(ops, record.Op)
+	}
+	assert.Equal(t, []string{sink.OpInsert, sink.OpUpdate, sink.OpDelete}, ops)
+	assert.Equal(t, "b", ms.flushed[1].After[1].ToString())
+	assert.Nil(t, ms.flushed[2].After)
+
+	// Nothing to save.
+	require.NoError(t, sp.checkpoint())
+
+	// DDLs are forwarded if on_ddl is EXEC. A failed flush doesn't save the position.
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_GTID, Gtid: gtid2}))
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_DDL, Statement: "alter table t1 add column c int", Timestamp: 101}))
+	require.Len(t, ms.pending, 1)
+	assert.Equal(t, sink.OpDDL, ms.pending[0].Op)
+	assert.Equal(t, gtid2, ms.pending[0].Gtid)
+	ms.flushErr = errors.New("disk full")
+	assert.EqualError(t, sp.checkpoint(), "error flushing external sink: disk full")
+	ms.flushErr = nil
+
+	// Reaching the stop position saves the position and stops the stream.
+	dbClient.ExpectRequestRE(fmt.Sprintf("update _vt.vreplication set pos='%s'", gtid3), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(fmt.Sprintf("update _vt.vreplication set state='Stopped', message='Stopped at position %s' where id=1", pos3), &sqltypes.Result{}, nil)
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_GTID, Gtid: gtid3}))
+	assert.Equal(t, io.EOF, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 102}))
+	dbClient.Wait()
+	assert.Len(t, ms.flushed, 4)
+
+	assert.EqualError(t, sp.applyEvent(&binlogdatapb.VEvent{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "t2"},
+	}), "unexpected event on table t2")
+}
+
+func TestSinkPlayerCurrentPosition(t *testing.T) {
+	const gtid1 = "MySQL56/00000000-0000-0000-0000-000000000001:1-10"
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	stats := binlogplayer.NewStats()
+	vr := &vreplicator{
+		id: 1,
+		source: &binlogdatapb.BinlogSource{
+			Keyspace:     "ks",
+			Shard:        "-80",
+			ExternalSink: &binlogdatapb.ExternalSink{Type: sink.TypeFile},
+		},
+		dbClient: newVDBClient(dbClient, stats),
+		stats:    stats,
+	}
+	sp := newSinkPlayer(vr, binlogplayer.VRSettings{})
+	sp.sink = &memorySink{}
+
+	// A stream without a position starts with the current position of
+	// the source, which is saved right away.
+	dbClient.ExpectRequestRE(fmt.Sprintf("update _vt.vreplication set pos='%s'", gtid1), &sqltypes.Result{}, nil)
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_GTID, Gtid: gtid1}))
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_OTHER}))
+	dbClient.Wait()
+
+	// The next positions are saved by the checkpoints.
+	require.NoError(t, sp.applyEvent(&binlogdatapb.VEvent{Type: binlogdatapb.VEventType_OTHER}))
+	assert.True(t, sp.unsaved)
+}
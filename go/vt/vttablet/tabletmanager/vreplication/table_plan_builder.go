@@ -54,6 +54,9 @@ type tablePlanBuilder struct {
 	lastpk     *sqltypes.Result
 	pkInfos    []*PrimaryKeyInfo
 	stats      *binlogplayer.Stats
+	// sourceWhere are the conditions of the send query that select the
+	// source rows of a group when its min and max aggregates are recomputed.
+	sourceWhere []sqlparser.Expr
 }
 
 // colExpr describes the processing to be performed to
@@ -62,8 +65,8 @@ type colExpr struct {
 	colName sqlparser.ColIdent
 	colType querypb.Type
 	// operation==opExpr: full expression is set
-	// operation==opCount: nothing is set.
-	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opCount: nothing is set for 'count(*)'. For 'count(a)', expr is set to 'a'.
+	// operation==opSum, opMin, opMax, opAvg: for 'sum(a)', expr is set to 'a'.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -75,6 +78,11 @@ type colExpr struct {
 	isPK       bool
 	dataType   string
 	columnType string
+
+	// For opAvg, avgSum and avgCount are the sum and the count of the
+	// same column. The average is computed from them.
+	avgSum   *colExpr
+	avgCount *colExpr
 }
 
 // operation is the opcode for the colExpr.
@@ -85,6 +93,9 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	opMin
+	opMax
+	opAvg
 )
 
 // insertType describes the type of insert statement to generate.
@@ -255,9 +266,15 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeAggregates(); err != nil {
+		return nil, err
+	}
 	if err := tpb.analyzePK(pkInfoMap); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeSourceWhere(); err != nil {
+		return nil, err
+	}
 
 	// if there are no columns being selected the select expression can be empty, so we "select 1" so we have a valid
 	// select to get a row back
@@ -295,7 +312,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 
 	bvf := &bindvarFormatter{}
 
-	return &TablePlan{
+	tp := &TablePlan{
 		TargetName:       tpb.name.String(),
 		Lastpk:           tpb.lastpk,
 		BulkInsertFront:  tpb.generateInsertPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
@@ -307,6 +324,10 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		PKReferences:     pkrefs,
 		Stats:            tpb.stats,
 	}
+	if tpb.onInsert == insertOnDup {
+		tpb.generateMinMax(tp)
+	}
+	return tp
 }
 
 func analyzeSelectFrom(query string) (sel *sqlparser.Select, from string, err error) {
@@ -321,11 +342,16 @@ func analyzeSelectFrom(query string) (sel *sqlparser.Select, from string, err er
 	if sel.Distinct {
 		return nil, "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
 	}
+	// Joins are not supported: each row event is for a single table, and the
+	// rows it would join with are neither in the event nor in the target.
 	if len(sel.From) > 1 {
-		return nil, "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
+		return nil, "", fmt.Errorf("unsupported join: %v", sqlparser.String(sel))
 	}
 	node, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
 	if !ok {
+		if _, ok := sel.From[0].(*sqlparser.JoinTableExpr); ok {
+			return nil, "", fmt.Errorf("unsupported join: %v", sqlparser.String(sel))
+		}
 		return nil, "", fmt.Errorf("unexpected: %v", sqlparser.String(sel))
 	}
 	fromTable := sqlparser.GetTableName(node.Expr)
@@ -365,35 +391,42 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 		references: make(map[string]bool),
 	}
 	if expr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok {
+		fname := expr.Name.Lowered()
 		if expr.Distinct {
+			switch fname {
+			case "count", "sum", "min", "max", "avg":
+				// Distinct aggregates are not supported: whether a value is
+				// new to the group, or still in it, can't be known from a row
+				// event, since the target only keeps the aggregate.
+				return nil, fmt.Errorf("unsupported distinct aggregate: %v", sqlparser.String(expr))
+			}
 			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 		}
-		switch fname := expr.Name.Lowered(); fname {
+		switch fname {
 		case "count":
-			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); !ok {
-				return nil, fmt.Errorf("only count(*) is supported: %v", sqlparser.String(expr))
+			if _, ok := expr.Exprs[0].(*sqlparser.StarExpr); ok {
+				cexpr.operation = opCount
+				return cexpr, nil
+			}
+			if err := tpb.analyzeAggregate(cexpr, expr); err != nil {
+				return nil, err
 			}
 			cexpr.operation = opCount
 			return cexpr, nil
-		case "sum":
-			if len(expr.Exprs) != 1 {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			innerCol, ok := aInner.Expr.(*sqlparser.ColName)
-			if !ok {
-				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		case "sum", "min", "max", "avg":
+			if err := tpb.analyzeAggregate(cexpr, expr); err != nil {
+				return nil, err
 			}
-			if !innerCol.Qualifier.IsEmpty() {
-				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+			switch fname {
+			case "sum":
+				cexpr.operation = opSum
+			case "min":
+				cexpr.operation = opMin
+			case "max":
+				cexpr.operation = opMax
+			case "avg":
+				cexpr.operation = opAvg
 			}
-			cexpr.operation = opSum
-			cexpr.expr = innerCol
-			tpb.addCol(innerCol.Name)
-			cexpr.references[innerCol.Name.Lowered()] = true
 			return cexpr, nil
 		case "keyspace_id":
 			if len(expr.Exprs) != 0 {
@@ -431,6 +464,29 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 	return cexpr, nil
 }
 
+// analyzeAggregate analyzes an aggregate function, whose only argument must
+// be a column of the source table.
+func (tpb *tablePlanBuilder) analyzeAggregate(cexpr *colExpr, expr *sqlparser.FuncExpr) error {
+	if len(expr.Exprs) != 1 {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	aInner, ok := expr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	innerCol, ok := aInner.Expr.(*sqlparser.ColName)
+	if !ok {
+		return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	if !innerCol.Qualifier.IsEmpty() {
+		return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
+	}
+	cexpr.expr = innerCol
+	tpb.addCol(innerCol.Name)
+	cexpr.references[innerCol.Name.Lowered()] = true
+	return nil
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -474,6 +530,67 @@ func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 	return nil
 }
 
+// analyzeAggregates validates the min, max and avg aggregates, which can
+// only be maintained for groups. An average is computed from the sum and
+// the count of the same column, which must also be in the select list.
+func (tpb *tablePlanBuilder) analyzeAggregates() error {
+	for _, cexpr := range tpb.colExprs {
+		switch cexpr.operation {
+		case opMin, opMax, opAvg:
+		default:
+			continue
+		}
+		if tpb.onInsert != insertOnDup {
+			return fmt.Errorf("aggregate expression requires a group by: %v", cexpr.colName.String())
+		}
+		if cexpr.operation != opAvg {
+			continue
+		}
+		col := cexpr.expr.(*sqlparser.ColName)
+		for _, other := range tpb.colExprs {
+			otherCol, ok := other.expr.(*sqlparser.ColName)
+			if !ok || !otherCol.Name.Equal(col.Name) {
+				continue
+			}
+			switch other.operation {
+			case opSum:
+				cexpr.avgSum = other
+			case opCount:
+				cexpr.avgCount = other
+			}
+		}
+		if cexpr.avgSum == nil || cexpr.avgCount == nil {
+			return fmt.Errorf("avg(%v) requires sum(%v) and count(%v) in the select list: %v", col.Name, col.Name, col.Name, cexpr.colName.String())
+		}
+	}
+	return nil
+}
+
+// assignedColExprs returns the columns that are updated when a group
+// changes. The averages come last because they're computed from the
+// updated sums and counts.
+func (tpb *tablePlanBuilder) assignedColExprs() []*colExpr {
+	var cexprs, avgs []*colExpr
+	for _, cexpr := range tpb.colExprs {
+		// We don't know of a use case where the group by columns
+		// don't match the pk of a table. But we'll allow this,
+		// and won't update the pk column with the new value if
+		// this does happen. This can be revisited if there's
+		// a legitimate use case in the future that demands
+		// a different behavior. This rule is applied uniformly
+		// for updates and deletes also.
+		if cexpr.isGrouped || cexpr.isPK {
+			continue
+		}
+		if cexpr.operation == opAvg {
+			avgs = append(avgs, cexpr)
+			continue
+		}
+		cexprs = append(cexprs, cexpr)
+	}
+	return append(cexprs, avgs...)
+}
+
 // analyzePK builds tpb.pkCols.
 func (tpb *tablePlanBuilder) analyzePK(pkInfoMap map[string][]*PrimaryKeyInfo) error {
 	pkcols, ok := pkInfoMap[tpb.name.String()]
@@ -553,10 +670,12 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 				buf.Myprintf("%v", cexpr.expr)
 			}
 		case opCount:
-			buf.WriteString("1")
+			tpb.generateCount(buf, cexpr)
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.Myprintf(")")
@@ -574,9 +693,11 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 		case opExpr:
 			buf.Myprintf("%v", cexpr.expr)
 		case opCount:
-			buf.WriteString("1")
+			tpb.generateCount(buf, cexpr)
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax, opAvg:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -590,27 +711,27 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 	}
 	buf.Myprintf(" on duplicate key update ")
 	separator := ""
-	for _, cexpr := range tpb.colExprs {
-		// We don't know of a use case where the group by columns
-		// don't match the pk of a table. But we'll allow this,
-		// and won't update the pk column with the new value if
-		// this does happen. This can be revisited if there's
-		// a legitimate use case in the future that demands
-		// a different behavior. This rule is applied uniformly
-		// for updates and deletes also.
-		if cexpr.isGrouped || cexpr.isPK {
-			continue
-		}
+	for _, cexpr := range tpb.assignedColExprs() {
 		buf.Myprintf("%s%v=", separator, cexpr.colName)
 		separator = ", "
 		switch cexpr.operation {
 		case opExpr:
 			buf.Myprintf("values(%v)", cexpr.colName)
 		case opCount:
-			buf.Myprintf("%v+1", cexpr.colName)
+			if cexpr.expr == nil {
+				buf.Myprintf("%v+1", cexpr.colName)
+			} else {
+				buf.Myprintf("%v+values(%v)", cexpr.colName, cexpr.colName)
+			}
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		case opMin:
+			buf.Myprintf("least(ifnull(%v, values(%v)), ifnull(values(%v), %v))", cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
+		case opMax:
+			buf.Myprintf("greatest(ifnull(%v, values(%v)), ifnull(values(%v), %v))", cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
+		case opAvg:
+			tpb.generateAvg(buf, cexpr)
 		}
 	}
 	return buf.ParsedQuery()
@@ -624,10 +745,7 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 	buf.Myprintf("update %v set ", tpb.name)
 	separator := ""
-	for _, cexpr := range tpb.colExprs {
-		if cexpr.isGrouped || cexpr.isPK {
-			continue
-		}
+	for _, cexpr := range tpb.assignedColExprs() {
 		buf.Myprintf("%s%v=", separator, cexpr.colName)
 		separator = ", "
 		switch cexpr.operation {
@@ -640,6 +758,23 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			}
 		case opCount:
 			buf.Myprintf("%v", cexpr.colName)
+			if cexpr.expr != nil {
+				bvf.mode = bvBefore
+				buf.Myprintf("-if(%v is null, 0, 1)", cexpr.expr)
+				bvf.mode = bvAfter
+				buf.Myprintf("+if(%v is null, 0, 1)", cexpr.expr)
+			}
+		case opMin, opMax:
+			// If the old value was the minimum or the maximum of the
+			// group, it's recomputed after the update: see MinMax.
+			bvf.mode = bvAfter
+			fn := "least"
+			if cexpr.operation == opMax {
+				fn = "greatest"
+			}
+			buf.Myprintf("%s(ifnull(%v, %v), ifnull(%v, %v))", fn, cexpr.colName, cexpr.expr, cexpr.expr, cexpr.colName)
+		case opAvg:
+			tpb.generateAvg(buf, cexpr)
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			bvf.mode = bvBefore
@@ -663,19 +798,25 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 		bvf.mode = bvBefore
 		buf.Myprintf("update %v set ", tpb.name)
 		separator := ""
-		for _, cexpr := range tpb.colExprs {
-			if cexpr.isGrouped || cexpr.isPK {
-				continue
-			}
+		for _, cexpr := range tpb.assignedColExprs() {
 			buf.Myprintf("%s%v=", separator, cexpr.colName)
 			separator = ", "
 			switch cexpr.operation {
 			case opExpr:
 				buf.WriteString("null")
 			case opCount:
-				buf.Myprintf("%v-1", cexpr.colName)
+				if cexpr.expr == nil {
+					buf.Myprintf("%v-1", cexpr.colName)
+				} else {
+					buf.Myprintf("%v-if(%v is null, 0, 1)", cexpr.colName, cexpr.expr)
+				}
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			case opMin, opMax:
+				// The value is recomputed after the delete if needed: see MinMax.
+				buf.Myprintf("%v", cexpr.colName)
+			case opAvg:
+				tpb.generateAvg(buf, cexpr)
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -685,6 +826,170 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// generateCount generates the value of a count for a new row, which is 1
+// for 'count(*)', and 1 only if the column is not null for 'count(a)'.
+func (tpb *tablePlanBuilder) generateCount(buf *sqlparser.TrackedBuffer, cexpr *colExpr) {
+	if cexpr.expr == nil {
+		buf.WriteString("1")
+		return
+	}
+	buf.Myprintf("if(%v is null, 0, 1)", cexpr.expr)
+}
+
+// generateAvg generates the value of an average from its sum and count,
+// which must have been updated before in the same statement.
+func (tpb *tablePlanBuilder) generateAvg(buf *sqlparser.TrackedBuffer, cexpr *colExpr) {
+	buf.Myprintf("%v/nullif(%v, 0)", cexpr.avgSum.colName, cexpr.avgCount.colName)
+}
+
+// analyzeSourceWhere computes the conditions that select the source rows of
+// a group when its min and max aggregates are recomputed. The in_keyrange
+// constructs are only understood by the vstreamer, so they're left out.
+// This keeps the same rows if they only reference group by columns: all the
+// rows of a group are then in the key range of the target.
+func (tpb *tablePlanBuilder) analyzeSourceWhere() error {
+	if tpb.onInsert != insertOnDup || tpb.sendSelect.Where == nil {
+		return nil
+	}
+	hasMinMax := false
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation == opMin || cexpr.operation == opMax {
+			hasMinMax = true
+			break
+		}
+	}
+	if !hasMinMax {
+		return nil
+	}
+	for _, expr := range sqlparser.SplitAndExpression(nil, tpb.sendSelect.Where.Expr) {
+		funcExpr, ok := expr.(*sqlparser.FuncExpr)
+		if !ok || !funcExpr.Name.EqualString("in_keyrange") {
+			tpb.sourceWhere = append(tpb.sourceWhere, expr)
+			continue
+		}
+		// The columns of 'in_keyrange(keyrange)' are those of the vindex of
+		// the source table, which is not known here.
+		if len(funcExpr.Exprs) < 3 {
+			return fmt.Errorf("min and max aggregates need the columns of the key range: %v", sqlparser.String(funcExpr))
+		}
+		for _, selExpr := range funcExpr.Exprs[:len(funcExpr.Exprs)-2] {
+			if !tpb.isGroupedColumn(selExpr) {
+				return fmt.Errorf("min and max aggregates need the key range columns to be grouped by: %v", sqlparser.String(funcExpr))
+			}
+		}
+	}
+	return nil
+}
+
+// isGroupedColumn returns true if the expression is a source column that is
+// grouped by.
+func (tpb *tablePlanBuilder) isGroupedColumn(selExpr sqlparser.SelectExpr) bool {
+	aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return false
+	}
+	colName, ok := aliased.Expr.(*sqlparser.ColName)
+	if !ok {
+		return false
+	}
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.isGrouped {
+			continue
+		}
+		if groupedCol, ok := cexpr.expr.(*sqlparser.ColName); ok && groupedCol.Name.Equal(colName.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateMinMax generates the statements that recompute the min and max
+// aggregates of a group from the sources of the workflow. They're only
+// needed for groups, because the other aggregates can be maintained
+// incrementally.
+func (tpb *tablePlanBuilder) generateMinMax(tp *TablePlan) {
+	var cexprs []*colExpr
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation == opMin || cexpr.operation == opMax {
+			cexprs = append(cexprs, cexpr)
+		}
+	}
+	if len(cexprs) == 0 {
+		return
+	}
+
+	bvf := &bindvarFormatter{}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.WriteString("select ")
+	for i, cexpr := range cexprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", cexpr.colName)
+		tp.MinMax = append(tp.MinMax, &minMaxColumn{
+			Name:   cexpr.colName.String(),
+			Source: cexpr.expr.(*sqlparser.ColName).Name.String(),
+			IsMin:  cexpr.operation == opMin,
+		})
+	}
+	buf.Myprintf(" from %v", tpb.name)
+	tpb.generateWhere(buf, bvf)
+	tp.SelectMinMax = buf.ParsedQuery()
+
+	// The new values are computed by the source with an aggregate query on
+	// the rows of the group, which are selected with the values of the
+	// group by expressions of the before image. The group by columns are
+	// compared directly, so that the query can use an index on them.
+	bvf = &bindvarFormatter{mode: bvBefore}
+	buf = sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.WriteString("select ")
+	for i, cexpr := range cexprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if cexpr.operation == opMin {
+			buf.WriteString("min(")
+		} else {
+			buf.WriteString("max(")
+		}
+		buf.WriteString(sqlparser.String(cexpr.expr))
+		buf.WriteString(")")
+	}
+	buf.WriteString(" from ")
+	buf.WriteString(sqlparser.String(tpb.sendSelect.From))
+	separator := " where "
+	for _, expr := range tpb.sourceWhere {
+		buf.WriteString(separator)
+		buf.WriteString("(")
+		buf.WriteString(sqlparser.String(expr))
+		buf.WriteString(")")
+		separator = " and "
+	}
+	for _, cexpr := range tpb.colExprs {
+		if !cexpr.isGrouped {
+			continue
+		}
+		buf.WriteString(separator)
+		buf.WriteString(sqlparser.String(cexpr.expr))
+		buf.Myprintf(" <=> %v", cexpr.expr)
+		separator = " and "
+	}
+	tp.SourceMinMax = buf.ParsedQuery()
+
+	bvf = &bindvarFormatter{}
+	buf = sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.Myprintf("update %v set ", tpb.name)
+	for i, cexpr := range cexprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v=", cexpr.colName)
+		buf.WriteArg(":", "m_"+cexpr.colName.String())
+	}
+	tpb.generateWhere(buf, bvf)
+	tp.UpdateMinMax = buf.ParsedQuery()
+}
+
 // For binary(n) column types, the value in the where clause needs to be padded with nulls upto the length of the column
 // for MySQL comparison to work properly. This is achieved by casting it to the column type
 func castIfNecessary(buf *sqlparser.TrackedBuffer, cexpr *colExpr) {
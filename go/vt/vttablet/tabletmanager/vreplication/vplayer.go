@@ -45,6 +45,8 @@ type vplayer struct {
 
 	replicatorPlan *ReplicatorPlan
 	tablePlans     map[string]*TablePlan
+	// sources recompute the min and max aggregates of the target tables.
+	sources *workflowSources
 
 	pos mysql.Position
 	// unsavedEvent is set any time we skip an event without
@@ -121,6 +123,9 @@ func (vp *vplayer) play(ctx context.Context) error {
 		}
 	}
 
+	vp.sources = newWorkflowSources(vp.vr)
+	defer vp.sources.close(ctx)
+
 	return vp.fetchAndApply(ctx)
 }
 
@@ -221,6 +226,8 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 			vp.vr.stats.QueryTimings.Record(vp.phase, start)
 			stats.Send(sql)
 			return qr, err
+		}, func(query string) ([]*sqltypes.Result, error) {
+			return vp.sources.query(ctx, query)
 		})
 		if err != nil {
 			return err
@@ -229,6 +236,29 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	return nil
 }
 
+// applyCoordinatedDDL waits for the other streams of the workflow to reach
+// the DDL. The last stream to reach it applies it for all of them.
+func (vp *vplayer) applyCoordinatedDDL(ctx context.Context, statement string) error {
+	if err := vp.vr.setMessage(fmt.Sprintf("Waiting for the other streams of the workflow to reach DDL: %s", statement)); err != nil {
+		return err
+	}
+	ddl, isLast, err := vp.vr.vre.registerDDL(vp.vr, statement, vp.pos)
+	if err != nil {
+		return err
+	}
+	if isLast {
+		return vp.vr.vre.applyCoordinatedDDL(ctx, vp.vr, ddl)
+	}
+	select {
+	case <-ddl.done:
+		// If the DDL failed, the stream is retried and waits for it again.
+		return ddl.err
+	case <-ctx.Done():
+		vp.vr.vre.unregisterDDL(vp.vr, ddl)
+		return io.EOF
+	}
+}
+
 func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	vp.numAccumulatedHeartbeats = 0
 	update := binlogplayer.GenerateUpdatePos(vp.vr.id, vp.pos, time.Now().Unix(), ts, vp.vr.stats.CopyRowCount.Get())
@@ -573,6 +603,18 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if posReached {
 				return io.EOF
 			}
+		case binlogdatapb.OnDDLAction_EXEC_COORDINATED:
+			if err := vp.applyCoordinatedDDL(ctx, event.Statement); err != nil {
+				return err
+			}
+			stats.Send(fmt.Sprintf("%v", event.Statement))
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
 		}
 	case binlogdatapb.VEventType_JOURNAL:
 		if vp.vr.dbClient.InTransaction {
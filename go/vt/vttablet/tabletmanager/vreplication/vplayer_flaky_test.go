@@ -1302,6 +1302,82 @@ func TestPlayerRowMove(t *testing.T) {
 	validateQueryCountStat(t, "replicate", 5)
 }
 
+func TestPlayerMinMax(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	execStatements(t, []string{
+		"create table src(id int, grp int, val int, primary key(id))",
+		fmt.Sprintf("create table %s.dst(grp int, mn int, mx int, primary key(grp))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src",
+		fmt.Sprintf("drop table %s.dst", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst",
+			Filter: "select grp, min(val) as mn, max(val) as mx from src group by grp",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+
+	execStatements(t, []string{
+		"insert into src values(1, 1, 5), (2, 1, 3), (3, 1, 9)",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into dst(grp,mn,mx) values (1,5,5) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+		"insert into dst(grp,mn,mx) values (1,3,3) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+		"insert into dst(grp,mn,mx) values (1,9,9) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "dst", [][]string{
+		{"1", "3", "9"},
+	})
+
+	// Deleting the minimum recomputes it from the source.
+	execStatements(t, []string{
+		"delete from src where id=2",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"update dst set mn=mn, mx=mx where grp=1",
+		"select mn, mx from dst where grp=1",
+		"update dst set mn=5, mx=9 where grp=1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "dst", [][]string{
+		{"1", "5", "9"},
+	})
+
+	// Other values are updated incrementally.
+	execStatements(t, []string{
+		"update src set val=7 where id=1",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"update dst set mn=least(ifnull(mn, 7), ifnull(7, mn)), mx=greatest(ifnull(mx, 7), ifnull(7, mx)) where grp=1",
+		"select mn, mx from dst where grp=1",
+		"update dst set mn=7, mx=9 where grp=1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+	expectData(t, "dst", [][]string{
+		{"1", "7", "9"},
+	})
+}
+
 func TestPlayerTypes(t *testing.T) {
 	log.Errorf("TestPlayerTypes: flavor is %s", env.Flavor)
 	enableJSONColumnTesting := false
@@ -1597,6 +1673,61 @@ func TestPlayerDDL(t *testing.T) {
 	cancel()
 }
 
+func TestPlayerDDLCoordinated(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	execStatements(t, []string{
+		"create table t1(id int, primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_EXEC_COORDINATED,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+	execStatements(t, []string{"insert into t1 values(1)"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id) values (1)",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// The stream is the only one of the workflow: it applies the DDL right away.
+	execStatements(t, []string{"alter table t1 add column val varchar(128)"})
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set message='Waiting for the other streams of the workflow to reach DDL: alter table t1 add column val",
+		"/update _vt.vreplication set message='Applying DDL for the streams of the workflow: alter table t1 add column val",
+		"alter table t1 add column val varchar(128)",
+		"begin",
+		"/update _vt.vreplication set pos=.*, message=''",
+		"commit",
+		"/update _vt.vreplication set pos=",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+	})
+	execStatements(t, []string{"insert into t1 values(2, 'a')"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (2,'a')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+}
+
 func TestPlayerStopPos(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
@@ -131,6 +131,7 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 // a stop position was requested. This phase differs from the Init phase because
 // there is a replication position.
 // If a request had a starting position, then we go directly into phase 3.
+// Streams that write to an external sink only have phase 3: see sinkPlayer.
 // During these phases, the state of vreplication is reported as 'Init', 'Copying',
 // or 'Running'. They all mean the same thing. The difference in the phases depends
 // on the criteria defined above. The different states reported are mainly
@@ -151,6 +152,9 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
+	if vr.source.ExternalSink != nil {
+		return vr.replicateToSink(ctx)
+	}
 	pkInfo, err := vr.buildPkInfoMap(ctx)
 	if err != nil {
 		return err
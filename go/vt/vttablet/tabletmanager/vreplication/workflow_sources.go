@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+const sqlReadWorkflowSources = "select id, source, cell, tablet_types from _vt.vreplication where db_name = %s and workflow = (select workflow from _vt.vreplication where id = %d)"
+
+// workflowSources runs queries on all the sources of the workflow of a
+// stream. A target shard has a stream for each source shard that it
+// replicates from, so the rows of a group of an aggregate target table
+// can come from each of them.
+// The queries run outside of any snapshot, and so lock no source table.
+type workflowSources struct {
+	vr *vreplicator
+	// clients are the clients of all the sources. The client of the stream
+	// itself is vr.sourceVStreamer. They're set on first use.
+	clients []VStreamerClient
+	// opened are the clients that workflowSources opened, to be closed.
+	opened []VStreamerClient
+}
+
+func newWorkflowSources(vr *vreplicator) *workflowSources {
+	return &workflowSources{vr: vr}
+}
+
+// query runs the query on each source, and returns their results.
+func (ws *workflowSources) query(ctx context.Context, query string) ([]*sqltypes.Result, error) {
+	if ws.clients == nil {
+		if err := ws.open(ctx); err != nil {
+			return nil, err
+		}
+	}
+	results := make([]*sqltypes.Result, 0, len(ws.clients))
+	for _, client := range ws.clients {
+		qr, err := client.Execute(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, qr)
+	}
+	return results, nil
+}
+
+// open reads the sources of the other streams of the workflow, and
+// connects to a tablet of each, picked like the streams pick theirs.
+func (ws *workflowSources) open(ctx context.Context) error {
+	vr := ws.vr
+	qr, err := vr.dbClient.ExecuteFetch(fmt.Sprintf(sqlReadWorkflowSources, encodeString(vr.vre.dbName), vr.id), -1)
+	if err != nil {
+		return err
+	}
+	clients := []VStreamerClient{vr.sourceVStreamer}
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return err
+		}
+		if uint32(id) == vr.id {
+			continue
+		}
+		var source binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(row.AsString("source", ""), &source); err != nil {
+			return err
+		}
+		if name := source.GetExternalMysql(); name != "" {
+			client, err := vr.vre.ec.Get(name)
+			if err != nil {
+				return err
+			}
+			clients = append(clients, client)
+			continue
+		}
+		cell := vr.vre.cell
+		if v := row.AsString("cell", ""); v != "" {
+			cell = v
+		}
+		tabletTypes := *tabletTypesStr
+		if v := row.AsString("tablet_types", ""); v != "" {
+			tabletTypes = v
+		}
+		tp, err := newSourceTabletPicker(ctx, vr.vre.ts, &source, cell, tabletTypes)
+		if err != nil {
+			return err
+		}
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return err
+		}
+		client := newTabletConnector(tablet)
+		if err := client.Open(ctx); err != nil {
+			return err
+		}
+		ws.opened = append(ws.opened, client)
+		clients = append(clients, client)
+	}
+	ws.clients = clients
+	return nil
+}
+
+// close closes the clients that workflowSources opened.
+func (ws *workflowSources) close(ctx context.Context) {
+	for _, client := range ws.opened {
+		client.Close(ctx)
+	}
+	ws.clients, ws.opened = nil, nil
+}
@@ -23,9 +23,11 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"time"
@@ -93,6 +95,15 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// HotRows returns the row (ranges) tracked by the automatic hot row detection.
+	HotRows() []txserializer.HotRow
+
+	// GCTables returns the tables going through the table garbage collection lifecycle.
+	GCTables(ctx context.Context) ([]*gc.GCTable, error)
+
+	// CancelGCTableDrop restores a table in HOLD state to its original name, and returns that name.
+	CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error)
 }
 
 // Ensure TabletServer satisfies Controller interface.
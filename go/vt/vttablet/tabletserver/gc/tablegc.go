@@ -23,11 +23,14 @@ import (
 	"math"
 	"math/rand"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
@@ -54,11 +57,30 @@ var checkInterval = flag.Duration("gc_check_interval", 1*time.Hour, "Interval be
 // gcLifecycle is the sequence of steps the table goes through in the process of getting dropped
 var gcLifecycle = flag.String("table_gc_lifecycle", "hold,purge,evac,drop", "States for a DROP TABLE garbage collection cycle. Default is 'hold,purge,evac,drop', use any subset ('drop' implcitly always included)")
 
+// purgeBatchSize is the number of rows deleted by each statement purging a table
+var purgeBatchSize = flag.Int("table_gc_purge_batch_size", 50, "Number of rows deleted by each statement purging a table in PURGE state")
+
+// purgeRowsPerSecond limits the rate at which rows are purged. The rate also backs off whenever the tablet throttler pushes back
+var purgeRowsPerSecond = flag.Int("table_gc_purge_rows_per_second", 0, "Maximum number of rows per second purged from tables in PURGE state. The rate is halved whenever the tablet throttler pushes back, and recovers as purging proceeds. 0 means no maximum")
+
 var (
-	sqlPurgeTable       = `delete from %a limit 50`
-	sqlShowVtTables     = `show tables like '\_vt\_%'`
-	sqlDropTable        = "drop table if exists `%a`"
-	purgeReentranceFlag int64
+	sqlPurgeTable             = `delete from %a limit %a`
+	sqlShowVtTables           = `show full tables like '\_vt\_%'`
+	sqlDropTable              = "drop table if exists `%a`"
+	sqlDropView               = "drop view if exists `%a`"
+	sqlRenameTable            = "rename table `%a` to `%a`"
+	sqlSelectGCTables         = `select table_name, table_type, ifnull(table_rows, 0), ifnull(data_length, 0), ifnull(index_length, 0) from information_schema.tables where table_schema=database() and table_name like '\_vt\_%'`
+	sqlSelectDroppedTableName = `select mysql_table from _vt.schema_migrations where ddl_action='drop' and find_in_set(%a, artifacts) order by id desc limit 1`
+	purgeReentranceFlag       int64
+)
+
+var (
+	purgedRows       = stats.NewCounter("TableGCPurgedRows", "Count of rows purged from tables in PURGE state")
+	purgeThrottled   = stats.NewCounter("TableGCPurgeThrottled", "Count of purge batches held back by the tablet throttler")
+	transitions      = stats.NewCountersWithSingleLabel("TableGCTransitions", "Count of tables transitioned into each GC state", "State")
+	droppedTables    = stats.NewCounter("TableGCDroppedTables", "Count of tables and views dropped by the table garbage collector")
+	cancelledDrops   = stats.NewCounter("TableGCCancelledDrops", "Count of tables restored from HOLD state to their original name")
+	gcTablesPerState = stats.NewGaugesWithSingleLabel("TableGCTables", "Number of tables in each GC state, as of the last check", "State")
 )
 
 // transitionRequest encapsulates a request to transition a table to next state
@@ -102,6 +124,7 @@ type TableGC struct {
 
 	purgingTables          map[string]bool
 	dropTablesChan         chan string
+	dropViewsChan          chan string
 	transitionRequestsChan chan *transitionRequest
 	purgeRequestsChan      chan bool
 	// lifecycleStates indicates what states a GC table goes through. The user can set
@@ -120,6 +143,20 @@ type GCStatus struct {
 	purgingTables []string
 }
 
+// GCTable describes a table going through the garbage collection lifecycle
+type GCTable struct {
+	Name  string
+	State schema.TableGCState
+	UUID  string
+	// Time is the time hint encoded in the table name, after which the table moves to its next state
+	Time   time.Time
+	IsView bool
+
+	TableRows   uint64
+	DataLength  uint64
+	IndexLength uint64
+}
+
 // NewTableGC creates a table collector
 func NewTableGC(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType, lagThrottler *throttle.Throttler) *TableGC {
 	collector := &TableGC{
@@ -139,6 +176,7 @@ func NewTableGC(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topoda
 
 		purgingTables:          map[string]bool{},
 		dropTablesChan:         make(chan string),
+		dropViewsChan:          make(chan string),
 		transitionRequestsChan: make(chan *transitionRequest),
 		purgeRequestsChan:      make(chan bool),
 	}
@@ -264,6 +302,12 @@ func (collector *TableGC) Operate(ctx context.Context) {
 					log.Errorf("TableGC: error dropping table %s: %+v", dropTableName, err)
 				}
 			}
+		case dropViewName := <-collector.dropViewsChan:
+			{
+				if err := collector.dropView(ctx, dropViewName); err != nil {
+					log.Errorf("TableGC: error dropping view %s: %+v", dropViewName, err)
+				}
+			}
 		case transition := <-collector.transitionRequestsChan:
 			{
 				if err := collector.transitionTable(ctx, transition); err != nil {
@@ -364,8 +408,16 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 		return err
 	}
 
+	tablesPerState := map[schema.TableGCState]int64{}
+	defer func() {
+		gcTablesPerState.ResetAll()
+		for state, count := range tablesPerState {
+			gcTablesPerState.Set(string(state), count)
+		}
+	}()
 	for _, row := range res.Rows {
 		tableName := row[0].ToString()
+		isView := row[1].ToString() == "VIEW"
 
 		shouldTransition, state, uuid, err := collector.shouldTransitionTable(tableName)
 
@@ -373,6 +425,9 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 			log.Errorf("TableGC: error while checking tables: %+v", err)
 			continue
 		}
+		if state != "" {
+			tablesPerState[state]++
+		}
 		if !shouldTransition {
 			// irrelevant table
 			continue
@@ -380,6 +435,11 @@ func (collector *TableGC) checkTables(ctx context.Context) error {
 
 		log.Infof("TableGC: will operate on table %s", tableName)
 
+		if isView {
+			// A view holds no data, so there is nothing to purge or evacuate. It is dropped as soon as it is due.
+			go func() { collector.dropViewsChan <- tableName }()
+			continue
+		}
 		if state == schema.HoldTableGCState {
 			// Hold period expired. Moving to next state
 			collector.submitTransitionRequest(ctx, state, tableName, uuid)
@@ -417,6 +477,10 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 		// Nothing do do here...
 		return "", nil
 	}
+	if *purgeBatchSize <= 0 {
+		// A DELETE with a non positive LIMIT deletes nothing, which would pass the table as purged
+		return tableName, fmt.Errorf("invalid -table_gc_purge_batch_size %d, must be positive", *purgeBatchSize)
+	}
 
 	conn, err := dbconnpool.NewDBConnection(ctx, collector.env.Config().DB.DbaWithDB())
 	if err != nil {
@@ -465,18 +529,24 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 	}()
 
 	log.Infof("TableGC: purge begin for %s", tableName)
+	rate := newPurgeRate(float64(*purgeRowsPerSecond), float64(*purgeBatchSize))
+	lastBatchRate := 0.0
 	for {
 		if !collector.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			purgeThrottled.Add(1)
+			rate.backOff(lastBatchRate)
 			continue
 		}
 		// OK, we're clear to go!
 
 		// Issue a DELETE
-		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, tableName)
+		batchStartTime := time.Now()
+		parsed := sqlparser.BuildParsedQuery(sqlPurgeTable, tableName, strconv.Itoa(*purgeBatchSize))
 		res, err := conn.ExecuteFetch(parsed.Query, 1, true)
 		if err != nil {
 			return tableName, err
 		}
+		purgedRows.Add(int64(res.RowsAffected))
 		if res.RowsAffected == 0 {
 			// The table is now empty!
 			// we happen to know at this time that the table is in PURGE state,
@@ -490,9 +560,69 @@ func (collector *TableGC) purge(ctx context.Context) (tableName string, err erro
 			time.AfterFunc(time.Second, func() { collector.purgeRequestsChan <- true })
 			return tableName, nil
 		}
+		// Respect the purge rate, if limited
+		rate.recover()
+		if wait := purgeBatchInterval(res.RowsAffected, rate.rowsPerSecond) - time.Since(batchStartTime); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return tableName, ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		lastBatchRate = float64(res.RowsAffected) / time.Since(batchStartTime).Seconds()
+	}
+}
+
+// purgeRate is the rate at which a table is purged. It is halved whenever the tablet throttler pushes back,
+// down to minRowsPerSecond, and grows back as batches go through, up to maxRowsPerSecond.
+type purgeRate struct {
+	maxRowsPerSecond float64
+	minRowsPerSecond float64
+	// rowsPerSecond is the current rate. A non positive rate means no limit
+	rowsPerSecond float64
+}
+
+// newPurgeRate returns a purge rate which starts at maxRowsPerSecond. A non positive maxRowsPerSecond means no maximum.
+func newPurgeRate(maxRowsPerSecond, minRowsPerSecond float64) *purgeRate {
+	return &purgeRate{
+		maxRowsPerSecond: maxRowsPerSecond,
+		minRowsPerSecond: minRowsPerSecond,
+		rowsPerSecond:    maxRowsPerSecond,
+	}
+}
+
+// backOff halves the rate. lastBatchRate is the actual rate of the last batch, which an unlimited rate backs off from.
+func (r *purgeRate) backOff(lastBatchRate float64) {
+	if r.rowsPerSecond <= 0 || (lastBatchRate > 0 && lastBatchRate < r.rowsPerSecond) {
+		r.rowsPerSecond = lastBatchRate
+	}
+	r.rowsPerSecond /= 2
+	if r.rowsPerSecond < r.minRowsPerSecond {
+		r.rowsPerSecond = r.minRowsPerSecond
+	}
+}
+
+// recover grows the rate by a tenth, up to the maximum. Without a maximum, the rate grows until it no longer
+// holds back the purge.
+func (r *purgeRate) recover() {
+	if r.rowsPerSecond <= 0 {
+		return
+	}
+	r.rowsPerSecond *= 1.1
+	if r.maxRowsPerSecond > 0 && r.rowsPerSecond > r.maxRowsPerSecond {
+		r.rowsPerSecond = r.maxRowsPerSecond
 	}
 }
 
+// purgeBatchInterval returns the minimal duration of a purge batch which deleted the given number of rows,
+// such that rows are purged at no more than rowsPerSecond. A non positive rowsPerSecond means no limit.
+func purgeBatchInterval(rowsAffected uint64, rowsPerSecond float64) time.Duration {
+	if rowsPerSecond <= 0 {
+		return 0
+	}
+	return time.Duration(float64(rowsAffected) / rowsPerSecond * float64(time.Second))
+}
+
 // dropTable runs an actual DROP TABLE statement, and marks the end of the line for the
 // tables' GC lifecycle.
 func (collector *TableGC) dropTable(ctx context.Context, tableName string) error {
@@ -514,6 +644,32 @@ func (collector *TableGC) dropTable(ctx context.Context, tableName string) error
 		return err
 	}
 	log.Infof("TableGC: dropped table: %s", tableName)
+	droppedTables.Add(1)
+	return nil
+}
+
+// dropView runs a DROP VIEW statement on a GC view. Views skip the purge and evac states, since
+// they hold no data, and since purging a view would delete rows from its underlying tables.
+func (collector *TableGC) dropView(ctx context.Context, viewName string) error {
+	if atomic.LoadInt64(&collector.isPrimary) == 0 {
+		return nil
+	}
+
+	conn, err := collector.pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	parsed := sqlparser.BuildParsedQuery(sqlDropView, viewName)
+
+	log.Infof("TableGC: dropping view: %s", viewName)
+	_, err = conn.Exec(ctx, parsed.Query, 1, true)
+	if err != nil {
+		return err
+	}
+	log.Infof("TableGC: dropped view: %s", viewName)
+	droppedTables.Add(1)
 	return nil
 }
 
@@ -553,6 +709,7 @@ func (collector *TableGC) transitionTable(ctx context.Context, transition *trans
 		return err
 	}
 	log.Infof("TableGC: renamed table: %s", transition.fromTableName)
+	transitions.Add(string(transition.toGCState), 1)
 	return nil
 }
 
@@ -613,3 +770,111 @@ func (collector *TableGC) Status() *GCStatus {
 
 	return status
 }
+
+// GCTables lists the tables and views going through the garbage collection lifecycle, along with their state and size
+func (collector *TableGC) GCTables(ctx context.Context) ([]*GCTable, error) {
+	if atomic.LoadInt64(&collector.isOpen) == 0 {
+		return nil, fmt.Errorf("table GC is not open; it only runs on a primary tablet")
+	}
+
+	conn, err := collector.pool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	res, err := conn.Exec(ctx, sqlSelectGCTables, math.MaxInt32, true)
+	if err != nil {
+		return nil, err
+	}
+	return gcTablesFromResult(res)
+}
+
+// gcTablesFromResult reads the GC tables out of the result of sqlSelectGCTables. Tables whose names
+// merely look like GC table names are skipped.
+func gcTablesFromResult(res *sqltypes.Result) ([]*GCTable, error) {
+	var gcTables []*GCTable
+	for _, row := range res.Rows {
+		tableName := row[0].ToString()
+		isGCTable, state, uuid, t, err := schema.AnalyzeGCTableName(tableName)
+		if err != nil {
+			return nil, err
+		}
+		if !isGCTable {
+			continue
+		}
+		gcTable := &GCTable{
+			Name:   tableName,
+			State:  state,
+			UUID:   uuid,
+			Time:   t,
+			IsView: row[1].ToString() == "VIEW",
+		}
+		if gcTable.TableRows, err = row[2].ToUint64(); err != nil {
+			return nil, err
+		}
+		if gcTable.DataLength, err = row[3].ToUint64(); err != nil {
+			return nil, err
+		}
+		if gcTable.IndexLength, err = row[4].ToUint64(); err != nil {
+			return nil, err
+		}
+		gcTables = append(gcTables, gcTable)
+	}
+	sort.SliceStable(gcTables, func(i, j int) bool {
+		return gcTables[i].Time.Before(gcTables[j].Time)
+	})
+	return gcTables, nil
+}
+
+// CancelDrop cancels the drop of a table in HOLD state, by renaming it back to the given original name.
+// When originalName is empty, the name is read from the online DDL migration which dropped the table.
+// Tables past the HOLD state cannot be restored, as they may already have been purged.
+// It returns the name the table was restored to.
+func (collector *TableGC) CancelDrop(ctx context.Context, tableName, originalName string) (string, error) {
+	isGCTable, state, _, _, err := schema.AnalyzeGCTableName(tableName)
+	if err != nil {
+		return "", err
+	}
+	if !isGCTable {
+		return "", fmt.Errorf("%s is not a GC table", tableName)
+	}
+	if state != schema.HoldTableGCState {
+		return "", fmt.Errorf("cannot cancel the drop of %s: table is in %s state, only %s tables can be restored", tableName, state, schema.HoldTableGCState)
+	}
+	if atomic.LoadInt64(&collector.isOpen) == 0 {
+		return "", fmt.Errorf("table GC is not open; it only runs on a primary tablet")
+	}
+
+	conn, err := collector.pool.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+
+	if originalName == "" {
+		query, err := sqlparser.ParseAndBind(sqlSelectDroppedTableName, sqltypes.StringBindVariable(tableName))
+		if err != nil {
+			return "", err
+		}
+		res, err := conn.Exec(ctx, query, 1, true)
+		if err != nil {
+			return "", fmt.Errorf("cannot read the original name of %s: %v", tableName, err)
+		}
+		if len(res.Rows) == 0 {
+			return "", fmt.Errorf("cannot find an online DDL migration which dropped %s; the original name must be provided", tableName)
+		}
+		originalName = res.Rows[0][0].ToString()
+	}
+	if schema.IsGCTableName(originalName) {
+		return "", fmt.Errorf("cannot restore %s to %s, which is itself a GC table name", tableName, originalName)
+	}
+
+	parsed := sqlparser.BuildParsedQuery(sqlRenameTable, tableName, originalName)
+	log.Infof("TableGC: cancelling drop, renaming table: %s to %s", tableName, originalName)
+	if _, err := conn.Exec(ctx, parsed.Query, 1, true); err != nil {
+		return "", err
+	}
+	cancelledDrops.Add(1)
+	return originalName, nil
+}
@@ -17,11 +17,15 @@ limitations under the License.
 package gc
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/schema"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNextTableToPurge(t *testing.T) {
@@ -219,3 +223,79 @@ func TestShouldTransitionTable(t *testing.T) {
 		}
 	}
 }
+
+func TestPurgeBatchInterval(t *testing.T) {
+	assert.Equal(t, time.Duration(0), purgeBatchInterval(50, 0))
+	assert.Equal(t, time.Duration(0), purgeBatchInterval(50, -1))
+	assert.Equal(t, time.Second, purgeBatchInterval(50, 50))
+	assert.Equal(t, 500*time.Millisecond, purgeBatchInterval(50, 100))
+	assert.Equal(t, time.Duration(0), purgeBatchInterval(0, 100))
+}
+
+func TestPurgeRate(t *testing.T) {
+	rate := newPurgeRate(1000, 50)
+	rate.backOff(2000)
+	assert.Equal(t, 500.0, rate.rowsPerSecond)
+	rate.backOff(300)
+	assert.Equal(t, 150.0, rate.rowsPerSecond)
+	rate.backOff(0)
+	rate.backOff(0)
+	assert.Equal(t, 50.0, rate.rowsPerSecond)
+	for i := 0; i < 100; i++ {
+		rate.recover()
+	}
+	assert.Equal(t, 1000.0, rate.rowsPerSecond)
+
+	// Without a maximum, the rate is unlimited until the throttler pushes back
+	rate = newPurgeRate(0, 50)
+	rate.recover()
+	assert.Equal(t, 0.0, rate.rowsPerSecond)
+	rate.backOff(400)
+	assert.Equal(t, 200.0, rate.rowsPerSecond)
+	rate.recover()
+	assert.InDelta(t, 220.0, rate.rowsPerSecond, 0.001)
+}
+
+func TestGCTablesFromResult(t *testing.T) {
+	fields := sqltypes.MakeTestFields("table_name|table_type|table_rows|data_length|index_length", "varchar|varchar|uint64|uint64|uint64")
+	res := sqltypes.MakeTestResult(fields,
+		"_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410|BASE TABLE|1000|65536|16384",
+		"_vt_HOLD_2ace8bcef73211ea87e9f875a4d24e90_20200915120400|VIEW|0|0|0",
+		"_vt_vrp_6ace8bcef73211ea87e9f875a4d24e90_20200915120410_|BASE TABLE|10|16384|0",
+	)
+	gcTables, err := gcTablesFromResult(res)
+	require.NoError(t, err)
+	require.Len(t, gcTables, 2)
+
+	// sorted by time hint
+	assert.Equal(t, &GCTable{
+		Name:   "_vt_HOLD_2ace8bcef73211ea87e9f875a4d24e90_20200915120400",
+		State:  schema.HoldTableGCState,
+		UUID:   "2ace8bcef73211ea87e9f875a4d24e90",
+		Time:   time.Date(2020, 9, 15, 12, 4, 0, 0, time.UTC),
+		IsView: true,
+	}, gcTables[0])
+	assert.Equal(t, &GCTable{
+		Name:        "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+		State:       schema.PurgeTableGCState,
+		UUID:        "6ace8bcef73211ea87e9f875a4d24e90",
+		Time:        time.Date(2020, 9, 15, 12, 4, 10, 0, time.UTC),
+		TableRows:   1000,
+		DataLength:  65536,
+		IndexLength: 16384,
+	}, gcTables[1])
+}
+
+func TestCancelDrop(t *testing.T) {
+	ctx := context.Background()
+	collector := &TableGC{}
+
+	_, err := collector.CancelDrop(ctx, "t1", "t2")
+	assert.EqualError(t, err, "t1 is not a GC table")
+
+	_, err = collector.CancelDrop(ctx, "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", "t1")
+	assert.EqualError(t, err, "cannot cancel the drop of _vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410: table is in PURGE state, only HOLD tables can be restored")
+
+	_, err = collector.CancelDrop(ctx, "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410", "t1")
+	assert.EqualError(t, err, "table GC is not open; it only runs on a primary tablet")
+}
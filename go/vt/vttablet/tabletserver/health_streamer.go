@@ -35,6 +35,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -61,9 +62,14 @@ type healthStreamer struct {
 	state   *querypb.StreamHealthResponse
 
 	history *history.History
+
+	// se is used to signal schema changes to clients, while the tablet is a master.
+	se                     *schema.Engine
+	signalWhenSchemaChange bool
+	isPrimary              sync2.AtomicBool
 }
 
-func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthStreamer {
+func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias, se *schema.Engine) *healthStreamer {
 	return &healthStreamer{
 		stats:              env.Stats(),
 		degradedThreshold:  env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
@@ -79,6 +85,9 @@ func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthS
 		},
 
 		history: history.New(5),
+
+		se:                     se,
+		signalWhenSchemaChange: env.Config().SignalWhenSchemaChange,
 	}
 }
 
@@ -160,6 +169,8 @@ func (hs *healthStreamer) unregister(ch chan *querypb.StreamHealthResponse) {
 }
 
 func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimestamp time.Time, lag time.Duration, err error, serving bool) {
+	hs.updateSchemaNotifier(tabletType)
+
 	hs.mu.Lock()
 	defer hs.mu.Unlock()
 
@@ -182,6 +193,17 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
 
+	hs.broadCastToClients(shr)
+	hs.history.Add(&historyRecord{
+		Time:       time.Now(),
+		serving:    shr.Serving,
+		tabletType: shr.Target.TabletType,
+		lag:        lag,
+		err:        err,
+	})
+}
+
+func (hs *healthStreamer) broadCastToClients(shr *querypb.StreamHealthResponse) {
 	for ch := range hs.clients {
 		select {
 		case ch <- shr:
@@ -203,13 +225,52 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 			delete(hs.clients, ch)
 		}
 	}
-	hs.history.Add(&historyRecord{
-		Time:       time.Now(),
-		serving:    shr.Serving,
-		tabletType: shr.Target.TabletType,
-		lag:        lag,
-		err:        err,
-	})
+}
+
+// updateSchemaNotifier registers the health streamer with the schema engine while the tablet is a master,
+// and unregisters it otherwise. It must be called without holding hs.mu, because the schema engine
+// calls the notifier upon registration.
+func (hs *healthStreamer) updateSchemaNotifier(tabletType topodatapb.TabletType) {
+	if !hs.signalWhenSchemaChange {
+		return
+	}
+	isPrimary := tabletType == topodatapb.TabletType_MASTER
+	if !hs.isPrimary.CompareAndSwap(!isPrimary, isPrimary) {
+		return
+	}
+	if isPrimary {
+		hs.se.RegisterNotifier("healthStreamer", hs.reload)
+	} else {
+		hs.se.UnregisterNotifier("healthStreamer")
+	}
+}
+
+// reload is called by the schema engine whenever it detects schema changes. The names of the changed
+// tables are sent to the clients along with the current health state, so that vtgates can reload them.
+func (hs *healthStreamer) reload(full map[string]*schema.Table, created, altered, dropped []string) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	var tables []string
+	tables = append(tables, created...)
+	tables = append(tables, altered...)
+	tables = append(tables, dropped...)
+	if len(tables) == 0 {
+		return
+	}
+
+	hs.state.RealtimeStats.TableSchemaChanged = tables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableSchemaChanged = nil
+
+	hs.broadCastToClients(shr)
+}
+
+// ReplicationLag returns the replication lag of the last health state.
+func (hs *healthStreamer) ReplicationLag() time.Duration {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	return time.Duration(hs.state.RealtimeStats.SecondsBehindMaster) * time.Second
 }
 
 func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
@@ -37,7 +37,7 @@ func TestHealthStreamerClosed(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	err := hs.Stream(context.Background(), func(shr *querypb.StreamHealthResponse) error {
 		return nil
 	})
@@ -52,7 +52,7 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	hs.Open()
 	defer hs.Close()
 	target := querypb.Target{}
@@ -136,6 +136,55 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 	assert.Equal(t, want, shr)
 }
 
+func TestHealthStreamerSchemaChange(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	hs.Open()
+	defer hs.Close()
+	target := querypb.Target{}
+	hs.InitDBConfig(target)
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	now := time.Now()
+	hs.ChangeState(topodatapb.TabletType_MASTER, now, 0, nil, true)
+	<-ch
+
+	// No change, no broadcast.
+	hs.reload(nil, nil, nil, nil)
+
+	hs.reload(nil, []string{"t1"}, []string{"t2"}, []string{"t3"})
+	shr := <-ch
+	want := &querypb.StreamHealthResponse{
+		Target: &querypb.Target{
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+		TabletAlias:                         &alias,
+		Serving:                             true,
+		TabletExternallyReparentedTimestamp: now.Unix(),
+		RealtimeStats: &querypb.RealtimeStats{
+			SecondsBehindMasterFilteredReplication: 1,
+			BinlogPlayersCount:                     2,
+			TableSchemaChanged:                     []string{"t1", "t2", "t3"},
+		},
+	}
+	assert.Equal(t, want, shr)
+
+	// The changed tables are only sent once.
+	hs.ChangeState(topodatapb.TabletType_MASTER, now, 0, nil, true)
+	shr = <-ch
+	want.RealtimeStats.TableSchemaChanged = nil
+	assert.Equal(t, want, shr)
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
@@ -151,3 +200,18 @@ func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, conte
 func testBlpFunc() (int64, int32) {
 	return 1, 2
 }
+
+func TestHealthStreamerReplicationLag(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	assert.Equal(t, time.Duration(0), hs.ReplicationLag())
+
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 3*time.Second, nil, true)
+	assert.Equal(t, 3*time.Second, hs.ReplicationLag())
+}
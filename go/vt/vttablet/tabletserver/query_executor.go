@@ -19,6 +19,7 @@ package tabletserver
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -460,6 +461,9 @@ func (qre *QueryExecutor) execDDL(conn *StatefulConnection) (*sqltypes.Result, e
 		// Parsing is successful.
 		if !onlineDDL.Strategy.IsDirect() {
 			// This is an online DDL.
+			if onlineDDL.StrategySetting().IsDryRun() {
+				return qre.tsv.onlineDDLExecutor.DryRunMigration(qre.ctx, qre.plan.FullStmt)
+			}
 			return qre.tsv.onlineDDLExecutor.SubmitMigration(qre.ctx, qre.plan.FullStmt)
 		}
 	}
@@ -834,11 +838,20 @@ func (qre *QueryExecutor) execAlterMigration() (*sqltypes.Result, error) {
 	case sqlparser.RetryMigrationType:
 		return qre.tsv.onlineDDLExecutor.RetryMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CompleteMigrationType:
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "ALTER VITESS_MIGRATION COMPLETE is not implemented yet")
+		return qre.tsv.onlineDDLExecutor.CompleteMigration(qre.ctx, alterMigration.UUID)
 	case sqlparser.CancelMigrationType:
 		return qre.tsv.onlineDDLExecutor.CancelMigration(qre.ctx, alterMigration.UUID, true, "CANCEL issued by user")
 	case sqlparser.CancelAllMigrationType:
 		return qre.tsv.onlineDDLExecutor.CancelPendingMigrations(qre.ctx, "CANCEL ALL issued by user")
+	case sqlparser.PriorityMigrationType:
+		if alterMigration.Priority == nil {
+			return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "ALTER VITESS_MIGRATION PRIORITY requires a priority value")
+		}
+		priority, err := strconv.ParseInt(alterMigration.Priority.Val, 10, 64)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid priority: %s", alterMigration.Priority.Val)
+		}
+		return qre.tsv.onlineDDLExecutor.PriorityMigration(qre.ctx, alterMigration.UUID, priority)
 	}
 	return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "ALTER VITESS_MIGRATION not implemented")
 }
@@ -847,6 +860,9 @@ func (qre *QueryExecutor) execRevertMigration() (*sqltypes.Result, error) {
 	if _, ok := qre.plan.FullStmt.(*sqlparser.RevertMigration); !ok {
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "Expecting REVERT VITESS_MIGRATION plan")
 	}
+	if onlineDDL, err := schema.OnlineDDLFromCommentedStatement(qre.plan.FullStmt); err == nil && onlineDDL.StrategySetting().IsDryRun() {
+		return qre.tsv.onlineDDLExecutor.DryRunMigration(qre.ctx, qre.plan.FullStmt)
+	}
 	return qre.tsv.onlineDDLExecutor.SubmitMigration(qre.ctx, qre.plan.FullStmt)
 }
 
@@ -50,7 +50,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(104)
+		size += int64(120)
 	}
 	// field Name vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Name.CachedSize(false)
@@ -69,5 +69,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	size += cached.SequenceInfo.CachedSize(true)
 	// field MessageInfo *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.MessageInfo
 	size += cached.MessageInfo.CachedSize(true)
+	// field ViewDefinition string
+	size += int64(len(cached.ViewDefinition))
 	return size
 }
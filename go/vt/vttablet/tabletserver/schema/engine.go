@@ -32,10 +32,12 @@ import (
 
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
@@ -321,6 +323,11 @@ func (se *Engine) reload(ctx context.Context) error {
 		return err
 	}
 
+	viewDefinitions, err := se.loadViewDefinitions(ctx, conn, tableData)
+	if err != nil {
+		return err
+	}
+
 	rec := concurrency.AllErrorRecorder{}
 	// curTables keeps track of tables in the new snapshot so we can detect what was dropped.
 	curTables := map[string]bool{"dual": true}
@@ -341,8 +348,10 @@ func (se *Engine) reload(ctx context.Context) error {
 
 		// TODO(sougou); find a better way detect changed tables. This method
 		// seems unreliable. The endtoend test flags all tables as changed.
+		// Views have no create_time. A view is changed when its definition is.
+		viewDefinition, isView := viewDefinitions[tableName]
 		tbl, isInTablesMap := se.tables[tableName]
-		if isInTablesMap && createTime < se.lastChange {
+		if isInTablesMap && createTime < se.lastChange && (!isView || tbl.ViewDefinition == viewDefinition) {
 			tbl.FileSize = fileSize
 			tbl.AllocatedSize = allocatedSize
 			continue
@@ -354,6 +363,10 @@ func (se *Engine) reload(ctx context.Context) error {
 			rec.RecordError(err)
 			continue
 		}
+		if isView {
+			table.Type = View
+			table.ViewDefinition = viewDefinition
+		}
 		table.FileSize = fileSize
 		table.AllocatedSize = allocatedSize
 		changedTables[tableName] = table
@@ -393,6 +406,30 @@ func (se *Engine) reload(ctx context.Context) error {
 	return nil
 }
 
+// loadViewDefinitions returns the definitions of the views listed in tableData, keyed by view name.
+// information_schema.views is only queried when there are views.
+func (se *Engine) loadViewDefinitions(ctx context.Context, conn *connpool.DBConn, tableData *sqltypes.Result) (map[string]string, error) {
+	viewDefinitions := make(map[string]string)
+	hasViews := false
+	for _, row := range tableData.Rows {
+		if row[1].ToString() == tmutils.TableView {
+			hasViews = true
+			break
+		}
+	}
+	if !hasViews {
+		return viewDefinitions, nil
+	}
+	viewData, err := conn.Exec(ctx, mysql.ViewDefinitions, maxTableCount, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range viewData.Rows {
+		viewDefinitions[row[0].ToString()] = row[1].ToString()
+	}
+	return viewDefinitions, nil
+}
+
 func (se *Engine) updateInnoDBRowsRead(ctx context.Context, conn *connpool.DBConn) error {
 	readRowsData, err := conn.Exec(ctx, mysql.ShowRowsRead, 10, false)
 	if err != nil {
@@ -241,6 +241,64 @@ func TestOpenAndReload(t *testing.T) {
 	assert.Equal(t, want, se.GetSchema())
 }
 
+func TestReloadView(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+			mysql.BaseShowTablesRow("test_view", true, "VIEW"),
+		},
+	})
+	db.AddQuery("select * from test_view where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "pk",
+			Type: sqltypes.Int32,
+		}},
+	})
+	viewDefinitionsFields := sqltypes.MakeTestFields("table_name|view_definition", "varchar|varchar")
+	db.AddQuery(mysql.ViewDefinitions, sqltypes.MakeTestResult(viewDefinitionsFields,
+		"test_view|select `test_table_01`.`pk` AS `pk` from `test_table_01`",
+	))
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"t",
+		"int64"),
+		"1427325876",
+	))
+	AddFakeInnoDBReadRowsResult(db, 0)
+	se := newEngine(10, 10*time.Second, 10*time.Second, db)
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	view := se.GetTable(sqlparser.NewTableIdent("test_view"))
+	require.NotNil(t, view)
+	assert.Equal(t, View, view.Type)
+	assert.Equal(t, "select `test_table_01`.`pk` AS `pk` from `test_table_01`", view.ViewDefinition)
+
+	var altered []string
+	se.RegisterNotifier("test", func(full map[string]*Table, created, alteredTables, dropped []string) {
+		altered = alteredTables
+	})
+	defer se.UnregisterNotifier("test")
+
+	// A view has no create_time, so an unchanged definition means an unchanged view
+	require.NoError(t, se.Reload(context.Background()))
+	assert.Empty(t, altered)
+
+	db.AddQuery(mysql.ViewDefinitions, sqltypes.MakeTestResult(viewDefinitionsFields,
+		"test_view|select `test_table_01`.`pk` AS `pk` from `test_table_01` where (`test_table_01`.`pk` > 0)",
+	))
+	require.NoError(t, se.Reload(context.Background()))
+	assert.Equal(t, []string{"test_view"}, altered)
+	view = se.GetTable(sqlparser.NewTableIdent("test_view"))
+	require.NotNil(t, view)
+	assert.Equal(t, "select `test_table_01`.`pk` AS `pk` from `test_table_01` where (`test_table_01`.`pk` > 0)", view.ViewDefinition)
+}
+
 func TestOpenFailedDueToMissMySQLTime(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/throttler"
 )
 
@@ -50,6 +51,9 @@ var (
 	queryLogHandler = flag.String("query-log-stream-handler", "/debug/querylog", "URL handler for streaming queries log")
 	txLogHandler    = flag.String("transaction-log-stream-handler", "/debug/txlog", "URL handler for streaming transactions log")
 
+	// structuredQueryLog configures the structured JSON query log of vttablet.
+	structuredQueryLog = streamlog.RegisterStructuredLogFlags("vttablet")
+
 	// TxLogger can be used to enable logging of transactions.
 	// Call TxLogger.ServeLogs in your main program to enable logging.
 	// The log format can be inferred by looking at TxConnection.Format.
@@ -122,6 +126,7 @@ func init() {
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
+	flag.BoolVar(&currentConfig.SignalWhenSchemaChange, "queryserver-config-schema-change-signal", defaultConfig.SignalWhenSchemaChange, "query server schema signal, will signal connected vtgates that schema has changed whenever this is detected. VTGates will need to have -schema_change_signal enabled for this to work")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -135,6 +140,9 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.BoolVar(&currentConfig.HotRowProtection.AutoDetect, "hot_row_protection_auto_detect", defaultConfig.HotRowProtection.AutoDetect, "If true, hot row protection only serializes transactions for row (ranges) for which MySQL returned lock wait timeouts or deadlocks. Requires -enable_hot_row_protection.")
+	flag.IntVar(&currentConfig.HotRowProtection.AutoDetectThreshold, "hot_row_protection_auto_detect_threshold", defaultConfig.HotRowProtection.AutoDetectThreshold, "Number of lock wait timeouts or deadlocks for the same row (range) within the decay period after which the row (range) is considered hot.")
+	SecondsVar(&currentConfig.HotRowProtection.AutoDetectDecaySeconds, "hot_row_protection_auto_detect_decay", defaultConfig.HotRowProtection.AutoDetectDecaySeconds, "Time in seconds after which a detected hot row is no longer serialized if no further lock wait timeouts or deadlocks were seen for it.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -223,6 +231,15 @@ func Init() {
 	if *txLogHandler != "" {
 		TxLogger.ServeLogs(*txLogHandler, streamlog.GetFormatter(TxLogger))
 	}
+
+	if structuredQueryLog.Enabled() {
+		sl, err := StatsLogger.LogStructured("vttablet", structuredQueryLog)
+		if err != nil {
+			log.Exitf("Invalid structured query log configuration: %v", err)
+		}
+		// Flush and close the sinks once the tablet stops serving queries.
+		servenv.OnClose(sl.Stop)
+	}
 }
 
 // TabletConfig contains all the configuration for query service
@@ -253,6 +270,7 @@ type TabletConfig struct {
 	SchemaReloadIntervalSeconds Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
 	WatchReplication            bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions         bool    `json:"trackSchemaVersions,omitempty"`
+	SignalWhenSchemaChange      bool    `json:"signalWhenSchemaChange,omitempty"`
 	TerseErrors                 bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
@@ -301,6 +319,11 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+	// AutoDetect restricts the serialization to row (ranges) for which
+	// MySQL reported lock wait timeouts or deadlocks.
+	AutoDetect             bool    `json:"autoDetect,omitempty"`
+	AutoDetectThreshold    int     `json:"autoDetectThreshold,omitempty"`
+	AutoDetectDecaySeconds Seconds `json:"autoDetectDecaySeconds,omitempty"`
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -372,6 +395,14 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if c.HotRowProtection.AutoDetect {
+		if v := c.HotRowProtection.AutoDetectThreshold; v <= 0 {
+			return fmt.Errorf("-hot_row_protection_auto_detect_threshold must be > 0 (specified value: %v)", v)
+		}
+		if v := c.HotRowProtection.AutoDetectDecaySeconds; v <= 0 {
+			return fmt.Errorf("-hot_row_protection_auto_detect_decay must be > 0 (specified value: %v)", v)
+		}
+	}
 	return nil
 }
 
@@ -445,6 +476,10 @@ var defaultConfig = TabletConfig{
 		// Allow more than 1 transaction for the same hot row through to have enough
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
+		// Serialize a row (range) after a few lock wait timeouts or deadlocks
+		// within a minute.
+		AutoDetectThreshold:    3,
+		AutoDetectDecaySeconds: 60,
 	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
@@ -117,6 +117,8 @@ healthcheck:
   intervalSeconds: 20
   unhealthyThresholdSeconds: 7200
 hotRowProtection:
+  autoDetectDecaySeconds: 60
+  autoDetectThreshold: 3
   maxConcurrency: 5
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
@@ -190,9 +192,11 @@ func TestFlags(t *testing.T) {
 			MaxRows:             10000,
 		},
 		HotRowProtection: HotRowProtectionConfig{
-			MaxQueueSize:       20,
-			MaxGlobalQueueSize: 1000,
-			MaxConcurrency:     5,
+			MaxQueueSize:           20,
+			MaxGlobalQueueSize:     1000,
+			MaxConcurrency:         5,
+			AutoDetectThreshold:    3,
+			AutoDetectDecaySeconds: 60,
 		},
 		StreamBufferSize:            32768,
 		QueryCacheSize:              int(cache.DefaultConfig.MaxEntries),
@@ -17,10 +17,12 @@ limitations under the License.
 package tabletenv
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"io"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -49,6 +51,7 @@ type LogStats struct {
 	PlanType             string
 	OriginalSQL          string
 	BindVariables        map[string]*querypb.BindVariable
+	QueryTags            map[string]string
 	rewrittenSqls        []string
 	RowsAffected         int
 	NumberOfQueries      int
@@ -155,6 +158,32 @@ func (stats *LogStats) FmtQuerySources() string {
 	return strings.Join(sources[:n], ",")
 }
 
+// FmtQueryTags returns the query tags as a JSON object if asJSON is set,
+// or as a comma separated list of key=value pairs otherwise. Keys are
+// sorted in both cases.
+func (stats *LogStats) FmtQueryTags(asJSON bool) string {
+	if asJSON {
+		if len(stats.QueryTags) == 0 {
+			return "{}"
+		}
+		b, err := json.Marshal(stats.QueryTags)
+		if err != nil {
+			return "{}"
+		}
+		return string(b)
+	}
+	keys := make([]string, 0, len(stats.QueryTags))
+	for k := range stats.QueryTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tags := make([]string, len(keys))
+	for i, k := range keys {
+		tags[i] = k + "=" + stats.QueryTags[k]
+	}
+	return strings.Join(tags, ",")
+}
+
 // ContextHTML returns the HTML version of the context that was used, or "".
 // This is a method on LogStats instead of a field so that it doesn't need
 // to be passed by value everywhere.
@@ -205,11 +234,12 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 
 	// Valid options for the QueryLogFormat are text or json
 	var fmtString string
+	queryTags := stats.FmtQueryTags(*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON)
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q, \"QueryTags\": %v}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -234,6 +264,88 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.RowsAffected,
 		stats.SizeOfResponse(),
 		stats.ErrorStr(),
+		queryTags,
 	)
 	return err
 }
+
+// LogRecord is the structured query log record of a LogStats.
+// See streamlog.RecordSchemaVersion for compatibility rules.
+type LogRecord struct {
+	streamlog.RecordHeader
+	Method          string            `json:"method"`
+	CallInfo        string            `json:"call_info"`
+	Username        string            `json:"username"`
+	ImmediateCaller string            `json:"immediate_caller"`
+	EffectiveCaller string            `json:"effective_caller"`
+	Keyspace        string            `json:"keyspace,omitempty"`
+	Shard           string            `json:"shard,omitempty"`
+	TabletType      string            `json:"tablet_type,omitempty"`
+	StartTime       time.Time         `json:"start_time"`
+	EndTime         time.Time         `json:"end_time"`
+	TotalTime       float64           `json:"total_time"`
+	PlanType        string            `json:"plan_type"`
+	CachedPlan      bool              `json:"cached_plan"`
+	OriginalSQL     string            `json:"original_sql"`
+	BindVars        json.RawMessage   `json:"bind_vars"`
+	QueryTags       map[string]string `json:"query_tags,omitempty"`
+	Queries         int               `json:"queries"`
+	RewrittenSQL    string            `json:"rewritten_sql"`
+	QuerySources    string            `json:"query_sources"`
+	MysqlTime       float64           `json:"mysql_time"`
+	ConnWaitTime    float64           `json:"conn_wait_time"`
+	RowsAffected    int               `json:"rows_affected"`
+	ResponseSize    int               `json:"response_size"`
+	TransactionID   int64             `json:"transaction_id"`
+	ReservedID      int64             `json:"reserved_id"`
+	Error           string            `json:"error"`
+}
+
+// Record returns the structured query log record, or nil if the query
+// is filtered out. It implements streamlog.Recorder.
+func (stats *LogStats) Record() interface{} {
+	if !streamlog.ShouldEmitLog(stats.OriginalSQL, uint64(stats.RowsAffected), uint64(len(stats.Rows))) {
+		return nil
+	}
+
+	rewrittenSQL := "[REDACTED]"
+	bindVars := json.RawMessage(`"[REDACTED]"`)
+	if !*streamlog.RedactDebugUIQueries {
+		rewrittenSQL = stats.RewrittenSQL()
+		bindVars = json.RawMessage(sqltypes.FormatBindVariables(stats.BindVariables, true, true))
+	}
+
+	callInfo, username := stats.CallInfo()
+	record := &LogRecord{
+		RecordHeader:    streamlog.NewRecordHeader("vttablet"),
+		Method:          stats.Method,
+		CallInfo:        callInfo,
+		Username:        username,
+		ImmediateCaller: stats.ImmediateCaller(),
+		EffectiveCaller: stats.EffectiveCaller(),
+		StartTime:       stats.StartTime,
+		EndTime:         stats.EndTime,
+		TotalTime:       stats.TotalTime().Seconds(),
+		PlanType:        stats.PlanType,
+		CachedPlan:      stats.CachedPlan,
+		OriginalSQL:     stats.OriginalSQL,
+		BindVars:        bindVars,
+		QueryTags:       stats.QueryTags,
+		Queries:         stats.NumberOfQueries,
+		RewrittenSQL:    rewrittenSQL,
+		QuerySources:    stats.FmtQuerySources(),
+		MysqlTime:       stats.MysqlResponseTime.Seconds(),
+		ConnWaitTime:    stats.WaitingForConnection.Seconds(),
+		RowsAffected:    stats.RowsAffected,
+		ResponseSize:    stats.SizeOfResponse(),
+		TransactionID:   stats.TransactionID,
+		ReservedID:      stats.ReservedID,
+		Error:           stats.ErrorStr(),
+	}
+	if stats.Target != nil {
+		record.Keyspace = stats.Target.Keyspace
+		record.Shard = stats.Target.Shard
+		record.TabletType = stats.Target.TabletType.String()
+	}
+	return record
+}
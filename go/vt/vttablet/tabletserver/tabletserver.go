@@ -169,10 +169,11 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
 	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
-	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
+	tsv.hs = newHealthStreamer(tsv, alias, tsv.se)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
+	tsv.vstreamer.SetReplicationLagFunc(tsv.hs.ReplicationLag)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
 	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
@@ -180,7 +181,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
-	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
+	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc, tsv.lagThrottler)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
 
 	tsv.sm = &stateManager{
@@ -686,6 +687,26 @@ func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Ta
 	return metadata, err
 }
 
+// UnresolvedTransactions returns the unresolved 2pc transactions of this tablet,
+// both as coordinator and as participant.
+func (tsv *TabletServer) UnresolvedTransactions(ctx context.Context, target *querypb.Target, abandonAge int64) (transactions []*querypb.TransactionMetadata, prepared []*querypb.PreparedTransaction, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"UnresolvedTransactions", "unresolved_transactions", nil,
+		target, nil, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			txe := &TxExecutor{
+				ctx:      ctx,
+				logStats: logStats,
+				te:       tsv.te,
+			}
+			transactions, prepared, err = txe.UnresolvedTransactions(time.Duration(abandonAge) * time.Second)
+			return err
+		},
+	)
+	return transactions, prepared, err
+}
+
 // Execute executes the query and returns the result as response.
 func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, err error) {
 	span, ctx := trace.NewSpan(ctx, "TabletServer.Execute")
@@ -713,6 +734,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
 			query, comments := sqlparser.SplitMarginComments(sql)
+			comments = withQueryTags(comments, options.GetQueryTags())
 			plan, err := tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options), reservedID != 0)
 			if err != nil {
 				return err
@@ -738,6 +760,9 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			}
 			result, err = qre.Execute()
 			if err != nil {
+				if transactionID != 0 {
+					tsv.recordHotRowContention(plan, query, bindVariables, err)
+				}
 				return err
 			}
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
@@ -761,6 +786,30 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	return result, err
 }
 
+// withQueryTags adds to the margin comments of a query the tags that they
+// don't already carry, so that they are passed through to MySQL.
+func withQueryTags(comments sqlparser.MarginComments, tags map[string]string) sqlparser.MarginComments {
+	if len(tags) == 0 {
+		return comments
+	}
+	present := sqlparser.ParseQueryTags(comments)
+	var missing map[string]string
+	for k, v := range tags {
+		if pv, ok := present[k]; ok && pv == v {
+			continue
+		}
+		if missing == nil {
+			missing = make(map[string]string)
+		}
+		missing[k] = v
+	}
+	if missing == nil {
+		return comments
+	}
+	comments.Leading = sqlparser.FormatQueryTags(missing) + " " + comments.Leading
+	return comments
+}
+
 // smallerTimeout returns the smaller of the two timeouts.
 // 0 is treated as infinity.
 func smallerTimeout(t1, t2 time.Duration) time.Duration {
@@ -789,6 +838,7 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
 			query, comments := sqlparser.SplitMarginComments(sql)
+			comments = withQueryTags(comments, options.GetQueryTags())
 			// TODO: update the isReservedConn logic when StreamExecute supports reserved connections.
 			plan, err := tsv.qe.GetStreamPlan(query, false /* isReservedConn */)
 			if err != nil {
@@ -950,6 +1000,10 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 				// Query is not subject to tx serialization/hot row protection.
 				return nil
 			}
+			if tsv.qe.txSerializer.AutoDetect() && !tsv.qe.txSerializer.IsHotRow(k) {
+				// Only automatically detected hot rows are serialized.
+				return nil
+			}
 
 			startTime := time.Now()
 			done, waited, waitErr := tsv.qe.txSerializer.Wait(ctx, k, table)
@@ -976,7 +1030,12 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		logComputeRowSerializerKey.Errorf("failed to get plan for query: %v err: %v", sql, err)
 		return "", ""
 	}
+	return txSerializerKey(plan, sql, bindVariables)
+}
 
+// txSerializerKey is the same as computeTxSerializerKey but for an already
+// computed plan.
+func txSerializerKey(plan *TabletPlan, sql string, bindVariables map[string]*querypb.BindVariable) (string, string) {
 	switch plan.PlanID {
 	// Serialize only UPDATE or DELETE queries.
 	case planbuilder.PlanUpdate, planbuilder.PlanUpdateLimit,
@@ -1002,6 +1061,44 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 	return key, tableName.String()
 }
 
+// recordHotRowContention feeds the automatic hot row detection with lock wait
+// timeouts and deadlocks which MySQL returned for a query within a transaction.
+func (tsv *TabletServer) recordHotRowContention(plan *TabletPlan, sql string, bindVariables map[string]*querypb.BindVariable, err error) {
+	if !tsv.enableHotRowProtection || !tsv.qe.txSerializer.AutoDetect() {
+		return
+	}
+	sqlErr, ok := err.(*mysql.SQLError)
+	if !ok {
+		return
+	}
+	switch sqlErr.Number() {
+	case mysql.ERLockWaitTimeout, mysql.ERLockDeadlock:
+	default:
+		return
+	}
+	k, table := txSerializerKey(plan, sql, bindVariables)
+	if k == "" {
+		return
+	}
+	tsv.qe.txSerializer.RecordContention(k, table)
+}
+
+// HotRows returns the row (ranges) which are currently tracked by the
+// automatic hot row detection.
+func (tsv *TabletServer) HotRows() []txserializer.HotRow {
+	return tsv.qe.txSerializer.HotRows()
+}
+
+// GCTables returns the tables going through the table garbage collection lifecycle.
+func (tsv *TabletServer) GCTables(ctx context.Context) ([]*gc.GCTable, error) {
+	return tsv.tableGC.GCTables(ctx)
+}
+
+// CancelGCTableDrop restores a table in HOLD state to its original name, and returns that name.
+func (tsv *TabletServer) CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error) {
+	return tsv.tableGC.CancelDrop(ctx, tableName, originalName)
+}
+
 // BeginExecuteBatch combines Begin and ExecuteBatch.
 func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	// TODO(mberlin): Integrate hot row protection here as we did for BeginExecute()
@@ -1245,6 +1342,7 @@ func (tsv *TabletServer) execRequest(
 	logStats.Target = target
 	logStats.OriginalSQL = sql
 	logStats.BindVariables = bindVariables
+	logStats.QueryTags = options.GetQueryTags()
 	defer tsv.handlePanicAndSendLogStats(sql, bindVariables, logStats)
 	if err = tsv.sm.StartRequest(ctx, target, allowOnShutdown); err != nil {
 		return err
@@ -703,6 +703,39 @@ func TestTabletServerStreamExecuteComments(t *testing.T) {
 		t.Fatal("stats are empty")
 	}
 }
+func TestTabletServerStreamExecuteQueryTags(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000 /*request_id='r1'*/"
+	// Only the tags missing from the query comments are added.
+	mysqlSQL := "/*route='%2Fcart'*/ " + executeSQL
+	db.AddQuery(mysqlSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	callback := func(*sqltypes.Result) error { return nil }
+	options := &querypb.ExecuteOptions{QueryTags: map[string]string{"request_id": "r1", "route": "/cart"}}
+
+	ch := tabletenv.StatsLogger.Subscribe("test stats logging")
+	defer tabletenv.StatsLogger.Unsubscribe(ch)
+
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, options, callback)
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum(mysqlSQL))
+
+	select {
+	case out := <-ch:
+		stats := out.(*tabletenv.LogStats)
+		assert.Equal(t, options.QueryTags, stats.QueryTags)
+	default:
+		t.Fatal("stats are empty")
+	}
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -1435,6 +1468,44 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests_ExecuteBatchAsTrans
 	}
 }
 
+func TestSerializeTransactionsSameRow_AutoDetect(t *testing.T) {
+	// This test verifies that with automatic hot row detection, only row
+	// (ranges) for which MySQL reported lock contention are serialized.
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.AutoDetect = true
+	config.HotRowProtection.AutoDetectThreshold = 2
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	key := "test_table where pk = 1 and `name` = 1"
+	q := "update test_table set name_string = 'tx1' where pk = :pk and `name` = :name"
+	bv := map[string]*querypb.BindVariable{
+		"pk":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.Int64BindVariable(1),
+	}
+	db.AddRejectedQuery("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
+		mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded; try restarting transaction"))
+
+	for i := 1; i <= 2; i++ {
+		_, txID, _, err := tsv.BeginExecute(ctx, &target, nil, q, bv, 0, nil)
+		require.Error(t, err)
+		if txID != 0 {
+			_, err = tsv.Rollback(ctx, &target, txID)
+			require.NoError(t, err)
+		}
+		hotRows := tsv.HotRows()
+		require.Len(t, hotRows, 1)
+		assert.Equal(t, key, hotRows[0].Key)
+		assert.Equal(t, "test_table", hotRows[0].Table)
+		assert.Equal(t, i, hotRows[0].Contentions)
+	}
+	assert.True(t, tsv.qe.txSerializer.IsHotRow(key))
+	assert.False(t, tsv.qe.txSerializer.IsHotRow("test_table where pk = 2 and `name` = 1"))
+}
+
 func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 	// This test is similar to TestSerializeTransactionsSameRow, but tests only
 	// that a queued request unblocks itself when its context is done.
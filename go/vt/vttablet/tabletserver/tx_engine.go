@@ -447,6 +447,7 @@ func (te *TxEngine) startWatchdog() {
 			log.Errorf("Error reading transactions for 2pc watchdog: %v", err)
 			return
 		}
+		te.env.Stats().Unresolved.Set("Transactions", int64(len(txs)))
 		if len(txs) == 0 {
 			return
 		}
@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -260,6 +261,54 @@ func (txe *TxExecutor) ReadTwopcInflight() (distributed []*tx.DistributedTx, pre
 	return distributed, prepared, failed, nil
 }
 
+// UnresolvedTransactions returns the distributed transactions coordinated by this
+// tablet and the transactions it has prepared as a participant, that were created
+// more than abandonAge ago.
+func (txe *TxExecutor) UnresolvedTransactions(abandonAge time.Duration) ([]*querypb.TransactionMetadata, []*querypb.PreparedTransaction, error) {
+	distributed, prepared, failed, err := txe.ReadTwopcInflight()
+	if err != nil {
+		return nil, nil, err
+	}
+	cutoff := time.Now().Add(-abandonAge)
+	var transactions []*querypb.TransactionMetadata
+	for _, dtx := range distributed {
+		if dtx.Created.After(cutoff) {
+			continue
+		}
+		participants := make([]*querypb.Target, 0, len(dtx.Participants))
+		for i := range dtx.Participants {
+			participants = append(participants, &querypb.Target{
+				Keyspace:   dtx.Participants[i].Keyspace,
+				Shard:      dtx.Participants[i].Shard,
+				TabletType: topodatapb.TabletType_MASTER,
+			})
+		}
+		transactions = append(transactions, &querypb.TransactionMetadata{
+			Dtid:         dtx.Dtid,
+			State:        querypb.TransactionState(querypb.TransactionState_value[dtx.State]),
+			TimeCreated:  dtx.Created.UnixNano(),
+			Participants: participants,
+		})
+	}
+	var preparedTxs []*querypb.PreparedTransaction
+	appendPrepared := func(ptxs []*tx.PreparedTx, isFailed bool) {
+		for _, ptx := range ptxs {
+			if ptx.Time.After(cutoff) {
+				continue
+			}
+			preparedTxs = append(preparedTxs, &querypb.PreparedTransaction{
+				Dtid:        ptx.Dtid,
+				Failed:      isFailed,
+				TimeCreated: ptx.Time.UnixNano(),
+				Queries:     ptx.Queries,
+			})
+		}
+	}
+	appendPrepared(prepared, false)
+	appendPrepared(failed, true)
+	return transactions, preparedTxs, nil
+}
+
 func (txe *TxExecutor) inTransaction(f func(*StatefulConnection) error) error {
 	conn, _, err := txe.te.txPool.Begin(txe.ctx, &querypb.ExecuteOptions{}, false, 0, nil)
 	if err != nil {
@@ -28,10 +28,12 @@ import (
 	"context"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/vtgate/fakerpcvtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -398,6 +400,91 @@ func TestExecutorReadAllTransactions(t *testing.T) {
 	}
 }
 
+func TestExecutorUnresolvedTransactions(t *testing.T) {
+	txe, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	recent := fmt.Sprintf("%d", time.Now().UnixNano())
+	db.AddQuery(txe.te.twoPC.readAllTransactions, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("dtid0"),
+			sqltypes.NewInt64(int64(querypb.TransactionState_COMMIT)),
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NewVarBinary("ks01"),
+			sqltypes.NewVarBinary("shard01"),
+		}, {
+			sqltypes.NewVarBinary("dtid1"),
+			sqltypes.NewInt64(int64(querypb.TransactionState_PREPARE)),
+			sqltypes.NewVarBinary(recent),
+			sqltypes.NewVarBinary("ks01"),
+			sqltypes.NewVarBinary("shard01"),
+		}},
+	})
+	db.AddQuery(txe.te.twoPC.readAllRedo, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("dtid2"),
+			sqltypes.NewInt64(RedoStatePrepared),
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NewVarBinary("insert into t values(1)"),
+		}, {
+			sqltypes.NewVarBinary("dtid3"),
+			sqltypes.NewInt64(RedoStateFailed),
+			sqltypes.NewVarBinary("1"),
+			sqltypes.NewVarBinary("insert into t values(2)"),
+		}, {
+			sqltypes.NewVarBinary("dtid4"),
+			sqltypes.NewInt64(RedoStatePrepared),
+			sqltypes.NewVarBinary(recent),
+			sqltypes.NewVarBinary("insert into t values(3)"),
+		}},
+	})
+
+	transactions, prepared, err := txe.UnresolvedTransactions(time.Minute)
+	require.NoError(t, err)
+	wantTransactions := []*querypb.TransactionMetadata{{
+		Dtid:        "dtid0",
+		State:       querypb.TransactionState_COMMIT,
+		TimeCreated: 1,
+		Participants: []*querypb.Target{{
+			Keyspace:   "ks01",
+			Shard:      "shard01",
+			TabletType: topodatapb.TabletType_MASTER,
+		}},
+	}}
+	utils.MustMatch(t, wantTransactions, transactions, "")
+	wantPrepared := []*querypb.PreparedTransaction{{
+		Dtid:        "dtid2",
+		TimeCreated: 1,
+		Queries:     []string{"insert into t values(1)"},
+	}, {
+		Dtid:        "dtid3",
+		Failed:      true,
+		TimeCreated: 1,
+		Queries:     []string{"insert into t values(2)"},
+	}}
+	utils.MustMatch(t, wantPrepared, prepared, "")
+
+	// Without an abandon age, the recent ones are returned too.
+	transactions, prepared, err = txe.UnresolvedTransactions(0)
+	require.NoError(t, err)
+	assert.Len(t, transactions, 2)
+	assert.Len(t, prepared, 3)
+}
+
 // These vars and types are used only for TestExecutorResolveTransaction
 var dtidCh = make(chan string)
 
@@ -21,6 +21,7 @@ package txserializer
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -62,6 +63,15 @@ type TxSerializer struct {
 	maxGlobalQueueSize     int
 	concurrentTransactions int
 
+	// autoDetect is true if only row (ranges) are serialized for which lock
+	// contention (lock wait timeouts or deadlocks) was reported by MySQL.
+	// A row (range) becomes "hot" once it saw "detectionThreshold" contentions
+	// within "decayPeriod". It stays hot until no contention was seen for
+	// "decayPeriod".
+	autoDetect         bool
+	detectionThreshold int
+	decayPeriod        time.Duration
+
 	// waits stores how many times a transaction was queued because another
 	// transaction was already in flight for the same row (range).
 	// The key of the map is the table name of the query.
@@ -80,6 +90,10 @@ type TxSerializer struct {
 	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
 	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
 
+	// hotRowsDetected counts per table how many row (ranges) were
+	// automatically detected as hot rows.
+	hotRowsDetected *stats.CountersWithSingleLabel
+
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
 	logWaitsDryRun               *logutil.ThrottledLogger
@@ -89,18 +103,27 @@ type TxSerializer struct {
 	mu         sync.Mutex
 	queues     map[string]*queue
 	globalSize int
+	// hotRows tracks the row (ranges) with lock contention when autoDetect is
+	// enabled. Guarded by "mu".
+	hotRows map[string]*hotRow
+
+	// now is used to determine the current time. It's overridden in tests.
+	now func() time.Time
 }
 
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
 		maxQueueSize:           config.HotRowProtection.MaxQueueSize,
 		maxGlobalQueueSize:     config.HotRowProtection.MaxGlobalQueueSize,
 		concurrentTransactions: config.HotRowProtection.MaxConcurrency,
+		autoDetect:             config.HotRowProtection.AutoDetect,
+		detectionThreshold:     config.HotRowProtection.AutoDetectThreshold,
+		decayPeriod:            config.HotRowProtection.AutoDetectDecaySeconds.Get(),
 		waits: env.Exporter().NewCountersWithSingleLabel(
 			"TxSerializerWaits",
 			"Number of times a transaction was queued because another transaction was already in flight for the same row range",
@@ -123,14 +146,23 @@ func New(env tabletenv.Env) *TxSerializer {
 		globalQueueExceededDryRun: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceededDryRun",
 			"Dry-run stats for TxSerializerGlobalQueueExceeded"),
+		hotRowsDetected: env.Exporter().NewCountersWithSingleLabel(
+			"TxSerializerHotRowsDetected",
+			"Number of row ranges which were automatically detected as hot rows due to lock wait timeouts or deadlocks",
+			"table_name"),
 		log:                          logutil.NewThrottledLogger("HotRowProtection", 5*time.Second),
 		logDryRun:                    logutil.NewThrottledLogger("HotRowProtection DryRun", 5*time.Second),
 		logWaitsDryRun:               logutil.NewThrottledLogger("HotRowProtection Waits DryRun", 5*time.Second),
 		logQueueExceededDryRun:       logutil.NewThrottledLogger("HotRowProtection QueueExceeded DryRun", 5*time.Second),
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
+		hotRows:                      make(map[string]*hotRow),
+		now:                          time.Now,
 	}
-
+	env.Exporter().NewGaugeFunc("TxSerializerHotRowsProtected", "Number of automatically detected hot row ranges which are currently serialized", func() int64 {
+		return int64(txs.protectedCount())
+	})
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -335,6 +367,170 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 	}
 }
 
+// AutoDetect returns true if only automatically detected hot rows are
+// serialized.
+func (txs *TxSerializer) AutoDetect() bool {
+	return txs.autoDetect
+}
+
+// RecordContention records that MySQL reported lock contention (a lock wait
+// timeout or a deadlock) for a transaction on the given row (range).
+// Once the detection threshold is reached within the decay period, the row
+// (range) is considered hot and IsHotRow() returns true for it.
+func (txs *TxSerializer) RecordContention(key, table string) {
+	if !txs.autoDetect {
+		return
+	}
+
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	now := txs.now()
+	txs.expireHotRowsLocked(now)
+	hr, ok := txs.hotRows[key]
+	if !ok {
+		hr = &hotRow{table: table}
+		txs.hotRows[key] = hr
+	}
+	hr.contentions++
+	hr.lastSeen = now
+	if !hr.protectedUntil.IsZero() {
+		// Already protected. Extend the protection.
+		hr.protectedUntil = now.Add(txs.decayPeriod)
+		return
+	}
+	if hr.contentions >= txs.detectionThreshold {
+		hr.protectedUntil = now.Add(txs.decayPeriod)
+		txs.hotRowsDetected.Add(table, 1)
+		txs.log.Infof("Detected hot row range (%v) after %v lock wait timeouts or deadlocks. Transactions will be serialized until %v.", key, hr.contentions, hr.protectedUntil)
+	}
+}
+
+// IsHotRow returns true if the given row (range) was automatically detected as
+// hot row and its protection did not decay yet.
+func (txs *TxSerializer) IsHotRow(key string) bool {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	hr, ok := txs.hotRows[key]
+	if !ok {
+		return false
+	}
+	return hr.protectedUntil.After(txs.now())
+}
+
+// HotRow describes a row (range) which is tracked by the automatic hot row
+// detection.
+type HotRow struct {
+	// Key is the table name followed by the WHERE clause.
+	Key   string
+	Table string
+	// Contentions is the number of lock wait timeouts and deadlocks observed
+	// within the current decay period.
+	Contentions int
+	// ProtectedUntil is the time until which transactions for this row (range)
+	// are serialized. It's zero if the detection threshold was not reached yet.
+	ProtectedUntil time.Time
+}
+
+// HotRows returns all row (ranges) which are currently tracked by the
+// automatic hot row detection, sorted by key.
+func (txs *TxSerializer) HotRows() []HotRow {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	txs.expireHotRowsLocked(txs.now())
+	result := make([]HotRow, 0, len(txs.hotRows))
+	for key, hr := range txs.hotRows {
+		result = append(result, HotRow{
+			Key:            key,
+			Table:          hr.table,
+			Contentions:    hr.contentions,
+			ProtectedUntil: hr.protectedUntil,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// expireHotRowsLocked removes all row (ranges) for which no contention was
+// seen within the decay period.
+// The method has the suffix "Locked" to clarify that "txs.mu" must be locked.
+func (txs *TxSerializer) expireHotRowsLocked(now time.Time) {
+	for key, hr := range txs.hotRows {
+		if now.Sub(hr.lastSeen) >= txs.decayPeriod {
+			delete(txs.hotRows, key)
+		}
+	}
+}
+
+func (txs *TxSerializer) protectedCount() int {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	now := txs.now()
+	count := 0
+	for _, hr := range txs.hotRows {
+		if hr.protectedUntil.After(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// ServeHotRowsHTTP lists the row (ranges) which are tracked by the automatic
+// hot row detection.
+func (txs *TxSerializer) ServeHotRowsHTTP(response http.ResponseWriter, request *http.Request) {
+	if *streamlog.RedactDebugUIQueries {
+		response.Write([]byte(`
+	<!DOCTYPE html>
+	<html>
+	<body>
+	<h1>Redacted</h1>
+	<p>/debug/hotrows_detected has been redacted for your protection</p>
+	</body>
+	</html>
+		`))
+		return
+	}
+
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "text/plain")
+	if !txs.autoDetect {
+		response.Write([]byte("automatic hot row detection is disabled\n"))
+		return
+	}
+	hotRows := txs.HotRows()
+	if len(hotRows) == 0 {
+		response.Write([]byte("empty\n"))
+		return
+	}
+	response.Write([]byte(fmt.Sprintf("Length: %d\n", len(hotRows))))
+	for _, hr := range hotRows {
+		protected := "not protected"
+		if !hr.ProtectedUntil.IsZero() {
+			protected = fmt.Sprintf("protected until %v", hr.ProtectedUntil.Format(time.RFC3339))
+		}
+		response.Write([]byte(fmt.Sprintf("%v: %s (%s)\n", hr.Contentions, hr.Key, protected)))
+	}
+}
+
+// hotRow tracks the lock contention for a row (range) when the automatic hot
+// row detection is enabled.
+type hotRow struct {
+	table string
+	// contentions counts the lock wait timeouts and deadlocks within the
+	// current decay period.
+	contentions int
+	// lastSeen is the time of the most recent contention.
+	lastSeen time.Time
+	// protectedUntil is set once the detection threshold was reached.
+	protectedUntil time.Time
+}
+
 // queue represents the local queue for a particular row (range).
 //
 // Note that we don't use a dedicated queue structure for all waiting
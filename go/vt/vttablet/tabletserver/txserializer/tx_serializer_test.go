@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -41,6 +42,7 @@ func resetVariables(txs *TxSerializer) {
 	txs.queueExceededDryRun.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
+	txs.hotRowsDetected.ResetAll()
 }
 
 func TestTxSerializer_NoHotRow(t *testing.T) {
@@ -521,6 +523,118 @@ func TestTxSerializerPending(t *testing.T) {
 	}
 }
 
+func TestTxSerializerAutoDetect(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.AutoDetect = true
+	config.HotRowProtection.AutoDetectThreshold = 2
+	config.HotRowProtection.AutoDetectDecaySeconds = 60
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+	now := time.Now()
+	txs.now = func() time.Time { return now }
+
+	// The first contention does not reach the threshold yet.
+	txs.RecordContention("t1 where1", "t1")
+	if txs.IsHotRow("t1 where1") {
+		t.Error("row must not be hot after the first contention")
+	}
+	if got, want := txs.HotRows(), []HotRow{{Key: "t1 where1", Table: "t1", Contentions: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong hot rows: got = %v, want = %v", got, want)
+	}
+
+	// The second contention within the decay period reaches the threshold.
+	now = now.Add(10 * time.Second)
+	txs.RecordContention("t1 where1", "t1")
+	if !txs.IsHotRow("t1 where1") {
+		t.Error("row must be hot after the second contention")
+	}
+	if txs.IsHotRow("t1 where2") {
+		t.Error("other rows must not be hot")
+	}
+	if got, want := txs.hotRowsDetected.Counts()["t1"], int64(1); got != want {
+		t.Errorf("wrong HotRowsDetected variable: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.protectedCount(), 1; got != want {
+		t.Errorf("wrong number of protected rows: got = %v, want = %v", got, want)
+	}
+
+	// Further contentions extend the protection.
+	now = now.Add(50 * time.Second)
+	txs.RecordContention("t1 where1", "t1")
+	now = now.Add(50 * time.Second)
+	if !txs.IsHotRow("t1 where1") {
+		t.Error("protection must have been extended")
+	}
+	if got, want := txs.hotRowsDetected.Counts()["t1"], int64(1); got != want {
+		t.Errorf("wrong HotRowsDetected variable: got = %v, want = %v", got, want)
+	}
+
+	// Without further contentions, the protection decays.
+	now = now.Add(10 * time.Second)
+	if txs.IsHotRow("t1 where1") {
+		t.Error("protection must have decayed")
+	}
+	if got := txs.HotRows(); len(got) != 0 {
+		t.Errorf("decayed rows must no longer be tracked: %v", got)
+	}
+}
+
+func TestTxSerializerAutoDetect_ContentionsDecay(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.AutoDetect = true
+	config.HotRowProtection.AutoDetectThreshold = 2
+	config.HotRowProtection.AutoDetectDecaySeconds = 60
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	now := time.Now()
+	txs.now = func() time.Time { return now }
+
+	// Two contentions which are further apart than the decay period do not
+	// make a hot row.
+	txs.RecordContention("t1 where1", "t1")
+	now = now.Add(61 * time.Second)
+	txs.RecordContention("t1 where1", "t1")
+	if txs.IsHotRow("t1 where1") {
+		t.Error("row must not be hot if the contentions decayed in between")
+	}
+}
+
+func TestTxSerializerAutoDetect_Disabled(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.AutoDetectThreshold = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+
+	txs.RecordContention("t1 where1", "t1")
+	if txs.IsHotRow("t1 where1") {
+		t.Error("contentions must be ignored if auto detection is disabled")
+	}
+}
+
+func TestTxSerializerAutoDetect_HTTPHandler(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.AutoDetect = true
+	config.HotRowProtection.AutoDetectThreshold = 1
+	config.HotRowProtection.AutoDetectDecaySeconds = 60
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+
+	req, err := http.NewRequest("GET", "/path-is-ignored-in-test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	txs.ServeHotRowsHTTP(rr, req)
+	if got, want := rr.Body.String(), "empty\n"; got != want {
+		t.Errorf("wrong content: got = \n%v\n want = \n%v", got, want)
+	}
+
+	txs.RecordContention("t1 where1", "t1")
+	rr = httptest.NewRecorder()
+	txs.ServeHotRowsHTTP(rr, req)
+	want := "Length: 1\n1: t1 where1 (protected until "
+	if got := rr.Body.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("wrong content: got = \n%v\n want prefix = \n%v", got, want)
+	}
+}
+
 func BenchmarkTxSerializer_NoHotRow(b *testing.B) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
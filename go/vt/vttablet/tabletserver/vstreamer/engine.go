@@ -23,6 +23,7 @@ import (
 	"errors"
 	"net/http"
 	"sync"
+	"time"
 
 	"vitess.io/vitess/go/vt/servenv"
 
@@ -91,6 +92,10 @@ type Engine struct {
 	vstreamersEndedWithErrors *stats.Counter
 
 	throttlerClient *throttle.Client
+
+	// replicationLag returns the replication lag of the tablet,
+	// which is reported in the heartbeats. It's set by SetReplicationLagFunc.
+	replicationLag func() time.Duration
 }
 
 // NewEngine creates a new Engine.
@@ -134,6 +139,21 @@ func (vse *Engine) InitDBConfig(keyspace string) {
 	vse.keyspace = keyspace
 }
 
+// SetReplicationLagFunc sets the function that returns the replication
+// lag of the tablet. The lag is sent in the heartbeat events.
+func (vse *Engine) SetReplicationLagFunc(f func() time.Duration) {
+	vse.replicationLag = f
+}
+
+// replicationLagSeconds returns the replication lag of the tablet, or 0
+// if it's unknown.
+func (vse *Engine) replicationLagSeconds() int64 {
+	if vse == nil || vse.replicationLag == nil {
+		return 0
+	}
+	return int64(vse.replicationLag().Seconds())
+}
+
 // Open starts the Engine service.
 func (vse *Engine) Open() {
 	vse.mu.Lock()
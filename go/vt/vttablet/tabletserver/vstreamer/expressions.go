@@ -0,0 +1,445 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file compiles the expressions of the filter queries into evalengine
+// expressions: the predicates of the where clause and the scalar expressions
+// of the select list. They're evaluated against the values of each row of
+// the table, which allows filtering and transforming the rows without asking
+// MySQL.
+//
+// The comparisons of non-binary strings are not supported because they
+// depend on the collation of the column. Such columns can be compared after
+// converting them to binary, like in "convert(col, binary) = 'abc'".
+
+var comparisonOps = map[sqlparser.ComparisonExprOperator]evalengine.ComparisonOp{
+	sqlparser.EqualOp:         evalengine.EqualOp,
+	sqlparser.NotEqualOp:      evalengine.NotEqualOp,
+	sqlparser.LessThanOp:      evalengine.LessThanOp,
+	sqlparser.LessEqualOp:     evalengine.LessEqualOp,
+	sqlparser.GreaterThanOp:   evalengine.GreaterThanOp,
+	sqlparser.GreaterEqualOp:  evalengine.GreaterEqualOp,
+	sqlparser.NullSafeEqualOp: evalengine.NullSafeEqualOp,
+}
+
+var isOps = map[sqlparser.IsExprOperator]evalengine.IsOp{
+	sqlparser.IsNullOp:     evalengine.IsNullOp,
+	sqlparser.IsNotNullOp:  evalengine.IsNotNullOp,
+	sqlparser.IsTrueOp:     evalengine.IsTrueOp,
+	sqlparser.IsNotTrueOp:  evalengine.IsNotTrueOp,
+	sqlparser.IsFalseOp:    evalengine.IsFalseOp,
+	sqlparser.IsNotFalseOp: evalengine.IsNotFalseOp,
+}
+
+// exprType returns the type of the values of a compiled expression.
+func exprType(expr evalengine.Expr) querypb.Type {
+	typ, err := expr.Type(evalengine.ExpressionEnv{})
+	if err != nil {
+		return sqltypes.Null
+	}
+	return typ
+}
+
+// evalExpr evaluates a compiled expression against the values of a row.
+func evalExpr(expr evalengine.Expr, values []sqltypes.Value) (sqltypes.Value, error) {
+	result, err := expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return result.Value(), nil
+}
+
+// compileExpr compiles an expression of the filter query.
+func (plan *Plan) compileExpr(node sqlparser.Expr) (evalengine.Expr, error) {
+	switch node := node.(type) {
+	case *sqlparser.ColName:
+		if !node.Qualifier.IsEmpty() {
+			return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(node))
+		}
+		colnum, err := findColumn(plan.Table, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewTypedColumn(colnum, plan.Table.Fields[colnum].Type), nil
+	case *sqlparser.Literal, *sqlparser.NullVal:
+		pv, err := sqlparser.NewPlanValue(node)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+		}
+		val, err := pv.ResolveValue(nil)
+		if err != nil {
+			return nil, err
+		}
+		// Like in MySQL, the numbers with a decimal point are exact,
+		// and only the ones with an exponent are floats.
+		if lit, ok := node.(*sqlparser.Literal); ok && lit.Type == sqlparser.FloatVal && !bytes.ContainsAny(lit.Bytes(), "eE") {
+			val = sqltypes.MakeTrusted(sqltypes.Decimal, lit.Bytes())
+		}
+		return evalengine.NewLiteral(val)
+	case sqlparser.BoolVal:
+		if node {
+			return evalengine.NewLiteralInt(1), nil
+		}
+		return evalengine.NewLiteralInt(0), nil
+	case *sqlparser.ComparisonExpr:
+		return plan.compileComparison(node)
+	case *sqlparser.RangeCond:
+		left, err := plan.compileExpr(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		from, err := plan.compileExpr(node.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := plan.compileExpr(node.To)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComparable(node, left, from, to); err != nil {
+			return nil, err
+		}
+		var between evalengine.Expr = &evalengine.LogicalExpr{
+			Op:    evalengine.AndOp,
+			Left:  &evalengine.ComparisonExpr{Op: evalengine.GreaterEqualOp, Left: left, Right: from},
+			Right: &evalengine.ComparisonExpr{Op: evalengine.LessEqualOp, Left: left, Right: to},
+		}
+		if node.Operator == sqlparser.NotBetweenOp {
+			between = &evalengine.NotExpr{Expr: between}
+		}
+		return between, nil
+	case *sqlparser.IsExpr:
+		expr, err := plan.compileExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.IsExpr{Op: isOps[node.Operator], Expr: expr}, nil
+	case *sqlparser.AndExpr:
+		return plan.compileLogical(evalengine.AndOp, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return plan.compileLogical(evalengine.OrOp, node.Left, node.Right)
+	case *sqlparser.XorExpr:
+		return plan.compileLogical(evalengine.XorOp, node.Left, node.Right)
+	case *sqlparser.NotExpr:
+		expr, err := plan.compileExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Expr: expr}, nil
+	case *sqlparser.BinaryExpr:
+		return plan.compileArithmetic(node)
+	case *sqlparser.UnaryExpr:
+		expr, err := plan.compileExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Operator {
+		case sqlparser.UPlusOp:
+			return expr, nil
+		case sqlparser.UMinusOp:
+			return newArithmeticExpr(&evalengine.Subtraction{}, evalengine.NewLiteralInt(0), expr), nil
+		case sqlparser.BangOp:
+			return &evalengine.NotExpr{Expr: expr}, nil
+		case sqlparser.BinaryOp, sqlparser.UBinaryOp:
+			return &evalengine.ConvertExpr{Expr: expr, ResultType: sqltypes.VarBinary, Length: -1}, nil
+		case sqlparser.Utf8mb4Op, sqlparser.Utf8Op, sqlparser.Latin1Op:
+			return &evalengine.ConvertExpr{Expr: expr, ResultType: sqltypes.VarChar, Length: -1}, nil
+		}
+	case *sqlparser.CaseExpr:
+		return plan.compileCase(node)
+	case *sqlparser.ConvertExpr:
+		return plan.compileConvert(node)
+	case *sqlparser.SubstrExpr:
+		var str sqlparser.Expr = node.Name
+		if node.StrVal != nil {
+			str = node.StrVal
+		}
+		return plan.compileFunc("substr", node, []sqlparser.Expr{str, node.From, node.To})
+	case *sqlparser.FuncExpr:
+		if node.Distinct || !node.Qualifier.IsEmpty() || node.IsAggregate() {
+			return nil, fmt.Errorf("unsupported function: %v", sqlparser.String(node))
+		}
+		args := make([]sqlparser.Expr, 0, len(node.Exprs))
+		for _, selExpr := range node.Exprs {
+			aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, fmt.Errorf("unsupported: %v", sqlparser.String(selExpr))
+			}
+			args = append(args, aliased.Expr)
+		}
+		return plan.compileFunc(node.Name.Lowered(), node, args)
+	}
+	return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+}
+
+// checkComparable returns an error if the expressions are non-binary
+// strings, because their comparison depends on their collation.
+func checkComparable(node sqlparser.Expr, exprs ...evalengine.Expr) error {
+	for _, expr := range exprs {
+		if sqltypes.IsText(exprType(expr)) {
+			return fmt.Errorf("unsupported: comparison of non-binary strings: %v", sqlparser.String(node))
+		}
+	}
+	return nil
+}
+
+func (plan *Plan) compileComparison(node *sqlparser.ComparisonExpr) (evalengine.Expr, error) {
+	left, err := plan.compileExpr(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case sqlparser.InOp, sqlparser.NotInOp:
+		tuple, ok := node.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+		}
+		in := &evalengine.InExpr{Left: left, Not: node.Operator == sqlparser.NotInOp}
+		for _, val := range tuple {
+			expr, err := plan.compileExpr(val)
+			if err != nil {
+				return nil, err
+			}
+			in.List = append(in.List, expr)
+		}
+		if err := checkComparable(node, append(in.List, left)...); err != nil {
+			return nil, err
+		}
+		return in, nil
+	case sqlparser.LikeOp, sqlparser.NotLikeOp:
+		pattern, err := plan.compileExpr(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkComparable(node, left, pattern); err != nil {
+			return nil, err
+		}
+		escape := byte('\\')
+		if node.Escape != nil {
+			lit, ok := node.Escape.(*sqlparser.Literal)
+			if !ok || lit.Type != sqlparser.StrVal || len(lit.Val) != 1 {
+				return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+			}
+			escape = lit.Val[0]
+		}
+		return evalengine.NewLikeExpr(left, pattern, escape, node.Operator == sqlparser.NotLikeOp), nil
+	}
+	op, ok := comparisonOps[node.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+	}
+	right, err := plan.compileExpr(node.Right)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkComparable(node, left, right); err != nil {
+		return nil, err
+	}
+	return &evalengine.ComparisonExpr{Op: op, Left: left, Right: right}, nil
+}
+
+func (plan *Plan) compileLogical(op evalengine.LogicalOp, left, right sqlparser.Expr) (evalengine.Expr, error) {
+	l, err := plan.compileExpr(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := plan.compileExpr(right)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.LogicalExpr{Op: op, Left: l, Right: r}, nil
+}
+
+var arithmeticOps = map[sqlparser.BinaryExprOperator]func() evalengine.BinaryExpr{
+	sqlparser.PlusOp:  func() evalengine.BinaryExpr { return &evalengine.Addition{} },
+	sqlparser.MinusOp: func() evalengine.BinaryExpr { return &evalengine.Subtraction{} },
+	sqlparser.MultOp:  func() evalengine.BinaryExpr { return &evalengine.Multiplication{} },
+	sqlparser.DivOp:   func() evalengine.BinaryExpr { return &evalengine.Division{} },
+}
+
+func (plan *Plan) compileArithmetic(node *sqlparser.BinaryExpr) (evalengine.Expr, error) {
+	op, ok := arithmeticOps[node.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+	}
+	// Date arithmetic: "col + interval 1 day".
+	if interval, ok := node.Right.(*sqlparser.IntervalExpr); ok && (node.Operator == sqlparser.PlusOp || node.Operator == sqlparser.MinusOp) {
+		return plan.compileInterval(node, node.Left, interval, node.Operator == sqlparser.MinusOp)
+	}
+	if interval, ok := node.Left.(*sqlparser.IntervalExpr); ok && node.Operator == sqlparser.PlusOp {
+		return plan.compileInterval(node, node.Right, interval, false)
+	}
+	left, err := plan.compileExpr(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := plan.compileExpr(node.Right)
+	if err != nil {
+		return nil, err
+	}
+	return newArithmeticExpr(op(), left, right), nil
+}
+
+// newArithmeticExpr returns an arithmetic operation. Like in MySQL, the
+// operands that aren't numbers are converted to floats.
+func newArithmeticExpr(op evalengine.BinaryExpr, left, right evalengine.Expr) evalengine.Expr {
+	return &evalengine.BinaryOp{Expr: op, Left: toNumber(left), Right: toNumber(right)}
+}
+
+func toNumber(expr evalengine.Expr) evalengine.Expr {
+	if typ := exprType(expr); sqltypes.IsNumber(typ) || typ == sqltypes.Null {
+		return expr
+	}
+	return &evalengine.ConvertExpr{Expr: expr, ResultType: sqltypes.Float64}
+}
+
+func (plan *Plan) compileCase(node *sqlparser.CaseExpr) (evalengine.Expr, error) {
+	c := &evalengine.CaseExpr{}
+	var err error
+	if node.Expr != nil {
+		if c.Base, err = plan.compileExpr(node.Expr); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, err := plan.compileExpr(when.Cond)
+		if err != nil {
+			return nil, err
+		}
+		if c.Base != nil {
+			if err := checkComparable(node, c.Base, cond); err != nil {
+				return nil, err
+			}
+		}
+		val, err := plan.compileExpr(when.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.Whens = append(c.Whens, evalengine.CaseWhen{Cond: cond, Val: val})
+	}
+	if node.Else != nil {
+		if c.Else, err = plan.compileExpr(node.Else); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (plan *Plan) compileConvert(node *sqlparser.ConvertExpr) (evalengine.Expr, error) {
+	expr, err := plan.compileExpr(node.Expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &evalengine.ConvertExpr{Expr: expr, Length: -1}
+	if node.Type.Length != nil {
+		if c.Length, err = strconv.Atoi(node.Type.Length.Val); err != nil {
+			return nil, err
+		}
+	}
+	if node.Type.Scale != nil {
+		if c.Scale, err = strconv.Atoi(node.Type.Scale.Val); err != nil {
+			return nil, err
+		}
+	}
+	switch strings.ToLower(node.Type.Type) {
+	case "signed":
+		c.ResultType = sqltypes.Int64
+	case "unsigned":
+		c.ResultType = sqltypes.Uint64
+	case "char", "nchar":
+		if strings.EqualFold(node.Type.Charset, "binary") {
+			c.ResultType = sqltypes.VarBinary
+		} else {
+			c.ResultType = sqltypes.VarChar
+		}
+	case "binary":
+		c.ResultType = sqltypes.VarBinary
+	case "decimal":
+		c.ResultType = sqltypes.Decimal
+	case "date":
+		c.ResultType = sqltypes.Date
+	case "datetime":
+		c.ResultType = sqltypes.Datetime
+	case "time":
+		c.ResultType = sqltypes.Time
+	default:
+		return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+	}
+	if c.Length > 6 && (c.ResultType == sqltypes.Datetime || c.ResultType == sqltypes.Time) {
+		return nil, fmt.Errorf("too big precision %d specified for %v, maximum is 6", c.Length, sqlparser.String(node))
+	}
+	return c, nil
+}
+
+func (plan *Plan) compileFunc(name string, node sqlparser.Expr, args []sqlparser.Expr) (evalengine.Expr, error) {
+	switch name {
+	case "date_add", "adddate", "date_sub", "subdate":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("incorrect parameter count in the call to %s: %v", name, sqlparser.String(node))
+		}
+		interval, ok := args[1].(*sqlparser.IntervalExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(node))
+		}
+		return plan.compileInterval(node, args[0], interval, name == "date_sub" || name == "subdate")
+	}
+	if !evalengine.HasFunction(name) {
+		return nil, fmt.Errorf("unsupported function: %v", sqlparser.String(node))
+	}
+	exprs := make([]evalengine.Expr, 0, len(args))
+	for _, arg := range args {
+		expr, err := plan.compileExpr(arg)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	f, err := evalengine.NewFuncExpr(name, exprs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", err.Error(), sqlparser.String(node))
+	}
+	return f, nil
+}
+
+func (plan *Plan) compileInterval(node, date sqlparser.Expr, interval *sqlparser.IntervalExpr, subtract bool) (evalengine.Expr, error) {
+	dateExpr, err := plan.compileExpr(date)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := plan.compileExpr(interval.Expr)
+	if err != nil {
+		return nil, err
+	}
+	ie, err := evalengine.NewIntervalExpr(dateExpr, amount, strings.ToLower(interval.Unit), subtract)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported interval unit: %v", sqlparser.String(node))
+	}
+	return ie, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestPlanFilterWhere(t *testing.T) {
+	table := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "val", Type: sqltypes.VarBinary},
+			{Name: "price", Type: sqltypes.Float64},
+			{Name: "created", Type: sqltypes.Datetime},
+		},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("apple"), sqltypes.NewFloat64(1.5), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-10 10:00:00"))},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("banana"), sqltypes.NewFloat64(0.25), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-02-15 12:30:00"))},
+		{sqltypes.NewInt64(3), sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		{sqltypes.NewInt64(4), sqltypes.NewVarBinary("cherry"), sqltypes.NewFloat64(12), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-20 00:00:00"))},
+	}
+
+	testcases := []struct {
+		where string
+		ids   []int64
+	}{
+		{where: "id = 2", ids: []int64{2}},
+		{where: "id != 2", ids: []int64{1, 3, 4}},
+		{where: "id > 1 and id <= 3", ids: []int64{2, 3}},
+		{where: "id < 2 or id >= 4", ids: []int64{1, 4}},
+		{where: "id between 2 and 3", ids: []int64{2, 3}},
+		{where: "id not between 2 and 3", ids: []int64{1, 4}},
+		{where: "id in (1, 4, 5)", ids: []int64{1, 4}},
+		{where: "id not in (1, 4)", ids: []int64{2, 3}},
+		{where: "id not in (1, null)", ids: nil},
+		{where: "val in ('apple', 'cherry')", ids: []int64{1, 4}},
+		{where: "val is null", ids: []int64{3}},
+		{where: "val is not null", ids: []int64{1, 2, 4}},
+		{where: "val like 'b%'", ids: []int64{2}},
+		{where: "val like '_pple'", ids: []int64{1}},
+		{where: "val not like '%an%'", ids: []int64{1, 4}},
+		{where: "val > 'b'", ids: []int64{2, 4}},
+		{where: "price > 1", ids: []int64{1, 4}},
+		{where: "price * 2 = 3", ids: []int64{1}},
+		{where: "not (price > 1)", ids: []int64{2}},
+		{where: "price > 1 xor id = 1", ids: []int64{4}},
+		{where: "price <=> null", ids: []int64{3}},
+		{where: "created >= '2021-02-01'", ids: []int64{2, 4}},
+		{where: "created < '2021-02-01 00:00:00' or val is null", ids: []int64{1, 3}},
+		{where: "month(created) = 3", ids: []int64{4}},
+		{where: "date(created) = '2021-03-20'", ids: []int64{4}},
+		{where: "created > date_sub('2021-03-20', interval 1 month)", ids: []int64{4}},
+		{where: "length(val) = 6", ids: []int64{2, 4}},
+		{where: "ifnull(price, 0) < 1", ids: []int64{2, 3}},
+		{where: "case when id < 3 then 1 else 0 end", ids: []int64{1, 2}},
+		{where: "id = 1 and in_keyrange('-80')", ids: []int64{1}},
+		{where: "id > 1 and in_keyrange(id, 'hash', '-80')", ids: []int64{2, 3}},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.where, func(t *testing.T) {
+			plan, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id from t1 where " + tcase.where}},
+			})
+			require.NoError(t, err)
+			var ids []int64
+			result := make([]sqltypes.Value, len(plan.ColExprs))
+			for _, row := range rows {
+				ok, err := plan.filter(row, result)
+				require.NoError(t, err)
+				if ok {
+					id, err := evalengine.ToInt64(result[0])
+					require.NoError(t, err)
+					ids = append(ids, id)
+				}
+			}
+			assert.Equal(t, tcase.ids, ids)
+		})
+	}
+}
+
+func TestPlanFilterSelect(t *testing.T) {
+	table := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64},
+			{Name: "val", Type: sqltypes.VarBinary},
+			{Name: "name", Type: sqltypes.VarChar},
+			{Name: "price", Type: sqltypes.Float64},
+			{Name: "created", Type: sqltypes.Datetime},
+			{Name: "day", Type: sqltypes.Date},
+			{Name: "u", Type: sqltypes.Uint64},
+			{Name: "amount", Type: sqltypes.Decimal},
+		},
+	}
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(7),
+		sqltypes.NewVarBinary("abc"),
+		sqltypes.NewVarChar("Héllo World"),
+		sqltypes.NewFloat64(2.5),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-31 13:05:09")),
+		sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-02-29")),
+		sqltypes.NewUint64(10),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("19.99")),
+	}
+	nullRow := []sqltypes.Value{sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL}
+
+	testcases := []struct {
+		expr    string
+		typ     querypb.Type
+		out     string
+		nullOut string
+	}{
+		{expr: "id + 1", typ: sqltypes.Int64, out: "INT64(8)", nullOut: "NULL"},
+		{expr: "-id", typ: sqltypes.Int64, out: "INT64(-7)", nullOut: "NULL"},
+		{expr: "u * 2", typ: sqltypes.Uint64, out: "UINT64(20)", nullOut: "NULL"},
+		{expr: "id / 2", typ: sqltypes.Float64, out: "FLOAT64(3.5)", nullOut: "NULL"},
+		{expr: "price - id", typ: sqltypes.Float64, out: "FLOAT64(-4.5)", nullOut: "NULL"},
+		{expr: "amount * 3", typ: sqltypes.Decimal, out: "DECIMAL(59.97)", nullOut: "NULL"},
+		{expr: "amount + 0.01", typ: sqltypes.Decimal, out: "DECIMAL(20.00)", nullOut: "NULL"},
+		{expr: "amount / 2", typ: sqltypes.Decimal, out: "DECIMAL(9.995000)", nullOut: "NULL"},
+		{expr: "id > 5", typ: sqltypes.Int64, out: "INT64(1)", nullOut: "NULL"},
+		{expr: "id is null", typ: sqltypes.Int64, out: "INT64(0)", nullOut: "INT64(1)"},
+		{expr: "concat(val, '-', id)", typ: sqltypes.VarBinary, out: `VARBINARY("abc-7")`, nullOut: "NULL"},
+		{expr: "concat(name, '!')", typ: sqltypes.VarChar, out: `VARCHAR("Héllo World!")`, nullOut: "NULL"},
+		{expr: "concat_ws(',', val, null, id)", typ: sqltypes.VarBinary, out: `VARBINARY("abc,7")`, nullOut: `VARBINARY("")`},
+		{expr: "upper(val)", typ: sqltypes.VarBinary, out: `VARBINARY("ABC")`, nullOut: "NULL"},
+		{expr: "lcase(name)", typ: sqltypes.VarChar, out: `VARCHAR("héllo world")`, nullOut: "NULL"},
+		{expr: "length(name)", typ: sqltypes.Int64, out: "INT64(12)", nullOut: "NULL"},
+		{expr: "char_length(name)", typ: sqltypes.Int64, out: "INT64(11)", nullOut: "NULL"},
+		{expr: "trim(concat('  ', val, ' '))", typ: sqltypes.VarBinary, out: `VARBINARY("abc")`, nullOut: "NULL"},
+		{expr: "left(name, 2)", typ: sqltypes.VarChar, out: `VARCHAR("Hé")`, nullOut: "NULL"},
+		{expr: "right(name, 5)", typ: sqltypes.VarChar, out: `VARCHAR("World")`, nullOut: "NULL"},
+		{expr: "substring(name, 7)", typ: sqltypes.VarChar, out: `VARCHAR("World")`, nullOut: "NULL"},
+		{expr: "substr(name, -5, 3)", typ: sqltypes.VarChar, out: `VARCHAR("Wor")`, nullOut: "NULL"},
+		{expr: "substring(val from 2 for 1)", typ: sqltypes.VarBinary, out: `VARBINARY("b")`, nullOut: "NULL"},
+		{expr: "replace(val, 'b', 'xx')", typ: sqltypes.VarBinary, out: `VARBINARY("axxc")`, nullOut: "NULL"},
+		{expr: "ifnull(price, 0)", typ: sqltypes.Float64, out: "FLOAT64(2.5)", nullOut: "FLOAT64(0)"},
+		{expr: "coalesce(val, 'none')", typ: sqltypes.VarBinary, out: `VARBINARY("abc")`, nullOut: `VARBINARY("none")`},
+		{expr: "if(id > 5, 'big', 'small')", typ: sqltypes.VarBinary, out: `VARBINARY("big")`, nullOut: `VARBINARY("small")`},
+		{expr: "nullif(id, 7)", typ: sqltypes.Int64, out: "NULL", nullOut: "NULL"},
+		{expr: "case id when 7 then 'seven' when 8 then 'eight' end", typ: sqltypes.VarBinary, out: `VARBINARY("seven")`, nullOut: "NULL"},
+		{expr: "case when price > 2 then price else id end", typ: sqltypes.Float64, out: "FLOAT64(2.5)", nullOut: "NULL"},
+		{expr: "cast(price as signed)", typ: sqltypes.Int64, out: "INT64(3)", nullOut: "NULL"},
+		{expr: "cast(id as char)", typ: sqltypes.VarChar, out: `VARCHAR("7")`, nullOut: "NULL"},
+		{expr: "cast(val as binary(5))", typ: sqltypes.VarBinary, out: `VARBINARY("abc\x00\x00")`, nullOut: "NULL"},
+		{expr: "convert(name, char(5))", typ: sqltypes.VarChar, out: `VARCHAR("Héllo")`, nullOut: "NULL"},
+		{expr: "cast(price as decimal(10, 2))", typ: sqltypes.Decimal, out: "DECIMAL(2.50)", nullOut: "NULL"},
+		{expr: "cast(created as date)", typ: sqltypes.Date, out: `DATE("2021-01-31")`, nullOut: "NULL"},
+		{expr: "cast(day as datetime)", typ: sqltypes.Datetime, out: `DATETIME("2020-02-29 00:00:00")`, nullOut: "NULL"},
+		{expr: "cast(created as time)", typ: sqltypes.Time, out: `TIME("13:05:09")`, nullOut: "NULL"},
+		{expr: "year(created)", typ: sqltypes.Int64, out: "INT64(2021)", nullOut: "NULL"},
+		{expr: "dayofmonth(day)", typ: sqltypes.Int64, out: "INT64(29)", nullOut: "NULL"},
+		{expr: "hour(created)", typ: sqltypes.Int64, out: "INT64(13)", nullOut: "NULL"},
+		{expr: "date(created)", typ: sqltypes.Date, out: `DATE("2021-01-31")`, nullOut: "NULL"},
+		{expr: "date_format(created, '%Y/%m/%d %H:%i:%s %W %b %p')", typ: sqltypes.VarChar, out: `VARCHAR("2021/01/31 13:05:09 Sunday Jan PM")`, nullOut: "NULL"},
+		{expr: "datediff(created, day)", typ: sqltypes.Int64, out: "INT64(337)", nullOut: "NULL"},
+		{expr: "date_add(created, interval 1 month)", typ: sqltypes.Datetime, out: `DATETIME("2021-02-28 13:05:09")`, nullOut: "NULL"},
+		{expr: "day + interval 1 year", typ: sqltypes.Date, out: `DATE("2021-02-28")`, nullOut: "NULL"},
+		{expr: "date_sub(day, interval 1 day)", typ: sqltypes.Date, out: `DATE("2020-02-28")`, nullOut: "NULL"},
+		{expr: "created - interval 10 second", typ: sqltypes.Datetime, out: `DATETIME("2021-01-31 13:04:59")`, nullOut: "NULL"},
+		{expr: "adddate(day, interval 2 hour)", typ: sqltypes.Datetime, out: `DATETIME("2020-02-29 02:00:00")`, nullOut: "NULL"},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			plan, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: fmt.Sprintf("select %s as out from t1", tcase.expr)}},
+			})
+			require.NoError(t, err)
+			require.Len(t, plan.ColExprs, 1)
+			assert.Equal(t, "out", plan.ColExprs[0].Field.Name)
+			assert.Equal(t, tcase.typ, plan.ColExprs[0].Field.Type)
+
+			result := make([]sqltypes.Value, 1)
+			ok, err := plan.filter(row, result)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tcase.out, result[0].String())
+
+			ok, err = plan.filter(nullRow, result)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tcase.nullOut, result[0].String())
+		})
+	}
+}
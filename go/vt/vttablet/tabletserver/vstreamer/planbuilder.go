@@ -19,7 +19,6 @@ package vstreamer
 import (
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -61,6 +60,9 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// Expression is used for any other predicate, which is evaluated
+	// against the row
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -77,6 +79,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the predicate for Expression. The row is filtered
+	// out if it's false or NULL.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -94,6 +100,10 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is evaluated to generate the value. If so,
+	// ColNum is ignored.
+	Expr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -137,9 +147,25 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case Expression:
+			val, err := evalExpr(filter.Expr, values)
+			if err != nil {
+				return false, err
+			}
+			if !evalengine.IsTrue(val) {
+				return false, nil
+			}
 		}
 	}
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			val, err := evalExpr(colExpr.Expr, values)
+			if err != nil {
+				return false, err
+			}
+			result[i] = val
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -248,6 +274,8 @@ func tableMatches(table sqlparser.TableName, dbname string, filter *binlogdatapb
 
 func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*Plan, error) {
 	for _, rule := range filter.Rules {
+		var plan *Plan
+		var err error
 		switch {
 		case strings.HasPrefix(rule.Match, "/"):
 			expr := strings.Trim(rule.Match, "/")
@@ -258,14 +286,55 @@ func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*
 			if !result {
 				continue
 			}
-			return buildREPlan(ti, vschema, rule.Filter)
+			plan, err = buildREPlan(ti, vschema, rule.Filter)
+			if err != nil {
+				return nil, err
+			}
 		case rule.Match == ti.Name:
-			return buildTablePlan(ti, vschema, rule.Filter)
+			plan, err = buildTablePlan(ti, vschema, rule.Filter)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
 		}
+		if err := plan.excludeColumns(rule.ExcludeColumns); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	}
 	return nil, nil
 }
 
+// excludeColumns prunes the excluded columns of the table from the
+// column expressions. Computed expressions are kept.
+func (plan *Plan) excludeColumns(excluded []string) error {
+	if len(excluded) == 0 {
+		return nil
+	}
+	colExprs := make([]ColExpr, 0, len(plan.ColExprs))
+	for _, cExpr := range plan.ColExprs {
+		if cExpr.Vindex == nil && cExpr.ColNum >= 0 && isExcluded(plan.Table.Fields[cExpr.ColNum].Name, excluded) {
+			continue
+		}
+		colExprs = append(colExprs, cExpr)
+	}
+	if len(colExprs) == 0 {
+		return fmt.Errorf("all the columns of table %s are excluded", plan.Table.Name)
+	}
+	plan.ColExprs = colExprs
+	return nil
+}
+
+func isExcluded(column string, excluded []string) bool {
+	for _, name := range excluded {
+		if strings.EqualFold(column, name) {
+			return true
+		}
+	}
+	return false
+}
+
 // buildREPlan handles cases where Match has a regular expression.
 // If so, the Filter can be an empty string or a keyrange, like "-80".
 func buildREPlan(ti *Table, vschema *localVSchema, filter string) (*Plan, error) {
@@ -372,38 +441,15 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
-			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
+			if filter, ok, err := plan.analyzeEqual(expr); err != nil {
 				return err
+			} else if ok {
+				plan.Filters = append(plan.Filters, filter)
+				continue
 			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
+			if err := plan.addExpressionFilter(expr); err != nil {
 				return err
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
 				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
@@ -412,12 +458,65 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 				return err
 			}
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if err := plan.addExpressionFilter(expr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// analyzeEqual returns an Equal filter if the expression compares
+// a column with an integer or a string literal.
+func (plan *Plan) analyzeEqual(expr *sqlparser.ComparisonExpr) (Filter, bool, error) {
+	if expr.Operator != sqlparser.EqualOp {
+		return Filter{}, false, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return Filter{}, false, nil
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if !ok || (val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal) {
+		return Filter{}, false, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: Equal,
+		ColNum: colnum,
+		Value:  resolved,
+	}, true, nil
+}
+
+// addExpressionFilter adds a filter that evaluates the predicate against the row.
+func (plan *Plan) addExpressionFilter(expr sqlparser.Expr) error {
+	compiled, err := plan.compileExpr(expr)
+	if err != nil {
+		return err
+	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: Expression,
+		ColNum: -1,
+		Expr:   compiled,
+	})
+	return nil
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -479,7 +578,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			return plan.analyzeScalarExpr(aliased)
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -500,32 +599,53 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Vindex:        cv.Vindex,
 			VindexColumns: vindexColumns,
 		}, nil
-	case *sqlparser.Literal:
-		//allow only intval 1
-		if inner.Type != sqlparser.IntVal {
-			return ColExpr{}, fmt.Errorf("only integer literals are supported")
-		}
-		num, err := strconv.ParseInt(string(inner.Val), 0, 64)
-		if err != nil {
-			return ColExpr{}, err
-		}
-		if num != 1 {
-			return ColExpr{}, fmt.Errorf("only the integer literal 1 is supported")
-		}
-		return ColExpr{
-			Field: &querypb.Field{
-				Name: "1",
-				Type: querypb.Type_INT64,
-			},
-			ColNum:     -1,
-			FixedValue: sqltypes.NewInt64(num),
-		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		return plan.analyzeScalarExpr(aliased)
 	}
 }
 
+// analyzeScalarExpr compiles an expression to be evaluated against the row.
+// If the expression doesn't reference any column, its value is computed once.
+func (plan *Plan) analyzeScalarExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	compiled, err := plan.compileExpr(aliased.Expr)
+	if err != nil {
+		log.Infof("Unsupported expression: %v", aliased.Expr)
+		return ColExpr{}, err
+	}
+	as := aliased.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+	}
+	cExpr := ColExpr{
+		Field: &querypb.Field{
+			Name: as.String(),
+			Type: exprType(compiled),
+		},
+		ColNum: -1,
+	}
+	if referencesColumns(aliased.Expr) {
+		cExpr.Expr = compiled
+		return cExpr, nil
+	}
+	if cExpr.FixedValue, err = evalExpr(compiled, nil); err != nil {
+		return ColExpr{}, err
+	}
+	return cExpr, nil
+}
+
+// referencesColumns returns true if the expression references columns of the table.
+func referencesColumns(expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, ok := node.(*sqlparser.ColName); ok {
+			found = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -398,6 +399,41 @@ func TestPlanBuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, 1+1 as two, concat('a', 'b') from t1 where id > 1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}, {
+				ColNum: -1,
+				Field: &querypb.Field{
+					Name: "two",
+					Type: sqltypes.Int64,
+				},
+				FixedValue: sqltypes.NewInt64(2),
+			}, {
+				ColNum: -1,
+				Field: &querypb.Field{
+					Name: "concat('a', 'b')",
+					Type: sqltypes.VarBinary,
+				},
+				FixedValue: sqltypes.NewVarBinary("ab"),
+			}},
+			Filters: []Filter{{
+				Opcode: Expression,
+				ColNum: -1,
+				Expr: &evalengine.ComparisonExpr{
+					Op:    evalengine.GreaterThanOp,
+					Left:  evalengine.NewTypedColumn(0, t1.Fields[0].Type),
+					Right: evalengine.NewLiteralInt(1),
+				},
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -537,8 +573,32 @@ func TestPlanBuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id<<1, val from t1"},
+		outErr:  `unsupported: id << 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val regexp 'a.*'"},
+		outErr:  `unsupported: val regexp 'a.*'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (select id from t2)"},
+		outErr:  `unsupported: id in (select id from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id > 1 or max(id) = 1"},
+		outErr:  `unsupported function: max(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where convert(val, char) = 'a'"},
+		outErr:  `unsupported: comparison of non-binary strings: convert(val, char) = 'a'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, substr(val, 1, 2, 3) from t1"},
+		outErr:  `incorrect parameter count in the call to substr: substr(val, 1, 2, 3)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, date_add(val, interval 1 fortnight) from t1"},
+		outErr:  `unsupported interval unit: date_add(val, interval 1 fortnight)`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -552,6 +612,34 @@ func TestPlanBuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id, 1+1, '-80')"},
 		outErr:  `unsupported: 1 + 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "/.*/", ExcludeColumns: []string{"VAL"}},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1", ExcludeColumns: []string{"id"}},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "/.*/", ExcludeColumns: []string{"id", "val"}},
+		outErr:  `all the columns of table t1 are excluded`,
 	}}
 	for _, tcase := range testcases {
 		t.Run(tcase.inRule.String(), func(t *testing.T) {
@@ -166,13 +166,13 @@ func TestStreamRowsScan(t *testing.T) {
 	wantQuery = "select id1, id2, id3, val from t4 where (id1 = 1 and id2 = 2 and id3 > 3) or (id1 = 1 and id2 > 2) or (id1 > 1) order by id1, id2, id3"
 	checkStream(t, "select * from t4", []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)}, wantQuery, wantStream)
 
-	// t1: test for unsupported integer literal
-	wantError := "only the integer literal 1 is supported"
-	expectStreamError(t, "select 2 from t1", wantError)
+	// t1: test for unsupported operator
+	wantError := "unsupported: id << 1"
+	expectStreamError(t, "select id << 1 from t1", wantError)
 
-	// t1: test for unsupported literal type
-	wantError = "only integer literals are supported"
-	expectStreamError(t, "select 'a' from t1", wantError)
+	// t1: test for unsupported function
+	wantError = "unsupported function: sha1(val)"
+	expectStreamError(t, "select sha1(val) from t1", wantError)
 }
 
 func TestStreamRowsUnicode(t *testing.T) {
@@ -326,6 +326,29 @@ func TestStreamRowsFilterVarBinary(t *testing.T) {
 	checkStream(t, "select id1, val from t1 where val = 'newton'", nil, wantQuery, wantStream)
 }
 
+func TestStreamRowsFilterExpressions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), primary key(id1))",
+		"insert into t1 values (1, 100, 'aaa'), (2, 200, 'bbb'), (3, 200, 'ccc'), (4, 100, 'ddd'), (5, 200, 'eee')",
+	})
+
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	wantStream := []string{
+		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"v" type:VARBINARY > pkfields:<name:"id1" type:INT32 > `,
+		`rows:<lengths:1 lengths:4 values:"2bbb!" > rows:<lengths:1 lengths:4 values:"5eee!" > lastpk:<lengths:1 values:"5" > `,
+	}
+	wantQuery := "select id1, id2, val from t1 order by id1"
+	checkStream(t, "select id1, concat(val, '!') as v from t1 where id2 between 150 and 250 and val in ('bbb', 'eee')", nil, wantQuery, wantStream)
+}
+
 func TestStreamRowsMultiPacket(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
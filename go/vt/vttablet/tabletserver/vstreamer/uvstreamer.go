@@ -193,9 +193,13 @@ func matchTable(tableName string, filter *binlogdatapb.Filter, tables map[string
 			found = true
 		}
 		if found {
+			query, err := excludeQueryColumns(getQuery(tableName, rule.Filter), tables[tableName], rule.ExcludeColumns)
+			if err != nil {
+				return nil, err
+			}
 			return &binlogdatapb.Rule{
 				Match:  tableName,
-				Filter: getQuery(tableName, rule.Filter),
+				Filter: query,
 			}, nil
 		}
 	}
@@ -219,6 +223,43 @@ func getQuery(tableName string, filter string) string {
 	return query
 }
 
+// excludeQueryColumns removes the excluded columns from the select
+// expressions of a copy query. A '*' is expanded to the remaining
+// columns of the table.
+func excludeQueryColumns(query string, table *schema.Table, excluded []string) (string, error) {
+	if len(excluded) == 0 {
+		return query, nil
+	}
+	sel, _, err := analyzeSelect(query)
+	if err != nil {
+		return "", err
+	}
+	var selExprs sqlparser.SelectExprs
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			for _, field := range table.Fields {
+				if isExcluded(field.Name, excluded) {
+					continue
+				}
+				selExprs = append(selExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(field.Name)}})
+			}
+		case *sqlparser.AliasedExpr:
+			if colName, ok := selExpr.Expr.(*sqlparser.ColName); ok && isExcluded(colName.Name.String(), excluded) {
+				continue
+			}
+			selExprs = append(selExprs, selExpr)
+		default:
+			selExprs = append(selExprs, selExpr)
+		}
+	}
+	if len(selExprs) == 0 {
+		return "", fmt.Errorf("all the columns of table %s are excluded", table.Name.String())
+	}
+	sel.SelectExprs = selExprs
+	return sqlparser.String(sel), nil
+}
+
 func (uvs *uvstreamer) Cancel() {
 	log.Infof("uvstreamer context is being cancelled")
 	uvs.cancel()
@@ -56,10 +56,13 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/proto/query"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 )
 
 const (
@@ -165,6 +168,51 @@ func TestVStreamCopyFilterValidations(t *testing.T) {
 	}
 }
 
+func TestExcludeQueryColumns(t *testing.T) {
+	table := &schema.Table{
+		Name: sqlparser.NewTableIdent("t1"),
+		Fields: []*query.Field{
+			{Name: "id", Type: query.Type_INT64},
+			{Name: "val", Type: query.Type_VARCHAR},
+			{Name: "data", Type: query.Type_BLOB},
+		},
+	}
+	testcases := []struct {
+		query    string
+		excluded []string
+		want     string
+		wantErr  string
+	}{{
+		query: "select * from t1",
+		want:  "select * from t1",
+	}, {
+		query:    "select * from t1",
+		excluded: []string{"DATA"},
+		want:     "select id, val from t1",
+	}, {
+		query:    "select * from t1 where in_keyrange('-80')",
+		excluded: []string{"data"},
+		want:     "select id, val from t1 where in_keyrange('-80')",
+	}, {
+		query:    "select id, data, upper(val) as uval from t1",
+		excluded: []string{"data", "val"},
+		want:     "select id, upper(val) as uval from t1",
+	}, {
+		query:    "select data from t1",
+		excluded: []string{"data"},
+		wantErr:  "all the columns of table t1 are excluded",
+	}}
+	for _, tcase := range testcases {
+		got, err := excludeQueryColumns(tcase.query, table, tcase.excluded)
+		if tcase.wantErr != "" {
+			assert.EqualError(t, err, tcase.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got)
+	}
+}
+
 func TestVStreamCopyCompleteFlow(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
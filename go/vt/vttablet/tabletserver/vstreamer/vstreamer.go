@@ -326,9 +326,10 @@ func (vs *vstreamer) parseEvents(ctx context.Context, events <-chan mysql.Binlog
 		case <-timer.C:
 			now := time.Now().UnixNano()
 			if err := bufferAndTransmit(&binlogdatapb.VEvent{
-				Type:        binlogdatapb.VEventType_HEARTBEAT,
-				Timestamp:   now / 1e9,
-				CurrentTime: now,
+				Type:                  binlogdatapb.VEventType_HEARTBEAT,
+				Timestamp:             now / 1e9,
+				CurrentTime:           now,
+				ReplicationLagSeconds: vs.vse.replicationLagSeconds(),
 			}); err != nil {
 				if err == io.EOF {
 					return nil
@@ -29,9 +29,11 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -80,6 +82,12 @@ type Controller struct {
 	// TS is the return value for TopoServer.
 	TS *topo.Server
 
+	// HotRowsResult is the return value for HotRows.
+	HotRowsResult []txserializer.HotRow
+
+	// GCTablesResult is the return value for GCTables.
+	GCTablesResult []*gc.GCTable
+
 	// mu protects the next fields in this structure. They are
 	// accessed by both the methods in this interface, and the
 	// background health check.
@@ -225,6 +233,21 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// HotRows is part of the tabletserver.Controller interface.
+func (tqsc *Controller) HotRows() []txserializer.HotRow {
+	return tqsc.HotRowsResult
+}
+
+// GCTables is part of the tabletserver.Controller interface.
+func (tqsc *Controller) GCTables(ctx context.Context) ([]*gc.GCTable, error) {
+	return tqsc.GCTablesResult, nil
+}
+
+// CancelGCTableDrop is part of the tabletserver.Controller interface.
+func (tqsc *Controller) CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error) {
+	return originalName, nil
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
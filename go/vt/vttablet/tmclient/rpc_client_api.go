@@ -52,6 +52,10 @@ type TabletManagerClient interface {
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
+	// GetHotRows asks the remote tablet for the row (ranges) tracked by
+	// its automatic hot row detection
+	GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error)
+
 	//
 	// Various read-write methods
 	//
@@ -147,6 +151,21 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	// VDiff creates, resumes, stops or shows a tablet-side vdiff of a workflow
+	VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+
+	//
+	// Table GC related functions
+	//
+
+	// GetGCTables asks the remote tablet for the tables going through
+	// the table garbage collection lifecycle
+	GetGCTables(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.GCTable, error)
+
+	// CancelGCTableDrop asks the remote tablet to restore a table in HOLD
+	// state to its original name. It returns the name the table was restored to.
+	CancelGCTableDrop(ctx context.Context, tablet *topodatapb.Tablet, tableName, originalName string) (string, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -335,6 +335,32 @@ func tmRPCTestGetPermissionsPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "GetPermissions", false /*verbose*/, err)
 }
 
+var testGetHotRowsReply = []*tabletmanagerdatapb.HotRow{
+	{
+		Key:            "t1 where id = 1",
+		Table:          "t1",
+		Contentions:    3,
+		ProtectedUntil: 1234,
+	},
+}
+
+func (fra *fakeRPCTM) GetHotRows(ctx context.Context) ([]*tabletmanagerdatapb.HotRow, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetHotRowsReply, nil
+}
+
+func tmRPCTestGetHotRows(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetHotRows(ctx, tablet)
+	compareError(t, "GetHotRows", err, result, testGetHotRowsReply)
+}
+
+func tmRPCTestGetHotRowsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetHotRows(ctx, tablet)
+	expectHandleRPCPanic(t, "GetHotRows", false /*verbose*/, err)
+}
+
 //
 // Various read-write methods
 //
@@ -910,6 +936,100 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var (
+	testVDiffRequest = &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  "ks",
+		Workflow:  "wf",
+		Action:    "show",
+		VdiffUuid: "last",
+	}
+	testVDiffResponse = &tabletmanagerdatapb.VDiffResponse{
+		VdiffUuid: "1234",
+		State:     "completed",
+		Tables: []*tabletmanagerdatapb.VDiffTableReport{{
+			TableName:     "t1",
+			State:         "completed",
+			ProcessedRows: 10,
+			MatchingRows:  10,
+		}},
+	}
+)
+
+func (fra *fakeRPCTM) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VDiff request", req, testVDiffRequest)
+	return testVDiffResponse, nil
+}
+
+func tmRPCTestVDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	compareError(t, "VDiff", err, response, testVDiffResponse)
+}
+
+func tmRPCTestVDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	expectHandleRPCPanic(t, "VDiff", true /*verbose*/, err)
+}
+
+//
+// Table GC related functions
+//
+
+var testGetGCTablesReply = []*tabletmanagerdatapb.GCTable{
+	{
+		Name:        "_vt_PURGE_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+		State:       "PURGE",
+		Uuid:        "6ace8bcef73211ea87e9f875a4d24e90",
+		Time:        1600171450,
+		TableRows:   1000,
+		DataLength:  65536,
+		IndexLength: 16384,
+	},
+}
+
+func (fra *fakeRPCTM) GetGCTables(ctx context.Context) ([]*tabletmanagerdatapb.GCTable, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetGCTablesReply, nil
+}
+
+func tmRPCTestGetGCTables(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetGCTables(ctx, tablet)
+	compareError(t, "GetGCTables", err, result, testGetGCTablesReply)
+}
+
+func tmRPCTestGetGCTablesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetGCTables(ctx, tablet)
+	expectHandleRPCPanic(t, "GetGCTables", false /*verbose*/, err)
+}
+
+var (
+	testCancelGCTableDropTableName    = "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"
+	testCancelGCTableDropOriginalName = "t1"
+)
+
+func (fra *fakeRPCTM) CancelGCTableDrop(ctx context.Context, tableName, originalName string) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CancelGCTableDrop tableName", tableName, testCancelGCTableDropTableName)
+	compare(fra.t, "CancelGCTableDrop originalName", originalName, testCancelGCTableDropOriginalName)
+	return originalName, nil
+}
+
+func tmRPCTestCancelGCTableDrop(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	restoredName, err := client.CancelGCTableDrop(ctx, tablet, testCancelGCTableDropTableName, testCancelGCTableDropOriginalName)
+	compareError(t, "CancelGCTableDrop", err, restoredName, testCancelGCTableDropOriginalName)
+}
+
+func tmRPCTestCancelGCTableDropPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CancelGCTableDrop(ctx, tablet, testCancelGCTableDropTableName, testCancelGCTableDropOriginalName)
+	expectHandleRPCPanic(t, "CancelGCTableDrop", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1256,6 +1376,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
+	tmRPCTestGetHotRows(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnly(ctx, t, client, tablet)
@@ -1284,6 +1405,11 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiff(ctx, t, client, tablet)
+
+	// Table GC related functions
+	tmRPCTestGetGCTables(ctx, t, client, tablet)
+	tmRPCTestCancelGCTableDrop(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1312,6 +1438,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
+	tmRPCTestGetHotRowsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
@@ -1336,6 +1463,11 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffPanic(ctx, t, client, tablet)
+
+	// Table GC related functions
+	tmRPCTestGetGCTablesPanic(ctx, t, client, tablet)
+	tmRPCTestCancelGCTableDropPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
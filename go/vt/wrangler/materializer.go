@@ -42,11 +42,13 @@ import (
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication/sink"
 )
 
 type materializer struct {
@@ -65,7 +67,10 @@ const (
 // MoveTables initiates moving table(s) over to another keyspace
 func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs,
 	cell, tabletTypes string, allTables bool, excludeTables string, autoStart, stopAfterCopy bool,
-	externalCluster string) error {
+	externalCluster string, onDDL binlogdatapb.OnDDLAction, onDDLStrategy string) error {
+	if err := validateOnDDL(onDDL, onDDLStrategy); err != nil {
+		return err
+	}
 	//FIXME validate tableSpecs, allTables, excludeTables
 	var tables []string
 	var externalTopo *topo.Server
@@ -195,6 +200,8 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		TabletTypes:     tabletTypes,
 		StopAfterCopy:   stopAfterCopy,
 		ExternalCluster: externalCluster,
+		OnDdl:           onDDL,
+		OnDdlStrategy:   onDDLStrategy,
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -794,12 +801,21 @@ func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldat
 	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
 		return nil, err
 	}
+	if err := validateOnDDL(ms.OnDdl, ms.OnDdlStrategy); err != nil {
+		return nil, err
+	}
+	if err := validateExternalSink(ms); err != nil {
+		return nil, err
+	}
 	mz, err := wr.buildMaterializer(ctx, ms)
 	if err != nil {
 		return nil, err
 	}
-	if err := mz.deploySchema(ctx); err != nil {
-		return nil, err
+	// The tables of the target are not used if the workflow writes to an external sink.
+	if ms.ExternalSink == nil {
+		if err := mz.deploySchema(ctx); err != nil {
+			return nil, err
+		}
 	}
 	inserts, err := mz.generateInserts(ctx)
 	if err != nil {
@@ -820,6 +836,30 @@ func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.Materialize
 	return mz.startStreams(ctx)
 }
 
+// validateOnDDL validates the DDL handling of a new workflow. A ddl strategy
+// can only be used by the streams that coordinate their DDLs.
+func validateOnDDL(onDDL binlogdatapb.OnDDLAction, onDDLStrategy string) error {
+	if onDDLStrategy == "" {
+		return nil
+	}
+	if onDDL != binlogdatapb.OnDDLAction_EXEC_COORDINATED {
+		return fmt.Errorf("a ddl strategy can only be specified with on_ddl %s", binlogdatapb.OnDDLAction_EXEC_COORDINATED)
+	}
+	_, err := schema.ParseDDLStrategy(onDDLStrategy)
+	return err
+}
+
+// validateExternalSink validates the external sink of a new workflow.
+func validateExternalSink(ms *vtctldatapb.MaterializeSettings) error {
+	if ms.ExternalSink == nil {
+		return nil
+	}
+	if ms.StopAfterCopy {
+		return fmt.Errorf("stop_after_copy cannot be used with an external sink: the streams have no copy phase")
+	}
+	return sink.Validate(ms.ExternalSink)
+}
+
 func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.MaterializeSettings) (*materializer, error) {
 	vschema, err := wr.ts.GetVSchema(ctx, ms.TargetKeyspace)
 	if err != nil {
@@ -829,7 +869,7 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 	if err != nil {
 		return nil, err
 	}
-	if targetVSchema.Keyspace.Sharded {
+	if targetVSchema.Keyspace.Sharded && ms.ExternalSink == nil {
 		for _, ts := range ms.TableSettings {
 			if targetVSchema.Tables[ts.TargetTable] == nil {
 				return nil, fmt.Errorf("table %s not found in vschema for keyspace %s", ts.TargetTable, ms.TargetKeyspace)
@@ -845,6 +885,11 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 	if err != nil {
 		return nil, err
 	}
+	if ms.ExternalSink != nil && len(targetShards) > 1 {
+		// The streams that write to an external sink don't depend on
+		// the key ranges of the target: they all run on the first shard.
+		targetShards = targetShards[:1]
+	}
 	return &materializer{
 		wr:            wr,
 		ms:            ms,
@@ -1006,6 +1051,9 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 			Filter:          &binlogdatapb.Filter{},
 			StopAfterCopy:   mz.ms.StopAfterCopy,
 			ExternalCluster: mz.ms.ExternalCluster,
+			OnDdl:           mz.ms.OnDdl,
+			OnDdlStrategy:   mz.ms.OnDdlStrategy,
+			ExternalSink:    mz.ms.ExternalSink,
 		}
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
@@ -1028,7 +1076,7 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 			}
 
 			filter := ts.SourceExpression
-			if mz.targetVSchema.Keyspace.Sharded && mz.targetVSchema.Tables[ts.TargetTable].Type != vindexes.TypeReference {
+			if mz.targetVSchema.Keyspace.Sharded && mz.ms.ExternalSink == nil && mz.targetVSchema.Tables[ts.TargetTable].Type != vindexes.TypeReference {
 				cv, err := vindexes.FindBestColVindex(mz.targetVSchema.Tables[ts.TargetTable])
 				if err != nil {
 					return "", err
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/logutil"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -62,7 +63,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -103,11 +104,11 @@ func TestMissingTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt", "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt", "", "", false, "", true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 	require.EqualError(t, err, "table(s) not found in source keyspace sourceks: tyt")
-	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt,t2,txt", "", "", false, "", true, false, "")
+	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt,t2,txt", "", "", false, "", true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 	require.EqualError(t, err, "table(s) not found in source keyspace sourceks: tyt,txt")
-	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "")
+	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 	require.NoError(t, err)
 }
 
@@ -163,7 +164,7 @@ func TestMoveTablesAllAndExclude(t *testing.T) {
 			env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
 			env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
 			env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
-			err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "", "", "", tcase.allTables, tcase.excludeTables, true, false, "")
+			err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "", "", "", tcase.allTables, tcase.excludeTables, true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 			require.NoError(t, err)
 			require.EqualValues(t, tcase.want, targetTables(env))
 		})
@@ -197,7 +198,7 @@ func TestMoveTablesStopFlags(t *testing.T) {
 		env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
 		// -auto_start=false is tested by NOT expecting the update query which sets state to RUNNING
 		err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "",
-			"", false, "", false, true, "")
+			"", false, "", false, true, "", binlogdatapb.OnDDLAction_IGNORE, "")
 		require.NoError(t, err)
 		env.tmc.verifyQueries(t)
 	})
@@ -223,7 +224,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", false, "", true, false, "", binlogdatapb.OnDDLAction_IGNORE, "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -1552,6 +1553,112 @@ func TestExternalizeVindex(t *testing.T) {
 	}
 }
 
+func TestMaterializerOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+		OnDdl:         binlogdatapb.OnDDLAction_EXEC_COORDINATED,
+		OnDdlStrategy: "online",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\(`+
+			`'workflow', `+
+			(`'keyspace:\\"sourceks\\" shard:\\"0\\" `+
+				`filter:<`+
+				`rules:<match:\\"t1\\" filter:\\"select.*t1\\" > `+
+				`> on_ddl:EXEC_COORDINATED on_ddl_strategy:\\"online\\" ', `)+
+			`'', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks'`+
+			`\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestValidateOnDDL(t *testing.T) {
+	require.NoError(t, validateOnDDL(binlogdatapb.OnDDLAction_EXEC, ""))
+	require.NoError(t, validateOnDDL(binlogdatapb.OnDDLAction_EXEC_COORDINATED, ""))
+	require.NoError(t, validateOnDDL(binlogdatapb.OnDDLAction_EXEC_COORDINATED, "gh-ost -max-load=Threads_running=100"))
+	require.EqualError(t, validateOnDDL(binlogdatapb.OnDDLAction_EXEC, "online"), "a ddl strategy can only be specified with on_ddl EXEC_COORDINATED")
+	require.EqualError(t, validateOnDDL(binlogdatapb.OnDDLAction_EXEC_COORDINATED, "unknown"), "Unknown online DDL strategy: 'unknown'")
+}
+
+func TestMaterializerExternalSink(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+		ExternalSink: &binlogdatapb.ExternalSink{
+			Type:    "kafka",
+			Address: "broker:9092",
+			Topic:   "changes",
+		},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"-40", "40-"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+	}
+	if err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs); err != nil {
+		t.Fatal(err)
+	}
+
+	// The streams all run on the first target shard, and their filters are not
+	// restricted to its key range. The schema is not deployed to the target.
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`.*shard:\\"-40\\" filter:<rules:<match:\\"t1\\" filter:\\"select \* from t1\\" > > external_sink:<type:\\"kafka\\" address:\\"broker:9092\\" topic:\\"changes\\" >.*`+
+			`.*shard:\\"40-\\" filter:<rules:<match:\\"t1\\" filter:\\"select \* from t1\\" > > external_sink:<type:\\"kafka\\" address:\\"broker:9092\\" topic:\\"changes\\" >.*`,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestValidateExternalSink(t *testing.T) {
+	require.NoError(t, validateExternalSink(&vtctldatapb.MaterializeSettings{}))
+	ms := &vtctldatapb.MaterializeSettings{
+		ExternalSink: &binlogdatapb.ExternalSink{Type: "file", Address: "/tmp", Topic: "t"},
+	}
+	require.NoError(t, validateExternalSink(ms))
+	ms.StopAfterCopy = true
+	require.EqualError(t, validateExternalSink(ms), "stop_after_copy cannot be used with an external sink: the streams have no copy phase")
+	ms.StopAfterCopy = false
+	ms.ExternalSink.Type = "s3"
+	require.EqualError(t, validateExternalSink(ms), "unsupported external sink type: s3")
+}
+
 func TestMaterializerOneToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
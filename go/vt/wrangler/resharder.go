@@ -53,6 +53,8 @@ type resharder struct {
 	cell          string //single cell or cellsAlias or comma-separated list of cells/cellsAliases
 	tabletTypes   string
 	stopAfterCopy bool
+	onDDL         binlogdatapb.OnDDLAction
+	onDDLStrategy string
 }
 
 type refStream struct {
@@ -64,7 +66,11 @@ type refStream struct {
 
 // Reshard initiates a resharding workflow.
 func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sources, targets []string,
-	skipSchemaCopy bool, cell, tabletTypes string, autoStart, stopAfterCopy bool) error {
+	skipSchemaCopy bool, cell, tabletTypes string, autoStart, stopAfterCopy bool,
+	onDDL binlogdatapb.OnDDLAction, onDDLStrategy string) error {
+	if err := validateOnDDL(onDDL, onDDLStrategy); err != nil {
+		return err
+	}
 	if err := wr.validateNewWorkflow(ctx, keyspace, workflow); err != nil {
 		return err
 	}
@@ -79,6 +85,8 @@ func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sour
 		return vterrors.Wrap(err, "buildResharder")
 	}
 	rs.stopAfterCopy = stopAfterCopy
+	rs.onDDL = onDDL
+	rs.onDDLStrategy = onDDLStrategy
 	if !skipSchemaCopy {
 		if err := rs.copySchema(ctx); err != nil {
 			return vterrors.Wrap(err, "copySchema")
@@ -319,6 +327,8 @@ func (rs *resharder) createStreams(ctx context.Context) error {
 				Shard:         source.ShardName(),
 				Filter:        filter,
 				StopAfterCopy: rs.stopAfterCopy,
+				OnDdl:         rs.onDDL,
+				OnDdlStrategy: rs.onDDLStrategy,
 			}
 			ig.AddRow(rs.workflow, bls, "", rs.cell, rs.tabletTypes)
 		}
@@ -23,6 +23,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -73,3 +76,53 @@ func TestValidateSchemaShard(t *testing.T) {
 	shouldErr := tme.wr.ValidateSchemaShard(ctx, "ks", "80-", nil /*excludeTables*/, true /*includeViews*/, true /*includeVSchema*/)
 	require.Contains(t, shouldErr.Error(), "Vschema Validation Failed:")
 }
+
+func TestRefreshVSchemaViews(t *testing.T) {
+	ctx := context.Background()
+	sourceShards := []string{"-80", "80-"}
+	targetShards := []string{"-40", "40-80", "80-c0", "c0-"}
+
+	tme := newTestShardMigrater(ctx, t, sourceShards, targetShards)
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:    "t1",
+			Columns: []string{"c1"},
+			Type:    tmutils.TableBaseTable,
+		}, {
+			Name:    "v1",
+			Columns: []string{"c1"},
+			Type:    tmutils.TableView,
+			Schema:  "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW {{.DatabaseName}}.`v1` AS select {{.DatabaseName}}.`t1`.`c1` AS `c1` from {{.DatabaseName}}.`t1` where ({{.DatabaseName}}.`t1`.`c1` > 0)",
+		}},
+	}
+	for _, primary := range append(tme.sourceMasters, tme.targetMasters...) {
+		primary.FakeMysqlDaemon.Schema = schm
+	}
+
+	vs, err := tme.wr.RefreshVSchemaViews(ctx, "ks", true /*dryRun*/)
+	require.NoError(t, err)
+	require.Contains(t, vs.Tables, "v1")
+	require.Equal(t, vindexes.TypeView, vs.Tables["v1"].Type)
+	require.Equal(t, "select t1.c1 as c1 from t1 where t1.c1 > 0", vs.Tables["v1"].ViewDefinition)
+
+	saved, err := tme.ts.GetVSchema(ctx, "ks")
+	require.NoError(t, err)
+	require.NotContains(t, saved.Tables, "v1")
+
+	_, err = tme.wr.RefreshVSchemaViews(ctx, "ks", false /*dryRun*/)
+	require.NoError(t, err)
+	saved, err = tme.ts.GetVSchema(ctx, "ks")
+	require.NoError(t, err)
+	require.Contains(t, saved.Tables, "v1")
+	require.Contains(t, saved.Tables, "t1")
+
+	// v1 is dropped
+	schm.TableDefinitions = schm.TableDefinitions[:1]
+	_, err = tme.wr.RefreshVSchemaViews(ctx, "ks", false /*dryRun*/)
+	require.NoError(t, err)
+	saved, err = tme.ts.GetVSchema(ctx, "ks")
+	require.NoError(t, err)
+	require.NotContains(t, saved.Tables, "v1")
+	require.Contains(t, saved.Tables, "t1")
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// GetGCTables returns the tables going through the table garbage collection lifecycle
+// on the master of the given shard, along with their state and size.
+func (wr *Wrangler) GetGCTables(ctx context.Context, keyspace, shard string) ([]*tabletmanagerdatapb.GCTable, error) {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	return wr.tmc.GetGCTables(ctx, master.Tablet)
+}
+
+// CancelGCTableDrop cancels the drop of a table on the master of the given shard, by restoring
+// the table, which must be in HOLD state, to its original name. If originalName is empty, the name
+// is read from the online DDL migration which dropped the table. It returns the restored name.
+func (wr *Wrangler) CancelGCTableDrop(ctx context.Context, keyspace, shard, tableName, originalName string) (string, error) {
+	master, err := wr.shardMaster(ctx, keyspace, shard)
+	if err != nil {
+		return "", err
+	}
+	return wr.tmc.CancelGCTableDrop(ctx, master.Tablet, tableName, originalName)
+}
+
+// shardMaster returns the master tablet of the given shard
+func (wr *Wrangler) shardMaster(ctx context.Context, keyspace, shard string) (*topo.TabletInfo, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shard)
+	}
+	return wr.ts.GetTablet(ctx, si.MasterAlias)
+}
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -219,6 +220,16 @@ func (wr *Wrangler) GenericVExec(ctx context.Context, tabletAlias *topodatapb.Ta
 	return wr.tmc.VExec(ctx, ti.Tablet, query, workflow, keyspace)
 }
 
+// GetHotRows returns the row (ranges) tracked by the automatic hot row
+// detection of a remote tablet
+func (wr *Wrangler) GetHotRows(ctx context.Context, tabletAlias *topodatapb.TabletAlias) ([]*tabletmanagerdatapb.HotRow, error) {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return nil, err
+	}
+	return wr.tmc.GetHotRows(ctx, ti.Tablet)
+}
+
 // isMasterTablet is a shortcut way to determine whether the current tablet
 // is a master before we allow its tablet record to be deleted. The canonical
 // way to determine the only true master in a shard is to list all the tablets
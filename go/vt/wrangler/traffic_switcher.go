@@ -1143,11 +1143,12 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 		bls := target.Sources[uid]
 		source := ts.sources[bls.Shard]
 		reverseBls := &binlogdatapb.BinlogSource{
-			Keyspace:   ts.targetKeyspace,
-			Shard:      target.GetShard().ShardName(),
-			TabletType: bls.TabletType,
-			Filter:     &binlogdatapb.Filter{},
-			OnDdl:      bls.OnDdl,
+			Keyspace:      ts.targetKeyspace,
+			Shard:         target.GetShard().ShardName(),
+			TabletType:    bls.TabletType,
+			Filter:        &binlogdatapb.Filter{},
+			OnDdl:         bls.OnDdl,
+			OnDdlStrategy: bls.OnDdlStrategy,
 		}
 		for _, rule := range bls.Filter.Rules {
 			if rule.Filter == "exclude" {
@@ -1267,6 +1268,13 @@ func (ts *trafficSwitcher) createJournals(ctx context.Context, sourceWorkflows [
 		if _, err := ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, statement); err != nil {
 			return err
 		}
+		if ts.migrationType == binlogdatapb.MigrationType_SHARDS {
+			// The journal is also kept in the topo, so that a VStream can
+			// move from the shard to its targets after the shard is deleted.
+			if err := ts.wr.ts.SaveReshardingJournal(ctx, source.GetShard().Keyspace(), source.GetShard().ShardName(), journal); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
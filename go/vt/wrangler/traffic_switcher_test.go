@@ -765,6 +765,14 @@ func TestShardMigrateMainflow(t *testing.T) {
 	checkIsMasterServing(t, tme.ts, "ks:-80", true)
 	checkIsMasterServing(t, tme.ts, "ks:80-", true)
 
+	// The journals of the sources are kept in the topo.
+	for _, shard := range []string{"-40", "40-"} {
+		journal, err := tme.ts.GetReshardingJournal(ctx, "ks", shard)
+		require.NoError(t, err)
+		require.Equal(t, int64(6432976123657117097), journal.Id)
+		require.Equal(t, "MariaDB/5-456-892", journal.LocalPosition)
+	}
+
 	verifyQueries(t, tme.allDBClients)
 }
 
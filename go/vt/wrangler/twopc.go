@@ -0,0 +1,288 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ShardTransactions contains the unresolved distributed transactions of a
+// shard, as read from its master tablet.
+type ShardTransactions struct {
+	Keyspace    string
+	Shard       string
+	TabletAlias string
+	// Transactions are the distributed transactions for which the shard
+	// is the metadata manager.
+	Transactions []*querypb.TransactionMetadata
+	// Prepared are the transactions the shard has prepared as a participant.
+	Prepared []*querypb.PreparedTransaction
+	// Error is set if the shard could not be read.
+	Error string `json:",omitempty"`
+}
+
+// ParticipantState is the state of a distributed transaction on one of its participants.
+type ParticipantState struct {
+	Keyspace    string
+	Shard       string
+	TabletAlias string
+	// State is one of "Prepared", "Failed" or "Unprepared". Unprepared means
+	// the participant has no redo log for the transaction: it was either
+	// never prepared, or already committed or rolled back.
+	State   string
+	Queries []string `json:",omitempty"`
+	Error   string   `json:",omitempty"`
+}
+
+// DistributedTransaction describes a distributed transaction, its state on the
+// metadata manager, and the state of each of its participants.
+type DistributedTransaction struct {
+	Dtid         string
+	State        string
+	TimeCreated  time.Time
+	Participants []*ParticipantState
+}
+
+// ListDistributedTransactions returns the unresolved distributed transactions
+// of every shard of the keyspace that were created more than abandonAge ago.
+// Shards that cannot be read are reported with an error instead of failing
+// the whole listing.
+func (wr *Wrangler) ListDistributedTransactions(ctx context.Context, keyspace string, abandonAge time.Duration) ([]*ShardTransactions, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(shards)
+
+	results := make([]*ShardTransactions, len(shards))
+	wg := sync.WaitGroup{}
+	for i, shard := range shards {
+		wg.Add(1)
+		go func(i int, shard string) {
+			defer wg.Done()
+			st := &ShardTransactions{Keyspace: keyspace, Shard: shard}
+			results[i] = st
+			err := wr.withMasterConn(ctx, keyspace, shard, func(tablet *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+				st.TabletAlias = topoproto.TabletAliasString(tablet.Alias)
+				var err error
+				st.Transactions, st.Prepared, err = conn.UnresolvedTransactions(ctx, target, int64(abandonAge/time.Second))
+				return err
+			})
+			if err != nil {
+				st.Error = err.Error()
+			}
+		}(i, shard)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// ReadDistributedTransaction returns the state of a distributed transaction
+// as recorded by its metadata manager, along with the prepared state of each
+// participant. It returns nil if the transaction is already resolved.
+func (wr *Wrangler) ReadDistributedTransaction(ctx context.Context, dtid string) (*DistributedTransaction, error) {
+	transaction, err := wr.readTransactionMetadata(ctx, dtid)
+	if err != nil || transaction == nil {
+		return nil, err
+	}
+	dt := &DistributedTransaction{
+		Dtid:         transaction.Dtid,
+		State:        transaction.State.String(),
+		TimeCreated:  time.Unix(0, transaction.TimeCreated),
+		Participants: make([]*ParticipantState, len(transaction.Participants)),
+	}
+	wg := sync.WaitGroup{}
+	for i, participant := range transaction.Participants {
+		wg.Add(1)
+		go func(i int, participant *querypb.Target) {
+			defer wg.Done()
+			ps := &ParticipantState{Keyspace: participant.Keyspace, Shard: participant.Shard, State: "Unprepared"}
+			dt.Participants[i] = ps
+			err := wr.withMasterConn(ctx, participant.Keyspace, participant.Shard, func(tablet *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+				ps.TabletAlias = topoproto.TabletAliasString(tablet.Alias)
+				_, prepared, err := conn.UnresolvedTransactions(ctx, target, 0)
+				if err != nil {
+					return err
+				}
+				for _, ptx := range prepared {
+					if ptx.Dtid != dtid {
+						continue
+					}
+					ps.State = "Prepared"
+					if ptx.Failed {
+						ps.State = "Failed"
+					}
+					ps.Queries = ptx.Queries
+				}
+				return nil
+			})
+			if err != nil {
+				ps.State = "Unknown"
+				ps.Error = err.Error()
+			}
+		}(i, participant)
+	}
+	wg.Wait()
+	return dt, nil
+}
+
+// ConcludeDistributedTransaction resolves a distributed transaction the same
+// way vtgate would: a transaction still in the PREPARE state is rolled back,
+// otherwise the recorded decision is carried out on all participants before
+// the metadata is removed.
+func (wr *Wrangler) ConcludeDistributedTransaction(ctx context.Context, dtid string) error {
+	return wr.resolveDistributedTransaction(ctx, dtid, false /* rollbackOnly */)
+}
+
+// RollbackDistributedTransaction rolls back a distributed transaction on all
+// participants and removes its metadata. It fails if the commit decision was
+// already made, because some participants may have committed already.
+func (wr *Wrangler) RollbackDistributedTransaction(ctx context.Context, dtid string) error {
+	return wr.resolveDistributedTransaction(ctx, dtid, true /* rollbackOnly */)
+}
+
+func (wr *Wrangler) resolveDistributedTransaction(ctx context.Context, dtid string, rollbackOnly bool) error {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	transaction, err := wr.readTransactionMetadata(ctx, dtid)
+	if err != nil {
+		return err
+	}
+	if transaction == nil {
+		wr.Logger().Printf("Distributed transaction %v is already resolved\n", dtid)
+		return nil
+	}
+
+	mmKeyspace, mmShardName := mmShard.Target.Keyspace, mmShard.Target.Shard
+	var participantAction func(conn queryservice.QueryService, target *querypb.Target) error
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		// Same as vtgate: make the decision to rollback first, so that
+		// a concurrent resolution cannot decide otherwise.
+		err := wr.withMasterConn(ctx, mmKeyspace, mmShardName, func(_ *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+			return conn.SetRollback(ctx, target, dtid, mmShard.TransactionId)
+		})
+		if err != nil {
+			return vterrors.Wrapf(err, "SetRollback(%v) failed", dtid)
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		wr.Logger().Printf("Rolling back distributed transaction %v\n", dtid)
+		participantAction = func(conn queryservice.QueryService, target *querypb.Target) error {
+			return conn.RollbackPrepared(ctx, target, dtid, 0)
+		}
+	case querypb.TransactionState_COMMIT:
+		if rollbackOnly {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "distributed transaction %v cannot be rolled back: the commit decision was already made", dtid)
+		}
+		wr.Logger().Printf("Committing distributed transaction %v\n", dtid)
+		participantAction = func(conn queryservice.QueryService, target *querypb.Target) error {
+			return conn.CommitPrepared(ctx, target, dtid)
+		}
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state for distributed transaction %v: %v", dtid, transaction.State)
+	}
+
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, participant := range transaction.Participants {
+		wg.Add(1)
+		go func(participant *querypb.Target) {
+			defer wg.Done()
+			err := wr.withMasterConn(ctx, participant.Keyspace, participant.Shard, func(_ *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+				return participantAction(conn, target)
+			})
+			if err != nil {
+				rec.RecordError(fmt.Errorf("participant %v/%v: %v", participant.Keyspace, participant.Shard, err))
+			}
+		}(participant)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+
+	return wr.withMasterConn(ctx, mmKeyspace, mmShardName, func(_ *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+		return conn.ConcludeTransaction(ctx, target, dtid)
+	})
+}
+
+// readTransactionMetadata reads the metadata of a distributed transaction
+// from its metadata manager. It returns nil if the transaction is resolved.
+func (wr *Wrangler) readTransactionMetadata(ctx context.Context, dtid string) (*querypb.TransactionMetadata, error) {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return nil, err
+	}
+	var transaction *querypb.TransactionMetadata
+	err = wr.withMasterConn(ctx, mmShard.Target.Keyspace, mmShard.Target.Shard, func(_ *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error {
+		var err error
+		transaction, err = conn.ReadTransaction(ctx, target, dtid)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		return nil, nil
+	}
+	return transaction, nil
+}
+
+// withMasterConn calls f with a query service connection to the master tablet of the shard.
+func (wr *Wrangler) withMasterConn(ctx context.Context, keyspace, shard string, f func(tablet *topodatapb.Tablet, conn queryservice.QueryService, target *querypb.Target) error) error {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return err
+	}
+	conn, err := tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return fmt.Errorf("cannot connect to tablet %v: %v", topoproto.TabletAliasString(si.MasterAlias), err)
+	}
+	defer conn.Close(ctx)
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	return f(ti.Tablet, conn, target)
+}
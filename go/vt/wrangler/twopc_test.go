@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// twopcConns has to be a global for RegisterDialer to work.
+var twopcConns map[uint32]*sandboxconn.SandboxConn
+
+func init() {
+	tabletconn.RegisterDialer("TwopcTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		if sbc, ok := twopcConns[tablet.Alias.Uid]; ok {
+			return sbc, nil
+		}
+		return nil, fmt.Errorf("tablet %d not found", tablet.Alias.Uid)
+	})
+}
+
+// newTwopcTestEnv creates a keyspace with the given shards, and returns the
+// sandbox connections of their masters, by shard.
+func newTwopcTestEnv(t *testing.T, shards ...string) (*Wrangler, map[string]*sandboxconn.SandboxConn) {
+	t.Helper()
+	ctx := context.Background()
+	protocol := flag.Lookup("tablet_protocol").Value.String()
+	flag.Set("tablet_protocol", "TwopcTest")
+	t.Cleanup(func() { flag.Set("tablet_protocol", protocol) })
+
+	ts := memorytopo.NewServer("cell")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	twopcConns = make(map[uint32]*sandboxconn.SandboxConn)
+	conns := make(map[string]*sandboxconn.SandboxConn)
+	for i, shard := range shards {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "cell", Uid: uint32(100 + i)},
+			Keyspace: "ks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		require.NoError(t, ts.CreateShard(ctx, "ks", shard))
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		_, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+		sbc := sandboxconn.NewSandboxConn(tablet)
+		twopcConns[tablet.Alias.Uid] = sbc
+		conns[shard] = sbc
+	}
+	return New(logutil.NewMemoryLogger(), ts, nil), conns
+}
+
+func twopcTestMetadata(state querypb.TransactionState) *querypb.TransactionMetadata {
+	return &querypb.TransactionMetadata{
+		Dtid:  "ks:-80:1234",
+		State: state,
+		Participants: []*querypb.Target{{
+			Keyspace:   "ks",
+			Shard:      "80-",
+			TabletType: topodatapb.TabletType_MASTER,
+		}},
+	}
+}
+
+func TestListDistributedTransactions(t *testing.T) {
+	wr, conns := newTwopcTestEnv(t, "-80", "80-")
+	conns["-80"].UnresolvedResults = []*querypb.TransactionMetadata{twopcTestMetadata(querypb.TransactionState_COMMIT)}
+	conns["80-"].PreparedResults = []*querypb.PreparedTransaction{{Dtid: "ks:-80:1234", Queries: []string{"insert into t values(1)"}}}
+
+	shards, err := wr.ListDistributedTransactions(context.Background(), "ks", 0)
+	require.NoError(t, err)
+	require.Len(t, shards, 2)
+	assert.Equal(t, "-80", shards[0].Shard)
+	assert.Equal(t, "cell-0000000100", shards[0].TabletAlias)
+	assert.Len(t, shards[0].Transactions, 1)
+	assert.Empty(t, shards[0].Prepared)
+	assert.Equal(t, "80-", shards[1].Shard)
+	assert.Empty(t, shards[1].Transactions)
+	assert.Len(t, shards[1].Prepared, 1)
+
+	// A shard error is reported in-band.
+	conns["80-"].MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	shards, err = wr.ListDistributedTransactions(context.Background(), "ks", 0)
+	require.NoError(t, err)
+	assert.Empty(t, shards[0].Error)
+	assert.NotEmpty(t, shards[1].Error)
+}
+
+func TestReadDistributedTransaction(t *testing.T) {
+	wr, conns := newTwopcTestEnv(t, "-80", "80-")
+	conns["-80"].ReadTransactionResults = []*querypb.TransactionMetadata{twopcTestMetadata(querypb.TransactionState_PREPARE)}
+	conns["80-"].PreparedResults = []*querypb.PreparedTransaction{{Dtid: "ks:-80:1234", Failed: true, Queries: []string{"insert into t values(1)"}}}
+
+	dt, err := wr.ReadDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.Equal(t, "PREPARE", dt.State)
+	require.Len(t, dt.Participants, 1)
+	assert.Equal(t, &ParticipantState{
+		Keyspace:    "ks",
+		Shard:       "80-",
+		TabletAlias: "cell-0000000101",
+		State:       "Failed",
+		Queries:     []string{"insert into t values(1)"},
+	}, dt.Participants[0])
+
+	// Resolved transactions are returned as nil.
+	dt, err = wr.ReadDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.Nil(t, dt)
+
+	_, err = wr.ReadDistributedTransaction(context.Background(), "bad-dtid")
+	assert.Error(t, err)
+}
+
+func TestConcludeDistributedTransaction(t *testing.T) {
+	testcases := []struct {
+		state            querypb.TransactionState
+		setRollback      int64
+		rollbackPrepared int64
+		commitPrepared   int64
+	}{{
+		state:            querypb.TransactionState_PREPARE,
+		setRollback:      1,
+		rollbackPrepared: 1,
+	}, {
+		state:            querypb.TransactionState_ROLLBACK,
+		rollbackPrepared: 1,
+	}, {
+		state:          querypb.TransactionState_COMMIT,
+		commitPrepared: 1,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.state.String(), func(t *testing.T) {
+			wr, conns := newTwopcTestEnv(t, "-80", "80-")
+			mm, participant := conns["-80"], conns["80-"]
+			mm.ReadTransactionResults = []*querypb.TransactionMetadata{twopcTestMetadata(tcase.state)}
+
+			err := wr.ConcludeDistributedTransaction(context.Background(), "ks:-80:1234")
+			require.NoError(t, err)
+			assert.EqualValues(t, tcase.setRollback, mm.SetRollbackCount.Get())
+			assert.EqualValues(t, tcase.rollbackPrepared, participant.RollbackPreparedCount.Get())
+			assert.EqualValues(t, tcase.commitPrepared, participant.CommitPreparedCount.Get())
+			assert.EqualValues(t, 1, mm.ConcludeTransactionCount.Get())
+		})
+	}
+}
+
+func TestConcludeDistributedTransactionParticipantFailure(t *testing.T) {
+	wr, conns := newTwopcTestEnv(t, "-80", "80-")
+	mm, participant := conns["-80"], conns["80-"]
+	mm.ReadTransactionResults = []*querypb.TransactionMetadata{twopcTestMetadata(querypb.TransactionState_COMMIT)}
+	participant.MustFailCommitPrepared = 1
+
+	err := wr.ConcludeDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "participant ks/80-")
+	// The metadata must be kept so that the transaction can be resolved later.
+	assert.EqualValues(t, 0, mm.ConcludeTransactionCount.Get())
+}
+
+func TestRollbackDistributedTransaction(t *testing.T) {
+	wr, conns := newTwopcTestEnv(t, "-80", "80-")
+	mm, participant := conns["-80"], conns["80-"]
+
+	mm.ReadTransactionResults = []*querypb.TransactionMetadata{twopcTestMetadata(querypb.TransactionState_COMMIT)}
+	err := wr.RollbackDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the commit decision was already made")
+	assert.EqualValues(t, 0, participant.RollbackPreparedCount.Get())
+	assert.EqualValues(t, 0, mm.ConcludeTransactionCount.Get())
+
+	mm.ReadTransactionResults = []*querypb.TransactionMetadata{twopcTestMetadata(querypb.TransactionState_PREPARE)}
+	err = wr.RollbackDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, mm.SetRollbackCount.Get())
+	assert.EqualValues(t, 1, participant.RollbackPreparedCount.Get())
+	assert.EqualValues(t, 1, mm.ConcludeTransactionCount.Get())
+
+	// Already resolved.
+	err = wr.RollbackDistributedTransaction(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, mm.ConcludeTransactionCount.Get())
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file contains the wrangler side of the tablet-side VDiff. The diff
+// runs on the master of each target shard, which records its progress and
+// can resume it. The functions below fan the requests out to all the
+// target shards of the workflow, and aggregate their answers.
+
+// VDiffSummary is the state of a tablet-side vdiff across all the target shards.
+type VDiffSummary struct {
+	UUID  string
+	State string
+	// Progress is the percentage of the estimated number of rows
+	// that were compared so far.
+	Progress      float64
+	ProcessedRows int64
+	TableRows     int64
+	// StartedAt, CompletedAt and ETA are zero if unknown.
+	StartedAt   time.Time
+	CompletedAt time.Time
+	ETA         time.Time
+	// Reports contains the aggregated report of each table.
+	Reports map[string]*DiffReport
+	// Incremental lists the tables of which only the rows that
+	// changed since the previous vdiff were compared.
+	Incremental map[string]bool
+	// Shards contains the answer of each target shard.
+	Shards map[string]*tabletmanagerdatapb.VDiffResponse
+}
+
+// vdiffStatePriority lists the states that take precedence when
+// the target shards are not in the same state.
+var vdiffStatePriority = []string{tabletvdiff.ErrorState, tabletvdiff.StoppedState, tabletvdiff.StartedState, tabletvdiff.PendingState}
+
+// VDiffCreate starts a tablet-side vdiff of the workflow on all the target
+// shards, and returns its uuid.
+func (wr *Wrangler) VDiffCreate(ctx context.Context, keyspace, workflowName string, options *tabletmanagerdatapb.VDiffOptions) (string, error) {
+	vdiffUUID := uuid.New().String()
+	_, err := wr.vdiffAction(ctx, keyspace, workflowName, &tabletmanagerdatapb.VDiffRequest{
+		Action:    tabletvdiff.CreateAction,
+		VdiffUuid: vdiffUUID,
+		Options:   options,
+	})
+	if err != nil {
+		return "", err
+	}
+	return vdiffUUID, nil
+}
+
+// VDiffResume resumes a stopped or failed tablet-side vdiff on all the
+// target shards. The tables that were completed are not diffed again.
+func (wr *Wrangler) VDiffResume(ctx context.Context, keyspace, workflowName, vdiffUUID string) error {
+	_, err := wr.vdiffAction(ctx, keyspace, workflowName, &tabletmanagerdatapb.VDiffRequest{
+		Action:    tabletvdiff.ResumeAction,
+		VdiffUuid: vdiffUUID,
+	})
+	return err
+}
+
+// VDiffStop stops a tablet-side vdiff on all the target shards.
+// It can later be resumed.
+func (wr *Wrangler) VDiffStop(ctx context.Context, keyspace, workflowName, vdiffUUID string) error {
+	_, err := wr.vdiffAction(ctx, keyspace, workflowName, &tabletmanagerdatapb.VDiffRequest{
+		Action:    tabletvdiff.StopAction,
+		VdiffUuid: vdiffUUID,
+	})
+	return err
+}
+
+// VDiffShow returns the aggregated state of a tablet-side vdiff.
+// An empty uuid selects the most recent vdiff of the workflow.
+func (wr *Wrangler) VDiffShow(ctx context.Context, keyspace, workflowName, vdiffUUID string) (*VDiffSummary, error) {
+	if vdiffUUID == "" {
+		vdiffUUID = tabletvdiff.LastVDiff
+	}
+	responses, err := wr.vdiffAction(ctx, keyspace, workflowName, &tabletmanagerdatapb.VDiffRequest{
+		Action:    tabletvdiff.ShowAction,
+		VdiffUuid: vdiffUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeVDiff(responses, time.Now())
+}
+
+// summarizeVDiff aggregates the answers of the target shards.
+func summarizeVDiff(responses map[string]*tabletmanagerdatapb.VDiffResponse, now time.Time) (*VDiffSummary, error) {
+	summary := &VDiffSummary{
+		Reports:     make(map[string]*DiffReport),
+		Incremental: make(map[string]bool),
+		Shards:      responses,
+	}
+	states := make(map[string]bool)
+	var startedAt, completedAt int64
+	for shard, response := range responses {
+		if summary.UUID == "" {
+			summary.UUID = response.VdiffUuid
+		} else if summary.UUID != response.VdiffUuid {
+			return nil, fmt.Errorf("the last vdiffs of the target shards differ: %s on %s, %s elsewhere, specify the uuid", response.VdiffUuid, shard, summary.UUID)
+		}
+		states[response.State] = true
+		if response.StartedAt != 0 && (startedAt == 0 || response.StartedAt < startedAt) {
+			startedAt = response.StartedAt
+		}
+		if response.CompletedAt > completedAt {
+			completedAt = response.CompletedAt
+		}
+		for _, table := range response.Tables {
+			dr, ok := summary.Reports[table.TableName]
+			if !ok {
+				dr = &DiffReport{}
+				summary.Reports[table.TableName] = dr
+				summary.Incremental[table.TableName] = table.Incremental
+			}
+			summary.Incremental[table.TableName] = summary.Incremental[table.TableName] && table.Incremental
+			dr.ProcessedRows += int(table.ProcessedRows)
+			dr.MatchingRows += int(table.MatchingRows)
+			dr.MismatchedRows += int(table.MismatchedRows)
+			dr.ExtraRowsSource += int(table.ExtraRowsSource)
+			dr.ExtraRowsTarget += int(table.ExtraRowsTarget)
+			summary.ProcessedRows += table.ProcessedRows
+			// The row count is an estimate, which can be lower
+			// than the number of rows actually compared. It's
+			// meaningless for incremental diffs.
+			if table.State == tabletvdiff.CompletedState || table.Incremental || table.TableRows < table.ProcessedRows {
+				summary.TableRows += table.ProcessedRows
+			} else {
+				summary.TableRows += table.TableRows
+			}
+		}
+	}
+
+	summary.State = tabletvdiff.CompletedState
+	if len(states) == 1 {
+		for state := range states {
+			summary.State = state
+		}
+	} else {
+		for _, state := range vdiffStatePriority {
+			if states[state] {
+				summary.State = state
+				break
+			}
+		}
+	}
+
+	if startedAt != 0 {
+		summary.StartedAt = time.Unix(startedAt, 0)
+	}
+	switch {
+	case summary.State == tabletvdiff.CompletedState:
+		summary.Progress = 100
+		if completedAt != 0 {
+			summary.CompletedAt = time.Unix(completedAt, 0)
+		}
+	case summary.TableRows > 0:
+		summary.Progress = float64(summary.ProcessedRows) * 100 / float64(summary.TableRows)
+		if summary.State == tabletvdiff.StartedState && summary.ProcessedRows > 0 && startedAt != 0 {
+			elapsed := now.Sub(summary.StartedAt)
+			remaining := time.Duration(float64(elapsed) * float64(summary.TableRows-summary.ProcessedRows) / float64(summary.ProcessedRows))
+			summary.ETA = now.Add(remaining).Truncate(time.Second)
+		}
+	}
+	return summary, nil
+}
+
+// vdiffAction sends the request to the masters of all the target shards
+// of the workflow, and returns their answers by shard.
+func (wr *Wrangler) vdiffAction(ctx context.Context, keyspace, workflowName string, req *tabletmanagerdatapb.VDiffRequest) (map[string]*tabletmanagerdatapb.VDiffResponse, error) {
+	targets, err := workflow.BuildTargets(ctx, wr.ts, wr.tmc, keyspace, workflowName)
+	if err != nil {
+		return nil, err
+	}
+	if targets == nil || len(targets.Targets) == 0 {
+		return nil, fmt.Errorf("workflow %s not found in keyspace %s", workflowName, keyspace)
+	}
+	req.Keyspace = keyspace
+	req.Workflow = workflowName
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	responses := make(map[string]*tabletmanagerdatapb.VDiffResponse, len(targets.Targets))
+	allErrors := &concurrency.AllErrorRecorder{}
+	for shard, target := range targets.Targets {
+		wg.Add(1)
+		go func(shard string, target *workflow.MigrationTarget) {
+			defer wg.Done()
+			primary := target.GetPrimary()
+			response, err := wr.tmc.VDiff(ctx, primary.Tablet, req)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "VDiff %s on tablet %v", req.Action, topoproto.TabletAliasString(primary.Alias)))
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			responses[shard] = response
+		}(shard, target)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	return responses, nil
+}
+
+// Tables returns the sorted names of the tables of the summary.
+func (summary *VDiffSummary) Tables() []string {
+	tables := make([]string, 0, len(summary.Reports))
+	for table := range summary.Reports {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestSummarizeVDiff(t *testing.T) {
+	now := time.Unix(1000, 0)
+	responses := map[string]*tabletmanagerdatapb.VDiffResponse{
+		"-80": {
+			VdiffUuid: "u1",
+			State:     "completed",
+			StartedAt: 600,
+			Tables: []*tabletmanagerdatapb.VDiffTableReport{{
+				TableName:       "t1",
+				State:           "completed",
+				TableRows:       120,
+				ProcessedRows:   100,
+				MatchingRows:    99,
+				ExtraRowsSource: 1,
+			}},
+		},
+		"80-": {
+			VdiffUuid: "u1",
+			State:     "started",
+			StartedAt: 800,
+			Tables: []*tabletmanagerdatapb.VDiffTableReport{{
+				TableName:      "t1",
+				State:          "started",
+				TableRows:      200,
+				ProcessedRows:  80,
+				MatchingRows:   79,
+				MismatchedRows: 1,
+			}},
+		},
+	}
+	summary, err := summarizeVDiff(responses, now)
+	require.NoError(t, err)
+	assert.Equal(t, "u1", summary.UUID)
+	assert.Equal(t, "started", summary.State)
+	assert.Equal(t, int64(180), summary.ProcessedRows)
+	assert.Equal(t, int64(300), summary.TableRows)
+	assert.Equal(t, 60.0, summary.Progress)
+	assert.Equal(t, time.Unix(600, 0), summary.StartedAt)
+	// 180 rows in 400s, 120 rows to go.
+	assert.Equal(t, time.Unix(1266, 0), summary.ETA)
+	assert.Equal(t, []string{"t1"}, summary.Tables())
+	assert.False(t, summary.Incremental["t1"])
+	assert.Equal(t, DiffReport{
+		ProcessedRows:   180,
+		MatchingRows:    178,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+	}, *summary.Reports["t1"])
+
+	responses["80-"].State = "completed"
+	responses["80-"].CompletedAt = 900
+	responses["80-"].Tables[0].State = "completed"
+	summary, err = summarizeVDiff(responses, now)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", summary.State)
+	assert.Equal(t, 100.0, summary.Progress)
+	assert.Equal(t, time.Unix(900, 0), summary.CompletedAt)
+	assert.True(t, summary.ETA.IsZero())
+
+	responses["80-"].VdiffUuid = "u2"
+	_, err = summarizeVDiff(responses, now)
+	assert.Error(t, err)
+}
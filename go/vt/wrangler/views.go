@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// viewDefinerRegexp matches the DEFINER clause of a CREATE VIEW statement, which the parser does not support.
+var viewDefinerRegexp = regexp.MustCompile("(?i)DEFINER=`[^`]*`@`[^`]*`\\s*")
+
+// RefreshVSchemaViews reads the views from the schema of the master of the keyspace's first shard,
+// and records each one in the keyspace's VSchema as a table of type view, along with its definition.
+// VSchema views that no longer exist in the schema are removed. Tables which are not views are left untouched.
+// It returns the updated VSchema, which is only saved if dryRun is false.
+func (wr *Wrangler) RefreshVSchemaViews(ctx context.Context, keyspace string, dryRun bool) (*vschemapb.Keyspace, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+	si, err := wr.ts.GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shards[0], err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shards[0])
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, true /*includeViews*/)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchema(%v) failed: %v", si.MasterAlias, err)
+	}
+
+	vs, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			return nil, err
+		}
+		vs = &vschemapb.Keyspace{}
+	}
+	if vs.Tables == nil {
+		vs.Tables = make(map[string]*vschemapb.Table)
+	}
+
+	views := make(map[string]bool)
+	for _, td := range sd.TableDefinitions {
+		if td.Type != tmutils.TableView {
+			continue
+		}
+		definition, err := viewDefinitionFromSchema(td.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the definition of view %v: %v", td.Name, err)
+		}
+		views[td.Name] = true
+		vs.Tables[td.Name] = &vschemapb.Table{
+			Type:           vindexes.TypeView,
+			ViewDefinition: definition,
+		}
+	}
+	for name, table := range vs.Tables {
+		if table.Type == vindexes.TypeView && !views[name] {
+			delete(vs.Tables, name)
+		}
+	}
+
+	if dryRun {
+		return vs, nil
+	}
+	if err := wr.ts.SaveVSchema(ctx, keyspace, vs); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+// viewDefinitionFromSchema returns the SELECT statement of a view, given its normalized
+// CREATE VIEW statement, where the database name is replaced by {{.DatabaseName}}.
+// Table names in the returned statement are not qualified by the database name.
+func viewDefinitionFromSchema(schema string) (string, error) {
+	schema = strings.ReplaceAll(schema, "{{.DatabaseName}}.", "")
+	schema = viewDefinerRegexp.ReplaceAllString(schema, "")
+	stmt, err := sqlparser.Parse(schema)
+	if err != nil {
+		return "", err
+	}
+	createView, ok := stmt.(*sqlparser.CreateView)
+	if !ok {
+		return "", fmt.Errorf("not a CREATE VIEW statement: %v", schema)
+	}
+	return sqlparser.String(createView.Select), nil
+}